@@ -9,6 +9,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/hay-kot/homebox/backend/internal/core/services"
+	"github.com/hay-kot/homebox/backend/internal/core/services/reporting"
 	"github.com/hay-kot/homebox/backend/internal/data/repo"
 	"github.com/hay-kot/homebox/backend/internal/sys/validate"
 	"github.com/hay-kot/homebox/backend/internal/web/adapters"
@@ -59,6 +60,7 @@ func (ctrl *V1Controller) HandleItemsGetAll() errchain.HandlerFunc {
 			LabelIDs:        queryUUIDList(params, "labels"),
       ParentItemIDs:   queryUUIDList(params, "parentIds"),
 			IncludeArchived: queryBool(params.Get("includeArchived")),
+			IncludeTrashed:  queryBool(params.Get("includeTrashed")),
 			Fields:          filterFieldItems(params["fields"]),
 			OrderBy:         params.Get("orderBy"),
 		}
@@ -156,6 +158,7 @@ func (ctrl *V1Controller) HandleItemDelete() errchain.HandlerFunc {
 //	@Param    id      path     string          true "Item ID"
 //	@Param    payload body     repo.ItemUpdate true "Item Data"
 //	@Success  200     {object} repo.ItemOut
+//	@Failure  409     {object} validate.ErrorResponse
 //	@Router   /v1/items/{id} [PUT]
 //	@Security Bearer
 func (ctrl *V1Controller) HandleItemUpdate() errchain.HandlerFunc {
@@ -163,7 +166,12 @@ func (ctrl *V1Controller) HandleItemUpdate() errchain.HandlerFunc {
 		auth := services.NewContext(r.Context())
 
 		body.ID = ID
-		return ctrl.repo.Items.UpdateByGroup(auth, auth.GID, body)
+		out, err := ctrl.repo.Items.UpdateByGroup(auth, auth.GID, body)
+		if errors.Is(err, repo.ErrVersionConflict) || errors.Is(err, repo.ErrItemInTrash) {
+			return repo.ItemOut{}, validate.NewRequestError(err, http.StatusConflict)
+		}
+
+		return out, err
 	}
 
 	return adapters.ActionID("id", fn, http.StatusOK)
@@ -177,6 +185,7 @@ func (ctrl *V1Controller) HandleItemUpdate() errchain.HandlerFunc {
 //	@Param    id      path     string          true "Item ID"
 //	@Param    payload body     repo.ItemPatch true "Item Data"
 //	@Success  200     {object} repo.ItemOut
+//	@Failure  409     {object} validate.ErrorResponse
 //	@Router   /v1/items/{id} [Patch]
 //	@Security Bearer
 func (ctrl *V1Controller) HandleItemPatch() errchain.HandlerFunc {
@@ -185,6 +194,9 @@ func (ctrl *V1Controller) HandleItemPatch() errchain.HandlerFunc {
 
 		body.ID = ID
 		err := ctrl.repo.Items.Patch(auth, auth.GID, ID, body)
+		if errors.Is(err, repo.ErrVersionConflict) || errors.Is(err, repo.ErrItemInTrash) {
+			return repo.ItemOut{}, validate.NewRequestError(err, http.StatusConflict)
+		}
 		if err != nil {
 			return repo.ItemOut{}, err
 		}
@@ -263,6 +275,12 @@ func (ctrl *V1Controller) HandleItemsImport() errchain.HandlerFunc {
 
 		_, err = ctrl.svc.Items.CsvImport(r.Context(), user.GroupID, file)
 		if err != nil {
+			// the remaining rows were imported, report the rows that failed
+			var importErr *reporting.ImportError
+			if errors.As(err, &importErr) {
+				return validate.NewRequestError(err, http.StatusUnprocessableEntity)
+			}
+
 			log.Err(err).Msg("failed to import items")
 			return validate.NewRequestError(err, http.StatusInternalServerError)
 		}
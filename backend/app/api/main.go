@@ -16,6 +16,7 @@ import (
 	"github.com/hay-kot/homebox/backend/internal/core/services"
 	"github.com/hay-kot/homebox/backend/internal/core/services/reporting/eventbus"
 	"github.com/hay-kot/homebox/backend/internal/data/ent"
+	_ "github.com/hay-kot/homebox/backend/internal/data/ent/runtime" // schema defaults, validators and hooks
 	"github.com/hay-kot/homebox/backend/internal/data/migrations"
 	"github.com/hay-kot/homebox/backend/internal/data/repo"
 	"github.com/hay-kot/homebox/backend/internal/sys/config"
@@ -128,7 +129,11 @@ func run(cfg *config.Config) error {
 
 	app.bus = eventbus.New()
 	app.db = c
-	app.repos = repo.New(c, app.bus, cfg.Storage.Data)
+	app.repos = repo.New(c, app.bus, cfg.Storage.Data, repo.ItemEventsOnBus(app.bus))
+	app.repos.Items.SetPageBounds(repo.PageBounds{
+		Default: cfg.Options.DefaultPageSize,
+		Max:     cfg.Options.MaxPageSize,
+	})
 	app.services = services.New(
 		app.repos,
 		services.WithAutoIncrementAssetID(cfg.Options.AutoIncrementAssetID),
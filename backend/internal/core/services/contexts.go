@@ -42,8 +42,13 @@ func NewContext(ctx context.Context) Context {
 }
 
 // SetUserCtx is a helper function that sets the ContextUser and ContextUserToken
-// values within the context of a web request (or any context).
+// values within the context of a web request (or any context). The user is also set
+// as the actor of the item changes made with the context.
 func SetUserCtx(ctx context.Context, user *repo.UserOut, token string) context.Context {
+	if user != nil {
+		ctx = repo.WithActor(ctx, user.ID)
+	}
+
 	ctx = context.WithValue(ctx, ContextUser, user)
 	ctx = context.WithValue(ctx, ContextUserToken, token)
 	return ctx
@@ -8,6 +8,7 @@ import (
 
 	"github.com/hay-kot/homebox/backend/internal/core/services/reporting/eventbus"
 	"github.com/hay-kot/homebox/backend/internal/data/ent"
+	_ "github.com/hay-kot/homebox/backend/internal/data/ent/runtime" // schema defaults, validators and hooks
 	"github.com/hay-kot/homebox/backend/internal/data/repo"
 	"github.com/hay-kot/homebox/backend/pkgs/faker"
 	_ "github.com/mattn/go-sqlite3"
@@ -59,8 +60,10 @@ func TestMain(m *testing.M) {
 		log.Fatalf("failed creating schema resources: %v", err)
 	}
 
+	go tbus.Run()
+
 	tClient = client
-	tRepos = repo.New(tClient, tbus, os.TempDir()+"/homebox")
+	tRepos = repo.New(tClient, tbus, os.TempDir()+"/homebox", nil)
 	tSvc = New(tRepos)
 	defer client.Close()
 
@@ -13,6 +13,10 @@ const (
 	EventLabelMutation    Event = "label.mutation"
 	EventLocationMutation Event = "location.mutation"
 	EventItemMutation     Event = "item.mutation"
+
+	// EventItemChange carries the item domain events of the repository, e.g.
+	// repo.ItemCreated
+	EventItemChange Event = "item.change"
 )
 
 type GroupMutationEvent struct {
@@ -39,6 +43,7 @@ func New() *EventBus {
 			EventLabelMutation:    {},
 			EventLocationMutation: {},
 			EventItemMutation:     {},
+			EventItemChange:       {},
 		},
 	}
 }
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/csv"
 	"errors"
+	"fmt"
 	"io"
 	"strings"
 )
@@ -13,6 +14,36 @@ var (
 	ErrMissingRequiredHeaders = errors.New("missing required headers `HB.location` or `HB.name`")
 )
 
+// RowError describes a row of an import that could not be imported. Line is the line of the
+// row in the sheet, the header being the first line.
+type RowError struct {
+	Line int
+	Err  error
+}
+
+func (e RowError) Error() string {
+	return fmt.Sprintf("line %d: %v", e.Line, e.Err)
+}
+
+func (e RowError) Unwrap() error {
+	return e.Err
+}
+
+// ImportError is returned when some of the rows of an import failed while the others were
+// imported.
+type ImportError struct {
+	Rows []RowError
+}
+
+func (e *ImportError) Error() string {
+	msgs := make([]string, len(e.Rows))
+	for i, r := range e.Rows {
+		msgs[i] = r.Error()
+	}
+
+	return fmt.Sprintf("%d rows could not be imported: %s", len(e.Rows), strings.Join(msgs, "; "))
+}
+
 // determineSeparator determines the separator used in the CSV file
 // It returns the separator as a rune and an error if it could not be determined
 //
@@ -57,6 +88,9 @@ func readRawCsv(r io.Reader) ([][]string, error) {
 
 	reader.Comma = sep
 
+	// rows with the wrong number of columns are reported per row by IOSheet.Read
+	reader.FieldsPerRecord = -1
+
 	return reader.ReadAll()
 }
 
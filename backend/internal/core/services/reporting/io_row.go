@@ -25,9 +25,10 @@ type ExportTSVRow struct {
 	Insured     bool   `csv:"HB.insured"`
 	Notes       string `csv:"HB.notes"`
 
-	PurchasePrice float64    `csv:"HB.purchase_price"`
-	PurchaseFrom  string     `csv:"HB.purchase_from"`
-	PurchaseTime  types.Date `csv:"HB.purchase_time"`
+	PurchasePrice       float64    `csv:"HB.purchase_price"`
+	PurchaseFrom        string     `csv:"HB.purchase_from"`
+	PurchaseTime        types.Date `csv:"HB.purchase_time"`
+	PurchaseOrderNumber string     `csv:"HB.purchase_order_number"`
 
 	Manufacturer string `csv:"HB.manufacturer"`
 	ModelNumber  string `csv:"HB.model_number"`
@@ -42,6 +43,10 @@ type ExportTSVRow struct {
 	SoldTime  types.Date `csv:"HB.sold_time"`
 	SoldNotes string     `csv:"HB.sold_notes"`
 
+	// AttachmentURLs are downloaded and attached when a new item is imported, the column
+	// is left out of exports.
+	AttachmentURLs URLString `csv:"HB.attachment_urls" export:"-"`
+
 	Fields []ExportItemFields `csv:"-"`
 }
 
@@ -66,6 +71,21 @@ func (ls LabelString) String() string {
 
 // ============================================================================
 
+// URLString is a string slice that is used to represent a list of URLs separated
+// by semicolons.
+type URLString []string
+
+func parseURLString(s string) URLString {
+	v, _ := parseSeparatedString(s, ";")
+	return v
+}
+
+func (us URLString) String() string {
+	return strings.Join(us, "; ")
+}
+
+// ============================================================================
+
 // LocationString is a string slice that is used to represent a location
 // hierarchy.
 //
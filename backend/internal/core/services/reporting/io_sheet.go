@@ -24,7 +24,11 @@ type IOSheet struct {
 	headers []string
 	custom  []int
 	index   map[string]int
+	lines   []int
 	Rows    []ExportTSVRow
+
+	// Errors holds the rows that could not be read
+	Errors []RowError
 }
 
 func (s *IOSheet) indexHeaders() {
@@ -59,6 +63,9 @@ func (s *IOSheet) GetColumn(str string) (col int, ok bool) {
 //   - the first row is assumed to be the header
 //   - at least 1 row of data is required
 //   - rows and columns must be rectangular (i.e. all rows must have the same number of columns)
+//
+// Rows that cannot be parsed are skipped and recorded in the "Errors" field, an error is
+// only returned when the sheet as a whole cannot be read.
 func (s *IOSheet) Read(data io.Reader) error {
 	sheet, err := readRawCsv(data)
 	if err != nil {
@@ -70,11 +77,20 @@ func (s *IOSheet) Read(data io.Reader) error {
 	}
 
 	s.headers = sheet[0]
-	s.Rows = make([]ExportTSVRow, len(sheet)-1)
+	s.Rows = make([]ExportTSVRow, 0, len(sheet)-1)
+	s.lines = make([]int, 0, len(sheet)-1)
+	s.Errors = nil
 
+rows:
 	for i, row := range sheet[1:] {
+		line := i + 2 // the header is the first line
+
 		if len(row) != len(s.headers) {
-			return fmt.Errorf("row has %d columns, expected %d", len(row), len(s.headers))
+			s.Errors = append(s.Errors, RowError{
+				Line: line,
+				Err:  fmt.Errorf("row has %d columns, expected %d", len(row), len(s.headers)),
+			})
+			continue
 		}
 
 		rowData := ExportTSVRow{}
@@ -116,6 +132,8 @@ func (s *IOSheet) Read(data io.Reader) error {
 				v = parseLocationString(val)
 			case reflect.TypeOf(LabelString{}):
 				v = parseLabelString(val)
+			case reflect.TypeOf(URLString{}):
+				v = parseURLString(val)
 			}
 
 			log.Debug().
@@ -126,7 +144,11 @@ func (s *IOSheet) Read(data io.Reader) error {
 
 			// Nil values are not allowed at the moment. This may change.
 			if v == nil {
-				return fmt.Errorf("could not convert %q to %s", val, field.Type)
+				s.Errors = append(s.Errors, RowError{
+					Line: line,
+					Err:  fmt.Errorf("could not convert %q to %s", val, field.Type),
+				})
+				continue rows
 			}
 
 			ptrField := reflect.ValueOf(&rowData).Elem().Field(i)
@@ -146,12 +168,18 @@ func (s *IOSheet) Read(data io.Reader) error {
 			})
 		}
 
-		s.Rows[i] = rowData
+		s.Rows = append(s.Rows, rowData)
+		s.lines = append(s.lines, line)
 	}
 
 	return nil
 }
 
+// Line returns the line of the sheet the i'th row was read from.
+func (s *IOSheet) Line(i int) int {
+	return s.lines[i]
+}
+
 // Write writes the sheet to a writer.
 func (s *IOSheet) ReadItems(ctx context.Context, items []repo.ItemOut, GID uuid.UUID, repos *repo.AllRepos) error {
 	s.Rows = make([]ExportTSVRow, len(items))
@@ -202,9 +230,10 @@ func (s *IOSheet) ReadItems(ctx context.Context, items []repo.ItemOut, GID uuid.
 			Insured:     item.Insured,
 			Archived:    item.Archived,
 
-			PurchasePrice: item.PurchasePrice,
-			PurchaseFrom:  item.PurchaseFrom,
-			PurchaseTime:  item.PurchaseTime,
+			PurchasePrice:       item.PurchasePrice,
+			PurchaseFrom:        item.PurchaseFrom,
+			PurchaseTime:        item.PurchaseTime,
+			PurchaseOrderNumber: item.PurchaseOrderNumber,
 
 			Manufacturer: item.Manufacturer,
 			ModelNumber:  item.ModelNumber,
@@ -238,7 +267,7 @@ func (s *IOSheet) ReadItems(ctx context.Context, items []repo.ItemOut, GID uuid.
 	for i := 0; i < st.NumField(); i++ {
 		field := st.Field(i)
 		tag := field.Tag.Get("csv")
-		if tag == "" || tag == "-" {
+		if tag == "" || tag == "-" || field.Tag.Get("export") == "-" {
 			continue
 		}
 
@@ -301,6 +330,8 @@ func (s *IOSheet) TSV() ([][]string, error) {
 				v = val.Interface().(LocationString).String()
 			case reflect.TypeOf(LabelString{}):
 				v = val.Interface().(LabelString).String()
+			case reflect.TypeOf(URLString{}):
+				v = val.Interface().(URLString).String()
 			default:
 				log.Debug().Str("type", field.Type.String()).Msg("unknown type")
 			}
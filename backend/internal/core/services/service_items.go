@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"net/http"
 	"strings"
 
 	"github.com/google/uuid"
@@ -23,19 +24,80 @@ type ItemService struct {
 	filepath string
 
 	autoIncrementAssetID bool
+
+	// attachmentClient downloads the files attached by URL, attachmentHTTPClient when nil
+	attachmentClient *http.Client
 }
 
+// Create creates the item with the next asset ID and attaches the files of its
+// AttachmentURLs, see attachURLs.
 func (svc *ItemService) Create(ctx Context, item repo.ItemCreate) (repo.ItemOut, error) {
-	if svc.autoIncrementAssetID {
-		highest, err := svc.repo.Items.GetHighestAssetID(ctx, ctx.GID)
+	out, err := svc.repo.Items.Create(ctx, ctx.GID, item)
+	if err != nil {
+		return repo.ItemOut{}, err
+	}
+
+	err = svc.assignAssetID(ctx, ctx.GID, &out)
+	if err != nil {
+		return repo.ItemOut{}, err
+	}
+
+	if svc.attachURLs(ctx, ctx.GID, out.ID, item.AttachmentURLs) > 0 {
+		return svc.repo.Items.GetOne(ctx, out.ID)
+	}
+
+	return out, nil
+}
+
+// CreateMany creates the items in a single transaction, see ItemsRepository.CreateMany. The
+// items get the next asset IDs in the order they were given.
+func (svc *ItemService) CreateMany(ctx Context, items []repo.ItemCreate) ([]repo.ItemOut, error) {
+	out, err := svc.repo.Items.CreateMany(ctx, ctx.GID, items)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range out {
+		err = svc.assignAssetID(ctx, ctx.GID, &out[i])
 		if err != nil {
-			return repo.ItemOut{}, err
+			return nil, err
 		}
+	}
 
-		item.AssetID = repo.AssetID(highest + 1)
+	return out, nil
+}
+
+// Duplicate creates a copy of the item, see ItemsRepository.DuplicateByGroup. The copy gets
+// the next asset ID like a created item.
+func (svc *ItemService) Duplicate(ctx Context, ID uuid.UUID, opts repo.DuplicateOptions) (repo.ItemOut, error) {
+	out, err := svc.repo.Items.DuplicateByGroup(ctx, ctx.GID, ID, opts)
+	if err != nil {
+		return repo.ItemOut{}, err
 	}
 
-	return svc.repo.Items.Create(ctx, ctx.GID, item)
+	err = svc.assignAssetID(ctx, ctx.GID, &out)
+	if err != nil {
+		return repo.ItemOut{}, err
+	}
+
+	return out, nil
+}
+
+// assignAssetID gives the created item the next asset ID of the group when asset IDs are
+// incremented automatically.
+func (svc *ItemService) assignAssetID(ctx context.Context, GID uuid.UUID, out *repo.ItemOut) error {
+	if !svc.autoIncrementAssetID {
+		return nil
+	}
+
+	// the asset ID is assigned after creation so that concurrent creates can't collide
+	aid, err := svc.repo.Items.AssignNextAssetID(ctx, GID, out.ID)
+	if err != nil {
+		return err
+	}
+
+	out.AssetID = aid
+	return nil
 }
 
 func (svc *ItemService) EnsureAssetID(ctx context.Context, GID uuid.UUID) (int, error) {
@@ -94,7 +156,8 @@ func serializeLocation[T ~[]string](location T) string {
 // CsvImport applies the following rules/operations
 //
 //  1. If the item does not exist, it is created.
-//  2. If the item has a ImportRef and it exists it is skipped
+//  2. If the item has a ImportRef and it exists it is updated with the columns of the sheet,
+//     an item in the trash is reported as a row error
 //  3. Locations and Labels are created if they do not exist.
 func (svc *ItemService) CsvImport(ctx context.Context, GID uuid.UUID, data io.Reader) (int, error) {
 	sheet := reporting.IOSheet{}
@@ -159,174 +222,232 @@ func (svc *ItemService) CsvImport(ctx context.Context, GID uuid.UUID, data io.Re
 		}
 	}
 
+	grp, err := svc.repo.Groups.GroupByID(ctx, GID)
+	if err != nil {
+		return 0, err
+	}
+
+	has := func(col string) bool {
+		_, ok := sheet.GetColumn(col)
+		return ok
+	}
+
 	finished := 0
+	rowErrors := sheet.Errors
 
 	for i := range sheet.Rows {
 		row := sheet.Rows[i]
 
-		createRequired := true
+		err := svc.importRow(ctx, GID, row, has, grp.Currency, labelMap, locationMap, &highestAID)
+		if err != nil {
+			rowErrors = append(rowErrors, reporting.RowError{Line: sheet.Line(i), Err: err})
+			continue
+		}
+
+		finished++
+	}
+
+	if len(rowErrors) > 0 {
+		return finished, &reporting.ImportError{Rows: rowErrors}
+	}
+
+	return finished, nil
+}
+
+// importRow creates or updates the item of a single row of an import, creating its labels
+// and locations as necessary. An existing item is only updated for the columns the sheet
+// has, has reports whether the sheet has a column.
+func (svc *ItemService) importRow(ctx context.Context, GID uuid.UUID, row reporting.ExportTSVRow, has func(col string) bool, groupCurrency string, labelMap, locationMap map[string]uuid.UUID, highestAID *repo.AssetID) error {
+	var (
+		err  error
+		item repo.ItemOut
+	)
+
+	createRequired := true
+
+	// ========================================
+	// Preflight check for existing item
+	if row.ImportRef != "" {
+		exists, err := svc.repo.Items.CheckRef(ctx, GID, row.ImportRef)
+		if err != nil {
+			return fmt.Errorf("error checking for existing item with ref %q: %w", row.ImportRef, err)
+		}
+
+		if exists {
+			createRequired = false
 
-		// ========================================
-		// Preflight check for existing item
-		if row.ImportRef != "" {
-			exists, err := svc.repo.Items.CheckRef(ctx, GID, row.ImportRef)
+			item, err = svc.repo.Items.GetByRef(ctx, GID, row.ImportRef)
 			if err != nil {
-				return 0, fmt.Errorf("error checking for existing item with ref %q: %w", row.ImportRef, err)
+				return err
 			}
 
-			if exists {
-				createRequired = false
+			if item.ArchivedAt != nil {
+				return fmt.Errorf("item with ref %q: %w", row.ImportRef, repo.ErrItemInTrash)
 			}
 		}
+	}
 
-		// ========================================
-		// Pre-Create Labels as necessary
-		labelIds := make([]uuid.UUID, len(row.LabelStr))
+	// ========================================
+	// Pre-Create Labels as necessary
+	labelIds := make([]uuid.UUID, len(row.LabelStr))
 
-		for j := range row.LabelStr {
-			label := row.LabelStr[j]
+	for j := range row.LabelStr {
+		label := row.LabelStr[j]
 
-			id, ok := labelMap[label]
-			if !ok {
-				newLabel, err := svc.repo.Labels.Create(ctx, GID, repo.LabelCreate{Name: label})
-				if err != nil {
-					return 0, err
-				}
-				id = newLabel.ID
+		id, ok := labelMap[label]
+		if !ok {
+			newLabel, err := svc.repo.Labels.Create(ctx, GID, repo.LabelCreate{Name: label})
+			if err != nil {
+				return err
 			}
-
-			labelIds[j] = id
-			labelMap[label] = id
+			id = newLabel.ID
 		}
 
-		// ========================================
-		// Pre-Create Locations as necessary
-		path := serializeLocation(row.Location)
-
-		locationID, ok := locationMap[path]
-		if !ok { // Traverse the path of LocationStr and check each path element to see if it exists already, if not create it.
-			paths := []string{}
-			for i, pathElement := range row.Location {
-				paths = append(paths, pathElement)
-				path := serializeLocation(paths)
-
-				locationID, ok = locationMap[path]
-				if !ok {
-					parentID := uuid.Nil
-
-					// Get the parent ID
-					if i > 0 {
-						parentPath := serializeLocation(row.Location[:i])
-						parentID = locationMap[parentPath]
-					}
-
-					newLocation, err := svc.repo.Locations.Create(ctx, GID, repo.LocationCreate{
-						ParentID: parentID,
-						Name:     pathElement,
-					})
-					if err != nil {
-						return 0, err
-					}
-					locationID = newLocation.ID
-				}
+		labelIds[j] = id
+		labelMap[label] = id
+	}
 
-				locationMap[path] = locationID
-			}
+	// ========================================
+	// Pre-Create Locations as necessary
+	path := serializeLocation(row.Location)
+
+	locationID, ok := locationMap[path]
+	if !ok { // Traverse the path of LocationStr and check each path element to see if it exists already, if not create it.
+		paths := []string{}
+		for i, pathElement := range row.Location {
+			paths = append(paths, pathElement)
+			path := serializeLocation(paths)
 
 			locationID, ok = locationMap[path]
 			if !ok {
-				return 0, errors.New("failed to create location")
-			}
-		}
+				parentID := uuid.Nil
 
-		var effAID repo.AssetID
-		if svc.autoIncrementAssetID && row.AssetID.Nil() {
-			effAID = highestAID + 1
-			highestAID++
-		} else {
-			effAID = row.AssetID
-		}
+				// Get the parent ID
+				if i > 0 {
+					parentPath := serializeLocation(row.Location[:i])
+					parentID = locationMap[parentPath]
+				}
 
-		// ========================================
-		// Create Item
-		var item repo.ItemOut
-		switch {
-		case createRequired:
-			newItem := repo.ItemCreate{
-				ImportRef:   row.ImportRef,
-				Name:        row.Name,
-				Description: row.Description,
-				AssetID:     effAID,
-				LocationID:  locationID,
-				LabelIDs:    labelIds,
+				newLocation, err := svc.repo.Locations.Create(ctx, GID, repo.LocationCreate{
+					ParentID: parentID,
+					Name:     pathElement,
+				})
+				if err != nil {
+					return err
+				}
+				locationID = newLocation.ID
 			}
 
-			item, err = svc.repo.Items.Create(ctx, GID, newItem)
-			if err != nil {
-				return 0, err
-			}
-		default:
-			item, err = svc.repo.Items.GetByRef(ctx, GID, row.ImportRef)
-			if err != nil {
-				return 0, err
-			}
+			locationMap[path] = locationID
 		}
 
-		if item.ID == uuid.Nil {
-			panic("item ID is nil on import - this should never happen")
+		locationID, ok = locationMap[path]
+		if !ok {
+			return errors.New("failed to create location")
 		}
+	}
 
-		fields := make([]repo.ItemField, len(row.Fields))
-		for i := range row.Fields {
-			fields[i] = repo.ItemField{
-				Name:      row.Fields[i].Name,
-				Type:      "text",
-				TextValue: row.Fields[i].Value,
-			}
+	// ========================================
+	// Create Item
+	if createRequired {
+		var effAID repo.AssetID
+		if svc.autoIncrementAssetID && row.AssetID.Nil() {
+			effAID = *highestAID + 1
+			*highestAID++
+		} else {
+			effAID = row.AssetID
 		}
 
-		updateItem := repo.ItemUpdate{
-			ID:         item.ID,
-			LabelIDs:   labelIds,
-			LocationID: locationID,
-
+		newItem := repo.ItemCreate{
+			ImportRef:   row.ImportRef,
 			Name:        row.Name,
 			Description: row.Description,
 			AssetID:     effAID,
-			Insured:     row.Insured,
-			Quantity:    row.Quantity,
-			Archived:    row.Archived,
+			Source:      "import",
+			LocationID:  locationID,
+			LabelIDs:    labelIds,
+		}
+
+		item, err = svc.repo.Items.Create(ctx, GID, newItem)
+		if err != nil {
+			return err
+		}
 
-			PurchasePrice: row.PurchasePrice,
-			PurchaseFrom:  row.PurchaseFrom,
-			PurchaseTime:  row.PurchaseTime,
+		svc.attachURLs(ctx, GID, item.ID, row.AttachmentURLs)
+	}
 
-			Manufacturer: row.Manufacturer,
-			ModelNumber:  row.ModelNumber,
-			SerialNumber: row.SerialNumber,
+	if item.ID == uuid.Nil {
+		panic("item ID is nil on import - this should never happen")
+	}
 
-			LifetimeWarranty: row.LifetimeWarranty,
-			WarrantyExpires:  row.WarrantyExpires,
-			WarrantyDetails:  row.WarrantyDetails,
+	// start from the stored item so that the columns missing from the sheet are kept
+	updateItem := item.Update()
+	updateItem.LocationID = locationID
 
-			SoldTo:    row.SoldTo,
-			SoldTime:  row.SoldTime,
-			SoldPrice: row.SoldPrice,
-			SoldNotes: row.SoldNotes,
+	if strings.EqualFold(updateItem.Currency, groupCurrency) {
+		updateItem.Currency = ""
+	}
 
-			Notes:  row.Notes,
-			Fields: fields,
+	importColumn(has("HB.name"), &updateItem.Name, row.Name)
+	importColumn(has("HB.description"), &updateItem.Description, row.Description)
+	importColumn(has("HB.labels"), &updateItem.LabelIDs, labelIds)
+	importColumn(has("HB.insured"), &updateItem.Insured, row.Insured)
+	importColumn(has("HB.quantity"), &updateItem.Quantity, row.Quantity)
+	importColumn(has("HB.archived"), &updateItem.Archived, row.Archived)
+	importColumn(!row.AssetID.Nil(), &updateItem.AssetID, row.AssetID)
+
+	importColumn(has("HB.purchase_price"), &updateItem.PurchasePrice, row.PurchasePrice)
+	importColumn(has("HB.purchase_from"), &updateItem.PurchaseFrom, row.PurchaseFrom)
+	importColumn(has("HB.purchase_time"), &updateItem.PurchaseTime, row.PurchaseTime)
+	importColumn(has("HB.purchase_order_number"), &updateItem.PurchaseOrderNumber, row.PurchaseOrderNumber)
+
+	importColumn(has("HB.manufacturer"), &updateItem.Manufacturer, row.Manufacturer)
+	importColumn(has("HB.model_number"), &updateItem.ModelNumber, row.ModelNumber)
+	importColumn(has("HB.serial_number"), &updateItem.SerialNumber, row.SerialNumber)
+
+	importColumn(has("HB.lifetime_warranty"), &updateItem.LifetimeWarranty, row.LifetimeWarranty)
+	importColumn(has("HB.warranty_expires"), &updateItem.WarrantyExpires, row.WarrantyExpires)
+	importColumn(has("HB.warranty_details"), &updateItem.WarrantyDetails, row.WarrantyDetails)
+
+	importColumn(has("HB.sold_to"), &updateItem.SoldTo, row.SoldTo)
+	importColumn(has("HB.sold_time"), &updateItem.SoldTime, row.SoldTime)
+	importColumn(has("HB.sold_price"), &updateItem.SoldPrice, row.SoldPrice)
+	importColumn(has("HB.sold_notes"), &updateItem.SoldNotes, row.SoldNotes)
+
+	importColumn(has("HB.notes"), &updateItem.Notes, row.Notes)
+
+	// custom fields of the sheet replace the fields of the same name, the others are kept
+	for _, f := range row.Fields {
+		field := repo.ItemField{
+			Name:      f.Name,
+			Type:      "text",
+			TextValue: f.Value,
 		}
 
-		item, err = svc.repo.Items.UpdateByGroup(ctx, GID, updateItem)
-		if err != nil {
-			return 0, err
+		replaced := false
+		for i := range updateItem.Fields {
+			if updateItem.Fields[i].Name == f.Name {
+				field.ID = updateItem.Fields[i].ID
+				updateItem.Fields[i] = field
+				replaced = true
+				break
+			}
 		}
 
-		finished++
+		if !replaced {
+			updateItem.Fields = append(updateItem.Fields, field)
+		}
 	}
 
-	return finished, nil
+	_, err = svc.repo.Items.UpdateByGroup(ctx, GID, updateItem)
+	return err
+}
+
+// importColumn sets the value of an imported column when the sheet has the column.
+func importColumn[T any](present bool, dst *T, v T) {
+	if present {
+		*dst = v
+	}
 }
 
 func (svc *ItemService) ExportTSV(ctx context.Context, GID uuid.UUID) ([][]string, error) {
@@ -3,7 +3,6 @@ package services
 import (
 	"context"
 	"io"
-	"os"
 
 	"github.com/google/uuid"
 	"github.com/hay-kot/homebox/backend/internal/data/ent"
@@ -83,8 +82,8 @@ func (svc *ItemService) AttachmentDelete(ctx context.Context, gid, itemId, attac
 		return err
 	}
 
-	// Remove File
-	err = os.Remove(attachment.Edges.Document.Path)
+	// Remove the document and its file, unless it's shared with another item
+	_, err = svc.repo.Docs.DeleteUnattached(ctx, attachment.Edges.Document.ID)
 
 	return err
 }
@@ -2,13 +2,19 @@ package services
 
 import (
 	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"path"
 	"strings"
 	"testing"
 
+	"github.com/google/uuid"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/attachment"
 	"github.com/hay-kot/homebox/backend/internal/data/repo"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestItemService_AddAttachment(t *testing.T) {
@@ -59,3 +65,118 @@ func TestItemService_AddAttachment(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, contents, string(bts))
 }
+
+func TestItemService_AttachURLs(t *testing.T) {
+	png := []byte("\x89PNG\r\n\x1a\n" + fk.Str(100))
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/receipt.png":
+			w.Header().Set("Content-Type", "image/png")
+			_, _ = w.Write(png)
+		case "/notes.txt":
+			w.Header().Set("Content-Type", "text/plain")
+			_, _ = w.Write([]byte("not allowed"))
+		case "/large.png":
+			w.Header().Set("Content-Type", "image/png")
+			_, _ = w.Write(make([]byte, maxURLAttachmentSize+1))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	// the test server listens on loopback, which the default client refuses
+	svc := &ItemService{repo: tRepos, attachmentClient: srv.Client()}
+
+	loc, err := tRepos.Locations.Create(context.Background(), tGroup.ID, repo.LocationCreate{
+		Name: fk.Str(10),
+	})
+	require.NoError(t, err)
+
+	itm, err := svc.repo.Items.Create(context.Background(), tGroup.ID, repo.ItemCreate{
+		Name:       fk.Str(10),
+		LocationID: loc.ID,
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = svc.repo.Items.Delete(context.Background(), itm.ID)
+	})
+
+	attached := svc.attachURLs(context.Background(), tGroup.ID, itm.ID, []string{
+		srv.URL + "/receipt.png",
+		srv.URL + "/missing.png",
+		srv.URL + "/notes.txt",
+		srv.URL + "/large.png",
+	})
+	assert.Equal(t, 1, attached)
+
+	// only the valid download is attached
+	itm, err = svc.repo.Items.GetOne(context.Background(), itm.ID)
+	require.NoError(t, err)
+	require.Len(t, itm.Attachments, 1)
+	assert.Equal(t, "receipt.png", itm.Attachments[0].Document.Title)
+	assert.Equal(t, attachment.TypePhoto.String(), itm.Attachments[0].Type, "images are stored as photos")
+	assert.True(t, itm.Attachments[0].Primary)
+
+	bts, err := os.ReadFile(itm.Attachments[0].Document.Path)
+	require.NoError(t, err)
+	assert.Equal(t, png, bts)
+
+	// at most maxURLAttachments urls are downloaded
+	urls := make([]string, maxURLAttachments+2)
+	for i := range urls {
+		urls[i] = srv.URL + "/receipt.png"
+	}
+	assert.Equal(t, maxURLAttachments, svc.attachURLs(context.Background(), tGroup.ID, itm.ID, urls))
+}
+
+func TestItemService_Create_AttachmentURLs(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/pdf")
+		_, _ = w.Write([]byte("%PDF-1.4 " + fk.Str(100)))
+	}))
+	t.Cleanup(srv.Close)
+
+	svc := &ItemService{repo: tRepos, attachmentClient: srv.Client()}
+
+	loc, err := tRepos.Locations.Create(context.Background(), tGroup.ID, repo.LocationCreate{
+		Name: fk.Str(10),
+	})
+	require.NoError(t, err)
+
+	itm, err := svc.Create(Context{Context: context.Background(), GID: tGroup.ID}, repo.ItemCreate{
+		Name:           fk.Str(10),
+		LocationID:     loc.ID,
+		AttachmentURLs: []string{srv.URL + "/manual.pdf"},
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = svc.repo.Items.Delete(context.Background(), itm.ID)
+	})
+
+	require.Len(t, itm.Attachments, 1)
+	assert.Equal(t, "manual.pdf", itm.Attachments[0].Document.Title)
+	assert.Equal(t, attachment.TypeAttachment.String(), itm.Attachments[0].Type)
+}
+
+func TestItemService_AttachURL_RefusesPrivateAddresses(t *testing.T) {
+	requested := false
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requested = true
+		w.Header().Set("Content-Type", "image/png")
+		_, _ = w.Write([]byte("\x89PNG\r\n\x1a\n"))
+	}))
+	t.Cleanup(srv.Close)
+
+	svc := &ItemService{repo: tRepos}
+
+	err := svc.attachURL(context.Background(), tGroup.ID, uuid.New(), srv.URL+"/receipt.png")
+	require.ErrorIs(t, err, ErrAttachmentAddress)
+	assert.False(t, requested)
+
+	for _, addr := range []string{"127.0.0.1", "10.0.0.1", "192.168.1.1", "169.254.169.254", "::1", "fe80::1", "100.64.0.1"} {
+		assert.False(t, isPublicIP(net.ParseIP(addr)), addr)
+	}
+	assert.True(t, isPublicIP(net.ParseIP("93.184.216.34")))
+}
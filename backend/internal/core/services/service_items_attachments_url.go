@@ -0,0 +1,210 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/attachment"
+	"github.com/hay-kot/homebox/backend/internal/data/repo"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// maxURLAttachmentSize is the largest file that is downloaded when attaching a file by URL.
+	maxURLAttachmentSize = 10 << 20
+	// maxURLAttachments is the number of URLs attached to a single item, the rest are skipped.
+	maxURLAttachments = 5
+	// maxURLAttachmentRedirects is the number of redirects followed for a single download.
+	maxURLAttachmentRedirects = 3
+)
+
+var (
+	ErrAttachmentTooLarge    = fmt.Errorf("attachment is larger than %d bytes", maxURLAttachmentSize)
+	ErrAttachmentContentType = errors.New("attachment content type is not allowed")
+	ErrAttachmentURL         = errors.New("attachment url must be an http or https url")
+	ErrAttachmentAddress     = errors.New("attachment url must resolve to a public address")
+)
+
+// urlAttachmentTypes maps the content types that may be attached by URL to the extension
+// used when the URL doesn't provide a file name.
+var urlAttachmentTypes = map[string]string{
+	"image/jpeg":      ".jpg",
+	"image/png":       ".png",
+	"image/gif":       ".gif",
+	"image/webp":      ".webp",
+	"application/pdf": ".pdf",
+}
+
+// attachmentHTTPClient downloads the files attached by URL. The URLs come from clients and
+// imported files, so connections are only made to public addresses to keep the server from being
+// used to reach internal services. The check runs on the resolved address of every
+// connection, redirects included.
+var attachmentHTTPClient = &http.Client{
+	Timeout: 30 * time.Second,
+	Transport: &http.Transport{
+		Proxy: nil,
+		DialContext: (&net.Dialer{
+			Timeout: 10 * time.Second,
+			Control: publicAddressOnly,
+		}).DialContext,
+		TLSHandshakeTimeout:   10 * time.Second,
+		ResponseHeaderTimeout: 10 * time.Second,
+	},
+	CheckRedirect: func(req *http.Request, via []*http.Request) error {
+		if len(via) >= maxURLAttachmentRedirects {
+			return fmt.Errorf("stopped after %d redirects", maxURLAttachmentRedirects)
+		}
+
+		if req.URL.Scheme != "http" && req.URL.Scheme != "https" {
+			return ErrAttachmentURL
+		}
+
+		return nil
+	},
+}
+
+// publicAddressOnly is a net.Dialer Control func that refuses connections to loopback,
+// private, link-local and other non-public addresses.
+func publicAddressOnly(network, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+
+	ip := net.ParseIP(host)
+	if ip == nil || !isPublicIP(ip) {
+		return ErrAttachmentAddress
+	}
+
+	return nil
+}
+
+func isPublicIP(ip net.IP) bool {
+	return !(ip.IsLoopback() ||
+		ip.IsPrivate() ||
+		ip.IsUnspecified() ||
+		ip.IsLinkLocalUnicast() ||
+		ip.IsLinkLocalMulticast() ||
+		ip.IsInterfaceLocalMulticast() ||
+		ip.IsMulticast() ||
+		sharedAddressSpace.Contains(ip))
+}
+
+// sharedAddressSpace is the carrier-grade NAT range, which net.IP.IsPrivate doesn't cover.
+var sharedAddressSpace = &net.IPNet{IP: net.IP{100, 64, 0, 0}, Mask: net.CIDRMask(10, 32)}
+
+// attachURLs downloads the files and attaches them to the item. Downloads are best effort,
+// a file that can't be attached is logged and skipped so that the item is still created.
+// At most maxURLAttachments URLs are downloaded. It returns the number of attached files.
+func (svc *ItemService) attachURLs(ctx context.Context, GID, itemID uuid.UUID, urls []string) int {
+	attached := 0
+
+	if len(urls) > maxURLAttachments {
+		log.Warn().Int("count", len(urls)).Str("item", itemID.String()).Msg("too many attachment urls, skipping the rest")
+		urls = urls[:maxURLAttachments]
+	}
+
+	for _, u := range urls {
+		err := svc.attachURL(ctx, GID, itemID, u)
+		if err != nil {
+			log.Warn().Err(err).Str("url", u).Str("item", itemID.String()).Msg("failed to attach file from url")
+			continue
+		}
+
+		attached++
+	}
+
+	return attached
+}
+
+func (svc *ItemService) attachURL(ctx context.Context, GID, itemID uuid.UUID, rawURL string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") {
+		return ErrAttachmentURL
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return err
+	}
+
+	client := svc.attachmentClient
+	if client == nil {
+		client = attachmentHTTPClient
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status downloading attachment: %s", resp.Status)
+	}
+
+	contentType, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+	if err != nil {
+		return ErrAttachmentContentType
+	}
+
+	ext, ok := urlAttachmentTypes[contentType]
+	if !ok {
+		return ErrAttachmentContentType
+	}
+
+	if resp.ContentLength > maxURLAttachmentSize {
+		return ErrAttachmentTooLarge
+	}
+
+	// read one byte past the limit to detect bodies without a content length that are too large
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxURLAttachmentSize+1))
+	if err != nil {
+		return err
+	}
+
+	if len(body) > maxURLAttachmentSize {
+		return ErrAttachmentTooLarge
+	}
+
+	name := path.Base(u.Path)
+	if name == "." || name == "/" {
+		name = "attachment"
+	}
+
+	// keep the extension of the URL unless it doesn't match the content type
+	if typ, _, _ := mime.ParseMediaType(mime.TypeByExtension(path.Ext(name))); typ != contentType {
+		name += ext
+	}
+
+	doc, err := svc.repo.Docs.Create(ctx, GID, repo.DocumentCreate{Title: name, Content: bytes.NewReader(body)})
+	if err != nil {
+		return err
+	}
+
+	// images are stored as photos so that the first one becomes the primary photo
+	typ := attachment.TypeAttachment
+	if strings.HasPrefix(contentType, "image/") {
+		typ = attachment.TypePhoto
+	}
+
+	_, err = svc.repo.Attachments.Create(ctx, itemID, doc.ID, typ)
+	if err != nil {
+		_, _ = svc.repo.Docs.DeleteUnattached(ctx, doc.ID)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,117 @@
+package services
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hay-kot/homebox/backend/internal/core/services/reporting"
+	"github.com/hay-kot/homebox/backend/internal/data/repo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestItemService_CsvImport(t *testing.T) {
+	ctx := context.Background()
+
+	g, err := tRepos.Groups.GroupCreate(ctx, "csv-import")
+	require.NoError(t, err)
+
+	svc := &ItemService{repo: tRepos}
+
+	csvText := strings.Join([]string{
+		"HB.import_ref,HB.location,HB.labels,HB.name,HB.quantity",
+		"ref-1,Garage / Shelf,Tools; Power,Drill,1",
+		"ref-2,Garage,Tools,Hammer,2",
+		"ref-3,Garage,Tools",
+		"ref-4,Garage,Tools,,1",
+	}, "\n")
+
+	count, err := svc.CsvImport(ctx, g.ID, strings.NewReader(csvText))
+	assert.Equal(t, 2, count)
+
+	// malformed rows are reported without aborting the import
+	var importErr *reporting.ImportError
+	require.ErrorAs(t, err, &importErr)
+	require.Len(t, importErr.Rows, 2)
+	assert.Equal(t, 4, importErr.Rows[0].Line)
+	assert.Equal(t, 5, importErr.Rows[1].Line)
+
+	labels, err := tRepos.Labels.GetAll(ctx, g.ID)
+	require.NoError(t, err)
+	assert.Len(t, labels, 2)
+
+	locations, err := tRepos.Locations.GetAll(ctx, g.ID, repo.LocationQuery{})
+	require.NoError(t, err)
+	assert.Len(t, locations, 2)
+
+	drill, err := tRepos.Items.GetByRef(ctx, g.ID, "ref-1")
+	require.NoError(t, err)
+	assert.Equal(t, "Shelf", drill.Location.Name)
+	assert.Len(t, drill.Labels, 2)
+
+	notes := "bought at the hardware store"
+	err = tRepos.Items.Patch(ctx, g.ID, drill.ID, repo.ItemPatch{ID: drill.ID, Notes: &notes})
+	require.NoError(t, err)
+
+	// importing again updates the existing items
+	csvText = strings.Join([]string{
+		"HB.import_ref,HB.location,HB.labels,HB.name,HB.quantity",
+		"ref-1,Garage / Shelf,Tools; Power,Cordless Drill,1",
+		"ref-2,Garage,Tools,Hammer,5",
+	}, "\n")
+
+	count, err = svc.CsvImport(ctx, g.ID, strings.NewReader(csvText))
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+
+	items, err := tRepos.Items.GetAll(ctx, g.ID)
+	require.NoError(t, err)
+	assert.Len(t, items, 2)
+
+	drill, err = tRepos.Items.GetByRef(ctx, g.ID, "ref-1")
+	require.NoError(t, err)
+	assert.Equal(t, "Cordless Drill", drill.Name)
+	assert.Equal(t, notes, drill.Notes, "columns missing from the sheet are kept")
+	assert.Len(t, drill.Labels, 2)
+
+	hammer, err := tRepos.Items.GetByRef(ctx, g.ID, "ref-2")
+	require.NoError(t, err)
+	assert.Equal(t, 5, hammer.Quantity)
+
+	// items in the trash are reported instead of updated
+	require.NoError(t, tRepos.Items.DeleteByGroup(ctx, g.ID, hammer.ID))
+
+	count, err = svc.CsvImport(ctx, g.ID, strings.NewReader(csvText))
+	assert.Equal(t, 1, count)
+	require.ErrorAs(t, err, &importErr)
+	require.Len(t, importErr.Rows, 1)
+	assert.Equal(t, 3, importErr.Rows[0].Line)
+	require.ErrorIs(t, importErr.Rows[0].Err, repo.ErrItemInTrash)
+}
+
+func TestItemService_CreateMany_AssetIDs(t *testing.T) {
+	ctx := context.Background()
+
+	g, err := tRepos.Groups.GroupCreate(ctx, "create-many-asset-ids")
+	require.NoError(t, err)
+
+	loc, err := tRepos.Locations.Create(ctx, g.ID, repo.LocationCreate{Name: fk.Str(10)})
+	require.NoError(t, err)
+
+	svc := &ItemService{repo: tRepos, autoIncrementAssetID: true}
+
+	data := make([]repo.ItemCreate, 3)
+	for i := range data {
+		data[i] = repo.ItemCreate{Name: fk.Str(10), LocationID: loc.ID}
+	}
+
+	out, err := svc.CreateMany(Context{Context: ctx, GID: g.ID}, data)
+	require.NoError(t, err)
+	require.Len(t, out, 3)
+
+	for i, itm := range out {
+		assert.Equal(t, data[i].Name, itm.Name)
+		assert.Equal(t, repo.AssetID(i+1), itm.AssetID)
+	}
+}
@@ -79,6 +79,20 @@ func (ac *AttachmentCreate) SetNillablePrimary(b *bool) *AttachmentCreate {
 	return ac
 }
 
+// SetDate sets the "date" field.
+func (ac *AttachmentCreate) SetDate(t time.Time) *AttachmentCreate {
+	ac.mutation.SetDate(t)
+	return ac
+}
+
+// SetNillableDate sets the "date" field if the given value is not nil.
+func (ac *AttachmentCreate) SetNillableDate(t *time.Time) *AttachmentCreate {
+	if t != nil {
+		ac.SetDate(*t)
+	}
+	return ac
+}
+
 // SetID sets the "id" field.
 func (ac *AttachmentCreate) SetID(u uuid.UUID) *AttachmentCreate {
 	ac.mutation.SetID(u)
@@ -248,6 +262,10 @@ func (ac *AttachmentCreate) createSpec() (*Attachment, *sqlgraph.CreateSpec) {
 		_spec.SetField(attachment.FieldPrimary, field.TypeBool, value)
 		_node.Primary = value
 	}
+	if value, ok := ac.mutation.Date(); ok {
+		_spec.SetField(attachment.FieldDate, field.TypeTime, value)
+		_node.Date = value
+	}
 	if nodes := ac.mutation.ItemIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -65,6 +65,26 @@ func (au *AttachmentUpdate) SetNillablePrimary(b *bool) *AttachmentUpdate {
 	return au
 }
 
+// SetDate sets the "date" field.
+func (au *AttachmentUpdate) SetDate(t time.Time) *AttachmentUpdate {
+	au.mutation.SetDate(t)
+	return au
+}
+
+// SetNillableDate sets the "date" field if the given value is not nil.
+func (au *AttachmentUpdate) SetNillableDate(t *time.Time) *AttachmentUpdate {
+	if t != nil {
+		au.SetDate(*t)
+	}
+	return au
+}
+
+// ClearDate clears the value of the "date" field.
+func (au *AttachmentUpdate) ClearDate() *AttachmentUpdate {
+	au.mutation.ClearDate()
+	return au
+}
+
 // SetItemID sets the "item" edge to the Item entity by ID.
 func (au *AttachmentUpdate) SetItemID(id uuid.UUID) *AttachmentUpdate {
 	au.mutation.SetItemID(id)
@@ -177,6 +197,12 @@ func (au *AttachmentUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := au.mutation.Primary(); ok {
 		_spec.SetField(attachment.FieldPrimary, field.TypeBool, value)
 	}
+	if value, ok := au.mutation.Date(); ok {
+		_spec.SetField(attachment.FieldDate, field.TypeTime, value)
+	}
+	if au.mutation.DateCleared() {
+		_spec.ClearField(attachment.FieldDate, field.TypeTime)
+	}
 	if au.mutation.ItemCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -289,6 +315,26 @@ func (auo *AttachmentUpdateOne) SetNillablePrimary(b *bool) *AttachmentUpdateOne
 	return auo
 }
 
+// SetDate sets the "date" field.
+func (auo *AttachmentUpdateOne) SetDate(t time.Time) *AttachmentUpdateOne {
+	auo.mutation.SetDate(t)
+	return auo
+}
+
+// SetNillableDate sets the "date" field if the given value is not nil.
+func (auo *AttachmentUpdateOne) SetNillableDate(t *time.Time) *AttachmentUpdateOne {
+	if t != nil {
+		auo.SetDate(*t)
+	}
+	return auo
+}
+
+// ClearDate clears the value of the "date" field.
+func (auo *AttachmentUpdateOne) ClearDate() *AttachmentUpdateOne {
+	auo.mutation.ClearDate()
+	return auo
+}
+
 // SetItemID sets the "item" edge to the Item entity by ID.
 func (auo *AttachmentUpdateOne) SetItemID(id uuid.UUID) *AttachmentUpdateOne {
 	auo.mutation.SetItemID(id)
@@ -431,6 +477,12 @@ func (auo *AttachmentUpdateOne) sqlSave(ctx context.Context) (_node *Attachment,
 	if value, ok := auo.mutation.Primary(); ok {
 		_spec.SetField(attachment.FieldPrimary, field.TypeBool, value)
 	}
+	if value, ok := auo.mutation.Date(); ok {
+		_spec.SetField(attachment.FieldDate, field.TypeTime, value)
+	}
+	if auo.mutation.DateCleared() {
+		_spec.ClearField(attachment.FieldDate, field.TypeTime)
+	}
 	if auo.mutation.ItemCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
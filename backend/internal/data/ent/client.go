@@ -19,15 +19,20 @@ import (
 	"github.com/hay-kot/homebox/backend/internal/data/ent/attachment"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/authroles"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/authtokens"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/currencyconversion"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/document"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/group"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/groupinvitationtoken"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/item"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/itemcomment"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/itemevent"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/itemfield"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/itemtemplate"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/label"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/location"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/maintenanceentry"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/notifier"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/savedsearch"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/user"
 )
 
@@ -42,6 +47,8 @@ type Client struct {
 	AuthRoles *AuthRolesClient
 	// AuthTokens is the client for interacting with the AuthTokens builders.
 	AuthTokens *AuthTokensClient
+	// CurrencyConversion is the client for interacting with the CurrencyConversion builders.
+	CurrencyConversion *CurrencyConversionClient
 	// Document is the client for interacting with the Document builders.
 	Document *DocumentClient
 	// Group is the client for interacting with the Group builders.
@@ -50,8 +57,14 @@ type Client struct {
 	GroupInvitationToken *GroupInvitationTokenClient
 	// Item is the client for interacting with the Item builders.
 	Item *ItemClient
+	// ItemComment is the client for interacting with the ItemComment builders.
+	ItemComment *ItemCommentClient
+	// ItemEvent is the client for interacting with the ItemEvent builders.
+	ItemEvent *ItemEventClient
 	// ItemField is the client for interacting with the ItemField builders.
 	ItemField *ItemFieldClient
+	// ItemTemplate is the client for interacting with the ItemTemplate builders.
+	ItemTemplate *ItemTemplateClient
 	// Label is the client for interacting with the Label builders.
 	Label *LabelClient
 	// Location is the client for interacting with the Location builders.
@@ -60,6 +73,8 @@ type Client struct {
 	MaintenanceEntry *MaintenanceEntryClient
 	// Notifier is the client for interacting with the Notifier builders.
 	Notifier *NotifierClient
+	// SavedSearch is the client for interacting with the SavedSearch builders.
+	SavedSearch *SavedSearchClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
 }
@@ -76,15 +91,20 @@ func (c *Client) init() {
 	c.Attachment = NewAttachmentClient(c.config)
 	c.AuthRoles = NewAuthRolesClient(c.config)
 	c.AuthTokens = NewAuthTokensClient(c.config)
+	c.CurrencyConversion = NewCurrencyConversionClient(c.config)
 	c.Document = NewDocumentClient(c.config)
 	c.Group = NewGroupClient(c.config)
 	c.GroupInvitationToken = NewGroupInvitationTokenClient(c.config)
 	c.Item = NewItemClient(c.config)
+	c.ItemComment = NewItemCommentClient(c.config)
+	c.ItemEvent = NewItemEventClient(c.config)
 	c.ItemField = NewItemFieldClient(c.config)
+	c.ItemTemplate = NewItemTemplateClient(c.config)
 	c.Label = NewLabelClient(c.config)
 	c.Location = NewLocationClient(c.config)
 	c.MaintenanceEntry = NewMaintenanceEntryClient(c.config)
 	c.Notifier = NewNotifierClient(c.config)
+	c.SavedSearch = NewSavedSearchClient(c.config)
 	c.User = NewUserClient(c.config)
 }
 
@@ -181,15 +201,20 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 		Attachment:           NewAttachmentClient(cfg),
 		AuthRoles:            NewAuthRolesClient(cfg),
 		AuthTokens:           NewAuthTokensClient(cfg),
+		CurrencyConversion:   NewCurrencyConversionClient(cfg),
 		Document:             NewDocumentClient(cfg),
 		Group:                NewGroupClient(cfg),
 		GroupInvitationToken: NewGroupInvitationTokenClient(cfg),
 		Item:                 NewItemClient(cfg),
+		ItemComment:          NewItemCommentClient(cfg),
+		ItemEvent:            NewItemEventClient(cfg),
 		ItemField:            NewItemFieldClient(cfg),
+		ItemTemplate:         NewItemTemplateClient(cfg),
 		Label:                NewLabelClient(cfg),
 		Location:             NewLocationClient(cfg),
 		MaintenanceEntry:     NewMaintenanceEntryClient(cfg),
 		Notifier:             NewNotifierClient(cfg),
+		SavedSearch:          NewSavedSearchClient(cfg),
 		User:                 NewUserClient(cfg),
 	}, nil
 }
@@ -213,15 +238,20 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 		Attachment:           NewAttachmentClient(cfg),
 		AuthRoles:            NewAuthRolesClient(cfg),
 		AuthTokens:           NewAuthTokensClient(cfg),
+		CurrencyConversion:   NewCurrencyConversionClient(cfg),
 		Document:             NewDocumentClient(cfg),
 		Group:                NewGroupClient(cfg),
 		GroupInvitationToken: NewGroupInvitationTokenClient(cfg),
 		Item:                 NewItemClient(cfg),
+		ItemComment:          NewItemCommentClient(cfg),
+		ItemEvent:            NewItemEventClient(cfg),
 		ItemField:            NewItemFieldClient(cfg),
+		ItemTemplate:         NewItemTemplateClient(cfg),
 		Label:                NewLabelClient(cfg),
 		Location:             NewLocationClient(cfg),
 		MaintenanceEntry:     NewMaintenanceEntryClient(cfg),
 		Notifier:             NewNotifierClient(cfg),
+		SavedSearch:          NewSavedSearchClient(cfg),
 		User:                 NewUserClient(cfg),
 	}, nil
 }
@@ -252,9 +282,10 @@ func (c *Client) Close() error {
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
 	for _, n := range []interface{ Use(...Hook) }{
-		c.Attachment, c.AuthRoles, c.AuthTokens, c.Document, c.Group,
-		c.GroupInvitationToken, c.Item, c.ItemField, c.Label, c.Location,
-		c.MaintenanceEntry, c.Notifier, c.User,
+		c.Attachment, c.AuthRoles, c.AuthTokens, c.CurrencyConversion, c.Document,
+		c.Group, c.GroupInvitationToken, c.Item, c.ItemComment, c.ItemEvent,
+		c.ItemField, c.ItemTemplate, c.Label, c.Location, c.MaintenanceEntry,
+		c.Notifier, c.SavedSearch, c.User,
 	} {
 		n.Use(hooks...)
 	}
@@ -264,9 +295,10 @@ func (c *Client) Use(hooks ...Hook) {
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	for _, n := range []interface{ Intercept(...Interceptor) }{
-		c.Attachment, c.AuthRoles, c.AuthTokens, c.Document, c.Group,
-		c.GroupInvitationToken, c.Item, c.ItemField, c.Label, c.Location,
-		c.MaintenanceEntry, c.Notifier, c.User,
+		c.Attachment, c.AuthRoles, c.AuthTokens, c.CurrencyConversion, c.Document,
+		c.Group, c.GroupInvitationToken, c.Item, c.ItemComment, c.ItemEvent,
+		c.ItemField, c.ItemTemplate, c.Label, c.Location, c.MaintenanceEntry,
+		c.Notifier, c.SavedSearch, c.User,
 	} {
 		n.Intercept(interceptors...)
 	}
@@ -281,6 +313,8 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.AuthRoles.mutate(ctx, m)
 	case *AuthTokensMutation:
 		return c.AuthTokens.mutate(ctx, m)
+	case *CurrencyConversionMutation:
+		return c.CurrencyConversion.mutate(ctx, m)
 	case *DocumentMutation:
 		return c.Document.mutate(ctx, m)
 	case *GroupMutation:
@@ -289,8 +323,14 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.GroupInvitationToken.mutate(ctx, m)
 	case *ItemMutation:
 		return c.Item.mutate(ctx, m)
+	case *ItemCommentMutation:
+		return c.ItemComment.mutate(ctx, m)
+	case *ItemEventMutation:
+		return c.ItemEvent.mutate(ctx, m)
 	case *ItemFieldMutation:
 		return c.ItemField.mutate(ctx, m)
+	case *ItemTemplateMutation:
+		return c.ItemTemplate.mutate(ctx, m)
 	case *LabelMutation:
 		return c.Label.mutate(ctx, m)
 	case *LocationMutation:
@@ -299,6 +339,8 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.MaintenanceEntry.mutate(ctx, m)
 	case *NotifierMutation:
 		return c.Notifier.mutate(ctx, m)
+	case *SavedSearchMutation:
+		return c.SavedSearch.mutate(ctx, m)
 	case *UserMutation:
 		return c.User.mutate(ctx, m)
 	default:
@@ -785,6 +827,155 @@ func (c *AuthTokensClient) mutate(ctx context.Context, m *AuthTokensMutation) (V
 	}
 }
 
+// CurrencyConversionClient is a client for the CurrencyConversion schema.
+type CurrencyConversionClient struct {
+	config
+}
+
+// NewCurrencyConversionClient returns a client for the CurrencyConversion from the given config.
+func NewCurrencyConversionClient(c config) *CurrencyConversionClient {
+	return &CurrencyConversionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `currencyconversion.Hooks(f(g(h())))`.
+func (c *CurrencyConversionClient) Use(hooks ...Hook) {
+	c.hooks.CurrencyConversion = append(c.hooks.CurrencyConversion, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `currencyconversion.Intercept(f(g(h())))`.
+func (c *CurrencyConversionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.CurrencyConversion = append(c.inters.CurrencyConversion, interceptors...)
+}
+
+// Create returns a builder for creating a CurrencyConversion entity.
+func (c *CurrencyConversionClient) Create() *CurrencyConversionCreate {
+	mutation := newCurrencyConversionMutation(c.config, OpCreate)
+	return &CurrencyConversionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of CurrencyConversion entities.
+func (c *CurrencyConversionClient) CreateBulk(builders ...*CurrencyConversionCreate) *CurrencyConversionCreateBulk {
+	return &CurrencyConversionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *CurrencyConversionClient) MapCreateBulk(slice any, setFunc func(*CurrencyConversionCreate, int)) *CurrencyConversionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &CurrencyConversionCreateBulk{err: fmt.Errorf("calling to CurrencyConversionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*CurrencyConversionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &CurrencyConversionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for CurrencyConversion.
+func (c *CurrencyConversionClient) Update() *CurrencyConversionUpdate {
+	mutation := newCurrencyConversionMutation(c.config, OpUpdate)
+	return &CurrencyConversionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *CurrencyConversionClient) UpdateOne(cc *CurrencyConversion) *CurrencyConversionUpdateOne {
+	mutation := newCurrencyConversionMutation(c.config, OpUpdateOne, withCurrencyConversion(cc))
+	return &CurrencyConversionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *CurrencyConversionClient) UpdateOneID(id uuid.UUID) *CurrencyConversionUpdateOne {
+	mutation := newCurrencyConversionMutation(c.config, OpUpdateOne, withCurrencyConversionID(id))
+	return &CurrencyConversionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for CurrencyConversion.
+func (c *CurrencyConversionClient) Delete() *CurrencyConversionDelete {
+	mutation := newCurrencyConversionMutation(c.config, OpDelete)
+	return &CurrencyConversionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *CurrencyConversionClient) DeleteOne(cc *CurrencyConversion) *CurrencyConversionDeleteOne {
+	return c.DeleteOneID(cc.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *CurrencyConversionClient) DeleteOneID(id uuid.UUID) *CurrencyConversionDeleteOne {
+	builder := c.Delete().Where(currencyconversion.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &CurrencyConversionDeleteOne{builder}
+}
+
+// Query returns a query builder for CurrencyConversion.
+func (c *CurrencyConversionClient) Query() *CurrencyConversionQuery {
+	return &CurrencyConversionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeCurrencyConversion},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a CurrencyConversion entity by its id.
+func (c *CurrencyConversionClient) Get(ctx context.Context, id uuid.UUID) (*CurrencyConversion, error) {
+	return c.Query().Where(currencyconversion.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *CurrencyConversionClient) GetX(ctx context.Context, id uuid.UUID) *CurrencyConversion {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryGroup queries the group edge of a CurrencyConversion.
+func (c *CurrencyConversionClient) QueryGroup(cc *CurrencyConversion) *GroupQuery {
+	query := (&GroupClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := cc.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(currencyconversion.Table, currencyconversion.FieldID, id),
+			sqlgraph.To(group.Table, group.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, currencyconversion.GroupTable, currencyconversion.GroupColumn),
+		)
+		fromV = sqlgraph.Neighbors(cc.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *CurrencyConversionClient) Hooks() []Hook {
+	return c.hooks.CurrencyConversion
+}
+
+// Interceptors returns the client interceptors.
+func (c *CurrencyConversionClient) Interceptors() []Interceptor {
+	return c.inters.CurrencyConversion
+}
+
+func (c *CurrencyConversionClient) mutate(ctx context.Context, m *CurrencyConversionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&CurrencyConversionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&CurrencyConversionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&CurrencyConversionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&CurrencyConversionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown CurrencyConversion mutation op: %q", m.Op())
+	}
+}
+
 // DocumentClient is a client for the Document schema.
 type DocumentClient struct {
 	config
@@ -1170,6 +1361,86 @@ func (c *GroupClient) QueryNotifiers(gr *Group) *NotifierQuery {
 	return query
 }
 
+// QueryCurrencyConversions queries the currency_conversions edge of a Group.
+func (c *GroupClient) QueryCurrencyConversions(gr *Group) *CurrencyConversionQuery {
+	query := (&CurrencyConversionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := gr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(group.Table, group.FieldID, id),
+			sqlgraph.To(currencyconversion.Table, currencyconversion.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, group.CurrencyConversionsTable, group.CurrencyConversionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(gr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryItemEvents queries the item_events edge of a Group.
+func (c *GroupClient) QueryItemEvents(gr *Group) *ItemEventQuery {
+	query := (&ItemEventClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := gr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(group.Table, group.FieldID, id),
+			sqlgraph.To(itemevent.Table, itemevent.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, group.ItemEventsTable, group.ItemEventsColumn),
+		)
+		fromV = sqlgraph.Neighbors(gr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySavedSearches queries the saved_searches edge of a Group.
+func (c *GroupClient) QuerySavedSearches(gr *Group) *SavedSearchQuery {
+	query := (&SavedSearchClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := gr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(group.Table, group.FieldID, id),
+			sqlgraph.To(savedsearch.Table, savedsearch.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, group.SavedSearchesTable, group.SavedSearchesColumn),
+		)
+		fromV = sqlgraph.Neighbors(gr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryItemTemplates queries the item_templates edge of a Group.
+func (c *GroupClient) QueryItemTemplates(gr *Group) *ItemTemplateQuery {
+	query := (&ItemTemplateClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := gr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(group.Table, group.FieldID, id),
+			sqlgraph.To(itemtemplate.Table, itemtemplate.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, group.ItemTemplatesTable, group.ItemTemplatesColumn),
+		)
+		fromV = sqlgraph.Neighbors(gr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryDefaultLocation queries the default_location edge of a Group.
+func (c *GroupClient) QueryDefaultLocation(gr *Group) *LocationQuery {
+	query := (&LocationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := gr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(group.Table, group.FieldID, id),
+			sqlgraph.To(location.Table, location.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, group.DefaultLocationTable, group.DefaultLocationColumn),
+		)
+		fromV = sqlgraph.Neighbors(gr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *GroupClient) Hooks() []Hook {
 	return c.hooks.Group
@@ -1500,6 +1771,22 @@ func (c *ItemClient) QueryChildren(i *Item) *ItemQuery {
 	return query
 }
 
+// QueryRelated queries the related edge of a Item.
+func (c *ItemClient) QueryRelated(i *Item) *ItemQuery {
+	query := (&ItemClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := i.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(item.Table, item.FieldID, id),
+			sqlgraph.To(item.Table, item.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, item.RelatedTable, item.RelatedPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(i.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // QueryLabel queries the label edge of a Item.
 func (c *ItemClient) QueryLabel(i *Item) *LabelQuery {
 	query := (&LabelClient{config: c.config}).Query()
@@ -1516,92 +1803,439 @@ func (c *ItemClient) QueryLabel(i *Item) *LabelQuery {
 	return query
 }
 
-// QueryLocation queries the location edge of a Item.
-func (c *ItemClient) QueryLocation(i *Item) *LocationQuery {
-	query := (&LocationClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := i.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(item.Table, item.FieldID, id),
-			sqlgraph.To(location.Table, location.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, item.LocationTable, item.LocationColumn),
-		)
-		fromV = sqlgraph.Neighbors(i.driver.Dialect(), step)
-		return fromV, nil
+// QueryLocation queries the location edge of a Item.
+func (c *ItemClient) QueryLocation(i *Item) *LocationQuery {
+	query := (&LocationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := i.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(item.Table, item.FieldID, id),
+			sqlgraph.To(location.Table, location.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, item.LocationTable, item.LocationColumn),
+		)
+		fromV = sqlgraph.Neighbors(i.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryFields queries the fields edge of a Item.
+func (c *ItemClient) QueryFields(i *Item) *ItemFieldQuery {
+	query := (&ItemFieldClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := i.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(item.Table, item.FieldID, id),
+			sqlgraph.To(itemfield.Table, itemfield.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, item.FieldsTable, item.FieldsColumn),
+		)
+		fromV = sqlgraph.Neighbors(i.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryMaintenanceEntries queries the maintenance_entries edge of a Item.
+func (c *ItemClient) QueryMaintenanceEntries(i *Item) *MaintenanceEntryQuery {
+	query := (&MaintenanceEntryClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := i.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(item.Table, item.FieldID, id),
+			sqlgraph.To(maintenanceentry.Table, maintenanceentry.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, item.MaintenanceEntriesTable, item.MaintenanceEntriesColumn),
+		)
+		fromV = sqlgraph.Neighbors(i.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAttachments queries the attachments edge of a Item.
+func (c *ItemClient) QueryAttachments(i *Item) *AttachmentQuery {
+	query := (&AttachmentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := i.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(item.Table, item.FieldID, id),
+			sqlgraph.To(attachment.Table, attachment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, item.AttachmentsTable, item.AttachmentsColumn),
+		)
+		fromV = sqlgraph.Neighbors(i.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryComments queries the comments edge of a Item.
+func (c *ItemClient) QueryComments(i *Item) *ItemCommentQuery {
+	query := (&ItemCommentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := i.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(item.Table, item.FieldID, id),
+			sqlgraph.To(itemcomment.Table, itemcomment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, item.CommentsTable, item.CommentsColumn),
+		)
+		fromV = sqlgraph.Neighbors(i.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ItemClient) Hooks() []Hook {
+	hooks := c.hooks.Item
+	return append(hooks[:len(hooks):len(hooks)], item.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *ItemClient) Interceptors() []Interceptor {
+	return c.inters.Item
+}
+
+func (c *ItemClient) mutate(ctx context.Context, m *ItemMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ItemCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ItemUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ItemDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Item mutation op: %q", m.Op())
+	}
+}
+
+// ItemCommentClient is a client for the ItemComment schema.
+type ItemCommentClient struct {
+	config
+}
+
+// NewItemCommentClient returns a client for the ItemComment from the given config.
+func NewItemCommentClient(c config) *ItemCommentClient {
+	return &ItemCommentClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `itemcomment.Hooks(f(g(h())))`.
+func (c *ItemCommentClient) Use(hooks ...Hook) {
+	c.hooks.ItemComment = append(c.hooks.ItemComment, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `itemcomment.Intercept(f(g(h())))`.
+func (c *ItemCommentClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ItemComment = append(c.inters.ItemComment, interceptors...)
+}
+
+// Create returns a builder for creating a ItemComment entity.
+func (c *ItemCommentClient) Create() *ItemCommentCreate {
+	mutation := newItemCommentMutation(c.config, OpCreate)
+	return &ItemCommentCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ItemComment entities.
+func (c *ItemCommentClient) CreateBulk(builders ...*ItemCommentCreate) *ItemCommentCreateBulk {
+	return &ItemCommentCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ItemCommentClient) MapCreateBulk(slice any, setFunc func(*ItemCommentCreate, int)) *ItemCommentCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ItemCommentCreateBulk{err: fmt.Errorf("calling to ItemCommentClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ItemCommentCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ItemCommentCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ItemComment.
+func (c *ItemCommentClient) Update() *ItemCommentUpdate {
+	mutation := newItemCommentMutation(c.config, OpUpdate)
+	return &ItemCommentUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ItemCommentClient) UpdateOne(ic *ItemComment) *ItemCommentUpdateOne {
+	mutation := newItemCommentMutation(c.config, OpUpdateOne, withItemComment(ic))
+	return &ItemCommentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ItemCommentClient) UpdateOneID(id uuid.UUID) *ItemCommentUpdateOne {
+	mutation := newItemCommentMutation(c.config, OpUpdateOne, withItemCommentID(id))
+	return &ItemCommentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ItemComment.
+func (c *ItemCommentClient) Delete() *ItemCommentDelete {
+	mutation := newItemCommentMutation(c.config, OpDelete)
+	return &ItemCommentDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ItemCommentClient) DeleteOne(ic *ItemComment) *ItemCommentDeleteOne {
+	return c.DeleteOneID(ic.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ItemCommentClient) DeleteOneID(id uuid.UUID) *ItemCommentDeleteOne {
+	builder := c.Delete().Where(itemcomment.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ItemCommentDeleteOne{builder}
+}
+
+// Query returns a query builder for ItemComment.
+func (c *ItemCommentClient) Query() *ItemCommentQuery {
+	return &ItemCommentQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeItemComment},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ItemComment entity by its id.
+func (c *ItemCommentClient) Get(ctx context.Context, id uuid.UUID) (*ItemComment, error) {
+	return c.Query().Where(itemcomment.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ItemCommentClient) GetX(ctx context.Context, id uuid.UUID) *ItemComment {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryItem queries the item edge of a ItemComment.
+func (c *ItemCommentClient) QueryItem(ic *ItemComment) *ItemQuery {
+	query := (&ItemClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ic.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(itemcomment.Table, itemcomment.FieldID, id),
+			sqlgraph.To(item.Table, item.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, itemcomment.ItemTable, itemcomment.ItemColumn),
+		)
+		fromV = sqlgraph.Neighbors(ic.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAuthor queries the author edge of a ItemComment.
+func (c *ItemCommentClient) QueryAuthor(ic *ItemComment) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ic.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(itemcomment.Table, itemcomment.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, itemcomment.AuthorTable, itemcomment.AuthorColumn),
+		)
+		fromV = sqlgraph.Neighbors(ic.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ItemCommentClient) Hooks() []Hook {
+	return c.hooks.ItemComment
+}
+
+// Interceptors returns the client interceptors.
+func (c *ItemCommentClient) Interceptors() []Interceptor {
+	return c.inters.ItemComment
+}
+
+func (c *ItemCommentClient) mutate(ctx context.Context, m *ItemCommentMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ItemCommentCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ItemCommentUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ItemCommentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ItemCommentDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ItemComment mutation op: %q", m.Op())
+	}
+}
+
+// ItemEventClient is a client for the ItemEvent schema.
+type ItemEventClient struct {
+	config
+}
+
+// NewItemEventClient returns a client for the ItemEvent from the given config.
+func NewItemEventClient(c config) *ItemEventClient {
+	return &ItemEventClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `itemevent.Hooks(f(g(h())))`.
+func (c *ItemEventClient) Use(hooks ...Hook) {
+	c.hooks.ItemEvent = append(c.hooks.ItemEvent, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `itemevent.Intercept(f(g(h())))`.
+func (c *ItemEventClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ItemEvent = append(c.inters.ItemEvent, interceptors...)
+}
+
+// Create returns a builder for creating a ItemEvent entity.
+func (c *ItemEventClient) Create() *ItemEventCreate {
+	mutation := newItemEventMutation(c.config, OpCreate)
+	return &ItemEventCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ItemEvent entities.
+func (c *ItemEventClient) CreateBulk(builders ...*ItemEventCreate) *ItemEventCreateBulk {
+	return &ItemEventCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ItemEventClient) MapCreateBulk(slice any, setFunc func(*ItemEventCreate, int)) *ItemEventCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ItemEventCreateBulk{err: fmt.Errorf("calling to ItemEventClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ItemEventCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ItemEventCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ItemEvent.
+func (c *ItemEventClient) Update() *ItemEventUpdate {
+	mutation := newItemEventMutation(c.config, OpUpdate)
+	return &ItemEventUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ItemEventClient) UpdateOne(ie *ItemEvent) *ItemEventUpdateOne {
+	mutation := newItemEventMutation(c.config, OpUpdateOne, withItemEvent(ie))
+	return &ItemEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ItemEventClient) UpdateOneID(id uuid.UUID) *ItemEventUpdateOne {
+	mutation := newItemEventMutation(c.config, OpUpdateOne, withItemEventID(id))
+	return &ItemEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ItemEvent.
+func (c *ItemEventClient) Delete() *ItemEventDelete {
+	mutation := newItemEventMutation(c.config, OpDelete)
+	return &ItemEventDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ItemEventClient) DeleteOne(ie *ItemEvent) *ItemEventDeleteOne {
+	return c.DeleteOneID(ie.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ItemEventClient) DeleteOneID(id uuid.UUID) *ItemEventDeleteOne {
+	builder := c.Delete().Where(itemevent.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ItemEventDeleteOne{builder}
+}
+
+// Query returns a query builder for ItemEvent.
+func (c *ItemEventClient) Query() *ItemEventQuery {
+	return &ItemEventQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeItemEvent},
+		inters: c.Interceptors(),
 	}
-	return query
 }
 
-// QueryFields queries the fields edge of a Item.
-func (c *ItemClient) QueryFields(i *Item) *ItemFieldQuery {
-	query := (&ItemFieldClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := i.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(item.Table, item.FieldID, id),
-			sqlgraph.To(itemfield.Table, itemfield.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, item.FieldsTable, item.FieldsColumn),
-		)
-		fromV = sqlgraph.Neighbors(i.driver.Dialect(), step)
-		return fromV, nil
+// Get returns a ItemEvent entity by its id.
+func (c *ItemEventClient) Get(ctx context.Context, id uuid.UUID) (*ItemEvent, error) {
+	return c.Query().Where(itemevent.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ItemEventClient) GetX(ctx context.Context, id uuid.UUID) *ItemEvent {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
 	}
-	return query
+	return obj
 }
 
-// QueryMaintenanceEntries queries the maintenance_entries edge of a Item.
-func (c *ItemClient) QueryMaintenanceEntries(i *Item) *MaintenanceEntryQuery {
-	query := (&MaintenanceEntryClient{config: c.config}).Query()
+// QueryGroup queries the group edge of a ItemEvent.
+func (c *ItemEventClient) QueryGroup(ie *ItemEvent) *GroupQuery {
+	query := (&GroupClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := i.ID
+		id := ie.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(item.Table, item.FieldID, id),
-			sqlgraph.To(maintenanceentry.Table, maintenanceentry.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, item.MaintenanceEntriesTable, item.MaintenanceEntriesColumn),
+			sqlgraph.From(itemevent.Table, itemevent.FieldID, id),
+			sqlgraph.To(group.Table, group.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, itemevent.GroupTable, itemevent.GroupColumn),
 		)
-		fromV = sqlgraph.Neighbors(i.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(ie.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
-// QueryAttachments queries the attachments edge of a Item.
-func (c *ItemClient) QueryAttachments(i *Item) *AttachmentQuery {
-	query := (&AttachmentClient{config: c.config}).Query()
+// QueryUser queries the user edge of a ItemEvent.
+func (c *ItemEventClient) QueryUser(ie *ItemEvent) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := i.ID
+		id := ie.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(item.Table, item.FieldID, id),
-			sqlgraph.To(attachment.Table, attachment.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, item.AttachmentsTable, item.AttachmentsColumn),
+			sqlgraph.From(itemevent.Table, itemevent.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, itemevent.UserTable, itemevent.UserColumn),
 		)
-		fromV = sqlgraph.Neighbors(i.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(ie.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *ItemClient) Hooks() []Hook {
-	return c.hooks.Item
+func (c *ItemEventClient) Hooks() []Hook {
+	return c.hooks.ItemEvent
 }
 
 // Interceptors returns the client interceptors.
-func (c *ItemClient) Interceptors() []Interceptor {
-	return c.inters.Item
+func (c *ItemEventClient) Interceptors() []Interceptor {
+	return c.inters.ItemEvent
 }
 
-func (c *ItemClient) mutate(ctx context.Context, m *ItemMutation) (Value, error) {
+func (c *ItemEventClient) mutate(ctx context.Context, m *ItemEventMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&ItemCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ItemEventCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&ItemUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ItemEventUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&ItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ItemEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&ItemDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&ItemEventDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Item mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown ItemEvent mutation op: %q", m.Op())
 	}
 }
 
@@ -1754,6 +2388,155 @@ func (c *ItemFieldClient) mutate(ctx context.Context, m *ItemFieldMutation) (Val
 	}
 }
 
+// ItemTemplateClient is a client for the ItemTemplate schema.
+type ItemTemplateClient struct {
+	config
+}
+
+// NewItemTemplateClient returns a client for the ItemTemplate from the given config.
+func NewItemTemplateClient(c config) *ItemTemplateClient {
+	return &ItemTemplateClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `itemtemplate.Hooks(f(g(h())))`.
+func (c *ItemTemplateClient) Use(hooks ...Hook) {
+	c.hooks.ItemTemplate = append(c.hooks.ItemTemplate, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `itemtemplate.Intercept(f(g(h())))`.
+func (c *ItemTemplateClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ItemTemplate = append(c.inters.ItemTemplate, interceptors...)
+}
+
+// Create returns a builder for creating a ItemTemplate entity.
+func (c *ItemTemplateClient) Create() *ItemTemplateCreate {
+	mutation := newItemTemplateMutation(c.config, OpCreate)
+	return &ItemTemplateCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ItemTemplate entities.
+func (c *ItemTemplateClient) CreateBulk(builders ...*ItemTemplateCreate) *ItemTemplateCreateBulk {
+	return &ItemTemplateCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ItemTemplateClient) MapCreateBulk(slice any, setFunc func(*ItemTemplateCreate, int)) *ItemTemplateCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ItemTemplateCreateBulk{err: fmt.Errorf("calling to ItemTemplateClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ItemTemplateCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ItemTemplateCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ItemTemplate.
+func (c *ItemTemplateClient) Update() *ItemTemplateUpdate {
+	mutation := newItemTemplateMutation(c.config, OpUpdate)
+	return &ItemTemplateUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ItemTemplateClient) UpdateOne(it *ItemTemplate) *ItemTemplateUpdateOne {
+	mutation := newItemTemplateMutation(c.config, OpUpdateOne, withItemTemplate(it))
+	return &ItemTemplateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ItemTemplateClient) UpdateOneID(id uuid.UUID) *ItemTemplateUpdateOne {
+	mutation := newItemTemplateMutation(c.config, OpUpdateOne, withItemTemplateID(id))
+	return &ItemTemplateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ItemTemplate.
+func (c *ItemTemplateClient) Delete() *ItemTemplateDelete {
+	mutation := newItemTemplateMutation(c.config, OpDelete)
+	return &ItemTemplateDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ItemTemplateClient) DeleteOne(it *ItemTemplate) *ItemTemplateDeleteOne {
+	return c.DeleteOneID(it.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ItemTemplateClient) DeleteOneID(id uuid.UUID) *ItemTemplateDeleteOne {
+	builder := c.Delete().Where(itemtemplate.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ItemTemplateDeleteOne{builder}
+}
+
+// Query returns a query builder for ItemTemplate.
+func (c *ItemTemplateClient) Query() *ItemTemplateQuery {
+	return &ItemTemplateQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeItemTemplate},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ItemTemplate entity by its id.
+func (c *ItemTemplateClient) Get(ctx context.Context, id uuid.UUID) (*ItemTemplate, error) {
+	return c.Query().Where(itemtemplate.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ItemTemplateClient) GetX(ctx context.Context, id uuid.UUID) *ItemTemplate {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryGroup queries the group edge of a ItemTemplate.
+func (c *ItemTemplateClient) QueryGroup(it *ItemTemplate) *GroupQuery {
+	query := (&GroupClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := it.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(itemtemplate.Table, itemtemplate.FieldID, id),
+			sqlgraph.To(group.Table, group.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, itemtemplate.GroupTable, itemtemplate.GroupColumn),
+		)
+		fromV = sqlgraph.Neighbors(it.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ItemTemplateClient) Hooks() []Hook {
+	return c.hooks.ItemTemplate
+}
+
+// Interceptors returns the client interceptors.
+func (c *ItemTemplateClient) Interceptors() []Interceptor {
+	return c.inters.ItemTemplate
+}
+
+func (c *ItemTemplateClient) mutate(ctx context.Context, m *ItemTemplateMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ItemTemplateCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ItemTemplateUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ItemTemplateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ItemTemplateDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ItemTemplate mutation op: %q", m.Op())
+	}
+}
+
 // LabelClient is a client for the Label schema.
 type LabelClient struct {
 	config
@@ -2430,6 +3213,155 @@ func (c *NotifierClient) mutate(ctx context.Context, m *NotifierMutation) (Value
 	}
 }
 
+// SavedSearchClient is a client for the SavedSearch schema.
+type SavedSearchClient struct {
+	config
+}
+
+// NewSavedSearchClient returns a client for the SavedSearch from the given config.
+func NewSavedSearchClient(c config) *SavedSearchClient {
+	return &SavedSearchClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `savedsearch.Hooks(f(g(h())))`.
+func (c *SavedSearchClient) Use(hooks ...Hook) {
+	c.hooks.SavedSearch = append(c.hooks.SavedSearch, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `savedsearch.Intercept(f(g(h())))`.
+func (c *SavedSearchClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SavedSearch = append(c.inters.SavedSearch, interceptors...)
+}
+
+// Create returns a builder for creating a SavedSearch entity.
+func (c *SavedSearchClient) Create() *SavedSearchCreate {
+	mutation := newSavedSearchMutation(c.config, OpCreate)
+	return &SavedSearchCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of SavedSearch entities.
+func (c *SavedSearchClient) CreateBulk(builders ...*SavedSearchCreate) *SavedSearchCreateBulk {
+	return &SavedSearchCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SavedSearchClient) MapCreateBulk(slice any, setFunc func(*SavedSearchCreate, int)) *SavedSearchCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SavedSearchCreateBulk{err: fmt.Errorf("calling to SavedSearchClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SavedSearchCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SavedSearchCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for SavedSearch.
+func (c *SavedSearchClient) Update() *SavedSearchUpdate {
+	mutation := newSavedSearchMutation(c.config, OpUpdate)
+	return &SavedSearchUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SavedSearchClient) UpdateOne(ss *SavedSearch) *SavedSearchUpdateOne {
+	mutation := newSavedSearchMutation(c.config, OpUpdateOne, withSavedSearch(ss))
+	return &SavedSearchUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SavedSearchClient) UpdateOneID(id uuid.UUID) *SavedSearchUpdateOne {
+	mutation := newSavedSearchMutation(c.config, OpUpdateOne, withSavedSearchID(id))
+	return &SavedSearchUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for SavedSearch.
+func (c *SavedSearchClient) Delete() *SavedSearchDelete {
+	mutation := newSavedSearchMutation(c.config, OpDelete)
+	return &SavedSearchDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SavedSearchClient) DeleteOne(ss *SavedSearch) *SavedSearchDeleteOne {
+	return c.DeleteOneID(ss.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SavedSearchClient) DeleteOneID(id uuid.UUID) *SavedSearchDeleteOne {
+	builder := c.Delete().Where(savedsearch.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SavedSearchDeleteOne{builder}
+}
+
+// Query returns a query builder for SavedSearch.
+func (c *SavedSearchClient) Query() *SavedSearchQuery {
+	return &SavedSearchQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSavedSearch},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a SavedSearch entity by its id.
+func (c *SavedSearchClient) Get(ctx context.Context, id uuid.UUID) (*SavedSearch, error) {
+	return c.Query().Where(savedsearch.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SavedSearchClient) GetX(ctx context.Context, id uuid.UUID) *SavedSearch {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryGroup queries the group edge of a SavedSearch.
+func (c *SavedSearchClient) QueryGroup(ss *SavedSearch) *GroupQuery {
+	query := (&GroupClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ss.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(savedsearch.Table, savedsearch.FieldID, id),
+			sqlgraph.To(group.Table, group.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, savedsearch.GroupTable, savedsearch.GroupColumn),
+		)
+		fromV = sqlgraph.Neighbors(ss.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *SavedSearchClient) Hooks() []Hook {
+	return c.hooks.SavedSearch
+}
+
+// Interceptors returns the client interceptors.
+func (c *SavedSearchClient) Interceptors() []Interceptor {
+	return c.inters.SavedSearch
+}
+
+func (c *SavedSearchClient) mutate(ctx context.Context, m *SavedSearchMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SavedSearchCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SavedSearchUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SavedSearchUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SavedSearchDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown SavedSearch mutation op: %q", m.Op())
+	}
+}
+
 // UserClient is a client for the User schema.
 type UserClient struct {
 	config
@@ -2586,6 +3518,38 @@ func (c *UserClient) QueryNotifiers(u *User) *NotifierQuery {
 	return query
 }
 
+// QueryItemComments queries the item_comments edge of a User.
+func (c *UserClient) QueryItemComments(u *User) *ItemCommentQuery {
+	query := (&ItemCommentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(itemcomment.Table, itemcomment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.ItemCommentsTable, user.ItemCommentsColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryItemEvents queries the item_events edge of a User.
+func (c *UserClient) QueryItemEvents(u *User) *ItemEventQuery {
+	query := (&ItemEventClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(itemevent.Table, itemevent.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.ItemEventsTable, user.ItemEventsColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *UserClient) Hooks() []Hook {
 	return c.hooks.User
@@ -2614,11 +3578,14 @@ func (c *UserClient) mutate(ctx context.Context, m *UserMutation) (Value, error)
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		Attachment, AuthRoles, AuthTokens, Document, Group, GroupInvitationToken, Item,
-		ItemField, Label, Location, MaintenanceEntry, Notifier, User []ent.Hook
+		Attachment, AuthRoles, AuthTokens, CurrencyConversion, Document, Group,
+		GroupInvitationToken, Item, ItemComment, ItemEvent, ItemField, ItemTemplate,
+		Label, Location, MaintenanceEntry, Notifier, SavedSearch, User []ent.Hook
 	}
 	inters struct {
-		Attachment, AuthRoles, AuthTokens, Document, Group, GroupInvitationToken, Item,
-		ItemField, Label, Location, MaintenanceEntry, Notifier, User []ent.Interceptor
+		Attachment, AuthRoles, AuthTokens, CurrencyConversion, Document, Group,
+		GroupInvitationToken, Item, ItemComment, ItemEvent, ItemField, ItemTemplate,
+		Label, Location, MaintenanceEntry, Notifier, SavedSearch,
+		User []ent.Interceptor
 	}
 )
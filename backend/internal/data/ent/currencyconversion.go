@@ -0,0 +1,211 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/currencyconversion"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/group"
+	"github.com/hay-kot/homebox/backend/internal/data/types"
+)
+
+// CurrencyConversion is the model entity for the CurrencyConversion schema.
+type CurrencyConversion struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// FromCurrency holds the value of the "from_currency" field.
+	FromCurrency string `json:"from_currency,omitempty"`
+	// ToCurrency holds the value of the "to_currency" field.
+	ToCurrency string `json:"to_currency,omitempty"`
+	// Rate holds the value of the "rate" field.
+	Rate float64 `json:"rate,omitempty"`
+	// OriginalPrices holds the value of the "original_prices" field.
+	OriginalPrices []types.PriceSnapshot `json:"original_prices,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the CurrencyConversionQuery when eager-loading is set.
+	Edges                      CurrencyConversionEdges `json:"edges"`
+	group_currency_conversions *uuid.UUID
+	selectValues               sql.SelectValues
+}
+
+// CurrencyConversionEdges holds the relations/edges for other nodes in the graph.
+type CurrencyConversionEdges struct {
+	// Group holds the value of the group edge.
+	Group *Group `json:"group,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// GroupOrErr returns the Group value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CurrencyConversionEdges) GroupOrErr() (*Group, error) {
+	if e.loadedTypes[0] {
+		if e.Group == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: group.Label}
+		}
+		return e.Group, nil
+	}
+	return nil, &NotLoadedError{edge: "group"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*CurrencyConversion) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case currencyconversion.FieldOriginalPrices:
+			values[i] = new([]byte)
+		case currencyconversion.FieldRate:
+			values[i] = new(sql.NullFloat64)
+		case currencyconversion.FieldFromCurrency, currencyconversion.FieldToCurrency:
+			values[i] = new(sql.NullString)
+		case currencyconversion.FieldCreatedAt, currencyconversion.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case currencyconversion.FieldID:
+			values[i] = new(uuid.UUID)
+		case currencyconversion.ForeignKeys[0]: // group_currency_conversions
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the CurrencyConversion fields.
+func (cc *CurrencyConversion) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case currencyconversion.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				cc.ID = *value
+			}
+		case currencyconversion.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				cc.CreatedAt = value.Time
+			}
+		case currencyconversion.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				cc.UpdatedAt = value.Time
+			}
+		case currencyconversion.FieldFromCurrency:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field from_currency", values[i])
+			} else if value.Valid {
+				cc.FromCurrency = value.String
+			}
+		case currencyconversion.FieldToCurrency:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field to_currency", values[i])
+			} else if value.Valid {
+				cc.ToCurrency = value.String
+			}
+		case currencyconversion.FieldRate:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field rate", values[i])
+			} else if value.Valid {
+				cc.Rate = value.Float64
+			}
+		case currencyconversion.FieldOriginalPrices:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field original_prices", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &cc.OriginalPrices); err != nil {
+					return fmt.Errorf("unmarshal field original_prices: %w", err)
+				}
+			}
+		case currencyconversion.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field group_currency_conversions", values[i])
+			} else if value.Valid {
+				cc.group_currency_conversions = new(uuid.UUID)
+				*cc.group_currency_conversions = *value.S.(*uuid.UUID)
+			}
+		default:
+			cc.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the CurrencyConversion.
+// This includes values selected through modifiers, order, etc.
+func (cc *CurrencyConversion) Value(name string) (ent.Value, error) {
+	return cc.selectValues.Get(name)
+}
+
+// QueryGroup queries the "group" edge of the CurrencyConversion entity.
+func (cc *CurrencyConversion) QueryGroup() *GroupQuery {
+	return NewCurrencyConversionClient(cc.config).QueryGroup(cc)
+}
+
+// Update returns a builder for updating this CurrencyConversion.
+// Note that you need to call CurrencyConversion.Unwrap() before calling this method if this CurrencyConversion
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (cc *CurrencyConversion) Update() *CurrencyConversionUpdateOne {
+	return NewCurrencyConversionClient(cc.config).UpdateOne(cc)
+}
+
+// Unwrap unwraps the CurrencyConversion entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (cc *CurrencyConversion) Unwrap() *CurrencyConversion {
+	_tx, ok := cc.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: CurrencyConversion is not a transactional entity")
+	}
+	cc.config.driver = _tx.drv
+	return cc
+}
+
+// String implements the fmt.Stringer.
+func (cc *CurrencyConversion) String() string {
+	var builder strings.Builder
+	builder.WriteString("CurrencyConversion(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", cc.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(cc.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(cc.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("from_currency=")
+	builder.WriteString(cc.FromCurrency)
+	builder.WriteString(", ")
+	builder.WriteString("to_currency=")
+	builder.WriteString(cc.ToCurrency)
+	builder.WriteString(", ")
+	builder.WriteString("rate=")
+	builder.WriteString(fmt.Sprintf("%v", cc.Rate))
+	builder.WriteString(", ")
+	builder.WriteString("original_prices=")
+	builder.WriteString(fmt.Sprintf("%v", cc.OriginalPrices))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// CurrencyConversions is a parsable slice of CurrencyConversion.
+type CurrencyConversions []*CurrencyConversion
@@ -0,0 +1,135 @@
+// Code generated by ent, DO NOT EDIT.
+
+package currencyconversion
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the currencyconversion type in the database.
+	Label = "currency_conversion"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldFromCurrency holds the string denoting the from_currency field in the database.
+	FieldFromCurrency = "from_currency"
+	// FieldToCurrency holds the string denoting the to_currency field in the database.
+	FieldToCurrency = "to_currency"
+	// FieldRate holds the string denoting the rate field in the database.
+	FieldRate = "rate"
+	// FieldOriginalPrices holds the string denoting the original_prices field in the database.
+	FieldOriginalPrices = "original_prices"
+	// EdgeGroup holds the string denoting the group edge name in mutations.
+	EdgeGroup = "group"
+	// Table holds the table name of the currencyconversion in the database.
+	Table = "currency_conversions"
+	// GroupTable is the table that holds the group relation/edge.
+	GroupTable = "currency_conversions"
+	// GroupInverseTable is the table name for the Group entity.
+	// It exists in this package in order to avoid circular dependency with the "group" package.
+	GroupInverseTable = "groups"
+	// GroupColumn is the table column denoting the group relation/edge.
+	GroupColumn = "group_currency_conversions"
+)
+
+// Columns holds all SQL columns for currencyconversion fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldFromCurrency,
+	FieldToCurrency,
+	FieldRate,
+	FieldOriginalPrices,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "currency_conversions"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"group_currency_conversions",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// FromCurrencyValidator is a validator for the "from_currency" field. It is called by the builders before save.
+	FromCurrencyValidator func(string) error
+	// ToCurrencyValidator is a validator for the "to_currency" field. It is called by the builders before save.
+	ToCurrencyValidator func(string) error
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the CurrencyConversion queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByFromCurrency orders the results by the from_currency field.
+func ByFromCurrency(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFromCurrency, opts...).ToFunc()
+}
+
+// ByToCurrency orders the results by the to_currency field.
+func ByToCurrency(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldToCurrency, opts...).ToFunc()
+}
+
+// ByRate orders the results by the rate field.
+func ByRate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRate, opts...).ToFunc()
+}
+
+// ByGroupField orders the results by group field.
+func ByGroupField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newGroupStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newGroupStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(GroupInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, GroupTable, GroupColumn),
+	)
+}
@@ -0,0 +1,346 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/currencyconversion"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/group"
+	"github.com/hay-kot/homebox/backend/internal/data/types"
+)
+
+// CurrencyConversionCreate is the builder for creating a CurrencyConversion entity.
+type CurrencyConversionCreate struct {
+	config
+	mutation *CurrencyConversionMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (ccc *CurrencyConversionCreate) SetCreatedAt(t time.Time) *CurrencyConversionCreate {
+	ccc.mutation.SetCreatedAt(t)
+	return ccc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (ccc *CurrencyConversionCreate) SetNillableCreatedAt(t *time.Time) *CurrencyConversionCreate {
+	if t != nil {
+		ccc.SetCreatedAt(*t)
+	}
+	return ccc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ccc *CurrencyConversionCreate) SetUpdatedAt(t time.Time) *CurrencyConversionCreate {
+	ccc.mutation.SetUpdatedAt(t)
+	return ccc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (ccc *CurrencyConversionCreate) SetNillableUpdatedAt(t *time.Time) *CurrencyConversionCreate {
+	if t != nil {
+		ccc.SetUpdatedAt(*t)
+	}
+	return ccc
+}
+
+// SetFromCurrency sets the "from_currency" field.
+func (ccc *CurrencyConversionCreate) SetFromCurrency(s string) *CurrencyConversionCreate {
+	ccc.mutation.SetFromCurrency(s)
+	return ccc
+}
+
+// SetToCurrency sets the "to_currency" field.
+func (ccc *CurrencyConversionCreate) SetToCurrency(s string) *CurrencyConversionCreate {
+	ccc.mutation.SetToCurrency(s)
+	return ccc
+}
+
+// SetRate sets the "rate" field.
+func (ccc *CurrencyConversionCreate) SetRate(f float64) *CurrencyConversionCreate {
+	ccc.mutation.SetRate(f)
+	return ccc
+}
+
+// SetOriginalPrices sets the "original_prices" field.
+func (ccc *CurrencyConversionCreate) SetOriginalPrices(ts []types.PriceSnapshot) *CurrencyConversionCreate {
+	ccc.mutation.SetOriginalPrices(ts)
+	return ccc
+}
+
+// SetID sets the "id" field.
+func (ccc *CurrencyConversionCreate) SetID(u uuid.UUID) *CurrencyConversionCreate {
+	ccc.mutation.SetID(u)
+	return ccc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (ccc *CurrencyConversionCreate) SetNillableID(u *uuid.UUID) *CurrencyConversionCreate {
+	if u != nil {
+		ccc.SetID(*u)
+	}
+	return ccc
+}
+
+// SetGroupID sets the "group" edge to the Group entity by ID.
+func (ccc *CurrencyConversionCreate) SetGroupID(id uuid.UUID) *CurrencyConversionCreate {
+	ccc.mutation.SetGroupID(id)
+	return ccc
+}
+
+// SetGroup sets the "group" edge to the Group entity.
+func (ccc *CurrencyConversionCreate) SetGroup(g *Group) *CurrencyConversionCreate {
+	return ccc.SetGroupID(g.ID)
+}
+
+// Mutation returns the CurrencyConversionMutation object of the builder.
+func (ccc *CurrencyConversionCreate) Mutation() *CurrencyConversionMutation {
+	return ccc.mutation
+}
+
+// Save creates the CurrencyConversion in the database.
+func (ccc *CurrencyConversionCreate) Save(ctx context.Context) (*CurrencyConversion, error) {
+	ccc.defaults()
+	return withHooks(ctx, ccc.sqlSave, ccc.mutation, ccc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ccc *CurrencyConversionCreate) SaveX(ctx context.Context) *CurrencyConversion {
+	v, err := ccc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ccc *CurrencyConversionCreate) Exec(ctx context.Context) error {
+	_, err := ccc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ccc *CurrencyConversionCreate) ExecX(ctx context.Context) {
+	if err := ccc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ccc *CurrencyConversionCreate) defaults() {
+	if _, ok := ccc.mutation.CreatedAt(); !ok {
+		v := currencyconversion.DefaultCreatedAt()
+		ccc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := ccc.mutation.UpdatedAt(); !ok {
+		v := currencyconversion.DefaultUpdatedAt()
+		ccc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := ccc.mutation.ID(); !ok {
+		v := currencyconversion.DefaultID()
+		ccc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ccc *CurrencyConversionCreate) check() error {
+	if _, ok := ccc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "CurrencyConversion.created_at"`)}
+	}
+	if _, ok := ccc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "CurrencyConversion.updated_at"`)}
+	}
+	if _, ok := ccc.mutation.FromCurrency(); !ok {
+		return &ValidationError{Name: "from_currency", err: errors.New(`ent: missing required field "CurrencyConversion.from_currency"`)}
+	}
+	if v, ok := ccc.mutation.FromCurrency(); ok {
+		if err := currencyconversion.FromCurrencyValidator(v); err != nil {
+			return &ValidationError{Name: "from_currency", err: fmt.Errorf(`ent: validator failed for field "CurrencyConversion.from_currency": %w`, err)}
+		}
+	}
+	if _, ok := ccc.mutation.ToCurrency(); !ok {
+		return &ValidationError{Name: "to_currency", err: errors.New(`ent: missing required field "CurrencyConversion.to_currency"`)}
+	}
+	if v, ok := ccc.mutation.ToCurrency(); ok {
+		if err := currencyconversion.ToCurrencyValidator(v); err != nil {
+			return &ValidationError{Name: "to_currency", err: fmt.Errorf(`ent: validator failed for field "CurrencyConversion.to_currency": %w`, err)}
+		}
+	}
+	if _, ok := ccc.mutation.Rate(); !ok {
+		return &ValidationError{Name: "rate", err: errors.New(`ent: missing required field "CurrencyConversion.rate"`)}
+	}
+	if _, ok := ccc.mutation.OriginalPrices(); !ok {
+		return &ValidationError{Name: "original_prices", err: errors.New(`ent: missing required field "CurrencyConversion.original_prices"`)}
+	}
+	if _, ok := ccc.mutation.GroupID(); !ok {
+		return &ValidationError{Name: "group", err: errors.New(`ent: missing required edge "CurrencyConversion.group"`)}
+	}
+	return nil
+}
+
+func (ccc *CurrencyConversionCreate) sqlSave(ctx context.Context) (*CurrencyConversion, error) {
+	if err := ccc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := ccc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ccc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	ccc.mutation.id = &_node.ID
+	ccc.mutation.done = true
+	return _node, nil
+}
+
+func (ccc *CurrencyConversionCreate) createSpec() (*CurrencyConversion, *sqlgraph.CreateSpec) {
+	var (
+		_node = &CurrencyConversion{config: ccc.config}
+		_spec = sqlgraph.NewCreateSpec(currencyconversion.Table, sqlgraph.NewFieldSpec(currencyconversion.FieldID, field.TypeUUID))
+	)
+	if id, ok := ccc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := ccc.mutation.CreatedAt(); ok {
+		_spec.SetField(currencyconversion.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := ccc.mutation.UpdatedAt(); ok {
+		_spec.SetField(currencyconversion.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := ccc.mutation.FromCurrency(); ok {
+		_spec.SetField(currencyconversion.FieldFromCurrency, field.TypeString, value)
+		_node.FromCurrency = value
+	}
+	if value, ok := ccc.mutation.ToCurrency(); ok {
+		_spec.SetField(currencyconversion.FieldToCurrency, field.TypeString, value)
+		_node.ToCurrency = value
+	}
+	if value, ok := ccc.mutation.Rate(); ok {
+		_spec.SetField(currencyconversion.FieldRate, field.TypeFloat64, value)
+		_node.Rate = value
+	}
+	if value, ok := ccc.mutation.OriginalPrices(); ok {
+		_spec.SetField(currencyconversion.FieldOriginalPrices, field.TypeJSON, value)
+		_node.OriginalPrices = value
+	}
+	if nodes := ccc.mutation.GroupIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   currencyconversion.GroupTable,
+			Columns: []string{currencyconversion.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.group_currency_conversions = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// CurrencyConversionCreateBulk is the builder for creating many CurrencyConversion entities in bulk.
+type CurrencyConversionCreateBulk struct {
+	config
+	err      error
+	builders []*CurrencyConversionCreate
+}
+
+// Save creates the CurrencyConversion entities in the database.
+func (cccb *CurrencyConversionCreateBulk) Save(ctx context.Context) ([]*CurrencyConversion, error) {
+	if cccb.err != nil {
+		return nil, cccb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(cccb.builders))
+	nodes := make([]*CurrencyConversion, len(cccb.builders))
+	mutators := make([]Mutator, len(cccb.builders))
+	for i := range cccb.builders {
+		func(i int, root context.Context) {
+			builder := cccb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CurrencyConversionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, cccb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, cccb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, cccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cccb *CurrencyConversionCreateBulk) SaveX(ctx context.Context) []*CurrencyConversion {
+	v, err := cccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cccb *CurrencyConversionCreateBulk) Exec(ctx context.Context) error {
+	_, err := cccb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cccb *CurrencyConversionCreateBulk) ExecX(ctx context.Context) {
+	if err := cccb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/currencyconversion"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/predicate"
+)
+
+// CurrencyConversionDelete is the builder for deleting a CurrencyConversion entity.
+type CurrencyConversionDelete struct {
+	config
+	hooks    []Hook
+	mutation *CurrencyConversionMutation
+}
+
+// Where appends a list predicates to the CurrencyConversionDelete builder.
+func (ccd *CurrencyConversionDelete) Where(ps ...predicate.CurrencyConversion) *CurrencyConversionDelete {
+	ccd.mutation.Where(ps...)
+	return ccd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (ccd *CurrencyConversionDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, ccd.sqlExec, ccd.mutation, ccd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ccd *CurrencyConversionDelete) ExecX(ctx context.Context) int {
+	n, err := ccd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (ccd *CurrencyConversionDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(currencyconversion.Table, sqlgraph.NewFieldSpec(currencyconversion.FieldID, field.TypeUUID))
+	if ps := ccd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, ccd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	ccd.mutation.done = true
+	return affected, err
+}
+
+// CurrencyConversionDeleteOne is the builder for deleting a single CurrencyConversion entity.
+type CurrencyConversionDeleteOne struct {
+	ccd *CurrencyConversionDelete
+}
+
+// Where appends a list predicates to the CurrencyConversionDelete builder.
+func (ccdo *CurrencyConversionDeleteOne) Where(ps ...predicate.CurrencyConversion) *CurrencyConversionDeleteOne {
+	ccdo.ccd.mutation.Where(ps...)
+	return ccdo
+}
+
+// Exec executes the deletion query.
+func (ccdo *CurrencyConversionDeleteOne) Exec(ctx context.Context) error {
+	n, err := ccdo.ccd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{currencyconversion.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ccdo *CurrencyConversionDeleteOne) ExecX(ctx context.Context) {
+	if err := ccdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,614 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/currencyconversion"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/group"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/predicate"
+)
+
+// CurrencyConversionQuery is the builder for querying CurrencyConversion entities.
+type CurrencyConversionQuery struct {
+	config
+	ctx        *QueryContext
+	order      []currencyconversion.OrderOption
+	inters     []Interceptor
+	predicates []predicate.CurrencyConversion
+	withGroup  *GroupQuery
+	withFKs    bool
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the CurrencyConversionQuery builder.
+func (ccq *CurrencyConversionQuery) Where(ps ...predicate.CurrencyConversion) *CurrencyConversionQuery {
+	ccq.predicates = append(ccq.predicates, ps...)
+	return ccq
+}
+
+// Limit the number of records to be returned by this query.
+func (ccq *CurrencyConversionQuery) Limit(limit int) *CurrencyConversionQuery {
+	ccq.ctx.Limit = &limit
+	return ccq
+}
+
+// Offset to start from.
+func (ccq *CurrencyConversionQuery) Offset(offset int) *CurrencyConversionQuery {
+	ccq.ctx.Offset = &offset
+	return ccq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (ccq *CurrencyConversionQuery) Unique(unique bool) *CurrencyConversionQuery {
+	ccq.ctx.Unique = &unique
+	return ccq
+}
+
+// Order specifies how the records should be ordered.
+func (ccq *CurrencyConversionQuery) Order(o ...currencyconversion.OrderOption) *CurrencyConversionQuery {
+	ccq.order = append(ccq.order, o...)
+	return ccq
+}
+
+// QueryGroup chains the current query on the "group" edge.
+func (ccq *CurrencyConversionQuery) QueryGroup() *GroupQuery {
+	query := (&GroupClient{config: ccq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := ccq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := ccq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(currencyconversion.Table, currencyconversion.FieldID, selector),
+			sqlgraph.To(group.Table, group.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, currencyconversion.GroupTable, currencyconversion.GroupColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(ccq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first CurrencyConversion entity from the query.
+// Returns a *NotFoundError when no CurrencyConversion was found.
+func (ccq *CurrencyConversionQuery) First(ctx context.Context) (*CurrencyConversion, error) {
+	nodes, err := ccq.Limit(1).All(setContextOp(ctx, ccq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{currencyconversion.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (ccq *CurrencyConversionQuery) FirstX(ctx context.Context) *CurrencyConversion {
+	node, err := ccq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first CurrencyConversion ID from the query.
+// Returns a *NotFoundError when no CurrencyConversion ID was found.
+func (ccq *CurrencyConversionQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = ccq.Limit(1).IDs(setContextOp(ctx, ccq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{currencyconversion.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (ccq *CurrencyConversionQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := ccq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single CurrencyConversion entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one CurrencyConversion entity is found.
+// Returns a *NotFoundError when no CurrencyConversion entities are found.
+func (ccq *CurrencyConversionQuery) Only(ctx context.Context) (*CurrencyConversion, error) {
+	nodes, err := ccq.Limit(2).All(setContextOp(ctx, ccq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{currencyconversion.Label}
+	default:
+		return nil, &NotSingularError{currencyconversion.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (ccq *CurrencyConversionQuery) OnlyX(ctx context.Context) *CurrencyConversion {
+	node, err := ccq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only CurrencyConversion ID in the query.
+// Returns a *NotSingularError when more than one CurrencyConversion ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (ccq *CurrencyConversionQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = ccq.Limit(2).IDs(setContextOp(ctx, ccq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{currencyconversion.Label}
+	default:
+		err = &NotSingularError{currencyconversion.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (ccq *CurrencyConversionQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := ccq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of CurrencyConversions.
+func (ccq *CurrencyConversionQuery) All(ctx context.Context) ([]*CurrencyConversion, error) {
+	ctx = setContextOp(ctx, ccq.ctx, "All")
+	if err := ccq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*CurrencyConversion, *CurrencyConversionQuery]()
+	return withInterceptors[[]*CurrencyConversion](ctx, ccq, qr, ccq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (ccq *CurrencyConversionQuery) AllX(ctx context.Context) []*CurrencyConversion {
+	nodes, err := ccq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of CurrencyConversion IDs.
+func (ccq *CurrencyConversionQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if ccq.ctx.Unique == nil && ccq.path != nil {
+		ccq.Unique(true)
+	}
+	ctx = setContextOp(ctx, ccq.ctx, "IDs")
+	if err = ccq.Select(currencyconversion.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (ccq *CurrencyConversionQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := ccq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (ccq *CurrencyConversionQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, ccq.ctx, "Count")
+	if err := ccq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, ccq, querierCount[*CurrencyConversionQuery](), ccq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (ccq *CurrencyConversionQuery) CountX(ctx context.Context) int {
+	count, err := ccq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (ccq *CurrencyConversionQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, ccq.ctx, "Exist")
+	switch _, err := ccq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (ccq *CurrencyConversionQuery) ExistX(ctx context.Context) bool {
+	exist, err := ccq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the CurrencyConversionQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (ccq *CurrencyConversionQuery) Clone() *CurrencyConversionQuery {
+	if ccq == nil {
+		return nil
+	}
+	return &CurrencyConversionQuery{
+		config:     ccq.config,
+		ctx:        ccq.ctx.Clone(),
+		order:      append([]currencyconversion.OrderOption{}, ccq.order...),
+		inters:     append([]Interceptor{}, ccq.inters...),
+		predicates: append([]predicate.CurrencyConversion{}, ccq.predicates...),
+		withGroup:  ccq.withGroup.Clone(),
+		// clone intermediate query.
+		sql:  ccq.sql.Clone(),
+		path: ccq.path,
+	}
+}
+
+// WithGroup tells the query-builder to eager-load the nodes that are connected to
+// the "group" edge. The optional arguments are used to configure the query builder of the edge.
+func (ccq *CurrencyConversionQuery) WithGroup(opts ...func(*GroupQuery)) *CurrencyConversionQuery {
+	query := (&GroupClient{config: ccq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	ccq.withGroup = query
+	return ccq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.CurrencyConversion.Query().
+//		GroupBy(currencyconversion.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (ccq *CurrencyConversionQuery) GroupBy(field string, fields ...string) *CurrencyConversionGroupBy {
+	ccq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &CurrencyConversionGroupBy{build: ccq}
+	grbuild.flds = &ccq.ctx.Fields
+	grbuild.label = currencyconversion.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.CurrencyConversion.Query().
+//		Select(currencyconversion.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (ccq *CurrencyConversionQuery) Select(fields ...string) *CurrencyConversionSelect {
+	ccq.ctx.Fields = append(ccq.ctx.Fields, fields...)
+	sbuild := &CurrencyConversionSelect{CurrencyConversionQuery: ccq}
+	sbuild.label = currencyconversion.Label
+	sbuild.flds, sbuild.scan = &ccq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a CurrencyConversionSelect configured with the given aggregations.
+func (ccq *CurrencyConversionQuery) Aggregate(fns ...AggregateFunc) *CurrencyConversionSelect {
+	return ccq.Select().Aggregate(fns...)
+}
+
+func (ccq *CurrencyConversionQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range ccq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, ccq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range ccq.ctx.Fields {
+		if !currencyconversion.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if ccq.path != nil {
+		prev, err := ccq.path(ctx)
+		if err != nil {
+			return err
+		}
+		ccq.sql = prev
+	}
+	return nil
+}
+
+func (ccq *CurrencyConversionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*CurrencyConversion, error) {
+	var (
+		nodes       = []*CurrencyConversion{}
+		withFKs     = ccq.withFKs
+		_spec       = ccq.querySpec()
+		loadedTypes = [1]bool{
+			ccq.withGroup != nil,
+		}
+	)
+	if ccq.withGroup != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, currencyconversion.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*CurrencyConversion).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &CurrencyConversion{config: ccq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, ccq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := ccq.withGroup; query != nil {
+		if err := ccq.loadGroup(ctx, query, nodes, nil,
+			func(n *CurrencyConversion, e *Group) { n.Edges.Group = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (ccq *CurrencyConversionQuery) loadGroup(ctx context.Context, query *GroupQuery, nodes []*CurrencyConversion, init func(*CurrencyConversion), assign func(*CurrencyConversion, *Group)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*CurrencyConversion)
+	for i := range nodes {
+		if nodes[i].group_currency_conversions == nil {
+			continue
+		}
+		fk := *nodes[i].group_currency_conversions
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(group.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "group_currency_conversions" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (ccq *CurrencyConversionQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := ccq.querySpec()
+	_spec.Node.Columns = ccq.ctx.Fields
+	if len(ccq.ctx.Fields) > 0 {
+		_spec.Unique = ccq.ctx.Unique != nil && *ccq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, ccq.driver, _spec)
+}
+
+func (ccq *CurrencyConversionQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(currencyconversion.Table, currencyconversion.Columns, sqlgraph.NewFieldSpec(currencyconversion.FieldID, field.TypeUUID))
+	_spec.From = ccq.sql
+	if unique := ccq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if ccq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := ccq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, currencyconversion.FieldID)
+		for i := range fields {
+			if fields[i] != currencyconversion.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := ccq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := ccq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := ccq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := ccq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (ccq *CurrencyConversionQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(ccq.driver.Dialect())
+	t1 := builder.Table(currencyconversion.Table)
+	columns := ccq.ctx.Fields
+	if len(columns) == 0 {
+		columns = currencyconversion.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if ccq.sql != nil {
+		selector = ccq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if ccq.ctx.Unique != nil && *ccq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range ccq.predicates {
+		p(selector)
+	}
+	for _, p := range ccq.order {
+		p(selector)
+	}
+	if offset := ccq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := ccq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// CurrencyConversionGroupBy is the group-by builder for CurrencyConversion entities.
+type CurrencyConversionGroupBy struct {
+	selector
+	build *CurrencyConversionQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (ccgb *CurrencyConversionGroupBy) Aggregate(fns ...AggregateFunc) *CurrencyConversionGroupBy {
+	ccgb.fns = append(ccgb.fns, fns...)
+	return ccgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ccgb *CurrencyConversionGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ccgb.build.ctx, "GroupBy")
+	if err := ccgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*CurrencyConversionQuery, *CurrencyConversionGroupBy](ctx, ccgb.build, ccgb, ccgb.build.inters, v)
+}
+
+func (ccgb *CurrencyConversionGroupBy) sqlScan(ctx context.Context, root *CurrencyConversionQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(ccgb.fns))
+	for _, fn := range ccgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*ccgb.flds)+len(ccgb.fns))
+		for _, f := range *ccgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*ccgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ccgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// CurrencyConversionSelect is the builder for selecting fields of CurrencyConversion entities.
+type CurrencyConversionSelect struct {
+	*CurrencyConversionQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (ccs *CurrencyConversionSelect) Aggregate(fns ...AggregateFunc) *CurrencyConversionSelect {
+	ccs.fns = append(ccs.fns, fns...)
+	return ccs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ccs *CurrencyConversionSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ccs.ctx, "Select")
+	if err := ccs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*CurrencyConversionQuery, *CurrencyConversionSelect](ctx, ccs.CurrencyConversionQuery, ccs, ccs.inters, v)
+}
+
+func (ccs *CurrencyConversionSelect) sqlScan(ctx context.Context, root *CurrencyConversionQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(ccs.fns))
+	for _, fn := range ccs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*ccs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ccs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
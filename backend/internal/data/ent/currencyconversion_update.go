@@ -0,0 +1,512 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/currencyconversion"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/group"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/predicate"
+	"github.com/hay-kot/homebox/backend/internal/data/types"
+)
+
+// CurrencyConversionUpdate is the builder for updating CurrencyConversion entities.
+type CurrencyConversionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *CurrencyConversionMutation
+}
+
+// Where appends a list predicates to the CurrencyConversionUpdate builder.
+func (ccu *CurrencyConversionUpdate) Where(ps ...predicate.CurrencyConversion) *CurrencyConversionUpdate {
+	ccu.mutation.Where(ps...)
+	return ccu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ccu *CurrencyConversionUpdate) SetUpdatedAt(t time.Time) *CurrencyConversionUpdate {
+	ccu.mutation.SetUpdatedAt(t)
+	return ccu
+}
+
+// SetFromCurrency sets the "from_currency" field.
+func (ccu *CurrencyConversionUpdate) SetFromCurrency(s string) *CurrencyConversionUpdate {
+	ccu.mutation.SetFromCurrency(s)
+	return ccu
+}
+
+// SetNillableFromCurrency sets the "from_currency" field if the given value is not nil.
+func (ccu *CurrencyConversionUpdate) SetNillableFromCurrency(s *string) *CurrencyConversionUpdate {
+	if s != nil {
+		ccu.SetFromCurrency(*s)
+	}
+	return ccu
+}
+
+// SetToCurrency sets the "to_currency" field.
+func (ccu *CurrencyConversionUpdate) SetToCurrency(s string) *CurrencyConversionUpdate {
+	ccu.mutation.SetToCurrency(s)
+	return ccu
+}
+
+// SetNillableToCurrency sets the "to_currency" field if the given value is not nil.
+func (ccu *CurrencyConversionUpdate) SetNillableToCurrency(s *string) *CurrencyConversionUpdate {
+	if s != nil {
+		ccu.SetToCurrency(*s)
+	}
+	return ccu
+}
+
+// SetRate sets the "rate" field.
+func (ccu *CurrencyConversionUpdate) SetRate(f float64) *CurrencyConversionUpdate {
+	ccu.mutation.ResetRate()
+	ccu.mutation.SetRate(f)
+	return ccu
+}
+
+// SetNillableRate sets the "rate" field if the given value is not nil.
+func (ccu *CurrencyConversionUpdate) SetNillableRate(f *float64) *CurrencyConversionUpdate {
+	if f != nil {
+		ccu.SetRate(*f)
+	}
+	return ccu
+}
+
+// AddRate adds f to the "rate" field.
+func (ccu *CurrencyConversionUpdate) AddRate(f float64) *CurrencyConversionUpdate {
+	ccu.mutation.AddRate(f)
+	return ccu
+}
+
+// SetOriginalPrices sets the "original_prices" field.
+func (ccu *CurrencyConversionUpdate) SetOriginalPrices(ts []types.PriceSnapshot) *CurrencyConversionUpdate {
+	ccu.mutation.SetOriginalPrices(ts)
+	return ccu
+}
+
+// AppendOriginalPrices appends ts to the "original_prices" field.
+func (ccu *CurrencyConversionUpdate) AppendOriginalPrices(ts []types.PriceSnapshot) *CurrencyConversionUpdate {
+	ccu.mutation.AppendOriginalPrices(ts)
+	return ccu
+}
+
+// SetGroupID sets the "group" edge to the Group entity by ID.
+func (ccu *CurrencyConversionUpdate) SetGroupID(id uuid.UUID) *CurrencyConversionUpdate {
+	ccu.mutation.SetGroupID(id)
+	return ccu
+}
+
+// SetGroup sets the "group" edge to the Group entity.
+func (ccu *CurrencyConversionUpdate) SetGroup(g *Group) *CurrencyConversionUpdate {
+	return ccu.SetGroupID(g.ID)
+}
+
+// Mutation returns the CurrencyConversionMutation object of the builder.
+func (ccu *CurrencyConversionUpdate) Mutation() *CurrencyConversionMutation {
+	return ccu.mutation
+}
+
+// ClearGroup clears the "group" edge to the Group entity.
+func (ccu *CurrencyConversionUpdate) ClearGroup() *CurrencyConversionUpdate {
+	ccu.mutation.ClearGroup()
+	return ccu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ccu *CurrencyConversionUpdate) Save(ctx context.Context) (int, error) {
+	ccu.defaults()
+	return withHooks(ctx, ccu.sqlSave, ccu.mutation, ccu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ccu *CurrencyConversionUpdate) SaveX(ctx context.Context) int {
+	affected, err := ccu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ccu *CurrencyConversionUpdate) Exec(ctx context.Context) error {
+	_, err := ccu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ccu *CurrencyConversionUpdate) ExecX(ctx context.Context) {
+	if err := ccu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ccu *CurrencyConversionUpdate) defaults() {
+	if _, ok := ccu.mutation.UpdatedAt(); !ok {
+		v := currencyconversion.UpdateDefaultUpdatedAt()
+		ccu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ccu *CurrencyConversionUpdate) check() error {
+	if v, ok := ccu.mutation.FromCurrency(); ok {
+		if err := currencyconversion.FromCurrencyValidator(v); err != nil {
+			return &ValidationError{Name: "from_currency", err: fmt.Errorf(`ent: validator failed for field "CurrencyConversion.from_currency": %w`, err)}
+		}
+	}
+	if v, ok := ccu.mutation.ToCurrency(); ok {
+		if err := currencyconversion.ToCurrencyValidator(v); err != nil {
+			return &ValidationError{Name: "to_currency", err: fmt.Errorf(`ent: validator failed for field "CurrencyConversion.to_currency": %w`, err)}
+		}
+	}
+	if _, ok := ccu.mutation.GroupID(); ccu.mutation.GroupCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "CurrencyConversion.group"`)
+	}
+	return nil
+}
+
+func (ccu *CurrencyConversionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := ccu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(currencyconversion.Table, currencyconversion.Columns, sqlgraph.NewFieldSpec(currencyconversion.FieldID, field.TypeUUID))
+	if ps := ccu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ccu.mutation.UpdatedAt(); ok {
+		_spec.SetField(currencyconversion.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := ccu.mutation.FromCurrency(); ok {
+		_spec.SetField(currencyconversion.FieldFromCurrency, field.TypeString, value)
+	}
+	if value, ok := ccu.mutation.ToCurrency(); ok {
+		_spec.SetField(currencyconversion.FieldToCurrency, field.TypeString, value)
+	}
+	if value, ok := ccu.mutation.Rate(); ok {
+		_spec.SetField(currencyconversion.FieldRate, field.TypeFloat64, value)
+	}
+	if value, ok := ccu.mutation.AddedRate(); ok {
+		_spec.AddField(currencyconversion.FieldRate, field.TypeFloat64, value)
+	}
+	if value, ok := ccu.mutation.OriginalPrices(); ok {
+		_spec.SetField(currencyconversion.FieldOriginalPrices, field.TypeJSON, value)
+	}
+	if value, ok := ccu.mutation.AppendedOriginalPrices(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, currencyconversion.FieldOriginalPrices, value)
+		})
+	}
+	if ccu.mutation.GroupCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   currencyconversion.GroupTable,
+			Columns: []string{currencyconversion.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ccu.mutation.GroupIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   currencyconversion.GroupTable,
+			Columns: []string{currencyconversion.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, ccu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{currencyconversion.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	ccu.mutation.done = true
+	return n, nil
+}
+
+// CurrencyConversionUpdateOne is the builder for updating a single CurrencyConversion entity.
+type CurrencyConversionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *CurrencyConversionMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ccuo *CurrencyConversionUpdateOne) SetUpdatedAt(t time.Time) *CurrencyConversionUpdateOne {
+	ccuo.mutation.SetUpdatedAt(t)
+	return ccuo
+}
+
+// SetFromCurrency sets the "from_currency" field.
+func (ccuo *CurrencyConversionUpdateOne) SetFromCurrency(s string) *CurrencyConversionUpdateOne {
+	ccuo.mutation.SetFromCurrency(s)
+	return ccuo
+}
+
+// SetNillableFromCurrency sets the "from_currency" field if the given value is not nil.
+func (ccuo *CurrencyConversionUpdateOne) SetNillableFromCurrency(s *string) *CurrencyConversionUpdateOne {
+	if s != nil {
+		ccuo.SetFromCurrency(*s)
+	}
+	return ccuo
+}
+
+// SetToCurrency sets the "to_currency" field.
+func (ccuo *CurrencyConversionUpdateOne) SetToCurrency(s string) *CurrencyConversionUpdateOne {
+	ccuo.mutation.SetToCurrency(s)
+	return ccuo
+}
+
+// SetNillableToCurrency sets the "to_currency" field if the given value is not nil.
+func (ccuo *CurrencyConversionUpdateOne) SetNillableToCurrency(s *string) *CurrencyConversionUpdateOne {
+	if s != nil {
+		ccuo.SetToCurrency(*s)
+	}
+	return ccuo
+}
+
+// SetRate sets the "rate" field.
+func (ccuo *CurrencyConversionUpdateOne) SetRate(f float64) *CurrencyConversionUpdateOne {
+	ccuo.mutation.ResetRate()
+	ccuo.mutation.SetRate(f)
+	return ccuo
+}
+
+// SetNillableRate sets the "rate" field if the given value is not nil.
+func (ccuo *CurrencyConversionUpdateOne) SetNillableRate(f *float64) *CurrencyConversionUpdateOne {
+	if f != nil {
+		ccuo.SetRate(*f)
+	}
+	return ccuo
+}
+
+// AddRate adds f to the "rate" field.
+func (ccuo *CurrencyConversionUpdateOne) AddRate(f float64) *CurrencyConversionUpdateOne {
+	ccuo.mutation.AddRate(f)
+	return ccuo
+}
+
+// SetOriginalPrices sets the "original_prices" field.
+func (ccuo *CurrencyConversionUpdateOne) SetOriginalPrices(ts []types.PriceSnapshot) *CurrencyConversionUpdateOne {
+	ccuo.mutation.SetOriginalPrices(ts)
+	return ccuo
+}
+
+// AppendOriginalPrices appends ts to the "original_prices" field.
+func (ccuo *CurrencyConversionUpdateOne) AppendOriginalPrices(ts []types.PriceSnapshot) *CurrencyConversionUpdateOne {
+	ccuo.mutation.AppendOriginalPrices(ts)
+	return ccuo
+}
+
+// SetGroupID sets the "group" edge to the Group entity by ID.
+func (ccuo *CurrencyConversionUpdateOne) SetGroupID(id uuid.UUID) *CurrencyConversionUpdateOne {
+	ccuo.mutation.SetGroupID(id)
+	return ccuo
+}
+
+// SetGroup sets the "group" edge to the Group entity.
+func (ccuo *CurrencyConversionUpdateOne) SetGroup(g *Group) *CurrencyConversionUpdateOne {
+	return ccuo.SetGroupID(g.ID)
+}
+
+// Mutation returns the CurrencyConversionMutation object of the builder.
+func (ccuo *CurrencyConversionUpdateOne) Mutation() *CurrencyConversionMutation {
+	return ccuo.mutation
+}
+
+// ClearGroup clears the "group" edge to the Group entity.
+func (ccuo *CurrencyConversionUpdateOne) ClearGroup() *CurrencyConversionUpdateOne {
+	ccuo.mutation.ClearGroup()
+	return ccuo
+}
+
+// Where appends a list predicates to the CurrencyConversionUpdate builder.
+func (ccuo *CurrencyConversionUpdateOne) Where(ps ...predicate.CurrencyConversion) *CurrencyConversionUpdateOne {
+	ccuo.mutation.Where(ps...)
+	return ccuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ccuo *CurrencyConversionUpdateOne) Select(field string, fields ...string) *CurrencyConversionUpdateOne {
+	ccuo.fields = append([]string{field}, fields...)
+	return ccuo
+}
+
+// Save executes the query and returns the updated CurrencyConversion entity.
+func (ccuo *CurrencyConversionUpdateOne) Save(ctx context.Context) (*CurrencyConversion, error) {
+	ccuo.defaults()
+	return withHooks(ctx, ccuo.sqlSave, ccuo.mutation, ccuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ccuo *CurrencyConversionUpdateOne) SaveX(ctx context.Context) *CurrencyConversion {
+	node, err := ccuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ccuo *CurrencyConversionUpdateOne) Exec(ctx context.Context) error {
+	_, err := ccuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ccuo *CurrencyConversionUpdateOne) ExecX(ctx context.Context) {
+	if err := ccuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ccuo *CurrencyConversionUpdateOne) defaults() {
+	if _, ok := ccuo.mutation.UpdatedAt(); !ok {
+		v := currencyconversion.UpdateDefaultUpdatedAt()
+		ccuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ccuo *CurrencyConversionUpdateOne) check() error {
+	if v, ok := ccuo.mutation.FromCurrency(); ok {
+		if err := currencyconversion.FromCurrencyValidator(v); err != nil {
+			return &ValidationError{Name: "from_currency", err: fmt.Errorf(`ent: validator failed for field "CurrencyConversion.from_currency": %w`, err)}
+		}
+	}
+	if v, ok := ccuo.mutation.ToCurrency(); ok {
+		if err := currencyconversion.ToCurrencyValidator(v); err != nil {
+			return &ValidationError{Name: "to_currency", err: fmt.Errorf(`ent: validator failed for field "CurrencyConversion.to_currency": %w`, err)}
+		}
+	}
+	if _, ok := ccuo.mutation.GroupID(); ccuo.mutation.GroupCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "CurrencyConversion.group"`)
+	}
+	return nil
+}
+
+func (ccuo *CurrencyConversionUpdateOne) sqlSave(ctx context.Context) (_node *CurrencyConversion, err error) {
+	if err := ccuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(currencyconversion.Table, currencyconversion.Columns, sqlgraph.NewFieldSpec(currencyconversion.FieldID, field.TypeUUID))
+	id, ok := ccuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "CurrencyConversion.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ccuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, currencyconversion.FieldID)
+		for _, f := range fields {
+			if !currencyconversion.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != currencyconversion.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ccuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ccuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(currencyconversion.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := ccuo.mutation.FromCurrency(); ok {
+		_spec.SetField(currencyconversion.FieldFromCurrency, field.TypeString, value)
+	}
+	if value, ok := ccuo.mutation.ToCurrency(); ok {
+		_spec.SetField(currencyconversion.FieldToCurrency, field.TypeString, value)
+	}
+	if value, ok := ccuo.mutation.Rate(); ok {
+		_spec.SetField(currencyconversion.FieldRate, field.TypeFloat64, value)
+	}
+	if value, ok := ccuo.mutation.AddedRate(); ok {
+		_spec.AddField(currencyconversion.FieldRate, field.TypeFloat64, value)
+	}
+	if value, ok := ccuo.mutation.OriginalPrices(); ok {
+		_spec.SetField(currencyconversion.FieldOriginalPrices, field.TypeJSON, value)
+	}
+	if value, ok := ccuo.mutation.AppendedOriginalPrices(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, currencyconversion.FieldOriginalPrices, value)
+		})
+	}
+	if ccuo.mutation.GroupCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   currencyconversion.GroupTable,
+			Columns: []string{currencyconversion.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ccuo.mutation.GroupIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   currencyconversion.GroupTable,
+			Columns: []string{currencyconversion.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &CurrencyConversion{config: ccuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ccuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{currencyconversion.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	ccuo.mutation.done = true
+	return _node, nil
+}
@@ -11,6 +11,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"github.com/google/uuid"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/group"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/location"
 )
 
 // Group is the model entity for the Group schema.
@@ -26,6 +27,12 @@ type Group struct {
 	Name string `json:"name,omitempty"`
 	// Currency holds the value of the "currency" field.
 	Currency group.Currency `json:"currency,omitempty"`
+	// DefaultLocationID holds the value of the "default_location_id" field.
+	DefaultLocationID *uuid.UUID `json:"default_location_id,omitempty"`
+	// DepreciationMethod holds the value of the "depreciation_method" field.
+	DepreciationMethod group.DepreciationMethod `json:"depreciation_method,omitempty"`
+	// DepreciationYears holds the value of the "depreciation_years" field.
+	DepreciationYears int `json:"depreciation_years,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the GroupQuery when eager-loading is set.
 	Edges        GroupEdges `json:"edges"`
@@ -48,9 +55,19 @@ type GroupEdges struct {
 	InvitationTokens []*GroupInvitationToken `json:"invitation_tokens,omitempty"`
 	// Notifiers holds the value of the notifiers edge.
 	Notifiers []*Notifier `json:"notifiers,omitempty"`
+	// CurrencyConversions holds the value of the currency_conversions edge.
+	CurrencyConversions []*CurrencyConversion `json:"currency_conversions,omitempty"`
+	// ItemEvents holds the value of the item_events edge.
+	ItemEvents []*ItemEvent `json:"item_events,omitempty"`
+	// SavedSearches holds the value of the saved_searches edge.
+	SavedSearches []*SavedSearch `json:"saved_searches,omitempty"`
+	// ItemTemplates holds the value of the item_templates edge.
+	ItemTemplates []*ItemTemplate `json:"item_templates,omitempty"`
+	// DefaultLocation holds the value of the default_location edge.
+	DefaultLocation *Location `json:"default_location,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [7]bool
+	loadedTypes [12]bool
 }
 
 // UsersOrErr returns the Users value or an error if the edge
@@ -116,12 +133,65 @@ func (e GroupEdges) NotifiersOrErr() ([]*Notifier, error) {
 	return nil, &NotLoadedError{edge: "notifiers"}
 }
 
+// CurrencyConversionsOrErr returns the CurrencyConversions value or an error if the edge
+// was not loaded in eager-loading.
+func (e GroupEdges) CurrencyConversionsOrErr() ([]*CurrencyConversion, error) {
+	if e.loadedTypes[7] {
+		return e.CurrencyConversions, nil
+	}
+	return nil, &NotLoadedError{edge: "currency_conversions"}
+}
+
+// ItemEventsOrErr returns the ItemEvents value or an error if the edge
+// was not loaded in eager-loading.
+func (e GroupEdges) ItemEventsOrErr() ([]*ItemEvent, error) {
+	if e.loadedTypes[8] {
+		return e.ItemEvents, nil
+	}
+	return nil, &NotLoadedError{edge: "item_events"}
+}
+
+// SavedSearchesOrErr returns the SavedSearches value or an error if the edge
+// was not loaded in eager-loading.
+func (e GroupEdges) SavedSearchesOrErr() ([]*SavedSearch, error) {
+	if e.loadedTypes[9] {
+		return e.SavedSearches, nil
+	}
+	return nil, &NotLoadedError{edge: "saved_searches"}
+}
+
+// ItemTemplatesOrErr returns the ItemTemplates value or an error if the edge
+// was not loaded in eager-loading.
+func (e GroupEdges) ItemTemplatesOrErr() ([]*ItemTemplate, error) {
+	if e.loadedTypes[10] {
+		return e.ItemTemplates, nil
+	}
+	return nil, &NotLoadedError{edge: "item_templates"}
+}
+
+// DefaultLocationOrErr returns the DefaultLocation value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e GroupEdges) DefaultLocationOrErr() (*Location, error) {
+	if e.loadedTypes[11] {
+		if e.DefaultLocation == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: location.Label}
+		}
+		return e.DefaultLocation, nil
+	}
+	return nil, &NotLoadedError{edge: "default_location"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Group) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case group.FieldName, group.FieldCurrency:
+		case group.FieldDefaultLocationID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case group.FieldDepreciationYears:
+			values[i] = new(sql.NullInt64)
+		case group.FieldName, group.FieldCurrency, group.FieldDepreciationMethod:
 			values[i] = new(sql.NullString)
 		case group.FieldCreatedAt, group.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
@@ -172,6 +242,25 @@ func (gr *Group) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				gr.Currency = group.Currency(value.String)
 			}
+		case group.FieldDefaultLocationID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field default_location_id", values[i])
+			} else if value.Valid {
+				gr.DefaultLocationID = new(uuid.UUID)
+				*gr.DefaultLocationID = *value.S.(*uuid.UUID)
+			}
+		case group.FieldDepreciationMethod:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field depreciation_method", values[i])
+			} else if value.Valid {
+				gr.DepreciationMethod = group.DepreciationMethod(value.String)
+			}
+		case group.FieldDepreciationYears:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field depreciation_years", values[i])
+			} else if value.Valid {
+				gr.DepreciationYears = int(value.Int64)
+			}
 		default:
 			gr.selectValues.Set(columns[i], values[i])
 		}
@@ -220,6 +309,31 @@ func (gr *Group) QueryNotifiers() *NotifierQuery {
 	return NewGroupClient(gr.config).QueryNotifiers(gr)
 }
 
+// QueryCurrencyConversions queries the "currency_conversions" edge of the Group entity.
+func (gr *Group) QueryCurrencyConversions() *CurrencyConversionQuery {
+	return NewGroupClient(gr.config).QueryCurrencyConversions(gr)
+}
+
+// QueryItemEvents queries the "item_events" edge of the Group entity.
+func (gr *Group) QueryItemEvents() *ItemEventQuery {
+	return NewGroupClient(gr.config).QueryItemEvents(gr)
+}
+
+// QuerySavedSearches queries the "saved_searches" edge of the Group entity.
+func (gr *Group) QuerySavedSearches() *SavedSearchQuery {
+	return NewGroupClient(gr.config).QuerySavedSearches(gr)
+}
+
+// QueryItemTemplates queries the "item_templates" edge of the Group entity.
+func (gr *Group) QueryItemTemplates() *ItemTemplateQuery {
+	return NewGroupClient(gr.config).QueryItemTemplates(gr)
+}
+
+// QueryDefaultLocation queries the "default_location" edge of the Group entity.
+func (gr *Group) QueryDefaultLocation() *LocationQuery {
+	return NewGroupClient(gr.config).QueryDefaultLocation(gr)
+}
+
 // Update returns a builder for updating this Group.
 // Note that you need to call Group.Unwrap() before calling this method if this Group
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -254,6 +368,17 @@ func (gr *Group) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("currency=")
 	builder.WriteString(fmt.Sprintf("%v", gr.Currency))
+	builder.WriteString(", ")
+	if v := gr.DefaultLocationID; v != nil {
+		builder.WriteString("default_location_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("depreciation_method=")
+	builder.WriteString(fmt.Sprintf("%v", gr.DepreciationMethod))
+	builder.WriteString(", ")
+	builder.WriteString("depreciation_years=")
+	builder.WriteString(fmt.Sprintf("%v", gr.DepreciationYears))
 	builder.WriteByte(')')
 	return builder.String()
 }
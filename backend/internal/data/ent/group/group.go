@@ -24,6 +24,12 @@ const (
 	FieldName = "name"
 	// FieldCurrency holds the string denoting the currency field in the database.
 	FieldCurrency = "currency"
+	// FieldDefaultLocationID holds the string denoting the default_location_id field in the database.
+	FieldDefaultLocationID = "default_location_id"
+	// FieldDepreciationMethod holds the string denoting the depreciation_method field in the database.
+	FieldDepreciationMethod = "depreciation_method"
+	// FieldDepreciationYears holds the string denoting the depreciation_years field in the database.
+	FieldDepreciationYears = "depreciation_years"
 	// EdgeUsers holds the string denoting the users edge name in mutations.
 	EdgeUsers = "users"
 	// EdgeLocations holds the string denoting the locations edge name in mutations.
@@ -38,6 +44,16 @@ const (
 	EdgeInvitationTokens = "invitation_tokens"
 	// EdgeNotifiers holds the string denoting the notifiers edge name in mutations.
 	EdgeNotifiers = "notifiers"
+	// EdgeCurrencyConversions holds the string denoting the currency_conversions edge name in mutations.
+	EdgeCurrencyConversions = "currency_conversions"
+	// EdgeItemEvents holds the string denoting the item_events edge name in mutations.
+	EdgeItemEvents = "item_events"
+	// EdgeSavedSearches holds the string denoting the saved_searches edge name in mutations.
+	EdgeSavedSearches = "saved_searches"
+	// EdgeItemTemplates holds the string denoting the item_templates edge name in mutations.
+	EdgeItemTemplates = "item_templates"
+	// EdgeDefaultLocation holds the string denoting the default_location edge name in mutations.
+	EdgeDefaultLocation = "default_location"
 	// Table holds the table name of the group in the database.
 	Table = "groups"
 	// UsersTable is the table that holds the users relation/edge.
@@ -89,6 +105,41 @@ const (
 	NotifiersInverseTable = "notifiers"
 	// NotifiersColumn is the table column denoting the notifiers relation/edge.
 	NotifiersColumn = "group_id"
+	// CurrencyConversionsTable is the table that holds the currency_conversions relation/edge.
+	CurrencyConversionsTable = "currency_conversions"
+	// CurrencyConversionsInverseTable is the table name for the CurrencyConversion entity.
+	// It exists in this package in order to avoid circular dependency with the "currencyconversion" package.
+	CurrencyConversionsInverseTable = "currency_conversions"
+	// CurrencyConversionsColumn is the table column denoting the currency_conversions relation/edge.
+	CurrencyConversionsColumn = "group_currency_conversions"
+	// ItemEventsTable is the table that holds the item_events relation/edge.
+	ItemEventsTable = "item_events"
+	// ItemEventsInverseTable is the table name for the ItemEvent entity.
+	// It exists in this package in order to avoid circular dependency with the "itemevent" package.
+	ItemEventsInverseTable = "item_events"
+	// ItemEventsColumn is the table column denoting the item_events relation/edge.
+	ItemEventsColumn = "group_item_events"
+	// SavedSearchesTable is the table that holds the saved_searches relation/edge.
+	SavedSearchesTable = "saved_searches"
+	// SavedSearchesInverseTable is the table name for the SavedSearch entity.
+	// It exists in this package in order to avoid circular dependency with the "savedsearch" package.
+	SavedSearchesInverseTable = "saved_searches"
+	// SavedSearchesColumn is the table column denoting the saved_searches relation/edge.
+	SavedSearchesColumn = "group_saved_searches"
+	// ItemTemplatesTable is the table that holds the item_templates relation/edge.
+	ItemTemplatesTable = "item_templates"
+	// ItemTemplatesInverseTable is the table name for the ItemTemplate entity.
+	// It exists in this package in order to avoid circular dependency with the "itemtemplate" package.
+	ItemTemplatesInverseTable = "item_templates"
+	// ItemTemplatesColumn is the table column denoting the item_templates relation/edge.
+	ItemTemplatesColumn = "group_item_templates"
+	// DefaultLocationTable is the table that holds the default_location relation/edge.
+	DefaultLocationTable = "groups"
+	// DefaultLocationInverseTable is the table name for the Location entity.
+	// It exists in this package in order to avoid circular dependency with the "location" package.
+	DefaultLocationInverseTable = "locations"
+	// DefaultLocationColumn is the table column denoting the default_location relation/edge.
+	DefaultLocationColumn = "default_location_id"
 )
 
 // Columns holds all SQL columns for group fields.
@@ -98,6 +149,9 @@ var Columns = []string{
 	FieldUpdatedAt,
 	FieldName,
 	FieldCurrency,
+	FieldDefaultLocationID,
+	FieldDepreciationMethod,
+	FieldDepreciationYears,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -119,6 +173,8 @@ var (
 	UpdateDefaultUpdatedAt func() time.Time
 	// NameValidator is a validator for the "name" field. It is called by the builders before save.
 	NameValidator func(string) error
+	// DefaultDepreciationYears holds the default value on creation for the "depreciation_years" field.
+	DefaultDepreciationYears int
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() uuid.UUID
 )
@@ -179,6 +235,33 @@ func CurrencyValidator(c Currency) error {
 	}
 }
 
+// DepreciationMethod defines the type for the "depreciation_method" enum field.
+type DepreciationMethod string
+
+// DepreciationMethodNone is the default value of the DepreciationMethod enum.
+const DefaultDepreciationMethod = DepreciationMethodNone
+
+// DepreciationMethod values.
+const (
+	DepreciationMethodNone             DepreciationMethod = "none"
+	DepreciationMethodStraightLine     DepreciationMethod = "straight_line"
+	DepreciationMethodDecliningBalance DepreciationMethod = "declining_balance"
+)
+
+func (dm DepreciationMethod) String() string {
+	return string(dm)
+}
+
+// DepreciationMethodValidator is a validator for the "depreciation_method" field enum values. It is called by the builders before save.
+func DepreciationMethodValidator(dm DepreciationMethod) error {
+	switch dm {
+	case DepreciationMethodNone, DepreciationMethodStraightLine, DepreciationMethodDecliningBalance:
+		return nil
+	default:
+		return fmt.Errorf("group: invalid enum value for depreciation_method field: %q", dm)
+	}
+}
+
 // OrderOption defines the ordering options for the Group queries.
 type OrderOption func(*sql.Selector)
 
@@ -207,6 +290,21 @@ func ByCurrency(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCurrency, opts...).ToFunc()
 }
 
+// ByDefaultLocationID orders the results by the default_location_id field.
+func ByDefaultLocationID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDefaultLocationID, opts...).ToFunc()
+}
+
+// ByDepreciationMethod orders the results by the depreciation_method field.
+func ByDepreciationMethod(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDepreciationMethod, opts...).ToFunc()
+}
+
+// ByDepreciationYears orders the results by the depreciation_years field.
+func ByDepreciationYears(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDepreciationYears, opts...).ToFunc()
+}
+
 // ByUsersCount orders the results by users count.
 func ByUsersCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -304,6 +402,69 @@ func ByNotifiers(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newNotifiersStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByCurrencyConversionsCount orders the results by currency_conversions count.
+func ByCurrencyConversionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newCurrencyConversionsStep(), opts...)
+	}
+}
+
+// ByCurrencyConversions orders the results by currency_conversions terms.
+func ByCurrencyConversions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newCurrencyConversionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByItemEventsCount orders the results by item_events count.
+func ByItemEventsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newItemEventsStep(), opts...)
+	}
+}
+
+// ByItemEvents orders the results by item_events terms.
+func ByItemEvents(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newItemEventsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// BySavedSearchesCount orders the results by saved_searches count.
+func BySavedSearchesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSavedSearchesStep(), opts...)
+	}
+}
+
+// BySavedSearches orders the results by saved_searches terms.
+func BySavedSearches(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSavedSearchesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByItemTemplatesCount orders the results by item_templates count.
+func ByItemTemplatesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newItemTemplatesStep(), opts...)
+	}
+}
+
+// ByItemTemplates orders the results by item_templates terms.
+func ByItemTemplates(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newItemTemplatesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByDefaultLocationField orders the results by default_location field.
+func ByDefaultLocationField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newDefaultLocationStep(), sql.OrderByField(field, opts...))
+	}
+}
 func newUsersStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -353,3 +514,38 @@ func newNotifiersStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, NotifiersTable, NotifiersColumn),
 	)
 }
+func newCurrencyConversionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(CurrencyConversionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, CurrencyConversionsTable, CurrencyConversionsColumn),
+	)
+}
+func newItemEventsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ItemEventsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ItemEventsTable, ItemEventsColumn),
+	)
+}
+func newSavedSearchesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SavedSearchesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, SavedSearchesTable, SavedSearchesColumn),
+	)
+}
+func newItemTemplatesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ItemTemplatesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ItemTemplatesTable, ItemTemplatesColumn),
+	)
+}
+func newDefaultLocationStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(DefaultLocationInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, DefaultLocationTable, DefaultLocationColumn),
+	)
+}
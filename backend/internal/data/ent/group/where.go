@@ -71,6 +71,16 @@ func Name(v string) predicate.Group {
 	return predicate.Group(sql.FieldEQ(FieldName, v))
 }
 
+// DefaultLocationID applies equality check predicate on the "default_location_id" field. It's identical to DefaultLocationIDEQ.
+func DefaultLocationID(v uuid.UUID) predicate.Group {
+	return predicate.Group(sql.FieldEQ(FieldDefaultLocationID, v))
+}
+
+// DepreciationYears applies equality check predicate on the "depreciation_years" field. It's identical to DepreciationYearsEQ.
+func DepreciationYears(v int) predicate.Group {
+	return predicate.Group(sql.FieldEQ(FieldDepreciationYears, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Group {
 	return predicate.Group(sql.FieldEQ(FieldCreatedAt, v))
@@ -236,6 +246,96 @@ func CurrencyNotIn(vs ...Currency) predicate.Group {
 	return predicate.Group(sql.FieldNotIn(FieldCurrency, vs...))
 }
 
+// DefaultLocationIDEQ applies the EQ predicate on the "default_location_id" field.
+func DefaultLocationIDEQ(v uuid.UUID) predicate.Group {
+	return predicate.Group(sql.FieldEQ(FieldDefaultLocationID, v))
+}
+
+// DefaultLocationIDNEQ applies the NEQ predicate on the "default_location_id" field.
+func DefaultLocationIDNEQ(v uuid.UUID) predicate.Group {
+	return predicate.Group(sql.FieldNEQ(FieldDefaultLocationID, v))
+}
+
+// DefaultLocationIDIn applies the In predicate on the "default_location_id" field.
+func DefaultLocationIDIn(vs ...uuid.UUID) predicate.Group {
+	return predicate.Group(sql.FieldIn(FieldDefaultLocationID, vs...))
+}
+
+// DefaultLocationIDNotIn applies the NotIn predicate on the "default_location_id" field.
+func DefaultLocationIDNotIn(vs ...uuid.UUID) predicate.Group {
+	return predicate.Group(sql.FieldNotIn(FieldDefaultLocationID, vs...))
+}
+
+// DefaultLocationIDIsNil applies the IsNil predicate on the "default_location_id" field.
+func DefaultLocationIDIsNil() predicate.Group {
+	return predicate.Group(sql.FieldIsNull(FieldDefaultLocationID))
+}
+
+// DefaultLocationIDNotNil applies the NotNil predicate on the "default_location_id" field.
+func DefaultLocationIDNotNil() predicate.Group {
+	return predicate.Group(sql.FieldNotNull(FieldDefaultLocationID))
+}
+
+// DepreciationMethodEQ applies the EQ predicate on the "depreciation_method" field.
+func DepreciationMethodEQ(v DepreciationMethod) predicate.Group {
+	return predicate.Group(sql.FieldEQ(FieldDepreciationMethod, v))
+}
+
+// DepreciationMethodNEQ applies the NEQ predicate on the "depreciation_method" field.
+func DepreciationMethodNEQ(v DepreciationMethod) predicate.Group {
+	return predicate.Group(sql.FieldNEQ(FieldDepreciationMethod, v))
+}
+
+// DepreciationMethodIn applies the In predicate on the "depreciation_method" field.
+func DepreciationMethodIn(vs ...DepreciationMethod) predicate.Group {
+	return predicate.Group(sql.FieldIn(FieldDepreciationMethod, vs...))
+}
+
+// DepreciationMethodNotIn applies the NotIn predicate on the "depreciation_method" field.
+func DepreciationMethodNotIn(vs ...DepreciationMethod) predicate.Group {
+	return predicate.Group(sql.FieldNotIn(FieldDepreciationMethod, vs...))
+}
+
+// DepreciationYearsEQ applies the EQ predicate on the "depreciation_years" field.
+func DepreciationYearsEQ(v int) predicate.Group {
+	return predicate.Group(sql.FieldEQ(FieldDepreciationYears, v))
+}
+
+// DepreciationYearsNEQ applies the NEQ predicate on the "depreciation_years" field.
+func DepreciationYearsNEQ(v int) predicate.Group {
+	return predicate.Group(sql.FieldNEQ(FieldDepreciationYears, v))
+}
+
+// DepreciationYearsIn applies the In predicate on the "depreciation_years" field.
+func DepreciationYearsIn(vs ...int) predicate.Group {
+	return predicate.Group(sql.FieldIn(FieldDepreciationYears, vs...))
+}
+
+// DepreciationYearsNotIn applies the NotIn predicate on the "depreciation_years" field.
+func DepreciationYearsNotIn(vs ...int) predicate.Group {
+	return predicate.Group(sql.FieldNotIn(FieldDepreciationYears, vs...))
+}
+
+// DepreciationYearsGT applies the GT predicate on the "depreciation_years" field.
+func DepreciationYearsGT(v int) predicate.Group {
+	return predicate.Group(sql.FieldGT(FieldDepreciationYears, v))
+}
+
+// DepreciationYearsGTE applies the GTE predicate on the "depreciation_years" field.
+func DepreciationYearsGTE(v int) predicate.Group {
+	return predicate.Group(sql.FieldGTE(FieldDepreciationYears, v))
+}
+
+// DepreciationYearsLT applies the LT predicate on the "depreciation_years" field.
+func DepreciationYearsLT(v int) predicate.Group {
+	return predicate.Group(sql.FieldLT(FieldDepreciationYears, v))
+}
+
+// DepreciationYearsLTE applies the LTE predicate on the "depreciation_years" field.
+func DepreciationYearsLTE(v int) predicate.Group {
+	return predicate.Group(sql.FieldLTE(FieldDepreciationYears, v))
+}
+
 // HasUsers applies the HasEdge predicate on the "users" edge.
 func HasUsers() predicate.Group {
 	return predicate.Group(func(s *sql.Selector) {
@@ -397,6 +497,121 @@ func HasNotifiersWith(preds ...predicate.Notifier) predicate.Group {
 	})
 }
 
+// HasCurrencyConversions applies the HasEdge predicate on the "currency_conversions" edge.
+func HasCurrencyConversions() predicate.Group {
+	return predicate.Group(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, CurrencyConversionsTable, CurrencyConversionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCurrencyConversionsWith applies the HasEdge predicate on the "currency_conversions" edge with a given conditions (other predicates).
+func HasCurrencyConversionsWith(preds ...predicate.CurrencyConversion) predicate.Group {
+	return predicate.Group(func(s *sql.Selector) {
+		step := newCurrencyConversionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasItemEvents applies the HasEdge predicate on the "item_events" edge.
+func HasItemEvents() predicate.Group {
+	return predicate.Group(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ItemEventsTable, ItemEventsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasItemEventsWith applies the HasEdge predicate on the "item_events" edge with a given conditions (other predicates).
+func HasItemEventsWith(preds ...predicate.ItemEvent) predicate.Group {
+	return predicate.Group(func(s *sql.Selector) {
+		step := newItemEventsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasSavedSearches applies the HasEdge predicate on the "saved_searches" edge.
+func HasSavedSearches() predicate.Group {
+	return predicate.Group(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, SavedSearchesTable, SavedSearchesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSavedSearchesWith applies the HasEdge predicate on the "saved_searches" edge with a given conditions (other predicates).
+func HasSavedSearchesWith(preds ...predicate.SavedSearch) predicate.Group {
+	return predicate.Group(func(s *sql.Selector) {
+		step := newSavedSearchesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasItemTemplates applies the HasEdge predicate on the "item_templates" edge.
+func HasItemTemplates() predicate.Group {
+	return predicate.Group(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ItemTemplatesTable, ItemTemplatesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasItemTemplatesWith applies the HasEdge predicate on the "item_templates" edge with a given conditions (other predicates).
+func HasItemTemplatesWith(preds ...predicate.ItemTemplate) predicate.Group {
+	return predicate.Group(func(s *sql.Selector) {
+		step := newItemTemplatesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasDefaultLocation applies the HasEdge predicate on the "default_location" edge.
+func HasDefaultLocation() predicate.Group {
+	return predicate.Group(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, DefaultLocationTable, DefaultLocationColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasDefaultLocationWith applies the HasEdge predicate on the "default_location" edge with a given conditions (other predicates).
+func HasDefaultLocationWith(preds ...predicate.Location) predicate.Group {
+	return predicate.Group(func(s *sql.Selector) {
+		step := newDefaultLocationStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Group) predicate.Group {
 	return predicate.Group(sql.AndPredicates(predicates...))
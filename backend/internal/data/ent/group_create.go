@@ -11,13 +11,17 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/currencyconversion"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/document"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/group"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/groupinvitationtoken"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/item"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/itemevent"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/itemtemplate"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/label"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/location"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/notifier"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/savedsearch"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/user"
 )
 
@@ -76,6 +80,48 @@ func (gc *GroupCreate) SetNillableCurrency(gr *group.Currency) *GroupCreate {
 	return gc
 }
 
+// SetDefaultLocationID sets the "default_location_id" field.
+func (gc *GroupCreate) SetDefaultLocationID(u uuid.UUID) *GroupCreate {
+	gc.mutation.SetDefaultLocationID(u)
+	return gc
+}
+
+// SetNillableDefaultLocationID sets the "default_location_id" field if the given value is not nil.
+func (gc *GroupCreate) SetNillableDefaultLocationID(u *uuid.UUID) *GroupCreate {
+	if u != nil {
+		gc.SetDefaultLocationID(*u)
+	}
+	return gc
+}
+
+// SetDepreciationMethod sets the "depreciation_method" field.
+func (gc *GroupCreate) SetDepreciationMethod(gm group.DepreciationMethod) *GroupCreate {
+	gc.mutation.SetDepreciationMethod(gm)
+	return gc
+}
+
+// SetNillableDepreciationMethod sets the "depreciation_method" field if the given value is not nil.
+func (gc *GroupCreate) SetNillableDepreciationMethod(gm *group.DepreciationMethod) *GroupCreate {
+	if gm != nil {
+		gc.SetDepreciationMethod(*gm)
+	}
+	return gc
+}
+
+// SetDepreciationYears sets the "depreciation_years" field.
+func (gc *GroupCreate) SetDepreciationYears(i int) *GroupCreate {
+	gc.mutation.SetDepreciationYears(i)
+	return gc
+}
+
+// SetNillableDepreciationYears sets the "depreciation_years" field if the given value is not nil.
+func (gc *GroupCreate) SetNillableDepreciationYears(i *int) *GroupCreate {
+	if i != nil {
+		gc.SetDepreciationYears(*i)
+	}
+	return gc
+}
+
 // SetID sets the "id" field.
 func (gc *GroupCreate) SetID(u uuid.UUID) *GroupCreate {
 	gc.mutation.SetID(u)
@@ -195,6 +241,71 @@ func (gc *GroupCreate) AddNotifiers(n ...*Notifier) *GroupCreate {
 	return gc.AddNotifierIDs(ids...)
 }
 
+// AddCurrencyConversionIDs adds the "currency_conversions" edge to the CurrencyConversion entity by IDs.
+func (gc *GroupCreate) AddCurrencyConversionIDs(ids ...uuid.UUID) *GroupCreate {
+	gc.mutation.AddCurrencyConversionIDs(ids...)
+	return gc
+}
+
+// AddCurrencyConversions adds the "currency_conversions" edges to the CurrencyConversion entity.
+func (gc *GroupCreate) AddCurrencyConversions(c ...*CurrencyConversion) *GroupCreate {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return gc.AddCurrencyConversionIDs(ids...)
+}
+
+// AddItemEventIDs adds the "item_events" edge to the ItemEvent entity by IDs.
+func (gc *GroupCreate) AddItemEventIDs(ids ...uuid.UUID) *GroupCreate {
+	gc.mutation.AddItemEventIDs(ids...)
+	return gc
+}
+
+// AddItemEvents adds the "item_events" edges to the ItemEvent entity.
+func (gc *GroupCreate) AddItemEvents(i ...*ItemEvent) *GroupCreate {
+	ids := make([]uuid.UUID, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return gc.AddItemEventIDs(ids...)
+}
+
+// AddSavedSearchIDs adds the "saved_searches" edge to the SavedSearch entity by IDs.
+func (gc *GroupCreate) AddSavedSearchIDs(ids ...uuid.UUID) *GroupCreate {
+	gc.mutation.AddSavedSearchIDs(ids...)
+	return gc
+}
+
+// AddSavedSearches adds the "saved_searches" edges to the SavedSearch entity.
+func (gc *GroupCreate) AddSavedSearches(s ...*SavedSearch) *GroupCreate {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return gc.AddSavedSearchIDs(ids...)
+}
+
+// AddItemTemplateIDs adds the "item_templates" edge to the ItemTemplate entity by IDs.
+func (gc *GroupCreate) AddItemTemplateIDs(ids ...uuid.UUID) *GroupCreate {
+	gc.mutation.AddItemTemplateIDs(ids...)
+	return gc
+}
+
+// AddItemTemplates adds the "item_templates" edges to the ItemTemplate entity.
+func (gc *GroupCreate) AddItemTemplates(i ...*ItemTemplate) *GroupCreate {
+	ids := make([]uuid.UUID, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return gc.AddItemTemplateIDs(ids...)
+}
+
+// SetDefaultLocation sets the "default_location" edge to the Location entity.
+func (gc *GroupCreate) SetDefaultLocation(l *Location) *GroupCreate {
+	return gc.SetDefaultLocationID(l.ID)
+}
+
 // Mutation returns the GroupMutation object of the builder.
 func (gc *GroupCreate) Mutation() *GroupMutation {
 	return gc.mutation
@@ -242,6 +353,14 @@ func (gc *GroupCreate) defaults() {
 		v := group.DefaultCurrency
 		gc.mutation.SetCurrency(v)
 	}
+	if _, ok := gc.mutation.DepreciationMethod(); !ok {
+		v := group.DefaultDepreciationMethod
+		gc.mutation.SetDepreciationMethod(v)
+	}
+	if _, ok := gc.mutation.DepreciationYears(); !ok {
+		v := group.DefaultDepreciationYears
+		gc.mutation.SetDepreciationYears(v)
+	}
 	if _, ok := gc.mutation.ID(); !ok {
 		v := group.DefaultID()
 		gc.mutation.SetID(v)
@@ -272,6 +391,17 @@ func (gc *GroupCreate) check() error {
 			return &ValidationError{Name: "currency", err: fmt.Errorf(`ent: validator failed for field "Group.currency": %w`, err)}
 		}
 	}
+	if _, ok := gc.mutation.DepreciationMethod(); !ok {
+		return &ValidationError{Name: "depreciation_method", err: errors.New(`ent: missing required field "Group.depreciation_method"`)}
+	}
+	if v, ok := gc.mutation.DepreciationMethod(); ok {
+		if err := group.DepreciationMethodValidator(v); err != nil {
+			return &ValidationError{Name: "depreciation_method", err: fmt.Errorf(`ent: validator failed for field "Group.depreciation_method": %w`, err)}
+		}
+	}
+	if _, ok := gc.mutation.DepreciationYears(); !ok {
+		return &ValidationError{Name: "depreciation_years", err: errors.New(`ent: missing required field "Group.depreciation_years"`)}
+	}
 	return nil
 }
 
@@ -323,6 +453,14 @@ func (gc *GroupCreate) createSpec() (*Group, *sqlgraph.CreateSpec) {
 		_spec.SetField(group.FieldCurrency, field.TypeEnum, value)
 		_node.Currency = value
 	}
+	if value, ok := gc.mutation.DepreciationMethod(); ok {
+		_spec.SetField(group.FieldDepreciationMethod, field.TypeEnum, value)
+		_node.DepreciationMethod = value
+	}
+	if value, ok := gc.mutation.DepreciationYears(); ok {
+		_spec.SetField(group.FieldDepreciationYears, field.TypeInt, value)
+		_node.DepreciationYears = value
+	}
 	if nodes := gc.mutation.UsersIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -435,6 +573,87 @@ func (gc *GroupCreate) createSpec() (*Group, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := gc.mutation.CurrencyConversionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.CurrencyConversionsTable,
+			Columns: []string{group.CurrencyConversionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(currencyconversion.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := gc.mutation.ItemEventsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.ItemEventsTable,
+			Columns: []string{group.ItemEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemevent.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := gc.mutation.SavedSearchesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.SavedSearchesTable,
+			Columns: []string{group.SavedSearchesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedsearch.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := gc.mutation.ItemTemplatesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.ItemTemplatesTable,
+			Columns: []string{group.ItemTemplatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemtemplate.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := gc.mutation.DefaultLocationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   group.DefaultLocationTable,
+			Columns: []string{group.DefaultLocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(location.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.DefaultLocationID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
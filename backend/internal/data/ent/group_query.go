@@ -12,31 +12,40 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/currencyconversion"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/document"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/group"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/groupinvitationtoken"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/item"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/itemevent"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/itemtemplate"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/label"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/location"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/notifier"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/predicate"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/savedsearch"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/user"
 )
 
 // GroupQuery is the builder for querying Group entities.
 type GroupQuery struct {
 	config
-	ctx                  *QueryContext
-	order                []group.OrderOption
-	inters               []Interceptor
-	predicates           []predicate.Group
-	withUsers            *UserQuery
-	withLocations        *LocationQuery
-	withItems            *ItemQuery
-	withLabels           *LabelQuery
-	withDocuments        *DocumentQuery
-	withInvitationTokens *GroupInvitationTokenQuery
-	withNotifiers        *NotifierQuery
+	ctx                     *QueryContext
+	order                   []group.OrderOption
+	inters                  []Interceptor
+	predicates              []predicate.Group
+	withUsers               *UserQuery
+	withLocations           *LocationQuery
+	withItems               *ItemQuery
+	withLabels              *LabelQuery
+	withDocuments           *DocumentQuery
+	withInvitationTokens    *GroupInvitationTokenQuery
+	withNotifiers           *NotifierQuery
+	withCurrencyConversions *CurrencyConversionQuery
+	withItemEvents          *ItemEventQuery
+	withSavedSearches       *SavedSearchQuery
+	withItemTemplates       *ItemTemplateQuery
+	withDefaultLocation     *LocationQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -227,6 +236,116 @@ func (gq *GroupQuery) QueryNotifiers() *NotifierQuery {
 	return query
 }
 
+// QueryCurrencyConversions chains the current query on the "currency_conversions" edge.
+func (gq *GroupQuery) QueryCurrencyConversions() *CurrencyConversionQuery {
+	query := (&CurrencyConversionClient{config: gq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := gq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := gq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(group.Table, group.FieldID, selector),
+			sqlgraph.To(currencyconversion.Table, currencyconversion.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, group.CurrencyConversionsTable, group.CurrencyConversionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(gq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryItemEvents chains the current query on the "item_events" edge.
+func (gq *GroupQuery) QueryItemEvents() *ItemEventQuery {
+	query := (&ItemEventClient{config: gq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := gq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := gq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(group.Table, group.FieldID, selector),
+			sqlgraph.To(itemevent.Table, itemevent.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, group.ItemEventsTable, group.ItemEventsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(gq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QuerySavedSearches chains the current query on the "saved_searches" edge.
+func (gq *GroupQuery) QuerySavedSearches() *SavedSearchQuery {
+	query := (&SavedSearchClient{config: gq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := gq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := gq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(group.Table, group.FieldID, selector),
+			sqlgraph.To(savedsearch.Table, savedsearch.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, group.SavedSearchesTable, group.SavedSearchesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(gq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryItemTemplates chains the current query on the "item_templates" edge.
+func (gq *GroupQuery) QueryItemTemplates() *ItemTemplateQuery {
+	query := (&ItemTemplateClient{config: gq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := gq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := gq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(group.Table, group.FieldID, selector),
+			sqlgraph.To(itemtemplate.Table, itemtemplate.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, group.ItemTemplatesTable, group.ItemTemplatesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(gq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryDefaultLocation chains the current query on the "default_location" edge.
+func (gq *GroupQuery) QueryDefaultLocation() *LocationQuery {
+	query := (&LocationClient{config: gq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := gq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := gq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(group.Table, group.FieldID, selector),
+			sqlgraph.To(location.Table, location.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, group.DefaultLocationTable, group.DefaultLocationColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(gq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Group entity from the query.
 // Returns a *NotFoundError when no Group was found.
 func (gq *GroupQuery) First(ctx context.Context) (*Group, error) {
@@ -414,18 +533,23 @@ func (gq *GroupQuery) Clone() *GroupQuery {
 		return nil
 	}
 	return &GroupQuery{
-		config:               gq.config,
-		ctx:                  gq.ctx.Clone(),
-		order:                append([]group.OrderOption{}, gq.order...),
-		inters:               append([]Interceptor{}, gq.inters...),
-		predicates:           append([]predicate.Group{}, gq.predicates...),
-		withUsers:            gq.withUsers.Clone(),
-		withLocations:        gq.withLocations.Clone(),
-		withItems:            gq.withItems.Clone(),
-		withLabels:           gq.withLabels.Clone(),
-		withDocuments:        gq.withDocuments.Clone(),
-		withInvitationTokens: gq.withInvitationTokens.Clone(),
-		withNotifiers:        gq.withNotifiers.Clone(),
+		config:                  gq.config,
+		ctx:                     gq.ctx.Clone(),
+		order:                   append([]group.OrderOption{}, gq.order...),
+		inters:                  append([]Interceptor{}, gq.inters...),
+		predicates:              append([]predicate.Group{}, gq.predicates...),
+		withUsers:               gq.withUsers.Clone(),
+		withLocations:           gq.withLocations.Clone(),
+		withItems:               gq.withItems.Clone(),
+		withLabels:              gq.withLabels.Clone(),
+		withDocuments:           gq.withDocuments.Clone(),
+		withInvitationTokens:    gq.withInvitationTokens.Clone(),
+		withNotifiers:           gq.withNotifiers.Clone(),
+		withCurrencyConversions: gq.withCurrencyConversions.Clone(),
+		withItemEvents:          gq.withItemEvents.Clone(),
+		withSavedSearches:       gq.withSavedSearches.Clone(),
+		withItemTemplates:       gq.withItemTemplates.Clone(),
+		withDefaultLocation:     gq.withDefaultLocation.Clone(),
 		// clone intermediate query.
 		sql:  gq.sql.Clone(),
 		path: gq.path,
@@ -509,6 +633,61 @@ func (gq *GroupQuery) WithNotifiers(opts ...func(*NotifierQuery)) *GroupQuery {
 	return gq
 }
 
+// WithCurrencyConversions tells the query-builder to eager-load the nodes that are connected to
+// the "currency_conversions" edge. The optional arguments are used to configure the query builder of the edge.
+func (gq *GroupQuery) WithCurrencyConversions(opts ...func(*CurrencyConversionQuery)) *GroupQuery {
+	query := (&CurrencyConversionClient{config: gq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	gq.withCurrencyConversions = query
+	return gq
+}
+
+// WithItemEvents tells the query-builder to eager-load the nodes that are connected to
+// the "item_events" edge. The optional arguments are used to configure the query builder of the edge.
+func (gq *GroupQuery) WithItemEvents(opts ...func(*ItemEventQuery)) *GroupQuery {
+	query := (&ItemEventClient{config: gq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	gq.withItemEvents = query
+	return gq
+}
+
+// WithSavedSearches tells the query-builder to eager-load the nodes that are connected to
+// the "saved_searches" edge. The optional arguments are used to configure the query builder of the edge.
+func (gq *GroupQuery) WithSavedSearches(opts ...func(*SavedSearchQuery)) *GroupQuery {
+	query := (&SavedSearchClient{config: gq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	gq.withSavedSearches = query
+	return gq
+}
+
+// WithItemTemplates tells the query-builder to eager-load the nodes that are connected to
+// the "item_templates" edge. The optional arguments are used to configure the query builder of the edge.
+func (gq *GroupQuery) WithItemTemplates(opts ...func(*ItemTemplateQuery)) *GroupQuery {
+	query := (&ItemTemplateClient{config: gq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	gq.withItemTemplates = query
+	return gq
+}
+
+// WithDefaultLocation tells the query-builder to eager-load the nodes that are connected to
+// the "default_location" edge. The optional arguments are used to configure the query builder of the edge.
+func (gq *GroupQuery) WithDefaultLocation(opts ...func(*LocationQuery)) *GroupQuery {
+	query := (&LocationClient{config: gq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	gq.withDefaultLocation = query
+	return gq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -587,7 +766,7 @@ func (gq *GroupQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Group,
 	var (
 		nodes       = []*Group{}
 		_spec       = gq.querySpec()
-		loadedTypes = [7]bool{
+		loadedTypes = [12]bool{
 			gq.withUsers != nil,
 			gq.withLocations != nil,
 			gq.withItems != nil,
@@ -595,6 +774,11 @@ func (gq *GroupQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Group,
 			gq.withDocuments != nil,
 			gq.withInvitationTokens != nil,
 			gq.withNotifiers != nil,
+			gq.withCurrencyConversions != nil,
+			gq.withItemEvents != nil,
+			gq.withSavedSearches != nil,
+			gq.withItemTemplates != nil,
+			gq.withDefaultLocation != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -666,6 +850,42 @@ func (gq *GroupQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Group,
 			return nil, err
 		}
 	}
+	if query := gq.withCurrencyConversions; query != nil {
+		if err := gq.loadCurrencyConversions(ctx, query, nodes,
+			func(n *Group) { n.Edges.CurrencyConversions = []*CurrencyConversion{} },
+			func(n *Group, e *CurrencyConversion) {
+				n.Edges.CurrencyConversions = append(n.Edges.CurrencyConversions, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	if query := gq.withItemEvents; query != nil {
+		if err := gq.loadItemEvents(ctx, query, nodes,
+			func(n *Group) { n.Edges.ItemEvents = []*ItemEvent{} },
+			func(n *Group, e *ItemEvent) { n.Edges.ItemEvents = append(n.Edges.ItemEvents, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := gq.withSavedSearches; query != nil {
+		if err := gq.loadSavedSearches(ctx, query, nodes,
+			func(n *Group) { n.Edges.SavedSearches = []*SavedSearch{} },
+			func(n *Group, e *SavedSearch) { n.Edges.SavedSearches = append(n.Edges.SavedSearches, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := gq.withItemTemplates; query != nil {
+		if err := gq.loadItemTemplates(ctx, query, nodes,
+			func(n *Group) { n.Edges.ItemTemplates = []*ItemTemplate{} },
+			func(n *Group, e *ItemTemplate) { n.Edges.ItemTemplates = append(n.Edges.ItemTemplates, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := gq.withDefaultLocation; query != nil {
+		if err := gq.loadDefaultLocation(ctx, query, nodes, nil,
+			func(n *Group, e *Location) { n.Edges.DefaultLocation = e }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -885,6 +1105,162 @@ func (gq *GroupQuery) loadNotifiers(ctx context.Context, query *NotifierQuery, n
 	}
 	return nil
 }
+func (gq *GroupQuery) loadCurrencyConversions(ctx context.Context, query *CurrencyConversionQuery, nodes []*Group, init func(*Group), assign func(*Group, *CurrencyConversion)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Group)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.CurrencyConversion(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(group.CurrencyConversionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.group_currency_conversions
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "group_currency_conversions" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "group_currency_conversions" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (gq *GroupQuery) loadItemEvents(ctx context.Context, query *ItemEventQuery, nodes []*Group, init func(*Group), assign func(*Group, *ItemEvent)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Group)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.ItemEvent(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(group.ItemEventsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.group_item_events
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "group_item_events" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "group_item_events" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (gq *GroupQuery) loadSavedSearches(ctx context.Context, query *SavedSearchQuery, nodes []*Group, init func(*Group), assign func(*Group, *SavedSearch)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Group)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.SavedSearch(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(group.SavedSearchesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.group_saved_searches
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "group_saved_searches" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "group_saved_searches" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (gq *GroupQuery) loadItemTemplates(ctx context.Context, query *ItemTemplateQuery, nodes []*Group, init func(*Group), assign func(*Group, *ItemTemplate)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Group)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.ItemTemplate(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(group.ItemTemplatesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.group_item_templates
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "group_item_templates" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "group_item_templates" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (gq *GroupQuery) loadDefaultLocation(ctx context.Context, query *LocationQuery, nodes []*Group, init func(*Group), assign func(*Group, *Location)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*Group)
+	for i := range nodes {
+		if nodes[i].DefaultLocationID == nil {
+			continue
+		}
+		fk := *nodes[i].DefaultLocationID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(location.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "default_location_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
 
 func (gq *GroupQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := gq.querySpec()
@@ -911,6 +1287,9 @@ func (gq *GroupQuery) querySpec() *sqlgraph.QuerySpec {
 				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
 			}
 		}
+		if gq.withDefaultLocation != nil {
+			_spec.Node.AddColumnOnce(group.FieldDefaultLocationID)
+		}
 	}
 	if ps := gq.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -12,14 +12,18 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/currencyconversion"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/document"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/group"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/groupinvitationtoken"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/item"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/itemevent"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/itemtemplate"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/label"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/location"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/notifier"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/predicate"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/savedsearch"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/user"
 )
 
@@ -70,6 +74,61 @@ func (gu *GroupUpdate) SetNillableCurrency(gr *group.Currency) *GroupUpdate {
 	return gu
 }
 
+// SetDefaultLocationID sets the "default_location_id" field.
+func (gu *GroupUpdate) SetDefaultLocationID(u uuid.UUID) *GroupUpdate {
+	gu.mutation.SetDefaultLocationID(u)
+	return gu
+}
+
+// SetNillableDefaultLocationID sets the "default_location_id" field if the given value is not nil.
+func (gu *GroupUpdate) SetNillableDefaultLocationID(u *uuid.UUID) *GroupUpdate {
+	if u != nil {
+		gu.SetDefaultLocationID(*u)
+	}
+	return gu
+}
+
+// ClearDefaultLocationID clears the value of the "default_location_id" field.
+func (gu *GroupUpdate) ClearDefaultLocationID() *GroupUpdate {
+	gu.mutation.ClearDefaultLocationID()
+	return gu
+}
+
+// SetDepreciationMethod sets the "depreciation_method" field.
+func (gu *GroupUpdate) SetDepreciationMethod(gm group.DepreciationMethod) *GroupUpdate {
+	gu.mutation.SetDepreciationMethod(gm)
+	return gu
+}
+
+// SetNillableDepreciationMethod sets the "depreciation_method" field if the given value is not nil.
+func (gu *GroupUpdate) SetNillableDepreciationMethod(gm *group.DepreciationMethod) *GroupUpdate {
+	if gm != nil {
+		gu.SetDepreciationMethod(*gm)
+	}
+	return gu
+}
+
+// SetDepreciationYears sets the "depreciation_years" field.
+func (gu *GroupUpdate) SetDepreciationYears(i int) *GroupUpdate {
+	gu.mutation.ResetDepreciationYears()
+	gu.mutation.SetDepreciationYears(i)
+	return gu
+}
+
+// SetNillableDepreciationYears sets the "depreciation_years" field if the given value is not nil.
+func (gu *GroupUpdate) SetNillableDepreciationYears(i *int) *GroupUpdate {
+	if i != nil {
+		gu.SetDepreciationYears(*i)
+	}
+	return gu
+}
+
+// AddDepreciationYears adds i to the "depreciation_years" field.
+func (gu *GroupUpdate) AddDepreciationYears(i int) *GroupUpdate {
+	gu.mutation.AddDepreciationYears(i)
+	return gu
+}
+
 // AddUserIDs adds the "users" edge to the User entity by IDs.
 func (gu *GroupUpdate) AddUserIDs(ids ...uuid.UUID) *GroupUpdate {
 	gu.mutation.AddUserIDs(ids...)
@@ -175,6 +234,71 @@ func (gu *GroupUpdate) AddNotifiers(n ...*Notifier) *GroupUpdate {
 	return gu.AddNotifierIDs(ids...)
 }
 
+// AddCurrencyConversionIDs adds the "currency_conversions" edge to the CurrencyConversion entity by IDs.
+func (gu *GroupUpdate) AddCurrencyConversionIDs(ids ...uuid.UUID) *GroupUpdate {
+	gu.mutation.AddCurrencyConversionIDs(ids...)
+	return gu
+}
+
+// AddCurrencyConversions adds the "currency_conversions" edges to the CurrencyConversion entity.
+func (gu *GroupUpdate) AddCurrencyConversions(c ...*CurrencyConversion) *GroupUpdate {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return gu.AddCurrencyConversionIDs(ids...)
+}
+
+// AddItemEventIDs adds the "item_events" edge to the ItemEvent entity by IDs.
+func (gu *GroupUpdate) AddItemEventIDs(ids ...uuid.UUID) *GroupUpdate {
+	gu.mutation.AddItemEventIDs(ids...)
+	return gu
+}
+
+// AddItemEvents adds the "item_events" edges to the ItemEvent entity.
+func (gu *GroupUpdate) AddItemEvents(i ...*ItemEvent) *GroupUpdate {
+	ids := make([]uuid.UUID, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return gu.AddItemEventIDs(ids...)
+}
+
+// AddSavedSearchIDs adds the "saved_searches" edge to the SavedSearch entity by IDs.
+func (gu *GroupUpdate) AddSavedSearchIDs(ids ...uuid.UUID) *GroupUpdate {
+	gu.mutation.AddSavedSearchIDs(ids...)
+	return gu
+}
+
+// AddSavedSearches adds the "saved_searches" edges to the SavedSearch entity.
+func (gu *GroupUpdate) AddSavedSearches(s ...*SavedSearch) *GroupUpdate {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return gu.AddSavedSearchIDs(ids...)
+}
+
+// AddItemTemplateIDs adds the "item_templates" edge to the ItemTemplate entity by IDs.
+func (gu *GroupUpdate) AddItemTemplateIDs(ids ...uuid.UUID) *GroupUpdate {
+	gu.mutation.AddItemTemplateIDs(ids...)
+	return gu
+}
+
+// AddItemTemplates adds the "item_templates" edges to the ItemTemplate entity.
+func (gu *GroupUpdate) AddItemTemplates(i ...*ItemTemplate) *GroupUpdate {
+	ids := make([]uuid.UUID, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return gu.AddItemTemplateIDs(ids...)
+}
+
+// SetDefaultLocation sets the "default_location" edge to the Location entity.
+func (gu *GroupUpdate) SetDefaultLocation(l *Location) *GroupUpdate {
+	return gu.SetDefaultLocationID(l.ID)
+}
+
 // Mutation returns the GroupMutation object of the builder.
 func (gu *GroupUpdate) Mutation() *GroupMutation {
 	return gu.mutation
@@ -327,6 +451,96 @@ func (gu *GroupUpdate) RemoveNotifiers(n ...*Notifier) *GroupUpdate {
 	return gu.RemoveNotifierIDs(ids...)
 }
 
+// ClearCurrencyConversions clears all "currency_conversions" edges to the CurrencyConversion entity.
+func (gu *GroupUpdate) ClearCurrencyConversions() *GroupUpdate {
+	gu.mutation.ClearCurrencyConversions()
+	return gu
+}
+
+// RemoveCurrencyConversionIDs removes the "currency_conversions" edge to CurrencyConversion entities by IDs.
+func (gu *GroupUpdate) RemoveCurrencyConversionIDs(ids ...uuid.UUID) *GroupUpdate {
+	gu.mutation.RemoveCurrencyConversionIDs(ids...)
+	return gu
+}
+
+// RemoveCurrencyConversions removes "currency_conversions" edges to CurrencyConversion entities.
+func (gu *GroupUpdate) RemoveCurrencyConversions(c ...*CurrencyConversion) *GroupUpdate {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return gu.RemoveCurrencyConversionIDs(ids...)
+}
+
+// ClearItemEvents clears all "item_events" edges to the ItemEvent entity.
+func (gu *GroupUpdate) ClearItemEvents() *GroupUpdate {
+	gu.mutation.ClearItemEvents()
+	return gu
+}
+
+// RemoveItemEventIDs removes the "item_events" edge to ItemEvent entities by IDs.
+func (gu *GroupUpdate) RemoveItemEventIDs(ids ...uuid.UUID) *GroupUpdate {
+	gu.mutation.RemoveItemEventIDs(ids...)
+	return gu
+}
+
+// RemoveItemEvents removes "item_events" edges to ItemEvent entities.
+func (gu *GroupUpdate) RemoveItemEvents(i ...*ItemEvent) *GroupUpdate {
+	ids := make([]uuid.UUID, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return gu.RemoveItemEventIDs(ids...)
+}
+
+// ClearSavedSearches clears all "saved_searches" edges to the SavedSearch entity.
+func (gu *GroupUpdate) ClearSavedSearches() *GroupUpdate {
+	gu.mutation.ClearSavedSearches()
+	return gu
+}
+
+// RemoveSavedSearchIDs removes the "saved_searches" edge to SavedSearch entities by IDs.
+func (gu *GroupUpdate) RemoveSavedSearchIDs(ids ...uuid.UUID) *GroupUpdate {
+	gu.mutation.RemoveSavedSearchIDs(ids...)
+	return gu
+}
+
+// RemoveSavedSearches removes "saved_searches" edges to SavedSearch entities.
+func (gu *GroupUpdate) RemoveSavedSearches(s ...*SavedSearch) *GroupUpdate {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return gu.RemoveSavedSearchIDs(ids...)
+}
+
+// ClearItemTemplates clears all "item_templates" edges to the ItemTemplate entity.
+func (gu *GroupUpdate) ClearItemTemplates() *GroupUpdate {
+	gu.mutation.ClearItemTemplates()
+	return gu
+}
+
+// RemoveItemTemplateIDs removes the "item_templates" edge to ItemTemplate entities by IDs.
+func (gu *GroupUpdate) RemoveItemTemplateIDs(ids ...uuid.UUID) *GroupUpdate {
+	gu.mutation.RemoveItemTemplateIDs(ids...)
+	return gu
+}
+
+// RemoveItemTemplates removes "item_templates" edges to ItemTemplate entities.
+func (gu *GroupUpdate) RemoveItemTemplates(i ...*ItemTemplate) *GroupUpdate {
+	ids := make([]uuid.UUID, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return gu.RemoveItemTemplateIDs(ids...)
+}
+
+// ClearDefaultLocation clears the "default_location" edge to the Location entity.
+func (gu *GroupUpdate) ClearDefaultLocation() *GroupUpdate {
+	gu.mutation.ClearDefaultLocation()
+	return gu
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (gu *GroupUpdate) Save(ctx context.Context) (int, error) {
 	gu.defaults()
@@ -375,6 +589,11 @@ func (gu *GroupUpdate) check() error {
 			return &ValidationError{Name: "currency", err: fmt.Errorf(`ent: validator failed for field "Group.currency": %w`, err)}
 		}
 	}
+	if v, ok := gu.mutation.DepreciationMethod(); ok {
+		if err := group.DepreciationMethodValidator(v); err != nil {
+			return &ValidationError{Name: "depreciation_method", err: fmt.Errorf(`ent: validator failed for field "Group.depreciation_method": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -399,6 +618,15 @@ func (gu *GroupUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := gu.mutation.Currency(); ok {
 		_spec.SetField(group.FieldCurrency, field.TypeEnum, value)
 	}
+	if value, ok := gu.mutation.DepreciationMethod(); ok {
+		_spec.SetField(group.FieldDepreciationMethod, field.TypeEnum, value)
+	}
+	if value, ok := gu.mutation.DepreciationYears(); ok {
+		_spec.SetField(group.FieldDepreciationYears, field.TypeInt, value)
+	}
+	if value, ok := gu.mutation.AddedDepreciationYears(); ok {
+		_spec.AddField(group.FieldDepreciationYears, field.TypeInt, value)
+	}
 	if gu.mutation.UsersCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -714,6 +942,215 @@ func (gu *GroupUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if gu.mutation.CurrencyConversionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.CurrencyConversionsTable,
+			Columns: []string{group.CurrencyConversionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(currencyconversion.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := gu.mutation.RemovedCurrencyConversionsIDs(); len(nodes) > 0 && !gu.mutation.CurrencyConversionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.CurrencyConversionsTable,
+			Columns: []string{group.CurrencyConversionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(currencyconversion.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := gu.mutation.CurrencyConversionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.CurrencyConversionsTable,
+			Columns: []string{group.CurrencyConversionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(currencyconversion.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if gu.mutation.ItemEventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.ItemEventsTable,
+			Columns: []string{group.ItemEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemevent.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := gu.mutation.RemovedItemEventsIDs(); len(nodes) > 0 && !gu.mutation.ItemEventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.ItemEventsTable,
+			Columns: []string{group.ItemEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemevent.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := gu.mutation.ItemEventsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.ItemEventsTable,
+			Columns: []string{group.ItemEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemevent.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if gu.mutation.SavedSearchesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.SavedSearchesTable,
+			Columns: []string{group.SavedSearchesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedsearch.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := gu.mutation.RemovedSavedSearchesIDs(); len(nodes) > 0 && !gu.mutation.SavedSearchesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.SavedSearchesTable,
+			Columns: []string{group.SavedSearchesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedsearch.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := gu.mutation.SavedSearchesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.SavedSearchesTable,
+			Columns: []string{group.SavedSearchesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedsearch.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if gu.mutation.ItemTemplatesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.ItemTemplatesTable,
+			Columns: []string{group.ItemTemplatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemtemplate.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := gu.mutation.RemovedItemTemplatesIDs(); len(nodes) > 0 && !gu.mutation.ItemTemplatesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.ItemTemplatesTable,
+			Columns: []string{group.ItemTemplatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemtemplate.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := gu.mutation.ItemTemplatesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.ItemTemplatesTable,
+			Columns: []string{group.ItemTemplatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemtemplate.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if gu.mutation.DefaultLocationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   group.DefaultLocationTable,
+			Columns: []string{group.DefaultLocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(location.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := gu.mutation.DefaultLocationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   group.DefaultLocationTable,
+			Columns: []string{group.DefaultLocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(location.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, gu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{group.Label}
@@ -734,40 +1171,95 @@ type GroupUpdateOne struct {
 	mutation *GroupMutation
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (guo *GroupUpdateOne) SetUpdatedAt(t time.Time) *GroupUpdateOne {
-	guo.mutation.SetUpdatedAt(t)
+// SetUpdatedAt sets the "updated_at" field.
+func (guo *GroupUpdateOne) SetUpdatedAt(t time.Time) *GroupUpdateOne {
+	guo.mutation.SetUpdatedAt(t)
+	return guo
+}
+
+// SetName sets the "name" field.
+func (guo *GroupUpdateOne) SetName(s string) *GroupUpdateOne {
+	guo.mutation.SetName(s)
+	return guo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (guo *GroupUpdateOne) SetNillableName(s *string) *GroupUpdateOne {
+	if s != nil {
+		guo.SetName(*s)
+	}
+	return guo
+}
+
+// SetCurrency sets the "currency" field.
+func (guo *GroupUpdateOne) SetCurrency(gr group.Currency) *GroupUpdateOne {
+	guo.mutation.SetCurrency(gr)
+	return guo
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (guo *GroupUpdateOne) SetNillableCurrency(gr *group.Currency) *GroupUpdateOne {
+	if gr != nil {
+		guo.SetCurrency(*gr)
+	}
+	return guo
+}
+
+// SetDefaultLocationID sets the "default_location_id" field.
+func (guo *GroupUpdateOne) SetDefaultLocationID(u uuid.UUID) *GroupUpdateOne {
+	guo.mutation.SetDefaultLocationID(u)
+	return guo
+}
+
+// SetNillableDefaultLocationID sets the "default_location_id" field if the given value is not nil.
+func (guo *GroupUpdateOne) SetNillableDefaultLocationID(u *uuid.UUID) *GroupUpdateOne {
+	if u != nil {
+		guo.SetDefaultLocationID(*u)
+	}
+	return guo
+}
+
+// ClearDefaultLocationID clears the value of the "default_location_id" field.
+func (guo *GroupUpdateOne) ClearDefaultLocationID() *GroupUpdateOne {
+	guo.mutation.ClearDefaultLocationID()
 	return guo
 }
 
-// SetName sets the "name" field.
-func (guo *GroupUpdateOne) SetName(s string) *GroupUpdateOne {
-	guo.mutation.SetName(s)
+// SetDepreciationMethod sets the "depreciation_method" field.
+func (guo *GroupUpdateOne) SetDepreciationMethod(gm group.DepreciationMethod) *GroupUpdateOne {
+	guo.mutation.SetDepreciationMethod(gm)
 	return guo
 }
 
-// SetNillableName sets the "name" field if the given value is not nil.
-func (guo *GroupUpdateOne) SetNillableName(s *string) *GroupUpdateOne {
-	if s != nil {
-		guo.SetName(*s)
+// SetNillableDepreciationMethod sets the "depreciation_method" field if the given value is not nil.
+func (guo *GroupUpdateOne) SetNillableDepreciationMethod(gm *group.DepreciationMethod) *GroupUpdateOne {
+	if gm != nil {
+		guo.SetDepreciationMethod(*gm)
 	}
 	return guo
 }
 
-// SetCurrency sets the "currency" field.
-func (guo *GroupUpdateOne) SetCurrency(gr group.Currency) *GroupUpdateOne {
-	guo.mutation.SetCurrency(gr)
+// SetDepreciationYears sets the "depreciation_years" field.
+func (guo *GroupUpdateOne) SetDepreciationYears(i int) *GroupUpdateOne {
+	guo.mutation.ResetDepreciationYears()
+	guo.mutation.SetDepreciationYears(i)
 	return guo
 }
 
-// SetNillableCurrency sets the "currency" field if the given value is not nil.
-func (guo *GroupUpdateOne) SetNillableCurrency(gr *group.Currency) *GroupUpdateOne {
-	if gr != nil {
-		guo.SetCurrency(*gr)
+// SetNillableDepreciationYears sets the "depreciation_years" field if the given value is not nil.
+func (guo *GroupUpdateOne) SetNillableDepreciationYears(i *int) *GroupUpdateOne {
+	if i != nil {
+		guo.SetDepreciationYears(*i)
 	}
 	return guo
 }
 
+// AddDepreciationYears adds i to the "depreciation_years" field.
+func (guo *GroupUpdateOne) AddDepreciationYears(i int) *GroupUpdateOne {
+	guo.mutation.AddDepreciationYears(i)
+	return guo
+}
+
 // AddUserIDs adds the "users" edge to the User entity by IDs.
 func (guo *GroupUpdateOne) AddUserIDs(ids ...uuid.UUID) *GroupUpdateOne {
 	guo.mutation.AddUserIDs(ids...)
@@ -873,6 +1365,71 @@ func (guo *GroupUpdateOne) AddNotifiers(n ...*Notifier) *GroupUpdateOne {
 	return guo.AddNotifierIDs(ids...)
 }
 
+// AddCurrencyConversionIDs adds the "currency_conversions" edge to the CurrencyConversion entity by IDs.
+func (guo *GroupUpdateOne) AddCurrencyConversionIDs(ids ...uuid.UUID) *GroupUpdateOne {
+	guo.mutation.AddCurrencyConversionIDs(ids...)
+	return guo
+}
+
+// AddCurrencyConversions adds the "currency_conversions" edges to the CurrencyConversion entity.
+func (guo *GroupUpdateOne) AddCurrencyConversions(c ...*CurrencyConversion) *GroupUpdateOne {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return guo.AddCurrencyConversionIDs(ids...)
+}
+
+// AddItemEventIDs adds the "item_events" edge to the ItemEvent entity by IDs.
+func (guo *GroupUpdateOne) AddItemEventIDs(ids ...uuid.UUID) *GroupUpdateOne {
+	guo.mutation.AddItemEventIDs(ids...)
+	return guo
+}
+
+// AddItemEvents adds the "item_events" edges to the ItemEvent entity.
+func (guo *GroupUpdateOne) AddItemEvents(i ...*ItemEvent) *GroupUpdateOne {
+	ids := make([]uuid.UUID, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return guo.AddItemEventIDs(ids...)
+}
+
+// AddSavedSearchIDs adds the "saved_searches" edge to the SavedSearch entity by IDs.
+func (guo *GroupUpdateOne) AddSavedSearchIDs(ids ...uuid.UUID) *GroupUpdateOne {
+	guo.mutation.AddSavedSearchIDs(ids...)
+	return guo
+}
+
+// AddSavedSearches adds the "saved_searches" edges to the SavedSearch entity.
+func (guo *GroupUpdateOne) AddSavedSearches(s ...*SavedSearch) *GroupUpdateOne {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return guo.AddSavedSearchIDs(ids...)
+}
+
+// AddItemTemplateIDs adds the "item_templates" edge to the ItemTemplate entity by IDs.
+func (guo *GroupUpdateOne) AddItemTemplateIDs(ids ...uuid.UUID) *GroupUpdateOne {
+	guo.mutation.AddItemTemplateIDs(ids...)
+	return guo
+}
+
+// AddItemTemplates adds the "item_templates" edges to the ItemTemplate entity.
+func (guo *GroupUpdateOne) AddItemTemplates(i ...*ItemTemplate) *GroupUpdateOne {
+	ids := make([]uuid.UUID, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return guo.AddItemTemplateIDs(ids...)
+}
+
+// SetDefaultLocation sets the "default_location" edge to the Location entity.
+func (guo *GroupUpdateOne) SetDefaultLocation(l *Location) *GroupUpdateOne {
+	return guo.SetDefaultLocationID(l.ID)
+}
+
 // Mutation returns the GroupMutation object of the builder.
 func (guo *GroupUpdateOne) Mutation() *GroupMutation {
 	return guo.mutation
@@ -1025,6 +1582,96 @@ func (guo *GroupUpdateOne) RemoveNotifiers(n ...*Notifier) *GroupUpdateOne {
 	return guo.RemoveNotifierIDs(ids...)
 }
 
+// ClearCurrencyConversions clears all "currency_conversions" edges to the CurrencyConversion entity.
+func (guo *GroupUpdateOne) ClearCurrencyConversions() *GroupUpdateOne {
+	guo.mutation.ClearCurrencyConversions()
+	return guo
+}
+
+// RemoveCurrencyConversionIDs removes the "currency_conversions" edge to CurrencyConversion entities by IDs.
+func (guo *GroupUpdateOne) RemoveCurrencyConversionIDs(ids ...uuid.UUID) *GroupUpdateOne {
+	guo.mutation.RemoveCurrencyConversionIDs(ids...)
+	return guo
+}
+
+// RemoveCurrencyConversions removes "currency_conversions" edges to CurrencyConversion entities.
+func (guo *GroupUpdateOne) RemoveCurrencyConversions(c ...*CurrencyConversion) *GroupUpdateOne {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return guo.RemoveCurrencyConversionIDs(ids...)
+}
+
+// ClearItemEvents clears all "item_events" edges to the ItemEvent entity.
+func (guo *GroupUpdateOne) ClearItemEvents() *GroupUpdateOne {
+	guo.mutation.ClearItemEvents()
+	return guo
+}
+
+// RemoveItemEventIDs removes the "item_events" edge to ItemEvent entities by IDs.
+func (guo *GroupUpdateOne) RemoveItemEventIDs(ids ...uuid.UUID) *GroupUpdateOne {
+	guo.mutation.RemoveItemEventIDs(ids...)
+	return guo
+}
+
+// RemoveItemEvents removes "item_events" edges to ItemEvent entities.
+func (guo *GroupUpdateOne) RemoveItemEvents(i ...*ItemEvent) *GroupUpdateOne {
+	ids := make([]uuid.UUID, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return guo.RemoveItemEventIDs(ids...)
+}
+
+// ClearSavedSearches clears all "saved_searches" edges to the SavedSearch entity.
+func (guo *GroupUpdateOne) ClearSavedSearches() *GroupUpdateOne {
+	guo.mutation.ClearSavedSearches()
+	return guo
+}
+
+// RemoveSavedSearchIDs removes the "saved_searches" edge to SavedSearch entities by IDs.
+func (guo *GroupUpdateOne) RemoveSavedSearchIDs(ids ...uuid.UUID) *GroupUpdateOne {
+	guo.mutation.RemoveSavedSearchIDs(ids...)
+	return guo
+}
+
+// RemoveSavedSearches removes "saved_searches" edges to SavedSearch entities.
+func (guo *GroupUpdateOne) RemoveSavedSearches(s ...*SavedSearch) *GroupUpdateOne {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return guo.RemoveSavedSearchIDs(ids...)
+}
+
+// ClearItemTemplates clears all "item_templates" edges to the ItemTemplate entity.
+func (guo *GroupUpdateOne) ClearItemTemplates() *GroupUpdateOne {
+	guo.mutation.ClearItemTemplates()
+	return guo
+}
+
+// RemoveItemTemplateIDs removes the "item_templates" edge to ItemTemplate entities by IDs.
+func (guo *GroupUpdateOne) RemoveItemTemplateIDs(ids ...uuid.UUID) *GroupUpdateOne {
+	guo.mutation.RemoveItemTemplateIDs(ids...)
+	return guo
+}
+
+// RemoveItemTemplates removes "item_templates" edges to ItemTemplate entities.
+func (guo *GroupUpdateOne) RemoveItemTemplates(i ...*ItemTemplate) *GroupUpdateOne {
+	ids := make([]uuid.UUID, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return guo.RemoveItemTemplateIDs(ids...)
+}
+
+// ClearDefaultLocation clears the "default_location" edge to the Location entity.
+func (guo *GroupUpdateOne) ClearDefaultLocation() *GroupUpdateOne {
+	guo.mutation.ClearDefaultLocation()
+	return guo
+}
+
 // Where appends a list predicates to the GroupUpdate builder.
 func (guo *GroupUpdateOne) Where(ps ...predicate.Group) *GroupUpdateOne {
 	guo.mutation.Where(ps...)
@@ -1086,6 +1733,11 @@ func (guo *GroupUpdateOne) check() error {
 			return &ValidationError{Name: "currency", err: fmt.Errorf(`ent: validator failed for field "Group.currency": %w`, err)}
 		}
 	}
+	if v, ok := guo.mutation.DepreciationMethod(); ok {
+		if err := group.DepreciationMethodValidator(v); err != nil {
+			return &ValidationError{Name: "depreciation_method", err: fmt.Errorf(`ent: validator failed for field "Group.depreciation_method": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -1127,6 +1779,15 @@ func (guo *GroupUpdateOne) sqlSave(ctx context.Context) (_node *Group, err error
 	if value, ok := guo.mutation.Currency(); ok {
 		_spec.SetField(group.FieldCurrency, field.TypeEnum, value)
 	}
+	if value, ok := guo.mutation.DepreciationMethod(); ok {
+		_spec.SetField(group.FieldDepreciationMethod, field.TypeEnum, value)
+	}
+	if value, ok := guo.mutation.DepreciationYears(); ok {
+		_spec.SetField(group.FieldDepreciationYears, field.TypeInt, value)
+	}
+	if value, ok := guo.mutation.AddedDepreciationYears(); ok {
+		_spec.AddField(group.FieldDepreciationYears, field.TypeInt, value)
+	}
 	if guo.mutation.UsersCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -1442,6 +2103,215 @@ func (guo *GroupUpdateOne) sqlSave(ctx context.Context) (_node *Group, err error
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if guo.mutation.CurrencyConversionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.CurrencyConversionsTable,
+			Columns: []string{group.CurrencyConversionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(currencyconversion.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := guo.mutation.RemovedCurrencyConversionsIDs(); len(nodes) > 0 && !guo.mutation.CurrencyConversionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.CurrencyConversionsTable,
+			Columns: []string{group.CurrencyConversionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(currencyconversion.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := guo.mutation.CurrencyConversionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.CurrencyConversionsTable,
+			Columns: []string{group.CurrencyConversionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(currencyconversion.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if guo.mutation.ItemEventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.ItemEventsTable,
+			Columns: []string{group.ItemEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemevent.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := guo.mutation.RemovedItemEventsIDs(); len(nodes) > 0 && !guo.mutation.ItemEventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.ItemEventsTable,
+			Columns: []string{group.ItemEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemevent.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := guo.mutation.ItemEventsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.ItemEventsTable,
+			Columns: []string{group.ItemEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemevent.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if guo.mutation.SavedSearchesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.SavedSearchesTable,
+			Columns: []string{group.SavedSearchesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedsearch.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := guo.mutation.RemovedSavedSearchesIDs(); len(nodes) > 0 && !guo.mutation.SavedSearchesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.SavedSearchesTable,
+			Columns: []string{group.SavedSearchesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedsearch.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := guo.mutation.SavedSearchesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.SavedSearchesTable,
+			Columns: []string{group.SavedSearchesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedsearch.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if guo.mutation.ItemTemplatesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.ItemTemplatesTable,
+			Columns: []string{group.ItemTemplatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemtemplate.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := guo.mutation.RemovedItemTemplatesIDs(); len(nodes) > 0 && !guo.mutation.ItemTemplatesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.ItemTemplatesTable,
+			Columns: []string{group.ItemTemplatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemtemplate.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := guo.mutation.ItemTemplatesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.ItemTemplatesTable,
+			Columns: []string{group.ItemTemplatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemtemplate.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if guo.mutation.DefaultLocationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   group.DefaultLocationTable,
+			Columns: []string{group.DefaultLocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(location.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := guo.mutation.DefaultLocationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   group.DefaultLocationTable,
+			Columns: []string{group.DefaultLocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(location.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Group{config: guo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
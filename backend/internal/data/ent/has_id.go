@@ -16,6 +16,10 @@ func (at *AuthTokens) GetID() uuid.UUID {
 	return at.ID
 }
 
+func (cc *CurrencyConversion) GetID() uuid.UUID {
+	return cc.ID
+}
+
 func (d *Document) GetID() uuid.UUID {
 	return d.ID
 }
@@ -32,10 +36,22 @@ func (i *Item) GetID() uuid.UUID {
 	return i.ID
 }
 
+func (ic *ItemComment) GetID() uuid.UUID {
+	return ic.ID
+}
+
+func (ie *ItemEvent) GetID() uuid.UUID {
+	return ie.ID
+}
+
 func (_if *ItemField) GetID() uuid.UUID {
 	return _if.ID
 }
 
+func (it *ItemTemplate) GetID() uuid.UUID {
+	return it.ID
+}
+
 func (l *Label) GetID() uuid.UUID {
 	return l.ID
 }
@@ -52,6 +68,10 @@ func (n *Notifier) GetID() uuid.UUID {
 	return n.ID
 }
 
+func (ss *SavedSearch) GetID() uuid.UUID {
+	return ss.ID
+}
+
 func (u *User) GetID() uuid.UUID {
 	return u.ID
 }
@@ -3,6 +3,7 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -32,16 +33,38 @@ type Item struct {
 	ImportRef string `json:"import_ref,omitempty"`
 	// Notes holds the value of the "notes" field.
 	Notes string `json:"notes,omitempty"`
+	// NotesFormat holds the value of the "notes_format" field.
+	NotesFormat item.NotesFormat `json:"notes_format,omitempty"`
 	// Quantity holds the value of the "quantity" field.
 	Quantity int `json:"quantity,omitempty"`
 	// Insured holds the value of the "insured" field.
 	Insured bool `json:"insured,omitempty"`
 	// Archived holds the value of the "archived" field.
 	Archived bool `json:"archived,omitempty"`
+	// Favorite holds the value of the "favorite" field.
+	Favorite bool `json:"favorite,omitempty"`
+	// Version holds the value of the "version" field.
+	Version int `json:"version,omitempty"`
+	// ArchivedAt holds the value of the "archived_at" field.
+	ArchivedAt *time.Time `json:"archived_at,omitempty"`
 	// AssetID holds the value of the "asset_id" field.
 	AssetID int `json:"asset_id,omitempty"`
+	// ReorderThreshold holds the value of the "reorder_threshold" field.
+	ReorderThreshold int `json:"reorder_threshold,omitempty"`
+	// Tags holds the value of the "tags" field.
+	Tags []string `json:"tags,omitempty"`
+	// Source holds the value of the "source" field.
+	Source item.Source `json:"source,omitempty"`
+	// AcquisitionType holds the value of the "acquisition_type" field.
+	AcquisitionType item.AcquisitionType `json:"acquisition_type,omitempty"`
+	// Latitude holds the value of the "latitude" field.
+	Latitude *float64 `json:"latitude,omitempty"`
+	// Longitude holds the value of the "longitude" field.
+	Longitude *float64 `json:"longitude,omitempty"`
 	// SerialNumber holds the value of the "serial_number" field.
 	SerialNumber string `json:"serial_number,omitempty"`
+	// Barcode holds the value of the "barcode" field.
+	Barcode string `json:"barcode,omitempty"`
 	// ModelNumber holds the value of the "model_number" field.
 	ModelNumber string `json:"model_number,omitempty"`
 	// Manufacturer holds the value of the "manufacturer" field.
@@ -56,8 +79,20 @@ type Item struct {
 	PurchaseTime time.Time `json:"purchase_time,omitempty"`
 	// PurchaseFrom holds the value of the "purchase_from" field.
 	PurchaseFrom string `json:"purchase_from,omitempty"`
+	// PurchaseOrderNumber holds the value of the "purchase_order_number" field.
+	PurchaseOrderNumber string `json:"purchase_order_number,omitempty"`
 	// PurchasePrice holds the value of the "purchase_price" field.
 	PurchasePrice float64 `json:"purchase_price,omitempty"`
+	// Currency holds the value of the "currency" field.
+	Currency string `json:"currency,omitempty"`
+	// ReplacementCost holds the value of the "replacement_cost" field.
+	ReplacementCost float64 `json:"replacement_cost,omitempty"`
+	// LoanedTo holds the value of the "loaned_to" field.
+	LoanedTo string `json:"loaned_to,omitempty"`
+	// LoanedAt holds the value of the "loaned_at" field.
+	LoanedAt *time.Time `json:"loaned_at,omitempty"`
+	// LoanDue holds the value of the "loan_due" field.
+	LoanDue *time.Time `json:"loan_due,omitempty"`
 	// SoldTime holds the value of the "sold_time" field.
 	SoldTime time.Time `json:"sold_time,omitempty"`
 	// SoldTo holds the value of the "sold_to" field.
@@ -83,6 +118,8 @@ type ItemEdges struct {
 	Parent *Item `json:"parent,omitempty"`
 	// Children holds the value of the children edge.
 	Children []*Item `json:"children,omitempty"`
+	// Related holds the value of the related edge.
+	Related []*Item `json:"related,omitempty"`
 	// Label holds the value of the label edge.
 	Label []*Label `json:"label,omitempty"`
 	// Location holds the value of the location edge.
@@ -93,9 +130,11 @@ type ItemEdges struct {
 	MaintenanceEntries []*MaintenanceEntry `json:"maintenance_entries,omitempty"`
 	// Attachments holds the value of the attachments edge.
 	Attachments []*Attachment `json:"attachments,omitempty"`
+	// Comments holds the value of the comments edge.
+	Comments []*ItemComment `json:"comments,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [8]bool
+	loadedTypes [10]bool
 }
 
 // GroupOrErr returns the Group value or an error if the edge
@@ -133,10 +172,19 @@ func (e ItemEdges) ChildrenOrErr() ([]*Item, error) {
 	return nil, &NotLoadedError{edge: "children"}
 }
 
+// RelatedOrErr returns the Related value or an error if the edge
+// was not loaded in eager-loading.
+func (e ItemEdges) RelatedOrErr() ([]*Item, error) {
+	if e.loadedTypes[3] {
+		return e.Related, nil
+	}
+	return nil, &NotLoadedError{edge: "related"}
+}
+
 // LabelOrErr returns the Label value or an error if the edge
 // was not loaded in eager-loading.
 func (e ItemEdges) LabelOrErr() ([]*Label, error) {
-	if e.loadedTypes[3] {
+	if e.loadedTypes[4] {
 		return e.Label, nil
 	}
 	return nil, &NotLoadedError{edge: "label"}
@@ -145,7 +193,7 @@ func (e ItemEdges) LabelOrErr() ([]*Label, error) {
 // LocationOrErr returns the Location value or an error if the edge
 // was not loaded in eager-loading, or loaded but was not found.
 func (e ItemEdges) LocationOrErr() (*Location, error) {
-	if e.loadedTypes[4] {
+	if e.loadedTypes[5] {
 		if e.Location == nil {
 			// Edge was loaded but was not found.
 			return nil, &NotFoundError{label: location.Label}
@@ -158,7 +206,7 @@ func (e ItemEdges) LocationOrErr() (*Location, error) {
 // FieldsOrErr returns the Fields value or an error if the edge
 // was not loaded in eager-loading.
 func (e ItemEdges) FieldsOrErr() ([]*ItemField, error) {
-	if e.loadedTypes[5] {
+	if e.loadedTypes[6] {
 		return e.Fields, nil
 	}
 	return nil, &NotLoadedError{edge: "fields"}
@@ -167,7 +215,7 @@ func (e ItemEdges) FieldsOrErr() ([]*ItemField, error) {
 // MaintenanceEntriesOrErr returns the MaintenanceEntries value or an error if the edge
 // was not loaded in eager-loading.
 func (e ItemEdges) MaintenanceEntriesOrErr() ([]*MaintenanceEntry, error) {
-	if e.loadedTypes[6] {
+	if e.loadedTypes[7] {
 		return e.MaintenanceEntries, nil
 	}
 	return nil, &NotLoadedError{edge: "maintenance_entries"}
@@ -176,26 +224,37 @@ func (e ItemEdges) MaintenanceEntriesOrErr() ([]*MaintenanceEntry, error) {
 // AttachmentsOrErr returns the Attachments value or an error if the edge
 // was not loaded in eager-loading.
 func (e ItemEdges) AttachmentsOrErr() ([]*Attachment, error) {
-	if e.loadedTypes[7] {
+	if e.loadedTypes[8] {
 		return e.Attachments, nil
 	}
 	return nil, &NotLoadedError{edge: "attachments"}
 }
 
+// CommentsOrErr returns the Comments value or an error if the edge
+// was not loaded in eager-loading.
+func (e ItemEdges) CommentsOrErr() ([]*ItemComment, error) {
+	if e.loadedTypes[9] {
+		return e.Comments, nil
+	}
+	return nil, &NotLoadedError{edge: "comments"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Item) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case item.FieldInsured, item.FieldArchived, item.FieldLifetimeWarranty:
+		case item.FieldTags:
+			values[i] = new([]byte)
+		case item.FieldInsured, item.FieldArchived, item.FieldFavorite, item.FieldLifetimeWarranty:
 			values[i] = new(sql.NullBool)
-		case item.FieldPurchasePrice, item.FieldSoldPrice:
+		case item.FieldLatitude, item.FieldLongitude, item.FieldPurchasePrice, item.FieldReplacementCost, item.FieldSoldPrice:
 			values[i] = new(sql.NullFloat64)
-		case item.FieldQuantity, item.FieldAssetID:
+		case item.FieldQuantity, item.FieldVersion, item.FieldAssetID, item.FieldReorderThreshold:
 			values[i] = new(sql.NullInt64)
-		case item.FieldName, item.FieldDescription, item.FieldImportRef, item.FieldNotes, item.FieldSerialNumber, item.FieldModelNumber, item.FieldManufacturer, item.FieldWarrantyDetails, item.FieldPurchaseFrom, item.FieldSoldTo, item.FieldSoldNotes:
+		case item.FieldName, item.FieldDescription, item.FieldImportRef, item.FieldNotes, item.FieldNotesFormat, item.FieldSource, item.FieldAcquisitionType, item.FieldSerialNumber, item.FieldBarcode, item.FieldModelNumber, item.FieldManufacturer, item.FieldWarrantyDetails, item.FieldPurchaseFrom, item.FieldPurchaseOrderNumber, item.FieldCurrency, item.FieldLoanedTo, item.FieldSoldTo, item.FieldSoldNotes:
 			values[i] = new(sql.NullString)
-		case item.FieldCreatedAt, item.FieldUpdatedAt, item.FieldWarrantyExpires, item.FieldPurchaseTime, item.FieldSoldTime:
+		case item.FieldCreatedAt, item.FieldUpdatedAt, item.FieldArchivedAt, item.FieldWarrantyExpires, item.FieldPurchaseTime, item.FieldLoanedAt, item.FieldLoanDue, item.FieldSoldTime:
 			values[i] = new(sql.NullTime)
 		case item.FieldID:
 			values[i] = new(uuid.UUID)
@@ -262,6 +321,12 @@ func (i *Item) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				i.Notes = value.String
 			}
+		case item.FieldNotesFormat:
+			if value, ok := values[j].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field notes_format", values[j])
+			} else if value.Valid {
+				i.NotesFormat = item.NotesFormat(value.String)
+			}
 		case item.FieldQuantity:
 			if value, ok := values[j].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field quantity", values[j])
@@ -280,18 +345,83 @@ func (i *Item) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				i.Archived = value.Bool
 			}
+		case item.FieldFavorite:
+			if value, ok := values[j].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field favorite", values[j])
+			} else if value.Valid {
+				i.Favorite = value.Bool
+			}
+		case item.FieldVersion:
+			if value, ok := values[j].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field version", values[j])
+			} else if value.Valid {
+				i.Version = int(value.Int64)
+			}
+		case item.FieldArchivedAt:
+			if value, ok := values[j].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field archived_at", values[j])
+			} else if value.Valid {
+				i.ArchivedAt = new(time.Time)
+				*i.ArchivedAt = value.Time
+			}
 		case item.FieldAssetID:
 			if value, ok := values[j].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field asset_id", values[j])
 			} else if value.Valid {
 				i.AssetID = int(value.Int64)
 			}
+		case item.FieldReorderThreshold:
+			if value, ok := values[j].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field reorder_threshold", values[j])
+			} else if value.Valid {
+				i.ReorderThreshold = int(value.Int64)
+			}
+		case item.FieldTags:
+			if value, ok := values[j].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field tags", values[j])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &i.Tags); err != nil {
+					return fmt.Errorf("unmarshal field tags: %w", err)
+				}
+			}
+		case item.FieldSource:
+			if value, ok := values[j].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field source", values[j])
+			} else if value.Valid {
+				i.Source = item.Source(value.String)
+			}
+		case item.FieldAcquisitionType:
+			if value, ok := values[j].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field acquisition_type", values[j])
+			} else if value.Valid {
+				i.AcquisitionType = item.AcquisitionType(value.String)
+			}
+		case item.FieldLatitude:
+			if value, ok := values[j].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field latitude", values[j])
+			} else if value.Valid {
+				i.Latitude = new(float64)
+				*i.Latitude = value.Float64
+			}
+		case item.FieldLongitude:
+			if value, ok := values[j].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field longitude", values[j])
+			} else if value.Valid {
+				i.Longitude = new(float64)
+				*i.Longitude = value.Float64
+			}
 		case item.FieldSerialNumber:
 			if value, ok := values[j].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field serial_number", values[j])
 			} else if value.Valid {
 				i.SerialNumber = value.String
 			}
+		case item.FieldBarcode:
+			if value, ok := values[j].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field barcode", values[j])
+			} else if value.Valid {
+				i.Barcode = value.String
+			}
 		case item.FieldModelNumber:
 			if value, ok := values[j].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field model_number", values[j])
@@ -334,12 +464,50 @@ func (i *Item) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				i.PurchaseFrom = value.String
 			}
+		case item.FieldPurchaseOrderNumber:
+			if value, ok := values[j].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field purchase_order_number", values[j])
+			} else if value.Valid {
+				i.PurchaseOrderNumber = value.String
+			}
 		case item.FieldPurchasePrice:
 			if value, ok := values[j].(*sql.NullFloat64); !ok {
 				return fmt.Errorf("unexpected type %T for field purchase_price", values[j])
 			} else if value.Valid {
 				i.PurchasePrice = value.Float64
 			}
+		case item.FieldCurrency:
+			if value, ok := values[j].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field currency", values[j])
+			} else if value.Valid {
+				i.Currency = value.String
+			}
+		case item.FieldReplacementCost:
+			if value, ok := values[j].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field replacement_cost", values[j])
+			} else if value.Valid {
+				i.ReplacementCost = value.Float64
+			}
+		case item.FieldLoanedTo:
+			if value, ok := values[j].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field loaned_to", values[j])
+			} else if value.Valid {
+				i.LoanedTo = value.String
+			}
+		case item.FieldLoanedAt:
+			if value, ok := values[j].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field loaned_at", values[j])
+			} else if value.Valid {
+				i.LoanedAt = new(time.Time)
+				*i.LoanedAt = value.Time
+			}
+		case item.FieldLoanDue:
+			if value, ok := values[j].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field loan_due", values[j])
+			} else if value.Valid {
+				i.LoanDue = new(time.Time)
+				*i.LoanDue = value.Time
+			}
 		case item.FieldSoldTime:
 			if value, ok := values[j].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field sold_time", values[j])
@@ -413,6 +581,11 @@ func (i *Item) QueryChildren() *ItemQuery {
 	return NewItemClient(i.config).QueryChildren(i)
 }
 
+// QueryRelated queries the "related" edge of the Item entity.
+func (i *Item) QueryRelated() *ItemQuery {
+	return NewItemClient(i.config).QueryRelated(i)
+}
+
 // QueryLabel queries the "label" edge of the Item entity.
 func (i *Item) QueryLabel() *LabelQuery {
 	return NewItemClient(i.config).QueryLabel(i)
@@ -438,6 +611,11 @@ func (i *Item) QueryAttachments() *AttachmentQuery {
 	return NewItemClient(i.config).QueryAttachments(i)
 }
 
+// QueryComments queries the "comments" edge of the Item entity.
+func (i *Item) QueryComments() *ItemCommentQuery {
+	return NewItemClient(i.config).QueryComments(i)
+}
+
 // Update returns a builder for updating this Item.
 // Note that you need to call Item.Unwrap() before calling this method if this Item
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -479,6 +657,9 @@ func (i *Item) String() string {
 	builder.WriteString("notes=")
 	builder.WriteString(i.Notes)
 	builder.WriteString(", ")
+	builder.WriteString("notes_format=")
+	builder.WriteString(fmt.Sprintf("%v", i.NotesFormat))
+	builder.WriteString(", ")
 	builder.WriteString("quantity=")
 	builder.WriteString(fmt.Sprintf("%v", i.Quantity))
 	builder.WriteString(", ")
@@ -488,12 +669,48 @@ func (i *Item) String() string {
 	builder.WriteString("archived=")
 	builder.WriteString(fmt.Sprintf("%v", i.Archived))
 	builder.WriteString(", ")
+	builder.WriteString("favorite=")
+	builder.WriteString(fmt.Sprintf("%v", i.Favorite))
+	builder.WriteString(", ")
+	builder.WriteString("version=")
+	builder.WriteString(fmt.Sprintf("%v", i.Version))
+	builder.WriteString(", ")
+	if v := i.ArchivedAt; v != nil {
+		builder.WriteString("archived_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("asset_id=")
 	builder.WriteString(fmt.Sprintf("%v", i.AssetID))
 	builder.WriteString(", ")
+	builder.WriteString("reorder_threshold=")
+	builder.WriteString(fmt.Sprintf("%v", i.ReorderThreshold))
+	builder.WriteString(", ")
+	builder.WriteString("tags=")
+	builder.WriteString(fmt.Sprintf("%v", i.Tags))
+	builder.WriteString(", ")
+	builder.WriteString("source=")
+	builder.WriteString(fmt.Sprintf("%v", i.Source))
+	builder.WriteString(", ")
+	builder.WriteString("acquisition_type=")
+	builder.WriteString(fmt.Sprintf("%v", i.AcquisitionType))
+	builder.WriteString(", ")
+	if v := i.Latitude; v != nil {
+		builder.WriteString("latitude=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := i.Longitude; v != nil {
+		builder.WriteString("longitude=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("serial_number=")
 	builder.WriteString(i.SerialNumber)
 	builder.WriteString(", ")
+	builder.WriteString("barcode=")
+	builder.WriteString(i.Barcode)
+	builder.WriteString(", ")
 	builder.WriteString("model_number=")
 	builder.WriteString(i.ModelNumber)
 	builder.WriteString(", ")
@@ -515,9 +732,31 @@ func (i *Item) String() string {
 	builder.WriteString("purchase_from=")
 	builder.WriteString(i.PurchaseFrom)
 	builder.WriteString(", ")
+	builder.WriteString("purchase_order_number=")
+	builder.WriteString(i.PurchaseOrderNumber)
+	builder.WriteString(", ")
 	builder.WriteString("purchase_price=")
 	builder.WriteString(fmt.Sprintf("%v", i.PurchasePrice))
 	builder.WriteString(", ")
+	builder.WriteString("currency=")
+	builder.WriteString(i.Currency)
+	builder.WriteString(", ")
+	builder.WriteString("replacement_cost=")
+	builder.WriteString(fmt.Sprintf("%v", i.ReplacementCost))
+	builder.WriteString(", ")
+	builder.WriteString("loaned_to=")
+	builder.WriteString(i.LoanedTo)
+	builder.WriteString(", ")
+	if v := i.LoanedAt; v != nil {
+		builder.WriteString("loaned_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := i.LoanDue; v != nil {
+		builder.WriteString("loan_due=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("sold_time=")
 	builder.WriteString(i.SoldTime.Format(time.ANSIC))
 	builder.WriteString(", ")
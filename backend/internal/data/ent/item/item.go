@@ -3,8 +3,10 @@
 package item
 
 import (
+	"fmt"
 	"time"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/google/uuid"
@@ -27,16 +29,38 @@ const (
 	FieldImportRef = "import_ref"
 	// FieldNotes holds the string denoting the notes field in the database.
 	FieldNotes = "notes"
+	// FieldNotesFormat holds the string denoting the notes_format field in the database.
+	FieldNotesFormat = "notes_format"
 	// FieldQuantity holds the string denoting the quantity field in the database.
 	FieldQuantity = "quantity"
 	// FieldInsured holds the string denoting the insured field in the database.
 	FieldInsured = "insured"
 	// FieldArchived holds the string denoting the archived field in the database.
 	FieldArchived = "archived"
+	// FieldFavorite holds the string denoting the favorite field in the database.
+	FieldFavorite = "favorite"
+	// FieldVersion holds the string denoting the version field in the database.
+	FieldVersion = "version"
+	// FieldArchivedAt holds the string denoting the archived_at field in the database.
+	FieldArchivedAt = "archived_at"
 	// FieldAssetID holds the string denoting the asset_id field in the database.
 	FieldAssetID = "asset_id"
+	// FieldReorderThreshold holds the string denoting the reorder_threshold field in the database.
+	FieldReorderThreshold = "reorder_threshold"
+	// FieldTags holds the string denoting the tags field in the database.
+	FieldTags = "tags"
+	// FieldSource holds the string denoting the source field in the database.
+	FieldSource = "source"
+	// FieldAcquisitionType holds the string denoting the acquisition_type field in the database.
+	FieldAcquisitionType = "acquisition_type"
+	// FieldLatitude holds the string denoting the latitude field in the database.
+	FieldLatitude = "latitude"
+	// FieldLongitude holds the string denoting the longitude field in the database.
+	FieldLongitude = "longitude"
 	// FieldSerialNumber holds the string denoting the serial_number field in the database.
 	FieldSerialNumber = "serial_number"
+	// FieldBarcode holds the string denoting the barcode field in the database.
+	FieldBarcode = "barcode"
 	// FieldModelNumber holds the string denoting the model_number field in the database.
 	FieldModelNumber = "model_number"
 	// FieldManufacturer holds the string denoting the manufacturer field in the database.
@@ -51,8 +75,20 @@ const (
 	FieldPurchaseTime = "purchase_time"
 	// FieldPurchaseFrom holds the string denoting the purchase_from field in the database.
 	FieldPurchaseFrom = "purchase_from"
+	// FieldPurchaseOrderNumber holds the string denoting the purchase_order_number field in the database.
+	FieldPurchaseOrderNumber = "purchase_order_number"
 	// FieldPurchasePrice holds the string denoting the purchase_price field in the database.
 	FieldPurchasePrice = "purchase_price"
+	// FieldCurrency holds the string denoting the currency field in the database.
+	FieldCurrency = "currency"
+	// FieldReplacementCost holds the string denoting the replacement_cost field in the database.
+	FieldReplacementCost = "replacement_cost"
+	// FieldLoanedTo holds the string denoting the loaned_to field in the database.
+	FieldLoanedTo = "loaned_to"
+	// FieldLoanedAt holds the string denoting the loaned_at field in the database.
+	FieldLoanedAt = "loaned_at"
+	// FieldLoanDue holds the string denoting the loan_due field in the database.
+	FieldLoanDue = "loan_due"
 	// FieldSoldTime holds the string denoting the sold_time field in the database.
 	FieldSoldTime = "sold_time"
 	// FieldSoldTo holds the string denoting the sold_to field in the database.
@@ -67,6 +103,8 @@ const (
 	EdgeParent = "parent"
 	// EdgeChildren holds the string denoting the children edge name in mutations.
 	EdgeChildren = "children"
+	// EdgeRelated holds the string denoting the related edge name in mutations.
+	EdgeRelated = "related"
 	// EdgeLabel holds the string denoting the label edge name in mutations.
 	EdgeLabel = "label"
 	// EdgeLocation holds the string denoting the location edge name in mutations.
@@ -77,6 +115,8 @@ const (
 	EdgeMaintenanceEntries = "maintenance_entries"
 	// EdgeAttachments holds the string denoting the attachments edge name in mutations.
 	EdgeAttachments = "attachments"
+	// EdgeComments holds the string denoting the comments edge name in mutations.
+	EdgeComments = "comments"
 	// Table holds the table name of the item in the database.
 	Table = "items"
 	// GroupTable is the table that holds the group relation/edge.
@@ -94,6 +134,8 @@ const (
 	ChildrenTable = "items"
 	// ChildrenColumn is the table column denoting the children relation/edge.
 	ChildrenColumn = "item_children"
+	// RelatedTable is the table that holds the related relation/edge. The primary key declared below.
+	RelatedTable = "item_related"
 	// LabelTable is the table that holds the label relation/edge. The primary key declared below.
 	LabelTable = "label_items"
 	// LabelInverseTable is the table name for the Label entity.
@@ -127,6 +169,13 @@ const (
 	AttachmentsInverseTable = "attachments"
 	// AttachmentsColumn is the table column denoting the attachments relation/edge.
 	AttachmentsColumn = "item_attachments"
+	// CommentsTable is the table that holds the comments relation/edge.
+	CommentsTable = "item_comments"
+	// CommentsInverseTable is the table name for the ItemComment entity.
+	// It exists in this package in order to avoid circular dependency with the "itemcomment" package.
+	CommentsInverseTable = "item_comments"
+	// CommentsColumn is the table column denoting the comments relation/edge.
+	CommentsColumn = "item_id"
 )
 
 // Columns holds all SQL columns for item fields.
@@ -138,11 +187,22 @@ var Columns = []string{
 	FieldDescription,
 	FieldImportRef,
 	FieldNotes,
+	FieldNotesFormat,
 	FieldQuantity,
 	FieldInsured,
 	FieldArchived,
+	FieldFavorite,
+	FieldVersion,
+	FieldArchivedAt,
 	FieldAssetID,
+	FieldReorderThreshold,
+	FieldTags,
+	FieldSource,
+	FieldAcquisitionType,
+	FieldLatitude,
+	FieldLongitude,
 	FieldSerialNumber,
+	FieldBarcode,
 	FieldModelNumber,
 	FieldManufacturer,
 	FieldLifetimeWarranty,
@@ -150,7 +210,13 @@ var Columns = []string{
 	FieldWarrantyDetails,
 	FieldPurchaseTime,
 	FieldPurchaseFrom,
+	FieldPurchaseOrderNumber,
 	FieldPurchasePrice,
+	FieldCurrency,
+	FieldReplacementCost,
+	FieldLoanedTo,
+	FieldLoanedAt,
+	FieldLoanDue,
 	FieldSoldTime,
 	FieldSoldTo,
 	FieldSoldPrice,
@@ -166,6 +232,9 @@ var ForeignKeys = []string{
 }
 
 var (
+	// RelatedPrimaryKey and RelatedColumn2 are the table columns denoting the
+	// primary key for the related relation (M2M).
+	RelatedPrimaryKey = []string{"item_id", "related_id"}
 	// LabelPrimaryKey and LabelColumn2 are the table columns denoting the
 	// primary key for the label relation (M2M).
 	LabelPrimaryKey = []string{"label_id", "item_id"}
@@ -186,7 +255,13 @@ func ValidColumn(column string) bool {
 	return false
 }
 
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/hay-kot/homebox/backend/internal/data/ent/runtime"
 var (
+	Hooks [1]ent.Hook
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
@@ -207,10 +282,18 @@ var (
 	DefaultInsured bool
 	// DefaultArchived holds the default value on creation for the "archived" field.
 	DefaultArchived bool
+	// DefaultFavorite holds the default value on creation for the "favorite" field.
+	DefaultFavorite bool
+	// DefaultVersion holds the default value on creation for the "version" field.
+	DefaultVersion int
 	// DefaultAssetID holds the default value on creation for the "asset_id" field.
 	DefaultAssetID int
+	// DefaultReorderThreshold holds the default value on creation for the "reorder_threshold" field.
+	DefaultReorderThreshold int
 	// SerialNumberValidator is a validator for the "serial_number" field. It is called by the builders before save.
 	SerialNumberValidator func(string) error
+	// BarcodeValidator is a validator for the "barcode" field. It is called by the builders before save.
+	BarcodeValidator func(string) error
 	// ModelNumberValidator is a validator for the "model_number" field. It is called by the builders before save.
 	ModelNumberValidator func(string) error
 	// ManufacturerValidator is a validator for the "manufacturer" field. It is called by the builders before save.
@@ -219,8 +302,16 @@ var (
 	DefaultLifetimeWarranty bool
 	// WarrantyDetailsValidator is a validator for the "warranty_details" field. It is called by the builders before save.
 	WarrantyDetailsValidator func(string) error
+	// PurchaseOrderNumberValidator is a validator for the "purchase_order_number" field. It is called by the builders before save.
+	PurchaseOrderNumberValidator func(string) error
 	// DefaultPurchasePrice holds the default value on creation for the "purchase_price" field.
 	DefaultPurchasePrice float64
+	// CurrencyValidator is a validator for the "currency" field. It is called by the builders before save.
+	CurrencyValidator func(string) error
+	// DefaultReplacementCost holds the default value on creation for the "replacement_cost" field.
+	DefaultReplacementCost float64
+	// LoanedToValidator is a validator for the "loaned_to" field. It is called by the builders before save.
+	LoanedToValidator func(string) error
 	// DefaultSoldPrice holds the default value on creation for the "sold_price" field.
 	DefaultSoldPrice float64
 	// SoldNotesValidator is a validator for the "sold_notes" field. It is called by the builders before save.
@@ -229,6 +320,88 @@ var (
 	DefaultID func() uuid.UUID
 )
 
+// NotesFormat defines the type for the "notes_format" enum field.
+type NotesFormat string
+
+// NotesFormatPlain is the default value of the NotesFormat enum.
+const DefaultNotesFormat = NotesFormatPlain
+
+// NotesFormat values.
+const (
+	NotesFormatPlain    NotesFormat = "plain"
+	NotesFormatMarkdown NotesFormat = "markdown"
+)
+
+func (nf NotesFormat) String() string {
+	return string(nf)
+}
+
+// NotesFormatValidator is a validator for the "notes_format" field enum values. It is called by the builders before save.
+func NotesFormatValidator(nf NotesFormat) error {
+	switch nf {
+	case NotesFormatPlain, NotesFormatMarkdown:
+		return nil
+	default:
+		return fmt.Errorf("item: invalid enum value for notes_format field: %q", nf)
+	}
+}
+
+// Source defines the type for the "source" enum field.
+type Source string
+
+// SourceManual is the default value of the Source enum.
+const DefaultSource = SourceManual
+
+// Source values.
+const (
+	SourceManual Source = "manual"
+	SourceImport Source = "import"
+	SourceAPI    Source = "api"
+)
+
+func (s Source) String() string {
+	return string(s)
+}
+
+// SourceValidator is a validator for the "source" field enum values. It is called by the builders before save.
+func SourceValidator(s Source) error {
+	switch s {
+	case SourceManual, SourceImport, SourceAPI:
+		return nil
+	default:
+		return fmt.Errorf("item: invalid enum value for source field: %q", s)
+	}
+}
+
+// AcquisitionType defines the type for the "acquisition_type" enum field.
+type AcquisitionType string
+
+// AcquisitionTypeBought is the default value of the AcquisitionType enum.
+const DefaultAcquisitionType = AcquisitionTypeBought
+
+// AcquisitionType values.
+const (
+	AcquisitionTypeBought    AcquisitionType = "bought"
+	AcquisitionTypeGift      AcquisitionType = "gift"
+	AcquisitionTypeInherited AcquisitionType = "inherited"
+	AcquisitionTypeMade      AcquisitionType = "made"
+	AcquisitionTypeFound     AcquisitionType = "found"
+)
+
+func (at AcquisitionType) String() string {
+	return string(at)
+}
+
+// AcquisitionTypeValidator is a validator for the "acquisition_type" field enum values. It is called by the builders before save.
+func AcquisitionTypeValidator(at AcquisitionType) error {
+	switch at {
+	case AcquisitionTypeBought, AcquisitionTypeGift, AcquisitionTypeInherited, AcquisitionTypeMade, AcquisitionTypeFound:
+		return nil
+	default:
+		return fmt.Errorf("item: invalid enum value for acquisition_type field: %q", at)
+	}
+}
+
 // OrderOption defines the ordering options for the Item queries.
 type OrderOption func(*sql.Selector)
 
@@ -267,6 +440,11 @@ func ByNotes(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldNotes, opts...).ToFunc()
 }
 
+// ByNotesFormat orders the results by the notes_format field.
+func ByNotesFormat(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNotesFormat, opts...).ToFunc()
+}
+
 // ByQuantity orders the results by the quantity field.
 func ByQuantity(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldQuantity, opts...).ToFunc()
@@ -282,16 +460,61 @@ func ByArchived(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldArchived, opts...).ToFunc()
 }
 
+// ByFavorite orders the results by the favorite field.
+func ByFavorite(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFavorite, opts...).ToFunc()
+}
+
+// ByVersion orders the results by the version field.
+func ByVersion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVersion, opts...).ToFunc()
+}
+
+// ByArchivedAt orders the results by the archived_at field.
+func ByArchivedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldArchivedAt, opts...).ToFunc()
+}
+
 // ByAssetID orders the results by the asset_id field.
 func ByAssetID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldAssetID, opts...).ToFunc()
 }
 
+// ByReorderThreshold orders the results by the reorder_threshold field.
+func ByReorderThreshold(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReorderThreshold, opts...).ToFunc()
+}
+
+// BySource orders the results by the source field.
+func BySource(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSource, opts...).ToFunc()
+}
+
+// ByAcquisitionType orders the results by the acquisition_type field.
+func ByAcquisitionType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAcquisitionType, opts...).ToFunc()
+}
+
+// ByLatitude orders the results by the latitude field.
+func ByLatitude(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLatitude, opts...).ToFunc()
+}
+
+// ByLongitude orders the results by the longitude field.
+func ByLongitude(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLongitude, opts...).ToFunc()
+}
+
 // BySerialNumber orders the results by the serial_number field.
 func BySerialNumber(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldSerialNumber, opts...).ToFunc()
 }
 
+// ByBarcode orders the results by the barcode field.
+func ByBarcode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBarcode, opts...).ToFunc()
+}
+
 // ByModelNumber orders the results by the model_number field.
 func ByModelNumber(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldModelNumber, opts...).ToFunc()
@@ -327,11 +550,41 @@ func ByPurchaseFrom(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldPurchaseFrom, opts...).ToFunc()
 }
 
+// ByPurchaseOrderNumber orders the results by the purchase_order_number field.
+func ByPurchaseOrderNumber(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPurchaseOrderNumber, opts...).ToFunc()
+}
+
 // ByPurchasePrice orders the results by the purchase_price field.
 func ByPurchasePrice(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldPurchasePrice, opts...).ToFunc()
 }
 
+// ByCurrency orders the results by the currency field.
+func ByCurrency(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCurrency, opts...).ToFunc()
+}
+
+// ByReplacementCost orders the results by the replacement_cost field.
+func ByReplacementCost(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReplacementCost, opts...).ToFunc()
+}
+
+// ByLoanedTo orders the results by the loaned_to field.
+func ByLoanedTo(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLoanedTo, opts...).ToFunc()
+}
+
+// ByLoanedAt orders the results by the loaned_at field.
+func ByLoanedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLoanedAt, opts...).ToFunc()
+}
+
+// ByLoanDue orders the results by the loan_due field.
+func ByLoanDue(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLoanDue, opts...).ToFunc()
+}
+
 // BySoldTime orders the results by the sold_time field.
 func BySoldTime(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldSoldTime, opts...).ToFunc()
@@ -380,6 +633,20 @@ func ByChildren(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 	}
 }
 
+// ByRelatedCount orders the results by related count.
+func ByRelatedCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newRelatedStep(), opts...)
+	}
+}
+
+// ByRelated orders the results by related terms.
+func ByRelated(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRelatedStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
 // ByLabelCount orders the results by label count.
 func ByLabelCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -442,6 +709,20 @@ func ByAttachments(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newAttachmentsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByCommentsCount orders the results by comments count.
+func ByCommentsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newCommentsStep(), opts...)
+	}
+}
+
+// ByComments orders the results by comments terms.
+func ByComments(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newCommentsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newGroupStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -463,6 +744,13 @@ func newChildrenStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, ChildrenTable, ChildrenColumn),
 	)
 }
+func newRelatedStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(Table, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, false, RelatedTable, RelatedPrimaryKey...),
+	)
+}
 func newLabelStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -498,3 +786,10 @@ func newAttachmentsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, AttachmentsTable, AttachmentsColumn),
 	)
 }
+func newCommentsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(CommentsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, CommentsTable, CommentsColumn),
+	)
+}
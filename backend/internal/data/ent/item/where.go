@@ -101,16 +101,51 @@ func Archived(v bool) predicate.Item {
 	return predicate.Item(sql.FieldEQ(FieldArchived, v))
 }
 
+// Favorite applies equality check predicate on the "favorite" field. It's identical to FavoriteEQ.
+func Favorite(v bool) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldFavorite, v))
+}
+
+// Version applies equality check predicate on the "version" field. It's identical to VersionEQ.
+func Version(v int) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldVersion, v))
+}
+
+// ArchivedAt applies equality check predicate on the "archived_at" field. It's identical to ArchivedAtEQ.
+func ArchivedAt(v time.Time) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldArchivedAt, v))
+}
+
 // AssetID applies equality check predicate on the "asset_id" field. It's identical to AssetIDEQ.
 func AssetID(v int) predicate.Item {
 	return predicate.Item(sql.FieldEQ(FieldAssetID, v))
 }
 
+// ReorderThreshold applies equality check predicate on the "reorder_threshold" field. It's identical to ReorderThresholdEQ.
+func ReorderThreshold(v int) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldReorderThreshold, v))
+}
+
+// Latitude applies equality check predicate on the "latitude" field. It's identical to LatitudeEQ.
+func Latitude(v float64) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldLatitude, v))
+}
+
+// Longitude applies equality check predicate on the "longitude" field. It's identical to LongitudeEQ.
+func Longitude(v float64) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldLongitude, v))
+}
+
 // SerialNumber applies equality check predicate on the "serial_number" field. It's identical to SerialNumberEQ.
 func SerialNumber(v string) predicate.Item {
 	return predicate.Item(sql.FieldEQ(FieldSerialNumber, v))
 }
 
+// Barcode applies equality check predicate on the "barcode" field. It's identical to BarcodeEQ.
+func Barcode(v string) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldBarcode, v))
+}
+
 // ModelNumber applies equality check predicate on the "model_number" field. It's identical to ModelNumberEQ.
 func ModelNumber(v string) predicate.Item {
 	return predicate.Item(sql.FieldEQ(FieldModelNumber, v))
@@ -146,11 +181,41 @@ func PurchaseFrom(v string) predicate.Item {
 	return predicate.Item(sql.FieldEQ(FieldPurchaseFrom, v))
 }
 
+// PurchaseOrderNumber applies equality check predicate on the "purchase_order_number" field. It's identical to PurchaseOrderNumberEQ.
+func PurchaseOrderNumber(v string) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldPurchaseOrderNumber, v))
+}
+
 // PurchasePrice applies equality check predicate on the "purchase_price" field. It's identical to PurchasePriceEQ.
 func PurchasePrice(v float64) predicate.Item {
 	return predicate.Item(sql.FieldEQ(FieldPurchasePrice, v))
 }
 
+// Currency applies equality check predicate on the "currency" field. It's identical to CurrencyEQ.
+func Currency(v string) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldCurrency, v))
+}
+
+// ReplacementCost applies equality check predicate on the "replacement_cost" field. It's identical to ReplacementCostEQ.
+func ReplacementCost(v float64) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldReplacementCost, v))
+}
+
+// LoanedTo applies equality check predicate on the "loaned_to" field. It's identical to LoanedToEQ.
+func LoanedTo(v string) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldLoanedTo, v))
+}
+
+// LoanedAt applies equality check predicate on the "loaned_at" field. It's identical to LoanedAtEQ.
+func LoanedAt(v time.Time) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldLoanedAt, v))
+}
+
+// LoanDue applies equality check predicate on the "loan_due" field. It's identical to LoanDueEQ.
+func LoanDue(v time.Time) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldLoanDue, v))
+}
+
 // SoldTime applies equality check predicate on the "sold_time" field. It's identical to SoldTimeEQ.
 func SoldTime(v time.Time) predicate.Item {
 	return predicate.Item(sql.FieldEQ(FieldSoldTime, v))
@@ -541,6 +606,26 @@ func NotesContainsFold(v string) predicate.Item {
 	return predicate.Item(sql.FieldContainsFold(FieldNotes, v))
 }
 
+// NotesFormatEQ applies the EQ predicate on the "notes_format" field.
+func NotesFormatEQ(v NotesFormat) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldNotesFormat, v))
+}
+
+// NotesFormatNEQ applies the NEQ predicate on the "notes_format" field.
+func NotesFormatNEQ(v NotesFormat) predicate.Item {
+	return predicate.Item(sql.FieldNEQ(FieldNotesFormat, v))
+}
+
+// NotesFormatIn applies the In predicate on the "notes_format" field.
+func NotesFormatIn(vs ...NotesFormat) predicate.Item {
+	return predicate.Item(sql.FieldIn(FieldNotesFormat, vs...))
+}
+
+// NotesFormatNotIn applies the NotIn predicate on the "notes_format" field.
+func NotesFormatNotIn(vs ...NotesFormat) predicate.Item {
+	return predicate.Item(sql.FieldNotIn(FieldNotesFormat, vs...))
+}
+
 // QuantityEQ applies the EQ predicate on the "quantity" field.
 func QuantityEQ(v int) predicate.Item {
 	return predicate.Item(sql.FieldEQ(FieldQuantity, v))
@@ -601,6 +686,106 @@ func ArchivedNEQ(v bool) predicate.Item {
 	return predicate.Item(sql.FieldNEQ(FieldArchived, v))
 }
 
+// FavoriteEQ applies the EQ predicate on the "favorite" field.
+func FavoriteEQ(v bool) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldFavorite, v))
+}
+
+// FavoriteNEQ applies the NEQ predicate on the "favorite" field.
+func FavoriteNEQ(v bool) predicate.Item {
+	return predicate.Item(sql.FieldNEQ(FieldFavorite, v))
+}
+
+// VersionEQ applies the EQ predicate on the "version" field.
+func VersionEQ(v int) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldVersion, v))
+}
+
+// VersionNEQ applies the NEQ predicate on the "version" field.
+func VersionNEQ(v int) predicate.Item {
+	return predicate.Item(sql.FieldNEQ(FieldVersion, v))
+}
+
+// VersionIn applies the In predicate on the "version" field.
+func VersionIn(vs ...int) predicate.Item {
+	return predicate.Item(sql.FieldIn(FieldVersion, vs...))
+}
+
+// VersionNotIn applies the NotIn predicate on the "version" field.
+func VersionNotIn(vs ...int) predicate.Item {
+	return predicate.Item(sql.FieldNotIn(FieldVersion, vs...))
+}
+
+// VersionGT applies the GT predicate on the "version" field.
+func VersionGT(v int) predicate.Item {
+	return predicate.Item(sql.FieldGT(FieldVersion, v))
+}
+
+// VersionGTE applies the GTE predicate on the "version" field.
+func VersionGTE(v int) predicate.Item {
+	return predicate.Item(sql.FieldGTE(FieldVersion, v))
+}
+
+// VersionLT applies the LT predicate on the "version" field.
+func VersionLT(v int) predicate.Item {
+	return predicate.Item(sql.FieldLT(FieldVersion, v))
+}
+
+// VersionLTE applies the LTE predicate on the "version" field.
+func VersionLTE(v int) predicate.Item {
+	return predicate.Item(sql.FieldLTE(FieldVersion, v))
+}
+
+// ArchivedAtEQ applies the EQ predicate on the "archived_at" field.
+func ArchivedAtEQ(v time.Time) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldArchivedAt, v))
+}
+
+// ArchivedAtNEQ applies the NEQ predicate on the "archived_at" field.
+func ArchivedAtNEQ(v time.Time) predicate.Item {
+	return predicate.Item(sql.FieldNEQ(FieldArchivedAt, v))
+}
+
+// ArchivedAtIn applies the In predicate on the "archived_at" field.
+func ArchivedAtIn(vs ...time.Time) predicate.Item {
+	return predicate.Item(sql.FieldIn(FieldArchivedAt, vs...))
+}
+
+// ArchivedAtNotIn applies the NotIn predicate on the "archived_at" field.
+func ArchivedAtNotIn(vs ...time.Time) predicate.Item {
+	return predicate.Item(sql.FieldNotIn(FieldArchivedAt, vs...))
+}
+
+// ArchivedAtGT applies the GT predicate on the "archived_at" field.
+func ArchivedAtGT(v time.Time) predicate.Item {
+	return predicate.Item(sql.FieldGT(FieldArchivedAt, v))
+}
+
+// ArchivedAtGTE applies the GTE predicate on the "archived_at" field.
+func ArchivedAtGTE(v time.Time) predicate.Item {
+	return predicate.Item(sql.FieldGTE(FieldArchivedAt, v))
+}
+
+// ArchivedAtLT applies the LT predicate on the "archived_at" field.
+func ArchivedAtLT(v time.Time) predicate.Item {
+	return predicate.Item(sql.FieldLT(FieldArchivedAt, v))
+}
+
+// ArchivedAtLTE applies the LTE predicate on the "archived_at" field.
+func ArchivedAtLTE(v time.Time) predicate.Item {
+	return predicate.Item(sql.FieldLTE(FieldArchivedAt, v))
+}
+
+// ArchivedAtIsNil applies the IsNil predicate on the "archived_at" field.
+func ArchivedAtIsNil() predicate.Item {
+	return predicate.Item(sql.FieldIsNull(FieldArchivedAt))
+}
+
+// ArchivedAtNotNil applies the NotNil predicate on the "archived_at" field.
+func ArchivedAtNotNil() predicate.Item {
+	return predicate.Item(sql.FieldNotNull(FieldArchivedAt))
+}
+
 // AssetIDEQ applies the EQ predicate on the "asset_id" field.
 func AssetIDEQ(v int) predicate.Item {
 	return predicate.Item(sql.FieldEQ(FieldAssetID, v))
@@ -641,6 +826,196 @@ func AssetIDLTE(v int) predicate.Item {
 	return predicate.Item(sql.FieldLTE(FieldAssetID, v))
 }
 
+// ReorderThresholdEQ applies the EQ predicate on the "reorder_threshold" field.
+func ReorderThresholdEQ(v int) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldReorderThreshold, v))
+}
+
+// ReorderThresholdNEQ applies the NEQ predicate on the "reorder_threshold" field.
+func ReorderThresholdNEQ(v int) predicate.Item {
+	return predicate.Item(sql.FieldNEQ(FieldReorderThreshold, v))
+}
+
+// ReorderThresholdIn applies the In predicate on the "reorder_threshold" field.
+func ReorderThresholdIn(vs ...int) predicate.Item {
+	return predicate.Item(sql.FieldIn(FieldReorderThreshold, vs...))
+}
+
+// ReorderThresholdNotIn applies the NotIn predicate on the "reorder_threshold" field.
+func ReorderThresholdNotIn(vs ...int) predicate.Item {
+	return predicate.Item(sql.FieldNotIn(FieldReorderThreshold, vs...))
+}
+
+// ReorderThresholdGT applies the GT predicate on the "reorder_threshold" field.
+func ReorderThresholdGT(v int) predicate.Item {
+	return predicate.Item(sql.FieldGT(FieldReorderThreshold, v))
+}
+
+// ReorderThresholdGTE applies the GTE predicate on the "reorder_threshold" field.
+func ReorderThresholdGTE(v int) predicate.Item {
+	return predicate.Item(sql.FieldGTE(FieldReorderThreshold, v))
+}
+
+// ReorderThresholdLT applies the LT predicate on the "reorder_threshold" field.
+func ReorderThresholdLT(v int) predicate.Item {
+	return predicate.Item(sql.FieldLT(FieldReorderThreshold, v))
+}
+
+// ReorderThresholdLTE applies the LTE predicate on the "reorder_threshold" field.
+func ReorderThresholdLTE(v int) predicate.Item {
+	return predicate.Item(sql.FieldLTE(FieldReorderThreshold, v))
+}
+
+// TagsIsNil applies the IsNil predicate on the "tags" field.
+func TagsIsNil() predicate.Item {
+	return predicate.Item(sql.FieldIsNull(FieldTags))
+}
+
+// TagsNotNil applies the NotNil predicate on the "tags" field.
+func TagsNotNil() predicate.Item {
+	return predicate.Item(sql.FieldNotNull(FieldTags))
+}
+
+// SourceEQ applies the EQ predicate on the "source" field.
+func SourceEQ(v Source) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldSource, v))
+}
+
+// SourceNEQ applies the NEQ predicate on the "source" field.
+func SourceNEQ(v Source) predicate.Item {
+	return predicate.Item(sql.FieldNEQ(FieldSource, v))
+}
+
+// SourceIn applies the In predicate on the "source" field.
+func SourceIn(vs ...Source) predicate.Item {
+	return predicate.Item(sql.FieldIn(FieldSource, vs...))
+}
+
+// SourceNotIn applies the NotIn predicate on the "source" field.
+func SourceNotIn(vs ...Source) predicate.Item {
+	return predicate.Item(sql.FieldNotIn(FieldSource, vs...))
+}
+
+// AcquisitionTypeEQ applies the EQ predicate on the "acquisition_type" field.
+func AcquisitionTypeEQ(v AcquisitionType) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldAcquisitionType, v))
+}
+
+// AcquisitionTypeNEQ applies the NEQ predicate on the "acquisition_type" field.
+func AcquisitionTypeNEQ(v AcquisitionType) predicate.Item {
+	return predicate.Item(sql.FieldNEQ(FieldAcquisitionType, v))
+}
+
+// AcquisitionTypeIn applies the In predicate on the "acquisition_type" field.
+func AcquisitionTypeIn(vs ...AcquisitionType) predicate.Item {
+	return predicate.Item(sql.FieldIn(FieldAcquisitionType, vs...))
+}
+
+// AcquisitionTypeNotIn applies the NotIn predicate on the "acquisition_type" field.
+func AcquisitionTypeNotIn(vs ...AcquisitionType) predicate.Item {
+	return predicate.Item(sql.FieldNotIn(FieldAcquisitionType, vs...))
+}
+
+// LatitudeEQ applies the EQ predicate on the "latitude" field.
+func LatitudeEQ(v float64) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldLatitude, v))
+}
+
+// LatitudeNEQ applies the NEQ predicate on the "latitude" field.
+func LatitudeNEQ(v float64) predicate.Item {
+	return predicate.Item(sql.FieldNEQ(FieldLatitude, v))
+}
+
+// LatitudeIn applies the In predicate on the "latitude" field.
+func LatitudeIn(vs ...float64) predicate.Item {
+	return predicate.Item(sql.FieldIn(FieldLatitude, vs...))
+}
+
+// LatitudeNotIn applies the NotIn predicate on the "latitude" field.
+func LatitudeNotIn(vs ...float64) predicate.Item {
+	return predicate.Item(sql.FieldNotIn(FieldLatitude, vs...))
+}
+
+// LatitudeGT applies the GT predicate on the "latitude" field.
+func LatitudeGT(v float64) predicate.Item {
+	return predicate.Item(sql.FieldGT(FieldLatitude, v))
+}
+
+// LatitudeGTE applies the GTE predicate on the "latitude" field.
+func LatitudeGTE(v float64) predicate.Item {
+	return predicate.Item(sql.FieldGTE(FieldLatitude, v))
+}
+
+// LatitudeLT applies the LT predicate on the "latitude" field.
+func LatitudeLT(v float64) predicate.Item {
+	return predicate.Item(sql.FieldLT(FieldLatitude, v))
+}
+
+// LatitudeLTE applies the LTE predicate on the "latitude" field.
+func LatitudeLTE(v float64) predicate.Item {
+	return predicate.Item(sql.FieldLTE(FieldLatitude, v))
+}
+
+// LatitudeIsNil applies the IsNil predicate on the "latitude" field.
+func LatitudeIsNil() predicate.Item {
+	return predicate.Item(sql.FieldIsNull(FieldLatitude))
+}
+
+// LatitudeNotNil applies the NotNil predicate on the "latitude" field.
+func LatitudeNotNil() predicate.Item {
+	return predicate.Item(sql.FieldNotNull(FieldLatitude))
+}
+
+// LongitudeEQ applies the EQ predicate on the "longitude" field.
+func LongitudeEQ(v float64) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldLongitude, v))
+}
+
+// LongitudeNEQ applies the NEQ predicate on the "longitude" field.
+func LongitudeNEQ(v float64) predicate.Item {
+	return predicate.Item(sql.FieldNEQ(FieldLongitude, v))
+}
+
+// LongitudeIn applies the In predicate on the "longitude" field.
+func LongitudeIn(vs ...float64) predicate.Item {
+	return predicate.Item(sql.FieldIn(FieldLongitude, vs...))
+}
+
+// LongitudeNotIn applies the NotIn predicate on the "longitude" field.
+func LongitudeNotIn(vs ...float64) predicate.Item {
+	return predicate.Item(sql.FieldNotIn(FieldLongitude, vs...))
+}
+
+// LongitudeGT applies the GT predicate on the "longitude" field.
+func LongitudeGT(v float64) predicate.Item {
+	return predicate.Item(sql.FieldGT(FieldLongitude, v))
+}
+
+// LongitudeGTE applies the GTE predicate on the "longitude" field.
+func LongitudeGTE(v float64) predicate.Item {
+	return predicate.Item(sql.FieldGTE(FieldLongitude, v))
+}
+
+// LongitudeLT applies the LT predicate on the "longitude" field.
+func LongitudeLT(v float64) predicate.Item {
+	return predicate.Item(sql.FieldLT(FieldLongitude, v))
+}
+
+// LongitudeLTE applies the LTE predicate on the "longitude" field.
+func LongitudeLTE(v float64) predicate.Item {
+	return predicate.Item(sql.FieldLTE(FieldLongitude, v))
+}
+
+// LongitudeIsNil applies the IsNil predicate on the "longitude" field.
+func LongitudeIsNil() predicate.Item {
+	return predicate.Item(sql.FieldIsNull(FieldLongitude))
+}
+
+// LongitudeNotNil applies the NotNil predicate on the "longitude" field.
+func LongitudeNotNil() predicate.Item {
+	return predicate.Item(sql.FieldNotNull(FieldLongitude))
+}
+
 // SerialNumberEQ applies the EQ predicate on the "serial_number" field.
 func SerialNumberEQ(v string) predicate.Item {
 	return predicate.Item(sql.FieldEQ(FieldSerialNumber, v))
@@ -716,6 +1091,81 @@ func SerialNumberContainsFold(v string) predicate.Item {
 	return predicate.Item(sql.FieldContainsFold(FieldSerialNumber, v))
 }
 
+// BarcodeEQ applies the EQ predicate on the "barcode" field.
+func BarcodeEQ(v string) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldBarcode, v))
+}
+
+// BarcodeNEQ applies the NEQ predicate on the "barcode" field.
+func BarcodeNEQ(v string) predicate.Item {
+	return predicate.Item(sql.FieldNEQ(FieldBarcode, v))
+}
+
+// BarcodeIn applies the In predicate on the "barcode" field.
+func BarcodeIn(vs ...string) predicate.Item {
+	return predicate.Item(sql.FieldIn(FieldBarcode, vs...))
+}
+
+// BarcodeNotIn applies the NotIn predicate on the "barcode" field.
+func BarcodeNotIn(vs ...string) predicate.Item {
+	return predicate.Item(sql.FieldNotIn(FieldBarcode, vs...))
+}
+
+// BarcodeGT applies the GT predicate on the "barcode" field.
+func BarcodeGT(v string) predicate.Item {
+	return predicate.Item(sql.FieldGT(FieldBarcode, v))
+}
+
+// BarcodeGTE applies the GTE predicate on the "barcode" field.
+func BarcodeGTE(v string) predicate.Item {
+	return predicate.Item(sql.FieldGTE(FieldBarcode, v))
+}
+
+// BarcodeLT applies the LT predicate on the "barcode" field.
+func BarcodeLT(v string) predicate.Item {
+	return predicate.Item(sql.FieldLT(FieldBarcode, v))
+}
+
+// BarcodeLTE applies the LTE predicate on the "barcode" field.
+func BarcodeLTE(v string) predicate.Item {
+	return predicate.Item(sql.FieldLTE(FieldBarcode, v))
+}
+
+// BarcodeContains applies the Contains predicate on the "barcode" field.
+func BarcodeContains(v string) predicate.Item {
+	return predicate.Item(sql.FieldContains(FieldBarcode, v))
+}
+
+// BarcodeHasPrefix applies the HasPrefix predicate on the "barcode" field.
+func BarcodeHasPrefix(v string) predicate.Item {
+	return predicate.Item(sql.FieldHasPrefix(FieldBarcode, v))
+}
+
+// BarcodeHasSuffix applies the HasSuffix predicate on the "barcode" field.
+func BarcodeHasSuffix(v string) predicate.Item {
+	return predicate.Item(sql.FieldHasSuffix(FieldBarcode, v))
+}
+
+// BarcodeIsNil applies the IsNil predicate on the "barcode" field.
+func BarcodeIsNil() predicate.Item {
+	return predicate.Item(sql.FieldIsNull(FieldBarcode))
+}
+
+// BarcodeNotNil applies the NotNil predicate on the "barcode" field.
+func BarcodeNotNil() predicate.Item {
+	return predicate.Item(sql.FieldNotNull(FieldBarcode))
+}
+
+// BarcodeEqualFold applies the EqualFold predicate on the "barcode" field.
+func BarcodeEqualFold(v string) predicate.Item {
+	return predicate.Item(sql.FieldEqualFold(FieldBarcode, v))
+}
+
+// BarcodeContainsFold applies the ContainsFold predicate on the "barcode" field.
+func BarcodeContainsFold(v string) predicate.Item {
+	return predicate.Item(sql.FieldContainsFold(FieldBarcode, v))
+}
+
 // ModelNumberEQ applies the EQ predicate on the "model_number" field.
 func ModelNumberEQ(v string) predicate.Item {
 	return predicate.Item(sql.FieldEQ(FieldModelNumber, v))
@@ -1126,6 +1576,81 @@ func PurchaseFromContainsFold(v string) predicate.Item {
 	return predicate.Item(sql.FieldContainsFold(FieldPurchaseFrom, v))
 }
 
+// PurchaseOrderNumberEQ applies the EQ predicate on the "purchase_order_number" field.
+func PurchaseOrderNumberEQ(v string) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldPurchaseOrderNumber, v))
+}
+
+// PurchaseOrderNumberNEQ applies the NEQ predicate on the "purchase_order_number" field.
+func PurchaseOrderNumberNEQ(v string) predicate.Item {
+	return predicate.Item(sql.FieldNEQ(FieldPurchaseOrderNumber, v))
+}
+
+// PurchaseOrderNumberIn applies the In predicate on the "purchase_order_number" field.
+func PurchaseOrderNumberIn(vs ...string) predicate.Item {
+	return predicate.Item(sql.FieldIn(FieldPurchaseOrderNumber, vs...))
+}
+
+// PurchaseOrderNumberNotIn applies the NotIn predicate on the "purchase_order_number" field.
+func PurchaseOrderNumberNotIn(vs ...string) predicate.Item {
+	return predicate.Item(sql.FieldNotIn(FieldPurchaseOrderNumber, vs...))
+}
+
+// PurchaseOrderNumberGT applies the GT predicate on the "purchase_order_number" field.
+func PurchaseOrderNumberGT(v string) predicate.Item {
+	return predicate.Item(sql.FieldGT(FieldPurchaseOrderNumber, v))
+}
+
+// PurchaseOrderNumberGTE applies the GTE predicate on the "purchase_order_number" field.
+func PurchaseOrderNumberGTE(v string) predicate.Item {
+	return predicate.Item(sql.FieldGTE(FieldPurchaseOrderNumber, v))
+}
+
+// PurchaseOrderNumberLT applies the LT predicate on the "purchase_order_number" field.
+func PurchaseOrderNumberLT(v string) predicate.Item {
+	return predicate.Item(sql.FieldLT(FieldPurchaseOrderNumber, v))
+}
+
+// PurchaseOrderNumberLTE applies the LTE predicate on the "purchase_order_number" field.
+func PurchaseOrderNumberLTE(v string) predicate.Item {
+	return predicate.Item(sql.FieldLTE(FieldPurchaseOrderNumber, v))
+}
+
+// PurchaseOrderNumberContains applies the Contains predicate on the "purchase_order_number" field.
+func PurchaseOrderNumberContains(v string) predicate.Item {
+	return predicate.Item(sql.FieldContains(FieldPurchaseOrderNumber, v))
+}
+
+// PurchaseOrderNumberHasPrefix applies the HasPrefix predicate on the "purchase_order_number" field.
+func PurchaseOrderNumberHasPrefix(v string) predicate.Item {
+	return predicate.Item(sql.FieldHasPrefix(FieldPurchaseOrderNumber, v))
+}
+
+// PurchaseOrderNumberHasSuffix applies the HasSuffix predicate on the "purchase_order_number" field.
+func PurchaseOrderNumberHasSuffix(v string) predicate.Item {
+	return predicate.Item(sql.FieldHasSuffix(FieldPurchaseOrderNumber, v))
+}
+
+// PurchaseOrderNumberIsNil applies the IsNil predicate on the "purchase_order_number" field.
+func PurchaseOrderNumberIsNil() predicate.Item {
+	return predicate.Item(sql.FieldIsNull(FieldPurchaseOrderNumber))
+}
+
+// PurchaseOrderNumberNotNil applies the NotNil predicate on the "purchase_order_number" field.
+func PurchaseOrderNumberNotNil() predicate.Item {
+	return predicate.Item(sql.FieldNotNull(FieldPurchaseOrderNumber))
+}
+
+// PurchaseOrderNumberEqualFold applies the EqualFold predicate on the "purchase_order_number" field.
+func PurchaseOrderNumberEqualFold(v string) predicate.Item {
+	return predicate.Item(sql.FieldEqualFold(FieldPurchaseOrderNumber, v))
+}
+
+// PurchaseOrderNumberContainsFold applies the ContainsFold predicate on the "purchase_order_number" field.
+func PurchaseOrderNumberContainsFold(v string) predicate.Item {
+	return predicate.Item(sql.FieldContainsFold(FieldPurchaseOrderNumber, v))
+}
+
 // PurchasePriceEQ applies the EQ predicate on the "purchase_price" field.
 func PurchasePriceEQ(v float64) predicate.Item {
 	return predicate.Item(sql.FieldEQ(FieldPurchasePrice, v))
@@ -1166,6 +1691,296 @@ func PurchasePriceLTE(v float64) predicate.Item {
 	return predicate.Item(sql.FieldLTE(FieldPurchasePrice, v))
 }
 
+// CurrencyEQ applies the EQ predicate on the "currency" field.
+func CurrencyEQ(v string) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldCurrency, v))
+}
+
+// CurrencyNEQ applies the NEQ predicate on the "currency" field.
+func CurrencyNEQ(v string) predicate.Item {
+	return predicate.Item(sql.FieldNEQ(FieldCurrency, v))
+}
+
+// CurrencyIn applies the In predicate on the "currency" field.
+func CurrencyIn(vs ...string) predicate.Item {
+	return predicate.Item(sql.FieldIn(FieldCurrency, vs...))
+}
+
+// CurrencyNotIn applies the NotIn predicate on the "currency" field.
+func CurrencyNotIn(vs ...string) predicate.Item {
+	return predicate.Item(sql.FieldNotIn(FieldCurrency, vs...))
+}
+
+// CurrencyGT applies the GT predicate on the "currency" field.
+func CurrencyGT(v string) predicate.Item {
+	return predicate.Item(sql.FieldGT(FieldCurrency, v))
+}
+
+// CurrencyGTE applies the GTE predicate on the "currency" field.
+func CurrencyGTE(v string) predicate.Item {
+	return predicate.Item(sql.FieldGTE(FieldCurrency, v))
+}
+
+// CurrencyLT applies the LT predicate on the "currency" field.
+func CurrencyLT(v string) predicate.Item {
+	return predicate.Item(sql.FieldLT(FieldCurrency, v))
+}
+
+// CurrencyLTE applies the LTE predicate on the "currency" field.
+func CurrencyLTE(v string) predicate.Item {
+	return predicate.Item(sql.FieldLTE(FieldCurrency, v))
+}
+
+// CurrencyContains applies the Contains predicate on the "currency" field.
+func CurrencyContains(v string) predicate.Item {
+	return predicate.Item(sql.FieldContains(FieldCurrency, v))
+}
+
+// CurrencyHasPrefix applies the HasPrefix predicate on the "currency" field.
+func CurrencyHasPrefix(v string) predicate.Item {
+	return predicate.Item(sql.FieldHasPrefix(FieldCurrency, v))
+}
+
+// CurrencyHasSuffix applies the HasSuffix predicate on the "currency" field.
+func CurrencyHasSuffix(v string) predicate.Item {
+	return predicate.Item(sql.FieldHasSuffix(FieldCurrency, v))
+}
+
+// CurrencyIsNil applies the IsNil predicate on the "currency" field.
+func CurrencyIsNil() predicate.Item {
+	return predicate.Item(sql.FieldIsNull(FieldCurrency))
+}
+
+// CurrencyNotNil applies the NotNil predicate on the "currency" field.
+func CurrencyNotNil() predicate.Item {
+	return predicate.Item(sql.FieldNotNull(FieldCurrency))
+}
+
+// CurrencyEqualFold applies the EqualFold predicate on the "currency" field.
+func CurrencyEqualFold(v string) predicate.Item {
+	return predicate.Item(sql.FieldEqualFold(FieldCurrency, v))
+}
+
+// CurrencyContainsFold applies the ContainsFold predicate on the "currency" field.
+func CurrencyContainsFold(v string) predicate.Item {
+	return predicate.Item(sql.FieldContainsFold(FieldCurrency, v))
+}
+
+// ReplacementCostEQ applies the EQ predicate on the "replacement_cost" field.
+func ReplacementCostEQ(v float64) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldReplacementCost, v))
+}
+
+// ReplacementCostNEQ applies the NEQ predicate on the "replacement_cost" field.
+func ReplacementCostNEQ(v float64) predicate.Item {
+	return predicate.Item(sql.FieldNEQ(FieldReplacementCost, v))
+}
+
+// ReplacementCostIn applies the In predicate on the "replacement_cost" field.
+func ReplacementCostIn(vs ...float64) predicate.Item {
+	return predicate.Item(sql.FieldIn(FieldReplacementCost, vs...))
+}
+
+// ReplacementCostNotIn applies the NotIn predicate on the "replacement_cost" field.
+func ReplacementCostNotIn(vs ...float64) predicate.Item {
+	return predicate.Item(sql.FieldNotIn(FieldReplacementCost, vs...))
+}
+
+// ReplacementCostGT applies the GT predicate on the "replacement_cost" field.
+func ReplacementCostGT(v float64) predicate.Item {
+	return predicate.Item(sql.FieldGT(FieldReplacementCost, v))
+}
+
+// ReplacementCostGTE applies the GTE predicate on the "replacement_cost" field.
+func ReplacementCostGTE(v float64) predicate.Item {
+	return predicate.Item(sql.FieldGTE(FieldReplacementCost, v))
+}
+
+// ReplacementCostLT applies the LT predicate on the "replacement_cost" field.
+func ReplacementCostLT(v float64) predicate.Item {
+	return predicate.Item(sql.FieldLT(FieldReplacementCost, v))
+}
+
+// ReplacementCostLTE applies the LTE predicate on the "replacement_cost" field.
+func ReplacementCostLTE(v float64) predicate.Item {
+	return predicate.Item(sql.FieldLTE(FieldReplacementCost, v))
+}
+
+// LoanedToEQ applies the EQ predicate on the "loaned_to" field.
+func LoanedToEQ(v string) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldLoanedTo, v))
+}
+
+// LoanedToNEQ applies the NEQ predicate on the "loaned_to" field.
+func LoanedToNEQ(v string) predicate.Item {
+	return predicate.Item(sql.FieldNEQ(FieldLoanedTo, v))
+}
+
+// LoanedToIn applies the In predicate on the "loaned_to" field.
+func LoanedToIn(vs ...string) predicate.Item {
+	return predicate.Item(sql.FieldIn(FieldLoanedTo, vs...))
+}
+
+// LoanedToNotIn applies the NotIn predicate on the "loaned_to" field.
+func LoanedToNotIn(vs ...string) predicate.Item {
+	return predicate.Item(sql.FieldNotIn(FieldLoanedTo, vs...))
+}
+
+// LoanedToGT applies the GT predicate on the "loaned_to" field.
+func LoanedToGT(v string) predicate.Item {
+	return predicate.Item(sql.FieldGT(FieldLoanedTo, v))
+}
+
+// LoanedToGTE applies the GTE predicate on the "loaned_to" field.
+func LoanedToGTE(v string) predicate.Item {
+	return predicate.Item(sql.FieldGTE(FieldLoanedTo, v))
+}
+
+// LoanedToLT applies the LT predicate on the "loaned_to" field.
+func LoanedToLT(v string) predicate.Item {
+	return predicate.Item(sql.FieldLT(FieldLoanedTo, v))
+}
+
+// LoanedToLTE applies the LTE predicate on the "loaned_to" field.
+func LoanedToLTE(v string) predicate.Item {
+	return predicate.Item(sql.FieldLTE(FieldLoanedTo, v))
+}
+
+// LoanedToContains applies the Contains predicate on the "loaned_to" field.
+func LoanedToContains(v string) predicate.Item {
+	return predicate.Item(sql.FieldContains(FieldLoanedTo, v))
+}
+
+// LoanedToHasPrefix applies the HasPrefix predicate on the "loaned_to" field.
+func LoanedToHasPrefix(v string) predicate.Item {
+	return predicate.Item(sql.FieldHasPrefix(FieldLoanedTo, v))
+}
+
+// LoanedToHasSuffix applies the HasSuffix predicate on the "loaned_to" field.
+func LoanedToHasSuffix(v string) predicate.Item {
+	return predicate.Item(sql.FieldHasSuffix(FieldLoanedTo, v))
+}
+
+// LoanedToIsNil applies the IsNil predicate on the "loaned_to" field.
+func LoanedToIsNil() predicate.Item {
+	return predicate.Item(sql.FieldIsNull(FieldLoanedTo))
+}
+
+// LoanedToNotNil applies the NotNil predicate on the "loaned_to" field.
+func LoanedToNotNil() predicate.Item {
+	return predicate.Item(sql.FieldNotNull(FieldLoanedTo))
+}
+
+// LoanedToEqualFold applies the EqualFold predicate on the "loaned_to" field.
+func LoanedToEqualFold(v string) predicate.Item {
+	return predicate.Item(sql.FieldEqualFold(FieldLoanedTo, v))
+}
+
+// LoanedToContainsFold applies the ContainsFold predicate on the "loaned_to" field.
+func LoanedToContainsFold(v string) predicate.Item {
+	return predicate.Item(sql.FieldContainsFold(FieldLoanedTo, v))
+}
+
+// LoanedAtEQ applies the EQ predicate on the "loaned_at" field.
+func LoanedAtEQ(v time.Time) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldLoanedAt, v))
+}
+
+// LoanedAtNEQ applies the NEQ predicate on the "loaned_at" field.
+func LoanedAtNEQ(v time.Time) predicate.Item {
+	return predicate.Item(sql.FieldNEQ(FieldLoanedAt, v))
+}
+
+// LoanedAtIn applies the In predicate on the "loaned_at" field.
+func LoanedAtIn(vs ...time.Time) predicate.Item {
+	return predicate.Item(sql.FieldIn(FieldLoanedAt, vs...))
+}
+
+// LoanedAtNotIn applies the NotIn predicate on the "loaned_at" field.
+func LoanedAtNotIn(vs ...time.Time) predicate.Item {
+	return predicate.Item(sql.FieldNotIn(FieldLoanedAt, vs...))
+}
+
+// LoanedAtGT applies the GT predicate on the "loaned_at" field.
+func LoanedAtGT(v time.Time) predicate.Item {
+	return predicate.Item(sql.FieldGT(FieldLoanedAt, v))
+}
+
+// LoanedAtGTE applies the GTE predicate on the "loaned_at" field.
+func LoanedAtGTE(v time.Time) predicate.Item {
+	return predicate.Item(sql.FieldGTE(FieldLoanedAt, v))
+}
+
+// LoanedAtLT applies the LT predicate on the "loaned_at" field.
+func LoanedAtLT(v time.Time) predicate.Item {
+	return predicate.Item(sql.FieldLT(FieldLoanedAt, v))
+}
+
+// LoanedAtLTE applies the LTE predicate on the "loaned_at" field.
+func LoanedAtLTE(v time.Time) predicate.Item {
+	return predicate.Item(sql.FieldLTE(FieldLoanedAt, v))
+}
+
+// LoanedAtIsNil applies the IsNil predicate on the "loaned_at" field.
+func LoanedAtIsNil() predicate.Item {
+	return predicate.Item(sql.FieldIsNull(FieldLoanedAt))
+}
+
+// LoanedAtNotNil applies the NotNil predicate on the "loaned_at" field.
+func LoanedAtNotNil() predicate.Item {
+	return predicate.Item(sql.FieldNotNull(FieldLoanedAt))
+}
+
+// LoanDueEQ applies the EQ predicate on the "loan_due" field.
+func LoanDueEQ(v time.Time) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldLoanDue, v))
+}
+
+// LoanDueNEQ applies the NEQ predicate on the "loan_due" field.
+func LoanDueNEQ(v time.Time) predicate.Item {
+	return predicate.Item(sql.FieldNEQ(FieldLoanDue, v))
+}
+
+// LoanDueIn applies the In predicate on the "loan_due" field.
+func LoanDueIn(vs ...time.Time) predicate.Item {
+	return predicate.Item(sql.FieldIn(FieldLoanDue, vs...))
+}
+
+// LoanDueNotIn applies the NotIn predicate on the "loan_due" field.
+func LoanDueNotIn(vs ...time.Time) predicate.Item {
+	return predicate.Item(sql.FieldNotIn(FieldLoanDue, vs...))
+}
+
+// LoanDueGT applies the GT predicate on the "loan_due" field.
+func LoanDueGT(v time.Time) predicate.Item {
+	return predicate.Item(sql.FieldGT(FieldLoanDue, v))
+}
+
+// LoanDueGTE applies the GTE predicate on the "loan_due" field.
+func LoanDueGTE(v time.Time) predicate.Item {
+	return predicate.Item(sql.FieldGTE(FieldLoanDue, v))
+}
+
+// LoanDueLT applies the LT predicate on the "loan_due" field.
+func LoanDueLT(v time.Time) predicate.Item {
+	return predicate.Item(sql.FieldLT(FieldLoanDue, v))
+}
+
+// LoanDueLTE applies the LTE predicate on the "loan_due" field.
+func LoanDueLTE(v time.Time) predicate.Item {
+	return predicate.Item(sql.FieldLTE(FieldLoanDue, v))
+}
+
+// LoanDueIsNil applies the IsNil predicate on the "loan_due" field.
+func LoanDueIsNil() predicate.Item {
+	return predicate.Item(sql.FieldIsNull(FieldLoanDue))
+}
+
+// LoanDueNotNil applies the NotNil predicate on the "loan_due" field.
+func LoanDueNotNil() predicate.Item {
+	return predicate.Item(sql.FieldNotNull(FieldLoanDue))
+}
+
 // SoldTimeEQ applies the EQ predicate on the "sold_time" field.
 func SoldTimeEQ(v time.Time) predicate.Item {
 	return predicate.Item(sql.FieldEQ(FieldSoldTime, v))
@@ -1475,6 +2290,29 @@ func HasChildrenWith(preds ...predicate.Item) predicate.Item {
 	})
 }
 
+// HasRelated applies the HasEdge predicate on the "related" edge.
+func HasRelated() predicate.Item {
+	return predicate.Item(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, RelatedTable, RelatedPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRelatedWith applies the HasEdge predicate on the "related" edge with a given conditions (other predicates).
+func HasRelatedWith(preds ...predicate.Item) predicate.Item {
+	return predicate.Item(func(s *sql.Selector) {
+		step := newRelatedStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // HasLabel applies the HasEdge predicate on the "label" edge.
 func HasLabel() predicate.Item {
 	return predicate.Item(func(s *sql.Selector) {
@@ -1590,6 +2428,29 @@ func HasAttachmentsWith(preds ...predicate.Attachment) predicate.Item {
 	})
 }
 
+// HasComments applies the HasEdge predicate on the "comments" edge.
+func HasComments() predicate.Item {
+	return predicate.Item(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, CommentsTable, CommentsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCommentsWith applies the HasEdge predicate on the "comments" edge with a given conditions (other predicates).
+func HasCommentsWith(preds ...predicate.ItemComment) predicate.Item {
+	return predicate.Item(func(s *sql.Selector) {
+		step := newCommentsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Item) predicate.Item {
 	return predicate.Item(sql.AndPredicates(predicates...))
@@ -14,6 +14,7 @@ import (
 	"github.com/hay-kot/homebox/backend/internal/data/ent/attachment"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/group"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/item"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/itemcomment"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/itemfield"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/label"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/location"
@@ -103,6 +104,20 @@ func (ic *ItemCreate) SetNillableNotes(s *string) *ItemCreate {
 	return ic
 }
 
+// SetNotesFormat sets the "notes_format" field.
+func (ic *ItemCreate) SetNotesFormat(_if item.NotesFormat) *ItemCreate {
+	ic.mutation.SetNotesFormat(_if)
+	return ic
+}
+
+// SetNillableNotesFormat sets the "notes_format" field if the given value is not nil.
+func (ic *ItemCreate) SetNillableNotesFormat(_if *item.NotesFormat) *ItemCreate {
+	if _if != nil {
+		ic.SetNotesFormat(*_if)
+	}
+	return ic
+}
+
 // SetQuantity sets the "quantity" field.
 func (ic *ItemCreate) SetQuantity(i int) *ItemCreate {
 	ic.mutation.SetQuantity(i)
@@ -145,6 +160,48 @@ func (ic *ItemCreate) SetNillableArchived(b *bool) *ItemCreate {
 	return ic
 }
 
+// SetFavorite sets the "favorite" field.
+func (ic *ItemCreate) SetFavorite(b bool) *ItemCreate {
+	ic.mutation.SetFavorite(b)
+	return ic
+}
+
+// SetNillableFavorite sets the "favorite" field if the given value is not nil.
+func (ic *ItemCreate) SetNillableFavorite(b *bool) *ItemCreate {
+	if b != nil {
+		ic.SetFavorite(*b)
+	}
+	return ic
+}
+
+// SetVersion sets the "version" field.
+func (ic *ItemCreate) SetVersion(i int) *ItemCreate {
+	ic.mutation.SetVersion(i)
+	return ic
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (ic *ItemCreate) SetNillableVersion(i *int) *ItemCreate {
+	if i != nil {
+		ic.SetVersion(*i)
+	}
+	return ic
+}
+
+// SetArchivedAt sets the "archived_at" field.
+func (ic *ItemCreate) SetArchivedAt(t time.Time) *ItemCreate {
+	ic.mutation.SetArchivedAt(t)
+	return ic
+}
+
+// SetNillableArchivedAt sets the "archived_at" field if the given value is not nil.
+func (ic *ItemCreate) SetNillableArchivedAt(t *time.Time) *ItemCreate {
+	if t != nil {
+		ic.SetArchivedAt(*t)
+	}
+	return ic
+}
+
 // SetAssetID sets the "asset_id" field.
 func (ic *ItemCreate) SetAssetID(i int) *ItemCreate {
 	ic.mutation.SetAssetID(i)
@@ -159,6 +216,82 @@ func (ic *ItemCreate) SetNillableAssetID(i *int) *ItemCreate {
 	return ic
 }
 
+// SetReorderThreshold sets the "reorder_threshold" field.
+func (ic *ItemCreate) SetReorderThreshold(i int) *ItemCreate {
+	ic.mutation.SetReorderThreshold(i)
+	return ic
+}
+
+// SetNillableReorderThreshold sets the "reorder_threshold" field if the given value is not nil.
+func (ic *ItemCreate) SetNillableReorderThreshold(i *int) *ItemCreate {
+	if i != nil {
+		ic.SetReorderThreshold(*i)
+	}
+	return ic
+}
+
+// SetTags sets the "tags" field.
+func (ic *ItemCreate) SetTags(s []string) *ItemCreate {
+	ic.mutation.SetTags(s)
+	return ic
+}
+
+// SetSource sets the "source" field.
+func (ic *ItemCreate) SetSource(i item.Source) *ItemCreate {
+	ic.mutation.SetSource(i)
+	return ic
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (ic *ItemCreate) SetNillableSource(i *item.Source) *ItemCreate {
+	if i != nil {
+		ic.SetSource(*i)
+	}
+	return ic
+}
+
+// SetAcquisitionType sets the "acquisition_type" field.
+func (ic *ItemCreate) SetAcquisitionType(it item.AcquisitionType) *ItemCreate {
+	ic.mutation.SetAcquisitionType(it)
+	return ic
+}
+
+// SetNillableAcquisitionType sets the "acquisition_type" field if the given value is not nil.
+func (ic *ItemCreate) SetNillableAcquisitionType(it *item.AcquisitionType) *ItemCreate {
+	if it != nil {
+		ic.SetAcquisitionType(*it)
+	}
+	return ic
+}
+
+// SetLatitude sets the "latitude" field.
+func (ic *ItemCreate) SetLatitude(f float64) *ItemCreate {
+	ic.mutation.SetLatitude(f)
+	return ic
+}
+
+// SetNillableLatitude sets the "latitude" field if the given value is not nil.
+func (ic *ItemCreate) SetNillableLatitude(f *float64) *ItemCreate {
+	if f != nil {
+		ic.SetLatitude(*f)
+	}
+	return ic
+}
+
+// SetLongitude sets the "longitude" field.
+func (ic *ItemCreate) SetLongitude(f float64) *ItemCreate {
+	ic.mutation.SetLongitude(f)
+	return ic
+}
+
+// SetNillableLongitude sets the "longitude" field if the given value is not nil.
+func (ic *ItemCreate) SetNillableLongitude(f *float64) *ItemCreate {
+	if f != nil {
+		ic.SetLongitude(*f)
+	}
+	return ic
+}
+
 // SetSerialNumber sets the "serial_number" field.
 func (ic *ItemCreate) SetSerialNumber(s string) *ItemCreate {
 	ic.mutation.SetSerialNumber(s)
@@ -173,6 +306,20 @@ func (ic *ItemCreate) SetNillableSerialNumber(s *string) *ItemCreate {
 	return ic
 }
 
+// SetBarcode sets the "barcode" field.
+func (ic *ItemCreate) SetBarcode(s string) *ItemCreate {
+	ic.mutation.SetBarcode(s)
+	return ic
+}
+
+// SetNillableBarcode sets the "barcode" field if the given value is not nil.
+func (ic *ItemCreate) SetNillableBarcode(s *string) *ItemCreate {
+	if s != nil {
+		ic.SetBarcode(*s)
+	}
+	return ic
+}
+
 // SetModelNumber sets the "model_number" field.
 func (ic *ItemCreate) SetModelNumber(s string) *ItemCreate {
 	ic.mutation.SetModelNumber(s)
@@ -271,6 +418,20 @@ func (ic *ItemCreate) SetNillablePurchaseFrom(s *string) *ItemCreate {
 	return ic
 }
 
+// SetPurchaseOrderNumber sets the "purchase_order_number" field.
+func (ic *ItemCreate) SetPurchaseOrderNumber(s string) *ItemCreate {
+	ic.mutation.SetPurchaseOrderNumber(s)
+	return ic
+}
+
+// SetNillablePurchaseOrderNumber sets the "purchase_order_number" field if the given value is not nil.
+func (ic *ItemCreate) SetNillablePurchaseOrderNumber(s *string) *ItemCreate {
+	if s != nil {
+		ic.SetPurchaseOrderNumber(*s)
+	}
+	return ic
+}
+
 // SetPurchasePrice sets the "purchase_price" field.
 func (ic *ItemCreate) SetPurchasePrice(f float64) *ItemCreate {
 	ic.mutation.SetPurchasePrice(f)
@@ -285,6 +446,76 @@ func (ic *ItemCreate) SetNillablePurchasePrice(f *float64) *ItemCreate {
 	return ic
 }
 
+// SetCurrency sets the "currency" field.
+func (ic *ItemCreate) SetCurrency(s string) *ItemCreate {
+	ic.mutation.SetCurrency(s)
+	return ic
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (ic *ItemCreate) SetNillableCurrency(s *string) *ItemCreate {
+	if s != nil {
+		ic.SetCurrency(*s)
+	}
+	return ic
+}
+
+// SetReplacementCost sets the "replacement_cost" field.
+func (ic *ItemCreate) SetReplacementCost(f float64) *ItemCreate {
+	ic.mutation.SetReplacementCost(f)
+	return ic
+}
+
+// SetNillableReplacementCost sets the "replacement_cost" field if the given value is not nil.
+func (ic *ItemCreate) SetNillableReplacementCost(f *float64) *ItemCreate {
+	if f != nil {
+		ic.SetReplacementCost(*f)
+	}
+	return ic
+}
+
+// SetLoanedTo sets the "loaned_to" field.
+func (ic *ItemCreate) SetLoanedTo(s string) *ItemCreate {
+	ic.mutation.SetLoanedTo(s)
+	return ic
+}
+
+// SetNillableLoanedTo sets the "loaned_to" field if the given value is not nil.
+func (ic *ItemCreate) SetNillableLoanedTo(s *string) *ItemCreate {
+	if s != nil {
+		ic.SetLoanedTo(*s)
+	}
+	return ic
+}
+
+// SetLoanedAt sets the "loaned_at" field.
+func (ic *ItemCreate) SetLoanedAt(t time.Time) *ItemCreate {
+	ic.mutation.SetLoanedAt(t)
+	return ic
+}
+
+// SetNillableLoanedAt sets the "loaned_at" field if the given value is not nil.
+func (ic *ItemCreate) SetNillableLoanedAt(t *time.Time) *ItemCreate {
+	if t != nil {
+		ic.SetLoanedAt(*t)
+	}
+	return ic
+}
+
+// SetLoanDue sets the "loan_due" field.
+func (ic *ItemCreate) SetLoanDue(t time.Time) *ItemCreate {
+	ic.mutation.SetLoanDue(t)
+	return ic
+}
+
+// SetNillableLoanDue sets the "loan_due" field if the given value is not nil.
+func (ic *ItemCreate) SetNillableLoanDue(t *time.Time) *ItemCreate {
+	if t != nil {
+		ic.SetLoanDue(*t)
+	}
+	return ic
+}
+
 // SetSoldTime sets the "sold_time" field.
 func (ic *ItemCreate) SetSoldTime(t time.Time) *ItemCreate {
 	ic.mutation.SetSoldTime(t)
@@ -400,6 +631,21 @@ func (ic *ItemCreate) AddChildren(i ...*Item) *ItemCreate {
 	return ic.AddChildIDs(ids...)
 }
 
+// AddRelatedIDs adds the "related" edge to the Item entity by IDs.
+func (ic *ItemCreate) AddRelatedIDs(ids ...uuid.UUID) *ItemCreate {
+	ic.mutation.AddRelatedIDs(ids...)
+	return ic
+}
+
+// AddRelated adds the "related" edges to the Item entity.
+func (ic *ItemCreate) AddRelated(i ...*Item) *ItemCreate {
+	ids := make([]uuid.UUID, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return ic.AddRelatedIDs(ids...)
+}
+
 // AddLabelIDs adds the "label" edge to the Label entity by IDs.
 func (ic *ItemCreate) AddLabelIDs(ids ...uuid.UUID) *ItemCreate {
 	ic.mutation.AddLabelIDs(ids...)
@@ -479,6 +725,21 @@ func (ic *ItemCreate) AddAttachments(a ...*Attachment) *ItemCreate {
 	return ic.AddAttachmentIDs(ids...)
 }
 
+// AddCommentIDs adds the "comments" edge to the ItemComment entity by IDs.
+func (ic *ItemCreate) AddCommentIDs(ids ...uuid.UUID) *ItemCreate {
+	ic.mutation.AddCommentIDs(ids...)
+	return ic
+}
+
+// AddComments adds the "comments" edges to the ItemComment entity.
+func (ic *ItemCreate) AddComments(i ...*ItemComment) *ItemCreate {
+	ids := make([]uuid.UUID, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return ic.AddCommentIDs(ids...)
+}
+
 // Mutation returns the ItemMutation object of the builder.
 func (ic *ItemCreate) Mutation() *ItemMutation {
 	return ic.mutation
@@ -486,7 +747,9 @@ func (ic *ItemCreate) Mutation() *ItemMutation {
 
 // Save creates the Item in the database.
 func (ic *ItemCreate) Save(ctx context.Context) (*Item, error) {
-	ic.defaults()
+	if err := ic.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, ic.sqlSave, ic.mutation, ic.hooks)
 }
 
@@ -513,15 +776,25 @@ func (ic *ItemCreate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (ic *ItemCreate) defaults() {
+func (ic *ItemCreate) defaults() error {
 	if _, ok := ic.mutation.CreatedAt(); !ok {
+		if item.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized item.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
 		v := item.DefaultCreatedAt()
 		ic.mutation.SetCreatedAt(v)
 	}
 	if _, ok := ic.mutation.UpdatedAt(); !ok {
+		if item.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized item.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
 		v := item.DefaultUpdatedAt()
 		ic.mutation.SetUpdatedAt(v)
 	}
+	if _, ok := ic.mutation.NotesFormat(); !ok {
+		v := item.DefaultNotesFormat
+		ic.mutation.SetNotesFormat(v)
+	}
 	if _, ok := ic.mutation.Quantity(); !ok {
 		v := item.DefaultQuantity
 		ic.mutation.SetQuantity(v)
@@ -534,10 +807,30 @@ func (ic *ItemCreate) defaults() {
 		v := item.DefaultArchived
 		ic.mutation.SetArchived(v)
 	}
+	if _, ok := ic.mutation.Favorite(); !ok {
+		v := item.DefaultFavorite
+		ic.mutation.SetFavorite(v)
+	}
+	if _, ok := ic.mutation.Version(); !ok {
+		v := item.DefaultVersion
+		ic.mutation.SetVersion(v)
+	}
 	if _, ok := ic.mutation.AssetID(); !ok {
 		v := item.DefaultAssetID
 		ic.mutation.SetAssetID(v)
 	}
+	if _, ok := ic.mutation.ReorderThreshold(); !ok {
+		v := item.DefaultReorderThreshold
+		ic.mutation.SetReorderThreshold(v)
+	}
+	if _, ok := ic.mutation.Source(); !ok {
+		v := item.DefaultSource
+		ic.mutation.SetSource(v)
+	}
+	if _, ok := ic.mutation.AcquisitionType(); !ok {
+		v := item.DefaultAcquisitionType
+		ic.mutation.SetAcquisitionType(v)
+	}
 	if _, ok := ic.mutation.LifetimeWarranty(); !ok {
 		v := item.DefaultLifetimeWarranty
 		ic.mutation.SetLifetimeWarranty(v)
@@ -546,14 +839,22 @@ func (ic *ItemCreate) defaults() {
 		v := item.DefaultPurchasePrice
 		ic.mutation.SetPurchasePrice(v)
 	}
+	if _, ok := ic.mutation.ReplacementCost(); !ok {
+		v := item.DefaultReplacementCost
+		ic.mutation.SetReplacementCost(v)
+	}
 	if _, ok := ic.mutation.SoldPrice(); !ok {
 		v := item.DefaultSoldPrice
 		ic.mutation.SetSoldPrice(v)
 	}
 	if _, ok := ic.mutation.ID(); !ok {
+		if item.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized item.DefaultID (forgotten import ent/runtime?)")
+		}
 		v := item.DefaultID()
 		ic.mutation.SetID(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -587,6 +888,14 @@ func (ic *ItemCreate) check() error {
 			return &ValidationError{Name: "notes", err: fmt.Errorf(`ent: validator failed for field "Item.notes": %w`, err)}
 		}
 	}
+	if _, ok := ic.mutation.NotesFormat(); !ok {
+		return &ValidationError{Name: "notes_format", err: errors.New(`ent: missing required field "Item.notes_format"`)}
+	}
+	if v, ok := ic.mutation.NotesFormat(); ok {
+		if err := item.NotesFormatValidator(v); err != nil {
+			return &ValidationError{Name: "notes_format", err: fmt.Errorf(`ent: validator failed for field "Item.notes_format": %w`, err)}
+		}
+	}
 	if _, ok := ic.mutation.Quantity(); !ok {
 		return &ValidationError{Name: "quantity", err: errors.New(`ent: missing required field "Item.quantity"`)}
 	}
@@ -596,14 +905,44 @@ func (ic *ItemCreate) check() error {
 	if _, ok := ic.mutation.Archived(); !ok {
 		return &ValidationError{Name: "archived", err: errors.New(`ent: missing required field "Item.archived"`)}
 	}
+	if _, ok := ic.mutation.Favorite(); !ok {
+		return &ValidationError{Name: "favorite", err: errors.New(`ent: missing required field "Item.favorite"`)}
+	}
+	if _, ok := ic.mutation.Version(); !ok {
+		return &ValidationError{Name: "version", err: errors.New(`ent: missing required field "Item.version"`)}
+	}
 	if _, ok := ic.mutation.AssetID(); !ok {
 		return &ValidationError{Name: "asset_id", err: errors.New(`ent: missing required field "Item.asset_id"`)}
 	}
+	if _, ok := ic.mutation.ReorderThreshold(); !ok {
+		return &ValidationError{Name: "reorder_threshold", err: errors.New(`ent: missing required field "Item.reorder_threshold"`)}
+	}
+	if _, ok := ic.mutation.Source(); !ok {
+		return &ValidationError{Name: "source", err: errors.New(`ent: missing required field "Item.source"`)}
+	}
+	if v, ok := ic.mutation.Source(); ok {
+		if err := item.SourceValidator(v); err != nil {
+			return &ValidationError{Name: "source", err: fmt.Errorf(`ent: validator failed for field "Item.source": %w`, err)}
+		}
+	}
+	if _, ok := ic.mutation.AcquisitionType(); !ok {
+		return &ValidationError{Name: "acquisition_type", err: errors.New(`ent: missing required field "Item.acquisition_type"`)}
+	}
+	if v, ok := ic.mutation.AcquisitionType(); ok {
+		if err := item.AcquisitionTypeValidator(v); err != nil {
+			return &ValidationError{Name: "acquisition_type", err: fmt.Errorf(`ent: validator failed for field "Item.acquisition_type": %w`, err)}
+		}
+	}
 	if v, ok := ic.mutation.SerialNumber(); ok {
 		if err := item.SerialNumberValidator(v); err != nil {
 			return &ValidationError{Name: "serial_number", err: fmt.Errorf(`ent: validator failed for field "Item.serial_number": %w`, err)}
 		}
 	}
+	if v, ok := ic.mutation.Barcode(); ok {
+		if err := item.BarcodeValidator(v); err != nil {
+			return &ValidationError{Name: "barcode", err: fmt.Errorf(`ent: validator failed for field "Item.barcode": %w`, err)}
+		}
+	}
 	if v, ok := ic.mutation.ModelNumber(); ok {
 		if err := item.ModelNumberValidator(v); err != nil {
 			return &ValidationError{Name: "model_number", err: fmt.Errorf(`ent: validator failed for field "Item.model_number": %w`, err)}
@@ -622,9 +961,27 @@ func (ic *ItemCreate) check() error {
 			return &ValidationError{Name: "warranty_details", err: fmt.Errorf(`ent: validator failed for field "Item.warranty_details": %w`, err)}
 		}
 	}
+	if v, ok := ic.mutation.PurchaseOrderNumber(); ok {
+		if err := item.PurchaseOrderNumberValidator(v); err != nil {
+			return &ValidationError{Name: "purchase_order_number", err: fmt.Errorf(`ent: validator failed for field "Item.purchase_order_number": %w`, err)}
+		}
+	}
 	if _, ok := ic.mutation.PurchasePrice(); !ok {
 		return &ValidationError{Name: "purchase_price", err: errors.New(`ent: missing required field "Item.purchase_price"`)}
 	}
+	if v, ok := ic.mutation.Currency(); ok {
+		if err := item.CurrencyValidator(v); err != nil {
+			return &ValidationError{Name: "currency", err: fmt.Errorf(`ent: validator failed for field "Item.currency": %w`, err)}
+		}
+	}
+	if _, ok := ic.mutation.ReplacementCost(); !ok {
+		return &ValidationError{Name: "replacement_cost", err: errors.New(`ent: missing required field "Item.replacement_cost"`)}
+	}
+	if v, ok := ic.mutation.LoanedTo(); ok {
+		if err := item.LoanedToValidator(v); err != nil {
+			return &ValidationError{Name: "loaned_to", err: fmt.Errorf(`ent: validator failed for field "Item.loaned_to": %w`, err)}
+		}
+	}
 	if _, ok := ic.mutation.SoldPrice(); !ok {
 		return &ValidationError{Name: "sold_price", err: errors.New(`ent: missing required field "Item.sold_price"`)}
 	}
@@ -695,6 +1052,10 @@ func (ic *ItemCreate) createSpec() (*Item, *sqlgraph.CreateSpec) {
 		_spec.SetField(item.FieldNotes, field.TypeString, value)
 		_node.Notes = value
 	}
+	if value, ok := ic.mutation.NotesFormat(); ok {
+		_spec.SetField(item.FieldNotesFormat, field.TypeEnum, value)
+		_node.NotesFormat = value
+	}
 	if value, ok := ic.mutation.Quantity(); ok {
 		_spec.SetField(item.FieldQuantity, field.TypeInt, value)
 		_node.Quantity = value
@@ -707,14 +1068,54 @@ func (ic *ItemCreate) createSpec() (*Item, *sqlgraph.CreateSpec) {
 		_spec.SetField(item.FieldArchived, field.TypeBool, value)
 		_node.Archived = value
 	}
+	if value, ok := ic.mutation.Favorite(); ok {
+		_spec.SetField(item.FieldFavorite, field.TypeBool, value)
+		_node.Favorite = value
+	}
+	if value, ok := ic.mutation.Version(); ok {
+		_spec.SetField(item.FieldVersion, field.TypeInt, value)
+		_node.Version = value
+	}
+	if value, ok := ic.mutation.ArchivedAt(); ok {
+		_spec.SetField(item.FieldArchivedAt, field.TypeTime, value)
+		_node.ArchivedAt = &value
+	}
 	if value, ok := ic.mutation.AssetID(); ok {
 		_spec.SetField(item.FieldAssetID, field.TypeInt, value)
 		_node.AssetID = value
 	}
+	if value, ok := ic.mutation.ReorderThreshold(); ok {
+		_spec.SetField(item.FieldReorderThreshold, field.TypeInt, value)
+		_node.ReorderThreshold = value
+	}
+	if value, ok := ic.mutation.Tags(); ok {
+		_spec.SetField(item.FieldTags, field.TypeJSON, value)
+		_node.Tags = value
+	}
+	if value, ok := ic.mutation.Source(); ok {
+		_spec.SetField(item.FieldSource, field.TypeEnum, value)
+		_node.Source = value
+	}
+	if value, ok := ic.mutation.AcquisitionType(); ok {
+		_spec.SetField(item.FieldAcquisitionType, field.TypeEnum, value)
+		_node.AcquisitionType = value
+	}
+	if value, ok := ic.mutation.Latitude(); ok {
+		_spec.SetField(item.FieldLatitude, field.TypeFloat64, value)
+		_node.Latitude = &value
+	}
+	if value, ok := ic.mutation.Longitude(); ok {
+		_spec.SetField(item.FieldLongitude, field.TypeFloat64, value)
+		_node.Longitude = &value
+	}
 	if value, ok := ic.mutation.SerialNumber(); ok {
 		_spec.SetField(item.FieldSerialNumber, field.TypeString, value)
 		_node.SerialNumber = value
 	}
+	if value, ok := ic.mutation.Barcode(); ok {
+		_spec.SetField(item.FieldBarcode, field.TypeString, value)
+		_node.Barcode = value
+	}
 	if value, ok := ic.mutation.ModelNumber(); ok {
 		_spec.SetField(item.FieldModelNumber, field.TypeString, value)
 		_node.ModelNumber = value
@@ -743,10 +1144,34 @@ func (ic *ItemCreate) createSpec() (*Item, *sqlgraph.CreateSpec) {
 		_spec.SetField(item.FieldPurchaseFrom, field.TypeString, value)
 		_node.PurchaseFrom = value
 	}
+	if value, ok := ic.mutation.PurchaseOrderNumber(); ok {
+		_spec.SetField(item.FieldPurchaseOrderNumber, field.TypeString, value)
+		_node.PurchaseOrderNumber = value
+	}
 	if value, ok := ic.mutation.PurchasePrice(); ok {
 		_spec.SetField(item.FieldPurchasePrice, field.TypeFloat64, value)
 		_node.PurchasePrice = value
 	}
+	if value, ok := ic.mutation.Currency(); ok {
+		_spec.SetField(item.FieldCurrency, field.TypeString, value)
+		_node.Currency = value
+	}
+	if value, ok := ic.mutation.ReplacementCost(); ok {
+		_spec.SetField(item.FieldReplacementCost, field.TypeFloat64, value)
+		_node.ReplacementCost = value
+	}
+	if value, ok := ic.mutation.LoanedTo(); ok {
+		_spec.SetField(item.FieldLoanedTo, field.TypeString, value)
+		_node.LoanedTo = value
+	}
+	if value, ok := ic.mutation.LoanedAt(); ok {
+		_spec.SetField(item.FieldLoanedAt, field.TypeTime, value)
+		_node.LoanedAt = &value
+	}
+	if value, ok := ic.mutation.LoanDue(); ok {
+		_spec.SetField(item.FieldLoanDue, field.TypeTime, value)
+		_node.LoanDue = &value
+	}
 	if value, ok := ic.mutation.SoldTime(); ok {
 		_spec.SetField(item.FieldSoldTime, field.TypeTime, value)
 		_node.SoldTime = value
@@ -813,6 +1238,22 @@ func (ic *ItemCreate) createSpec() (*Item, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := ic.mutation.RelatedIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   item.RelatedTable,
+			Columns: item.RelatedPrimaryKey,
+			Bidi:    true,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	if nodes := ic.mutation.LabelIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
@@ -894,6 +1335,22 @@ func (ic *ItemCreate) createSpec() (*Item, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := ic.mutation.CommentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   item.CommentsTable,
+			Columns: []string{item.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemcomment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
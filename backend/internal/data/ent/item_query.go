@@ -15,6 +15,7 @@ import (
 	"github.com/hay-kot/homebox/backend/internal/data/ent/attachment"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/group"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/item"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/itemcomment"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/itemfield"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/label"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/location"
@@ -32,11 +33,13 @@ type ItemQuery struct {
 	withGroup              *GroupQuery
 	withParent             *ItemQuery
 	withChildren           *ItemQuery
+	withRelated            *ItemQuery
 	withLabel              *LabelQuery
 	withLocation           *LocationQuery
 	withFields             *ItemFieldQuery
 	withMaintenanceEntries *MaintenanceEntryQuery
 	withAttachments        *AttachmentQuery
+	withComments           *ItemCommentQuery
 	withFKs                bool
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
@@ -140,6 +143,28 @@ func (iq *ItemQuery) QueryChildren() *ItemQuery {
 	return query
 }
 
+// QueryRelated chains the current query on the "related" edge.
+func (iq *ItemQuery) QueryRelated() *ItemQuery {
+	query := (&ItemClient{config: iq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := iq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := iq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(item.Table, item.FieldID, selector),
+			sqlgraph.To(item.Table, item.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, item.RelatedTable, item.RelatedPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(iq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // QueryLabel chains the current query on the "label" edge.
 func (iq *ItemQuery) QueryLabel() *LabelQuery {
 	query := (&LabelClient{config: iq.config}).Query()
@@ -250,6 +275,28 @@ func (iq *ItemQuery) QueryAttachments() *AttachmentQuery {
 	return query
 }
 
+// QueryComments chains the current query on the "comments" edge.
+func (iq *ItemQuery) QueryComments() *ItemCommentQuery {
+	query := (&ItemCommentClient{config: iq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := iq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := iq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(item.Table, item.FieldID, selector),
+			sqlgraph.To(itemcomment.Table, itemcomment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, item.CommentsTable, item.CommentsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(iq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Item entity from the query.
 // Returns a *NotFoundError when no Item was found.
 func (iq *ItemQuery) First(ctx context.Context) (*Item, error) {
@@ -445,11 +492,13 @@ func (iq *ItemQuery) Clone() *ItemQuery {
 		withGroup:              iq.withGroup.Clone(),
 		withParent:             iq.withParent.Clone(),
 		withChildren:           iq.withChildren.Clone(),
+		withRelated:            iq.withRelated.Clone(),
 		withLabel:              iq.withLabel.Clone(),
 		withLocation:           iq.withLocation.Clone(),
 		withFields:             iq.withFields.Clone(),
 		withMaintenanceEntries: iq.withMaintenanceEntries.Clone(),
 		withAttachments:        iq.withAttachments.Clone(),
+		withComments:           iq.withComments.Clone(),
 		// clone intermediate query.
 		sql:  iq.sql.Clone(),
 		path: iq.path,
@@ -489,6 +538,17 @@ func (iq *ItemQuery) WithChildren(opts ...func(*ItemQuery)) *ItemQuery {
 	return iq
 }
 
+// WithRelated tells the query-builder to eager-load the nodes that are connected to
+// the "related" edge. The optional arguments are used to configure the query builder of the edge.
+func (iq *ItemQuery) WithRelated(opts ...func(*ItemQuery)) *ItemQuery {
+	query := (&ItemClient{config: iq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	iq.withRelated = query
+	return iq
+}
+
 // WithLabel tells the query-builder to eager-load the nodes that are connected to
 // the "label" edge. The optional arguments are used to configure the query builder of the edge.
 func (iq *ItemQuery) WithLabel(opts ...func(*LabelQuery)) *ItemQuery {
@@ -544,6 +604,17 @@ func (iq *ItemQuery) WithAttachments(opts ...func(*AttachmentQuery)) *ItemQuery
 	return iq
 }
 
+// WithComments tells the query-builder to eager-load the nodes that are connected to
+// the "comments" edge. The optional arguments are used to configure the query builder of the edge.
+func (iq *ItemQuery) WithComments(opts ...func(*ItemCommentQuery)) *ItemQuery {
+	query := (&ItemCommentClient{config: iq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	iq.withComments = query
+	return iq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -623,15 +694,17 @@ func (iq *ItemQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Item, e
 		nodes       = []*Item{}
 		withFKs     = iq.withFKs
 		_spec       = iq.querySpec()
-		loadedTypes = [8]bool{
+		loadedTypes = [10]bool{
 			iq.withGroup != nil,
 			iq.withParent != nil,
 			iq.withChildren != nil,
+			iq.withRelated != nil,
 			iq.withLabel != nil,
 			iq.withLocation != nil,
 			iq.withFields != nil,
 			iq.withMaintenanceEntries != nil,
 			iq.withAttachments != nil,
+			iq.withComments != nil,
 		}
 	)
 	if iq.withGroup != nil || iq.withParent != nil || iq.withLocation != nil {
@@ -677,6 +750,13 @@ func (iq *ItemQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Item, e
 			return nil, err
 		}
 	}
+	if query := iq.withRelated; query != nil {
+		if err := iq.loadRelated(ctx, query, nodes,
+			func(n *Item) { n.Edges.Related = []*Item{} },
+			func(n *Item, e *Item) { n.Edges.Related = append(n.Edges.Related, e) }); err != nil {
+			return nil, err
+		}
+	}
 	if query := iq.withLabel; query != nil {
 		if err := iq.loadLabel(ctx, query, nodes,
 			func(n *Item) { n.Edges.Label = []*Label{} },
@@ -711,6 +791,13 @@ func (iq *ItemQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Item, e
 			return nil, err
 		}
 	}
+	if query := iq.withComments; query != nil {
+		if err := iq.loadComments(ctx, query, nodes,
+			func(n *Item) { n.Edges.Comments = []*ItemComment{} },
+			func(n *Item, e *ItemComment) { n.Edges.Comments = append(n.Edges.Comments, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -809,6 +896,67 @@ func (iq *ItemQuery) loadChildren(ctx context.Context, query *ItemQuery, nodes [
 	}
 	return nil
 }
+func (iq *ItemQuery) loadRelated(ctx context.Context, query *ItemQuery, nodes []*Item, init func(*Item), assign func(*Item, *Item)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[uuid.UUID]*Item)
+	nids := make(map[uuid.UUID]map[*Item]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(item.RelatedTable)
+		s.Join(joinT).On(s.C(item.FieldID), joinT.C(item.RelatedPrimaryKey[1]))
+		s.Where(sql.InValues(joinT.C(item.RelatedPrimaryKey[0]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(item.RelatedPrimaryKey[0]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(uuid.UUID)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := *values[0].(*uuid.UUID)
+				inValue := *values[1].(*uuid.UUID)
+				if nids[inValue] == nil {
+					nids[inValue] = map[*Item]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*Item](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "related" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
 func (iq *ItemQuery) loadLabel(ctx context.Context, query *LabelQuery, nodes []*Item, init func(*Item), assign func(*Item, *Label)) error {
 	edgeIDs := make([]driver.Value, len(nodes))
 	byID := make(map[uuid.UUID]*Item)
@@ -994,6 +1142,37 @@ func (iq *ItemQuery) loadAttachments(ctx context.Context, query *AttachmentQuery
 	}
 	return nil
 }
+func (iq *ItemQuery) loadComments(ctx context.Context, query *ItemCommentQuery, nodes []*Item, init func(*Item), assign func(*Item, *ItemComment)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Item)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(itemcomment.FieldItemID)
+	}
+	query.Where(predicate.ItemComment(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(item.CommentsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ItemID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "item_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (iq *ItemQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := iq.querySpec()
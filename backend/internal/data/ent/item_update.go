@@ -10,11 +10,13 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/attachment"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/group"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/item"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/itemcomment"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/itemfield"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/label"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/location"
@@ -115,6 +117,20 @@ func (iu *ItemUpdate) ClearNotes() *ItemUpdate {
 	return iu
 }
 
+// SetNotesFormat sets the "notes_format" field.
+func (iu *ItemUpdate) SetNotesFormat(_if item.NotesFormat) *ItemUpdate {
+	iu.mutation.SetNotesFormat(_if)
+	return iu
+}
+
+// SetNillableNotesFormat sets the "notes_format" field if the given value is not nil.
+func (iu *ItemUpdate) SetNillableNotesFormat(_if *item.NotesFormat) *ItemUpdate {
+	if _if != nil {
+		iu.SetNotesFormat(*_if)
+	}
+	return iu
+}
+
 // SetQuantity sets the "quantity" field.
 func (iu *ItemUpdate) SetQuantity(i int) *ItemUpdate {
 	iu.mutation.ResetQuantity()
@@ -164,6 +180,61 @@ func (iu *ItemUpdate) SetNillableArchived(b *bool) *ItemUpdate {
 	return iu
 }
 
+// SetFavorite sets the "favorite" field.
+func (iu *ItemUpdate) SetFavorite(b bool) *ItemUpdate {
+	iu.mutation.SetFavorite(b)
+	return iu
+}
+
+// SetNillableFavorite sets the "favorite" field if the given value is not nil.
+func (iu *ItemUpdate) SetNillableFavorite(b *bool) *ItemUpdate {
+	if b != nil {
+		iu.SetFavorite(*b)
+	}
+	return iu
+}
+
+// SetVersion sets the "version" field.
+func (iu *ItemUpdate) SetVersion(i int) *ItemUpdate {
+	iu.mutation.ResetVersion()
+	iu.mutation.SetVersion(i)
+	return iu
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (iu *ItemUpdate) SetNillableVersion(i *int) *ItemUpdate {
+	if i != nil {
+		iu.SetVersion(*i)
+	}
+	return iu
+}
+
+// AddVersion adds i to the "version" field.
+func (iu *ItemUpdate) AddVersion(i int) *ItemUpdate {
+	iu.mutation.AddVersion(i)
+	return iu
+}
+
+// SetArchivedAt sets the "archived_at" field.
+func (iu *ItemUpdate) SetArchivedAt(t time.Time) *ItemUpdate {
+	iu.mutation.SetArchivedAt(t)
+	return iu
+}
+
+// SetNillableArchivedAt sets the "archived_at" field if the given value is not nil.
+func (iu *ItemUpdate) SetNillableArchivedAt(t *time.Time) *ItemUpdate {
+	if t != nil {
+		iu.SetArchivedAt(*t)
+	}
+	return iu
+}
+
+// ClearArchivedAt clears the value of the "archived_at" field.
+func (iu *ItemUpdate) ClearArchivedAt() *ItemUpdate {
+	iu.mutation.ClearArchivedAt()
+	return iu
+}
+
 // SetAssetID sets the "asset_id" field.
 func (iu *ItemUpdate) SetAssetID(i int) *ItemUpdate {
 	iu.mutation.ResetAssetID()
@@ -185,6 +256,127 @@ func (iu *ItemUpdate) AddAssetID(i int) *ItemUpdate {
 	return iu
 }
 
+// SetReorderThreshold sets the "reorder_threshold" field.
+func (iu *ItemUpdate) SetReorderThreshold(i int) *ItemUpdate {
+	iu.mutation.ResetReorderThreshold()
+	iu.mutation.SetReorderThreshold(i)
+	return iu
+}
+
+// SetNillableReorderThreshold sets the "reorder_threshold" field if the given value is not nil.
+func (iu *ItemUpdate) SetNillableReorderThreshold(i *int) *ItemUpdate {
+	if i != nil {
+		iu.SetReorderThreshold(*i)
+	}
+	return iu
+}
+
+// AddReorderThreshold adds i to the "reorder_threshold" field.
+func (iu *ItemUpdate) AddReorderThreshold(i int) *ItemUpdate {
+	iu.mutation.AddReorderThreshold(i)
+	return iu
+}
+
+// SetTags sets the "tags" field.
+func (iu *ItemUpdate) SetTags(s []string) *ItemUpdate {
+	iu.mutation.SetTags(s)
+	return iu
+}
+
+// AppendTags appends s to the "tags" field.
+func (iu *ItemUpdate) AppendTags(s []string) *ItemUpdate {
+	iu.mutation.AppendTags(s)
+	return iu
+}
+
+// ClearTags clears the value of the "tags" field.
+func (iu *ItemUpdate) ClearTags() *ItemUpdate {
+	iu.mutation.ClearTags()
+	return iu
+}
+
+// SetSource sets the "source" field.
+func (iu *ItemUpdate) SetSource(i item.Source) *ItemUpdate {
+	iu.mutation.SetSource(i)
+	return iu
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (iu *ItemUpdate) SetNillableSource(i *item.Source) *ItemUpdate {
+	if i != nil {
+		iu.SetSource(*i)
+	}
+	return iu
+}
+
+// SetAcquisitionType sets the "acquisition_type" field.
+func (iu *ItemUpdate) SetAcquisitionType(it item.AcquisitionType) *ItemUpdate {
+	iu.mutation.SetAcquisitionType(it)
+	return iu
+}
+
+// SetNillableAcquisitionType sets the "acquisition_type" field if the given value is not nil.
+func (iu *ItemUpdate) SetNillableAcquisitionType(it *item.AcquisitionType) *ItemUpdate {
+	if it != nil {
+		iu.SetAcquisitionType(*it)
+	}
+	return iu
+}
+
+// SetLatitude sets the "latitude" field.
+func (iu *ItemUpdate) SetLatitude(f float64) *ItemUpdate {
+	iu.mutation.ResetLatitude()
+	iu.mutation.SetLatitude(f)
+	return iu
+}
+
+// SetNillableLatitude sets the "latitude" field if the given value is not nil.
+func (iu *ItemUpdate) SetNillableLatitude(f *float64) *ItemUpdate {
+	if f != nil {
+		iu.SetLatitude(*f)
+	}
+	return iu
+}
+
+// AddLatitude adds f to the "latitude" field.
+func (iu *ItemUpdate) AddLatitude(f float64) *ItemUpdate {
+	iu.mutation.AddLatitude(f)
+	return iu
+}
+
+// ClearLatitude clears the value of the "latitude" field.
+func (iu *ItemUpdate) ClearLatitude() *ItemUpdate {
+	iu.mutation.ClearLatitude()
+	return iu
+}
+
+// SetLongitude sets the "longitude" field.
+func (iu *ItemUpdate) SetLongitude(f float64) *ItemUpdate {
+	iu.mutation.ResetLongitude()
+	iu.mutation.SetLongitude(f)
+	return iu
+}
+
+// SetNillableLongitude sets the "longitude" field if the given value is not nil.
+func (iu *ItemUpdate) SetNillableLongitude(f *float64) *ItemUpdate {
+	if f != nil {
+		iu.SetLongitude(*f)
+	}
+	return iu
+}
+
+// AddLongitude adds f to the "longitude" field.
+func (iu *ItemUpdate) AddLongitude(f float64) *ItemUpdate {
+	iu.mutation.AddLongitude(f)
+	return iu
+}
+
+// ClearLongitude clears the value of the "longitude" field.
+func (iu *ItemUpdate) ClearLongitude() *ItemUpdate {
+	iu.mutation.ClearLongitude()
+	return iu
+}
+
 // SetSerialNumber sets the "serial_number" field.
 func (iu *ItemUpdate) SetSerialNumber(s string) *ItemUpdate {
 	iu.mutation.SetSerialNumber(s)
@@ -205,6 +397,26 @@ func (iu *ItemUpdate) ClearSerialNumber() *ItemUpdate {
 	return iu
 }
 
+// SetBarcode sets the "barcode" field.
+func (iu *ItemUpdate) SetBarcode(s string) *ItemUpdate {
+	iu.mutation.SetBarcode(s)
+	return iu
+}
+
+// SetNillableBarcode sets the "barcode" field if the given value is not nil.
+func (iu *ItemUpdate) SetNillableBarcode(s *string) *ItemUpdate {
+	if s != nil {
+		iu.SetBarcode(*s)
+	}
+	return iu
+}
+
+// ClearBarcode clears the value of the "barcode" field.
+func (iu *ItemUpdate) ClearBarcode() *ItemUpdate {
+	iu.mutation.ClearBarcode()
+	return iu
+}
+
 // SetModelNumber sets the "model_number" field.
 func (iu *ItemUpdate) SetModelNumber(s string) *ItemUpdate {
 	iu.mutation.SetModelNumber(s)
@@ -339,6 +551,26 @@ func (iu *ItemUpdate) ClearPurchaseFrom() *ItemUpdate {
 	return iu
 }
 
+// SetPurchaseOrderNumber sets the "purchase_order_number" field.
+func (iu *ItemUpdate) SetPurchaseOrderNumber(s string) *ItemUpdate {
+	iu.mutation.SetPurchaseOrderNumber(s)
+	return iu
+}
+
+// SetNillablePurchaseOrderNumber sets the "purchase_order_number" field if the given value is not nil.
+func (iu *ItemUpdate) SetNillablePurchaseOrderNumber(s *string) *ItemUpdate {
+	if s != nil {
+		iu.SetPurchaseOrderNumber(*s)
+	}
+	return iu
+}
+
+// ClearPurchaseOrderNumber clears the value of the "purchase_order_number" field.
+func (iu *ItemUpdate) ClearPurchaseOrderNumber() *ItemUpdate {
+	iu.mutation.ClearPurchaseOrderNumber()
+	return iu
+}
+
 // SetPurchasePrice sets the "purchase_price" field.
 func (iu *ItemUpdate) SetPurchasePrice(f float64) *ItemUpdate {
 	iu.mutation.ResetPurchasePrice()
@@ -360,6 +592,107 @@ func (iu *ItemUpdate) AddPurchasePrice(f float64) *ItemUpdate {
 	return iu
 }
 
+// SetCurrency sets the "currency" field.
+func (iu *ItemUpdate) SetCurrency(s string) *ItemUpdate {
+	iu.mutation.SetCurrency(s)
+	return iu
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (iu *ItemUpdate) SetNillableCurrency(s *string) *ItemUpdate {
+	if s != nil {
+		iu.SetCurrency(*s)
+	}
+	return iu
+}
+
+// ClearCurrency clears the value of the "currency" field.
+func (iu *ItemUpdate) ClearCurrency() *ItemUpdate {
+	iu.mutation.ClearCurrency()
+	return iu
+}
+
+// SetReplacementCost sets the "replacement_cost" field.
+func (iu *ItemUpdate) SetReplacementCost(f float64) *ItemUpdate {
+	iu.mutation.ResetReplacementCost()
+	iu.mutation.SetReplacementCost(f)
+	return iu
+}
+
+// SetNillableReplacementCost sets the "replacement_cost" field if the given value is not nil.
+func (iu *ItemUpdate) SetNillableReplacementCost(f *float64) *ItemUpdate {
+	if f != nil {
+		iu.SetReplacementCost(*f)
+	}
+	return iu
+}
+
+// AddReplacementCost adds f to the "replacement_cost" field.
+func (iu *ItemUpdate) AddReplacementCost(f float64) *ItemUpdate {
+	iu.mutation.AddReplacementCost(f)
+	return iu
+}
+
+// SetLoanedTo sets the "loaned_to" field.
+func (iu *ItemUpdate) SetLoanedTo(s string) *ItemUpdate {
+	iu.mutation.SetLoanedTo(s)
+	return iu
+}
+
+// SetNillableLoanedTo sets the "loaned_to" field if the given value is not nil.
+func (iu *ItemUpdate) SetNillableLoanedTo(s *string) *ItemUpdate {
+	if s != nil {
+		iu.SetLoanedTo(*s)
+	}
+	return iu
+}
+
+// ClearLoanedTo clears the value of the "loaned_to" field.
+func (iu *ItemUpdate) ClearLoanedTo() *ItemUpdate {
+	iu.mutation.ClearLoanedTo()
+	return iu
+}
+
+// SetLoanedAt sets the "loaned_at" field.
+func (iu *ItemUpdate) SetLoanedAt(t time.Time) *ItemUpdate {
+	iu.mutation.SetLoanedAt(t)
+	return iu
+}
+
+// SetNillableLoanedAt sets the "loaned_at" field if the given value is not nil.
+func (iu *ItemUpdate) SetNillableLoanedAt(t *time.Time) *ItemUpdate {
+	if t != nil {
+		iu.SetLoanedAt(*t)
+	}
+	return iu
+}
+
+// ClearLoanedAt clears the value of the "loaned_at" field.
+func (iu *ItemUpdate) ClearLoanedAt() *ItemUpdate {
+	iu.mutation.ClearLoanedAt()
+	return iu
+}
+
+// SetLoanDue sets the "loan_due" field.
+func (iu *ItemUpdate) SetLoanDue(t time.Time) *ItemUpdate {
+	iu.mutation.SetLoanDue(t)
+	return iu
+}
+
+// SetNillableLoanDue sets the "loan_due" field if the given value is not nil.
+func (iu *ItemUpdate) SetNillableLoanDue(t *time.Time) *ItemUpdate {
+	if t != nil {
+		iu.SetLoanDue(*t)
+	}
+	return iu
+}
+
+// ClearLoanDue clears the value of the "loan_due" field.
+func (iu *ItemUpdate) ClearLoanDue() *ItemUpdate {
+	iu.mutation.ClearLoanDue()
+	return iu
+}
+
 // SetSoldTime sets the "sold_time" field.
 func (iu *ItemUpdate) SetSoldTime(t time.Time) *ItemUpdate {
 	iu.mutation.SetSoldTime(t)
@@ -486,6 +819,21 @@ func (iu *ItemUpdate) AddChildren(i ...*Item) *ItemUpdate {
 	return iu.AddChildIDs(ids...)
 }
 
+// AddRelatedIDs adds the "related" edge to the Item entity by IDs.
+func (iu *ItemUpdate) AddRelatedIDs(ids ...uuid.UUID) *ItemUpdate {
+	iu.mutation.AddRelatedIDs(ids...)
+	return iu
+}
+
+// AddRelated adds the "related" edges to the Item entity.
+func (iu *ItemUpdate) AddRelated(i ...*Item) *ItemUpdate {
+	ids := make([]uuid.UUID, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return iu.AddRelatedIDs(ids...)
+}
+
 // AddLabelIDs adds the "label" edge to the Label entity by IDs.
 func (iu *ItemUpdate) AddLabelIDs(ids ...uuid.UUID) *ItemUpdate {
 	iu.mutation.AddLabelIDs(ids...)
@@ -565,6 +913,21 @@ func (iu *ItemUpdate) AddAttachments(a ...*Attachment) *ItemUpdate {
 	return iu.AddAttachmentIDs(ids...)
 }
 
+// AddCommentIDs adds the "comments" edge to the ItemComment entity by IDs.
+func (iu *ItemUpdate) AddCommentIDs(ids ...uuid.UUID) *ItemUpdate {
+	iu.mutation.AddCommentIDs(ids...)
+	return iu
+}
+
+// AddComments adds the "comments" edges to the ItemComment entity.
+func (iu *ItemUpdate) AddComments(i ...*ItemComment) *ItemUpdate {
+	ids := make([]uuid.UUID, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return iu.AddCommentIDs(ids...)
+}
+
 // Mutation returns the ItemMutation object of the builder.
 func (iu *ItemUpdate) Mutation() *ItemMutation {
 	return iu.mutation
@@ -603,6 +966,27 @@ func (iu *ItemUpdate) RemoveChildren(i ...*Item) *ItemUpdate {
 	return iu.RemoveChildIDs(ids...)
 }
 
+// ClearRelated clears all "related" edges to the Item entity.
+func (iu *ItemUpdate) ClearRelated() *ItemUpdate {
+	iu.mutation.ClearRelated()
+	return iu
+}
+
+// RemoveRelatedIDs removes the "related" edge to Item entities by IDs.
+func (iu *ItemUpdate) RemoveRelatedIDs(ids ...uuid.UUID) *ItemUpdate {
+	iu.mutation.RemoveRelatedIDs(ids...)
+	return iu
+}
+
+// RemoveRelated removes "related" edges to Item entities.
+func (iu *ItemUpdate) RemoveRelated(i ...*Item) *ItemUpdate {
+	ids := make([]uuid.UUID, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return iu.RemoveRelatedIDs(ids...)
+}
+
 // ClearLabel clears all "label" edges to the Label entity.
 func (iu *ItemUpdate) ClearLabel() *ItemUpdate {
 	iu.mutation.ClearLabel()
@@ -693,9 +1077,32 @@ func (iu *ItemUpdate) RemoveAttachments(a ...*Attachment) *ItemUpdate {
 	return iu.RemoveAttachmentIDs(ids...)
 }
 
+// ClearComments clears all "comments" edges to the ItemComment entity.
+func (iu *ItemUpdate) ClearComments() *ItemUpdate {
+	iu.mutation.ClearComments()
+	return iu
+}
+
+// RemoveCommentIDs removes the "comments" edge to ItemComment entities by IDs.
+func (iu *ItemUpdate) RemoveCommentIDs(ids ...uuid.UUID) *ItemUpdate {
+	iu.mutation.RemoveCommentIDs(ids...)
+	return iu
+}
+
+// RemoveComments removes "comments" edges to ItemComment entities.
+func (iu *ItemUpdate) RemoveComments(i ...*ItemComment) *ItemUpdate {
+	ids := make([]uuid.UUID, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return iu.RemoveCommentIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (iu *ItemUpdate) Save(ctx context.Context) (int, error) {
-	iu.defaults()
+	if err := iu.defaults(); err != nil {
+		return 0, err
+	}
 	return withHooks(ctx, iu.sqlSave, iu.mutation, iu.hooks)
 }
 
@@ -722,11 +1129,15 @@ func (iu *ItemUpdate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (iu *ItemUpdate) defaults() {
+func (iu *ItemUpdate) defaults() error {
 	if _, ok := iu.mutation.UpdatedAt(); !ok {
+		if item.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized item.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
 		v := item.UpdateDefaultUpdatedAt()
 		iu.mutation.SetUpdatedAt(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -751,11 +1162,31 @@ func (iu *ItemUpdate) check() error {
 			return &ValidationError{Name: "notes", err: fmt.Errorf(`ent: validator failed for field "Item.notes": %w`, err)}
 		}
 	}
+	if v, ok := iu.mutation.NotesFormat(); ok {
+		if err := item.NotesFormatValidator(v); err != nil {
+			return &ValidationError{Name: "notes_format", err: fmt.Errorf(`ent: validator failed for field "Item.notes_format": %w`, err)}
+		}
+	}
+	if v, ok := iu.mutation.Source(); ok {
+		if err := item.SourceValidator(v); err != nil {
+			return &ValidationError{Name: "source", err: fmt.Errorf(`ent: validator failed for field "Item.source": %w`, err)}
+		}
+	}
+	if v, ok := iu.mutation.AcquisitionType(); ok {
+		if err := item.AcquisitionTypeValidator(v); err != nil {
+			return &ValidationError{Name: "acquisition_type", err: fmt.Errorf(`ent: validator failed for field "Item.acquisition_type": %w`, err)}
+		}
+	}
 	if v, ok := iu.mutation.SerialNumber(); ok {
 		if err := item.SerialNumberValidator(v); err != nil {
 			return &ValidationError{Name: "serial_number", err: fmt.Errorf(`ent: validator failed for field "Item.serial_number": %w`, err)}
 		}
 	}
+	if v, ok := iu.mutation.Barcode(); ok {
+		if err := item.BarcodeValidator(v); err != nil {
+			return &ValidationError{Name: "barcode", err: fmt.Errorf(`ent: validator failed for field "Item.barcode": %w`, err)}
+		}
+	}
 	if v, ok := iu.mutation.ModelNumber(); ok {
 		if err := item.ModelNumberValidator(v); err != nil {
 			return &ValidationError{Name: "model_number", err: fmt.Errorf(`ent: validator failed for field "Item.model_number": %w`, err)}
@@ -771,6 +1202,21 @@ func (iu *ItemUpdate) check() error {
 			return &ValidationError{Name: "warranty_details", err: fmt.Errorf(`ent: validator failed for field "Item.warranty_details": %w`, err)}
 		}
 	}
+	if v, ok := iu.mutation.PurchaseOrderNumber(); ok {
+		if err := item.PurchaseOrderNumberValidator(v); err != nil {
+			return &ValidationError{Name: "purchase_order_number", err: fmt.Errorf(`ent: validator failed for field "Item.purchase_order_number": %w`, err)}
+		}
+	}
+	if v, ok := iu.mutation.Currency(); ok {
+		if err := item.CurrencyValidator(v); err != nil {
+			return &ValidationError{Name: "currency", err: fmt.Errorf(`ent: validator failed for field "Item.currency": %w`, err)}
+		}
+	}
+	if v, ok := iu.mutation.LoanedTo(); ok {
+		if err := item.LoanedToValidator(v); err != nil {
+			return &ValidationError{Name: "loaned_to", err: fmt.Errorf(`ent: validator failed for field "Item.loaned_to": %w`, err)}
+		}
+	}
 	if v, ok := iu.mutation.SoldNotes(); ok {
 		if err := item.SoldNotesValidator(v); err != nil {
 			return &ValidationError{Name: "sold_notes", err: fmt.Errorf(`ent: validator failed for field "Item.sold_notes": %w`, err)}
@@ -818,6 +1264,9 @@ func (iu *ItemUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if iu.mutation.NotesCleared() {
 		_spec.ClearField(item.FieldNotes, field.TypeString)
 	}
+	if value, ok := iu.mutation.NotesFormat(); ok {
+		_spec.SetField(item.FieldNotesFormat, field.TypeEnum, value)
+	}
 	if value, ok := iu.mutation.Quantity(); ok {
 		_spec.SetField(item.FieldQuantity, field.TypeInt, value)
 	}
@@ -830,18 +1279,80 @@ func (iu *ItemUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := iu.mutation.Archived(); ok {
 		_spec.SetField(item.FieldArchived, field.TypeBool, value)
 	}
+	if value, ok := iu.mutation.Favorite(); ok {
+		_spec.SetField(item.FieldFavorite, field.TypeBool, value)
+	}
+	if value, ok := iu.mutation.Version(); ok {
+		_spec.SetField(item.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := iu.mutation.AddedVersion(); ok {
+		_spec.AddField(item.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := iu.mutation.ArchivedAt(); ok {
+		_spec.SetField(item.FieldArchivedAt, field.TypeTime, value)
+	}
+	if iu.mutation.ArchivedAtCleared() {
+		_spec.ClearField(item.FieldArchivedAt, field.TypeTime)
+	}
 	if value, ok := iu.mutation.AssetID(); ok {
 		_spec.SetField(item.FieldAssetID, field.TypeInt, value)
 	}
 	if value, ok := iu.mutation.AddedAssetID(); ok {
 		_spec.AddField(item.FieldAssetID, field.TypeInt, value)
 	}
+	if value, ok := iu.mutation.ReorderThreshold(); ok {
+		_spec.SetField(item.FieldReorderThreshold, field.TypeInt, value)
+	}
+	if value, ok := iu.mutation.AddedReorderThreshold(); ok {
+		_spec.AddField(item.FieldReorderThreshold, field.TypeInt, value)
+	}
+	if value, ok := iu.mutation.Tags(); ok {
+		_spec.SetField(item.FieldTags, field.TypeJSON, value)
+	}
+	if value, ok := iu.mutation.AppendedTags(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, item.FieldTags, value)
+		})
+	}
+	if iu.mutation.TagsCleared() {
+		_spec.ClearField(item.FieldTags, field.TypeJSON)
+	}
+	if value, ok := iu.mutation.Source(); ok {
+		_spec.SetField(item.FieldSource, field.TypeEnum, value)
+	}
+	if value, ok := iu.mutation.AcquisitionType(); ok {
+		_spec.SetField(item.FieldAcquisitionType, field.TypeEnum, value)
+	}
+	if value, ok := iu.mutation.Latitude(); ok {
+		_spec.SetField(item.FieldLatitude, field.TypeFloat64, value)
+	}
+	if value, ok := iu.mutation.AddedLatitude(); ok {
+		_spec.AddField(item.FieldLatitude, field.TypeFloat64, value)
+	}
+	if iu.mutation.LatitudeCleared() {
+		_spec.ClearField(item.FieldLatitude, field.TypeFloat64)
+	}
+	if value, ok := iu.mutation.Longitude(); ok {
+		_spec.SetField(item.FieldLongitude, field.TypeFloat64, value)
+	}
+	if value, ok := iu.mutation.AddedLongitude(); ok {
+		_spec.AddField(item.FieldLongitude, field.TypeFloat64, value)
+	}
+	if iu.mutation.LongitudeCleared() {
+		_spec.ClearField(item.FieldLongitude, field.TypeFloat64)
+	}
 	if value, ok := iu.mutation.SerialNumber(); ok {
 		_spec.SetField(item.FieldSerialNumber, field.TypeString, value)
 	}
 	if iu.mutation.SerialNumberCleared() {
 		_spec.ClearField(item.FieldSerialNumber, field.TypeString)
 	}
+	if value, ok := iu.mutation.Barcode(); ok {
+		_spec.SetField(item.FieldBarcode, field.TypeString, value)
+	}
+	if iu.mutation.BarcodeCleared() {
+		_spec.ClearField(item.FieldBarcode, field.TypeString)
+	}
 	if value, ok := iu.mutation.ModelNumber(); ok {
 		_spec.SetField(item.FieldModelNumber, field.TypeString, value)
 	}
@@ -881,12 +1392,48 @@ func (iu *ItemUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if iu.mutation.PurchaseFromCleared() {
 		_spec.ClearField(item.FieldPurchaseFrom, field.TypeString)
 	}
+	if value, ok := iu.mutation.PurchaseOrderNumber(); ok {
+		_spec.SetField(item.FieldPurchaseOrderNumber, field.TypeString, value)
+	}
+	if iu.mutation.PurchaseOrderNumberCleared() {
+		_spec.ClearField(item.FieldPurchaseOrderNumber, field.TypeString)
+	}
 	if value, ok := iu.mutation.PurchasePrice(); ok {
 		_spec.SetField(item.FieldPurchasePrice, field.TypeFloat64, value)
 	}
 	if value, ok := iu.mutation.AddedPurchasePrice(); ok {
 		_spec.AddField(item.FieldPurchasePrice, field.TypeFloat64, value)
 	}
+	if value, ok := iu.mutation.Currency(); ok {
+		_spec.SetField(item.FieldCurrency, field.TypeString, value)
+	}
+	if iu.mutation.CurrencyCleared() {
+		_spec.ClearField(item.FieldCurrency, field.TypeString)
+	}
+	if value, ok := iu.mutation.ReplacementCost(); ok {
+		_spec.SetField(item.FieldReplacementCost, field.TypeFloat64, value)
+	}
+	if value, ok := iu.mutation.AddedReplacementCost(); ok {
+		_spec.AddField(item.FieldReplacementCost, field.TypeFloat64, value)
+	}
+	if value, ok := iu.mutation.LoanedTo(); ok {
+		_spec.SetField(item.FieldLoanedTo, field.TypeString, value)
+	}
+	if iu.mutation.LoanedToCleared() {
+		_spec.ClearField(item.FieldLoanedTo, field.TypeString)
+	}
+	if value, ok := iu.mutation.LoanedAt(); ok {
+		_spec.SetField(item.FieldLoanedAt, field.TypeTime, value)
+	}
+	if iu.mutation.LoanedAtCleared() {
+		_spec.ClearField(item.FieldLoanedAt, field.TypeTime)
+	}
+	if value, ok := iu.mutation.LoanDue(); ok {
+		_spec.SetField(item.FieldLoanDue, field.TypeTime, value)
+	}
+	if iu.mutation.LoanDueCleared() {
+		_spec.ClearField(item.FieldLoanDue, field.TypeTime)
+	}
 	if value, ok := iu.mutation.SoldTime(); ok {
 		_spec.SetField(item.FieldSoldTime, field.TypeTime, value)
 	}
@@ -1014,6 +1561,51 @@ func (iu *ItemUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if iu.mutation.RelatedCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   item.RelatedTable,
+			Columns: item.RelatedPrimaryKey,
+			Bidi:    true,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iu.mutation.RemovedRelatedIDs(); len(nodes) > 0 && !iu.mutation.RelatedCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   item.RelatedTable,
+			Columns: item.RelatedPrimaryKey,
+			Bidi:    true,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iu.mutation.RelatedIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   item.RelatedTable,
+			Columns: item.RelatedPrimaryKey,
+			Bidi:    true,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if iu.mutation.LabelCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
@@ -1223,6 +1815,51 @@ func (iu *ItemUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if iu.mutation.CommentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   item.CommentsTable,
+			Columns: []string{item.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemcomment.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iu.mutation.RemovedCommentsIDs(); len(nodes) > 0 && !iu.mutation.CommentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   item.CommentsTable,
+			Columns: []string{item.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemcomment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iu.mutation.CommentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   item.CommentsTable,
+			Columns: []string{item.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemcomment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, iu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{item.Label}
@@ -1323,6 +1960,20 @@ func (iuo *ItemUpdateOne) ClearNotes() *ItemUpdateOne {
 	return iuo
 }
 
+// SetNotesFormat sets the "notes_format" field.
+func (iuo *ItemUpdateOne) SetNotesFormat(_if item.NotesFormat) *ItemUpdateOne {
+	iuo.mutation.SetNotesFormat(_if)
+	return iuo
+}
+
+// SetNillableNotesFormat sets the "notes_format" field if the given value is not nil.
+func (iuo *ItemUpdateOne) SetNillableNotesFormat(_if *item.NotesFormat) *ItemUpdateOne {
+	if _if != nil {
+		iuo.SetNotesFormat(*_if)
+	}
+	return iuo
+}
+
 // SetQuantity sets the "quantity" field.
 func (iuo *ItemUpdateOne) SetQuantity(i int) *ItemUpdateOne {
 	iuo.mutation.ResetQuantity()
@@ -1364,32 +2015,208 @@ func (iuo *ItemUpdateOne) SetArchived(b bool) *ItemUpdateOne {
 	return iuo
 }
 
-// SetNillableArchived sets the "archived" field if the given value is not nil.
-func (iuo *ItemUpdateOne) SetNillableArchived(b *bool) *ItemUpdateOne {
-	if b != nil {
-		iuo.SetArchived(*b)
-	}
+// SetNillableArchived sets the "archived" field if the given value is not nil.
+func (iuo *ItemUpdateOne) SetNillableArchived(b *bool) *ItemUpdateOne {
+	if b != nil {
+		iuo.SetArchived(*b)
+	}
+	return iuo
+}
+
+// SetFavorite sets the "favorite" field.
+func (iuo *ItemUpdateOne) SetFavorite(b bool) *ItemUpdateOne {
+	iuo.mutation.SetFavorite(b)
+	return iuo
+}
+
+// SetNillableFavorite sets the "favorite" field if the given value is not nil.
+func (iuo *ItemUpdateOne) SetNillableFavorite(b *bool) *ItemUpdateOne {
+	if b != nil {
+		iuo.SetFavorite(*b)
+	}
+	return iuo
+}
+
+// SetVersion sets the "version" field.
+func (iuo *ItemUpdateOne) SetVersion(i int) *ItemUpdateOne {
+	iuo.mutation.ResetVersion()
+	iuo.mutation.SetVersion(i)
+	return iuo
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (iuo *ItemUpdateOne) SetNillableVersion(i *int) *ItemUpdateOne {
+	if i != nil {
+		iuo.SetVersion(*i)
+	}
+	return iuo
+}
+
+// AddVersion adds i to the "version" field.
+func (iuo *ItemUpdateOne) AddVersion(i int) *ItemUpdateOne {
+	iuo.mutation.AddVersion(i)
+	return iuo
+}
+
+// SetArchivedAt sets the "archived_at" field.
+func (iuo *ItemUpdateOne) SetArchivedAt(t time.Time) *ItemUpdateOne {
+	iuo.mutation.SetArchivedAt(t)
+	return iuo
+}
+
+// SetNillableArchivedAt sets the "archived_at" field if the given value is not nil.
+func (iuo *ItemUpdateOne) SetNillableArchivedAt(t *time.Time) *ItemUpdateOne {
+	if t != nil {
+		iuo.SetArchivedAt(*t)
+	}
+	return iuo
+}
+
+// ClearArchivedAt clears the value of the "archived_at" field.
+func (iuo *ItemUpdateOne) ClearArchivedAt() *ItemUpdateOne {
+	iuo.mutation.ClearArchivedAt()
+	return iuo
+}
+
+// SetAssetID sets the "asset_id" field.
+func (iuo *ItemUpdateOne) SetAssetID(i int) *ItemUpdateOne {
+	iuo.mutation.ResetAssetID()
+	iuo.mutation.SetAssetID(i)
+	return iuo
+}
+
+// SetNillableAssetID sets the "asset_id" field if the given value is not nil.
+func (iuo *ItemUpdateOne) SetNillableAssetID(i *int) *ItemUpdateOne {
+	if i != nil {
+		iuo.SetAssetID(*i)
+	}
+	return iuo
+}
+
+// AddAssetID adds i to the "asset_id" field.
+func (iuo *ItemUpdateOne) AddAssetID(i int) *ItemUpdateOne {
+	iuo.mutation.AddAssetID(i)
+	return iuo
+}
+
+// SetReorderThreshold sets the "reorder_threshold" field.
+func (iuo *ItemUpdateOne) SetReorderThreshold(i int) *ItemUpdateOne {
+	iuo.mutation.ResetReorderThreshold()
+	iuo.mutation.SetReorderThreshold(i)
+	return iuo
+}
+
+// SetNillableReorderThreshold sets the "reorder_threshold" field if the given value is not nil.
+func (iuo *ItemUpdateOne) SetNillableReorderThreshold(i *int) *ItemUpdateOne {
+	if i != nil {
+		iuo.SetReorderThreshold(*i)
+	}
+	return iuo
+}
+
+// AddReorderThreshold adds i to the "reorder_threshold" field.
+func (iuo *ItemUpdateOne) AddReorderThreshold(i int) *ItemUpdateOne {
+	iuo.mutation.AddReorderThreshold(i)
+	return iuo
+}
+
+// SetTags sets the "tags" field.
+func (iuo *ItemUpdateOne) SetTags(s []string) *ItemUpdateOne {
+	iuo.mutation.SetTags(s)
+	return iuo
+}
+
+// AppendTags appends s to the "tags" field.
+func (iuo *ItemUpdateOne) AppendTags(s []string) *ItemUpdateOne {
+	iuo.mutation.AppendTags(s)
+	return iuo
+}
+
+// ClearTags clears the value of the "tags" field.
+func (iuo *ItemUpdateOne) ClearTags() *ItemUpdateOne {
+	iuo.mutation.ClearTags()
+	return iuo
+}
+
+// SetSource sets the "source" field.
+func (iuo *ItemUpdateOne) SetSource(i item.Source) *ItemUpdateOne {
+	iuo.mutation.SetSource(i)
+	return iuo
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (iuo *ItemUpdateOne) SetNillableSource(i *item.Source) *ItemUpdateOne {
+	if i != nil {
+		iuo.SetSource(*i)
+	}
+	return iuo
+}
+
+// SetAcquisitionType sets the "acquisition_type" field.
+func (iuo *ItemUpdateOne) SetAcquisitionType(it item.AcquisitionType) *ItemUpdateOne {
+	iuo.mutation.SetAcquisitionType(it)
+	return iuo
+}
+
+// SetNillableAcquisitionType sets the "acquisition_type" field if the given value is not nil.
+func (iuo *ItemUpdateOne) SetNillableAcquisitionType(it *item.AcquisitionType) *ItemUpdateOne {
+	if it != nil {
+		iuo.SetAcquisitionType(*it)
+	}
+	return iuo
+}
+
+// SetLatitude sets the "latitude" field.
+func (iuo *ItemUpdateOne) SetLatitude(f float64) *ItemUpdateOne {
+	iuo.mutation.ResetLatitude()
+	iuo.mutation.SetLatitude(f)
+	return iuo
+}
+
+// SetNillableLatitude sets the "latitude" field if the given value is not nil.
+func (iuo *ItemUpdateOne) SetNillableLatitude(f *float64) *ItemUpdateOne {
+	if f != nil {
+		iuo.SetLatitude(*f)
+	}
+	return iuo
+}
+
+// AddLatitude adds f to the "latitude" field.
+func (iuo *ItemUpdateOne) AddLatitude(f float64) *ItemUpdateOne {
+	iuo.mutation.AddLatitude(f)
+	return iuo
+}
+
+// ClearLatitude clears the value of the "latitude" field.
+func (iuo *ItemUpdateOne) ClearLatitude() *ItemUpdateOne {
+	iuo.mutation.ClearLatitude()
 	return iuo
 }
 
-// SetAssetID sets the "asset_id" field.
-func (iuo *ItemUpdateOne) SetAssetID(i int) *ItemUpdateOne {
-	iuo.mutation.ResetAssetID()
-	iuo.mutation.SetAssetID(i)
+// SetLongitude sets the "longitude" field.
+func (iuo *ItemUpdateOne) SetLongitude(f float64) *ItemUpdateOne {
+	iuo.mutation.ResetLongitude()
+	iuo.mutation.SetLongitude(f)
 	return iuo
 }
 
-// SetNillableAssetID sets the "asset_id" field if the given value is not nil.
-func (iuo *ItemUpdateOne) SetNillableAssetID(i *int) *ItemUpdateOne {
-	if i != nil {
-		iuo.SetAssetID(*i)
+// SetNillableLongitude sets the "longitude" field if the given value is not nil.
+func (iuo *ItemUpdateOne) SetNillableLongitude(f *float64) *ItemUpdateOne {
+	if f != nil {
+		iuo.SetLongitude(*f)
 	}
 	return iuo
 }
 
-// AddAssetID adds i to the "asset_id" field.
-func (iuo *ItemUpdateOne) AddAssetID(i int) *ItemUpdateOne {
-	iuo.mutation.AddAssetID(i)
+// AddLongitude adds f to the "longitude" field.
+func (iuo *ItemUpdateOne) AddLongitude(f float64) *ItemUpdateOne {
+	iuo.mutation.AddLongitude(f)
+	return iuo
+}
+
+// ClearLongitude clears the value of the "longitude" field.
+func (iuo *ItemUpdateOne) ClearLongitude() *ItemUpdateOne {
+	iuo.mutation.ClearLongitude()
 	return iuo
 }
 
@@ -1413,6 +2240,26 @@ func (iuo *ItemUpdateOne) ClearSerialNumber() *ItemUpdateOne {
 	return iuo
 }
 
+// SetBarcode sets the "barcode" field.
+func (iuo *ItemUpdateOne) SetBarcode(s string) *ItemUpdateOne {
+	iuo.mutation.SetBarcode(s)
+	return iuo
+}
+
+// SetNillableBarcode sets the "barcode" field if the given value is not nil.
+func (iuo *ItemUpdateOne) SetNillableBarcode(s *string) *ItemUpdateOne {
+	if s != nil {
+		iuo.SetBarcode(*s)
+	}
+	return iuo
+}
+
+// ClearBarcode clears the value of the "barcode" field.
+func (iuo *ItemUpdateOne) ClearBarcode() *ItemUpdateOne {
+	iuo.mutation.ClearBarcode()
+	return iuo
+}
+
 // SetModelNumber sets the "model_number" field.
 func (iuo *ItemUpdateOne) SetModelNumber(s string) *ItemUpdateOne {
 	iuo.mutation.SetModelNumber(s)
@@ -1547,6 +2394,26 @@ func (iuo *ItemUpdateOne) ClearPurchaseFrom() *ItemUpdateOne {
 	return iuo
 }
 
+// SetPurchaseOrderNumber sets the "purchase_order_number" field.
+func (iuo *ItemUpdateOne) SetPurchaseOrderNumber(s string) *ItemUpdateOne {
+	iuo.mutation.SetPurchaseOrderNumber(s)
+	return iuo
+}
+
+// SetNillablePurchaseOrderNumber sets the "purchase_order_number" field if the given value is not nil.
+func (iuo *ItemUpdateOne) SetNillablePurchaseOrderNumber(s *string) *ItemUpdateOne {
+	if s != nil {
+		iuo.SetPurchaseOrderNumber(*s)
+	}
+	return iuo
+}
+
+// ClearPurchaseOrderNumber clears the value of the "purchase_order_number" field.
+func (iuo *ItemUpdateOne) ClearPurchaseOrderNumber() *ItemUpdateOne {
+	iuo.mutation.ClearPurchaseOrderNumber()
+	return iuo
+}
+
 // SetPurchasePrice sets the "purchase_price" field.
 func (iuo *ItemUpdateOne) SetPurchasePrice(f float64) *ItemUpdateOne {
 	iuo.mutation.ResetPurchasePrice()
@@ -1568,6 +2435,107 @@ func (iuo *ItemUpdateOne) AddPurchasePrice(f float64) *ItemUpdateOne {
 	return iuo
 }
 
+// SetCurrency sets the "currency" field.
+func (iuo *ItemUpdateOne) SetCurrency(s string) *ItemUpdateOne {
+	iuo.mutation.SetCurrency(s)
+	return iuo
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (iuo *ItemUpdateOne) SetNillableCurrency(s *string) *ItemUpdateOne {
+	if s != nil {
+		iuo.SetCurrency(*s)
+	}
+	return iuo
+}
+
+// ClearCurrency clears the value of the "currency" field.
+func (iuo *ItemUpdateOne) ClearCurrency() *ItemUpdateOne {
+	iuo.mutation.ClearCurrency()
+	return iuo
+}
+
+// SetReplacementCost sets the "replacement_cost" field.
+func (iuo *ItemUpdateOne) SetReplacementCost(f float64) *ItemUpdateOne {
+	iuo.mutation.ResetReplacementCost()
+	iuo.mutation.SetReplacementCost(f)
+	return iuo
+}
+
+// SetNillableReplacementCost sets the "replacement_cost" field if the given value is not nil.
+func (iuo *ItemUpdateOne) SetNillableReplacementCost(f *float64) *ItemUpdateOne {
+	if f != nil {
+		iuo.SetReplacementCost(*f)
+	}
+	return iuo
+}
+
+// AddReplacementCost adds f to the "replacement_cost" field.
+func (iuo *ItemUpdateOne) AddReplacementCost(f float64) *ItemUpdateOne {
+	iuo.mutation.AddReplacementCost(f)
+	return iuo
+}
+
+// SetLoanedTo sets the "loaned_to" field.
+func (iuo *ItemUpdateOne) SetLoanedTo(s string) *ItemUpdateOne {
+	iuo.mutation.SetLoanedTo(s)
+	return iuo
+}
+
+// SetNillableLoanedTo sets the "loaned_to" field if the given value is not nil.
+func (iuo *ItemUpdateOne) SetNillableLoanedTo(s *string) *ItemUpdateOne {
+	if s != nil {
+		iuo.SetLoanedTo(*s)
+	}
+	return iuo
+}
+
+// ClearLoanedTo clears the value of the "loaned_to" field.
+func (iuo *ItemUpdateOne) ClearLoanedTo() *ItemUpdateOne {
+	iuo.mutation.ClearLoanedTo()
+	return iuo
+}
+
+// SetLoanedAt sets the "loaned_at" field.
+func (iuo *ItemUpdateOne) SetLoanedAt(t time.Time) *ItemUpdateOne {
+	iuo.mutation.SetLoanedAt(t)
+	return iuo
+}
+
+// SetNillableLoanedAt sets the "loaned_at" field if the given value is not nil.
+func (iuo *ItemUpdateOne) SetNillableLoanedAt(t *time.Time) *ItemUpdateOne {
+	if t != nil {
+		iuo.SetLoanedAt(*t)
+	}
+	return iuo
+}
+
+// ClearLoanedAt clears the value of the "loaned_at" field.
+func (iuo *ItemUpdateOne) ClearLoanedAt() *ItemUpdateOne {
+	iuo.mutation.ClearLoanedAt()
+	return iuo
+}
+
+// SetLoanDue sets the "loan_due" field.
+func (iuo *ItemUpdateOne) SetLoanDue(t time.Time) *ItemUpdateOne {
+	iuo.mutation.SetLoanDue(t)
+	return iuo
+}
+
+// SetNillableLoanDue sets the "loan_due" field if the given value is not nil.
+func (iuo *ItemUpdateOne) SetNillableLoanDue(t *time.Time) *ItemUpdateOne {
+	if t != nil {
+		iuo.SetLoanDue(*t)
+	}
+	return iuo
+}
+
+// ClearLoanDue clears the value of the "loan_due" field.
+func (iuo *ItemUpdateOne) ClearLoanDue() *ItemUpdateOne {
+	iuo.mutation.ClearLoanDue()
+	return iuo
+}
+
 // SetSoldTime sets the "sold_time" field.
 func (iuo *ItemUpdateOne) SetSoldTime(t time.Time) *ItemUpdateOne {
 	iuo.mutation.SetSoldTime(t)
@@ -1694,6 +2662,21 @@ func (iuo *ItemUpdateOne) AddChildren(i ...*Item) *ItemUpdateOne {
 	return iuo.AddChildIDs(ids...)
 }
 
+// AddRelatedIDs adds the "related" edge to the Item entity by IDs.
+func (iuo *ItemUpdateOne) AddRelatedIDs(ids ...uuid.UUID) *ItemUpdateOne {
+	iuo.mutation.AddRelatedIDs(ids...)
+	return iuo
+}
+
+// AddRelated adds the "related" edges to the Item entity.
+func (iuo *ItemUpdateOne) AddRelated(i ...*Item) *ItemUpdateOne {
+	ids := make([]uuid.UUID, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return iuo.AddRelatedIDs(ids...)
+}
+
 // AddLabelIDs adds the "label" edge to the Label entity by IDs.
 func (iuo *ItemUpdateOne) AddLabelIDs(ids ...uuid.UUID) *ItemUpdateOne {
 	iuo.mutation.AddLabelIDs(ids...)
@@ -1773,6 +2756,21 @@ func (iuo *ItemUpdateOne) AddAttachments(a ...*Attachment) *ItemUpdateOne {
 	return iuo.AddAttachmentIDs(ids...)
 }
 
+// AddCommentIDs adds the "comments" edge to the ItemComment entity by IDs.
+func (iuo *ItemUpdateOne) AddCommentIDs(ids ...uuid.UUID) *ItemUpdateOne {
+	iuo.mutation.AddCommentIDs(ids...)
+	return iuo
+}
+
+// AddComments adds the "comments" edges to the ItemComment entity.
+func (iuo *ItemUpdateOne) AddComments(i ...*ItemComment) *ItemUpdateOne {
+	ids := make([]uuid.UUID, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return iuo.AddCommentIDs(ids...)
+}
+
 // Mutation returns the ItemMutation object of the builder.
 func (iuo *ItemUpdateOne) Mutation() *ItemMutation {
 	return iuo.mutation
@@ -1811,6 +2809,27 @@ func (iuo *ItemUpdateOne) RemoveChildren(i ...*Item) *ItemUpdateOne {
 	return iuo.RemoveChildIDs(ids...)
 }
 
+// ClearRelated clears all "related" edges to the Item entity.
+func (iuo *ItemUpdateOne) ClearRelated() *ItemUpdateOne {
+	iuo.mutation.ClearRelated()
+	return iuo
+}
+
+// RemoveRelatedIDs removes the "related" edge to Item entities by IDs.
+func (iuo *ItemUpdateOne) RemoveRelatedIDs(ids ...uuid.UUID) *ItemUpdateOne {
+	iuo.mutation.RemoveRelatedIDs(ids...)
+	return iuo
+}
+
+// RemoveRelated removes "related" edges to Item entities.
+func (iuo *ItemUpdateOne) RemoveRelated(i ...*Item) *ItemUpdateOne {
+	ids := make([]uuid.UUID, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return iuo.RemoveRelatedIDs(ids...)
+}
+
 // ClearLabel clears all "label" edges to the Label entity.
 func (iuo *ItemUpdateOne) ClearLabel() *ItemUpdateOne {
 	iuo.mutation.ClearLabel()
@@ -1901,6 +2920,27 @@ func (iuo *ItemUpdateOne) RemoveAttachments(a ...*Attachment) *ItemUpdateOne {
 	return iuo.RemoveAttachmentIDs(ids...)
 }
 
+// ClearComments clears all "comments" edges to the ItemComment entity.
+func (iuo *ItemUpdateOne) ClearComments() *ItemUpdateOne {
+	iuo.mutation.ClearComments()
+	return iuo
+}
+
+// RemoveCommentIDs removes the "comments" edge to ItemComment entities by IDs.
+func (iuo *ItemUpdateOne) RemoveCommentIDs(ids ...uuid.UUID) *ItemUpdateOne {
+	iuo.mutation.RemoveCommentIDs(ids...)
+	return iuo
+}
+
+// RemoveComments removes "comments" edges to ItemComment entities.
+func (iuo *ItemUpdateOne) RemoveComments(i ...*ItemComment) *ItemUpdateOne {
+	ids := make([]uuid.UUID, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return iuo.RemoveCommentIDs(ids...)
+}
+
 // Where appends a list predicates to the ItemUpdate builder.
 func (iuo *ItemUpdateOne) Where(ps ...predicate.Item) *ItemUpdateOne {
 	iuo.mutation.Where(ps...)
@@ -1916,7 +2956,9 @@ func (iuo *ItemUpdateOne) Select(field string, fields ...string) *ItemUpdateOne
 
 // Save executes the query and returns the updated Item entity.
 func (iuo *ItemUpdateOne) Save(ctx context.Context) (*Item, error) {
-	iuo.defaults()
+	if err := iuo.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, iuo.sqlSave, iuo.mutation, iuo.hooks)
 }
 
@@ -1943,11 +2985,15 @@ func (iuo *ItemUpdateOne) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (iuo *ItemUpdateOne) defaults() {
+func (iuo *ItemUpdateOne) defaults() error {
 	if _, ok := iuo.mutation.UpdatedAt(); !ok {
+		if item.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized item.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
 		v := item.UpdateDefaultUpdatedAt()
 		iuo.mutation.SetUpdatedAt(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -1972,11 +3018,31 @@ func (iuo *ItemUpdateOne) check() error {
 			return &ValidationError{Name: "notes", err: fmt.Errorf(`ent: validator failed for field "Item.notes": %w`, err)}
 		}
 	}
+	if v, ok := iuo.mutation.NotesFormat(); ok {
+		if err := item.NotesFormatValidator(v); err != nil {
+			return &ValidationError{Name: "notes_format", err: fmt.Errorf(`ent: validator failed for field "Item.notes_format": %w`, err)}
+		}
+	}
+	if v, ok := iuo.mutation.Source(); ok {
+		if err := item.SourceValidator(v); err != nil {
+			return &ValidationError{Name: "source", err: fmt.Errorf(`ent: validator failed for field "Item.source": %w`, err)}
+		}
+	}
+	if v, ok := iuo.mutation.AcquisitionType(); ok {
+		if err := item.AcquisitionTypeValidator(v); err != nil {
+			return &ValidationError{Name: "acquisition_type", err: fmt.Errorf(`ent: validator failed for field "Item.acquisition_type": %w`, err)}
+		}
+	}
 	if v, ok := iuo.mutation.SerialNumber(); ok {
 		if err := item.SerialNumberValidator(v); err != nil {
 			return &ValidationError{Name: "serial_number", err: fmt.Errorf(`ent: validator failed for field "Item.serial_number": %w`, err)}
 		}
 	}
+	if v, ok := iuo.mutation.Barcode(); ok {
+		if err := item.BarcodeValidator(v); err != nil {
+			return &ValidationError{Name: "barcode", err: fmt.Errorf(`ent: validator failed for field "Item.barcode": %w`, err)}
+		}
+	}
 	if v, ok := iuo.mutation.ModelNumber(); ok {
 		if err := item.ModelNumberValidator(v); err != nil {
 			return &ValidationError{Name: "model_number", err: fmt.Errorf(`ent: validator failed for field "Item.model_number": %w`, err)}
@@ -1992,6 +3058,21 @@ func (iuo *ItemUpdateOne) check() error {
 			return &ValidationError{Name: "warranty_details", err: fmt.Errorf(`ent: validator failed for field "Item.warranty_details": %w`, err)}
 		}
 	}
+	if v, ok := iuo.mutation.PurchaseOrderNumber(); ok {
+		if err := item.PurchaseOrderNumberValidator(v); err != nil {
+			return &ValidationError{Name: "purchase_order_number", err: fmt.Errorf(`ent: validator failed for field "Item.purchase_order_number": %w`, err)}
+		}
+	}
+	if v, ok := iuo.mutation.Currency(); ok {
+		if err := item.CurrencyValidator(v); err != nil {
+			return &ValidationError{Name: "currency", err: fmt.Errorf(`ent: validator failed for field "Item.currency": %w`, err)}
+		}
+	}
+	if v, ok := iuo.mutation.LoanedTo(); ok {
+		if err := item.LoanedToValidator(v); err != nil {
+			return &ValidationError{Name: "loaned_to", err: fmt.Errorf(`ent: validator failed for field "Item.loaned_to": %w`, err)}
+		}
+	}
 	if v, ok := iuo.mutation.SoldNotes(); ok {
 		if err := item.SoldNotesValidator(v); err != nil {
 			return &ValidationError{Name: "sold_notes", err: fmt.Errorf(`ent: validator failed for field "Item.sold_notes": %w`, err)}
@@ -2056,6 +3137,9 @@ func (iuo *ItemUpdateOne) sqlSave(ctx context.Context) (_node *Item, err error)
 	if iuo.mutation.NotesCleared() {
 		_spec.ClearField(item.FieldNotes, field.TypeString)
 	}
+	if value, ok := iuo.mutation.NotesFormat(); ok {
+		_spec.SetField(item.FieldNotesFormat, field.TypeEnum, value)
+	}
 	if value, ok := iuo.mutation.Quantity(); ok {
 		_spec.SetField(item.FieldQuantity, field.TypeInt, value)
 	}
@@ -2068,18 +3152,80 @@ func (iuo *ItemUpdateOne) sqlSave(ctx context.Context) (_node *Item, err error)
 	if value, ok := iuo.mutation.Archived(); ok {
 		_spec.SetField(item.FieldArchived, field.TypeBool, value)
 	}
+	if value, ok := iuo.mutation.Favorite(); ok {
+		_spec.SetField(item.FieldFavorite, field.TypeBool, value)
+	}
+	if value, ok := iuo.mutation.Version(); ok {
+		_spec.SetField(item.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := iuo.mutation.AddedVersion(); ok {
+		_spec.AddField(item.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := iuo.mutation.ArchivedAt(); ok {
+		_spec.SetField(item.FieldArchivedAt, field.TypeTime, value)
+	}
+	if iuo.mutation.ArchivedAtCleared() {
+		_spec.ClearField(item.FieldArchivedAt, field.TypeTime)
+	}
 	if value, ok := iuo.mutation.AssetID(); ok {
 		_spec.SetField(item.FieldAssetID, field.TypeInt, value)
 	}
 	if value, ok := iuo.mutation.AddedAssetID(); ok {
 		_spec.AddField(item.FieldAssetID, field.TypeInt, value)
 	}
+	if value, ok := iuo.mutation.ReorderThreshold(); ok {
+		_spec.SetField(item.FieldReorderThreshold, field.TypeInt, value)
+	}
+	if value, ok := iuo.mutation.AddedReorderThreshold(); ok {
+		_spec.AddField(item.FieldReorderThreshold, field.TypeInt, value)
+	}
+	if value, ok := iuo.mutation.Tags(); ok {
+		_spec.SetField(item.FieldTags, field.TypeJSON, value)
+	}
+	if value, ok := iuo.mutation.AppendedTags(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, item.FieldTags, value)
+		})
+	}
+	if iuo.mutation.TagsCleared() {
+		_spec.ClearField(item.FieldTags, field.TypeJSON)
+	}
+	if value, ok := iuo.mutation.Source(); ok {
+		_spec.SetField(item.FieldSource, field.TypeEnum, value)
+	}
+	if value, ok := iuo.mutation.AcquisitionType(); ok {
+		_spec.SetField(item.FieldAcquisitionType, field.TypeEnum, value)
+	}
+	if value, ok := iuo.mutation.Latitude(); ok {
+		_spec.SetField(item.FieldLatitude, field.TypeFloat64, value)
+	}
+	if value, ok := iuo.mutation.AddedLatitude(); ok {
+		_spec.AddField(item.FieldLatitude, field.TypeFloat64, value)
+	}
+	if iuo.mutation.LatitudeCleared() {
+		_spec.ClearField(item.FieldLatitude, field.TypeFloat64)
+	}
+	if value, ok := iuo.mutation.Longitude(); ok {
+		_spec.SetField(item.FieldLongitude, field.TypeFloat64, value)
+	}
+	if value, ok := iuo.mutation.AddedLongitude(); ok {
+		_spec.AddField(item.FieldLongitude, field.TypeFloat64, value)
+	}
+	if iuo.mutation.LongitudeCleared() {
+		_spec.ClearField(item.FieldLongitude, field.TypeFloat64)
+	}
 	if value, ok := iuo.mutation.SerialNumber(); ok {
 		_spec.SetField(item.FieldSerialNumber, field.TypeString, value)
 	}
 	if iuo.mutation.SerialNumberCleared() {
 		_spec.ClearField(item.FieldSerialNumber, field.TypeString)
 	}
+	if value, ok := iuo.mutation.Barcode(); ok {
+		_spec.SetField(item.FieldBarcode, field.TypeString, value)
+	}
+	if iuo.mutation.BarcodeCleared() {
+		_spec.ClearField(item.FieldBarcode, field.TypeString)
+	}
 	if value, ok := iuo.mutation.ModelNumber(); ok {
 		_spec.SetField(item.FieldModelNumber, field.TypeString, value)
 	}
@@ -2119,12 +3265,48 @@ func (iuo *ItemUpdateOne) sqlSave(ctx context.Context) (_node *Item, err error)
 	if iuo.mutation.PurchaseFromCleared() {
 		_spec.ClearField(item.FieldPurchaseFrom, field.TypeString)
 	}
+	if value, ok := iuo.mutation.PurchaseOrderNumber(); ok {
+		_spec.SetField(item.FieldPurchaseOrderNumber, field.TypeString, value)
+	}
+	if iuo.mutation.PurchaseOrderNumberCleared() {
+		_spec.ClearField(item.FieldPurchaseOrderNumber, field.TypeString)
+	}
 	if value, ok := iuo.mutation.PurchasePrice(); ok {
 		_spec.SetField(item.FieldPurchasePrice, field.TypeFloat64, value)
 	}
 	if value, ok := iuo.mutation.AddedPurchasePrice(); ok {
 		_spec.AddField(item.FieldPurchasePrice, field.TypeFloat64, value)
 	}
+	if value, ok := iuo.mutation.Currency(); ok {
+		_spec.SetField(item.FieldCurrency, field.TypeString, value)
+	}
+	if iuo.mutation.CurrencyCleared() {
+		_spec.ClearField(item.FieldCurrency, field.TypeString)
+	}
+	if value, ok := iuo.mutation.ReplacementCost(); ok {
+		_spec.SetField(item.FieldReplacementCost, field.TypeFloat64, value)
+	}
+	if value, ok := iuo.mutation.AddedReplacementCost(); ok {
+		_spec.AddField(item.FieldReplacementCost, field.TypeFloat64, value)
+	}
+	if value, ok := iuo.mutation.LoanedTo(); ok {
+		_spec.SetField(item.FieldLoanedTo, field.TypeString, value)
+	}
+	if iuo.mutation.LoanedToCleared() {
+		_spec.ClearField(item.FieldLoanedTo, field.TypeString)
+	}
+	if value, ok := iuo.mutation.LoanedAt(); ok {
+		_spec.SetField(item.FieldLoanedAt, field.TypeTime, value)
+	}
+	if iuo.mutation.LoanedAtCleared() {
+		_spec.ClearField(item.FieldLoanedAt, field.TypeTime)
+	}
+	if value, ok := iuo.mutation.LoanDue(); ok {
+		_spec.SetField(item.FieldLoanDue, field.TypeTime, value)
+	}
+	if iuo.mutation.LoanDueCleared() {
+		_spec.ClearField(item.FieldLoanDue, field.TypeTime)
+	}
 	if value, ok := iuo.mutation.SoldTime(); ok {
 		_spec.SetField(item.FieldSoldTime, field.TypeTime, value)
 	}
@@ -2252,6 +3434,51 @@ func (iuo *ItemUpdateOne) sqlSave(ctx context.Context) (_node *Item, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if iuo.mutation.RelatedCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   item.RelatedTable,
+			Columns: item.RelatedPrimaryKey,
+			Bidi:    true,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iuo.mutation.RemovedRelatedIDs(); len(nodes) > 0 && !iuo.mutation.RelatedCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   item.RelatedTable,
+			Columns: item.RelatedPrimaryKey,
+			Bidi:    true,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iuo.mutation.RelatedIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   item.RelatedTable,
+			Columns: item.RelatedPrimaryKey,
+			Bidi:    true,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if iuo.mutation.LabelCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
@@ -2461,6 +3688,51 @@ func (iuo *ItemUpdateOne) sqlSave(ctx context.Context) (_node *Item, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if iuo.mutation.CommentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   item.CommentsTable,
+			Columns: []string{item.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemcomment.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iuo.mutation.RemovedCommentsIDs(); len(nodes) > 0 && !iuo.mutation.CommentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   item.CommentsTable,
+			Columns: []string{item.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemcomment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iuo.mutation.CommentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   item.CommentsTable,
+			Columns: []string{item.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemcomment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Item{config: iuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,202 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/item"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/itemcomment"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/user"
+)
+
+// ItemComment is the model entity for the ItemComment schema.
+type ItemComment struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// ItemID holds the value of the "item_id" field.
+	ItemID uuid.UUID `json:"item_id,omitempty"`
+	// Content holds the value of the "content" field.
+	Content string `json:"content,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ItemCommentQuery when eager-loading is set.
+	Edges              ItemCommentEdges `json:"edges"`
+	user_item_comments *uuid.UUID
+	selectValues       sql.SelectValues
+}
+
+// ItemCommentEdges holds the relations/edges for other nodes in the graph.
+type ItemCommentEdges struct {
+	// Item holds the value of the item edge.
+	Item *Item `json:"item,omitempty"`
+	// Author holds the value of the author edge.
+	Author *User `json:"author,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// ItemOrErr returns the Item value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ItemCommentEdges) ItemOrErr() (*Item, error) {
+	if e.loadedTypes[0] {
+		if e.Item == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: item.Label}
+		}
+		return e.Item, nil
+	}
+	return nil, &NotLoadedError{edge: "item"}
+}
+
+// AuthorOrErr returns the Author value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ItemCommentEdges) AuthorOrErr() (*User, error) {
+	if e.loadedTypes[1] {
+		if e.Author == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: user.Label}
+		}
+		return e.Author, nil
+	}
+	return nil, &NotLoadedError{edge: "author"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ItemComment) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case itemcomment.FieldContent:
+			values[i] = new(sql.NullString)
+		case itemcomment.FieldCreatedAt, itemcomment.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case itemcomment.FieldID, itemcomment.FieldItemID:
+			values[i] = new(uuid.UUID)
+		case itemcomment.ForeignKeys[0]: // user_item_comments
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ItemComment fields.
+func (ic *ItemComment) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case itemcomment.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				ic.ID = *value
+			}
+		case itemcomment.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ic.CreatedAt = value.Time
+			}
+		case itemcomment.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				ic.UpdatedAt = value.Time
+			}
+		case itemcomment.FieldItemID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field item_id", values[i])
+			} else if value != nil {
+				ic.ItemID = *value
+			}
+		case itemcomment.FieldContent:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field content", values[i])
+			} else if value.Valid {
+				ic.Content = value.String
+			}
+		case itemcomment.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field user_item_comments", values[i])
+			} else if value.Valid {
+				ic.user_item_comments = new(uuid.UUID)
+				*ic.user_item_comments = *value.S.(*uuid.UUID)
+			}
+		default:
+			ic.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ItemComment.
+// This includes values selected through modifiers, order, etc.
+func (ic *ItemComment) Value(name string) (ent.Value, error) {
+	return ic.selectValues.Get(name)
+}
+
+// QueryItem queries the "item" edge of the ItemComment entity.
+func (ic *ItemComment) QueryItem() *ItemQuery {
+	return NewItemCommentClient(ic.config).QueryItem(ic)
+}
+
+// QueryAuthor queries the "author" edge of the ItemComment entity.
+func (ic *ItemComment) QueryAuthor() *UserQuery {
+	return NewItemCommentClient(ic.config).QueryAuthor(ic)
+}
+
+// Update returns a builder for updating this ItemComment.
+// Note that you need to call ItemComment.Unwrap() before calling this method if this ItemComment
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ic *ItemComment) Update() *ItemCommentUpdateOne {
+	return NewItemCommentClient(ic.config).UpdateOne(ic)
+}
+
+// Unwrap unwraps the ItemComment entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ic *ItemComment) Unwrap() *ItemComment {
+	_tx, ok := ic.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ItemComment is not a transactional entity")
+	}
+	ic.config.driver = _tx.drv
+	return ic
+}
+
+// String implements the fmt.Stringer.
+func (ic *ItemComment) String() string {
+	var builder strings.Builder
+	builder.WriteString("ItemComment(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ic.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(ic.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(ic.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("item_id=")
+	builder.WriteString(fmt.Sprintf("%v", ic.ItemID))
+	builder.WriteString(", ")
+	builder.WriteString("content=")
+	builder.WriteString(ic.Content)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ItemComments is a parsable slice of ItemComment.
+type ItemComments []*ItemComment
@@ -0,0 +1,341 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/item"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/itemcomment"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/user"
+)
+
+// ItemCommentCreate is the builder for creating a ItemComment entity.
+type ItemCommentCreate struct {
+	config
+	mutation *ItemCommentMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (icc *ItemCommentCreate) SetCreatedAt(t time.Time) *ItemCommentCreate {
+	icc.mutation.SetCreatedAt(t)
+	return icc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (icc *ItemCommentCreate) SetNillableCreatedAt(t *time.Time) *ItemCommentCreate {
+	if t != nil {
+		icc.SetCreatedAt(*t)
+	}
+	return icc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (icc *ItemCommentCreate) SetUpdatedAt(t time.Time) *ItemCommentCreate {
+	icc.mutation.SetUpdatedAt(t)
+	return icc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (icc *ItemCommentCreate) SetNillableUpdatedAt(t *time.Time) *ItemCommentCreate {
+	if t != nil {
+		icc.SetUpdatedAt(*t)
+	}
+	return icc
+}
+
+// SetItemID sets the "item_id" field.
+func (icc *ItemCommentCreate) SetItemID(u uuid.UUID) *ItemCommentCreate {
+	icc.mutation.SetItemID(u)
+	return icc
+}
+
+// SetContent sets the "content" field.
+func (icc *ItemCommentCreate) SetContent(s string) *ItemCommentCreate {
+	icc.mutation.SetContent(s)
+	return icc
+}
+
+// SetID sets the "id" field.
+func (icc *ItemCommentCreate) SetID(u uuid.UUID) *ItemCommentCreate {
+	icc.mutation.SetID(u)
+	return icc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (icc *ItemCommentCreate) SetNillableID(u *uuid.UUID) *ItemCommentCreate {
+	if u != nil {
+		icc.SetID(*u)
+	}
+	return icc
+}
+
+// SetItem sets the "item" edge to the Item entity.
+func (icc *ItemCommentCreate) SetItem(i *Item) *ItemCommentCreate {
+	return icc.SetItemID(i.ID)
+}
+
+// SetAuthorID sets the "author" edge to the User entity by ID.
+func (icc *ItemCommentCreate) SetAuthorID(id uuid.UUID) *ItemCommentCreate {
+	icc.mutation.SetAuthorID(id)
+	return icc
+}
+
+// SetNillableAuthorID sets the "author" edge to the User entity by ID if the given value is not nil.
+func (icc *ItemCommentCreate) SetNillableAuthorID(id *uuid.UUID) *ItemCommentCreate {
+	if id != nil {
+		icc = icc.SetAuthorID(*id)
+	}
+	return icc
+}
+
+// SetAuthor sets the "author" edge to the User entity.
+func (icc *ItemCommentCreate) SetAuthor(u *User) *ItemCommentCreate {
+	return icc.SetAuthorID(u.ID)
+}
+
+// Mutation returns the ItemCommentMutation object of the builder.
+func (icc *ItemCommentCreate) Mutation() *ItemCommentMutation {
+	return icc.mutation
+}
+
+// Save creates the ItemComment in the database.
+func (icc *ItemCommentCreate) Save(ctx context.Context) (*ItemComment, error) {
+	icc.defaults()
+	return withHooks(ctx, icc.sqlSave, icc.mutation, icc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (icc *ItemCommentCreate) SaveX(ctx context.Context) *ItemComment {
+	v, err := icc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (icc *ItemCommentCreate) Exec(ctx context.Context) error {
+	_, err := icc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (icc *ItemCommentCreate) ExecX(ctx context.Context) {
+	if err := icc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (icc *ItemCommentCreate) defaults() {
+	if _, ok := icc.mutation.CreatedAt(); !ok {
+		v := itemcomment.DefaultCreatedAt()
+		icc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := icc.mutation.UpdatedAt(); !ok {
+		v := itemcomment.DefaultUpdatedAt()
+		icc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := icc.mutation.ID(); !ok {
+		v := itemcomment.DefaultID()
+		icc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (icc *ItemCommentCreate) check() error {
+	if _, ok := icc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ItemComment.created_at"`)}
+	}
+	if _, ok := icc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ItemComment.updated_at"`)}
+	}
+	if _, ok := icc.mutation.ItemID(); !ok {
+		return &ValidationError{Name: "item_id", err: errors.New(`ent: missing required field "ItemComment.item_id"`)}
+	}
+	if _, ok := icc.mutation.Content(); !ok {
+		return &ValidationError{Name: "content", err: errors.New(`ent: missing required field "ItemComment.content"`)}
+	}
+	if v, ok := icc.mutation.Content(); ok {
+		if err := itemcomment.ContentValidator(v); err != nil {
+			return &ValidationError{Name: "content", err: fmt.Errorf(`ent: validator failed for field "ItemComment.content": %w`, err)}
+		}
+	}
+	if _, ok := icc.mutation.ItemID(); !ok {
+		return &ValidationError{Name: "item", err: errors.New(`ent: missing required edge "ItemComment.item"`)}
+	}
+	return nil
+}
+
+func (icc *ItemCommentCreate) sqlSave(ctx context.Context) (*ItemComment, error) {
+	if err := icc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := icc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, icc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	icc.mutation.id = &_node.ID
+	icc.mutation.done = true
+	return _node, nil
+}
+
+func (icc *ItemCommentCreate) createSpec() (*ItemComment, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ItemComment{config: icc.config}
+		_spec = sqlgraph.NewCreateSpec(itemcomment.Table, sqlgraph.NewFieldSpec(itemcomment.FieldID, field.TypeUUID))
+	)
+	if id, ok := icc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := icc.mutation.CreatedAt(); ok {
+		_spec.SetField(itemcomment.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := icc.mutation.UpdatedAt(); ok {
+		_spec.SetField(itemcomment.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := icc.mutation.Content(); ok {
+		_spec.SetField(itemcomment.FieldContent, field.TypeString, value)
+		_node.Content = value
+	}
+	if nodes := icc.mutation.ItemIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   itemcomment.ItemTable,
+			Columns: []string{itemcomment.ItemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ItemID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := icc.mutation.AuthorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   itemcomment.AuthorTable,
+			Columns: []string{itemcomment.AuthorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.user_item_comments = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ItemCommentCreateBulk is the builder for creating many ItemComment entities in bulk.
+type ItemCommentCreateBulk struct {
+	config
+	err      error
+	builders []*ItemCommentCreate
+}
+
+// Save creates the ItemComment entities in the database.
+func (iccb *ItemCommentCreateBulk) Save(ctx context.Context) ([]*ItemComment, error) {
+	if iccb.err != nil {
+		return nil, iccb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(iccb.builders))
+	nodes := make([]*ItemComment, len(iccb.builders))
+	mutators := make([]Mutator, len(iccb.builders))
+	for i := range iccb.builders {
+		func(i int, root context.Context) {
+			builder := iccb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ItemCommentMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, iccb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, iccb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, iccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (iccb *ItemCommentCreateBulk) SaveX(ctx context.Context) []*ItemComment {
+	v, err := iccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (iccb *ItemCommentCreateBulk) Exec(ctx context.Context) error {
+	_, err := iccb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iccb *ItemCommentCreateBulk) ExecX(ctx context.Context) {
+	if err := iccb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/itemcomment"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/predicate"
+)
+
+// ItemCommentDelete is the builder for deleting a ItemComment entity.
+type ItemCommentDelete struct {
+	config
+	hooks    []Hook
+	mutation *ItemCommentMutation
+}
+
+// Where appends a list predicates to the ItemCommentDelete builder.
+func (icd *ItemCommentDelete) Where(ps ...predicate.ItemComment) *ItemCommentDelete {
+	icd.mutation.Where(ps...)
+	return icd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (icd *ItemCommentDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, icd.sqlExec, icd.mutation, icd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (icd *ItemCommentDelete) ExecX(ctx context.Context) int {
+	n, err := icd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (icd *ItemCommentDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(itemcomment.Table, sqlgraph.NewFieldSpec(itemcomment.FieldID, field.TypeUUID))
+	if ps := icd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, icd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	icd.mutation.done = true
+	return affected, err
+}
+
+// ItemCommentDeleteOne is the builder for deleting a single ItemComment entity.
+type ItemCommentDeleteOne struct {
+	icd *ItemCommentDelete
+}
+
+// Where appends a list predicates to the ItemCommentDelete builder.
+func (icdo *ItemCommentDeleteOne) Where(ps ...predicate.ItemComment) *ItemCommentDeleteOne {
+	icdo.icd.mutation.Where(ps...)
+	return icdo
+}
+
+// Exec executes the deletion query.
+func (icdo *ItemCommentDeleteOne) Exec(ctx context.Context) error {
+	n, err := icdo.icd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{itemcomment.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (icdo *ItemCommentDeleteOne) ExecX(ctx context.Context) {
+	if err := icdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,689 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/item"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/itemcomment"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/predicate"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/user"
+)
+
+// ItemCommentQuery is the builder for querying ItemComment entities.
+type ItemCommentQuery struct {
+	config
+	ctx        *QueryContext
+	order      []itemcomment.OrderOption
+	inters     []Interceptor
+	predicates []predicate.ItemComment
+	withItem   *ItemQuery
+	withAuthor *UserQuery
+	withFKs    bool
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ItemCommentQuery builder.
+func (icq *ItemCommentQuery) Where(ps ...predicate.ItemComment) *ItemCommentQuery {
+	icq.predicates = append(icq.predicates, ps...)
+	return icq
+}
+
+// Limit the number of records to be returned by this query.
+func (icq *ItemCommentQuery) Limit(limit int) *ItemCommentQuery {
+	icq.ctx.Limit = &limit
+	return icq
+}
+
+// Offset to start from.
+func (icq *ItemCommentQuery) Offset(offset int) *ItemCommentQuery {
+	icq.ctx.Offset = &offset
+	return icq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (icq *ItemCommentQuery) Unique(unique bool) *ItemCommentQuery {
+	icq.ctx.Unique = &unique
+	return icq
+}
+
+// Order specifies how the records should be ordered.
+func (icq *ItemCommentQuery) Order(o ...itemcomment.OrderOption) *ItemCommentQuery {
+	icq.order = append(icq.order, o...)
+	return icq
+}
+
+// QueryItem chains the current query on the "item" edge.
+func (icq *ItemCommentQuery) QueryItem() *ItemQuery {
+	query := (&ItemClient{config: icq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := icq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := icq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(itemcomment.Table, itemcomment.FieldID, selector),
+			sqlgraph.To(item.Table, item.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, itemcomment.ItemTable, itemcomment.ItemColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(icq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryAuthor chains the current query on the "author" edge.
+func (icq *ItemCommentQuery) QueryAuthor() *UserQuery {
+	query := (&UserClient{config: icq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := icq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := icq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(itemcomment.Table, itemcomment.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, itemcomment.AuthorTable, itemcomment.AuthorColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(icq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first ItemComment entity from the query.
+// Returns a *NotFoundError when no ItemComment was found.
+func (icq *ItemCommentQuery) First(ctx context.Context) (*ItemComment, error) {
+	nodes, err := icq.Limit(1).All(setContextOp(ctx, icq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{itemcomment.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (icq *ItemCommentQuery) FirstX(ctx context.Context) *ItemComment {
+	node, err := icq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ItemComment ID from the query.
+// Returns a *NotFoundError when no ItemComment ID was found.
+func (icq *ItemCommentQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = icq.Limit(1).IDs(setContextOp(ctx, icq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{itemcomment.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (icq *ItemCommentQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := icq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ItemComment entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ItemComment entity is found.
+// Returns a *NotFoundError when no ItemComment entities are found.
+func (icq *ItemCommentQuery) Only(ctx context.Context) (*ItemComment, error) {
+	nodes, err := icq.Limit(2).All(setContextOp(ctx, icq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{itemcomment.Label}
+	default:
+		return nil, &NotSingularError{itemcomment.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (icq *ItemCommentQuery) OnlyX(ctx context.Context) *ItemComment {
+	node, err := icq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ItemComment ID in the query.
+// Returns a *NotSingularError when more than one ItemComment ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (icq *ItemCommentQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = icq.Limit(2).IDs(setContextOp(ctx, icq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{itemcomment.Label}
+	default:
+		err = &NotSingularError{itemcomment.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (icq *ItemCommentQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := icq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ItemComments.
+func (icq *ItemCommentQuery) All(ctx context.Context) ([]*ItemComment, error) {
+	ctx = setContextOp(ctx, icq.ctx, "All")
+	if err := icq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*ItemComment, *ItemCommentQuery]()
+	return withInterceptors[[]*ItemComment](ctx, icq, qr, icq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (icq *ItemCommentQuery) AllX(ctx context.Context) []*ItemComment {
+	nodes, err := icq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ItemComment IDs.
+func (icq *ItemCommentQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if icq.ctx.Unique == nil && icq.path != nil {
+		icq.Unique(true)
+	}
+	ctx = setContextOp(ctx, icq.ctx, "IDs")
+	if err = icq.Select(itemcomment.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (icq *ItemCommentQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := icq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (icq *ItemCommentQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, icq.ctx, "Count")
+	if err := icq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, icq, querierCount[*ItemCommentQuery](), icq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (icq *ItemCommentQuery) CountX(ctx context.Context) int {
+	count, err := icq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (icq *ItemCommentQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, icq.ctx, "Exist")
+	switch _, err := icq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (icq *ItemCommentQuery) ExistX(ctx context.Context) bool {
+	exist, err := icq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ItemCommentQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (icq *ItemCommentQuery) Clone() *ItemCommentQuery {
+	if icq == nil {
+		return nil
+	}
+	return &ItemCommentQuery{
+		config:     icq.config,
+		ctx:        icq.ctx.Clone(),
+		order:      append([]itemcomment.OrderOption{}, icq.order...),
+		inters:     append([]Interceptor{}, icq.inters...),
+		predicates: append([]predicate.ItemComment{}, icq.predicates...),
+		withItem:   icq.withItem.Clone(),
+		withAuthor: icq.withAuthor.Clone(),
+		// clone intermediate query.
+		sql:  icq.sql.Clone(),
+		path: icq.path,
+	}
+}
+
+// WithItem tells the query-builder to eager-load the nodes that are connected to
+// the "item" edge. The optional arguments are used to configure the query builder of the edge.
+func (icq *ItemCommentQuery) WithItem(opts ...func(*ItemQuery)) *ItemCommentQuery {
+	query := (&ItemClient{config: icq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	icq.withItem = query
+	return icq
+}
+
+// WithAuthor tells the query-builder to eager-load the nodes that are connected to
+// the "author" edge. The optional arguments are used to configure the query builder of the edge.
+func (icq *ItemCommentQuery) WithAuthor(opts ...func(*UserQuery)) *ItemCommentQuery {
+	query := (&UserClient{config: icq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	icq.withAuthor = query
+	return icq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ItemComment.Query().
+//		GroupBy(itemcomment.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (icq *ItemCommentQuery) GroupBy(field string, fields ...string) *ItemCommentGroupBy {
+	icq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &ItemCommentGroupBy{build: icq}
+	grbuild.flds = &icq.ctx.Fields
+	grbuild.label = itemcomment.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.ItemComment.Query().
+//		Select(itemcomment.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (icq *ItemCommentQuery) Select(fields ...string) *ItemCommentSelect {
+	icq.ctx.Fields = append(icq.ctx.Fields, fields...)
+	sbuild := &ItemCommentSelect{ItemCommentQuery: icq}
+	sbuild.label = itemcomment.Label
+	sbuild.flds, sbuild.scan = &icq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a ItemCommentSelect configured with the given aggregations.
+func (icq *ItemCommentQuery) Aggregate(fns ...AggregateFunc) *ItemCommentSelect {
+	return icq.Select().Aggregate(fns...)
+}
+
+func (icq *ItemCommentQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range icq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, icq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range icq.ctx.Fields {
+		if !itemcomment.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if icq.path != nil {
+		prev, err := icq.path(ctx)
+		if err != nil {
+			return err
+		}
+		icq.sql = prev
+	}
+	return nil
+}
+
+func (icq *ItemCommentQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ItemComment, error) {
+	var (
+		nodes       = []*ItemComment{}
+		withFKs     = icq.withFKs
+		_spec       = icq.querySpec()
+		loadedTypes = [2]bool{
+			icq.withItem != nil,
+			icq.withAuthor != nil,
+		}
+	)
+	if icq.withAuthor != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, itemcomment.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*ItemComment).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &ItemComment{config: icq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, icq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := icq.withItem; query != nil {
+		if err := icq.loadItem(ctx, query, nodes, nil,
+			func(n *ItemComment, e *Item) { n.Edges.Item = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := icq.withAuthor; query != nil {
+		if err := icq.loadAuthor(ctx, query, nodes, nil,
+			func(n *ItemComment, e *User) { n.Edges.Author = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (icq *ItemCommentQuery) loadItem(ctx context.Context, query *ItemQuery, nodes []*ItemComment, init func(*ItemComment), assign func(*ItemComment, *Item)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*ItemComment)
+	for i := range nodes {
+		fk := nodes[i].ItemID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(item.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "item_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (icq *ItemCommentQuery) loadAuthor(ctx context.Context, query *UserQuery, nodes []*ItemComment, init func(*ItemComment), assign func(*ItemComment, *User)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*ItemComment)
+	for i := range nodes {
+		if nodes[i].user_item_comments == nil {
+			continue
+		}
+		fk := *nodes[i].user_item_comments
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(user.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "user_item_comments" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (icq *ItemCommentQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := icq.querySpec()
+	_spec.Node.Columns = icq.ctx.Fields
+	if len(icq.ctx.Fields) > 0 {
+		_spec.Unique = icq.ctx.Unique != nil && *icq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, icq.driver, _spec)
+}
+
+func (icq *ItemCommentQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(itemcomment.Table, itemcomment.Columns, sqlgraph.NewFieldSpec(itemcomment.FieldID, field.TypeUUID))
+	_spec.From = icq.sql
+	if unique := icq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if icq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := icq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, itemcomment.FieldID)
+		for i := range fields {
+			if fields[i] != itemcomment.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if icq.withItem != nil {
+			_spec.Node.AddColumnOnce(itemcomment.FieldItemID)
+		}
+	}
+	if ps := icq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := icq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := icq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := icq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (icq *ItemCommentQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(icq.driver.Dialect())
+	t1 := builder.Table(itemcomment.Table)
+	columns := icq.ctx.Fields
+	if len(columns) == 0 {
+		columns = itemcomment.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if icq.sql != nil {
+		selector = icq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if icq.ctx.Unique != nil && *icq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range icq.predicates {
+		p(selector)
+	}
+	for _, p := range icq.order {
+		p(selector)
+	}
+	if offset := icq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := icq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ItemCommentGroupBy is the group-by builder for ItemComment entities.
+type ItemCommentGroupBy struct {
+	selector
+	build *ItemCommentQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (icgb *ItemCommentGroupBy) Aggregate(fns ...AggregateFunc) *ItemCommentGroupBy {
+	icgb.fns = append(icgb.fns, fns...)
+	return icgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (icgb *ItemCommentGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, icgb.build.ctx, "GroupBy")
+	if err := icgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ItemCommentQuery, *ItemCommentGroupBy](ctx, icgb.build, icgb, icgb.build.inters, v)
+}
+
+func (icgb *ItemCommentGroupBy) sqlScan(ctx context.Context, root *ItemCommentQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(icgb.fns))
+	for _, fn := range icgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*icgb.flds)+len(icgb.fns))
+		for _, f := range *icgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*icgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := icgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// ItemCommentSelect is the builder for selecting fields of ItemComment entities.
+type ItemCommentSelect struct {
+	*ItemCommentQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (ics *ItemCommentSelect) Aggregate(fns ...AggregateFunc) *ItemCommentSelect {
+	ics.fns = append(ics.fns, fns...)
+	return ics
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ics *ItemCommentSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ics.ctx, "Select")
+	if err := ics.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ItemCommentQuery, *ItemCommentSelect](ctx, ics.ItemCommentQuery, ics, ics.inters, v)
+}
+
+func (ics *ItemCommentSelect) sqlScan(ctx context.Context, root *ItemCommentQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(ics.fns))
+	for _, fn := range ics.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*ics.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ics.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
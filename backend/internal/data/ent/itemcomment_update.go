@@ -0,0 +1,497 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/item"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/itemcomment"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/predicate"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/user"
+)
+
+// ItemCommentUpdate is the builder for updating ItemComment entities.
+type ItemCommentUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ItemCommentMutation
+}
+
+// Where appends a list predicates to the ItemCommentUpdate builder.
+func (icu *ItemCommentUpdate) Where(ps ...predicate.ItemComment) *ItemCommentUpdate {
+	icu.mutation.Where(ps...)
+	return icu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (icu *ItemCommentUpdate) SetUpdatedAt(t time.Time) *ItemCommentUpdate {
+	icu.mutation.SetUpdatedAt(t)
+	return icu
+}
+
+// SetItemID sets the "item_id" field.
+func (icu *ItemCommentUpdate) SetItemID(u uuid.UUID) *ItemCommentUpdate {
+	icu.mutation.SetItemID(u)
+	return icu
+}
+
+// SetNillableItemID sets the "item_id" field if the given value is not nil.
+func (icu *ItemCommentUpdate) SetNillableItemID(u *uuid.UUID) *ItemCommentUpdate {
+	if u != nil {
+		icu.SetItemID(*u)
+	}
+	return icu
+}
+
+// SetContent sets the "content" field.
+func (icu *ItemCommentUpdate) SetContent(s string) *ItemCommentUpdate {
+	icu.mutation.SetContent(s)
+	return icu
+}
+
+// SetNillableContent sets the "content" field if the given value is not nil.
+func (icu *ItemCommentUpdate) SetNillableContent(s *string) *ItemCommentUpdate {
+	if s != nil {
+		icu.SetContent(*s)
+	}
+	return icu
+}
+
+// SetItem sets the "item" edge to the Item entity.
+func (icu *ItemCommentUpdate) SetItem(i *Item) *ItemCommentUpdate {
+	return icu.SetItemID(i.ID)
+}
+
+// SetAuthorID sets the "author" edge to the User entity by ID.
+func (icu *ItemCommentUpdate) SetAuthorID(id uuid.UUID) *ItemCommentUpdate {
+	icu.mutation.SetAuthorID(id)
+	return icu
+}
+
+// SetNillableAuthorID sets the "author" edge to the User entity by ID if the given value is not nil.
+func (icu *ItemCommentUpdate) SetNillableAuthorID(id *uuid.UUID) *ItemCommentUpdate {
+	if id != nil {
+		icu = icu.SetAuthorID(*id)
+	}
+	return icu
+}
+
+// SetAuthor sets the "author" edge to the User entity.
+func (icu *ItemCommentUpdate) SetAuthor(u *User) *ItemCommentUpdate {
+	return icu.SetAuthorID(u.ID)
+}
+
+// Mutation returns the ItemCommentMutation object of the builder.
+func (icu *ItemCommentUpdate) Mutation() *ItemCommentMutation {
+	return icu.mutation
+}
+
+// ClearItem clears the "item" edge to the Item entity.
+func (icu *ItemCommentUpdate) ClearItem() *ItemCommentUpdate {
+	icu.mutation.ClearItem()
+	return icu
+}
+
+// ClearAuthor clears the "author" edge to the User entity.
+func (icu *ItemCommentUpdate) ClearAuthor() *ItemCommentUpdate {
+	icu.mutation.ClearAuthor()
+	return icu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (icu *ItemCommentUpdate) Save(ctx context.Context) (int, error) {
+	icu.defaults()
+	return withHooks(ctx, icu.sqlSave, icu.mutation, icu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (icu *ItemCommentUpdate) SaveX(ctx context.Context) int {
+	affected, err := icu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (icu *ItemCommentUpdate) Exec(ctx context.Context) error {
+	_, err := icu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (icu *ItemCommentUpdate) ExecX(ctx context.Context) {
+	if err := icu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (icu *ItemCommentUpdate) defaults() {
+	if _, ok := icu.mutation.UpdatedAt(); !ok {
+		v := itemcomment.UpdateDefaultUpdatedAt()
+		icu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (icu *ItemCommentUpdate) check() error {
+	if v, ok := icu.mutation.Content(); ok {
+		if err := itemcomment.ContentValidator(v); err != nil {
+			return &ValidationError{Name: "content", err: fmt.Errorf(`ent: validator failed for field "ItemComment.content": %w`, err)}
+		}
+	}
+	if _, ok := icu.mutation.ItemID(); icu.mutation.ItemCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "ItemComment.item"`)
+	}
+	return nil
+}
+
+func (icu *ItemCommentUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := icu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(itemcomment.Table, itemcomment.Columns, sqlgraph.NewFieldSpec(itemcomment.FieldID, field.TypeUUID))
+	if ps := icu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := icu.mutation.UpdatedAt(); ok {
+		_spec.SetField(itemcomment.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := icu.mutation.Content(); ok {
+		_spec.SetField(itemcomment.FieldContent, field.TypeString, value)
+	}
+	if icu.mutation.ItemCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   itemcomment.ItemTable,
+			Columns: []string{itemcomment.ItemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := icu.mutation.ItemIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   itemcomment.ItemTable,
+			Columns: []string{itemcomment.ItemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if icu.mutation.AuthorCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   itemcomment.AuthorTable,
+			Columns: []string{itemcomment.AuthorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := icu.mutation.AuthorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   itemcomment.AuthorTable,
+			Columns: []string{itemcomment.AuthorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, icu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{itemcomment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	icu.mutation.done = true
+	return n, nil
+}
+
+// ItemCommentUpdateOne is the builder for updating a single ItemComment entity.
+type ItemCommentUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ItemCommentMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (icuo *ItemCommentUpdateOne) SetUpdatedAt(t time.Time) *ItemCommentUpdateOne {
+	icuo.mutation.SetUpdatedAt(t)
+	return icuo
+}
+
+// SetItemID sets the "item_id" field.
+func (icuo *ItemCommentUpdateOne) SetItemID(u uuid.UUID) *ItemCommentUpdateOne {
+	icuo.mutation.SetItemID(u)
+	return icuo
+}
+
+// SetNillableItemID sets the "item_id" field if the given value is not nil.
+func (icuo *ItemCommentUpdateOne) SetNillableItemID(u *uuid.UUID) *ItemCommentUpdateOne {
+	if u != nil {
+		icuo.SetItemID(*u)
+	}
+	return icuo
+}
+
+// SetContent sets the "content" field.
+func (icuo *ItemCommentUpdateOne) SetContent(s string) *ItemCommentUpdateOne {
+	icuo.mutation.SetContent(s)
+	return icuo
+}
+
+// SetNillableContent sets the "content" field if the given value is not nil.
+func (icuo *ItemCommentUpdateOne) SetNillableContent(s *string) *ItemCommentUpdateOne {
+	if s != nil {
+		icuo.SetContent(*s)
+	}
+	return icuo
+}
+
+// SetItem sets the "item" edge to the Item entity.
+func (icuo *ItemCommentUpdateOne) SetItem(i *Item) *ItemCommentUpdateOne {
+	return icuo.SetItemID(i.ID)
+}
+
+// SetAuthorID sets the "author" edge to the User entity by ID.
+func (icuo *ItemCommentUpdateOne) SetAuthorID(id uuid.UUID) *ItemCommentUpdateOne {
+	icuo.mutation.SetAuthorID(id)
+	return icuo
+}
+
+// SetNillableAuthorID sets the "author" edge to the User entity by ID if the given value is not nil.
+func (icuo *ItemCommentUpdateOne) SetNillableAuthorID(id *uuid.UUID) *ItemCommentUpdateOne {
+	if id != nil {
+		icuo = icuo.SetAuthorID(*id)
+	}
+	return icuo
+}
+
+// SetAuthor sets the "author" edge to the User entity.
+func (icuo *ItemCommentUpdateOne) SetAuthor(u *User) *ItemCommentUpdateOne {
+	return icuo.SetAuthorID(u.ID)
+}
+
+// Mutation returns the ItemCommentMutation object of the builder.
+func (icuo *ItemCommentUpdateOne) Mutation() *ItemCommentMutation {
+	return icuo.mutation
+}
+
+// ClearItem clears the "item" edge to the Item entity.
+func (icuo *ItemCommentUpdateOne) ClearItem() *ItemCommentUpdateOne {
+	icuo.mutation.ClearItem()
+	return icuo
+}
+
+// ClearAuthor clears the "author" edge to the User entity.
+func (icuo *ItemCommentUpdateOne) ClearAuthor() *ItemCommentUpdateOne {
+	icuo.mutation.ClearAuthor()
+	return icuo
+}
+
+// Where appends a list predicates to the ItemCommentUpdate builder.
+func (icuo *ItemCommentUpdateOne) Where(ps ...predicate.ItemComment) *ItemCommentUpdateOne {
+	icuo.mutation.Where(ps...)
+	return icuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (icuo *ItemCommentUpdateOne) Select(field string, fields ...string) *ItemCommentUpdateOne {
+	icuo.fields = append([]string{field}, fields...)
+	return icuo
+}
+
+// Save executes the query and returns the updated ItemComment entity.
+func (icuo *ItemCommentUpdateOne) Save(ctx context.Context) (*ItemComment, error) {
+	icuo.defaults()
+	return withHooks(ctx, icuo.sqlSave, icuo.mutation, icuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (icuo *ItemCommentUpdateOne) SaveX(ctx context.Context) *ItemComment {
+	node, err := icuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (icuo *ItemCommentUpdateOne) Exec(ctx context.Context) error {
+	_, err := icuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (icuo *ItemCommentUpdateOne) ExecX(ctx context.Context) {
+	if err := icuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (icuo *ItemCommentUpdateOne) defaults() {
+	if _, ok := icuo.mutation.UpdatedAt(); !ok {
+		v := itemcomment.UpdateDefaultUpdatedAt()
+		icuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (icuo *ItemCommentUpdateOne) check() error {
+	if v, ok := icuo.mutation.Content(); ok {
+		if err := itemcomment.ContentValidator(v); err != nil {
+			return &ValidationError{Name: "content", err: fmt.Errorf(`ent: validator failed for field "ItemComment.content": %w`, err)}
+		}
+	}
+	if _, ok := icuo.mutation.ItemID(); icuo.mutation.ItemCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "ItemComment.item"`)
+	}
+	return nil
+}
+
+func (icuo *ItemCommentUpdateOne) sqlSave(ctx context.Context) (_node *ItemComment, err error) {
+	if err := icuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(itemcomment.Table, itemcomment.Columns, sqlgraph.NewFieldSpec(itemcomment.FieldID, field.TypeUUID))
+	id, ok := icuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ItemComment.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := icuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, itemcomment.FieldID)
+		for _, f := range fields {
+			if !itemcomment.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != itemcomment.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := icuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := icuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(itemcomment.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := icuo.mutation.Content(); ok {
+		_spec.SetField(itemcomment.FieldContent, field.TypeString, value)
+	}
+	if icuo.mutation.ItemCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   itemcomment.ItemTable,
+			Columns: []string{itemcomment.ItemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := icuo.mutation.ItemIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   itemcomment.ItemTable,
+			Columns: []string{itemcomment.ItemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if icuo.mutation.AuthorCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   itemcomment.AuthorTable,
+			Columns: []string{itemcomment.AuthorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := icuo.mutation.AuthorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   itemcomment.AuthorTable,
+			Columns: []string{itemcomment.AuthorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &ItemComment{config: icuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, icuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{itemcomment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	icuo.mutation.done = true
+	return _node, nil
+}
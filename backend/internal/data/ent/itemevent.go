@@ -0,0 +1,229 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/group"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/itemevent"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/user"
+	"github.com/hay-kot/homebox/backend/internal/data/types"
+)
+
+// ItemEvent is the model entity for the ItemEvent schema.
+type ItemEvent struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// ItemID holds the value of the "item_id" field.
+	ItemID uuid.UUID `json:"item_id,omitempty"`
+	// Type holds the value of the "type" field.
+	Type itemevent.Type `json:"type,omitempty"`
+	// Changes holds the value of the "changes" field.
+	Changes map[string]types.FieldChange `json:"changes,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ItemEventQuery when eager-loading is set.
+	Edges             ItemEventEdges `json:"edges"`
+	group_item_events *uuid.UUID
+	user_item_events  *uuid.UUID
+	selectValues      sql.SelectValues
+}
+
+// ItemEventEdges holds the relations/edges for other nodes in the graph.
+type ItemEventEdges struct {
+	// Group holds the value of the group edge.
+	Group *Group `json:"group,omitempty"`
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// GroupOrErr returns the Group value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ItemEventEdges) GroupOrErr() (*Group, error) {
+	if e.loadedTypes[0] {
+		if e.Group == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: group.Label}
+		}
+		return e.Group, nil
+	}
+	return nil, &NotLoadedError{edge: "group"}
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ItemEventEdges) UserOrErr() (*User, error) {
+	if e.loadedTypes[1] {
+		if e.User == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: user.Label}
+		}
+		return e.User, nil
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ItemEvent) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case itemevent.FieldChanges:
+			values[i] = new([]byte)
+		case itemevent.FieldType:
+			values[i] = new(sql.NullString)
+		case itemevent.FieldCreatedAt, itemevent.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case itemevent.FieldID, itemevent.FieldItemID:
+			values[i] = new(uuid.UUID)
+		case itemevent.ForeignKeys[0]: // group_item_events
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case itemevent.ForeignKeys[1]: // user_item_events
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ItemEvent fields.
+func (ie *ItemEvent) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case itemevent.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				ie.ID = *value
+			}
+		case itemevent.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ie.CreatedAt = value.Time
+			}
+		case itemevent.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				ie.UpdatedAt = value.Time
+			}
+		case itemevent.FieldItemID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field item_id", values[i])
+			} else if value != nil {
+				ie.ItemID = *value
+			}
+		case itemevent.FieldType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field type", values[i])
+			} else if value.Valid {
+				ie.Type = itemevent.Type(value.String)
+			}
+		case itemevent.FieldChanges:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field changes", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &ie.Changes); err != nil {
+					return fmt.Errorf("unmarshal field changes: %w", err)
+				}
+			}
+		case itemevent.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field group_item_events", values[i])
+			} else if value.Valid {
+				ie.group_item_events = new(uuid.UUID)
+				*ie.group_item_events = *value.S.(*uuid.UUID)
+			}
+		case itemevent.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field user_item_events", values[i])
+			} else if value.Valid {
+				ie.user_item_events = new(uuid.UUID)
+				*ie.user_item_events = *value.S.(*uuid.UUID)
+			}
+		default:
+			ie.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ItemEvent.
+// This includes values selected through modifiers, order, etc.
+func (ie *ItemEvent) Value(name string) (ent.Value, error) {
+	return ie.selectValues.Get(name)
+}
+
+// QueryGroup queries the "group" edge of the ItemEvent entity.
+func (ie *ItemEvent) QueryGroup() *GroupQuery {
+	return NewItemEventClient(ie.config).QueryGroup(ie)
+}
+
+// QueryUser queries the "user" edge of the ItemEvent entity.
+func (ie *ItemEvent) QueryUser() *UserQuery {
+	return NewItemEventClient(ie.config).QueryUser(ie)
+}
+
+// Update returns a builder for updating this ItemEvent.
+// Note that you need to call ItemEvent.Unwrap() before calling this method if this ItemEvent
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ie *ItemEvent) Update() *ItemEventUpdateOne {
+	return NewItemEventClient(ie.config).UpdateOne(ie)
+}
+
+// Unwrap unwraps the ItemEvent entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ie *ItemEvent) Unwrap() *ItemEvent {
+	_tx, ok := ie.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ItemEvent is not a transactional entity")
+	}
+	ie.config.driver = _tx.drv
+	return ie
+}
+
+// String implements the fmt.Stringer.
+func (ie *ItemEvent) String() string {
+	var builder strings.Builder
+	builder.WriteString("ItemEvent(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ie.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(ie.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(ie.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("item_id=")
+	builder.WriteString(fmt.Sprintf("%v", ie.ItemID))
+	builder.WriteString(", ")
+	builder.WriteString("type=")
+	builder.WriteString(fmt.Sprintf("%v", ie.Type))
+	builder.WriteString(", ")
+	builder.WriteString("changes=")
+	builder.WriteString(fmt.Sprintf("%v", ie.Changes))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ItemEvents is a parsable slice of ItemEvent.
+type ItemEvents []*ItemEvent
@@ -0,0 +1,362 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/group"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/itemevent"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/user"
+	"github.com/hay-kot/homebox/backend/internal/data/types"
+)
+
+// ItemEventCreate is the builder for creating a ItemEvent entity.
+type ItemEventCreate struct {
+	config
+	mutation *ItemEventMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (iec *ItemEventCreate) SetCreatedAt(t time.Time) *ItemEventCreate {
+	iec.mutation.SetCreatedAt(t)
+	return iec
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (iec *ItemEventCreate) SetNillableCreatedAt(t *time.Time) *ItemEventCreate {
+	if t != nil {
+		iec.SetCreatedAt(*t)
+	}
+	return iec
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (iec *ItemEventCreate) SetUpdatedAt(t time.Time) *ItemEventCreate {
+	iec.mutation.SetUpdatedAt(t)
+	return iec
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (iec *ItemEventCreate) SetNillableUpdatedAt(t *time.Time) *ItemEventCreate {
+	if t != nil {
+		iec.SetUpdatedAt(*t)
+	}
+	return iec
+}
+
+// SetItemID sets the "item_id" field.
+func (iec *ItemEventCreate) SetItemID(u uuid.UUID) *ItemEventCreate {
+	iec.mutation.SetItemID(u)
+	return iec
+}
+
+// SetType sets the "type" field.
+func (iec *ItemEventCreate) SetType(i itemevent.Type) *ItemEventCreate {
+	iec.mutation.SetType(i)
+	return iec
+}
+
+// SetChanges sets the "changes" field.
+func (iec *ItemEventCreate) SetChanges(mc map[string]types.FieldChange) *ItemEventCreate {
+	iec.mutation.SetChanges(mc)
+	return iec
+}
+
+// SetID sets the "id" field.
+func (iec *ItemEventCreate) SetID(u uuid.UUID) *ItemEventCreate {
+	iec.mutation.SetID(u)
+	return iec
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (iec *ItemEventCreate) SetNillableID(u *uuid.UUID) *ItemEventCreate {
+	if u != nil {
+		iec.SetID(*u)
+	}
+	return iec
+}
+
+// SetGroupID sets the "group" edge to the Group entity by ID.
+func (iec *ItemEventCreate) SetGroupID(id uuid.UUID) *ItemEventCreate {
+	iec.mutation.SetGroupID(id)
+	return iec
+}
+
+// SetGroup sets the "group" edge to the Group entity.
+func (iec *ItemEventCreate) SetGroup(g *Group) *ItemEventCreate {
+	return iec.SetGroupID(g.ID)
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (iec *ItemEventCreate) SetUserID(id uuid.UUID) *ItemEventCreate {
+	iec.mutation.SetUserID(id)
+	return iec
+}
+
+// SetNillableUserID sets the "user" edge to the User entity by ID if the given value is not nil.
+func (iec *ItemEventCreate) SetNillableUserID(id *uuid.UUID) *ItemEventCreate {
+	if id != nil {
+		iec = iec.SetUserID(*id)
+	}
+	return iec
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (iec *ItemEventCreate) SetUser(u *User) *ItemEventCreate {
+	return iec.SetUserID(u.ID)
+}
+
+// Mutation returns the ItemEventMutation object of the builder.
+func (iec *ItemEventCreate) Mutation() *ItemEventMutation {
+	return iec.mutation
+}
+
+// Save creates the ItemEvent in the database.
+func (iec *ItemEventCreate) Save(ctx context.Context) (*ItemEvent, error) {
+	iec.defaults()
+	return withHooks(ctx, iec.sqlSave, iec.mutation, iec.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (iec *ItemEventCreate) SaveX(ctx context.Context) *ItemEvent {
+	v, err := iec.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (iec *ItemEventCreate) Exec(ctx context.Context) error {
+	_, err := iec.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iec *ItemEventCreate) ExecX(ctx context.Context) {
+	if err := iec.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (iec *ItemEventCreate) defaults() {
+	if _, ok := iec.mutation.CreatedAt(); !ok {
+		v := itemevent.DefaultCreatedAt()
+		iec.mutation.SetCreatedAt(v)
+	}
+	if _, ok := iec.mutation.UpdatedAt(); !ok {
+		v := itemevent.DefaultUpdatedAt()
+		iec.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := iec.mutation.ID(); !ok {
+		v := itemevent.DefaultID()
+		iec.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (iec *ItemEventCreate) check() error {
+	if _, ok := iec.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ItemEvent.created_at"`)}
+	}
+	if _, ok := iec.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ItemEvent.updated_at"`)}
+	}
+	if _, ok := iec.mutation.ItemID(); !ok {
+		return &ValidationError{Name: "item_id", err: errors.New(`ent: missing required field "ItemEvent.item_id"`)}
+	}
+	if _, ok := iec.mutation.GetType(); !ok {
+		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "ItemEvent.type"`)}
+	}
+	if v, ok := iec.mutation.GetType(); ok {
+		if err := itemevent.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "ItemEvent.type": %w`, err)}
+		}
+	}
+	if _, ok := iec.mutation.GroupID(); !ok {
+		return &ValidationError{Name: "group", err: errors.New(`ent: missing required edge "ItemEvent.group"`)}
+	}
+	return nil
+}
+
+func (iec *ItemEventCreate) sqlSave(ctx context.Context) (*ItemEvent, error) {
+	if err := iec.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := iec.createSpec()
+	if err := sqlgraph.CreateNode(ctx, iec.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	iec.mutation.id = &_node.ID
+	iec.mutation.done = true
+	return _node, nil
+}
+
+func (iec *ItemEventCreate) createSpec() (*ItemEvent, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ItemEvent{config: iec.config}
+		_spec = sqlgraph.NewCreateSpec(itemevent.Table, sqlgraph.NewFieldSpec(itemevent.FieldID, field.TypeUUID))
+	)
+	if id, ok := iec.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := iec.mutation.CreatedAt(); ok {
+		_spec.SetField(itemevent.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := iec.mutation.UpdatedAt(); ok {
+		_spec.SetField(itemevent.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := iec.mutation.ItemID(); ok {
+		_spec.SetField(itemevent.FieldItemID, field.TypeUUID, value)
+		_node.ItemID = value
+	}
+	if value, ok := iec.mutation.GetType(); ok {
+		_spec.SetField(itemevent.FieldType, field.TypeEnum, value)
+		_node.Type = value
+	}
+	if value, ok := iec.mutation.Changes(); ok {
+		_spec.SetField(itemevent.FieldChanges, field.TypeJSON, value)
+		_node.Changes = value
+	}
+	if nodes := iec.mutation.GroupIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   itemevent.GroupTable,
+			Columns: []string{itemevent.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.group_item_events = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := iec.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   itemevent.UserTable,
+			Columns: []string{itemevent.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.user_item_events = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ItemEventCreateBulk is the builder for creating many ItemEvent entities in bulk.
+type ItemEventCreateBulk struct {
+	config
+	err      error
+	builders []*ItemEventCreate
+}
+
+// Save creates the ItemEvent entities in the database.
+func (iecb *ItemEventCreateBulk) Save(ctx context.Context) ([]*ItemEvent, error) {
+	if iecb.err != nil {
+		return nil, iecb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(iecb.builders))
+	nodes := make([]*ItemEvent, len(iecb.builders))
+	mutators := make([]Mutator, len(iecb.builders))
+	for i := range iecb.builders {
+		func(i int, root context.Context) {
+			builder := iecb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ItemEventMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, iecb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, iecb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, iecb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (iecb *ItemEventCreateBulk) SaveX(ctx context.Context) []*ItemEvent {
+	v, err := iecb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (iecb *ItemEventCreateBulk) Exec(ctx context.Context) error {
+	_, err := iecb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iecb *ItemEventCreateBulk) ExecX(ctx context.Context) {
+	if err := iecb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
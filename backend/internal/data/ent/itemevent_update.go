@@ -0,0 +1,552 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/group"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/itemevent"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/predicate"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/user"
+	"github.com/hay-kot/homebox/backend/internal/data/types"
+)
+
+// ItemEventUpdate is the builder for updating ItemEvent entities.
+type ItemEventUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ItemEventMutation
+}
+
+// Where appends a list predicates to the ItemEventUpdate builder.
+func (ieu *ItemEventUpdate) Where(ps ...predicate.ItemEvent) *ItemEventUpdate {
+	ieu.mutation.Where(ps...)
+	return ieu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ieu *ItemEventUpdate) SetUpdatedAt(t time.Time) *ItemEventUpdate {
+	ieu.mutation.SetUpdatedAt(t)
+	return ieu
+}
+
+// SetItemID sets the "item_id" field.
+func (ieu *ItemEventUpdate) SetItemID(u uuid.UUID) *ItemEventUpdate {
+	ieu.mutation.SetItemID(u)
+	return ieu
+}
+
+// SetNillableItemID sets the "item_id" field if the given value is not nil.
+func (ieu *ItemEventUpdate) SetNillableItemID(u *uuid.UUID) *ItemEventUpdate {
+	if u != nil {
+		ieu.SetItemID(*u)
+	}
+	return ieu
+}
+
+// SetType sets the "type" field.
+func (ieu *ItemEventUpdate) SetType(i itemevent.Type) *ItemEventUpdate {
+	ieu.mutation.SetType(i)
+	return ieu
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (ieu *ItemEventUpdate) SetNillableType(i *itemevent.Type) *ItemEventUpdate {
+	if i != nil {
+		ieu.SetType(*i)
+	}
+	return ieu
+}
+
+// SetChanges sets the "changes" field.
+func (ieu *ItemEventUpdate) SetChanges(mc map[string]types.FieldChange) *ItemEventUpdate {
+	ieu.mutation.SetChanges(mc)
+	return ieu
+}
+
+// ClearChanges clears the value of the "changes" field.
+func (ieu *ItemEventUpdate) ClearChanges() *ItemEventUpdate {
+	ieu.mutation.ClearChanges()
+	return ieu
+}
+
+// SetGroupID sets the "group" edge to the Group entity by ID.
+func (ieu *ItemEventUpdate) SetGroupID(id uuid.UUID) *ItemEventUpdate {
+	ieu.mutation.SetGroupID(id)
+	return ieu
+}
+
+// SetGroup sets the "group" edge to the Group entity.
+func (ieu *ItemEventUpdate) SetGroup(g *Group) *ItemEventUpdate {
+	return ieu.SetGroupID(g.ID)
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (ieu *ItemEventUpdate) SetUserID(id uuid.UUID) *ItemEventUpdate {
+	ieu.mutation.SetUserID(id)
+	return ieu
+}
+
+// SetNillableUserID sets the "user" edge to the User entity by ID if the given value is not nil.
+func (ieu *ItemEventUpdate) SetNillableUserID(id *uuid.UUID) *ItemEventUpdate {
+	if id != nil {
+		ieu = ieu.SetUserID(*id)
+	}
+	return ieu
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (ieu *ItemEventUpdate) SetUser(u *User) *ItemEventUpdate {
+	return ieu.SetUserID(u.ID)
+}
+
+// Mutation returns the ItemEventMutation object of the builder.
+func (ieu *ItemEventUpdate) Mutation() *ItemEventMutation {
+	return ieu.mutation
+}
+
+// ClearGroup clears the "group" edge to the Group entity.
+func (ieu *ItemEventUpdate) ClearGroup() *ItemEventUpdate {
+	ieu.mutation.ClearGroup()
+	return ieu
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (ieu *ItemEventUpdate) ClearUser() *ItemEventUpdate {
+	ieu.mutation.ClearUser()
+	return ieu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ieu *ItemEventUpdate) Save(ctx context.Context) (int, error) {
+	ieu.defaults()
+	return withHooks(ctx, ieu.sqlSave, ieu.mutation, ieu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ieu *ItemEventUpdate) SaveX(ctx context.Context) int {
+	affected, err := ieu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ieu *ItemEventUpdate) Exec(ctx context.Context) error {
+	_, err := ieu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ieu *ItemEventUpdate) ExecX(ctx context.Context) {
+	if err := ieu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ieu *ItemEventUpdate) defaults() {
+	if _, ok := ieu.mutation.UpdatedAt(); !ok {
+		v := itemevent.UpdateDefaultUpdatedAt()
+		ieu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ieu *ItemEventUpdate) check() error {
+	if v, ok := ieu.mutation.GetType(); ok {
+		if err := itemevent.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "ItemEvent.type": %w`, err)}
+		}
+	}
+	if _, ok := ieu.mutation.GroupID(); ieu.mutation.GroupCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "ItemEvent.group"`)
+	}
+	return nil
+}
+
+func (ieu *ItemEventUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := ieu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(itemevent.Table, itemevent.Columns, sqlgraph.NewFieldSpec(itemevent.FieldID, field.TypeUUID))
+	if ps := ieu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ieu.mutation.UpdatedAt(); ok {
+		_spec.SetField(itemevent.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := ieu.mutation.ItemID(); ok {
+		_spec.SetField(itemevent.FieldItemID, field.TypeUUID, value)
+	}
+	if value, ok := ieu.mutation.GetType(); ok {
+		_spec.SetField(itemevent.FieldType, field.TypeEnum, value)
+	}
+	if value, ok := ieu.mutation.Changes(); ok {
+		_spec.SetField(itemevent.FieldChanges, field.TypeJSON, value)
+	}
+	if ieu.mutation.ChangesCleared() {
+		_spec.ClearField(itemevent.FieldChanges, field.TypeJSON)
+	}
+	if ieu.mutation.GroupCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   itemevent.GroupTable,
+			Columns: []string{itemevent.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ieu.mutation.GroupIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   itemevent.GroupTable,
+			Columns: []string{itemevent.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ieu.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   itemevent.UserTable,
+			Columns: []string{itemevent.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ieu.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   itemevent.UserTable,
+			Columns: []string{itemevent.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, ieu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{itemevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	ieu.mutation.done = true
+	return n, nil
+}
+
+// ItemEventUpdateOne is the builder for updating a single ItemEvent entity.
+type ItemEventUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ItemEventMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ieuo *ItemEventUpdateOne) SetUpdatedAt(t time.Time) *ItemEventUpdateOne {
+	ieuo.mutation.SetUpdatedAt(t)
+	return ieuo
+}
+
+// SetItemID sets the "item_id" field.
+func (ieuo *ItemEventUpdateOne) SetItemID(u uuid.UUID) *ItemEventUpdateOne {
+	ieuo.mutation.SetItemID(u)
+	return ieuo
+}
+
+// SetNillableItemID sets the "item_id" field if the given value is not nil.
+func (ieuo *ItemEventUpdateOne) SetNillableItemID(u *uuid.UUID) *ItemEventUpdateOne {
+	if u != nil {
+		ieuo.SetItemID(*u)
+	}
+	return ieuo
+}
+
+// SetType sets the "type" field.
+func (ieuo *ItemEventUpdateOne) SetType(i itemevent.Type) *ItemEventUpdateOne {
+	ieuo.mutation.SetType(i)
+	return ieuo
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (ieuo *ItemEventUpdateOne) SetNillableType(i *itemevent.Type) *ItemEventUpdateOne {
+	if i != nil {
+		ieuo.SetType(*i)
+	}
+	return ieuo
+}
+
+// SetChanges sets the "changes" field.
+func (ieuo *ItemEventUpdateOne) SetChanges(mc map[string]types.FieldChange) *ItemEventUpdateOne {
+	ieuo.mutation.SetChanges(mc)
+	return ieuo
+}
+
+// ClearChanges clears the value of the "changes" field.
+func (ieuo *ItemEventUpdateOne) ClearChanges() *ItemEventUpdateOne {
+	ieuo.mutation.ClearChanges()
+	return ieuo
+}
+
+// SetGroupID sets the "group" edge to the Group entity by ID.
+func (ieuo *ItemEventUpdateOne) SetGroupID(id uuid.UUID) *ItemEventUpdateOne {
+	ieuo.mutation.SetGroupID(id)
+	return ieuo
+}
+
+// SetGroup sets the "group" edge to the Group entity.
+func (ieuo *ItemEventUpdateOne) SetGroup(g *Group) *ItemEventUpdateOne {
+	return ieuo.SetGroupID(g.ID)
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (ieuo *ItemEventUpdateOne) SetUserID(id uuid.UUID) *ItemEventUpdateOne {
+	ieuo.mutation.SetUserID(id)
+	return ieuo
+}
+
+// SetNillableUserID sets the "user" edge to the User entity by ID if the given value is not nil.
+func (ieuo *ItemEventUpdateOne) SetNillableUserID(id *uuid.UUID) *ItemEventUpdateOne {
+	if id != nil {
+		ieuo = ieuo.SetUserID(*id)
+	}
+	return ieuo
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (ieuo *ItemEventUpdateOne) SetUser(u *User) *ItemEventUpdateOne {
+	return ieuo.SetUserID(u.ID)
+}
+
+// Mutation returns the ItemEventMutation object of the builder.
+func (ieuo *ItemEventUpdateOne) Mutation() *ItemEventMutation {
+	return ieuo.mutation
+}
+
+// ClearGroup clears the "group" edge to the Group entity.
+func (ieuo *ItemEventUpdateOne) ClearGroup() *ItemEventUpdateOne {
+	ieuo.mutation.ClearGroup()
+	return ieuo
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (ieuo *ItemEventUpdateOne) ClearUser() *ItemEventUpdateOne {
+	ieuo.mutation.ClearUser()
+	return ieuo
+}
+
+// Where appends a list predicates to the ItemEventUpdate builder.
+func (ieuo *ItemEventUpdateOne) Where(ps ...predicate.ItemEvent) *ItemEventUpdateOne {
+	ieuo.mutation.Where(ps...)
+	return ieuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ieuo *ItemEventUpdateOne) Select(field string, fields ...string) *ItemEventUpdateOne {
+	ieuo.fields = append([]string{field}, fields...)
+	return ieuo
+}
+
+// Save executes the query and returns the updated ItemEvent entity.
+func (ieuo *ItemEventUpdateOne) Save(ctx context.Context) (*ItemEvent, error) {
+	ieuo.defaults()
+	return withHooks(ctx, ieuo.sqlSave, ieuo.mutation, ieuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ieuo *ItemEventUpdateOne) SaveX(ctx context.Context) *ItemEvent {
+	node, err := ieuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ieuo *ItemEventUpdateOne) Exec(ctx context.Context) error {
+	_, err := ieuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ieuo *ItemEventUpdateOne) ExecX(ctx context.Context) {
+	if err := ieuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ieuo *ItemEventUpdateOne) defaults() {
+	if _, ok := ieuo.mutation.UpdatedAt(); !ok {
+		v := itemevent.UpdateDefaultUpdatedAt()
+		ieuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ieuo *ItemEventUpdateOne) check() error {
+	if v, ok := ieuo.mutation.GetType(); ok {
+		if err := itemevent.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "ItemEvent.type": %w`, err)}
+		}
+	}
+	if _, ok := ieuo.mutation.GroupID(); ieuo.mutation.GroupCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "ItemEvent.group"`)
+	}
+	return nil
+}
+
+func (ieuo *ItemEventUpdateOne) sqlSave(ctx context.Context) (_node *ItemEvent, err error) {
+	if err := ieuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(itemevent.Table, itemevent.Columns, sqlgraph.NewFieldSpec(itemevent.FieldID, field.TypeUUID))
+	id, ok := ieuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ItemEvent.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ieuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, itemevent.FieldID)
+		for _, f := range fields {
+			if !itemevent.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != itemevent.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ieuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ieuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(itemevent.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := ieuo.mutation.ItemID(); ok {
+		_spec.SetField(itemevent.FieldItemID, field.TypeUUID, value)
+	}
+	if value, ok := ieuo.mutation.GetType(); ok {
+		_spec.SetField(itemevent.FieldType, field.TypeEnum, value)
+	}
+	if value, ok := ieuo.mutation.Changes(); ok {
+		_spec.SetField(itemevent.FieldChanges, field.TypeJSON, value)
+	}
+	if ieuo.mutation.ChangesCleared() {
+		_spec.ClearField(itemevent.FieldChanges, field.TypeJSON)
+	}
+	if ieuo.mutation.GroupCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   itemevent.GroupTable,
+			Columns: []string{itemevent.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ieuo.mutation.GroupIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   itemevent.GroupTable,
+			Columns: []string{itemevent.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ieuo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   itemevent.UserTable,
+			Columns: []string{itemevent.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ieuo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   itemevent.UserTable,
+			Columns: []string{itemevent.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &ItemEvent{config: ieuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ieuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{itemevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	ieuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,187 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/group"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/itemtemplate"
+	"github.com/hay-kot/homebox/backend/internal/data/types"
+)
+
+// ItemTemplate is the model entity for the ItemTemplate schema.
+type ItemTemplate struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Defaults holds the value of the "defaults" field.
+	Defaults types.ItemTemplateDefaults `json:"defaults,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ItemTemplateQuery when eager-loading is set.
+	Edges                ItemTemplateEdges `json:"edges"`
+	group_item_templates *uuid.UUID
+	selectValues         sql.SelectValues
+}
+
+// ItemTemplateEdges holds the relations/edges for other nodes in the graph.
+type ItemTemplateEdges struct {
+	// Group holds the value of the group edge.
+	Group *Group `json:"group,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// GroupOrErr returns the Group value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ItemTemplateEdges) GroupOrErr() (*Group, error) {
+	if e.loadedTypes[0] {
+		if e.Group == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: group.Label}
+		}
+		return e.Group, nil
+	}
+	return nil, &NotLoadedError{edge: "group"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ItemTemplate) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case itemtemplate.FieldDefaults:
+			values[i] = new([]byte)
+		case itemtemplate.FieldName:
+			values[i] = new(sql.NullString)
+		case itemtemplate.FieldCreatedAt, itemtemplate.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case itemtemplate.FieldID:
+			values[i] = new(uuid.UUID)
+		case itemtemplate.ForeignKeys[0]: // group_item_templates
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ItemTemplate fields.
+func (it *ItemTemplate) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case itemtemplate.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				it.ID = *value
+			}
+		case itemtemplate.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				it.CreatedAt = value.Time
+			}
+		case itemtemplate.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				it.UpdatedAt = value.Time
+			}
+		case itemtemplate.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				it.Name = value.String
+			}
+		case itemtemplate.FieldDefaults:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field defaults", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &it.Defaults); err != nil {
+					return fmt.Errorf("unmarshal field defaults: %w", err)
+				}
+			}
+		case itemtemplate.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field group_item_templates", values[i])
+			} else if value.Valid {
+				it.group_item_templates = new(uuid.UUID)
+				*it.group_item_templates = *value.S.(*uuid.UUID)
+			}
+		default:
+			it.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ItemTemplate.
+// This includes values selected through modifiers, order, etc.
+func (it *ItemTemplate) Value(name string) (ent.Value, error) {
+	return it.selectValues.Get(name)
+}
+
+// QueryGroup queries the "group" edge of the ItemTemplate entity.
+func (it *ItemTemplate) QueryGroup() *GroupQuery {
+	return NewItemTemplateClient(it.config).QueryGroup(it)
+}
+
+// Update returns a builder for updating this ItemTemplate.
+// Note that you need to call ItemTemplate.Unwrap() before calling this method if this ItemTemplate
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (it *ItemTemplate) Update() *ItemTemplateUpdateOne {
+	return NewItemTemplateClient(it.config).UpdateOne(it)
+}
+
+// Unwrap unwraps the ItemTemplate entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (it *ItemTemplate) Unwrap() *ItemTemplate {
+	_tx, ok := it.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ItemTemplate is not a transactional entity")
+	}
+	it.config.driver = _tx.drv
+	return it
+}
+
+// String implements the fmt.Stringer.
+func (it *ItemTemplate) String() string {
+	var builder strings.Builder
+	builder.WriteString("ItemTemplate(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", it.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(it.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(it.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(it.Name)
+	builder.WriteString(", ")
+	builder.WriteString("defaults=")
+	builder.WriteString(fmt.Sprintf("%v", it.Defaults))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ItemTemplates is a parsable slice of ItemTemplate.
+type ItemTemplates []*ItemTemplate
@@ -0,0 +1,315 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/group"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/itemtemplate"
+	"github.com/hay-kot/homebox/backend/internal/data/types"
+)
+
+// ItemTemplateCreate is the builder for creating a ItemTemplate entity.
+type ItemTemplateCreate struct {
+	config
+	mutation *ItemTemplateMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (itc *ItemTemplateCreate) SetCreatedAt(t time.Time) *ItemTemplateCreate {
+	itc.mutation.SetCreatedAt(t)
+	return itc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (itc *ItemTemplateCreate) SetNillableCreatedAt(t *time.Time) *ItemTemplateCreate {
+	if t != nil {
+		itc.SetCreatedAt(*t)
+	}
+	return itc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (itc *ItemTemplateCreate) SetUpdatedAt(t time.Time) *ItemTemplateCreate {
+	itc.mutation.SetUpdatedAt(t)
+	return itc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (itc *ItemTemplateCreate) SetNillableUpdatedAt(t *time.Time) *ItemTemplateCreate {
+	if t != nil {
+		itc.SetUpdatedAt(*t)
+	}
+	return itc
+}
+
+// SetName sets the "name" field.
+func (itc *ItemTemplateCreate) SetName(s string) *ItemTemplateCreate {
+	itc.mutation.SetName(s)
+	return itc
+}
+
+// SetDefaults sets the "defaults" field.
+func (itc *ItemTemplateCreate) SetDefaults(ttd types.ItemTemplateDefaults) *ItemTemplateCreate {
+	itc.mutation.SetDefaults(ttd)
+	return itc
+}
+
+// SetID sets the "id" field.
+func (itc *ItemTemplateCreate) SetID(u uuid.UUID) *ItemTemplateCreate {
+	itc.mutation.SetID(u)
+	return itc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (itc *ItemTemplateCreate) SetNillableID(u *uuid.UUID) *ItemTemplateCreate {
+	if u != nil {
+		itc.SetID(*u)
+	}
+	return itc
+}
+
+// SetGroupID sets the "group" edge to the Group entity by ID.
+func (itc *ItemTemplateCreate) SetGroupID(id uuid.UUID) *ItemTemplateCreate {
+	itc.mutation.SetGroupID(id)
+	return itc
+}
+
+// SetGroup sets the "group" edge to the Group entity.
+func (itc *ItemTemplateCreate) SetGroup(g *Group) *ItemTemplateCreate {
+	return itc.SetGroupID(g.ID)
+}
+
+// Mutation returns the ItemTemplateMutation object of the builder.
+func (itc *ItemTemplateCreate) Mutation() *ItemTemplateMutation {
+	return itc.mutation
+}
+
+// Save creates the ItemTemplate in the database.
+func (itc *ItemTemplateCreate) Save(ctx context.Context) (*ItemTemplate, error) {
+	itc.defaults()
+	return withHooks(ctx, itc.sqlSave, itc.mutation, itc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (itc *ItemTemplateCreate) SaveX(ctx context.Context) *ItemTemplate {
+	v, err := itc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (itc *ItemTemplateCreate) Exec(ctx context.Context) error {
+	_, err := itc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (itc *ItemTemplateCreate) ExecX(ctx context.Context) {
+	if err := itc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (itc *ItemTemplateCreate) defaults() {
+	if _, ok := itc.mutation.CreatedAt(); !ok {
+		v := itemtemplate.DefaultCreatedAt()
+		itc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := itc.mutation.UpdatedAt(); !ok {
+		v := itemtemplate.DefaultUpdatedAt()
+		itc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := itc.mutation.ID(); !ok {
+		v := itemtemplate.DefaultID()
+		itc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (itc *ItemTemplateCreate) check() error {
+	if _, ok := itc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ItemTemplate.created_at"`)}
+	}
+	if _, ok := itc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ItemTemplate.updated_at"`)}
+	}
+	if _, ok := itc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "ItemTemplate.name"`)}
+	}
+	if v, ok := itc.mutation.Name(); ok {
+		if err := itemtemplate.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "ItemTemplate.name": %w`, err)}
+		}
+	}
+	if _, ok := itc.mutation.Defaults(); !ok {
+		return &ValidationError{Name: "defaults", err: errors.New(`ent: missing required field "ItemTemplate.defaults"`)}
+	}
+	if _, ok := itc.mutation.GroupID(); !ok {
+		return &ValidationError{Name: "group", err: errors.New(`ent: missing required edge "ItemTemplate.group"`)}
+	}
+	return nil
+}
+
+func (itc *ItemTemplateCreate) sqlSave(ctx context.Context) (*ItemTemplate, error) {
+	if err := itc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := itc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, itc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	itc.mutation.id = &_node.ID
+	itc.mutation.done = true
+	return _node, nil
+}
+
+func (itc *ItemTemplateCreate) createSpec() (*ItemTemplate, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ItemTemplate{config: itc.config}
+		_spec = sqlgraph.NewCreateSpec(itemtemplate.Table, sqlgraph.NewFieldSpec(itemtemplate.FieldID, field.TypeUUID))
+	)
+	if id, ok := itc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := itc.mutation.CreatedAt(); ok {
+		_spec.SetField(itemtemplate.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := itc.mutation.UpdatedAt(); ok {
+		_spec.SetField(itemtemplate.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := itc.mutation.Name(); ok {
+		_spec.SetField(itemtemplate.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := itc.mutation.Defaults(); ok {
+		_spec.SetField(itemtemplate.FieldDefaults, field.TypeJSON, value)
+		_node.Defaults = value
+	}
+	if nodes := itc.mutation.GroupIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   itemtemplate.GroupTable,
+			Columns: []string{itemtemplate.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.group_item_templates = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ItemTemplateCreateBulk is the builder for creating many ItemTemplate entities in bulk.
+type ItemTemplateCreateBulk struct {
+	config
+	err      error
+	builders []*ItemTemplateCreate
+}
+
+// Save creates the ItemTemplate entities in the database.
+func (itcb *ItemTemplateCreateBulk) Save(ctx context.Context) ([]*ItemTemplate, error) {
+	if itcb.err != nil {
+		return nil, itcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(itcb.builders))
+	nodes := make([]*ItemTemplate, len(itcb.builders))
+	mutators := make([]Mutator, len(itcb.builders))
+	for i := range itcb.builders {
+		func(i int, root context.Context) {
+			builder := itcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ItemTemplateMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, itcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, itcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, itcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (itcb *ItemTemplateCreateBulk) SaveX(ctx context.Context) []*ItemTemplate {
+	v, err := itcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (itcb *ItemTemplateCreateBulk) Exec(ctx context.Context) error {
+	_, err := itcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (itcb *ItemTemplateCreateBulk) ExecX(ctx context.Context) {
+	if err := itcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/itemtemplate"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/predicate"
+)
+
+// ItemTemplateDelete is the builder for deleting a ItemTemplate entity.
+type ItemTemplateDelete struct {
+	config
+	hooks    []Hook
+	mutation *ItemTemplateMutation
+}
+
+// Where appends a list predicates to the ItemTemplateDelete builder.
+func (itd *ItemTemplateDelete) Where(ps ...predicate.ItemTemplate) *ItemTemplateDelete {
+	itd.mutation.Where(ps...)
+	return itd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (itd *ItemTemplateDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, itd.sqlExec, itd.mutation, itd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (itd *ItemTemplateDelete) ExecX(ctx context.Context) int {
+	n, err := itd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (itd *ItemTemplateDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(itemtemplate.Table, sqlgraph.NewFieldSpec(itemtemplate.FieldID, field.TypeUUID))
+	if ps := itd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, itd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	itd.mutation.done = true
+	return affected, err
+}
+
+// ItemTemplateDeleteOne is the builder for deleting a single ItemTemplate entity.
+type ItemTemplateDeleteOne struct {
+	itd *ItemTemplateDelete
+}
+
+// Where appends a list predicates to the ItemTemplateDelete builder.
+func (itdo *ItemTemplateDeleteOne) Where(ps ...predicate.ItemTemplate) *ItemTemplateDeleteOne {
+	itdo.itd.mutation.Where(ps...)
+	return itdo
+}
+
+// Exec executes the deletion query.
+func (itdo *ItemTemplateDeleteOne) Exec(ctx context.Context) error {
+	n, err := itdo.itd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{itemtemplate.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (itdo *ItemTemplateDeleteOne) ExecX(ctx context.Context) {
+	if err := itdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
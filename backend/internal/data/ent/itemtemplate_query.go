@@ -0,0 +1,614 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/group"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/itemtemplate"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/predicate"
+)
+
+// ItemTemplateQuery is the builder for querying ItemTemplate entities.
+type ItemTemplateQuery struct {
+	config
+	ctx        *QueryContext
+	order      []itemtemplate.OrderOption
+	inters     []Interceptor
+	predicates []predicate.ItemTemplate
+	withGroup  *GroupQuery
+	withFKs    bool
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ItemTemplateQuery builder.
+func (itq *ItemTemplateQuery) Where(ps ...predicate.ItemTemplate) *ItemTemplateQuery {
+	itq.predicates = append(itq.predicates, ps...)
+	return itq
+}
+
+// Limit the number of records to be returned by this query.
+func (itq *ItemTemplateQuery) Limit(limit int) *ItemTemplateQuery {
+	itq.ctx.Limit = &limit
+	return itq
+}
+
+// Offset to start from.
+func (itq *ItemTemplateQuery) Offset(offset int) *ItemTemplateQuery {
+	itq.ctx.Offset = &offset
+	return itq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (itq *ItemTemplateQuery) Unique(unique bool) *ItemTemplateQuery {
+	itq.ctx.Unique = &unique
+	return itq
+}
+
+// Order specifies how the records should be ordered.
+func (itq *ItemTemplateQuery) Order(o ...itemtemplate.OrderOption) *ItemTemplateQuery {
+	itq.order = append(itq.order, o...)
+	return itq
+}
+
+// QueryGroup chains the current query on the "group" edge.
+func (itq *ItemTemplateQuery) QueryGroup() *GroupQuery {
+	query := (&GroupClient{config: itq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := itq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := itq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(itemtemplate.Table, itemtemplate.FieldID, selector),
+			sqlgraph.To(group.Table, group.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, itemtemplate.GroupTable, itemtemplate.GroupColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(itq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first ItemTemplate entity from the query.
+// Returns a *NotFoundError when no ItemTemplate was found.
+func (itq *ItemTemplateQuery) First(ctx context.Context) (*ItemTemplate, error) {
+	nodes, err := itq.Limit(1).All(setContextOp(ctx, itq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{itemtemplate.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (itq *ItemTemplateQuery) FirstX(ctx context.Context) *ItemTemplate {
+	node, err := itq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ItemTemplate ID from the query.
+// Returns a *NotFoundError when no ItemTemplate ID was found.
+func (itq *ItemTemplateQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = itq.Limit(1).IDs(setContextOp(ctx, itq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{itemtemplate.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (itq *ItemTemplateQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := itq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ItemTemplate entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ItemTemplate entity is found.
+// Returns a *NotFoundError when no ItemTemplate entities are found.
+func (itq *ItemTemplateQuery) Only(ctx context.Context) (*ItemTemplate, error) {
+	nodes, err := itq.Limit(2).All(setContextOp(ctx, itq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{itemtemplate.Label}
+	default:
+		return nil, &NotSingularError{itemtemplate.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (itq *ItemTemplateQuery) OnlyX(ctx context.Context) *ItemTemplate {
+	node, err := itq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ItemTemplate ID in the query.
+// Returns a *NotSingularError when more than one ItemTemplate ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (itq *ItemTemplateQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = itq.Limit(2).IDs(setContextOp(ctx, itq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{itemtemplate.Label}
+	default:
+		err = &NotSingularError{itemtemplate.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (itq *ItemTemplateQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := itq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ItemTemplates.
+func (itq *ItemTemplateQuery) All(ctx context.Context) ([]*ItemTemplate, error) {
+	ctx = setContextOp(ctx, itq.ctx, "All")
+	if err := itq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*ItemTemplate, *ItemTemplateQuery]()
+	return withInterceptors[[]*ItemTemplate](ctx, itq, qr, itq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (itq *ItemTemplateQuery) AllX(ctx context.Context) []*ItemTemplate {
+	nodes, err := itq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ItemTemplate IDs.
+func (itq *ItemTemplateQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if itq.ctx.Unique == nil && itq.path != nil {
+		itq.Unique(true)
+	}
+	ctx = setContextOp(ctx, itq.ctx, "IDs")
+	if err = itq.Select(itemtemplate.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (itq *ItemTemplateQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := itq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (itq *ItemTemplateQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, itq.ctx, "Count")
+	if err := itq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, itq, querierCount[*ItemTemplateQuery](), itq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (itq *ItemTemplateQuery) CountX(ctx context.Context) int {
+	count, err := itq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (itq *ItemTemplateQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, itq.ctx, "Exist")
+	switch _, err := itq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (itq *ItemTemplateQuery) ExistX(ctx context.Context) bool {
+	exist, err := itq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ItemTemplateQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (itq *ItemTemplateQuery) Clone() *ItemTemplateQuery {
+	if itq == nil {
+		return nil
+	}
+	return &ItemTemplateQuery{
+		config:     itq.config,
+		ctx:        itq.ctx.Clone(),
+		order:      append([]itemtemplate.OrderOption{}, itq.order...),
+		inters:     append([]Interceptor{}, itq.inters...),
+		predicates: append([]predicate.ItemTemplate{}, itq.predicates...),
+		withGroup:  itq.withGroup.Clone(),
+		// clone intermediate query.
+		sql:  itq.sql.Clone(),
+		path: itq.path,
+	}
+}
+
+// WithGroup tells the query-builder to eager-load the nodes that are connected to
+// the "group" edge. The optional arguments are used to configure the query builder of the edge.
+func (itq *ItemTemplateQuery) WithGroup(opts ...func(*GroupQuery)) *ItemTemplateQuery {
+	query := (&GroupClient{config: itq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	itq.withGroup = query
+	return itq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ItemTemplate.Query().
+//		GroupBy(itemtemplate.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (itq *ItemTemplateQuery) GroupBy(field string, fields ...string) *ItemTemplateGroupBy {
+	itq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &ItemTemplateGroupBy{build: itq}
+	grbuild.flds = &itq.ctx.Fields
+	grbuild.label = itemtemplate.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.ItemTemplate.Query().
+//		Select(itemtemplate.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (itq *ItemTemplateQuery) Select(fields ...string) *ItemTemplateSelect {
+	itq.ctx.Fields = append(itq.ctx.Fields, fields...)
+	sbuild := &ItemTemplateSelect{ItemTemplateQuery: itq}
+	sbuild.label = itemtemplate.Label
+	sbuild.flds, sbuild.scan = &itq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a ItemTemplateSelect configured with the given aggregations.
+func (itq *ItemTemplateQuery) Aggregate(fns ...AggregateFunc) *ItemTemplateSelect {
+	return itq.Select().Aggregate(fns...)
+}
+
+func (itq *ItemTemplateQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range itq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, itq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range itq.ctx.Fields {
+		if !itemtemplate.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if itq.path != nil {
+		prev, err := itq.path(ctx)
+		if err != nil {
+			return err
+		}
+		itq.sql = prev
+	}
+	return nil
+}
+
+func (itq *ItemTemplateQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ItemTemplate, error) {
+	var (
+		nodes       = []*ItemTemplate{}
+		withFKs     = itq.withFKs
+		_spec       = itq.querySpec()
+		loadedTypes = [1]bool{
+			itq.withGroup != nil,
+		}
+	)
+	if itq.withGroup != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, itemtemplate.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*ItemTemplate).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &ItemTemplate{config: itq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, itq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := itq.withGroup; query != nil {
+		if err := itq.loadGroup(ctx, query, nodes, nil,
+			func(n *ItemTemplate, e *Group) { n.Edges.Group = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (itq *ItemTemplateQuery) loadGroup(ctx context.Context, query *GroupQuery, nodes []*ItemTemplate, init func(*ItemTemplate), assign func(*ItemTemplate, *Group)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*ItemTemplate)
+	for i := range nodes {
+		if nodes[i].group_item_templates == nil {
+			continue
+		}
+		fk := *nodes[i].group_item_templates
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(group.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "group_item_templates" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (itq *ItemTemplateQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := itq.querySpec()
+	_spec.Node.Columns = itq.ctx.Fields
+	if len(itq.ctx.Fields) > 0 {
+		_spec.Unique = itq.ctx.Unique != nil && *itq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, itq.driver, _spec)
+}
+
+func (itq *ItemTemplateQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(itemtemplate.Table, itemtemplate.Columns, sqlgraph.NewFieldSpec(itemtemplate.FieldID, field.TypeUUID))
+	_spec.From = itq.sql
+	if unique := itq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if itq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := itq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, itemtemplate.FieldID)
+		for i := range fields {
+			if fields[i] != itemtemplate.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := itq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := itq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := itq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := itq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (itq *ItemTemplateQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(itq.driver.Dialect())
+	t1 := builder.Table(itemtemplate.Table)
+	columns := itq.ctx.Fields
+	if len(columns) == 0 {
+		columns = itemtemplate.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if itq.sql != nil {
+		selector = itq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if itq.ctx.Unique != nil && *itq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range itq.predicates {
+		p(selector)
+	}
+	for _, p := range itq.order {
+		p(selector)
+	}
+	if offset := itq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := itq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ItemTemplateGroupBy is the group-by builder for ItemTemplate entities.
+type ItemTemplateGroupBy struct {
+	selector
+	build *ItemTemplateQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (itgb *ItemTemplateGroupBy) Aggregate(fns ...AggregateFunc) *ItemTemplateGroupBy {
+	itgb.fns = append(itgb.fns, fns...)
+	return itgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (itgb *ItemTemplateGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, itgb.build.ctx, "GroupBy")
+	if err := itgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ItemTemplateQuery, *ItemTemplateGroupBy](ctx, itgb.build, itgb, itgb.build.inters, v)
+}
+
+func (itgb *ItemTemplateGroupBy) sqlScan(ctx context.Context, root *ItemTemplateQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(itgb.fns))
+	for _, fn := range itgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*itgb.flds)+len(itgb.fns))
+		for _, f := range *itgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*itgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := itgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// ItemTemplateSelect is the builder for selecting fields of ItemTemplate entities.
+type ItemTemplateSelect struct {
+	*ItemTemplateQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (its *ItemTemplateSelect) Aggregate(fns ...AggregateFunc) *ItemTemplateSelect {
+	its.fns = append(its.fns, fns...)
+	return its
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (its *ItemTemplateSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, its.ctx, "Select")
+	if err := its.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ItemTemplateQuery, *ItemTemplateSelect](ctx, its.ItemTemplateQuery, its, its.inters, v)
+}
+
+func (its *ItemTemplateSelect) sqlScan(ctx context.Context, root *ItemTemplateQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(its.fns))
+	for _, fn := range its.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*its.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := its.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
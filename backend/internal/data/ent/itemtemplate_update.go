@@ -0,0 +1,407 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/group"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/itemtemplate"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/predicate"
+	"github.com/hay-kot/homebox/backend/internal/data/types"
+)
+
+// ItemTemplateUpdate is the builder for updating ItemTemplate entities.
+type ItemTemplateUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ItemTemplateMutation
+}
+
+// Where appends a list predicates to the ItemTemplateUpdate builder.
+func (itu *ItemTemplateUpdate) Where(ps ...predicate.ItemTemplate) *ItemTemplateUpdate {
+	itu.mutation.Where(ps...)
+	return itu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (itu *ItemTemplateUpdate) SetUpdatedAt(t time.Time) *ItemTemplateUpdate {
+	itu.mutation.SetUpdatedAt(t)
+	return itu
+}
+
+// SetName sets the "name" field.
+func (itu *ItemTemplateUpdate) SetName(s string) *ItemTemplateUpdate {
+	itu.mutation.SetName(s)
+	return itu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (itu *ItemTemplateUpdate) SetNillableName(s *string) *ItemTemplateUpdate {
+	if s != nil {
+		itu.SetName(*s)
+	}
+	return itu
+}
+
+// SetDefaults sets the "defaults" field.
+func (itu *ItemTemplateUpdate) SetDefaults(ttd types.ItemTemplateDefaults) *ItemTemplateUpdate {
+	itu.mutation.SetDefaults(ttd)
+	return itu
+}
+
+// SetNillableDefaults sets the "defaults" field if the given value is not nil.
+func (itu *ItemTemplateUpdate) SetNillableDefaults(ttd *types.ItemTemplateDefaults) *ItemTemplateUpdate {
+	if ttd != nil {
+		itu.SetDefaults(*ttd)
+	}
+	return itu
+}
+
+// SetGroupID sets the "group" edge to the Group entity by ID.
+func (itu *ItemTemplateUpdate) SetGroupID(id uuid.UUID) *ItemTemplateUpdate {
+	itu.mutation.SetGroupID(id)
+	return itu
+}
+
+// SetGroup sets the "group" edge to the Group entity.
+func (itu *ItemTemplateUpdate) SetGroup(g *Group) *ItemTemplateUpdate {
+	return itu.SetGroupID(g.ID)
+}
+
+// Mutation returns the ItemTemplateMutation object of the builder.
+func (itu *ItemTemplateUpdate) Mutation() *ItemTemplateMutation {
+	return itu.mutation
+}
+
+// ClearGroup clears the "group" edge to the Group entity.
+func (itu *ItemTemplateUpdate) ClearGroup() *ItemTemplateUpdate {
+	itu.mutation.ClearGroup()
+	return itu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (itu *ItemTemplateUpdate) Save(ctx context.Context) (int, error) {
+	itu.defaults()
+	return withHooks(ctx, itu.sqlSave, itu.mutation, itu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (itu *ItemTemplateUpdate) SaveX(ctx context.Context) int {
+	affected, err := itu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (itu *ItemTemplateUpdate) Exec(ctx context.Context) error {
+	_, err := itu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (itu *ItemTemplateUpdate) ExecX(ctx context.Context) {
+	if err := itu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (itu *ItemTemplateUpdate) defaults() {
+	if _, ok := itu.mutation.UpdatedAt(); !ok {
+		v := itemtemplate.UpdateDefaultUpdatedAt()
+		itu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (itu *ItemTemplateUpdate) check() error {
+	if v, ok := itu.mutation.Name(); ok {
+		if err := itemtemplate.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "ItemTemplate.name": %w`, err)}
+		}
+	}
+	if _, ok := itu.mutation.GroupID(); itu.mutation.GroupCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "ItemTemplate.group"`)
+	}
+	return nil
+}
+
+func (itu *ItemTemplateUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := itu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(itemtemplate.Table, itemtemplate.Columns, sqlgraph.NewFieldSpec(itemtemplate.FieldID, field.TypeUUID))
+	if ps := itu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := itu.mutation.UpdatedAt(); ok {
+		_spec.SetField(itemtemplate.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := itu.mutation.Name(); ok {
+		_spec.SetField(itemtemplate.FieldName, field.TypeString, value)
+	}
+	if value, ok := itu.mutation.Defaults(); ok {
+		_spec.SetField(itemtemplate.FieldDefaults, field.TypeJSON, value)
+	}
+	if itu.mutation.GroupCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   itemtemplate.GroupTable,
+			Columns: []string{itemtemplate.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := itu.mutation.GroupIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   itemtemplate.GroupTable,
+			Columns: []string{itemtemplate.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, itu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{itemtemplate.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	itu.mutation.done = true
+	return n, nil
+}
+
+// ItemTemplateUpdateOne is the builder for updating a single ItemTemplate entity.
+type ItemTemplateUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ItemTemplateMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ituo *ItemTemplateUpdateOne) SetUpdatedAt(t time.Time) *ItemTemplateUpdateOne {
+	ituo.mutation.SetUpdatedAt(t)
+	return ituo
+}
+
+// SetName sets the "name" field.
+func (ituo *ItemTemplateUpdateOne) SetName(s string) *ItemTemplateUpdateOne {
+	ituo.mutation.SetName(s)
+	return ituo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (ituo *ItemTemplateUpdateOne) SetNillableName(s *string) *ItemTemplateUpdateOne {
+	if s != nil {
+		ituo.SetName(*s)
+	}
+	return ituo
+}
+
+// SetDefaults sets the "defaults" field.
+func (ituo *ItemTemplateUpdateOne) SetDefaults(ttd types.ItemTemplateDefaults) *ItemTemplateUpdateOne {
+	ituo.mutation.SetDefaults(ttd)
+	return ituo
+}
+
+// SetNillableDefaults sets the "defaults" field if the given value is not nil.
+func (ituo *ItemTemplateUpdateOne) SetNillableDefaults(ttd *types.ItemTemplateDefaults) *ItemTemplateUpdateOne {
+	if ttd != nil {
+		ituo.SetDefaults(*ttd)
+	}
+	return ituo
+}
+
+// SetGroupID sets the "group" edge to the Group entity by ID.
+func (ituo *ItemTemplateUpdateOne) SetGroupID(id uuid.UUID) *ItemTemplateUpdateOne {
+	ituo.mutation.SetGroupID(id)
+	return ituo
+}
+
+// SetGroup sets the "group" edge to the Group entity.
+func (ituo *ItemTemplateUpdateOne) SetGroup(g *Group) *ItemTemplateUpdateOne {
+	return ituo.SetGroupID(g.ID)
+}
+
+// Mutation returns the ItemTemplateMutation object of the builder.
+func (ituo *ItemTemplateUpdateOne) Mutation() *ItemTemplateMutation {
+	return ituo.mutation
+}
+
+// ClearGroup clears the "group" edge to the Group entity.
+func (ituo *ItemTemplateUpdateOne) ClearGroup() *ItemTemplateUpdateOne {
+	ituo.mutation.ClearGroup()
+	return ituo
+}
+
+// Where appends a list predicates to the ItemTemplateUpdate builder.
+func (ituo *ItemTemplateUpdateOne) Where(ps ...predicate.ItemTemplate) *ItemTemplateUpdateOne {
+	ituo.mutation.Where(ps...)
+	return ituo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ituo *ItemTemplateUpdateOne) Select(field string, fields ...string) *ItemTemplateUpdateOne {
+	ituo.fields = append([]string{field}, fields...)
+	return ituo
+}
+
+// Save executes the query and returns the updated ItemTemplate entity.
+func (ituo *ItemTemplateUpdateOne) Save(ctx context.Context) (*ItemTemplate, error) {
+	ituo.defaults()
+	return withHooks(ctx, ituo.sqlSave, ituo.mutation, ituo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ituo *ItemTemplateUpdateOne) SaveX(ctx context.Context) *ItemTemplate {
+	node, err := ituo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ituo *ItemTemplateUpdateOne) Exec(ctx context.Context) error {
+	_, err := ituo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ituo *ItemTemplateUpdateOne) ExecX(ctx context.Context) {
+	if err := ituo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ituo *ItemTemplateUpdateOne) defaults() {
+	if _, ok := ituo.mutation.UpdatedAt(); !ok {
+		v := itemtemplate.UpdateDefaultUpdatedAt()
+		ituo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ituo *ItemTemplateUpdateOne) check() error {
+	if v, ok := ituo.mutation.Name(); ok {
+		if err := itemtemplate.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "ItemTemplate.name": %w`, err)}
+		}
+	}
+	if _, ok := ituo.mutation.GroupID(); ituo.mutation.GroupCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "ItemTemplate.group"`)
+	}
+	return nil
+}
+
+func (ituo *ItemTemplateUpdateOne) sqlSave(ctx context.Context) (_node *ItemTemplate, err error) {
+	if err := ituo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(itemtemplate.Table, itemtemplate.Columns, sqlgraph.NewFieldSpec(itemtemplate.FieldID, field.TypeUUID))
+	id, ok := ituo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ItemTemplate.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ituo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, itemtemplate.FieldID)
+		for _, f := range fields {
+			if !itemtemplate.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != itemtemplate.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ituo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ituo.mutation.UpdatedAt(); ok {
+		_spec.SetField(itemtemplate.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := ituo.mutation.Name(); ok {
+		_spec.SetField(itemtemplate.FieldName, field.TypeString, value)
+	}
+	if value, ok := ituo.mutation.Defaults(); ok {
+		_spec.SetField(itemtemplate.FieldDefaults, field.TypeJSON, value)
+	}
+	if ituo.mutation.GroupCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   itemtemplate.GroupTable,
+			Columns: []string{itemtemplate.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ituo.mutation.GroupIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   itemtemplate.GroupTable,
+			Columns: []string{itemtemplate.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &ItemTemplate{config: ituo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ituo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{itemtemplate.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	ituo.mutation.done = true
+	return _node, nil
+}
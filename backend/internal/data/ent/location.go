@@ -27,6 +27,8 @@ type Location struct {
 	Name string `json:"name,omitempty"`
 	// Description holds the value of the "description" field.
 	Description string `json:"description,omitempty"`
+	// Capacity holds the value of the "capacity" field.
+	Capacity int `json:"capacity,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the LocationQuery when eager-loading is set.
 	Edges             LocationEdges `json:"edges"`
@@ -99,6 +101,8 @@ func (*Location) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case location.FieldCapacity:
+			values[i] = new(sql.NullInt64)
 		case location.FieldName, location.FieldDescription:
 			values[i] = new(sql.NullString)
 		case location.FieldCreatedAt, location.FieldUpdatedAt:
@@ -154,6 +158,12 @@ func (l *Location) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				l.Description = value.String
 			}
+		case location.FieldCapacity:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field capacity", values[i])
+			} else if value.Valid {
+				l.Capacity = int(value.Int64)
+			}
 		case location.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullScanner); !ok {
 				return fmt.Errorf("unexpected type %T for field group_locations", values[i])
@@ -235,6 +245,9 @@ func (l *Location) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("description=")
 	builder.WriteString(l.Description)
+	builder.WriteString(", ")
+	builder.WriteString("capacity=")
+	builder.WriteString(fmt.Sprintf("%v", l.Capacity))
 	builder.WriteByte(')')
 	return builder.String()
 }
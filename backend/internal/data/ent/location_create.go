@@ -71,6 +71,20 @@ func (lc *LocationCreate) SetNillableDescription(s *string) *LocationCreate {
 	return lc
 }
 
+// SetCapacity sets the "capacity" field.
+func (lc *LocationCreate) SetCapacity(i int) *LocationCreate {
+	lc.mutation.SetCapacity(i)
+	return lc
+}
+
+// SetNillableCapacity sets the "capacity" field if the given value is not nil.
+func (lc *LocationCreate) SetNillableCapacity(i *int) *LocationCreate {
+	if i != nil {
+		lc.SetCapacity(*i)
+	}
+	return lc
+}
+
 // SetID sets the "id" field.
 func (lc *LocationCreate) SetID(u uuid.UUID) *LocationCreate {
 	lc.mutation.SetID(u)
@@ -188,6 +202,10 @@ func (lc *LocationCreate) defaults() {
 		v := location.DefaultUpdatedAt()
 		lc.mutation.SetUpdatedAt(v)
 	}
+	if _, ok := lc.mutation.Capacity(); !ok {
+		v := location.DefaultCapacity
+		lc.mutation.SetCapacity(v)
+	}
 	if _, ok := lc.mutation.ID(); !ok {
 		v := location.DefaultID()
 		lc.mutation.SetID(v)
@@ -215,6 +233,14 @@ func (lc *LocationCreate) check() error {
 			return &ValidationError{Name: "description", err: fmt.Errorf(`ent: validator failed for field "Location.description": %w`, err)}
 		}
 	}
+	if _, ok := lc.mutation.Capacity(); !ok {
+		return &ValidationError{Name: "capacity", err: errors.New(`ent: missing required field "Location.capacity"`)}
+	}
+	if v, ok := lc.mutation.Capacity(); ok {
+		if err := location.CapacityValidator(v); err != nil {
+			return &ValidationError{Name: "capacity", err: fmt.Errorf(`ent: validator failed for field "Location.capacity": %w`, err)}
+		}
+	}
 	if _, ok := lc.mutation.GroupID(); !ok {
 		return &ValidationError{Name: "group", err: errors.New(`ent: missing required edge "Location.group"`)}
 	}
@@ -269,6 +295,10 @@ func (lc *LocationCreate) createSpec() (*Location, *sqlgraph.CreateSpec) {
 		_spec.SetField(location.FieldDescription, field.TypeString, value)
 		_node.Description = value
 	}
+	if value, ok := lc.mutation.Capacity(); ok {
+		_spec.SetField(location.FieldCapacity, field.TypeInt, value)
+		_node.Capacity = value
+	}
 	if nodes := lc.mutation.GroupIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
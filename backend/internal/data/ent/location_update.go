@@ -71,6 +71,27 @@ func (lu *LocationUpdate) ClearDescription() *LocationUpdate {
 	return lu
 }
 
+// SetCapacity sets the "capacity" field.
+func (lu *LocationUpdate) SetCapacity(i int) *LocationUpdate {
+	lu.mutation.ResetCapacity()
+	lu.mutation.SetCapacity(i)
+	return lu
+}
+
+// SetNillableCapacity sets the "capacity" field if the given value is not nil.
+func (lu *LocationUpdate) SetNillableCapacity(i *int) *LocationUpdate {
+	if i != nil {
+		lu.SetCapacity(*i)
+	}
+	return lu
+}
+
+// AddCapacity adds i to the "capacity" field.
+func (lu *LocationUpdate) AddCapacity(i int) *LocationUpdate {
+	lu.mutation.AddCapacity(i)
+	return lu
+}
+
 // SetGroupID sets the "group" edge to the Group entity by ID.
 func (lu *LocationUpdate) SetGroupID(id uuid.UUID) *LocationUpdate {
 	lu.mutation.SetGroupID(id)
@@ -238,6 +259,11 @@ func (lu *LocationUpdate) check() error {
 			return &ValidationError{Name: "description", err: fmt.Errorf(`ent: validator failed for field "Location.description": %w`, err)}
 		}
 	}
+	if v, ok := lu.mutation.Capacity(); ok {
+		if err := location.CapacityValidator(v); err != nil {
+			return &ValidationError{Name: "capacity", err: fmt.Errorf(`ent: validator failed for field "Location.capacity": %w`, err)}
+		}
+	}
 	if _, ok := lu.mutation.GroupID(); lu.mutation.GroupCleared() && !ok {
 		return errors.New(`ent: clearing a required unique edge "Location.group"`)
 	}
@@ -268,6 +294,12 @@ func (lu *LocationUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if lu.mutation.DescriptionCleared() {
 		_spec.ClearField(location.FieldDescription, field.TypeString)
 	}
+	if value, ok := lu.mutation.Capacity(); ok {
+		_spec.SetField(location.FieldCapacity, field.TypeInt, value)
+	}
+	if value, ok := lu.mutation.AddedCapacity(); ok {
+		_spec.AddField(location.FieldCapacity, field.TypeInt, value)
+	}
 	if lu.mutation.GroupCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -476,6 +508,27 @@ func (luo *LocationUpdateOne) ClearDescription() *LocationUpdateOne {
 	return luo
 }
 
+// SetCapacity sets the "capacity" field.
+func (luo *LocationUpdateOne) SetCapacity(i int) *LocationUpdateOne {
+	luo.mutation.ResetCapacity()
+	luo.mutation.SetCapacity(i)
+	return luo
+}
+
+// SetNillableCapacity sets the "capacity" field if the given value is not nil.
+func (luo *LocationUpdateOne) SetNillableCapacity(i *int) *LocationUpdateOne {
+	if i != nil {
+		luo.SetCapacity(*i)
+	}
+	return luo
+}
+
+// AddCapacity adds i to the "capacity" field.
+func (luo *LocationUpdateOne) AddCapacity(i int) *LocationUpdateOne {
+	luo.mutation.AddCapacity(i)
+	return luo
+}
+
 // SetGroupID sets the "group" edge to the Group entity by ID.
 func (luo *LocationUpdateOne) SetGroupID(id uuid.UUID) *LocationUpdateOne {
 	luo.mutation.SetGroupID(id)
@@ -656,6 +709,11 @@ func (luo *LocationUpdateOne) check() error {
 			return &ValidationError{Name: "description", err: fmt.Errorf(`ent: validator failed for field "Location.description": %w`, err)}
 		}
 	}
+	if v, ok := luo.mutation.Capacity(); ok {
+		if err := location.CapacityValidator(v); err != nil {
+			return &ValidationError{Name: "capacity", err: fmt.Errorf(`ent: validator failed for field "Location.capacity": %w`, err)}
+		}
+	}
 	if _, ok := luo.mutation.GroupID(); luo.mutation.GroupCleared() && !ok {
 		return errors.New(`ent: clearing a required unique edge "Location.group"`)
 	}
@@ -703,6 +761,12 @@ func (luo *LocationUpdateOne) sqlSave(ctx context.Context) (_node *Location, err
 	if luo.mutation.DescriptionCleared() {
 		_spec.ClearField(location.FieldDescription, field.TypeString)
 	}
+	if value, ok := luo.mutation.Capacity(); ok {
+		_spec.SetField(location.FieldCapacity, field.TypeInt, value)
+	}
+	if value, ok := luo.mutation.AddedCapacity(); ok {
+		_spec.AddField(location.FieldCapacity, field.TypeInt, value)
+	}
 	if luo.mutation.GroupCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -15,6 +15,7 @@ var (
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "type", Type: field.TypeEnum, Enums: []string{"photo", "manual", "warranty", "attachment", "receipt"}, Default: "attachment"},
 		{Name: "primary", Type: field.TypeBool, Default: false},
+		{Name: "date", Type: field.TypeTime, Nullable: true},
 		{Name: "document_attachments", Type: field.TypeUUID},
 		{Name: "item_attachments", Type: field.TypeUUID},
 	}
@@ -26,13 +27,13 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "attachments_documents_attachments",
-				Columns:    []*schema.Column{AttachmentsColumns[5]},
+				Columns:    []*schema.Column{AttachmentsColumns[6]},
 				RefColumns: []*schema.Column{DocumentsColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 			{
 				Symbol:     "attachments_items_attachments",
-				Columns:    []*schema.Column{AttachmentsColumns[6]},
+				Columns:    []*schema.Column{AttachmentsColumns[7]},
 				RefColumns: []*schema.Column{ItemsColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
@@ -88,6 +89,31 @@ var (
 			},
 		},
 	}
+	// CurrencyConversionsColumns holds the columns for the "currency_conversions" table.
+	CurrencyConversionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "from_currency", Type: field.TypeString, Size: 3},
+		{Name: "to_currency", Type: field.TypeString, Size: 3},
+		{Name: "rate", Type: field.TypeFloat64},
+		{Name: "original_prices", Type: field.TypeJSON},
+		{Name: "group_currency_conversions", Type: field.TypeUUID},
+	}
+	// CurrencyConversionsTable holds the schema information for the "currency_conversions" table.
+	CurrencyConversionsTable = &schema.Table{
+		Name:       "currency_conversions",
+		Columns:    CurrencyConversionsColumns,
+		PrimaryKey: []*schema.Column{CurrencyConversionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "currency_conversions_groups_currency_conversions",
+				Columns:    []*schema.Column{CurrencyConversionsColumns[7]},
+				RefColumns: []*schema.Column{GroupsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
 	// DocumentsColumns holds the columns for the "documents" table.
 	DocumentsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
@@ -118,12 +144,23 @@ var (
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "name", Type: field.TypeString, Size: 255},
 		{Name: "currency", Type: field.TypeEnum, Enums: []string{"aed", "aud", "bgn", "brl", "cad", "chf", "czk", "dkk", "eur", "gbp", "hkd", "idr", "inr", "jpy", "krw", "mxn", "nok", "nzd", "pln", "rmb", "ron", "rub", "sar", "sek", "sgd", "thb", "try", "usd", "xag", "xau", "zar"}, Default: "usd"},
+		{Name: "depreciation_method", Type: field.TypeEnum, Enums: []string{"none", "straight_line", "declining_balance"}, Default: "none"},
+		{Name: "depreciation_years", Type: field.TypeInt, Default: 0},
+		{Name: "default_location_id", Type: field.TypeUUID, Nullable: true},
 	}
 	// GroupsTable holds the schema information for the "groups" table.
 	GroupsTable = &schema.Table{
 		Name:       "groups",
 		Columns:    GroupsColumns,
 		PrimaryKey: []*schema.Column{GroupsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "groups_locations_default_location",
+				Columns:    []*schema.Column{GroupsColumns[7]},
+				RefColumns: []*schema.Column{LocationsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
 	}
 	// GroupInvitationTokensColumns holds the columns for the "group_invitation_tokens" table.
 	GroupInvitationTokensColumns = []*schema.Column{
@@ -158,11 +195,22 @@ var (
 		{Name: "description", Type: field.TypeString, Nullable: true, Size: 1000},
 		{Name: "import_ref", Type: field.TypeString, Nullable: true, Size: 100},
 		{Name: "notes", Type: field.TypeString, Nullable: true, Size: 1000},
+		{Name: "notes_format", Type: field.TypeEnum, Enums: []string{"plain", "markdown"}, Default: "plain"},
 		{Name: "quantity", Type: field.TypeInt, Default: 1},
 		{Name: "insured", Type: field.TypeBool, Default: false},
 		{Name: "archived", Type: field.TypeBool, Default: false},
+		{Name: "favorite", Type: field.TypeBool, Default: false},
+		{Name: "version", Type: field.TypeInt, Default: 1},
+		{Name: "archived_at", Type: field.TypeTime, Nullable: true},
 		{Name: "asset_id", Type: field.TypeInt, Default: 0},
+		{Name: "reorder_threshold", Type: field.TypeInt, Default: 0},
+		{Name: "tags", Type: field.TypeJSON, Nullable: true},
+		{Name: "source", Type: field.TypeEnum, Enums: []string{"manual", "import", "api"}, Default: "manual"},
+		{Name: "acquisition_type", Type: field.TypeEnum, Enums: []string{"bought", "gift", "inherited", "made", "found"}, Default: "bought"},
+		{Name: "latitude", Type: field.TypeFloat64, Nullable: true},
+		{Name: "longitude", Type: field.TypeFloat64, Nullable: true},
 		{Name: "serial_number", Type: field.TypeString, Nullable: true, Size: 255},
+		{Name: "barcode", Type: field.TypeString, Nullable: true, Size: 255},
 		{Name: "model_number", Type: field.TypeString, Nullable: true, Size: 255},
 		{Name: "manufacturer", Type: field.TypeString, Nullable: true, Size: 255},
 		{Name: "lifetime_warranty", Type: field.TypeBool, Default: false},
@@ -170,7 +218,13 @@ var (
 		{Name: "warranty_details", Type: field.TypeString, Nullable: true, Size: 1000},
 		{Name: "purchase_time", Type: field.TypeTime, Nullable: true},
 		{Name: "purchase_from", Type: field.TypeString, Nullable: true},
+		{Name: "purchase_order_number", Type: field.TypeString, Nullable: true, Size: 255},
 		{Name: "purchase_price", Type: field.TypeFloat64, Default: 0},
+		{Name: "currency", Type: field.TypeString, Nullable: true, Size: 3},
+		{Name: "replacement_cost", Type: field.TypeFloat64, Default: 0},
+		{Name: "loaned_to", Type: field.TypeString, Nullable: true, Size: 255},
+		{Name: "loaned_at", Type: field.TypeTime, Nullable: true},
+		{Name: "loan_due", Type: field.TypeTime, Nullable: true},
 		{Name: "sold_time", Type: field.TypeTime, Nullable: true},
 		{Name: "sold_to", Type: field.TypeString, Nullable: true},
 		{Name: "sold_price", Type: field.TypeFloat64, Default: 0},
@@ -187,19 +241,19 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "items_groups_items",
-				Columns:    []*schema.Column{ItemsColumns[24]},
+				Columns:    []*schema.Column{ItemsColumns[41]},
 				RefColumns: []*schema.Column{GroupsColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 			{
 				Symbol:     "items_items_children",
-				Columns:    []*schema.Column{ItemsColumns[25]},
+				Columns:    []*schema.Column{ItemsColumns[42]},
 				RefColumns: []*schema.Column{ItemsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "items_locations_items",
-				Columns:    []*schema.Column{ItemsColumns[26]},
+				Columns:    []*schema.Column{ItemsColumns[43]},
 				RefColumns: []*schema.Column{LocationsColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
@@ -213,27 +267,106 @@ var (
 			{
 				Name:    "item_manufacturer",
 				Unique:  false,
-				Columns: []*schema.Column{ItemsColumns[13]},
+				Columns: []*schema.Column{ItemsColumns[24]},
 			},
 			{
 				Name:    "item_model_number",
 				Unique:  false,
-				Columns: []*schema.Column{ItemsColumns[12]},
+				Columns: []*schema.Column{ItemsColumns[23]},
 			},
 			{
 				Name:    "item_serial_number",
 				Unique:  false,
-				Columns: []*schema.Column{ItemsColumns[11]},
+				Columns: []*schema.Column{ItemsColumns[21]},
+			},
+			{
+				Name:    "item_barcode",
+				Unique:  false,
+				Columns: []*schema.Column{ItemsColumns[22]},
 			},
 			{
 				Name:    "item_archived",
 				Unique:  false,
-				Columns: []*schema.Column{ItemsColumns[9]},
+				Columns: []*schema.Column{ItemsColumns[10]},
 			},
 			{
 				Name:    "item_asset_id",
 				Unique:  false,
-				Columns: []*schema.Column{ItemsColumns[10]},
+				Columns: []*schema.Column{ItemsColumns[14]},
+			},
+		},
+	}
+	// ItemCommentsColumns holds the columns for the "item_comments" table.
+	ItemCommentsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "content", Type: field.TypeString, Size: 2500},
+		{Name: "item_id", Type: field.TypeUUID},
+		{Name: "user_item_comments", Type: field.TypeUUID, Nullable: true},
+	}
+	// ItemCommentsTable holds the schema information for the "item_comments" table.
+	ItemCommentsTable = &schema.Table{
+		Name:       "item_comments",
+		Columns:    ItemCommentsColumns,
+		PrimaryKey: []*schema.Column{ItemCommentsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "item_comments_items_comments",
+				Columns:    []*schema.Column{ItemCommentsColumns[4]},
+				RefColumns: []*schema.Column{ItemsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "item_comments_users_item_comments",
+				Columns:    []*schema.Column{ItemCommentsColumns[5]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "itemcomment_item_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{ItemCommentsColumns[4], ItemCommentsColumns[1]},
+			},
+		},
+	}
+	// ItemEventsColumns holds the columns for the "item_events" table.
+	ItemEventsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "item_id", Type: field.TypeUUID},
+		{Name: "type", Type: field.TypeEnum, Enums: []string{"adjustment", "create", "update", "delete"}},
+		{Name: "changes", Type: field.TypeJSON, Nullable: true},
+		{Name: "group_item_events", Type: field.TypeUUID},
+		{Name: "user_item_events", Type: field.TypeUUID, Nullable: true},
+	}
+	// ItemEventsTable holds the schema information for the "item_events" table.
+	ItemEventsTable = &schema.Table{
+		Name:       "item_events",
+		Columns:    ItemEventsColumns,
+		PrimaryKey: []*schema.Column{ItemEventsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "item_events_groups_item_events",
+				Columns:    []*schema.Column{ItemEventsColumns[6]},
+				RefColumns: []*schema.Column{GroupsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "item_events_users_item_events",
+				Columns:    []*schema.Column{ItemEventsColumns[7]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "itemevent_item_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{ItemEventsColumns[3], ItemEventsColumns[1]},
 			},
 		},
 	}
@@ -265,6 +398,29 @@ var (
 			},
 		},
 	}
+	// ItemTemplatesColumns holds the columns for the "item_templates" table.
+	ItemTemplatesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "name", Type: field.TypeString, Size: 255},
+		{Name: "defaults", Type: field.TypeJSON},
+		{Name: "group_item_templates", Type: field.TypeUUID},
+	}
+	// ItemTemplatesTable holds the schema information for the "item_templates" table.
+	ItemTemplatesTable = &schema.Table{
+		Name:       "item_templates",
+		Columns:    ItemTemplatesColumns,
+		PrimaryKey: []*schema.Column{ItemTemplatesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "item_templates_groups_item_templates",
+				Columns:    []*schema.Column{ItemTemplatesColumns[5]},
+				RefColumns: []*schema.Column{GroupsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
 	// LabelsColumns holds the columns for the "labels" table.
 	LabelsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
@@ -296,6 +452,7 @@ var (
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "name", Type: field.TypeString, Size: 255},
 		{Name: "description", Type: field.TypeString, Nullable: true, Size: 1000},
+		{Name: "capacity", Type: field.TypeInt, Default: 0},
 		{Name: "group_locations", Type: field.TypeUUID},
 		{Name: "location_children", Type: field.TypeUUID, Nullable: true},
 	}
@@ -307,13 +464,13 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "locations_groups_locations",
-				Columns:    []*schema.Column{LocationsColumns[5]},
+				Columns:    []*schema.Column{LocationsColumns[6]},
 				RefColumns: []*schema.Column{GroupsColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 			{
 				Symbol:     "locations_locations_children",
-				Columns:    []*schema.Column{LocationsColumns[6]},
+				Columns:    []*schema.Column{LocationsColumns[7]},
 				RefColumns: []*schema.Column{LocationsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
@@ -398,6 +555,29 @@ var (
 			},
 		},
 	}
+	// SavedSearchesColumns holds the columns for the "saved_searches" table.
+	SavedSearchesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "name", Type: field.TypeString, Size: 255},
+		{Name: "query", Type: field.TypeJSON},
+		{Name: "group_saved_searches", Type: field.TypeUUID},
+	}
+	// SavedSearchesTable holds the schema information for the "saved_searches" table.
+	SavedSearchesTable = &schema.Table{
+		Name:       "saved_searches",
+		Columns:    SavedSearchesColumns,
+		PrimaryKey: []*schema.Column{SavedSearchesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "saved_searches_groups_saved_searches",
+				Columns:    []*schema.Column{SavedSearchesColumns[5]},
+				RefColumns: []*schema.Column{GroupsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
 	// UsersColumns holds the columns for the "users" table.
 	UsersColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
@@ -426,6 +606,31 @@ var (
 			},
 		},
 	}
+	// ItemRelatedColumns holds the columns for the "item_related" table.
+	ItemRelatedColumns = []*schema.Column{
+		{Name: "item_id", Type: field.TypeUUID},
+		{Name: "related_id", Type: field.TypeUUID},
+	}
+	// ItemRelatedTable holds the schema information for the "item_related" table.
+	ItemRelatedTable = &schema.Table{
+		Name:       "item_related",
+		Columns:    ItemRelatedColumns,
+		PrimaryKey: []*schema.Column{ItemRelatedColumns[0], ItemRelatedColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "item_related_item_id",
+				Columns:    []*schema.Column{ItemRelatedColumns[0]},
+				RefColumns: []*schema.Column{ItemsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "item_related_related_id",
+				Columns:    []*schema.Column{ItemRelatedColumns[1]},
+				RefColumns: []*schema.Column{ItemsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
 	// LabelItemsColumns holds the columns for the "label_items" table.
 	LabelItemsColumns = []*schema.Column{
 		{Name: "label_id", Type: field.TypeUUID},
@@ -456,16 +661,22 @@ var (
 		AttachmentsTable,
 		AuthRolesTable,
 		AuthTokensTable,
+		CurrencyConversionsTable,
 		DocumentsTable,
 		GroupsTable,
 		GroupInvitationTokensTable,
 		ItemsTable,
+		ItemCommentsTable,
+		ItemEventsTable,
 		ItemFieldsTable,
+		ItemTemplatesTable,
 		LabelsTable,
 		LocationsTable,
 		MaintenanceEntriesTable,
 		NotifiersTable,
+		SavedSearchesTable,
 		UsersTable,
+		ItemRelatedTable,
 		LabelItemsTable,
 	}
 )
@@ -475,19 +686,29 @@ func init() {
 	AttachmentsTable.ForeignKeys[1].RefTable = ItemsTable
 	AuthRolesTable.ForeignKeys[0].RefTable = AuthTokensTable
 	AuthTokensTable.ForeignKeys[0].RefTable = UsersTable
+	CurrencyConversionsTable.ForeignKeys[0].RefTable = GroupsTable
 	DocumentsTable.ForeignKeys[0].RefTable = GroupsTable
+	GroupsTable.ForeignKeys[0].RefTable = LocationsTable
 	GroupInvitationTokensTable.ForeignKeys[0].RefTable = GroupsTable
 	ItemsTable.ForeignKeys[0].RefTable = GroupsTable
 	ItemsTable.ForeignKeys[1].RefTable = ItemsTable
 	ItemsTable.ForeignKeys[2].RefTable = LocationsTable
+	ItemCommentsTable.ForeignKeys[0].RefTable = ItemsTable
+	ItemCommentsTable.ForeignKeys[1].RefTable = UsersTable
+	ItemEventsTable.ForeignKeys[0].RefTable = GroupsTable
+	ItemEventsTable.ForeignKeys[1].RefTable = UsersTable
 	ItemFieldsTable.ForeignKeys[0].RefTable = ItemsTable
+	ItemTemplatesTable.ForeignKeys[0].RefTable = GroupsTable
 	LabelsTable.ForeignKeys[0].RefTable = GroupsTable
 	LocationsTable.ForeignKeys[0].RefTable = GroupsTable
 	LocationsTable.ForeignKeys[1].RefTable = LocationsTable
 	MaintenanceEntriesTable.ForeignKeys[0].RefTable = ItemsTable
 	NotifiersTable.ForeignKeys[0].RefTable = GroupsTable
 	NotifiersTable.ForeignKeys[1].RefTable = UsersTable
+	SavedSearchesTable.ForeignKeys[0].RefTable = GroupsTable
 	UsersTable.ForeignKeys[0].RefTable = GroupsTable
+	ItemRelatedTable.ForeignKeys[0].RefTable = ItemsTable
+	ItemRelatedTable.ForeignKeys[1].RefTable = ItemsTable
 	LabelItemsTable.ForeignKeys[0].RefTable = LabelsTable
 	LabelItemsTable.ForeignKeys[1].RefTable = ItemsTable
 }
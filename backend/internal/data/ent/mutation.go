@@ -15,17 +15,23 @@ import (
 	"github.com/hay-kot/homebox/backend/internal/data/ent/attachment"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/authroles"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/authtokens"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/currencyconversion"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/document"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/group"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/groupinvitationtoken"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/item"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/itemcomment"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/itemevent"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/itemfield"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/itemtemplate"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/label"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/location"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/maintenanceentry"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/notifier"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/predicate"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/savedsearch"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/user"
+	"github.com/hay-kot/homebox/backend/internal/data/types"
 )
 
 const (
@@ -40,15 +46,20 @@ const (
 	TypeAttachment           = "Attachment"
 	TypeAuthRoles            = "AuthRoles"
 	TypeAuthTokens           = "AuthTokens"
+	TypeCurrencyConversion   = "CurrencyConversion"
 	TypeDocument             = "Document"
 	TypeGroup                = "Group"
 	TypeGroupInvitationToken = "GroupInvitationToken"
 	TypeItem                 = "Item"
+	TypeItemComment          = "ItemComment"
+	TypeItemEvent            = "ItemEvent"
 	TypeItemField            = "ItemField"
+	TypeItemTemplate         = "ItemTemplate"
 	TypeLabel                = "Label"
 	TypeLocation             = "Location"
 	TypeMaintenanceEntry     = "MaintenanceEntry"
 	TypeNotifier             = "Notifier"
+	TypeSavedSearch          = "SavedSearch"
 	TypeUser                 = "User"
 )
 
@@ -62,6 +73,7 @@ type AttachmentMutation struct {
 	updated_at      *time.Time
 	_type           *attachment.Type
 	primary         *bool
+	date            *time.Time
 	clearedFields   map[string]struct{}
 	item            *uuid.UUID
 	cleareditem     bool
@@ -320,6 +332,55 @@ func (m *AttachmentMutation) ResetPrimary() {
 	m.primary = nil
 }
 
+// SetDate sets the "date" field.
+func (m *AttachmentMutation) SetDate(t time.Time) {
+	m.date = &t
+}
+
+// Date returns the value of the "date" field in the mutation.
+func (m *AttachmentMutation) Date() (r time.Time, exists bool) {
+	v := m.date
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDate returns the old "date" field's value of the Attachment entity.
+// If the Attachment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AttachmentMutation) OldDate(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDate: %w", err)
+	}
+	return oldValue.Date, nil
+}
+
+// ClearDate clears the value of the "date" field.
+func (m *AttachmentMutation) ClearDate() {
+	m.date = nil
+	m.clearedFields[attachment.FieldDate] = struct{}{}
+}
+
+// DateCleared returns if the "date" field was cleared in this mutation.
+func (m *AttachmentMutation) DateCleared() bool {
+	_, ok := m.clearedFields[attachment.FieldDate]
+	return ok
+}
+
+// ResetDate resets all changes to the "date" field.
+func (m *AttachmentMutation) ResetDate() {
+	m.date = nil
+	delete(m.clearedFields, attachment.FieldDate)
+}
+
 // SetItemID sets the "item" edge to the Item entity by id.
 func (m *AttachmentMutation) SetItemID(id uuid.UUID) {
 	m.item = &id
@@ -432,7 +493,7 @@ func (m *AttachmentMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *AttachmentMutation) Fields() []string {
-	fields := make([]string, 0, 4)
+	fields := make([]string, 0, 5)
 	if m.created_at != nil {
 		fields = append(fields, attachment.FieldCreatedAt)
 	}
@@ -445,6 +506,9 @@ func (m *AttachmentMutation) Fields() []string {
 	if m.primary != nil {
 		fields = append(fields, attachment.FieldPrimary)
 	}
+	if m.date != nil {
+		fields = append(fields, attachment.FieldDate)
+	}
 	return fields
 }
 
@@ -461,6 +525,8 @@ func (m *AttachmentMutation) Field(name string) (ent.Value, bool) {
 		return m.GetType()
 	case attachment.FieldPrimary:
 		return m.Primary()
+	case attachment.FieldDate:
+		return m.Date()
 	}
 	return nil, false
 }
@@ -478,6 +544,8 @@ func (m *AttachmentMutation) OldField(ctx context.Context, name string) (ent.Val
 		return m.OldType(ctx)
 	case attachment.FieldPrimary:
 		return m.OldPrimary(ctx)
+	case attachment.FieldDate:
+		return m.OldDate(ctx)
 	}
 	return nil, fmt.Errorf("unknown Attachment field %s", name)
 }
@@ -515,6 +583,13 @@ func (m *AttachmentMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetPrimary(v)
 		return nil
+	case attachment.FieldDate:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDate(v)
+		return nil
 	}
 	return fmt.Errorf("unknown Attachment field %s", name)
 }
@@ -544,7 +619,11 @@ func (m *AttachmentMutation) AddField(name string, value ent.Value) error {
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
 func (m *AttachmentMutation) ClearedFields() []string {
-	return nil
+	var fields []string
+	if m.FieldCleared(attachment.FieldDate) {
+		fields = append(fields, attachment.FieldDate)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
@@ -557,6 +636,11 @@ func (m *AttachmentMutation) FieldCleared(name string) bool {
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
 func (m *AttachmentMutation) ClearField(name string) error {
+	switch name {
+	case attachment.FieldDate:
+		m.ClearDate()
+		return nil
+	}
 	return fmt.Errorf("unknown Attachment nullable field %s", name)
 }
 
@@ -576,6 +660,9 @@ func (m *AttachmentMutation) ResetField(name string) error {
 	case attachment.FieldPrimary:
 		m.ResetPrimary()
 		return nil
+	case attachment.FieldDate:
+		m.ResetDate()
+		return nil
 	}
 	return fmt.Errorf("unknown Attachment field %s", name)
 }
@@ -1685,38 +1772,39 @@ func (m *AuthTokensMutation) ResetEdge(name string) error {
 	return fmt.Errorf("unknown AuthTokens edge %s", name)
 }
 
-// DocumentMutation represents an operation that mutates the Document nodes in the graph.
-type DocumentMutation struct {
+// CurrencyConversionMutation represents an operation that mutates the CurrencyConversion nodes in the graph.
+type CurrencyConversionMutation struct {
 	config
-	op                 Op
-	typ                string
-	id                 *uuid.UUID
-	created_at         *time.Time
-	updated_at         *time.Time
-	title              *string
-	_path              *string
-	clearedFields      map[string]struct{}
-	group              *uuid.UUID
-	clearedgroup       bool
-	attachments        map[uuid.UUID]struct{}
-	removedattachments map[uuid.UUID]struct{}
-	clearedattachments bool
-	done               bool
-	oldValue           func(context.Context) (*Document, error)
-	predicates         []predicate.Document
-}
-
-var _ ent.Mutation = (*DocumentMutation)(nil)
-
-// documentOption allows management of the mutation configuration using functional options.
-type documentOption func(*DocumentMutation)
-
-// newDocumentMutation creates new mutation for the Document entity.
-func newDocumentMutation(c config, op Op, opts ...documentOption) *DocumentMutation {
-	m := &DocumentMutation{
+	op                    Op
+	typ                   string
+	id                    *uuid.UUID
+	created_at            *time.Time
+	updated_at            *time.Time
+	from_currency         *string
+	to_currency           *string
+	rate                  *float64
+	addrate               *float64
+	original_prices       *[]types.PriceSnapshot
+	appendoriginal_prices []types.PriceSnapshot
+	clearedFields         map[string]struct{}
+	group                 *uuid.UUID
+	clearedgroup          bool
+	done                  bool
+	oldValue              func(context.Context) (*CurrencyConversion, error)
+	predicates            []predicate.CurrencyConversion
+}
+
+var _ ent.Mutation = (*CurrencyConversionMutation)(nil)
+
+// currencyconversionOption allows management of the mutation configuration using functional options.
+type currencyconversionOption func(*CurrencyConversionMutation)
+
+// newCurrencyConversionMutation creates new mutation for the CurrencyConversion entity.
+func newCurrencyConversionMutation(c config, op Op, opts ...currencyconversionOption) *CurrencyConversionMutation {
+	m := &CurrencyConversionMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeDocument,
+		typ:           TypeCurrencyConversion,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -1725,20 +1813,20 @@ func newDocumentMutation(c config, op Op, opts ...documentOption) *DocumentMutat
 	return m
 }
 
-// withDocumentID sets the ID field of the mutation.
-func withDocumentID(id uuid.UUID) documentOption {
-	return func(m *DocumentMutation) {
+// withCurrencyConversionID sets the ID field of the mutation.
+func withCurrencyConversionID(id uuid.UUID) currencyconversionOption {
+	return func(m *CurrencyConversionMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Document
+			value *CurrencyConversion
 		)
-		m.oldValue = func(ctx context.Context) (*Document, error) {
+		m.oldValue = func(ctx context.Context) (*CurrencyConversion, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Document.Get(ctx, id)
+					value, err = m.Client().CurrencyConversion.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1747,10 +1835,10 @@ func withDocumentID(id uuid.UUID) documentOption {
 	}
 }
 
-// withDocument sets the old Document of the mutation.
-func withDocument(node *Document) documentOption {
-	return func(m *DocumentMutation) {
-		m.oldValue = func(context.Context) (*Document, error) {
+// withCurrencyConversion sets the old CurrencyConversion of the mutation.
+func withCurrencyConversion(node *CurrencyConversion) currencyconversionOption {
+	return func(m *CurrencyConversionMutation) {
+		m.oldValue = func(context.Context) (*CurrencyConversion, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1759,7 +1847,7 @@ func withDocument(node *Document) documentOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m DocumentMutation) Client() *Client {
+func (m CurrencyConversionMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1767,7 +1855,7 @@ func (m DocumentMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m DocumentMutation) Tx() (*Tx, error) {
+func (m CurrencyConversionMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -1777,14 +1865,14 @@ func (m DocumentMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Document entities.
-func (m *DocumentMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of CurrencyConversion entities.
+func (m *CurrencyConversionMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *DocumentMutation) ID() (id uuid.UUID, exists bool) {
+func (m *CurrencyConversionMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1795,7 +1883,7 @@ func (m *DocumentMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *DocumentMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *CurrencyConversionMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -1804,19 +1892,19 @@ func (m *DocumentMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Document.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().CurrencyConversion.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *DocumentMutation) SetCreatedAt(t time.Time) {
+func (m *CurrencyConversionMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *DocumentMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *CurrencyConversionMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -1824,10 +1912,10 @@ func (m *DocumentMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Document entity.
-// If the Document object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the CurrencyConversion entity.
+// If the CurrencyConversion object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DocumentMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *CurrencyConversionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -1842,17 +1930,17 @@ func (m *DocumentMutation) OldCreatedAt(ctx context.Context) (v time.Time, err e
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *DocumentMutation) ResetCreatedAt() {
+func (m *CurrencyConversionMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *DocumentMutation) SetUpdatedAt(t time.Time) {
+func (m *CurrencyConversionMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *DocumentMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *CurrencyConversionMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -1860,10 +1948,10 @@ func (m *DocumentMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Document entity.
-// If the Document object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the CurrencyConversion entity.
+// If the CurrencyConversion object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DocumentMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *CurrencyConversionMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -1878,99 +1966,206 @@ func (m *DocumentMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err e
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *DocumentMutation) ResetUpdatedAt() {
+func (m *CurrencyConversionMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
-// SetTitle sets the "title" field.
-func (m *DocumentMutation) SetTitle(s string) {
-	m.title = &s
+// SetFromCurrency sets the "from_currency" field.
+func (m *CurrencyConversionMutation) SetFromCurrency(s string) {
+	m.from_currency = &s
 }
 
-// Title returns the value of the "title" field in the mutation.
-func (m *DocumentMutation) Title() (r string, exists bool) {
-	v := m.title
+// FromCurrency returns the value of the "from_currency" field in the mutation.
+func (m *CurrencyConversionMutation) FromCurrency() (r string, exists bool) {
+	v := m.from_currency
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTitle returns the old "title" field's value of the Document entity.
-// If the Document object wasn't provided to the builder, the object is fetched from the database.
+// OldFromCurrency returns the old "from_currency" field's value of the CurrencyConversion entity.
+// If the CurrencyConversion object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DocumentMutation) OldTitle(ctx context.Context) (v string, err error) {
+func (m *CurrencyConversionMutation) OldFromCurrency(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+		return v, errors.New("OldFromCurrency is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTitle requires an ID field in the mutation")
+		return v, errors.New("OldFromCurrency requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+		return v, fmt.Errorf("querying old value for OldFromCurrency: %w", err)
 	}
-	return oldValue.Title, nil
+	return oldValue.FromCurrency, nil
 }
 
-// ResetTitle resets all changes to the "title" field.
-func (m *DocumentMutation) ResetTitle() {
-	m.title = nil
+// ResetFromCurrency resets all changes to the "from_currency" field.
+func (m *CurrencyConversionMutation) ResetFromCurrency() {
+	m.from_currency = nil
 }
 
-// SetPath sets the "path" field.
-func (m *DocumentMutation) SetPath(s string) {
-	m._path = &s
+// SetToCurrency sets the "to_currency" field.
+func (m *CurrencyConversionMutation) SetToCurrency(s string) {
+	m.to_currency = &s
 }
 
-// Path returns the value of the "path" field in the mutation.
-func (m *DocumentMutation) Path() (r string, exists bool) {
-	v := m._path
+// ToCurrency returns the value of the "to_currency" field in the mutation.
+func (m *CurrencyConversionMutation) ToCurrency() (r string, exists bool) {
+	v := m.to_currency
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPath returns the old "path" field's value of the Document entity.
-// If the Document object wasn't provided to the builder, the object is fetched from the database.
+// OldToCurrency returns the old "to_currency" field's value of the CurrencyConversion entity.
+// If the CurrencyConversion object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DocumentMutation) OldPath(ctx context.Context) (v string, err error) {
+func (m *CurrencyConversionMutation) OldToCurrency(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPath is only allowed on UpdateOne operations")
+		return v, errors.New("OldToCurrency is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPath requires an ID field in the mutation")
+		return v, errors.New("OldToCurrency requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPath: %w", err)
+		return v, fmt.Errorf("querying old value for OldToCurrency: %w", err)
 	}
-	return oldValue.Path, nil
+	return oldValue.ToCurrency, nil
 }
 
-// ResetPath resets all changes to the "path" field.
-func (m *DocumentMutation) ResetPath() {
-	m._path = nil
+// ResetToCurrency resets all changes to the "to_currency" field.
+func (m *CurrencyConversionMutation) ResetToCurrency() {
+	m.to_currency = nil
+}
+
+// SetRate sets the "rate" field.
+func (m *CurrencyConversionMutation) SetRate(f float64) {
+	m.rate = &f
+	m.addrate = nil
+}
+
+// Rate returns the value of the "rate" field in the mutation.
+func (m *CurrencyConversionMutation) Rate() (r float64, exists bool) {
+	v := m.rate
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRate returns the old "rate" field's value of the CurrencyConversion entity.
+// If the CurrencyConversion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CurrencyConversionMutation) OldRate(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRate: %w", err)
+	}
+	return oldValue.Rate, nil
+}
+
+// AddRate adds f to the "rate" field.
+func (m *CurrencyConversionMutation) AddRate(f float64) {
+	if m.addrate != nil {
+		*m.addrate += f
+	} else {
+		m.addrate = &f
+	}
+}
+
+// AddedRate returns the value that was added to the "rate" field in this mutation.
+func (m *CurrencyConversionMutation) AddedRate() (r float64, exists bool) {
+	v := m.addrate
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetRate resets all changes to the "rate" field.
+func (m *CurrencyConversionMutation) ResetRate() {
+	m.rate = nil
+	m.addrate = nil
+}
+
+// SetOriginalPrices sets the "original_prices" field.
+func (m *CurrencyConversionMutation) SetOriginalPrices(ts []types.PriceSnapshot) {
+	m.original_prices = &ts
+	m.appendoriginal_prices = nil
+}
+
+// OriginalPrices returns the value of the "original_prices" field in the mutation.
+func (m *CurrencyConversionMutation) OriginalPrices() (r []types.PriceSnapshot, exists bool) {
+	v := m.original_prices
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOriginalPrices returns the old "original_prices" field's value of the CurrencyConversion entity.
+// If the CurrencyConversion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CurrencyConversionMutation) OldOriginalPrices(ctx context.Context) (v []types.PriceSnapshot, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOriginalPrices is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOriginalPrices requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOriginalPrices: %w", err)
+	}
+	return oldValue.OriginalPrices, nil
+}
+
+// AppendOriginalPrices adds ts to the "original_prices" field.
+func (m *CurrencyConversionMutation) AppendOriginalPrices(ts []types.PriceSnapshot) {
+	m.appendoriginal_prices = append(m.appendoriginal_prices, ts...)
+}
+
+// AppendedOriginalPrices returns the list of values that were appended to the "original_prices" field in this mutation.
+func (m *CurrencyConversionMutation) AppendedOriginalPrices() ([]types.PriceSnapshot, bool) {
+	if len(m.appendoriginal_prices) == 0 {
+		return nil, false
+	}
+	return m.appendoriginal_prices, true
+}
+
+// ResetOriginalPrices resets all changes to the "original_prices" field.
+func (m *CurrencyConversionMutation) ResetOriginalPrices() {
+	m.original_prices = nil
+	m.appendoriginal_prices = nil
 }
 
 // SetGroupID sets the "group" edge to the Group entity by id.
-func (m *DocumentMutation) SetGroupID(id uuid.UUID) {
+func (m *CurrencyConversionMutation) SetGroupID(id uuid.UUID) {
 	m.group = &id
 }
 
 // ClearGroup clears the "group" edge to the Group entity.
-func (m *DocumentMutation) ClearGroup() {
+func (m *CurrencyConversionMutation) ClearGroup() {
 	m.clearedgroup = true
 }
 
 // GroupCleared reports if the "group" edge to the Group entity was cleared.
-func (m *DocumentMutation) GroupCleared() bool {
+func (m *CurrencyConversionMutation) GroupCleared() bool {
 	return m.clearedgroup
 }
 
 // GroupID returns the "group" edge ID in the mutation.
-func (m *DocumentMutation) GroupID() (id uuid.UUID, exists bool) {
+func (m *CurrencyConversionMutation) GroupID() (id uuid.UUID, exists bool) {
 	if m.group != nil {
 		return *m.group, true
 	}
@@ -1980,7 +2175,7 @@ func (m *DocumentMutation) GroupID() (id uuid.UUID, exists bool) {
 // GroupIDs returns the "group" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
 // GroupID instead. It exists only for internal usage by the builders.
-func (m *DocumentMutation) GroupIDs() (ids []uuid.UUID) {
+func (m *CurrencyConversionMutation) GroupIDs() (ids []uuid.UUID) {
 	if id := m.group; id != nil {
 		ids = append(ids, *id)
 	}
@@ -1988,128 +2183,84 @@ func (m *DocumentMutation) GroupIDs() (ids []uuid.UUID) {
 }
 
 // ResetGroup resets all changes to the "group" edge.
-func (m *DocumentMutation) ResetGroup() {
+func (m *CurrencyConversionMutation) ResetGroup() {
 	m.group = nil
 	m.clearedgroup = false
 }
 
-// AddAttachmentIDs adds the "attachments" edge to the Attachment entity by ids.
-func (m *DocumentMutation) AddAttachmentIDs(ids ...uuid.UUID) {
-	if m.attachments == nil {
-		m.attachments = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.attachments[ids[i]] = struct{}{}
+// Where appends a list predicates to the CurrencyConversionMutation builder.
+func (m *CurrencyConversionMutation) Where(ps ...predicate.CurrencyConversion) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the CurrencyConversionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *CurrencyConversionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.CurrencyConversion, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
+	m.Where(p...)
 }
 
-// ClearAttachments clears the "attachments" edge to the Attachment entity.
-func (m *DocumentMutation) ClearAttachments() {
-	m.clearedattachments = true
+// Op returns the operation name.
+func (m *CurrencyConversionMutation) Op() Op {
+	return m.op
 }
 
-// AttachmentsCleared reports if the "attachments" edge to the Attachment entity was cleared.
-func (m *DocumentMutation) AttachmentsCleared() bool {
-	return m.clearedattachments
+// SetOp allows setting the mutation operation.
+func (m *CurrencyConversionMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// RemoveAttachmentIDs removes the "attachments" edge to the Attachment entity by IDs.
-func (m *DocumentMutation) RemoveAttachmentIDs(ids ...uuid.UUID) {
-	if m.removedattachments == nil {
-		m.removedattachments = make(map[uuid.UUID]struct{})
+// Type returns the node type of this mutation (CurrencyConversion).
+func (m *CurrencyConversionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *CurrencyConversionMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.created_at != nil {
+		fields = append(fields, currencyconversion.FieldCreatedAt)
 	}
-	for i := range ids {
-		delete(m.attachments, ids[i])
-		m.removedattachments[ids[i]] = struct{}{}
+	if m.updated_at != nil {
+		fields = append(fields, currencyconversion.FieldUpdatedAt)
+	}
+	if m.from_currency != nil {
+		fields = append(fields, currencyconversion.FieldFromCurrency)
 	}
-}
-
-// RemovedAttachments returns the removed IDs of the "attachments" edge to the Attachment entity.
-func (m *DocumentMutation) RemovedAttachmentsIDs() (ids []uuid.UUID) {
-	for id := range m.removedattachments {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// AttachmentsIDs returns the "attachments" edge IDs in the mutation.
-func (m *DocumentMutation) AttachmentsIDs() (ids []uuid.UUID) {
-	for id := range m.attachments {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// ResetAttachments resets all changes to the "attachments" edge.
-func (m *DocumentMutation) ResetAttachments() {
-	m.attachments = nil
-	m.clearedattachments = false
-	m.removedattachments = nil
-}
-
-// Where appends a list predicates to the DocumentMutation builder.
-func (m *DocumentMutation) Where(ps ...predicate.Document) {
-	m.predicates = append(m.predicates, ps...)
-}
-
-// WhereP appends storage-level predicates to the DocumentMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *DocumentMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Document, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
-	}
-	m.Where(p...)
-}
-
-// Op returns the operation name.
-func (m *DocumentMutation) Op() Op {
-	return m.op
-}
-
-// SetOp allows setting the mutation operation.
-func (m *DocumentMutation) SetOp(op Op) {
-	m.op = op
-}
-
-// Type returns the node type of this mutation (Document).
-func (m *DocumentMutation) Type() string {
-	return m.typ
-}
-
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *DocumentMutation) Fields() []string {
-	fields := make([]string, 0, 4)
-	if m.created_at != nil {
-		fields = append(fields, document.FieldCreatedAt)
-	}
-	if m.updated_at != nil {
-		fields = append(fields, document.FieldUpdatedAt)
-	}
-	if m.title != nil {
-		fields = append(fields, document.FieldTitle)
-	}
-	if m._path != nil {
-		fields = append(fields, document.FieldPath)
-	}
-	return fields
+	if m.to_currency != nil {
+		fields = append(fields, currencyconversion.FieldToCurrency)
+	}
+	if m.rate != nil {
+		fields = append(fields, currencyconversion.FieldRate)
+	}
+	if m.original_prices != nil {
+		fields = append(fields, currencyconversion.FieldOriginalPrices)
+	}
+	return fields
 }
 
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *DocumentMutation) Field(name string) (ent.Value, bool) {
+func (m *CurrencyConversionMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case document.FieldCreatedAt:
+	case currencyconversion.FieldCreatedAt:
 		return m.CreatedAt()
-	case document.FieldUpdatedAt:
+	case currencyconversion.FieldUpdatedAt:
 		return m.UpdatedAt()
-	case document.FieldTitle:
-		return m.Title()
-	case document.FieldPath:
-		return m.Path()
+	case currencyconversion.FieldFromCurrency:
+		return m.FromCurrency()
+	case currencyconversion.FieldToCurrency:
+		return m.ToCurrency()
+	case currencyconversion.FieldRate:
+		return m.Rate()
+	case currencyconversion.FieldOriginalPrices:
+		return m.OriginalPrices()
 	}
 	return nil, false
 }
@@ -2117,268 +2268,263 @@ func (m *DocumentMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *DocumentMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *CurrencyConversionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case document.FieldCreatedAt:
+	case currencyconversion.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case document.FieldUpdatedAt:
+	case currencyconversion.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
-	case document.FieldTitle:
-		return m.OldTitle(ctx)
-	case document.FieldPath:
-		return m.OldPath(ctx)
+	case currencyconversion.FieldFromCurrency:
+		return m.OldFromCurrency(ctx)
+	case currencyconversion.FieldToCurrency:
+		return m.OldToCurrency(ctx)
+	case currencyconversion.FieldRate:
+		return m.OldRate(ctx)
+	case currencyconversion.FieldOriginalPrices:
+		return m.OldOriginalPrices(ctx)
 	}
-	return nil, fmt.Errorf("unknown Document field %s", name)
+	return nil, fmt.Errorf("unknown CurrencyConversion field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *DocumentMutation) SetField(name string, value ent.Value) error {
+func (m *CurrencyConversionMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case document.FieldCreatedAt:
+	case currencyconversion.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case document.FieldUpdatedAt:
+	case currencyconversion.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdatedAt(v)
 		return nil
-	case document.FieldTitle:
+	case currencyconversion.FieldFromCurrency:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTitle(v)
+		m.SetFromCurrency(v)
 		return nil
-	case document.FieldPath:
+	case currencyconversion.FieldToCurrency:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPath(v)
+		m.SetToCurrency(v)
+		return nil
+	case currencyconversion.FieldRate:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRate(v)
+		return nil
+	case currencyconversion.FieldOriginalPrices:
+		v, ok := value.([]types.PriceSnapshot)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOriginalPrices(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Document field %s", name)
+	return fmt.Errorf("unknown CurrencyConversion field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *DocumentMutation) AddedFields() []string {
-	return nil
+func (m *CurrencyConversionMutation) AddedFields() []string {
+	var fields []string
+	if m.addrate != nil {
+		fields = append(fields, currencyconversion.FieldRate)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *DocumentMutation) AddedField(name string) (ent.Value, bool) {
+func (m *CurrencyConversionMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case currencyconversion.FieldRate:
+		return m.AddedRate()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *DocumentMutation) AddField(name string, value ent.Value) error {
+func (m *CurrencyConversionMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case currencyconversion.FieldRate:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRate(v)
+		return nil
 	}
-	return fmt.Errorf("unknown Document numeric field %s", name)
+	return fmt.Errorf("unknown CurrencyConversion numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *DocumentMutation) ClearedFields() []string {
+func (m *CurrencyConversionMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *DocumentMutation) FieldCleared(name string) bool {
+func (m *CurrencyConversionMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *DocumentMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Document nullable field %s", name)
+func (m *CurrencyConversionMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown CurrencyConversion nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *DocumentMutation) ResetField(name string) error {
+func (m *CurrencyConversionMutation) ResetField(name string) error {
 	switch name {
-	case document.FieldCreatedAt:
+	case currencyconversion.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case document.FieldUpdatedAt:
+	case currencyconversion.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
-	case document.FieldTitle:
-		m.ResetTitle()
+	case currencyconversion.FieldFromCurrency:
+		m.ResetFromCurrency()
 		return nil
-	case document.FieldPath:
-		m.ResetPath()
+	case currencyconversion.FieldToCurrency:
+		m.ResetToCurrency()
+		return nil
+	case currencyconversion.FieldRate:
+		m.ResetRate()
+		return nil
+	case currencyconversion.FieldOriginalPrices:
+		m.ResetOriginalPrices()
 		return nil
 	}
-	return fmt.Errorf("unknown Document field %s", name)
+	return fmt.Errorf("unknown CurrencyConversion field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *DocumentMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
+func (m *CurrencyConversionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
 	if m.group != nil {
-		edges = append(edges, document.EdgeGroup)
-	}
-	if m.attachments != nil {
-		edges = append(edges, document.EdgeAttachments)
+		edges = append(edges, currencyconversion.EdgeGroup)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *DocumentMutation) AddedIDs(name string) []ent.Value {
+func (m *CurrencyConversionMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case document.EdgeGroup:
+	case currencyconversion.EdgeGroup:
 		if id := m.group; id != nil {
 			return []ent.Value{*id}
 		}
-	case document.EdgeAttachments:
-		ids := make([]ent.Value, 0, len(m.attachments))
-		for id := range m.attachments {
-			ids = append(ids, id)
-		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *DocumentMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.removedattachments != nil {
-		edges = append(edges, document.EdgeAttachments)
-	}
+func (m *CurrencyConversionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *DocumentMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case document.EdgeAttachments:
-		ids := make([]ent.Value, 0, len(m.removedattachments))
-		for id := range m.removedattachments {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *CurrencyConversionMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *DocumentMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
+func (m *CurrencyConversionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
 	if m.clearedgroup {
-		edges = append(edges, document.EdgeGroup)
-	}
-	if m.clearedattachments {
-		edges = append(edges, document.EdgeAttachments)
+		edges = append(edges, currencyconversion.EdgeGroup)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *DocumentMutation) EdgeCleared(name string) bool {
+func (m *CurrencyConversionMutation) EdgeCleared(name string) bool {
 	switch name {
-	case document.EdgeGroup:
+	case currencyconversion.EdgeGroup:
 		return m.clearedgroup
-	case document.EdgeAttachments:
-		return m.clearedattachments
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *DocumentMutation) ClearEdge(name string) error {
+func (m *CurrencyConversionMutation) ClearEdge(name string) error {
 	switch name {
-	case document.EdgeGroup:
+	case currencyconversion.EdgeGroup:
 		m.ClearGroup()
 		return nil
 	}
-	return fmt.Errorf("unknown Document unique edge %s", name)
+	return fmt.Errorf("unknown CurrencyConversion unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *DocumentMutation) ResetEdge(name string) error {
+func (m *CurrencyConversionMutation) ResetEdge(name string) error {
 	switch name {
-	case document.EdgeGroup:
+	case currencyconversion.EdgeGroup:
 		m.ResetGroup()
 		return nil
-	case document.EdgeAttachments:
-		m.ResetAttachments()
-		return nil
 	}
-	return fmt.Errorf("unknown Document edge %s", name)
+	return fmt.Errorf("unknown CurrencyConversion edge %s", name)
 }
 
-// GroupMutation represents an operation that mutates the Group nodes in the graph.
-type GroupMutation struct {
+// DocumentMutation represents an operation that mutates the Document nodes in the graph.
+type DocumentMutation struct {
 	config
-	op                       Op
-	typ                      string
-	id                       *uuid.UUID
-	created_at               *time.Time
-	updated_at               *time.Time
-	name                     *string
-	currency                 *group.Currency
-	clearedFields            map[string]struct{}
-	users                    map[uuid.UUID]struct{}
-	removedusers             map[uuid.UUID]struct{}
-	clearedusers             bool
-	locations                map[uuid.UUID]struct{}
-	removedlocations         map[uuid.UUID]struct{}
-	clearedlocations         bool
-	items                    map[uuid.UUID]struct{}
-	removeditems             map[uuid.UUID]struct{}
-	cleareditems             bool
-	labels                   map[uuid.UUID]struct{}
-	removedlabels            map[uuid.UUID]struct{}
-	clearedlabels            bool
-	documents                map[uuid.UUID]struct{}
-	removeddocuments         map[uuid.UUID]struct{}
-	cleareddocuments         bool
-	invitation_tokens        map[uuid.UUID]struct{}
-	removedinvitation_tokens map[uuid.UUID]struct{}
-	clearedinvitation_tokens bool
-	notifiers                map[uuid.UUID]struct{}
-	removednotifiers         map[uuid.UUID]struct{}
-	clearednotifiers         bool
-	done                     bool
-	oldValue                 func(context.Context) (*Group, error)
-	predicates               []predicate.Group
+	op                 Op
+	typ                string
+	id                 *uuid.UUID
+	created_at         *time.Time
+	updated_at         *time.Time
+	title              *string
+	_path              *string
+	clearedFields      map[string]struct{}
+	group              *uuid.UUID
+	clearedgroup       bool
+	attachments        map[uuid.UUID]struct{}
+	removedattachments map[uuid.UUID]struct{}
+	clearedattachments bool
+	done               bool
+	oldValue           func(context.Context) (*Document, error)
+	predicates         []predicate.Document
 }
 
-var _ ent.Mutation = (*GroupMutation)(nil)
+var _ ent.Mutation = (*DocumentMutation)(nil)
 
-// groupOption allows management of the mutation configuration using functional options.
-type groupOption func(*GroupMutation)
+// documentOption allows management of the mutation configuration using functional options.
+type documentOption func(*DocumentMutation)
 
-// newGroupMutation creates new mutation for the Group entity.
-func newGroupMutation(c config, op Op, opts ...groupOption) *GroupMutation {
-	m := &GroupMutation{
+// newDocumentMutation creates new mutation for the Document entity.
+func newDocumentMutation(c config, op Op, opts ...documentOption) *DocumentMutation {
+	m := &DocumentMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeGroup,
+		typ:           TypeDocument,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -2387,20 +2533,20 @@ func newGroupMutation(c config, op Op, opts ...groupOption) *GroupMutation {
 	return m
 }
 
-// withGroupID sets the ID field of the mutation.
-func withGroupID(id uuid.UUID) groupOption {
-	return func(m *GroupMutation) {
+// withDocumentID sets the ID field of the mutation.
+func withDocumentID(id uuid.UUID) documentOption {
+	return func(m *DocumentMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Group
+			value *Document
 		)
-		m.oldValue = func(ctx context.Context) (*Group, error) {
+		m.oldValue = func(ctx context.Context) (*Document, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Group.Get(ctx, id)
+					value, err = m.Client().Document.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -2409,10 +2555,10 @@ func withGroupID(id uuid.UUID) groupOption {
 	}
 }
 
-// withGroup sets the old Group of the mutation.
-func withGroup(node *Group) groupOption {
-	return func(m *GroupMutation) {
-		m.oldValue = func(context.Context) (*Group, error) {
+// withDocument sets the old Document of the mutation.
+func withDocument(node *Document) documentOption {
+	return func(m *DocumentMutation) {
+		m.oldValue = func(context.Context) (*Document, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -2421,7 +2567,7 @@ func withGroup(node *Group) groupOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m GroupMutation) Client() *Client {
+func (m DocumentMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -2429,7 +2575,7 @@ func (m GroupMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m GroupMutation) Tx() (*Tx, error) {
+func (m DocumentMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -2439,14 +2585,14 @@ func (m GroupMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Group entities.
-func (m *GroupMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of Document entities.
+func (m *DocumentMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *GroupMutation) ID() (id uuid.UUID, exists bool) {
+func (m *DocumentMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -2457,7 +2603,7 @@ func (m *GroupMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *GroupMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *DocumentMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -2466,19 +2612,19 @@ func (m *GroupMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Group.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Document.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *GroupMutation) SetCreatedAt(t time.Time) {
+func (m *DocumentMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *GroupMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *DocumentMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -2486,10 +2632,10 @@ func (m *GroupMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Group entity.
-// If the Group object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Document entity.
+// If the Document object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GroupMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *DocumentMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -2504,17 +2650,17 @@ func (m *GroupMutation) OldCreatedAt(ctx context.Context) (v time.Time, err erro
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *GroupMutation) ResetCreatedAt() {
+func (m *DocumentMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *GroupMutation) SetUpdatedAt(t time.Time) {
+func (m *DocumentMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *GroupMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *DocumentMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -2522,10 +2668,10 @@ func (m *GroupMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Group entity.
-// If the Group object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the Document entity.
+// If the Document object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GroupMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *DocumentMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -2540,1313 +2686,1601 @@ func (m *GroupMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err erro
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *GroupMutation) ResetUpdatedAt() {
+func (m *DocumentMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
-// SetName sets the "name" field.
-func (m *GroupMutation) SetName(s string) {
-	m.name = &s
+// SetTitle sets the "title" field.
+func (m *DocumentMutation) SetTitle(s string) {
+	m.title = &s
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *GroupMutation) Name() (r string, exists bool) {
-	v := m.name
+// Title returns the value of the "title" field in the mutation.
+func (m *DocumentMutation) Title() (r string, exists bool) {
+	v := m.title
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Group entity.
-// If the Group object wasn't provided to the builder, the object is fetched from the database.
+// OldTitle returns the old "title" field's value of the Document entity.
+// If the Document object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GroupMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *DocumentMutation) OldTitle(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldTitle requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.Title, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *GroupMutation) ResetName() {
-	m.name = nil
+// ResetTitle resets all changes to the "title" field.
+func (m *DocumentMutation) ResetTitle() {
+	m.title = nil
 }
 
-// SetCurrency sets the "currency" field.
-func (m *GroupMutation) SetCurrency(gr group.Currency) {
-	m.currency = &gr
+// SetPath sets the "path" field.
+func (m *DocumentMutation) SetPath(s string) {
+	m._path = &s
 }
 
-// Currency returns the value of the "currency" field in the mutation.
-func (m *GroupMutation) Currency() (r group.Currency, exists bool) {
-	v := m.currency
+// Path returns the value of the "path" field in the mutation.
+func (m *DocumentMutation) Path() (r string, exists bool) {
+	v := m._path
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCurrency returns the old "currency" field's value of the Group entity.
-// If the Group object wasn't provided to the builder, the object is fetched from the database.
+// OldPath returns the old "path" field's value of the Document entity.
+// If the Document object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GroupMutation) OldCurrency(ctx context.Context) (v group.Currency, err error) {
+func (m *DocumentMutation) OldPath(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCurrency is only allowed on UpdateOne operations")
+		return v, errors.New("OldPath is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCurrency requires an ID field in the mutation")
+		return v, errors.New("OldPath requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCurrency: %w", err)
+		return v, fmt.Errorf("querying old value for OldPath: %w", err)
 	}
-	return oldValue.Currency, nil
-}
-
-// ResetCurrency resets all changes to the "currency" field.
-func (m *GroupMutation) ResetCurrency() {
-	m.currency = nil
+	return oldValue.Path, nil
 }
 
-// AddUserIDs adds the "users" edge to the User entity by ids.
-func (m *GroupMutation) AddUserIDs(ids ...uuid.UUID) {
-	if m.users == nil {
-		m.users = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.users[ids[i]] = struct{}{}
-	}
+// ResetPath resets all changes to the "path" field.
+func (m *DocumentMutation) ResetPath() {
+	m._path = nil
 }
 
-// ClearUsers clears the "users" edge to the User entity.
-func (m *GroupMutation) ClearUsers() {
-	m.clearedusers = true
+// SetGroupID sets the "group" edge to the Group entity by id.
+func (m *DocumentMutation) SetGroupID(id uuid.UUID) {
+	m.group = &id
 }
 
-// UsersCleared reports if the "users" edge to the User entity was cleared.
-func (m *GroupMutation) UsersCleared() bool {
-	return m.clearedusers
+// ClearGroup clears the "group" edge to the Group entity.
+func (m *DocumentMutation) ClearGroup() {
+	m.clearedgroup = true
 }
 
-// RemoveUserIDs removes the "users" edge to the User entity by IDs.
-func (m *GroupMutation) RemoveUserIDs(ids ...uuid.UUID) {
-	if m.removedusers == nil {
-		m.removedusers = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.users, ids[i])
-		m.removedusers[ids[i]] = struct{}{}
-	}
+// GroupCleared reports if the "group" edge to the Group entity was cleared.
+func (m *DocumentMutation) GroupCleared() bool {
+	return m.clearedgroup
 }
 
-// RemovedUsers returns the removed IDs of the "users" edge to the User entity.
-func (m *GroupMutation) RemovedUsersIDs() (ids []uuid.UUID) {
-	for id := range m.removedusers {
-		ids = append(ids, id)
+// GroupID returns the "group" edge ID in the mutation.
+func (m *DocumentMutation) GroupID() (id uuid.UUID, exists bool) {
+	if m.group != nil {
+		return *m.group, true
 	}
 	return
 }
 
-// UsersIDs returns the "users" edge IDs in the mutation.
-func (m *GroupMutation) UsersIDs() (ids []uuid.UUID) {
-	for id := range m.users {
-		ids = append(ids, id)
+// GroupIDs returns the "group" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// GroupID instead. It exists only for internal usage by the builders.
+func (m *DocumentMutation) GroupIDs() (ids []uuid.UUID) {
+	if id := m.group; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetUsers resets all changes to the "users" edge.
-func (m *GroupMutation) ResetUsers() {
-	m.users = nil
-	m.clearedusers = false
-	m.removedusers = nil
+// ResetGroup resets all changes to the "group" edge.
+func (m *DocumentMutation) ResetGroup() {
+	m.group = nil
+	m.clearedgroup = false
 }
 
-// AddLocationIDs adds the "locations" edge to the Location entity by ids.
-func (m *GroupMutation) AddLocationIDs(ids ...uuid.UUID) {
-	if m.locations == nil {
-		m.locations = make(map[uuid.UUID]struct{})
+// AddAttachmentIDs adds the "attachments" edge to the Attachment entity by ids.
+func (m *DocumentMutation) AddAttachmentIDs(ids ...uuid.UUID) {
+	if m.attachments == nil {
+		m.attachments = make(map[uuid.UUID]struct{})
 	}
 	for i := range ids {
-		m.locations[ids[i]] = struct{}{}
+		m.attachments[ids[i]] = struct{}{}
 	}
 }
 
-// ClearLocations clears the "locations" edge to the Location entity.
-func (m *GroupMutation) ClearLocations() {
-	m.clearedlocations = true
+// ClearAttachments clears the "attachments" edge to the Attachment entity.
+func (m *DocumentMutation) ClearAttachments() {
+	m.clearedattachments = true
 }
 
-// LocationsCleared reports if the "locations" edge to the Location entity was cleared.
-func (m *GroupMutation) LocationsCleared() bool {
-	return m.clearedlocations
+// AttachmentsCleared reports if the "attachments" edge to the Attachment entity was cleared.
+func (m *DocumentMutation) AttachmentsCleared() bool {
+	return m.clearedattachments
 }
 
-// RemoveLocationIDs removes the "locations" edge to the Location entity by IDs.
-func (m *GroupMutation) RemoveLocationIDs(ids ...uuid.UUID) {
-	if m.removedlocations == nil {
-		m.removedlocations = make(map[uuid.UUID]struct{})
+// RemoveAttachmentIDs removes the "attachments" edge to the Attachment entity by IDs.
+func (m *DocumentMutation) RemoveAttachmentIDs(ids ...uuid.UUID) {
+	if m.removedattachments == nil {
+		m.removedattachments = make(map[uuid.UUID]struct{})
 	}
 	for i := range ids {
-		delete(m.locations, ids[i])
-		m.removedlocations[ids[i]] = struct{}{}
+		delete(m.attachments, ids[i])
+		m.removedattachments[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedLocations returns the removed IDs of the "locations" edge to the Location entity.
-func (m *GroupMutation) RemovedLocationsIDs() (ids []uuid.UUID) {
-	for id := range m.removedlocations {
+// RemovedAttachments returns the removed IDs of the "attachments" edge to the Attachment entity.
+func (m *DocumentMutation) RemovedAttachmentsIDs() (ids []uuid.UUID) {
+	for id := range m.removedattachments {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// LocationsIDs returns the "locations" edge IDs in the mutation.
-func (m *GroupMutation) LocationsIDs() (ids []uuid.UUID) {
-	for id := range m.locations {
+// AttachmentsIDs returns the "attachments" edge IDs in the mutation.
+func (m *DocumentMutation) AttachmentsIDs() (ids []uuid.UUID) {
+	for id := range m.attachments {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetLocations resets all changes to the "locations" edge.
-func (m *GroupMutation) ResetLocations() {
-	m.locations = nil
-	m.clearedlocations = false
-	m.removedlocations = nil
+// ResetAttachments resets all changes to the "attachments" edge.
+func (m *DocumentMutation) ResetAttachments() {
+	m.attachments = nil
+	m.clearedattachments = false
+	m.removedattachments = nil
 }
 
-// AddItemIDs adds the "items" edge to the Item entity by ids.
-func (m *GroupMutation) AddItemIDs(ids ...uuid.UUID) {
-	if m.items == nil {
-		m.items = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.items[ids[i]] = struct{}{}
-	}
+// Where appends a list predicates to the DocumentMutation builder.
+func (m *DocumentMutation) Where(ps ...predicate.Document) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// ClearItems clears the "items" edge to the Item entity.
-func (m *GroupMutation) ClearItems() {
-	m.cleareditems = true
+// WhereP appends storage-level predicates to the DocumentMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *DocumentMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Document, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
 }
 
-// ItemsCleared reports if the "items" edge to the Item entity was cleared.
-func (m *GroupMutation) ItemsCleared() bool {
-	return m.cleareditems
+// Op returns the operation name.
+func (m *DocumentMutation) Op() Op {
+	return m.op
 }
 
-// RemoveItemIDs removes the "items" edge to the Item entity by IDs.
-func (m *GroupMutation) RemoveItemIDs(ids ...uuid.UUID) {
-	if m.removeditems == nil {
-		m.removeditems = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.items, ids[i])
-		m.removeditems[ids[i]] = struct{}{}
-	}
+// SetOp allows setting the mutation operation.
+func (m *DocumentMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// RemovedItems returns the removed IDs of the "items" edge to the Item entity.
-func (m *GroupMutation) RemovedItemsIDs() (ids []uuid.UUID) {
-	for id := range m.removeditems {
-		ids = append(ids, id)
-	}
-	return
+// Type returns the node type of this mutation (Document).
+func (m *DocumentMutation) Type() string {
+	return m.typ
 }
 
-// ItemsIDs returns the "items" edge IDs in the mutation.
-func (m *GroupMutation) ItemsIDs() (ids []uuid.UUID) {
-	for id := range m.items {
-		ids = append(ids, id)
-	}
-	return
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *DocumentMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.created_at != nil {
+		fields = append(fields, document.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, document.FieldUpdatedAt)
+	}
+	if m.title != nil {
+		fields = append(fields, document.FieldTitle)
+	}
+	if m._path != nil {
+		fields = append(fields, document.FieldPath)
+	}
+	return fields
 }
 
-// ResetItems resets all changes to the "items" edge.
-func (m *GroupMutation) ResetItems() {
-	m.items = nil
-	m.cleareditems = false
-	m.removeditems = nil
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *DocumentMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case document.FieldCreatedAt:
+		return m.CreatedAt()
+	case document.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case document.FieldTitle:
+		return m.Title()
+	case document.FieldPath:
+		return m.Path()
+	}
+	return nil, false
 }
 
-// AddLabelIDs adds the "labels" edge to the Label entity by ids.
-func (m *GroupMutation) AddLabelIDs(ids ...uuid.UUID) {
-	if m.labels == nil {
-		m.labels = make(map[uuid.UUID]struct{})
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *DocumentMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case document.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case document.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case document.FieldTitle:
+		return m.OldTitle(ctx)
+	case document.FieldPath:
+		return m.OldPath(ctx)
 	}
-	for i := range ids {
-		m.labels[ids[i]] = struct{}{}
+	return nil, fmt.Errorf("unknown Document field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DocumentMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case document.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case document.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case document.FieldTitle:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTitle(v)
+		return nil
+	case document.FieldPath:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPath(v)
+		return nil
 	}
+	return fmt.Errorf("unknown Document field %s", name)
 }
 
-// ClearLabels clears the "labels" edge to the Label entity.
-func (m *GroupMutation) ClearLabels() {
-	m.clearedlabels = true
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *DocumentMutation) AddedFields() []string {
+	return nil
 }
 
-// LabelsCleared reports if the "labels" edge to the Label entity was cleared.
-func (m *GroupMutation) LabelsCleared() bool {
-	return m.clearedlabels
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *DocumentMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
 }
 
-// RemoveLabelIDs removes the "labels" edge to the Label entity by IDs.
-func (m *GroupMutation) RemoveLabelIDs(ids ...uuid.UUID) {
-	if m.removedlabels == nil {
-		m.removedlabels = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.labels, ids[i])
-		m.removedlabels[ids[i]] = struct{}{}
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DocumentMutation) AddField(name string, value ent.Value) error {
+	switch name {
 	}
+	return fmt.Errorf("unknown Document numeric field %s", name)
 }
 
-// RemovedLabels returns the removed IDs of the "labels" edge to the Label entity.
-func (m *GroupMutation) RemovedLabelsIDs() (ids []uuid.UUID) {
-	for id := range m.removedlabels {
-		ids = append(ids, id)
-	}
-	return
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *DocumentMutation) ClearedFields() []string {
+	return nil
 }
 
-// LabelsIDs returns the "labels" edge IDs in the mutation.
-func (m *GroupMutation) LabelsIDs() (ids []uuid.UUID) {
-	for id := range m.labels {
-		ids = append(ids, id)
-	}
-	return
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *DocumentMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
 }
 
-// ResetLabels resets all changes to the "labels" edge.
-func (m *GroupMutation) ResetLabels() {
-	m.labels = nil
-	m.clearedlabels = false
-	m.removedlabels = nil
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *DocumentMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Document nullable field %s", name)
 }
 
-// AddDocumentIDs adds the "documents" edge to the Document entity by ids.
-func (m *GroupMutation) AddDocumentIDs(ids ...uuid.UUID) {
-	if m.documents == nil {
-		m.documents = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.documents[ids[i]] = struct{}{}
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *DocumentMutation) ResetField(name string) error {
+	switch name {
+	case document.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case document.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case document.FieldTitle:
+		m.ResetTitle()
+		return nil
+	case document.FieldPath:
+		m.ResetPath()
+		return nil
 	}
+	return fmt.Errorf("unknown Document field %s", name)
 }
 
-// ClearDocuments clears the "documents" edge to the Document entity.
-func (m *GroupMutation) ClearDocuments() {
-	m.cleareddocuments = true
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *DocumentMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.group != nil {
+		edges = append(edges, document.EdgeGroup)
+	}
+	if m.attachments != nil {
+		edges = append(edges, document.EdgeAttachments)
+	}
+	return edges
 }
 
-// DocumentsCleared reports if the "documents" edge to the Document entity was cleared.
-func (m *GroupMutation) DocumentsCleared() bool {
-	return m.cleareddocuments
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *DocumentMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case document.EdgeGroup:
+		if id := m.group; id != nil {
+			return []ent.Value{*id}
+		}
+	case document.EdgeAttachments:
+		ids := make([]ent.Value, 0, len(m.attachments))
+		for id := range m.attachments {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
 }
 
-// RemoveDocumentIDs removes the "documents" edge to the Document entity by IDs.
-func (m *GroupMutation) RemoveDocumentIDs(ids ...uuid.UUID) {
-	if m.removeddocuments == nil {
-		m.removeddocuments = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.documents, ids[i])
-		m.removeddocuments[ids[i]] = struct{}{}
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *DocumentMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedattachments != nil {
+		edges = append(edges, document.EdgeAttachments)
 	}
+	return edges
 }
 
-// RemovedDocuments returns the removed IDs of the "documents" edge to the Document entity.
-func (m *GroupMutation) RemovedDocumentsIDs() (ids []uuid.UUID) {
-	for id := range m.removeddocuments {
-		ids = append(ids, id)
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *DocumentMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case document.EdgeAttachments:
+		ids := make([]ent.Value, 0, len(m.removedattachments))
+		for id := range m.removedattachments {
+			ids = append(ids, id)
+		}
+		return ids
 	}
-	return
+	return nil
 }
 
-// DocumentsIDs returns the "documents" edge IDs in the mutation.
-func (m *GroupMutation) DocumentsIDs() (ids []uuid.UUID) {
-	for id := range m.documents {
-		ids = append(ids, id)
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *DocumentMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedgroup {
+		edges = append(edges, document.EdgeGroup)
 	}
-	return
+	if m.clearedattachments {
+		edges = append(edges, document.EdgeAttachments)
+	}
+	return edges
 }
 
-// ResetDocuments resets all changes to the "documents" edge.
-func (m *GroupMutation) ResetDocuments() {
-	m.documents = nil
-	m.cleareddocuments = false
-	m.removeddocuments = nil
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *DocumentMutation) EdgeCleared(name string) bool {
+	switch name {
+	case document.EdgeGroup:
+		return m.clearedgroup
+	case document.EdgeAttachments:
+		return m.clearedattachments
+	}
+	return false
 }
 
-// AddInvitationTokenIDs adds the "invitation_tokens" edge to the GroupInvitationToken entity by ids.
-func (m *GroupMutation) AddInvitationTokenIDs(ids ...uuid.UUID) {
-	if m.invitation_tokens == nil {
-		m.invitation_tokens = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.invitation_tokens[ids[i]] = struct{}{}
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *DocumentMutation) ClearEdge(name string) error {
+	switch name {
+	case document.EdgeGroup:
+		m.ClearGroup()
+		return nil
 	}
+	return fmt.Errorf("unknown Document unique edge %s", name)
 }
 
-// ClearInvitationTokens clears the "invitation_tokens" edge to the GroupInvitationToken entity.
-func (m *GroupMutation) ClearInvitationTokens() {
-	m.clearedinvitation_tokens = true
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *DocumentMutation) ResetEdge(name string) error {
+	switch name {
+	case document.EdgeGroup:
+		m.ResetGroup()
+		return nil
+	case document.EdgeAttachments:
+		m.ResetAttachments()
+		return nil
+	}
+	return fmt.Errorf("unknown Document edge %s", name)
 }
 
-// InvitationTokensCleared reports if the "invitation_tokens" edge to the GroupInvitationToken entity was cleared.
-func (m *GroupMutation) InvitationTokensCleared() bool {
-	return m.clearedinvitation_tokens
+// GroupMutation represents an operation that mutates the Group nodes in the graph.
+type GroupMutation struct {
+	config
+	op                          Op
+	typ                         string
+	id                          *uuid.UUID
+	created_at                  *time.Time
+	updated_at                  *time.Time
+	name                        *string
+	currency                    *group.Currency
+	depreciation_method         *group.DepreciationMethod
+	depreciation_years          *int
+	adddepreciation_years       *int
+	clearedFields               map[string]struct{}
+	users                       map[uuid.UUID]struct{}
+	removedusers                map[uuid.UUID]struct{}
+	clearedusers                bool
+	locations                   map[uuid.UUID]struct{}
+	removedlocations            map[uuid.UUID]struct{}
+	clearedlocations            bool
+	items                       map[uuid.UUID]struct{}
+	removeditems                map[uuid.UUID]struct{}
+	cleareditems                bool
+	labels                      map[uuid.UUID]struct{}
+	removedlabels               map[uuid.UUID]struct{}
+	clearedlabels               bool
+	documents                   map[uuid.UUID]struct{}
+	removeddocuments            map[uuid.UUID]struct{}
+	cleareddocuments            bool
+	invitation_tokens           map[uuid.UUID]struct{}
+	removedinvitation_tokens    map[uuid.UUID]struct{}
+	clearedinvitation_tokens    bool
+	notifiers                   map[uuid.UUID]struct{}
+	removednotifiers            map[uuid.UUID]struct{}
+	clearednotifiers            bool
+	currency_conversions        map[uuid.UUID]struct{}
+	removedcurrency_conversions map[uuid.UUID]struct{}
+	clearedcurrency_conversions bool
+	item_events                 map[uuid.UUID]struct{}
+	removeditem_events          map[uuid.UUID]struct{}
+	cleareditem_events          bool
+	saved_searches              map[uuid.UUID]struct{}
+	removedsaved_searches       map[uuid.UUID]struct{}
+	clearedsaved_searches       bool
+	item_templates              map[uuid.UUID]struct{}
+	removeditem_templates       map[uuid.UUID]struct{}
+	cleareditem_templates       bool
+	default_location            *uuid.UUID
+	cleareddefault_location     bool
+	done                        bool
+	oldValue                    func(context.Context) (*Group, error)
+	predicates                  []predicate.Group
 }
 
-// RemoveInvitationTokenIDs removes the "invitation_tokens" edge to the GroupInvitationToken entity by IDs.
-func (m *GroupMutation) RemoveInvitationTokenIDs(ids ...uuid.UUID) {
-	if m.removedinvitation_tokens == nil {
-		m.removedinvitation_tokens = make(map[uuid.UUID]struct{})
+var _ ent.Mutation = (*GroupMutation)(nil)
+
+// groupOption allows management of the mutation configuration using functional options.
+type groupOption func(*GroupMutation)
+
+// newGroupMutation creates new mutation for the Group entity.
+func newGroupMutation(c config, op Op, opts ...groupOption) *GroupMutation {
+	m := &GroupMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeGroup,
+		clearedFields: make(map[string]struct{}),
 	}
-	for i := range ids {
-		delete(m.invitation_tokens, ids[i])
-		m.removedinvitation_tokens[ids[i]] = struct{}{}
+	for _, opt := range opts {
+		opt(m)
 	}
+	return m
 }
 
-// RemovedInvitationTokens returns the removed IDs of the "invitation_tokens" edge to the GroupInvitationToken entity.
-func (m *GroupMutation) RemovedInvitationTokensIDs() (ids []uuid.UUID) {
-	for id := range m.removedinvitation_tokens {
-		ids = append(ids, id)
+// withGroupID sets the ID field of the mutation.
+func withGroupID(id uuid.UUID) groupOption {
+	return func(m *GroupMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Group
+		)
+		m.oldValue = func(ctx context.Context) (*Group, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Group.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
 	}
-	return
 }
 
-// InvitationTokensIDs returns the "invitation_tokens" edge IDs in the mutation.
-func (m *GroupMutation) InvitationTokensIDs() (ids []uuid.UUID) {
-	for id := range m.invitation_tokens {
-		ids = append(ids, id)
+// withGroup sets the old Group of the mutation.
+func withGroup(node *Group) groupOption {
+	return func(m *GroupMutation) {
+		m.oldValue = func(context.Context) (*Group, error) {
+			return node, nil
+		}
+		m.id = &node.ID
 	}
-	return
 }
 
-// ResetInvitationTokens resets all changes to the "invitation_tokens" edge.
-func (m *GroupMutation) ResetInvitationTokens() {
-	m.invitation_tokens = nil
-	m.clearedinvitation_tokens = false
-	m.removedinvitation_tokens = nil
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m GroupMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
 }
 
-// AddNotifierIDs adds the "notifiers" edge to the Notifier entity by ids.
-func (m *GroupMutation) AddNotifierIDs(ids ...uuid.UUID) {
-	if m.notifiers == nil {
-		m.notifiers = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.notifiers[ids[i]] = struct{}{}
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m GroupMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// ClearNotifiers clears the "notifiers" edge to the Notifier entity.
-func (m *GroupMutation) ClearNotifiers() {
-	m.clearednotifiers = true
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Group entities.
+func (m *GroupMutation) SetID(id uuid.UUID) {
+	m.id = &id
 }
 
-// NotifiersCleared reports if the "notifiers" edge to the Notifier entity was cleared.
-func (m *GroupMutation) NotifiersCleared() bool {
-	return m.clearednotifiers
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *GroupMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
 }
 
-// RemoveNotifierIDs removes the "notifiers" edge to the Notifier entity by IDs.
-func (m *GroupMutation) RemoveNotifierIDs(ids ...uuid.UUID) {
-	if m.removednotifiers == nil {
-		m.removednotifiers = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.notifiers, ids[i])
-		m.removednotifiers[ids[i]] = struct{}{}
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *GroupMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Group.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// RemovedNotifiers returns the removed IDs of the "notifiers" edge to the Notifier entity.
-func (m *GroupMutation) RemovedNotifiersIDs() (ids []uuid.UUID) {
-	for id := range m.removednotifiers {
-		ids = append(ids, id)
+// SetCreatedAt sets the "created_at" field.
+func (m *GroupMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *GroupMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// NotifiersIDs returns the "notifiers" edge IDs in the mutation.
-func (m *GroupMutation) NotifiersIDs() (ids []uuid.UUID) {
-	for id := range m.notifiers {
-		ids = append(ids, id)
+// OldCreatedAt returns the old "created_at" field's value of the Group entity.
+// If the Group object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GroupMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
 }
 
-// ResetNotifiers resets all changes to the "notifiers" edge.
-func (m *GroupMutation) ResetNotifiers() {
-	m.notifiers = nil
-	m.clearednotifiers = false
-	m.removednotifiers = nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *GroupMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// Where appends a list predicates to the GroupMutation builder.
-func (m *GroupMutation) Where(ps ...predicate.Group) {
-	m.predicates = append(m.predicates, ps...)
+// SetUpdatedAt sets the "updated_at" field.
+func (m *GroupMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// WhereP appends storage-level predicates to the GroupMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *GroupMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Group, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *GroupMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
 	}
-	m.Where(p...)
+	return *v, true
 }
 
-// Op returns the operation name.
-func (m *GroupMutation) Op() Op {
-	return m.op
+// OldUpdatedAt returns the old "updated_at" field's value of the Group entity.
+// If the Group object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GroupMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
 }
 
-// SetOp allows setting the mutation operation.
-func (m *GroupMutation) SetOp(op Op) {
-	m.op = op
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *GroupMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// Type returns the node type of this mutation (Group).
-func (m *GroupMutation) Type() string {
-	return m.typ
+// SetName sets the "name" field.
+func (m *GroupMutation) SetName(s string) {
+	m.name = &s
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *GroupMutation) Fields() []string {
-	fields := make([]string, 0, 4)
-	if m.created_at != nil {
-		fields = append(fields, group.FieldCreatedAt)
+// Name returns the value of the "name" field in the mutation.
+func (m *GroupMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
 	}
-	if m.updated_at != nil {
-		fields = append(fields, group.FieldUpdatedAt)
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Group entity.
+// If the Group object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GroupMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
-	if m.name != nil {
-		fields = append(fields, group.FieldName)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
-	if m.currency != nil {
-		fields = append(fields, group.FieldCurrency)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return fields
+	return oldValue.Name, nil
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *GroupMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case group.FieldCreatedAt:
-		return m.CreatedAt()
-	case group.FieldUpdatedAt:
-		return m.UpdatedAt()
-	case group.FieldName:
-		return m.Name()
-	case group.FieldCurrency:
-		return m.Currency()
-	}
-	return nil, false
+// ResetName resets all changes to the "name" field.
+func (m *GroupMutation) ResetName() {
+	m.name = nil
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *GroupMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case group.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case group.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
-	case group.FieldName:
-		return m.OldName(ctx)
-	case group.FieldCurrency:
-		return m.OldCurrency(ctx)
+// SetCurrency sets the "currency" field.
+func (m *GroupMutation) SetCurrency(gr group.Currency) {
+	m.currency = &gr
+}
+
+// Currency returns the value of the "currency" field in the mutation.
+func (m *GroupMutation) Currency() (r group.Currency, exists bool) {
+	v := m.currency
+	if v == nil {
+		return
 	}
-	return nil, fmt.Errorf("unknown Group field %s", name)
+	return *v, true
 }
 
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *GroupMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case group.FieldCreatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreatedAt(v)
-		return nil
-	case group.FieldUpdatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUpdatedAt(v)
-		return nil
-	case group.FieldName:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetName(v)
-		return nil
-	case group.FieldCurrency:
-		v, ok := value.(group.Currency)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCurrency(v)
-		return nil
+// OldCurrency returns the old "currency" field's value of the Group entity.
+// If the Group object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GroupMutation) OldCurrency(ctx context.Context) (v group.Currency, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCurrency is only allowed on UpdateOne operations")
 	}
-	return fmt.Errorf("unknown Group field %s", name)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCurrency requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCurrency: %w", err)
+	}
+	return oldValue.Currency, nil
 }
 
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *GroupMutation) AddedFields() []string {
-	return nil
+// ResetCurrency resets all changes to the "currency" field.
+func (m *GroupMutation) ResetCurrency() {
+	m.currency = nil
 }
 
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *GroupMutation) AddedField(name string) (ent.Value, bool) {
-	return nil, false
+// SetDefaultLocationID sets the "default_location_id" field.
+func (m *GroupMutation) SetDefaultLocationID(u uuid.UUID) {
+	m.default_location = &u
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *GroupMutation) AddField(name string, value ent.Value) error {
-	switch name {
+// DefaultLocationID returns the value of the "default_location_id" field in the mutation.
+func (m *GroupMutation) DefaultLocationID() (r uuid.UUID, exists bool) {
+	v := m.default_location
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown Group numeric field %s", name)
+	return *v, true
 }
 
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *GroupMutation) ClearedFields() []string {
-	return nil
+// OldDefaultLocationID returns the old "default_location_id" field's value of the Group entity.
+// If the Group object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GroupMutation) OldDefaultLocationID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDefaultLocationID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDefaultLocationID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDefaultLocationID: %w", err)
+	}
+	return oldValue.DefaultLocationID, nil
 }
 
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *GroupMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
+// ClearDefaultLocationID clears the value of the "default_location_id" field.
+func (m *GroupMutation) ClearDefaultLocationID() {
+	m.default_location = nil
+	m.clearedFields[group.FieldDefaultLocationID] = struct{}{}
+}
+
+// DefaultLocationIDCleared returns if the "default_location_id" field was cleared in this mutation.
+func (m *GroupMutation) DefaultLocationIDCleared() bool {
+	_, ok := m.clearedFields[group.FieldDefaultLocationID]
 	return ok
 }
 
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *GroupMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Group nullable field %s", name)
+// ResetDefaultLocationID resets all changes to the "default_location_id" field.
+func (m *GroupMutation) ResetDefaultLocationID() {
+	m.default_location = nil
+	delete(m.clearedFields, group.FieldDefaultLocationID)
 }
 
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *GroupMutation) ResetField(name string) error {
-	switch name {
-	case group.FieldCreatedAt:
-		m.ResetCreatedAt()
-		return nil
-	case group.FieldUpdatedAt:
-		m.ResetUpdatedAt()
-		return nil
-	case group.FieldName:
-		m.ResetName()
-		return nil
-	case group.FieldCurrency:
-		m.ResetCurrency()
-		return nil
+// SetDepreciationMethod sets the "depreciation_method" field.
+func (m *GroupMutation) SetDepreciationMethod(gm group.DepreciationMethod) {
+	m.depreciation_method = &gm
+}
+
+// DepreciationMethod returns the value of the "depreciation_method" field in the mutation.
+func (m *GroupMutation) DepreciationMethod() (r group.DepreciationMethod, exists bool) {
+	v := m.depreciation_method
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown Group field %s", name)
+	return *v, true
 }
 
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *GroupMutation) AddedEdges() []string {
-	edges := make([]string, 0, 7)
-	if m.users != nil {
-		edges = append(edges, group.EdgeUsers)
+// OldDepreciationMethod returns the old "depreciation_method" field's value of the Group entity.
+// If the Group object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GroupMutation) OldDepreciationMethod(ctx context.Context) (v group.DepreciationMethod, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDepreciationMethod is only allowed on UpdateOne operations")
 	}
-	if m.locations != nil {
-		edges = append(edges, group.EdgeLocations)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDepreciationMethod requires an ID field in the mutation")
 	}
-	if m.items != nil {
-		edges = append(edges, group.EdgeItems)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDepreciationMethod: %w", err)
 	}
-	if m.labels != nil {
-		edges = append(edges, group.EdgeLabels)
+	return oldValue.DepreciationMethod, nil
+}
+
+// ResetDepreciationMethod resets all changes to the "depreciation_method" field.
+func (m *GroupMutation) ResetDepreciationMethod() {
+	m.depreciation_method = nil
+}
+
+// SetDepreciationYears sets the "depreciation_years" field.
+func (m *GroupMutation) SetDepreciationYears(i int) {
+	m.depreciation_years = &i
+	m.adddepreciation_years = nil
+}
+
+// DepreciationYears returns the value of the "depreciation_years" field in the mutation.
+func (m *GroupMutation) DepreciationYears() (r int, exists bool) {
+	v := m.depreciation_years
+	if v == nil {
+		return
 	}
-	if m.documents != nil {
-		edges = append(edges, group.EdgeDocuments)
+	return *v, true
+}
+
+// OldDepreciationYears returns the old "depreciation_years" field's value of the Group entity.
+// If the Group object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GroupMutation) OldDepreciationYears(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDepreciationYears is only allowed on UpdateOne operations")
 	}
-	if m.invitation_tokens != nil {
-		edges = append(edges, group.EdgeInvitationTokens)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDepreciationYears requires an ID field in the mutation")
 	}
-	if m.notifiers != nil {
-		edges = append(edges, group.EdgeNotifiers)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDepreciationYears: %w", err)
 	}
-	return edges
+	return oldValue.DepreciationYears, nil
 }
 
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *GroupMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case group.EdgeUsers:
-		ids := make([]ent.Value, 0, len(m.users))
-		for id := range m.users {
-			ids = append(ids, id)
-		}
-		return ids
-	case group.EdgeLocations:
-		ids := make([]ent.Value, 0, len(m.locations))
-		for id := range m.locations {
-			ids = append(ids, id)
-		}
-		return ids
-	case group.EdgeItems:
-		ids := make([]ent.Value, 0, len(m.items))
-		for id := range m.items {
-			ids = append(ids, id)
-		}
-		return ids
-	case group.EdgeLabels:
-		ids := make([]ent.Value, 0, len(m.labels))
-		for id := range m.labels {
-			ids = append(ids, id)
-		}
-		return ids
-	case group.EdgeDocuments:
-		ids := make([]ent.Value, 0, len(m.documents))
-		for id := range m.documents {
-			ids = append(ids, id)
-		}
-		return ids
-	case group.EdgeInvitationTokens:
-		ids := make([]ent.Value, 0, len(m.invitation_tokens))
-		for id := range m.invitation_tokens {
-			ids = append(ids, id)
-		}
-		return ids
-	case group.EdgeNotifiers:
-		ids := make([]ent.Value, 0, len(m.notifiers))
-		for id := range m.notifiers {
-			ids = append(ids, id)
-		}
-		return ids
+// AddDepreciationYears adds i to the "depreciation_years" field.
+func (m *GroupMutation) AddDepreciationYears(i int) {
+	if m.adddepreciation_years != nil {
+		*m.adddepreciation_years += i
+	} else {
+		m.adddepreciation_years = &i
 	}
-	return nil
 }
 
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *GroupMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 7)
-	if m.removedusers != nil {
-		edges = append(edges, group.EdgeUsers)
-	}
-	if m.removedlocations != nil {
-		edges = append(edges, group.EdgeLocations)
-	}
-	if m.removeditems != nil {
-		edges = append(edges, group.EdgeItems)
-	}
-	if m.removedlabels != nil {
-		edges = append(edges, group.EdgeLabels)
-	}
-	if m.removeddocuments != nil {
-		edges = append(edges, group.EdgeDocuments)
-	}
-	if m.removedinvitation_tokens != nil {
-		edges = append(edges, group.EdgeInvitationTokens)
-	}
-	if m.removednotifiers != nil {
-		edges = append(edges, group.EdgeNotifiers)
+// AddedDepreciationYears returns the value that was added to the "depreciation_years" field in this mutation.
+func (m *GroupMutation) AddedDepreciationYears() (r int, exists bool) {
+	v := m.adddepreciation_years
+	if v == nil {
+		return
 	}
-	return edges
+	return *v, true
 }
 
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *GroupMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case group.EdgeUsers:
-		ids := make([]ent.Value, 0, len(m.removedusers))
-		for id := range m.removedusers {
-			ids = append(ids, id)
-		}
-		return ids
-	case group.EdgeLocations:
-		ids := make([]ent.Value, 0, len(m.removedlocations))
-		for id := range m.removedlocations {
-			ids = append(ids, id)
-		}
-		return ids
-	case group.EdgeItems:
-		ids := make([]ent.Value, 0, len(m.removeditems))
-		for id := range m.removeditems {
-			ids = append(ids, id)
-		}
-		return ids
-	case group.EdgeLabels:
-		ids := make([]ent.Value, 0, len(m.removedlabels))
-		for id := range m.removedlabels {
-			ids = append(ids, id)
-		}
-		return ids
-	case group.EdgeDocuments:
-		ids := make([]ent.Value, 0, len(m.removeddocuments))
-		for id := range m.removeddocuments {
-			ids = append(ids, id)
-		}
-		return ids
-	case group.EdgeInvitationTokens:
-		ids := make([]ent.Value, 0, len(m.removedinvitation_tokens))
-		for id := range m.removedinvitation_tokens {
-			ids = append(ids, id)
-		}
-		return ids
-	case group.EdgeNotifiers:
-		ids := make([]ent.Value, 0, len(m.removednotifiers))
-		for id := range m.removednotifiers {
-			ids = append(ids, id)
-		}
-		return ids
-	}
-	return nil
+// ResetDepreciationYears resets all changes to the "depreciation_years" field.
+func (m *GroupMutation) ResetDepreciationYears() {
+	m.depreciation_years = nil
+	m.adddepreciation_years = nil
 }
 
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *GroupMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 7)
-	if m.clearedusers {
-		edges = append(edges, group.EdgeUsers)
-	}
-	if m.clearedlocations {
-		edges = append(edges, group.EdgeLocations)
-	}
-	if m.cleareditems {
-		edges = append(edges, group.EdgeItems)
-	}
-	if m.clearedlabels {
-		edges = append(edges, group.EdgeLabels)
-	}
-	if m.cleareddocuments {
-		edges = append(edges, group.EdgeDocuments)
-	}
-	if m.clearedinvitation_tokens {
-		edges = append(edges, group.EdgeInvitationTokens)
+// AddUserIDs adds the "users" edge to the User entity by ids.
+func (m *GroupMutation) AddUserIDs(ids ...uuid.UUID) {
+	if m.users == nil {
+		m.users = make(map[uuid.UUID]struct{})
 	}
-	if m.clearednotifiers {
-		edges = append(edges, group.EdgeNotifiers)
+	for i := range ids {
+		m.users[ids[i]] = struct{}{}
 	}
-	return edges
 }
 
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *GroupMutation) EdgeCleared(name string) bool {
-	switch name {
-	case group.EdgeUsers:
-		return m.clearedusers
-	case group.EdgeLocations:
-		return m.clearedlocations
-	case group.EdgeItems:
-		return m.cleareditems
-	case group.EdgeLabels:
-		return m.clearedlabels
-	case group.EdgeDocuments:
-		return m.cleareddocuments
-	case group.EdgeInvitationTokens:
-		return m.clearedinvitation_tokens
-	case group.EdgeNotifiers:
-		return m.clearednotifiers
-	}
-	return false
+// ClearUsers clears the "users" edge to the User entity.
+func (m *GroupMutation) ClearUsers() {
+	m.clearedusers = true
 }
 
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *GroupMutation) ClearEdge(name string) error {
-	switch name {
-	}
-	return fmt.Errorf("unknown Group unique edge %s", name)
+// UsersCleared reports if the "users" edge to the User entity was cleared.
+func (m *GroupMutation) UsersCleared() bool {
+	return m.clearedusers
 }
 
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *GroupMutation) ResetEdge(name string) error {
-	switch name {
-	case group.EdgeUsers:
-		m.ResetUsers()
-		return nil
-	case group.EdgeLocations:
-		m.ResetLocations()
-		return nil
-	case group.EdgeItems:
-		m.ResetItems()
-		return nil
-	case group.EdgeLabels:
-		m.ResetLabels()
-		return nil
-	case group.EdgeDocuments:
-		m.ResetDocuments()
-		return nil
-	case group.EdgeInvitationTokens:
-		m.ResetInvitationTokens()
-		return nil
-	case group.EdgeNotifiers:
-		m.ResetNotifiers()
-		return nil
+// RemoveUserIDs removes the "users" edge to the User entity by IDs.
+func (m *GroupMutation) RemoveUserIDs(ids ...uuid.UUID) {
+	if m.removedusers == nil {
+		m.removedusers = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.users, ids[i])
+		m.removedusers[ids[i]] = struct{}{}
 	}
-	return fmt.Errorf("unknown Group edge %s", name)
 }
 
-// GroupInvitationTokenMutation represents an operation that mutates the GroupInvitationToken nodes in the graph.
-type GroupInvitationTokenMutation struct {
-	config
-	op            Op
-	typ           string
-	id            *uuid.UUID
-	created_at    *time.Time
-	updated_at    *time.Time
-	token         *[]byte
-	expires_at    *time.Time
-	uses          *int
-	adduses       *int
-	clearedFields map[string]struct{}
-	group         *uuid.UUID
-	clearedgroup  bool
-	done          bool
-	oldValue      func(context.Context) (*GroupInvitationToken, error)
-	predicates    []predicate.GroupInvitationToken
+// RemovedUsers returns the removed IDs of the "users" edge to the User entity.
+func (m *GroupMutation) RemovedUsersIDs() (ids []uuid.UUID) {
+	for id := range m.removedusers {
+		ids = append(ids, id)
+	}
+	return
 }
 
-var _ ent.Mutation = (*GroupInvitationTokenMutation)(nil)
+// UsersIDs returns the "users" edge IDs in the mutation.
+func (m *GroupMutation) UsersIDs() (ids []uuid.UUID) {
+	for id := range m.users {
+		ids = append(ids, id)
+	}
+	return
+}
 
-// groupinvitationtokenOption allows management of the mutation configuration using functional options.
-type groupinvitationtokenOption func(*GroupInvitationTokenMutation)
+// ResetUsers resets all changes to the "users" edge.
+func (m *GroupMutation) ResetUsers() {
+	m.users = nil
+	m.clearedusers = false
+	m.removedusers = nil
+}
 
-// newGroupInvitationTokenMutation creates new mutation for the GroupInvitationToken entity.
-func newGroupInvitationTokenMutation(c config, op Op, opts ...groupinvitationtokenOption) *GroupInvitationTokenMutation {
-	m := &GroupInvitationTokenMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeGroupInvitationToken,
-		clearedFields: make(map[string]struct{}),
+// AddLocationIDs adds the "locations" edge to the Location entity by ids.
+func (m *GroupMutation) AddLocationIDs(ids ...uuid.UUID) {
+	if m.locations == nil {
+		m.locations = make(map[uuid.UUID]struct{})
 	}
-	for _, opt := range opts {
-		opt(m)
+	for i := range ids {
+		m.locations[ids[i]] = struct{}{}
 	}
-	return m
 }
 
-// withGroupInvitationTokenID sets the ID field of the mutation.
-func withGroupInvitationTokenID(id uuid.UUID) groupinvitationtokenOption {
-	return func(m *GroupInvitationTokenMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *GroupInvitationToken
-		)
-		m.oldValue = func(ctx context.Context) (*GroupInvitationToken, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().GroupInvitationToken.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
-	}
+// ClearLocations clears the "locations" edge to the Location entity.
+func (m *GroupMutation) ClearLocations() {
+	m.clearedlocations = true
 }
 
-// withGroupInvitationToken sets the old GroupInvitationToken of the mutation.
-func withGroupInvitationToken(node *GroupInvitationToken) groupinvitationtokenOption {
-	return func(m *GroupInvitationTokenMutation) {
-		m.oldValue = func(context.Context) (*GroupInvitationToken, error) {
-			return node, nil
-		}
-		m.id = &node.ID
-	}
+// LocationsCleared reports if the "locations" edge to the Location entity was cleared.
+func (m *GroupMutation) LocationsCleared() bool {
+	return m.clearedlocations
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m GroupInvitationTokenMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
+// RemoveLocationIDs removes the "locations" edge to the Location entity by IDs.
+func (m *GroupMutation) RemoveLocationIDs(ids ...uuid.UUID) {
+	if m.removedlocations == nil {
+		m.removedlocations = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.locations, ids[i])
+		m.removedlocations[ids[i]] = struct{}{}
+	}
 }
 
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m GroupInvitationTokenMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
+// RemovedLocations returns the removed IDs of the "locations" edge to the Location entity.
+func (m *GroupMutation) RemovedLocationsIDs() (ids []uuid.UUID) {
+	for id := range m.removedlocations {
+		ids = append(ids, id)
 	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
+	return
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of GroupInvitationToken entities.
-func (m *GroupInvitationTokenMutation) SetID(id uuid.UUID) {
-	m.id = &id
+// LocationsIDs returns the "locations" edge IDs in the mutation.
+func (m *GroupMutation) LocationsIDs() (ids []uuid.UUID) {
+	for id := range m.locations {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *GroupInvitationTokenMutation) ID() (id uuid.UUID, exists bool) {
-	if m.id == nil {
-		return
-	}
-	return *m.id, true
+// ResetLocations resets all changes to the "locations" edge.
+func (m *GroupMutation) ResetLocations() {
+	m.locations = nil
+	m.clearedlocations = false
+	m.removedlocations = nil
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *GroupInvitationTokenMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []uuid.UUID{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().GroupInvitationToken.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+// AddItemIDs adds the "items" edge to the Item entity by ids.
+func (m *GroupMutation) AddItemIDs(ids ...uuid.UUID) {
+	if m.items == nil {
+		m.items = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.items[ids[i]] = struct{}{}
 	}
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *GroupInvitationTokenMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// ClearItems clears the "items" edge to the Item entity.
+func (m *GroupMutation) ClearItems() {
+	m.cleareditems = true
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *GroupInvitationTokenMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
-	if v == nil {
-		return
-	}
-	return *v, true
+// ItemsCleared reports if the "items" edge to the Item entity was cleared.
+func (m *GroupMutation) ItemsCleared() bool {
+	return m.cleareditems
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the GroupInvitationToken entity.
-// If the GroupInvitationToken object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GroupInvitationTokenMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+// RemoveItemIDs removes the "items" edge to the Item entity by IDs.
+func (m *GroupMutation) RemoveItemIDs(ids ...uuid.UUID) {
+	if m.removeditems == nil {
+		m.removeditems = make(map[uuid.UUID]struct{})
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	for i := range ids {
+		delete(m.items, ids[i])
+		m.removeditems[ids[i]] = struct{}{}
 	}
-	return oldValue.CreatedAt, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *GroupInvitationTokenMutation) ResetCreatedAt() {
-	m.created_at = nil
+// RemovedItems returns the removed IDs of the "items" edge to the Item entity.
+func (m *GroupMutation) RemovedItemsIDs() (ids []uuid.UUID) {
+	for id := range m.removeditems {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *GroupInvitationTokenMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// ItemsIDs returns the "items" edge IDs in the mutation.
+func (m *GroupMutation) ItemsIDs() (ids []uuid.UUID) {
+	for id := range m.items {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *GroupInvitationTokenMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
-	if v == nil {
-		return
-	}
-	return *v, true
+// ResetItems resets all changes to the "items" edge.
+func (m *GroupMutation) ResetItems() {
+	m.items = nil
+	m.cleareditems = false
+	m.removeditems = nil
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the GroupInvitationToken entity.
-// If the GroupInvitationToken object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GroupInvitationTokenMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+// AddLabelIDs adds the "labels" edge to the Label entity by ids.
+func (m *GroupMutation) AddLabelIDs(ids ...uuid.UUID) {
+	if m.labels == nil {
+		m.labels = make(map[uuid.UUID]struct{})
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	for i := range ids {
+		m.labels[ids[i]] = struct{}{}
 	}
-	return oldValue.UpdatedAt, nil
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *GroupInvitationTokenMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// ClearLabels clears the "labels" edge to the Label entity.
+func (m *GroupMutation) ClearLabels() {
+	m.clearedlabels = true
 }
 
-// SetToken sets the "token" field.
-func (m *GroupInvitationTokenMutation) SetToken(b []byte) {
-	m.token = &b
+// LabelsCleared reports if the "labels" edge to the Label entity was cleared.
+func (m *GroupMutation) LabelsCleared() bool {
+	return m.clearedlabels
 }
 
-// Token returns the value of the "token" field in the mutation.
-func (m *GroupInvitationTokenMutation) Token() (r []byte, exists bool) {
-	v := m.token
-	if v == nil {
-		return
+// RemoveLabelIDs removes the "labels" edge to the Label entity by IDs.
+func (m *GroupMutation) RemoveLabelIDs(ids ...uuid.UUID) {
+	if m.removedlabels == nil {
+		m.removedlabels = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.labels, ids[i])
+		m.removedlabels[ids[i]] = struct{}{}
 	}
-	return *v, true
 }
 
-// OldToken returns the old "token" field's value of the GroupInvitationToken entity.
-// If the GroupInvitationToken object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GroupInvitationTokenMutation) OldToken(ctx context.Context) (v []byte, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldToken is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldToken requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldToken: %w", err)
+// RemovedLabels returns the removed IDs of the "labels" edge to the Label entity.
+func (m *GroupMutation) RemovedLabelsIDs() (ids []uuid.UUID) {
+	for id := range m.removedlabels {
+		ids = append(ids, id)
 	}
-	return oldValue.Token, nil
+	return
 }
 
-// ResetToken resets all changes to the "token" field.
-func (m *GroupInvitationTokenMutation) ResetToken() {
-	m.token = nil
+// LabelsIDs returns the "labels" edge IDs in the mutation.
+func (m *GroupMutation) LabelsIDs() (ids []uuid.UUID) {
+	for id := range m.labels {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// SetExpiresAt sets the "expires_at" field.
-func (m *GroupInvitationTokenMutation) SetExpiresAt(t time.Time) {
-	m.expires_at = &t
+// ResetLabels resets all changes to the "labels" edge.
+func (m *GroupMutation) ResetLabels() {
+	m.labels = nil
+	m.clearedlabels = false
+	m.removedlabels = nil
 }
 
-// ExpiresAt returns the value of the "expires_at" field in the mutation.
-func (m *GroupInvitationTokenMutation) ExpiresAt() (r time.Time, exists bool) {
-	v := m.expires_at
-	if v == nil {
-		return
+// AddDocumentIDs adds the "documents" edge to the Document entity by ids.
+func (m *GroupMutation) AddDocumentIDs(ids ...uuid.UUID) {
+	if m.documents == nil {
+		m.documents = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.documents[ids[i]] = struct{}{}
 	}
-	return *v, true
 }
 
-// OldExpiresAt returns the old "expires_at" field's value of the GroupInvitationToken entity.
-// If the GroupInvitationToken object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GroupInvitationTokenMutation) OldExpiresAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+// ClearDocuments clears the "documents" edge to the Document entity.
+func (m *GroupMutation) ClearDocuments() {
+	m.cleareddocuments = true
+}
+
+// DocumentsCleared reports if the "documents" edge to the Document entity was cleared.
+func (m *GroupMutation) DocumentsCleared() bool {
+	return m.cleareddocuments
+}
+
+// RemoveDocumentIDs removes the "documents" edge to the Document entity by IDs.
+func (m *GroupMutation) RemoveDocumentIDs(ids ...uuid.UUID) {
+	if m.removeddocuments == nil {
+		m.removeddocuments = make(map[uuid.UUID]struct{})
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+	for i := range ids {
+		delete(m.documents, ids[i])
+		m.removeddocuments[ids[i]] = struct{}{}
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+}
+
+// RemovedDocuments returns the removed IDs of the "documents" edge to the Document entity.
+func (m *GroupMutation) RemovedDocumentsIDs() (ids []uuid.UUID) {
+	for id := range m.removeddocuments {
+		ids = append(ids, id)
 	}
-	return oldValue.ExpiresAt, nil
+	return
 }
 
-// ResetExpiresAt resets all changes to the "expires_at" field.
-func (m *GroupInvitationTokenMutation) ResetExpiresAt() {
-	m.expires_at = nil
+// DocumentsIDs returns the "documents" edge IDs in the mutation.
+func (m *GroupMutation) DocumentsIDs() (ids []uuid.UUID) {
+	for id := range m.documents {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// SetUses sets the "uses" field.
-func (m *GroupInvitationTokenMutation) SetUses(i int) {
-	m.uses = &i
-	m.adduses = nil
+// ResetDocuments resets all changes to the "documents" edge.
+func (m *GroupMutation) ResetDocuments() {
+	m.documents = nil
+	m.cleareddocuments = false
+	m.removeddocuments = nil
 }
 
-// Uses returns the value of the "uses" field in the mutation.
-func (m *GroupInvitationTokenMutation) Uses() (r int, exists bool) {
-	v := m.uses
-	if v == nil {
-		return
+// AddInvitationTokenIDs adds the "invitation_tokens" edge to the GroupInvitationToken entity by ids.
+func (m *GroupMutation) AddInvitationTokenIDs(ids ...uuid.UUID) {
+	if m.invitation_tokens == nil {
+		m.invitation_tokens = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.invitation_tokens[ids[i]] = struct{}{}
 	}
-	return *v, true
 }
 
-// OldUses returns the old "uses" field's value of the GroupInvitationToken entity.
-// If the GroupInvitationToken object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GroupInvitationTokenMutation) OldUses(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUses is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUses requires an ID field in the mutation")
+// ClearInvitationTokens clears the "invitation_tokens" edge to the GroupInvitationToken entity.
+func (m *GroupMutation) ClearInvitationTokens() {
+	m.clearedinvitation_tokens = true
+}
+
+// InvitationTokensCleared reports if the "invitation_tokens" edge to the GroupInvitationToken entity was cleared.
+func (m *GroupMutation) InvitationTokensCleared() bool {
+	return m.clearedinvitation_tokens
+}
+
+// RemoveInvitationTokenIDs removes the "invitation_tokens" edge to the GroupInvitationToken entity by IDs.
+func (m *GroupMutation) RemoveInvitationTokenIDs(ids ...uuid.UUID) {
+	if m.removedinvitation_tokens == nil {
+		m.removedinvitation_tokens = make(map[uuid.UUID]struct{})
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUses: %w", err)
+	for i := range ids {
+		delete(m.invitation_tokens, ids[i])
+		m.removedinvitation_tokens[ids[i]] = struct{}{}
 	}
-	return oldValue.Uses, nil
 }
 
-// AddUses adds i to the "uses" field.
-func (m *GroupInvitationTokenMutation) AddUses(i int) {
-	if m.adduses != nil {
-		*m.adduses += i
-	} else {
-		m.adduses = &i
+// RemovedInvitationTokens returns the removed IDs of the "invitation_tokens" edge to the GroupInvitationToken entity.
+func (m *GroupMutation) RemovedInvitationTokensIDs() (ids []uuid.UUID) {
+	for id := range m.removedinvitation_tokens {
+		ids = append(ids, id)
 	}
+	return
 }
 
-// AddedUses returns the value that was added to the "uses" field in this mutation.
-func (m *GroupInvitationTokenMutation) AddedUses() (r int, exists bool) {
-	v := m.adduses
-	if v == nil {
-		return
+// InvitationTokensIDs returns the "invitation_tokens" edge IDs in the mutation.
+func (m *GroupMutation) InvitationTokensIDs() (ids []uuid.UUID) {
+	for id := range m.invitation_tokens {
+		ids = append(ids, id)
 	}
-	return *v, true
+	return
 }
 
-// ResetUses resets all changes to the "uses" field.
-func (m *GroupInvitationTokenMutation) ResetUses() {
-	m.uses = nil
-	m.adduses = nil
+// ResetInvitationTokens resets all changes to the "invitation_tokens" edge.
+func (m *GroupMutation) ResetInvitationTokens() {
+	m.invitation_tokens = nil
+	m.clearedinvitation_tokens = false
+	m.removedinvitation_tokens = nil
 }
 
-// SetGroupID sets the "group" edge to the Group entity by id.
-func (m *GroupInvitationTokenMutation) SetGroupID(id uuid.UUID) {
-	m.group = &id
+// AddNotifierIDs adds the "notifiers" edge to the Notifier entity by ids.
+func (m *GroupMutation) AddNotifierIDs(ids ...uuid.UUID) {
+	if m.notifiers == nil {
+		m.notifiers = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.notifiers[ids[i]] = struct{}{}
+	}
 }
 
-// ClearGroup clears the "group" edge to the Group entity.
-func (m *GroupInvitationTokenMutation) ClearGroup() {
-	m.clearedgroup = true
+// ClearNotifiers clears the "notifiers" edge to the Notifier entity.
+func (m *GroupMutation) ClearNotifiers() {
+	m.clearednotifiers = true
 }
 
-// GroupCleared reports if the "group" edge to the Group entity was cleared.
-func (m *GroupInvitationTokenMutation) GroupCleared() bool {
-	return m.clearedgroup
+// NotifiersCleared reports if the "notifiers" edge to the Notifier entity was cleared.
+func (m *GroupMutation) NotifiersCleared() bool {
+	return m.clearednotifiers
 }
 
-// GroupID returns the "group" edge ID in the mutation.
-func (m *GroupInvitationTokenMutation) GroupID() (id uuid.UUID, exists bool) {
-	if m.group != nil {
-		return *m.group, true
+// RemoveNotifierIDs removes the "notifiers" edge to the Notifier entity by IDs.
+func (m *GroupMutation) RemoveNotifierIDs(ids ...uuid.UUID) {
+	if m.removednotifiers == nil {
+		m.removednotifiers = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.notifiers, ids[i])
+		m.removednotifiers[ids[i]] = struct{}{}
 	}
-	return
 }
 
-// GroupIDs returns the "group" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// GroupID instead. It exists only for internal usage by the builders.
-func (m *GroupInvitationTokenMutation) GroupIDs() (ids []uuid.UUID) {
-	if id := m.group; id != nil {
-		ids = append(ids, *id)
+// RemovedNotifiers returns the removed IDs of the "notifiers" edge to the Notifier entity.
+func (m *GroupMutation) RemovedNotifiersIDs() (ids []uuid.UUID) {
+	for id := range m.removednotifiers {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetGroup resets all changes to the "group" edge.
-func (m *GroupInvitationTokenMutation) ResetGroup() {
-	m.group = nil
-	m.clearedgroup = false
+// NotifiersIDs returns the "notifiers" edge IDs in the mutation.
+func (m *GroupMutation) NotifiersIDs() (ids []uuid.UUID) {
+	for id := range m.notifiers {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// Where appends a list predicates to the GroupInvitationTokenMutation builder.
-func (m *GroupInvitationTokenMutation) Where(ps ...predicate.GroupInvitationToken) {
-	m.predicates = append(m.predicates, ps...)
+// ResetNotifiers resets all changes to the "notifiers" edge.
+func (m *GroupMutation) ResetNotifiers() {
+	m.notifiers = nil
+	m.clearednotifiers = false
+	m.removednotifiers = nil
 }
 
-// WhereP appends storage-level predicates to the GroupInvitationTokenMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *GroupInvitationTokenMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.GroupInvitationToken, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// AddCurrencyConversionIDs adds the "currency_conversions" edge to the CurrencyConversion entity by ids.
+func (m *GroupMutation) AddCurrencyConversionIDs(ids ...uuid.UUID) {
+	if m.currency_conversions == nil {
+		m.currency_conversions = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.currency_conversions[ids[i]] = struct{}{}
 	}
-	m.Where(p...)
 }
 
-// Op returns the operation name.
-func (m *GroupInvitationTokenMutation) Op() Op {
-	return m.op
+// ClearCurrencyConversions clears the "currency_conversions" edge to the CurrencyConversion entity.
+func (m *GroupMutation) ClearCurrencyConversions() {
+	m.clearedcurrency_conversions = true
 }
 
-// SetOp allows setting the mutation operation.
-func (m *GroupInvitationTokenMutation) SetOp(op Op) {
-	m.op = op
+// CurrencyConversionsCleared reports if the "currency_conversions" edge to the CurrencyConversion entity was cleared.
+func (m *GroupMutation) CurrencyConversionsCleared() bool {
+	return m.clearedcurrency_conversions
 }
 
-// Type returns the node type of this mutation (GroupInvitationToken).
-func (m *GroupInvitationTokenMutation) Type() string {
-	return m.typ
+// RemoveCurrencyConversionIDs removes the "currency_conversions" edge to the CurrencyConversion entity by IDs.
+func (m *GroupMutation) RemoveCurrencyConversionIDs(ids ...uuid.UUID) {
+	if m.removedcurrency_conversions == nil {
+		m.removedcurrency_conversions = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.currency_conversions, ids[i])
+		m.removedcurrency_conversions[ids[i]] = struct{}{}
+	}
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *GroupInvitationTokenMutation) Fields() []string {
-	fields := make([]string, 0, 5)
-	if m.created_at != nil {
-		fields = append(fields, groupinvitationtoken.FieldCreatedAt)
-	}
-	if m.updated_at != nil {
-		fields = append(fields, groupinvitationtoken.FieldUpdatedAt)
+// RemovedCurrencyConversions returns the removed IDs of the "currency_conversions" edge to the CurrencyConversion entity.
+func (m *GroupMutation) RemovedCurrencyConversionsIDs() (ids []uuid.UUID) {
+	for id := range m.removedcurrency_conversions {
+		ids = append(ids, id)
 	}
-	if m.token != nil {
-		fields = append(fields, groupinvitationtoken.FieldToken)
+	return
+}
+
+// CurrencyConversionsIDs returns the "currency_conversions" edge IDs in the mutation.
+func (m *GroupMutation) CurrencyConversionsIDs() (ids []uuid.UUID) {
+	for id := range m.currency_conversions {
+		ids = append(ids, id)
 	}
-	if m.expires_at != nil {
-		fields = append(fields, groupinvitationtoken.FieldExpiresAt)
+	return
+}
+
+// ResetCurrencyConversions resets all changes to the "currency_conversions" edge.
+func (m *GroupMutation) ResetCurrencyConversions() {
+	m.currency_conversions = nil
+	m.clearedcurrency_conversions = false
+	m.removedcurrency_conversions = nil
+}
+
+// AddItemEventIDs adds the "item_events" edge to the ItemEvent entity by ids.
+func (m *GroupMutation) AddItemEventIDs(ids ...uuid.UUID) {
+	if m.item_events == nil {
+		m.item_events = make(map[uuid.UUID]struct{})
 	}
-	if m.uses != nil {
-		fields = append(fields, groupinvitationtoken.FieldUses)
+	for i := range ids {
+		m.item_events[ids[i]] = struct{}{}
 	}
-	return fields
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *GroupInvitationTokenMutation) Field(name string) (ent.Value, bool) {
+// ClearItemEvents clears the "item_events" edge to the ItemEvent entity.
+func (m *GroupMutation) ClearItemEvents() {
+	m.cleareditem_events = true
+}
+
+// ItemEventsCleared reports if the "item_events" edge to the ItemEvent entity was cleared.
+func (m *GroupMutation) ItemEventsCleared() bool {
+	return m.cleareditem_events
+}
+
+// RemoveItemEventIDs removes the "item_events" edge to the ItemEvent entity by IDs.
+func (m *GroupMutation) RemoveItemEventIDs(ids ...uuid.UUID) {
+	if m.removeditem_events == nil {
+		m.removeditem_events = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.item_events, ids[i])
+		m.removeditem_events[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedItemEvents returns the removed IDs of the "item_events" edge to the ItemEvent entity.
+func (m *GroupMutation) RemovedItemEventsIDs() (ids []uuid.UUID) {
+	for id := range m.removeditem_events {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ItemEventsIDs returns the "item_events" edge IDs in the mutation.
+func (m *GroupMutation) ItemEventsIDs() (ids []uuid.UUID) {
+	for id := range m.item_events {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetItemEvents resets all changes to the "item_events" edge.
+func (m *GroupMutation) ResetItemEvents() {
+	m.item_events = nil
+	m.cleareditem_events = false
+	m.removeditem_events = nil
+}
+
+// AddSavedSearchIDs adds the "saved_searches" edge to the SavedSearch entity by ids.
+func (m *GroupMutation) AddSavedSearchIDs(ids ...uuid.UUID) {
+	if m.saved_searches == nil {
+		m.saved_searches = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.saved_searches[ids[i]] = struct{}{}
+	}
+}
+
+// ClearSavedSearches clears the "saved_searches" edge to the SavedSearch entity.
+func (m *GroupMutation) ClearSavedSearches() {
+	m.clearedsaved_searches = true
+}
+
+// SavedSearchesCleared reports if the "saved_searches" edge to the SavedSearch entity was cleared.
+func (m *GroupMutation) SavedSearchesCleared() bool {
+	return m.clearedsaved_searches
+}
+
+// RemoveSavedSearchIDs removes the "saved_searches" edge to the SavedSearch entity by IDs.
+func (m *GroupMutation) RemoveSavedSearchIDs(ids ...uuid.UUID) {
+	if m.removedsaved_searches == nil {
+		m.removedsaved_searches = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.saved_searches, ids[i])
+		m.removedsaved_searches[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedSavedSearches returns the removed IDs of the "saved_searches" edge to the SavedSearch entity.
+func (m *GroupMutation) RemovedSavedSearchesIDs() (ids []uuid.UUID) {
+	for id := range m.removedsaved_searches {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// SavedSearchesIDs returns the "saved_searches" edge IDs in the mutation.
+func (m *GroupMutation) SavedSearchesIDs() (ids []uuid.UUID) {
+	for id := range m.saved_searches {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetSavedSearches resets all changes to the "saved_searches" edge.
+func (m *GroupMutation) ResetSavedSearches() {
+	m.saved_searches = nil
+	m.clearedsaved_searches = false
+	m.removedsaved_searches = nil
+}
+
+// AddItemTemplateIDs adds the "item_templates" edge to the ItemTemplate entity by ids.
+func (m *GroupMutation) AddItemTemplateIDs(ids ...uuid.UUID) {
+	if m.item_templates == nil {
+		m.item_templates = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.item_templates[ids[i]] = struct{}{}
+	}
+}
+
+// ClearItemTemplates clears the "item_templates" edge to the ItemTemplate entity.
+func (m *GroupMutation) ClearItemTemplates() {
+	m.cleareditem_templates = true
+}
+
+// ItemTemplatesCleared reports if the "item_templates" edge to the ItemTemplate entity was cleared.
+func (m *GroupMutation) ItemTemplatesCleared() bool {
+	return m.cleareditem_templates
+}
+
+// RemoveItemTemplateIDs removes the "item_templates" edge to the ItemTemplate entity by IDs.
+func (m *GroupMutation) RemoveItemTemplateIDs(ids ...uuid.UUID) {
+	if m.removeditem_templates == nil {
+		m.removeditem_templates = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.item_templates, ids[i])
+		m.removeditem_templates[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedItemTemplates returns the removed IDs of the "item_templates" edge to the ItemTemplate entity.
+func (m *GroupMutation) RemovedItemTemplatesIDs() (ids []uuid.UUID) {
+	for id := range m.removeditem_templates {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ItemTemplatesIDs returns the "item_templates" edge IDs in the mutation.
+func (m *GroupMutation) ItemTemplatesIDs() (ids []uuid.UUID) {
+	for id := range m.item_templates {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetItemTemplates resets all changes to the "item_templates" edge.
+func (m *GroupMutation) ResetItemTemplates() {
+	m.item_templates = nil
+	m.cleareditem_templates = false
+	m.removeditem_templates = nil
+}
+
+// ClearDefaultLocation clears the "default_location" edge to the Location entity.
+func (m *GroupMutation) ClearDefaultLocation() {
+	m.cleareddefault_location = true
+	m.clearedFields[group.FieldDefaultLocationID] = struct{}{}
+}
+
+// DefaultLocationCleared reports if the "default_location" edge to the Location entity was cleared.
+func (m *GroupMutation) DefaultLocationCleared() bool {
+	return m.DefaultLocationIDCleared() || m.cleareddefault_location
+}
+
+// DefaultLocationIDs returns the "default_location" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// DefaultLocationID instead. It exists only for internal usage by the builders.
+func (m *GroupMutation) DefaultLocationIDs() (ids []uuid.UUID) {
+	if id := m.default_location; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetDefaultLocation resets all changes to the "default_location" edge.
+func (m *GroupMutation) ResetDefaultLocation() {
+	m.default_location = nil
+	m.cleareddefault_location = false
+}
+
+// Where appends a list predicates to the GroupMutation builder.
+func (m *GroupMutation) Where(ps ...predicate.Group) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the GroupMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *GroupMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Group, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *GroupMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *GroupMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Group).
+func (m *GroupMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *GroupMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.created_at != nil {
+		fields = append(fields, group.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, group.FieldUpdatedAt)
+	}
+	if m.name != nil {
+		fields = append(fields, group.FieldName)
+	}
+	if m.currency != nil {
+		fields = append(fields, group.FieldCurrency)
+	}
+	if m.default_location != nil {
+		fields = append(fields, group.FieldDefaultLocationID)
+	}
+	if m.depreciation_method != nil {
+		fields = append(fields, group.FieldDepreciationMethod)
+	}
+	if m.depreciation_years != nil {
+		fields = append(fields, group.FieldDepreciationYears)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *GroupMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case groupinvitationtoken.FieldCreatedAt:
+	case group.FieldCreatedAt:
 		return m.CreatedAt()
-	case groupinvitationtoken.FieldUpdatedAt:
+	case group.FieldUpdatedAt:
 		return m.UpdatedAt()
-	case groupinvitationtoken.FieldToken:
-		return m.Token()
-	case groupinvitationtoken.FieldExpiresAt:
-		return m.ExpiresAt()
-	case groupinvitationtoken.FieldUses:
-		return m.Uses()
+	case group.FieldName:
+		return m.Name()
+	case group.FieldCurrency:
+		return m.Currency()
+	case group.FieldDefaultLocationID:
+		return m.DefaultLocationID()
+	case group.FieldDepreciationMethod:
+		return m.DepreciationMethod()
+	case group.FieldDepreciationYears:
+		return m.DepreciationYears()
 	}
 	return nil, false
 }
@@ -3854,72 +4288,90 @@ func (m *GroupInvitationTokenMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *GroupInvitationTokenMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *GroupMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case groupinvitationtoken.FieldCreatedAt:
+	case group.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case groupinvitationtoken.FieldUpdatedAt:
+	case group.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
-	case groupinvitationtoken.FieldToken:
-		return m.OldToken(ctx)
-	case groupinvitationtoken.FieldExpiresAt:
-		return m.OldExpiresAt(ctx)
-	case groupinvitationtoken.FieldUses:
-		return m.OldUses(ctx)
+	case group.FieldName:
+		return m.OldName(ctx)
+	case group.FieldCurrency:
+		return m.OldCurrency(ctx)
+	case group.FieldDefaultLocationID:
+		return m.OldDefaultLocationID(ctx)
+	case group.FieldDepreciationMethod:
+		return m.OldDepreciationMethod(ctx)
+	case group.FieldDepreciationYears:
+		return m.OldDepreciationYears(ctx)
 	}
-	return nil, fmt.Errorf("unknown GroupInvitationToken field %s", name)
+	return nil, fmt.Errorf("unknown Group field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *GroupInvitationTokenMutation) SetField(name string, value ent.Value) error {
+func (m *GroupMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case groupinvitationtoken.FieldCreatedAt:
+	case group.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case groupinvitationtoken.FieldUpdatedAt:
+	case group.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdatedAt(v)
 		return nil
-	case groupinvitationtoken.FieldToken:
-		v, ok := value.([]byte)
+	case group.FieldName:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetToken(v)
+		m.SetName(v)
 		return nil
-	case groupinvitationtoken.FieldExpiresAt:
-		v, ok := value.(time.Time)
+	case group.FieldCurrency:
+		v, ok := value.(group.Currency)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetExpiresAt(v)
+		m.SetCurrency(v)
 		return nil
-	case groupinvitationtoken.FieldUses:
+	case group.FieldDefaultLocationID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDefaultLocationID(v)
+		return nil
+	case group.FieldDepreciationMethod:
+		v, ok := value.(group.DepreciationMethod)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDepreciationMethod(v)
+		return nil
+	case group.FieldDepreciationYears:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUses(v)
+		m.SetDepreciationYears(v)
 		return nil
 	}
-	return fmt.Errorf("unknown GroupInvitationToken field %s", name)
+	return fmt.Errorf("unknown Group field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *GroupInvitationTokenMutation) AddedFields() []string {
+func (m *GroupMutation) AddedFields() []string {
 	var fields []string
-	if m.adduses != nil {
-		fields = append(fields, groupinvitationtoken.FieldUses)
+	if m.adddepreciation_years != nil {
+		fields = append(fields, group.FieldDepreciationYears)
 	}
 	return fields
 }
@@ -3927,10 +4379,10 @@ func (m *GroupInvitationTokenMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *GroupInvitationTokenMutation) AddedField(name string) (ent.Value, bool) {
+func (m *GroupMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case groupinvitationtoken.FieldUses:
-		return m.AddedUses()
+	case group.FieldDepreciationYears:
+		return m.AddedDepreciationYears()
 	}
 	return nil, false
 }
@@ -3938,76 +4390,190 @@ func (m *GroupInvitationTokenMutation) AddedField(name string) (ent.Value, bool)
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *GroupInvitationTokenMutation) AddField(name string, value ent.Value) error {
+func (m *GroupMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case groupinvitationtoken.FieldUses:
+	case group.FieldDepreciationYears:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddUses(v)
+		m.AddDepreciationYears(v)
 		return nil
 	}
-	return fmt.Errorf("unknown GroupInvitationToken numeric field %s", name)
+	return fmt.Errorf("unknown Group numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *GroupInvitationTokenMutation) ClearedFields() []string {
-	return nil
+func (m *GroupMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(group.FieldDefaultLocationID) {
+		fields = append(fields, group.FieldDefaultLocationID)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *GroupInvitationTokenMutation) FieldCleared(name string) bool {
+func (m *GroupMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *GroupInvitationTokenMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown GroupInvitationToken nullable field %s", name)
+func (m *GroupMutation) ClearField(name string) error {
+	switch name {
+	case group.FieldDefaultLocationID:
+		m.ClearDefaultLocationID()
+		return nil
+	}
+	return fmt.Errorf("unknown Group nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *GroupInvitationTokenMutation) ResetField(name string) error {
+func (m *GroupMutation) ResetField(name string) error {
 	switch name {
-	case groupinvitationtoken.FieldCreatedAt:
+	case group.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case groupinvitationtoken.FieldUpdatedAt:
+	case group.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
-	case groupinvitationtoken.FieldToken:
-		m.ResetToken()
+	case group.FieldName:
+		m.ResetName()
 		return nil
-	case groupinvitationtoken.FieldExpiresAt:
-		m.ResetExpiresAt()
+	case group.FieldCurrency:
+		m.ResetCurrency()
 		return nil
-	case groupinvitationtoken.FieldUses:
-		m.ResetUses()
+	case group.FieldDefaultLocationID:
+		m.ResetDefaultLocationID()
+		return nil
+	case group.FieldDepreciationMethod:
+		m.ResetDepreciationMethod()
+		return nil
+	case group.FieldDepreciationYears:
+		m.ResetDepreciationYears()
 		return nil
 	}
-	return fmt.Errorf("unknown GroupInvitationToken field %s", name)
+	return fmt.Errorf("unknown Group field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *GroupInvitationTokenMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.group != nil {
-		edges = append(edges, groupinvitationtoken.EdgeGroup)
+func (m *GroupMutation) AddedEdges() []string {
+	edges := make([]string, 0, 12)
+	if m.users != nil {
+		edges = append(edges, group.EdgeUsers)
+	}
+	if m.locations != nil {
+		edges = append(edges, group.EdgeLocations)
+	}
+	if m.items != nil {
+		edges = append(edges, group.EdgeItems)
+	}
+	if m.labels != nil {
+		edges = append(edges, group.EdgeLabels)
+	}
+	if m.documents != nil {
+		edges = append(edges, group.EdgeDocuments)
+	}
+	if m.invitation_tokens != nil {
+		edges = append(edges, group.EdgeInvitationTokens)
+	}
+	if m.notifiers != nil {
+		edges = append(edges, group.EdgeNotifiers)
+	}
+	if m.currency_conversions != nil {
+		edges = append(edges, group.EdgeCurrencyConversions)
+	}
+	if m.item_events != nil {
+		edges = append(edges, group.EdgeItemEvents)
+	}
+	if m.saved_searches != nil {
+		edges = append(edges, group.EdgeSavedSearches)
+	}
+	if m.item_templates != nil {
+		edges = append(edges, group.EdgeItemTemplates)
+	}
+	if m.default_location != nil {
+		edges = append(edges, group.EdgeDefaultLocation)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *GroupInvitationTokenMutation) AddedIDs(name string) []ent.Value {
+func (m *GroupMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case groupinvitationtoken.EdgeGroup:
-		if id := m.group; id != nil {
+	case group.EdgeUsers:
+		ids := make([]ent.Value, 0, len(m.users))
+		for id := range m.users {
+			ids = append(ids, id)
+		}
+		return ids
+	case group.EdgeLocations:
+		ids := make([]ent.Value, 0, len(m.locations))
+		for id := range m.locations {
+			ids = append(ids, id)
+		}
+		return ids
+	case group.EdgeItems:
+		ids := make([]ent.Value, 0, len(m.items))
+		for id := range m.items {
+			ids = append(ids, id)
+		}
+		return ids
+	case group.EdgeLabels:
+		ids := make([]ent.Value, 0, len(m.labels))
+		for id := range m.labels {
+			ids = append(ids, id)
+		}
+		return ids
+	case group.EdgeDocuments:
+		ids := make([]ent.Value, 0, len(m.documents))
+		for id := range m.documents {
+			ids = append(ids, id)
+		}
+		return ids
+	case group.EdgeInvitationTokens:
+		ids := make([]ent.Value, 0, len(m.invitation_tokens))
+		for id := range m.invitation_tokens {
+			ids = append(ids, id)
+		}
+		return ids
+	case group.EdgeNotifiers:
+		ids := make([]ent.Value, 0, len(m.notifiers))
+		for id := range m.notifiers {
+			ids = append(ids, id)
+		}
+		return ids
+	case group.EdgeCurrencyConversions:
+		ids := make([]ent.Value, 0, len(m.currency_conversions))
+		for id := range m.currency_conversions {
+			ids = append(ids, id)
+		}
+		return ids
+	case group.EdgeItemEvents:
+		ids := make([]ent.Value, 0, len(m.item_events))
+		for id := range m.item_events {
+			ids = append(ids, id)
+		}
+		return ids
+	case group.EdgeSavedSearches:
+		ids := make([]ent.Value, 0, len(m.saved_searches))
+		for id := range m.saved_searches {
+			ids = append(ids, id)
+		}
+		return ids
+	case group.EdgeItemTemplates:
+		ids := make([]ent.Value, 0, len(m.item_templates))
+		for id := range m.item_templates {
+			ids = append(ids, id)
+		}
+		return ids
+	case group.EdgeDefaultLocation:
+		if id := m.default_location; id != nil {
 			return []ent.Value{*id}
 		}
 	}
@@ -4015,129 +4581,278 @@ func (m *GroupInvitationTokenMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *GroupInvitationTokenMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *GroupMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 12)
+	if m.removedusers != nil {
+		edges = append(edges, group.EdgeUsers)
+	}
+	if m.removedlocations != nil {
+		edges = append(edges, group.EdgeLocations)
+	}
+	if m.removeditems != nil {
+		edges = append(edges, group.EdgeItems)
+	}
+	if m.removedlabels != nil {
+		edges = append(edges, group.EdgeLabels)
+	}
+	if m.removeddocuments != nil {
+		edges = append(edges, group.EdgeDocuments)
+	}
+	if m.removedinvitation_tokens != nil {
+		edges = append(edges, group.EdgeInvitationTokens)
+	}
+	if m.removednotifiers != nil {
+		edges = append(edges, group.EdgeNotifiers)
+	}
+	if m.removedcurrency_conversions != nil {
+		edges = append(edges, group.EdgeCurrencyConversions)
+	}
+	if m.removeditem_events != nil {
+		edges = append(edges, group.EdgeItemEvents)
+	}
+	if m.removedsaved_searches != nil {
+		edges = append(edges, group.EdgeSavedSearches)
+	}
+	if m.removeditem_templates != nil {
+		edges = append(edges, group.EdgeItemTemplates)
+	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *GroupInvitationTokenMutation) RemovedIDs(name string) []ent.Value {
+func (m *GroupMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case group.EdgeUsers:
+		ids := make([]ent.Value, 0, len(m.removedusers))
+		for id := range m.removedusers {
+			ids = append(ids, id)
+		}
+		return ids
+	case group.EdgeLocations:
+		ids := make([]ent.Value, 0, len(m.removedlocations))
+		for id := range m.removedlocations {
+			ids = append(ids, id)
+		}
+		return ids
+	case group.EdgeItems:
+		ids := make([]ent.Value, 0, len(m.removeditems))
+		for id := range m.removeditems {
+			ids = append(ids, id)
+		}
+		return ids
+	case group.EdgeLabels:
+		ids := make([]ent.Value, 0, len(m.removedlabels))
+		for id := range m.removedlabels {
+			ids = append(ids, id)
+		}
+		return ids
+	case group.EdgeDocuments:
+		ids := make([]ent.Value, 0, len(m.removeddocuments))
+		for id := range m.removeddocuments {
+			ids = append(ids, id)
+		}
+		return ids
+	case group.EdgeInvitationTokens:
+		ids := make([]ent.Value, 0, len(m.removedinvitation_tokens))
+		for id := range m.removedinvitation_tokens {
+			ids = append(ids, id)
+		}
+		return ids
+	case group.EdgeNotifiers:
+		ids := make([]ent.Value, 0, len(m.removednotifiers))
+		for id := range m.removednotifiers {
+			ids = append(ids, id)
+		}
+		return ids
+	case group.EdgeCurrencyConversions:
+		ids := make([]ent.Value, 0, len(m.removedcurrency_conversions))
+		for id := range m.removedcurrency_conversions {
+			ids = append(ids, id)
+		}
+		return ids
+	case group.EdgeItemEvents:
+		ids := make([]ent.Value, 0, len(m.removeditem_events))
+		for id := range m.removeditem_events {
+			ids = append(ids, id)
+		}
+		return ids
+	case group.EdgeSavedSearches:
+		ids := make([]ent.Value, 0, len(m.removedsaved_searches))
+		for id := range m.removedsaved_searches {
+			ids = append(ids, id)
+		}
+		return ids
+	case group.EdgeItemTemplates:
+		ids := make([]ent.Value, 0, len(m.removeditem_templates))
+		for id := range m.removeditem_templates {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *GroupInvitationTokenMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedgroup {
-		edges = append(edges, groupinvitationtoken.EdgeGroup)
+func (m *GroupMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 12)
+	if m.clearedusers {
+		edges = append(edges, group.EdgeUsers)
+	}
+	if m.clearedlocations {
+		edges = append(edges, group.EdgeLocations)
+	}
+	if m.cleareditems {
+		edges = append(edges, group.EdgeItems)
+	}
+	if m.clearedlabels {
+		edges = append(edges, group.EdgeLabels)
+	}
+	if m.cleareddocuments {
+		edges = append(edges, group.EdgeDocuments)
+	}
+	if m.clearedinvitation_tokens {
+		edges = append(edges, group.EdgeInvitationTokens)
+	}
+	if m.clearednotifiers {
+		edges = append(edges, group.EdgeNotifiers)
+	}
+	if m.clearedcurrency_conversions {
+		edges = append(edges, group.EdgeCurrencyConversions)
+	}
+	if m.cleareditem_events {
+		edges = append(edges, group.EdgeItemEvents)
+	}
+	if m.clearedsaved_searches {
+		edges = append(edges, group.EdgeSavedSearches)
+	}
+	if m.cleareditem_templates {
+		edges = append(edges, group.EdgeItemTemplates)
+	}
+	if m.cleareddefault_location {
+		edges = append(edges, group.EdgeDefaultLocation)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *GroupInvitationTokenMutation) EdgeCleared(name string) bool {
+func (m *GroupMutation) EdgeCleared(name string) bool {
 	switch name {
-	case groupinvitationtoken.EdgeGroup:
-		return m.clearedgroup
+	case group.EdgeUsers:
+		return m.clearedusers
+	case group.EdgeLocations:
+		return m.clearedlocations
+	case group.EdgeItems:
+		return m.cleareditems
+	case group.EdgeLabels:
+		return m.clearedlabels
+	case group.EdgeDocuments:
+		return m.cleareddocuments
+	case group.EdgeInvitationTokens:
+		return m.clearedinvitation_tokens
+	case group.EdgeNotifiers:
+		return m.clearednotifiers
+	case group.EdgeCurrencyConversions:
+		return m.clearedcurrency_conversions
+	case group.EdgeItemEvents:
+		return m.cleareditem_events
+	case group.EdgeSavedSearches:
+		return m.clearedsaved_searches
+	case group.EdgeItemTemplates:
+		return m.cleareditem_templates
+	case group.EdgeDefaultLocation:
+		return m.cleareddefault_location
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *GroupInvitationTokenMutation) ClearEdge(name string) error {
+func (m *GroupMutation) ClearEdge(name string) error {
 	switch name {
-	case groupinvitationtoken.EdgeGroup:
-		m.ClearGroup()
+	case group.EdgeDefaultLocation:
+		m.ClearDefaultLocation()
 		return nil
 	}
-	return fmt.Errorf("unknown GroupInvitationToken unique edge %s", name)
+	return fmt.Errorf("unknown Group unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *GroupInvitationTokenMutation) ResetEdge(name string) error {
+func (m *GroupMutation) ResetEdge(name string) error {
 	switch name {
-	case groupinvitationtoken.EdgeGroup:
-		m.ResetGroup()
+	case group.EdgeUsers:
+		m.ResetUsers()
+		return nil
+	case group.EdgeLocations:
+		m.ResetLocations()
+		return nil
+	case group.EdgeItems:
+		m.ResetItems()
+		return nil
+	case group.EdgeLabels:
+		m.ResetLabels()
+		return nil
+	case group.EdgeDocuments:
+		m.ResetDocuments()
+		return nil
+	case group.EdgeInvitationTokens:
+		m.ResetInvitationTokens()
+		return nil
+	case group.EdgeNotifiers:
+		m.ResetNotifiers()
+		return nil
+	case group.EdgeCurrencyConversions:
+		m.ResetCurrencyConversions()
+		return nil
+	case group.EdgeItemEvents:
+		m.ResetItemEvents()
+		return nil
+	case group.EdgeSavedSearches:
+		m.ResetSavedSearches()
+		return nil
+	case group.EdgeItemTemplates:
+		m.ResetItemTemplates()
+		return nil
+	case group.EdgeDefaultLocation:
+		m.ResetDefaultLocation()
 		return nil
 	}
-	return fmt.Errorf("unknown GroupInvitationToken edge %s", name)
+	return fmt.Errorf("unknown Group edge %s", name)
 }
 
-// ItemMutation represents an operation that mutates the Item nodes in the graph.
-type ItemMutation struct {
+// GroupInvitationTokenMutation represents an operation that mutates the GroupInvitationToken nodes in the graph.
+type GroupInvitationTokenMutation struct {
 	config
-	op                         Op
-	typ                        string
-	id                         *uuid.UUID
-	created_at                 *time.Time
-	updated_at                 *time.Time
-	name                       *string
-	description                *string
-	import_ref                 *string
-	notes                      *string
-	quantity                   *int
-	addquantity                *int
-	insured                    *bool
-	archived                   *bool
-	asset_id                   *int
-	addasset_id                *int
-	serial_number              *string
-	model_number               *string
-	manufacturer               *string
-	lifetime_warranty          *bool
-	warranty_expires           *time.Time
-	warranty_details           *string
-	purchase_time              *time.Time
-	purchase_from              *string
-	purchase_price             *float64
-	addpurchase_price          *float64
-	sold_time                  *time.Time
-	sold_to                    *string
-	sold_price                 *float64
-	addsold_price              *float64
-	sold_notes                 *string
-	clearedFields              map[string]struct{}
-	group                      *uuid.UUID
-	clearedgroup               bool
-	parent                     *uuid.UUID
-	clearedparent              bool
-	children                   map[uuid.UUID]struct{}
-	removedchildren            map[uuid.UUID]struct{}
-	clearedchildren            bool
-	label                      map[uuid.UUID]struct{}
-	removedlabel               map[uuid.UUID]struct{}
-	clearedlabel               bool
-	location                   *uuid.UUID
-	clearedlocation            bool
-	fields                     map[uuid.UUID]struct{}
-	removedfields              map[uuid.UUID]struct{}
-	clearedfields              bool
-	maintenance_entries        map[uuid.UUID]struct{}
-	removedmaintenance_entries map[uuid.UUID]struct{}
-	clearedmaintenance_entries bool
-	attachments                map[uuid.UUID]struct{}
-	removedattachments         map[uuid.UUID]struct{}
-	clearedattachments         bool
-	done                       bool
-	oldValue                   func(context.Context) (*Item, error)
-	predicates                 []predicate.Item
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	created_at    *time.Time
+	updated_at    *time.Time
+	token         *[]byte
+	expires_at    *time.Time
+	uses          *int
+	adduses       *int
+	clearedFields map[string]struct{}
+	group         *uuid.UUID
+	clearedgroup  bool
+	done          bool
+	oldValue      func(context.Context) (*GroupInvitationToken, error)
+	predicates    []predicate.GroupInvitationToken
 }
 
-var _ ent.Mutation = (*ItemMutation)(nil)
+var _ ent.Mutation = (*GroupInvitationTokenMutation)(nil)
 
-// itemOption allows management of the mutation configuration using functional options.
-type itemOption func(*ItemMutation)
+// groupinvitationtokenOption allows management of the mutation configuration using functional options.
+type groupinvitationtokenOption func(*GroupInvitationTokenMutation)
 
-// newItemMutation creates new mutation for the Item entity.
-func newItemMutation(c config, op Op, opts ...itemOption) *ItemMutation {
-	m := &ItemMutation{
+// newGroupInvitationTokenMutation creates new mutation for the GroupInvitationToken entity.
+func newGroupInvitationTokenMutation(c config, op Op, opts ...groupinvitationtokenOption) *GroupInvitationTokenMutation {
+	m := &GroupInvitationTokenMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeItem,
+		typ:           TypeGroupInvitationToken,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -4146,20 +4861,20 @@ func newItemMutation(c config, op Op, opts ...itemOption) *ItemMutation {
 	return m
 }
 
-// withItemID sets the ID field of the mutation.
-func withItemID(id uuid.UUID) itemOption {
-	return func(m *ItemMutation) {
+// withGroupInvitationTokenID sets the ID field of the mutation.
+func withGroupInvitationTokenID(id uuid.UUID) groupinvitationtokenOption {
+	return func(m *GroupInvitationTokenMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Item
+			value *GroupInvitationToken
 		)
-		m.oldValue = func(ctx context.Context) (*Item, error) {
+		m.oldValue = func(ctx context.Context) (*GroupInvitationToken, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Item.Get(ctx, id)
+					value, err = m.Client().GroupInvitationToken.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -4168,10 +4883,10 @@ func withItemID(id uuid.UUID) itemOption {
 	}
 }
 
-// withItem sets the old Item of the mutation.
-func withItem(node *Item) itemOption {
-	return func(m *ItemMutation) {
-		m.oldValue = func(context.Context) (*Item, error) {
+// withGroupInvitationToken sets the old GroupInvitationToken of the mutation.
+func withGroupInvitationToken(node *GroupInvitationToken) groupinvitationtokenOption {
+	return func(m *GroupInvitationTokenMutation) {
+		m.oldValue = func(context.Context) (*GroupInvitationToken, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -4180,7 +4895,7 @@ func withItem(node *Item) itemOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ItemMutation) Client() *Client {
+func (m GroupInvitationTokenMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -4188,7 +4903,7 @@ func (m ItemMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m ItemMutation) Tx() (*Tx, error) {
+func (m GroupInvitationTokenMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -4198,14 +4913,14 @@ func (m ItemMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Item entities.
-func (m *ItemMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of GroupInvitationToken entities.
+func (m *GroupInvitationTokenMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *ItemMutation) ID() (id uuid.UUID, exists bool) {
+func (m *GroupInvitationTokenMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -4216,7 +4931,7 @@ func (m *ItemMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *ItemMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *GroupInvitationTokenMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -4225,19 +4940,19 @@ func (m *ItemMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Item.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().GroupInvitationToken.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *ItemMutation) SetCreatedAt(t time.Time) {
+func (m *GroupInvitationTokenMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *ItemMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *GroupInvitationTokenMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -4245,10 +4960,10 @@ func (m *ItemMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Item entity.
-// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the GroupInvitationToken entity.
+// If the GroupInvitationToken object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ItemMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *GroupInvitationTokenMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -4263,17 +4978,17 @@ func (m *ItemMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *ItemMutation) ResetCreatedAt() {
+func (m *GroupInvitationTokenMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *ItemMutation) SetUpdatedAt(t time.Time) {
+func (m *GroupInvitationTokenMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *ItemMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *GroupInvitationTokenMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -4281,10 +4996,10 @@ func (m *ItemMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Item entity.
-// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the GroupInvitationToken entity.
+// If the GroupInvitationToken object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ItemMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *GroupInvitationTokenMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -4299,2316 +5014,7255 @@ func (m *ItemMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *ItemMutation) ResetUpdatedAt() {
+func (m *GroupInvitationTokenMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
-// SetName sets the "name" field.
-func (m *ItemMutation) SetName(s string) {
-	m.name = &s
+// SetToken sets the "token" field.
+func (m *GroupInvitationTokenMutation) SetToken(b []byte) {
+	m.token = &b
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *ItemMutation) Name() (r string, exists bool) {
-	v := m.name
+// Token returns the value of the "token" field in the mutation.
+func (m *GroupInvitationTokenMutation) Token() (r []byte, exists bool) {
+	v := m.token
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Item entity.
-// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// OldToken returns the old "token" field's value of the GroupInvitationToken entity.
+// If the GroupInvitationToken object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ItemMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *GroupInvitationTokenMutation) OldToken(ctx context.Context) (v []byte, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldToken is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldToken requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldToken: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.Token, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *ItemMutation) ResetName() {
-	m.name = nil
+// ResetToken resets all changes to the "token" field.
+func (m *GroupInvitationTokenMutation) ResetToken() {
+	m.token = nil
 }
 
-// SetDescription sets the "description" field.
-func (m *ItemMutation) SetDescription(s string) {
-	m.description = &s
+// SetExpiresAt sets the "expires_at" field.
+func (m *GroupInvitationTokenMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
 }
 
-// Description returns the value of the "description" field in the mutation.
-func (m *ItemMutation) Description() (r string, exists bool) {
-	v := m.description
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *GroupInvitationTokenMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDescription returns the old "description" field's value of the Item entity.
-// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// OldExpiresAt returns the old "expires_at" field's value of the GroupInvitationToken entity.
+// If the GroupInvitationToken object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ItemMutation) OldDescription(ctx context.Context) (v string, err error) {
+func (m *GroupInvitationTokenMutation) OldExpiresAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDescription requires an ID field in the mutation")
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
 	}
-	return oldValue.Description, nil
-}
-
-// ClearDescription clears the value of the "description" field.
-func (m *ItemMutation) ClearDescription() {
-	m.description = nil
-	m.clearedFields[item.FieldDescription] = struct{}{}
-}
-
-// DescriptionCleared returns if the "description" field was cleared in this mutation.
-func (m *ItemMutation) DescriptionCleared() bool {
-	_, ok := m.clearedFields[item.FieldDescription]
-	return ok
+	return oldValue.ExpiresAt, nil
 }
 
-// ResetDescription resets all changes to the "description" field.
-func (m *ItemMutation) ResetDescription() {
-	m.description = nil
-	delete(m.clearedFields, item.FieldDescription)
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *GroupInvitationTokenMutation) ResetExpiresAt() {
+	m.expires_at = nil
 }
 
-// SetImportRef sets the "import_ref" field.
-func (m *ItemMutation) SetImportRef(s string) {
-	m.import_ref = &s
+// SetUses sets the "uses" field.
+func (m *GroupInvitationTokenMutation) SetUses(i int) {
+	m.uses = &i
+	m.adduses = nil
 }
 
-// ImportRef returns the value of the "import_ref" field in the mutation.
-func (m *ItemMutation) ImportRef() (r string, exists bool) {
-	v := m.import_ref
+// Uses returns the value of the "uses" field in the mutation.
+func (m *GroupInvitationTokenMutation) Uses() (r int, exists bool) {
+	v := m.uses
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldImportRef returns the old "import_ref" field's value of the Item entity.
-// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// OldUses returns the old "uses" field's value of the GroupInvitationToken entity.
+// If the GroupInvitationToken object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ItemMutation) OldImportRef(ctx context.Context) (v string, err error) {
+func (m *GroupInvitationTokenMutation) OldUses(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldImportRef is only allowed on UpdateOne operations")
+		return v, errors.New("OldUses is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldImportRef requires an ID field in the mutation")
+		return v, errors.New("OldUses requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldImportRef: %w", err)
+		return v, fmt.Errorf("querying old value for OldUses: %w", err)
 	}
-	return oldValue.ImportRef, nil
+	return oldValue.Uses, nil
 }
 
-// ClearImportRef clears the value of the "import_ref" field.
-func (m *ItemMutation) ClearImportRef() {
-	m.import_ref = nil
-	m.clearedFields[item.FieldImportRef] = struct{}{}
-}
-
-// ImportRefCleared returns if the "import_ref" field was cleared in this mutation.
-func (m *ItemMutation) ImportRefCleared() bool {
-	_, ok := m.clearedFields[item.FieldImportRef]
-	return ok
-}
-
-// ResetImportRef resets all changes to the "import_ref" field.
-func (m *ItemMutation) ResetImportRef() {
-	m.import_ref = nil
-	delete(m.clearedFields, item.FieldImportRef)
-}
-
-// SetNotes sets the "notes" field.
-func (m *ItemMutation) SetNotes(s string) {
-	m.notes = &s
+// AddUses adds i to the "uses" field.
+func (m *GroupInvitationTokenMutation) AddUses(i int) {
+	if m.adduses != nil {
+		*m.adduses += i
+	} else {
+		m.adduses = &i
+	}
 }
 
-// Notes returns the value of the "notes" field in the mutation.
-func (m *ItemMutation) Notes() (r string, exists bool) {
-	v := m.notes
+// AddedUses returns the value that was added to the "uses" field in this mutation.
+func (m *GroupInvitationTokenMutation) AddedUses() (r int, exists bool) {
+	v := m.adduses
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldNotes returns the old "notes" field's value of the Item entity.
-// If the Item object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ItemMutation) OldNotes(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldNotes is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldNotes requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldNotes: %w", err)
-	}
-	return oldValue.Notes, nil
+// ResetUses resets all changes to the "uses" field.
+func (m *GroupInvitationTokenMutation) ResetUses() {
+	m.uses = nil
+	m.adduses = nil
 }
 
-// ClearNotes clears the value of the "notes" field.
-func (m *ItemMutation) ClearNotes() {
-	m.notes = nil
-	m.clearedFields[item.FieldNotes] = struct{}{}
+// SetGroupID sets the "group" edge to the Group entity by id.
+func (m *GroupInvitationTokenMutation) SetGroupID(id uuid.UUID) {
+	m.group = &id
 }
 
-// NotesCleared returns if the "notes" field was cleared in this mutation.
-func (m *ItemMutation) NotesCleared() bool {
-	_, ok := m.clearedFields[item.FieldNotes]
-	return ok
+// ClearGroup clears the "group" edge to the Group entity.
+func (m *GroupInvitationTokenMutation) ClearGroup() {
+	m.clearedgroup = true
 }
 
-// ResetNotes resets all changes to the "notes" field.
-func (m *ItemMutation) ResetNotes() {
-	m.notes = nil
-	delete(m.clearedFields, item.FieldNotes)
+// GroupCleared reports if the "group" edge to the Group entity was cleared.
+func (m *GroupInvitationTokenMutation) GroupCleared() bool {
+	return m.clearedgroup
 }
 
-// SetQuantity sets the "quantity" field.
-func (m *ItemMutation) SetQuantity(i int) {
-	m.quantity = &i
-	m.addquantity = nil
+// GroupID returns the "group" edge ID in the mutation.
+func (m *GroupInvitationTokenMutation) GroupID() (id uuid.UUID, exists bool) {
+	if m.group != nil {
+		return *m.group, true
+	}
+	return
 }
 
-// Quantity returns the value of the "quantity" field in the mutation.
-func (m *ItemMutation) Quantity() (r int, exists bool) {
-	v := m.quantity
-	if v == nil {
-		return
+// GroupIDs returns the "group" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// GroupID instead. It exists only for internal usage by the builders.
+func (m *GroupInvitationTokenMutation) GroupIDs() (ids []uuid.UUID) {
+	if id := m.group; id != nil {
+		ids = append(ids, *id)
 	}
-	return *v, true
+	return
 }
 
-// OldQuantity returns the old "quantity" field's value of the Item entity.
-// If the Item object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ItemMutation) OldQuantity(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldQuantity is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldQuantity requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldQuantity: %w", err)
-	}
-	return oldValue.Quantity, nil
+// ResetGroup resets all changes to the "group" edge.
+func (m *GroupInvitationTokenMutation) ResetGroup() {
+	m.group = nil
+	m.clearedgroup = false
 }
 
-// AddQuantity adds i to the "quantity" field.
-func (m *ItemMutation) AddQuantity(i int) {
-	if m.addquantity != nil {
-		*m.addquantity += i
-	} else {
-		m.addquantity = &i
-	}
+// Where appends a list predicates to the GroupInvitationTokenMutation builder.
+func (m *GroupInvitationTokenMutation) Where(ps ...predicate.GroupInvitationToken) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// AddedQuantity returns the value that was added to the "quantity" field in this mutation.
-func (m *ItemMutation) AddedQuantity() (r int, exists bool) {
-	v := m.addquantity
-	if v == nil {
-		return
+// WhereP appends storage-level predicates to the GroupInvitationTokenMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *GroupInvitationTokenMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.GroupInvitationToken, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	return *v, true
+	m.Where(p...)
 }
 
-// ResetQuantity resets all changes to the "quantity" field.
-func (m *ItemMutation) ResetQuantity() {
-	m.quantity = nil
-	m.addquantity = nil
+// Op returns the operation name.
+func (m *GroupInvitationTokenMutation) Op() Op {
+	return m.op
 }
 
-// SetInsured sets the "insured" field.
-func (m *ItemMutation) SetInsured(b bool) {
-	m.insured = &b
+// SetOp allows setting the mutation operation.
+func (m *GroupInvitationTokenMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// Insured returns the value of the "insured" field in the mutation.
-func (m *ItemMutation) Insured() (r bool, exists bool) {
-	v := m.insured
-	if v == nil {
-		return
-	}
-	return *v, true
+// Type returns the node type of this mutation (GroupInvitationToken).
+func (m *GroupInvitationTokenMutation) Type() string {
+	return m.typ
 }
 
-// OldInsured returns the old "insured" field's value of the Item entity.
-// If the Item object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ItemMutation) OldInsured(ctx context.Context) (v bool, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldInsured is only allowed on UpdateOne operations")
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *GroupInvitationTokenMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.created_at != nil {
+		fields = append(fields, groupinvitationtoken.FieldCreatedAt)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldInsured requires an ID field in the mutation")
+	if m.updated_at != nil {
+		fields = append(fields, groupinvitationtoken.FieldUpdatedAt)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldInsured: %w", err)
+	if m.token != nil {
+		fields = append(fields, groupinvitationtoken.FieldToken)
 	}
-	return oldValue.Insured, nil
-}
-
-// ResetInsured resets all changes to the "insured" field.
-func (m *ItemMutation) ResetInsured() {
-	m.insured = nil
-}
-
-// SetArchived sets the "archived" field.
-func (m *ItemMutation) SetArchived(b bool) {
-	m.archived = &b
-}
-
-// Archived returns the value of the "archived" field in the mutation.
-func (m *ItemMutation) Archived() (r bool, exists bool) {
-	v := m.archived
-	if v == nil {
-		return
+	if m.expires_at != nil {
+		fields = append(fields, groupinvitationtoken.FieldExpiresAt)
 	}
-	return *v, true
+	if m.uses != nil {
+		fields = append(fields, groupinvitationtoken.FieldUses)
+	}
+	return fields
 }
 
-// OldArchived returns the old "archived" field's value of the Item entity.
-// If the Item object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ItemMutation) OldArchived(ctx context.Context) (v bool, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldArchived is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldArchived requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldArchived: %w", err)
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *GroupInvitationTokenMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case groupinvitationtoken.FieldCreatedAt:
+		return m.CreatedAt()
+	case groupinvitationtoken.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case groupinvitationtoken.FieldToken:
+		return m.Token()
+	case groupinvitationtoken.FieldExpiresAt:
+		return m.ExpiresAt()
+	case groupinvitationtoken.FieldUses:
+		return m.Uses()
 	}
-	return oldValue.Archived, nil
+	return nil, false
 }
 
-// ResetArchived resets all changes to the "archived" field.
-func (m *ItemMutation) ResetArchived() {
-	m.archived = nil
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *GroupInvitationTokenMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case groupinvitationtoken.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case groupinvitationtoken.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case groupinvitationtoken.FieldToken:
+		return m.OldToken(ctx)
+	case groupinvitationtoken.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	case groupinvitationtoken.FieldUses:
+		return m.OldUses(ctx)
+	}
+	return nil, fmt.Errorf("unknown GroupInvitationToken field %s", name)
 }
 
-// SetAssetID sets the "asset_id" field.
-func (m *ItemMutation) SetAssetID(i int) {
-	m.asset_id = &i
-	m.addasset_id = nil
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *GroupInvitationTokenMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case groupinvitationtoken.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case groupinvitationtoken.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case groupinvitationtoken.FieldToken:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetToken(v)
+		return nil
+	case groupinvitationtoken.FieldExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresAt(v)
+		return nil
+	case groupinvitationtoken.FieldUses:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUses(v)
+		return nil
+	}
+	return fmt.Errorf("unknown GroupInvitationToken field %s", name)
 }
 
-// AssetID returns the value of the "asset_id" field in the mutation.
-func (m *ItemMutation) AssetID() (r int, exists bool) {
-	v := m.asset_id
-	if v == nil {
-		return
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *GroupInvitationTokenMutation) AddedFields() []string {
+	var fields []string
+	if m.adduses != nil {
+		fields = append(fields, groupinvitationtoken.FieldUses)
 	}
-	return *v, true
+	return fields
 }
 
-// OldAssetID returns the old "asset_id" field's value of the Item entity.
-// If the Item object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ItemMutation) OldAssetID(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAssetID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAssetID requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAssetID: %w", err)
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *GroupInvitationTokenMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case groupinvitationtoken.FieldUses:
+		return m.AddedUses()
 	}
-	return oldValue.AssetID, nil
+	return nil, false
 }
 
-// AddAssetID adds i to the "asset_id" field.
-func (m *ItemMutation) AddAssetID(i int) {
-	if m.addasset_id != nil {
-		*m.addasset_id += i
-	} else {
-		m.addasset_id = &i
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *GroupInvitationTokenMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case groupinvitationtoken.FieldUses:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddUses(v)
+		return nil
 	}
+	return fmt.Errorf("unknown GroupInvitationToken numeric field %s", name)
 }
 
-// AddedAssetID returns the value that was added to the "asset_id" field in this mutation.
-func (m *ItemMutation) AddedAssetID() (r int, exists bool) {
-	v := m.addasset_id
-	if v == nil {
-		return
-	}
-	return *v, true
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *GroupInvitationTokenMutation) ClearedFields() []string {
+	return nil
 }
 
-// ResetAssetID resets all changes to the "asset_id" field.
-func (m *ItemMutation) ResetAssetID() {
-	m.asset_id = nil
-	m.addasset_id = nil
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *GroupInvitationTokenMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
 }
 
-// SetSerialNumber sets the "serial_number" field.
-func (m *ItemMutation) SetSerialNumber(s string) {
-	m.serial_number = &s
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *GroupInvitationTokenMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown GroupInvitationToken nullable field %s", name)
 }
 
-// SerialNumber returns the value of the "serial_number" field in the mutation.
-func (m *ItemMutation) SerialNumber() (r string, exists bool) {
-	v := m.serial_number
-	if v == nil {
-		return
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *GroupInvitationTokenMutation) ResetField(name string) error {
+	switch name {
+	case groupinvitationtoken.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case groupinvitationtoken.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case groupinvitationtoken.FieldToken:
+		m.ResetToken()
+		return nil
+	case groupinvitationtoken.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
+	case groupinvitationtoken.FieldUses:
+		m.ResetUses()
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown GroupInvitationToken field %s", name)
 }
 
-// OldSerialNumber returns the old "serial_number" field's value of the Item entity.
-// If the Item object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ItemMutation) OldSerialNumber(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSerialNumber is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSerialNumber requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSerialNumber: %w", err)
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *GroupInvitationTokenMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.group != nil {
+		edges = append(edges, groupinvitationtoken.EdgeGroup)
 	}
-	return oldValue.SerialNumber, nil
+	return edges
 }
 
-// ClearSerialNumber clears the value of the "serial_number" field.
-func (m *ItemMutation) ClearSerialNumber() {
-	m.serial_number = nil
-	m.clearedFields[item.FieldSerialNumber] = struct{}{}
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *GroupInvitationTokenMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case groupinvitationtoken.EdgeGroup:
+		if id := m.group; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
 }
 
-// SerialNumberCleared returns if the "serial_number" field was cleared in this mutation.
-func (m *ItemMutation) SerialNumberCleared() bool {
-	_, ok := m.clearedFields[item.FieldSerialNumber]
-	return ok
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *GroupInvitationTokenMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
 }
 
-// ResetSerialNumber resets all changes to the "serial_number" field.
-func (m *ItemMutation) ResetSerialNumber() {
-	m.serial_number = nil
-	delete(m.clearedFields, item.FieldSerialNumber)
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *GroupInvitationTokenMutation) RemovedIDs(name string) []ent.Value {
+	return nil
 }
 
-// SetModelNumber sets the "model_number" field.
-func (m *ItemMutation) SetModelNumber(s string) {
-	m.model_number = &s
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *GroupInvitationTokenMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedgroup {
+		edges = append(edges, groupinvitationtoken.EdgeGroup)
+	}
+	return edges
 }
 
-// ModelNumber returns the value of the "model_number" field in the mutation.
-func (m *ItemMutation) ModelNumber() (r string, exists bool) {
-	v := m.model_number
-	if v == nil {
-		return
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *GroupInvitationTokenMutation) EdgeCleared(name string) bool {
+	switch name {
+	case groupinvitationtoken.EdgeGroup:
+		return m.clearedgroup
 	}
-	return *v, true
+	return false
 }
 
-// OldModelNumber returns the old "model_number" field's value of the Item entity.
-// If the Item object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ItemMutation) OldModelNumber(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldModelNumber is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldModelNumber requires an ID field in the mutation")
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *GroupInvitationTokenMutation) ClearEdge(name string) error {
+	switch name {
+	case groupinvitationtoken.EdgeGroup:
+		m.ClearGroup()
+		return nil
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldModelNumber: %w", err)
+	return fmt.Errorf("unknown GroupInvitationToken unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *GroupInvitationTokenMutation) ResetEdge(name string) error {
+	switch name {
+	case groupinvitationtoken.EdgeGroup:
+		m.ResetGroup()
+		return nil
 	}
-	return oldValue.ModelNumber, nil
+	return fmt.Errorf("unknown GroupInvitationToken edge %s", name)
 }
 
-// ClearModelNumber clears the value of the "model_number" field.
-func (m *ItemMutation) ClearModelNumber() {
-	m.model_number = nil
-	m.clearedFields[item.FieldModelNumber] = struct{}{}
+// ItemMutation represents an operation that mutates the Item nodes in the graph.
+type ItemMutation struct {
+	config
+	op                         Op
+	typ                        string
+	id                         *uuid.UUID
+	created_at                 *time.Time
+	updated_at                 *time.Time
+	name                       *string
+	description                *string
+	import_ref                 *string
+	notes                      *string
+	notes_format               *item.NotesFormat
+	quantity                   *int
+	addquantity                *int
+	insured                    *bool
+	archived                   *bool
+	favorite                   *bool
+	version                    *int
+	addversion                 *int
+	archived_at                *time.Time
+	asset_id                   *int
+	addasset_id                *int
+	reorder_threshold          *int
+	addreorder_threshold       *int
+	tags                       *[]string
+	appendtags                 []string
+	source                     *item.Source
+	acquisition_type           *item.AcquisitionType
+	latitude                   *float64
+	addlatitude                *float64
+	longitude                  *float64
+	addlongitude               *float64
+	serial_number              *string
+	barcode                    *string
+	model_number               *string
+	manufacturer               *string
+	lifetime_warranty          *bool
+	warranty_expires           *time.Time
+	warranty_details           *string
+	purchase_time              *time.Time
+	purchase_from              *string
+	purchase_order_number      *string
+	purchase_price             *float64
+	addpurchase_price          *float64
+	currency                   *string
+	replacement_cost           *float64
+	addreplacement_cost        *float64
+	loaned_to                  *string
+	loaned_at                  *time.Time
+	loan_due                   *time.Time
+	sold_time                  *time.Time
+	sold_to                    *string
+	sold_price                 *float64
+	addsold_price              *float64
+	sold_notes                 *string
+	clearedFields              map[string]struct{}
+	group                      *uuid.UUID
+	clearedgroup               bool
+	parent                     *uuid.UUID
+	clearedparent              bool
+	children                   map[uuid.UUID]struct{}
+	removedchildren            map[uuid.UUID]struct{}
+	clearedchildren            bool
+	related                    map[uuid.UUID]struct{}
+	removedrelated             map[uuid.UUID]struct{}
+	clearedrelated             bool
+	label                      map[uuid.UUID]struct{}
+	removedlabel               map[uuid.UUID]struct{}
+	clearedlabel               bool
+	location                   *uuid.UUID
+	clearedlocation            bool
+	fields                     map[uuid.UUID]struct{}
+	removedfields              map[uuid.UUID]struct{}
+	clearedfields              bool
+	maintenance_entries        map[uuid.UUID]struct{}
+	removedmaintenance_entries map[uuid.UUID]struct{}
+	clearedmaintenance_entries bool
+	attachments                map[uuid.UUID]struct{}
+	removedattachments         map[uuid.UUID]struct{}
+	clearedattachments         bool
+	comments                   map[uuid.UUID]struct{}
+	removedcomments            map[uuid.UUID]struct{}
+	clearedcomments            bool
+	done                       bool
+	oldValue                   func(context.Context) (*Item, error)
+	predicates                 []predicate.Item
 }
 
-// ModelNumberCleared returns if the "model_number" field was cleared in this mutation.
-func (m *ItemMutation) ModelNumberCleared() bool {
-	_, ok := m.clearedFields[item.FieldModelNumber]
-	return ok
-}
+var _ ent.Mutation = (*ItemMutation)(nil)
 
-// ResetModelNumber resets all changes to the "model_number" field.
-func (m *ItemMutation) ResetModelNumber() {
-	m.model_number = nil
-	delete(m.clearedFields, item.FieldModelNumber)
-}
+// itemOption allows management of the mutation configuration using functional options.
+type itemOption func(*ItemMutation)
 
-// SetManufacturer sets the "manufacturer" field.
-func (m *ItemMutation) SetManufacturer(s string) {
-	m.manufacturer = &s
+// newItemMutation creates new mutation for the Item entity.
+func newItemMutation(c config, op Op, opts ...itemOption) *ItemMutation {
+	m := &ItemMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeItem,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
 }
 
-// Manufacturer returns the value of the "manufacturer" field in the mutation.
-func (m *ItemMutation) Manufacturer() (r string, exists bool) {
-	v := m.manufacturer
-	if v == nil {
-		return
+// withItemID sets the ID field of the mutation.
+func withItemID(id uuid.UUID) itemOption {
+	return func(m *ItemMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Item
+		)
+		m.oldValue = func(ctx context.Context) (*Item, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Item.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
 	}
-	return *v, true
 }
 
-// OldManufacturer returns the old "manufacturer" field's value of the Item entity.
-// If the Item object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ItemMutation) OldManufacturer(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldManufacturer is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldManufacturer requires an ID field in the mutation")
+// withItem sets the old Item of the mutation.
+func withItem(node *Item) itemOption {
+	return func(m *ItemMutation) {
+		m.oldValue = func(context.Context) (*Item, error) {
+			return node, nil
+		}
+		m.id = &node.ID
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldManufacturer: %w", err)
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ItemMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ItemMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
-	return oldValue.Manufacturer, nil
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// ClearManufacturer clears the value of the "manufacturer" field.
-func (m *ItemMutation) ClearManufacturer() {
-	m.manufacturer = nil
-	m.clearedFields[item.FieldManufacturer] = struct{}{}
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Item entities.
+func (m *ItemMutation) SetID(id uuid.UUID) {
+	m.id = &id
 }
 
-// ManufacturerCleared returns if the "manufacturer" field was cleared in this mutation.
-func (m *ItemMutation) ManufacturerCleared() bool {
-	_, ok := m.clearedFields[item.FieldManufacturer]
-	return ok
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ItemMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
 }
 
-// ResetManufacturer resets all changes to the "manufacturer" field.
-func (m *ItemMutation) ResetManufacturer() {
-	m.manufacturer = nil
-	delete(m.clearedFields, item.FieldManufacturer)
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ItemMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Item.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
 }
 
-// SetLifetimeWarranty sets the "lifetime_warranty" field.
-func (m *ItemMutation) SetLifetimeWarranty(b bool) {
-	m.lifetime_warranty = &b
+// SetCreatedAt sets the "created_at" field.
+func (m *ItemMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// LifetimeWarranty returns the value of the "lifetime_warranty" field in the mutation.
-func (m *ItemMutation) LifetimeWarranty() (r bool, exists bool) {
-	v := m.lifetime_warranty
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ItemMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLifetimeWarranty returns the old "lifetime_warranty" field's value of the Item entity.
+// OldCreatedAt returns the old "created_at" field's value of the Item entity.
 // If the Item object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ItemMutation) OldLifetimeWarranty(ctx context.Context) (v bool, err error) {
+func (m *ItemMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLifetimeWarranty is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLifetimeWarranty requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLifetimeWarranty: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.LifetimeWarranty, nil
+	return oldValue.CreatedAt, nil
 }
 
-// ResetLifetimeWarranty resets all changes to the "lifetime_warranty" field.
-func (m *ItemMutation) ResetLifetimeWarranty() {
-	m.lifetime_warranty = nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ItemMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// SetWarrantyExpires sets the "warranty_expires" field.
-func (m *ItemMutation) SetWarrantyExpires(t time.Time) {
-	m.warranty_expires = &t
+// SetUpdatedAt sets the "updated_at" field.
+func (m *ItemMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// WarrantyExpires returns the value of the "warranty_expires" field in the mutation.
-func (m *ItemMutation) WarrantyExpires() (r time.Time, exists bool) {
-	v := m.warranty_expires
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *ItemMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldWarrantyExpires returns the old "warranty_expires" field's value of the Item entity.
+// OldUpdatedAt returns the old "updated_at" field's value of the Item entity.
 // If the Item object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ItemMutation) OldWarrantyExpires(ctx context.Context) (v time.Time, err error) {
+func (m *ItemMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldWarrantyExpires is only allowed on UpdateOne operations")
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldWarrantyExpires requires an ID field in the mutation")
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldWarrantyExpires: %w", err)
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
 	}
-	return oldValue.WarrantyExpires, nil
+	return oldValue.UpdatedAt, nil
 }
 
-// ClearWarrantyExpires clears the value of the "warranty_expires" field.
-func (m *ItemMutation) ClearWarrantyExpires() {
-	m.warranty_expires = nil
-	m.clearedFields[item.FieldWarrantyExpires] = struct{}{}
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *ItemMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// WarrantyExpiresCleared returns if the "warranty_expires" field was cleared in this mutation.
-func (m *ItemMutation) WarrantyExpiresCleared() bool {
-	_, ok := m.clearedFields[item.FieldWarrantyExpires]
-	return ok
-}
-
-// ResetWarrantyExpires resets all changes to the "warranty_expires" field.
-func (m *ItemMutation) ResetWarrantyExpires() {
-	m.warranty_expires = nil
-	delete(m.clearedFields, item.FieldWarrantyExpires)
-}
-
-// SetWarrantyDetails sets the "warranty_details" field.
-func (m *ItemMutation) SetWarrantyDetails(s string) {
-	m.warranty_details = &s
+// SetName sets the "name" field.
+func (m *ItemMutation) SetName(s string) {
+	m.name = &s
 }
 
-// WarrantyDetails returns the value of the "warranty_details" field in the mutation.
-func (m *ItemMutation) WarrantyDetails() (r string, exists bool) {
-	v := m.warranty_details
+// Name returns the value of the "name" field in the mutation.
+func (m *ItemMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldWarrantyDetails returns the old "warranty_details" field's value of the Item entity.
+// OldName returns the old "name" field's value of the Item entity.
 // If the Item object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ItemMutation) OldWarrantyDetails(ctx context.Context) (v string, err error) {
+func (m *ItemMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldWarrantyDetails is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldWarrantyDetails requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldWarrantyDetails: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.WarrantyDetails, nil
-}
-
-// ClearWarrantyDetails clears the value of the "warranty_details" field.
-func (m *ItemMutation) ClearWarrantyDetails() {
-	m.warranty_details = nil
-	m.clearedFields[item.FieldWarrantyDetails] = struct{}{}
-}
-
-// WarrantyDetailsCleared returns if the "warranty_details" field was cleared in this mutation.
-func (m *ItemMutation) WarrantyDetailsCleared() bool {
-	_, ok := m.clearedFields[item.FieldWarrantyDetails]
-	return ok
+	return oldValue.Name, nil
 }
 
-// ResetWarrantyDetails resets all changes to the "warranty_details" field.
-func (m *ItemMutation) ResetWarrantyDetails() {
-	m.warranty_details = nil
-	delete(m.clearedFields, item.FieldWarrantyDetails)
+// ResetName resets all changes to the "name" field.
+func (m *ItemMutation) ResetName() {
+	m.name = nil
 }
 
-// SetPurchaseTime sets the "purchase_time" field.
-func (m *ItemMutation) SetPurchaseTime(t time.Time) {
-	m.purchase_time = &t
+// SetDescription sets the "description" field.
+func (m *ItemMutation) SetDescription(s string) {
+	m.description = &s
 }
 
-// PurchaseTime returns the value of the "purchase_time" field in the mutation.
-func (m *ItemMutation) PurchaseTime() (r time.Time, exists bool) {
-	v := m.purchase_time
+// Description returns the value of the "description" field in the mutation.
+func (m *ItemMutation) Description() (r string, exists bool) {
+	v := m.description
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPurchaseTime returns the old "purchase_time" field's value of the Item entity.
+// OldDescription returns the old "description" field's value of the Item entity.
 // If the Item object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ItemMutation) OldPurchaseTime(ctx context.Context) (v time.Time, err error) {
+func (m *ItemMutation) OldDescription(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPurchaseTime is only allowed on UpdateOne operations")
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPurchaseTime requires an ID field in the mutation")
+		return v, errors.New("OldDescription requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPurchaseTime: %w", err)
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
 	}
-	return oldValue.PurchaseTime, nil
+	return oldValue.Description, nil
 }
 
-// ClearPurchaseTime clears the value of the "purchase_time" field.
-func (m *ItemMutation) ClearPurchaseTime() {
-	m.purchase_time = nil
-	m.clearedFields[item.FieldPurchaseTime] = struct{}{}
+// ClearDescription clears the value of the "description" field.
+func (m *ItemMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[item.FieldDescription] = struct{}{}
 }
 
-// PurchaseTimeCleared returns if the "purchase_time" field was cleared in this mutation.
-func (m *ItemMutation) PurchaseTimeCleared() bool {
-	_, ok := m.clearedFields[item.FieldPurchaseTime]
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *ItemMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[item.FieldDescription]
 	return ok
 }
 
-// ResetPurchaseTime resets all changes to the "purchase_time" field.
-func (m *ItemMutation) ResetPurchaseTime() {
-	m.purchase_time = nil
-	delete(m.clearedFields, item.FieldPurchaseTime)
+// ResetDescription resets all changes to the "description" field.
+func (m *ItemMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, item.FieldDescription)
 }
 
-// SetPurchaseFrom sets the "purchase_from" field.
-func (m *ItemMutation) SetPurchaseFrom(s string) {
-	m.purchase_from = &s
+// SetImportRef sets the "import_ref" field.
+func (m *ItemMutation) SetImportRef(s string) {
+	m.import_ref = &s
 }
 
-// PurchaseFrom returns the value of the "purchase_from" field in the mutation.
-func (m *ItemMutation) PurchaseFrom() (r string, exists bool) {
-	v := m.purchase_from
+// ImportRef returns the value of the "import_ref" field in the mutation.
+func (m *ItemMutation) ImportRef() (r string, exists bool) {
+	v := m.import_ref
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPurchaseFrom returns the old "purchase_from" field's value of the Item entity.
+// OldImportRef returns the old "import_ref" field's value of the Item entity.
 // If the Item object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ItemMutation) OldPurchaseFrom(ctx context.Context) (v string, err error) {
+func (m *ItemMutation) OldImportRef(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPurchaseFrom is only allowed on UpdateOne operations")
+		return v, errors.New("OldImportRef is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPurchaseFrom requires an ID field in the mutation")
+		return v, errors.New("OldImportRef requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPurchaseFrom: %w", err)
+		return v, fmt.Errorf("querying old value for OldImportRef: %w", err)
 	}
-	return oldValue.PurchaseFrom, nil
+	return oldValue.ImportRef, nil
 }
 
-// ClearPurchaseFrom clears the value of the "purchase_from" field.
-func (m *ItemMutation) ClearPurchaseFrom() {
-	m.purchase_from = nil
-	m.clearedFields[item.FieldPurchaseFrom] = struct{}{}
+// ClearImportRef clears the value of the "import_ref" field.
+func (m *ItemMutation) ClearImportRef() {
+	m.import_ref = nil
+	m.clearedFields[item.FieldImportRef] = struct{}{}
 }
 
-// PurchaseFromCleared returns if the "purchase_from" field was cleared in this mutation.
-func (m *ItemMutation) PurchaseFromCleared() bool {
-	_, ok := m.clearedFields[item.FieldPurchaseFrom]
+// ImportRefCleared returns if the "import_ref" field was cleared in this mutation.
+func (m *ItemMutation) ImportRefCleared() bool {
+	_, ok := m.clearedFields[item.FieldImportRef]
 	return ok
 }
 
-// ResetPurchaseFrom resets all changes to the "purchase_from" field.
-func (m *ItemMutation) ResetPurchaseFrom() {
-	m.purchase_from = nil
-	delete(m.clearedFields, item.FieldPurchaseFrom)
+// ResetImportRef resets all changes to the "import_ref" field.
+func (m *ItemMutation) ResetImportRef() {
+	m.import_ref = nil
+	delete(m.clearedFields, item.FieldImportRef)
 }
 
-// SetPurchasePrice sets the "purchase_price" field.
-func (m *ItemMutation) SetPurchasePrice(f float64) {
-	m.purchase_price = &f
-	m.addpurchase_price = nil
+// SetNotes sets the "notes" field.
+func (m *ItemMutation) SetNotes(s string) {
+	m.notes = &s
 }
 
-// PurchasePrice returns the value of the "purchase_price" field in the mutation.
-func (m *ItemMutation) PurchasePrice() (r float64, exists bool) {
-	v := m.purchase_price
+// Notes returns the value of the "notes" field in the mutation.
+func (m *ItemMutation) Notes() (r string, exists bool) {
+	v := m.notes
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPurchasePrice returns the old "purchase_price" field's value of the Item entity.
+// OldNotes returns the old "notes" field's value of the Item entity.
 // If the Item object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ItemMutation) OldPurchasePrice(ctx context.Context) (v float64, err error) {
+func (m *ItemMutation) OldNotes(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPurchasePrice is only allowed on UpdateOne operations")
+		return v, errors.New("OldNotes is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPurchasePrice requires an ID field in the mutation")
+		return v, errors.New("OldNotes requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPurchasePrice: %w", err)
+		return v, fmt.Errorf("querying old value for OldNotes: %w", err)
 	}
-	return oldValue.PurchasePrice, nil
+	return oldValue.Notes, nil
 }
 
-// AddPurchasePrice adds f to the "purchase_price" field.
-func (m *ItemMutation) AddPurchasePrice(f float64) {
-	if m.addpurchase_price != nil {
-		*m.addpurchase_price += f
-	} else {
-		m.addpurchase_price = &f
-	}
+// ClearNotes clears the value of the "notes" field.
+func (m *ItemMutation) ClearNotes() {
+	m.notes = nil
+	m.clearedFields[item.FieldNotes] = struct{}{}
 }
 
-// AddedPurchasePrice returns the value that was added to the "purchase_price" field in this mutation.
-func (m *ItemMutation) AddedPurchasePrice() (r float64, exists bool) {
-	v := m.addpurchase_price
-	if v == nil {
-		return
-	}
-	return *v, true
+// NotesCleared returns if the "notes" field was cleared in this mutation.
+func (m *ItemMutation) NotesCleared() bool {
+	_, ok := m.clearedFields[item.FieldNotes]
+	return ok
 }
 
-// ResetPurchasePrice resets all changes to the "purchase_price" field.
-func (m *ItemMutation) ResetPurchasePrice() {
-	m.purchase_price = nil
-	m.addpurchase_price = nil
+// ResetNotes resets all changes to the "notes" field.
+func (m *ItemMutation) ResetNotes() {
+	m.notes = nil
+	delete(m.clearedFields, item.FieldNotes)
 }
 
-// SetSoldTime sets the "sold_time" field.
-func (m *ItemMutation) SetSoldTime(t time.Time) {
-	m.sold_time = &t
+// SetNotesFormat sets the "notes_format" field.
+func (m *ItemMutation) SetNotesFormat(_if item.NotesFormat) {
+	m.notes_format = &_if
 }
 
-// SoldTime returns the value of the "sold_time" field in the mutation.
-func (m *ItemMutation) SoldTime() (r time.Time, exists bool) {
-	v := m.sold_time
+// NotesFormat returns the value of the "notes_format" field in the mutation.
+func (m *ItemMutation) NotesFormat() (r item.NotesFormat, exists bool) {
+	v := m.notes_format
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSoldTime returns the old "sold_time" field's value of the Item entity.
+// OldNotesFormat returns the old "notes_format" field's value of the Item entity.
 // If the Item object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ItemMutation) OldSoldTime(ctx context.Context) (v time.Time, err error) {
+func (m *ItemMutation) OldNotesFormat(ctx context.Context) (v item.NotesFormat, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSoldTime is only allowed on UpdateOne operations")
+		return v, errors.New("OldNotesFormat is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSoldTime requires an ID field in the mutation")
+		return v, errors.New("OldNotesFormat requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSoldTime: %w", err)
+		return v, fmt.Errorf("querying old value for OldNotesFormat: %w", err)
 	}
-	return oldValue.SoldTime, nil
-}
-
-// ClearSoldTime clears the value of the "sold_time" field.
-func (m *ItemMutation) ClearSoldTime() {
-	m.sold_time = nil
-	m.clearedFields[item.FieldSoldTime] = struct{}{}
-}
-
-// SoldTimeCleared returns if the "sold_time" field was cleared in this mutation.
-func (m *ItemMutation) SoldTimeCleared() bool {
-	_, ok := m.clearedFields[item.FieldSoldTime]
-	return ok
+	return oldValue.NotesFormat, nil
 }
 
-// ResetSoldTime resets all changes to the "sold_time" field.
-func (m *ItemMutation) ResetSoldTime() {
-	m.sold_time = nil
-	delete(m.clearedFields, item.FieldSoldTime)
+// ResetNotesFormat resets all changes to the "notes_format" field.
+func (m *ItemMutation) ResetNotesFormat() {
+	m.notes_format = nil
 }
 
-// SetSoldTo sets the "sold_to" field.
-func (m *ItemMutation) SetSoldTo(s string) {
-	m.sold_to = &s
+// SetQuantity sets the "quantity" field.
+func (m *ItemMutation) SetQuantity(i int) {
+	m.quantity = &i
+	m.addquantity = nil
 }
 
-// SoldTo returns the value of the "sold_to" field in the mutation.
-func (m *ItemMutation) SoldTo() (r string, exists bool) {
-	v := m.sold_to
+// Quantity returns the value of the "quantity" field in the mutation.
+func (m *ItemMutation) Quantity() (r int, exists bool) {
+	v := m.quantity
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSoldTo returns the old "sold_to" field's value of the Item entity.
+// OldQuantity returns the old "quantity" field's value of the Item entity.
 // If the Item object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ItemMutation) OldSoldTo(ctx context.Context) (v string, err error) {
+func (m *ItemMutation) OldQuantity(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSoldTo is only allowed on UpdateOne operations")
+		return v, errors.New("OldQuantity is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSoldTo requires an ID field in the mutation")
+		return v, errors.New("OldQuantity requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSoldTo: %w", err)
+		return v, fmt.Errorf("querying old value for OldQuantity: %w", err)
 	}
-	return oldValue.SoldTo, nil
+	return oldValue.Quantity, nil
 }
 
-// ClearSoldTo clears the value of the "sold_to" field.
-func (m *ItemMutation) ClearSoldTo() {
-	m.sold_to = nil
-	m.clearedFields[item.FieldSoldTo] = struct{}{}
+// AddQuantity adds i to the "quantity" field.
+func (m *ItemMutation) AddQuantity(i int) {
+	if m.addquantity != nil {
+		*m.addquantity += i
+	} else {
+		m.addquantity = &i
+	}
 }
 
-// SoldToCleared returns if the "sold_to" field was cleared in this mutation.
-func (m *ItemMutation) SoldToCleared() bool {
-	_, ok := m.clearedFields[item.FieldSoldTo]
-	return ok
+// AddedQuantity returns the value that was added to the "quantity" field in this mutation.
+func (m *ItemMutation) AddedQuantity() (r int, exists bool) {
+	v := m.addquantity
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ResetSoldTo resets all changes to the "sold_to" field.
-func (m *ItemMutation) ResetSoldTo() {
-	m.sold_to = nil
-	delete(m.clearedFields, item.FieldSoldTo)
+// ResetQuantity resets all changes to the "quantity" field.
+func (m *ItemMutation) ResetQuantity() {
+	m.quantity = nil
+	m.addquantity = nil
 }
 
-// SetSoldPrice sets the "sold_price" field.
-func (m *ItemMutation) SetSoldPrice(f float64) {
-	m.sold_price = &f
-	m.addsold_price = nil
+// SetInsured sets the "insured" field.
+func (m *ItemMutation) SetInsured(b bool) {
+	m.insured = &b
 }
 
-// SoldPrice returns the value of the "sold_price" field in the mutation.
-func (m *ItemMutation) SoldPrice() (r float64, exists bool) {
-	v := m.sold_price
+// Insured returns the value of the "insured" field in the mutation.
+func (m *ItemMutation) Insured() (r bool, exists bool) {
+	v := m.insured
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSoldPrice returns the old "sold_price" field's value of the Item entity.
+// OldInsured returns the old "insured" field's value of the Item entity.
 // If the Item object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ItemMutation) OldSoldPrice(ctx context.Context) (v float64, err error) {
+func (m *ItemMutation) OldInsured(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSoldPrice is only allowed on UpdateOne operations")
+		return v, errors.New("OldInsured is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSoldPrice requires an ID field in the mutation")
+		return v, errors.New("OldInsured requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSoldPrice: %w", err)
+		return v, fmt.Errorf("querying old value for OldInsured: %w", err)
 	}
-	return oldValue.SoldPrice, nil
+	return oldValue.Insured, nil
 }
 
-// AddSoldPrice adds f to the "sold_price" field.
-func (m *ItemMutation) AddSoldPrice(f float64) {
-	if m.addsold_price != nil {
-		*m.addsold_price += f
-	} else {
-		m.addsold_price = &f
-	}
+// ResetInsured resets all changes to the "insured" field.
+func (m *ItemMutation) ResetInsured() {
+	m.insured = nil
 }
 
-// AddedSoldPrice returns the value that was added to the "sold_price" field in this mutation.
-func (m *ItemMutation) AddedSoldPrice() (r float64, exists bool) {
-	v := m.addsold_price
+// SetArchived sets the "archived" field.
+func (m *ItemMutation) SetArchived(b bool) {
+	m.archived = &b
+}
+
+// Archived returns the value of the "archived" field in the mutation.
+func (m *ItemMutation) Archived() (r bool, exists bool) {
+	v := m.archived
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetSoldPrice resets all changes to the "sold_price" field.
-func (m *ItemMutation) ResetSoldPrice() {
-	m.sold_price = nil
-	m.addsold_price = nil
+// OldArchived returns the old "archived" field's value of the Item entity.
+// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemMutation) OldArchived(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldArchived is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldArchived requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldArchived: %w", err)
+	}
+	return oldValue.Archived, nil
 }
 
-// SetSoldNotes sets the "sold_notes" field.
-func (m *ItemMutation) SetSoldNotes(s string) {
-	m.sold_notes = &s
+// ResetArchived resets all changes to the "archived" field.
+func (m *ItemMutation) ResetArchived() {
+	m.archived = nil
 }
 
-// SoldNotes returns the value of the "sold_notes" field in the mutation.
-func (m *ItemMutation) SoldNotes() (r string, exists bool) {
-	v := m.sold_notes
+// SetFavorite sets the "favorite" field.
+func (m *ItemMutation) SetFavorite(b bool) {
+	m.favorite = &b
+}
+
+// Favorite returns the value of the "favorite" field in the mutation.
+func (m *ItemMutation) Favorite() (r bool, exists bool) {
+	v := m.favorite
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSoldNotes returns the old "sold_notes" field's value of the Item entity.
+// OldFavorite returns the old "favorite" field's value of the Item entity.
 // If the Item object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ItemMutation) OldSoldNotes(ctx context.Context) (v string, err error) {
+func (m *ItemMutation) OldFavorite(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSoldNotes is only allowed on UpdateOne operations")
+		return v, errors.New("OldFavorite is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSoldNotes requires an ID field in the mutation")
+		return v, errors.New("OldFavorite requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSoldNotes: %w", err)
+		return v, fmt.Errorf("querying old value for OldFavorite: %w", err)
 	}
-	return oldValue.SoldNotes, nil
+	return oldValue.Favorite, nil
 }
 
-// ClearSoldNotes clears the value of the "sold_notes" field.
-func (m *ItemMutation) ClearSoldNotes() {
-	m.sold_notes = nil
-	m.clearedFields[item.FieldSoldNotes] = struct{}{}
+// ResetFavorite resets all changes to the "favorite" field.
+func (m *ItemMutation) ResetFavorite() {
+	m.favorite = nil
 }
 
-// SoldNotesCleared returns if the "sold_notes" field was cleared in this mutation.
-func (m *ItemMutation) SoldNotesCleared() bool {
-	_, ok := m.clearedFields[item.FieldSoldNotes]
-	return ok
+// SetVersion sets the "version" field.
+func (m *ItemMutation) SetVersion(i int) {
+	m.version = &i
+	m.addversion = nil
 }
 
-// ResetSoldNotes resets all changes to the "sold_notes" field.
-func (m *ItemMutation) ResetSoldNotes() {
-	m.sold_notes = nil
-	delete(m.clearedFields, item.FieldSoldNotes)
+// Version returns the value of the "version" field in the mutation.
+func (m *ItemMutation) Version() (r int, exists bool) {
+	v := m.version
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// SetGroupID sets the "group" edge to the Group entity by id.
-func (m *ItemMutation) SetGroupID(id uuid.UUID) {
-	m.group = &id
+// OldVersion returns the old "version" field's value of the Item entity.
+// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemMutation) OldVersion(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVersion: %w", err)
+	}
+	return oldValue.Version, nil
 }
 
-// ClearGroup clears the "group" edge to the Group entity.
-func (m *ItemMutation) ClearGroup() {
-	m.clearedgroup = true
+// AddVersion adds i to the "version" field.
+func (m *ItemMutation) AddVersion(i int) {
+	if m.addversion != nil {
+		*m.addversion += i
+	} else {
+		m.addversion = &i
+	}
 }
 
-// GroupCleared reports if the "group" edge to the Group entity was cleared.
-func (m *ItemMutation) GroupCleared() bool {
-	return m.clearedgroup
+// AddedVersion returns the value that was added to the "version" field in this mutation.
+func (m *ItemMutation) AddedVersion() (r int, exists bool) {
+	v := m.addversion
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// GroupID returns the "group" edge ID in the mutation.
-func (m *ItemMutation) GroupID() (id uuid.UUID, exists bool) {
-	if m.group != nil {
-		return *m.group, true
+// ResetVersion resets all changes to the "version" field.
+func (m *ItemMutation) ResetVersion() {
+	m.version = nil
+	m.addversion = nil
+}
+
+// SetArchivedAt sets the "archived_at" field.
+func (m *ItemMutation) SetArchivedAt(t time.Time) {
+	m.archived_at = &t
+}
+
+// ArchivedAt returns the value of the "archived_at" field in the mutation.
+func (m *ItemMutation) ArchivedAt() (r time.Time, exists bool) {
+	v := m.archived_at
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// GroupIDs returns the "group" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// GroupID instead. It exists only for internal usage by the builders.
-func (m *ItemMutation) GroupIDs() (ids []uuid.UUID) {
-	if id := m.group; id != nil {
-		ids = append(ids, *id)
+// OldArchivedAt returns the old "archived_at" field's value of the Item entity.
+// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemMutation) OldArchivedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldArchivedAt is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldArchivedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldArchivedAt: %w", err)
+	}
+	return oldValue.ArchivedAt, nil
 }
 
-// ResetGroup resets all changes to the "group" edge.
-func (m *ItemMutation) ResetGroup() {
-	m.group = nil
-	m.clearedgroup = false
+// ClearArchivedAt clears the value of the "archived_at" field.
+func (m *ItemMutation) ClearArchivedAt() {
+	m.archived_at = nil
+	m.clearedFields[item.FieldArchivedAt] = struct{}{}
 }
 
-// SetParentID sets the "parent" edge to the Item entity by id.
-func (m *ItemMutation) SetParentID(id uuid.UUID) {
-	m.parent = &id
+// ArchivedAtCleared returns if the "archived_at" field was cleared in this mutation.
+func (m *ItemMutation) ArchivedAtCleared() bool {
+	_, ok := m.clearedFields[item.FieldArchivedAt]
+	return ok
 }
 
-// ClearParent clears the "parent" edge to the Item entity.
-func (m *ItemMutation) ClearParent() {
-	m.clearedparent = true
+// ResetArchivedAt resets all changes to the "archived_at" field.
+func (m *ItemMutation) ResetArchivedAt() {
+	m.archived_at = nil
+	delete(m.clearedFields, item.FieldArchivedAt)
 }
 
-// ParentCleared reports if the "parent" edge to the Item entity was cleared.
-func (m *ItemMutation) ParentCleared() bool {
-	return m.clearedparent
+// SetAssetID sets the "asset_id" field.
+func (m *ItemMutation) SetAssetID(i int) {
+	m.asset_id = &i
+	m.addasset_id = nil
 }
 
-// ParentID returns the "parent" edge ID in the mutation.
-func (m *ItemMutation) ParentID() (id uuid.UUID, exists bool) {
-	if m.parent != nil {
-		return *m.parent, true
+// AssetID returns the value of the "asset_id" field in the mutation.
+func (m *ItemMutation) AssetID() (r int, exists bool) {
+	v := m.asset_id
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// ParentIDs returns the "parent" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// ParentID instead. It exists only for internal usage by the builders.
-func (m *ItemMutation) ParentIDs() (ids []uuid.UUID) {
-	if id := m.parent; id != nil {
-		ids = append(ids, *id)
+// OldAssetID returns the old "asset_id" field's value of the Item entity.
+// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemMutation) OldAssetID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAssetID is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAssetID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAssetID: %w", err)
+	}
+	return oldValue.AssetID, nil
 }
 
-// ResetParent resets all changes to the "parent" edge.
-func (m *ItemMutation) ResetParent() {
-	m.parent = nil
-	m.clearedparent = false
+// AddAssetID adds i to the "asset_id" field.
+func (m *ItemMutation) AddAssetID(i int) {
+	if m.addasset_id != nil {
+		*m.addasset_id += i
+	} else {
+		m.addasset_id = &i
+	}
 }
 
-// AddChildIDs adds the "children" edge to the Item entity by ids.
-func (m *ItemMutation) AddChildIDs(ids ...uuid.UUID) {
-	if m.children == nil {
-		m.children = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.children[ids[i]] = struct{}{}
+// AddedAssetID returns the value that was added to the "asset_id" field in this mutation.
+func (m *ItemMutation) AddedAssetID() (r int, exists bool) {
+	v := m.addasset_id
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// ClearChildren clears the "children" edge to the Item entity.
-func (m *ItemMutation) ClearChildren() {
-	m.clearedchildren = true
+// ResetAssetID resets all changes to the "asset_id" field.
+func (m *ItemMutation) ResetAssetID() {
+	m.asset_id = nil
+	m.addasset_id = nil
 }
 
-// ChildrenCleared reports if the "children" edge to the Item entity was cleared.
-func (m *ItemMutation) ChildrenCleared() bool {
-	return m.clearedchildren
+// SetReorderThreshold sets the "reorder_threshold" field.
+func (m *ItemMutation) SetReorderThreshold(i int) {
+	m.reorder_threshold = &i
+	m.addreorder_threshold = nil
 }
 
-// RemoveChildIDs removes the "children" edge to the Item entity by IDs.
-func (m *ItemMutation) RemoveChildIDs(ids ...uuid.UUID) {
-	if m.removedchildren == nil {
-		m.removedchildren = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.children, ids[i])
-		m.removedchildren[ids[i]] = struct{}{}
+// ReorderThreshold returns the value of the "reorder_threshold" field in the mutation.
+func (m *ItemMutation) ReorderThreshold() (r int, exists bool) {
+	v := m.reorder_threshold
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedChildren returns the removed IDs of the "children" edge to the Item entity.
-func (m *ItemMutation) RemovedChildrenIDs() (ids []uuid.UUID) {
-	for id := range m.removedchildren {
-		ids = append(ids, id)
+// OldReorderThreshold returns the old "reorder_threshold" field's value of the Item entity.
+// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemMutation) OldReorderThreshold(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReorderThreshold is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReorderThreshold requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReorderThreshold: %w", err)
+	}
+	return oldValue.ReorderThreshold, nil
 }
 
-// ChildrenIDs returns the "children" edge IDs in the mutation.
-func (m *ItemMutation) ChildrenIDs() (ids []uuid.UUID) {
-	for id := range m.children {
-		ids = append(ids, id)
+// AddReorderThreshold adds i to the "reorder_threshold" field.
+func (m *ItemMutation) AddReorderThreshold(i int) {
+	if m.addreorder_threshold != nil {
+		*m.addreorder_threshold += i
+	} else {
+		m.addreorder_threshold = &i
 	}
-	return
 }
 
-// ResetChildren resets all changes to the "children" edge.
-func (m *ItemMutation) ResetChildren() {
-	m.children = nil
-	m.clearedchildren = false
-	m.removedchildren = nil
+// AddedReorderThreshold returns the value that was added to the "reorder_threshold" field in this mutation.
+func (m *ItemMutation) AddedReorderThreshold() (r int, exists bool) {
+	v := m.addreorder_threshold
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// AddLabelIDs adds the "label" edge to the Label entity by ids.
-func (m *ItemMutation) AddLabelIDs(ids ...uuid.UUID) {
-	if m.label == nil {
-		m.label = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.label[ids[i]] = struct{}{}
-	}
+// ResetReorderThreshold resets all changes to the "reorder_threshold" field.
+func (m *ItemMutation) ResetReorderThreshold() {
+	m.reorder_threshold = nil
+	m.addreorder_threshold = nil
 }
 
-// ClearLabel clears the "label" edge to the Label entity.
-func (m *ItemMutation) ClearLabel() {
-	m.clearedlabel = true
+// SetTags sets the "tags" field.
+func (m *ItemMutation) SetTags(s []string) {
+	m.tags = &s
+	m.appendtags = nil
 }
 
-// LabelCleared reports if the "label" edge to the Label entity was cleared.
-func (m *ItemMutation) LabelCleared() bool {
-	return m.clearedlabel
+// Tags returns the value of the "tags" field in the mutation.
+func (m *ItemMutation) Tags() (r []string, exists bool) {
+	v := m.tags
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// RemoveLabelIDs removes the "label" edge to the Label entity by IDs.
-func (m *ItemMutation) RemoveLabelIDs(ids ...uuid.UUID) {
-	if m.removedlabel == nil {
-		m.removedlabel = make(map[uuid.UUID]struct{})
+// OldTags returns the old "tags" field's value of the Item entity.
+// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemMutation) OldTags(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTags is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		delete(m.label, ids[i])
-		m.removedlabel[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTags requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTags: %w", err)
 	}
+	return oldValue.Tags, nil
 }
 
-// RemovedLabel returns the removed IDs of the "label" edge to the Label entity.
-func (m *ItemMutation) RemovedLabelIDs() (ids []uuid.UUID) {
-	for id := range m.removedlabel {
-		ids = append(ids, id)
-	}
-	return
+// AppendTags adds s to the "tags" field.
+func (m *ItemMutation) AppendTags(s []string) {
+	m.appendtags = append(m.appendtags, s...)
 }
 
-// LabelIDs returns the "label" edge IDs in the mutation.
-func (m *ItemMutation) LabelIDs() (ids []uuid.UUID) {
-	for id := range m.label {
-		ids = append(ids, id)
+// AppendedTags returns the list of values that were appended to the "tags" field in this mutation.
+func (m *ItemMutation) AppendedTags() ([]string, bool) {
+	if len(m.appendtags) == 0 {
+		return nil, false
 	}
-	return
+	return m.appendtags, true
 }
 
-// ResetLabel resets all changes to the "label" edge.
-func (m *ItemMutation) ResetLabel() {
-	m.label = nil
-	m.clearedlabel = false
-	m.removedlabel = nil
+// ClearTags clears the value of the "tags" field.
+func (m *ItemMutation) ClearTags() {
+	m.tags = nil
+	m.appendtags = nil
+	m.clearedFields[item.FieldTags] = struct{}{}
 }
 
-// SetLocationID sets the "location" edge to the Location entity by id.
-func (m *ItemMutation) SetLocationID(id uuid.UUID) {
-	m.location = &id
+// TagsCleared returns if the "tags" field was cleared in this mutation.
+func (m *ItemMutation) TagsCleared() bool {
+	_, ok := m.clearedFields[item.FieldTags]
+	return ok
 }
 
-// ClearLocation clears the "location" edge to the Location entity.
-func (m *ItemMutation) ClearLocation() {
-	m.clearedlocation = true
+// ResetTags resets all changes to the "tags" field.
+func (m *ItemMutation) ResetTags() {
+	m.tags = nil
+	m.appendtags = nil
+	delete(m.clearedFields, item.FieldTags)
 }
 
-// LocationCleared reports if the "location" edge to the Location entity was cleared.
-func (m *ItemMutation) LocationCleared() bool {
-	return m.clearedlocation
+// SetSource sets the "source" field.
+func (m *ItemMutation) SetSource(i item.Source) {
+	m.source = &i
 }
 
-// LocationID returns the "location" edge ID in the mutation.
-func (m *ItemMutation) LocationID() (id uuid.UUID, exists bool) {
-	if m.location != nil {
-		return *m.location, true
+// Source returns the value of the "source" field in the mutation.
+func (m *ItemMutation) Source() (r item.Source, exists bool) {
+	v := m.source
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// LocationIDs returns the "location" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// LocationID instead. It exists only for internal usage by the builders.
-func (m *ItemMutation) LocationIDs() (ids []uuid.UUID) {
-	if id := m.location; id != nil {
-		ids = append(ids, *id)
+// OldSource returns the old "source" field's value of the Item entity.
+// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemMutation) OldSource(ctx context.Context) (v item.Source, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSource is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSource requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSource: %w", err)
+	}
+	return oldValue.Source, nil
 }
 
-// ResetLocation resets all changes to the "location" edge.
-func (m *ItemMutation) ResetLocation() {
-	m.location = nil
-	m.clearedlocation = false
+// ResetSource resets all changes to the "source" field.
+func (m *ItemMutation) ResetSource() {
+	m.source = nil
 }
 
-// AddFieldIDs adds the "fields" edge to the ItemField entity by ids.
-func (m *ItemMutation) AddFieldIDs(ids ...uuid.UUID) {
-	if m.fields == nil {
-		m.fields = make(map[uuid.UUID]struct{})
+// SetAcquisitionType sets the "acquisition_type" field.
+func (m *ItemMutation) SetAcquisitionType(it item.AcquisitionType) {
+	m.acquisition_type = &it
+}
+
+// AcquisitionType returns the value of the "acquisition_type" field in the mutation.
+func (m *ItemMutation) AcquisitionType() (r item.AcquisitionType, exists bool) {
+	v := m.acquisition_type
+	if v == nil {
+		return
 	}
-	for i := range ids {
-		m.fields[ids[i]] = struct{}{}
+	return *v, true
+}
+
+// OldAcquisitionType returns the old "acquisition_type" field's value of the Item entity.
+// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemMutation) OldAcquisitionType(ctx context.Context) (v item.AcquisitionType, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAcquisitionType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAcquisitionType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAcquisitionType: %w", err)
 	}
+	return oldValue.AcquisitionType, nil
 }
 
-// ClearFields clears the "fields" edge to the ItemField entity.
-func (m *ItemMutation) ClearFields() {
-	m.clearedfields = true
+// ResetAcquisitionType resets all changes to the "acquisition_type" field.
+func (m *ItemMutation) ResetAcquisitionType() {
+	m.acquisition_type = nil
 }
 
-// FieldsCleared reports if the "fields" edge to the ItemField entity was cleared.
-func (m *ItemMutation) FieldsCleared() bool {
-	return m.clearedfields
+// SetLatitude sets the "latitude" field.
+func (m *ItemMutation) SetLatitude(f float64) {
+	m.latitude = &f
+	m.addlatitude = nil
 }
 
-// RemoveFieldIDs removes the "fields" edge to the ItemField entity by IDs.
-func (m *ItemMutation) RemoveFieldIDs(ids ...uuid.UUID) {
-	if m.removedfields == nil {
-		m.removedfields = make(map[uuid.UUID]struct{})
+// Latitude returns the value of the "latitude" field in the mutation.
+func (m *ItemMutation) Latitude() (r float64, exists bool) {
+	v := m.latitude
+	if v == nil {
+		return
 	}
-	for i := range ids {
-		delete(m.fields, ids[i])
-		m.removedfields[ids[i]] = struct{}{}
+	return *v, true
+}
+
+// OldLatitude returns the old "latitude" field's value of the Item entity.
+// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemMutation) OldLatitude(ctx context.Context) (v *float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLatitude is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLatitude requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLatitude: %w", err)
 	}
+	return oldValue.Latitude, nil
 }
 
-// RemovedFields returns the removed IDs of the "fields" edge to the ItemField entity.
-func (m *ItemMutation) RemovedFieldsIDs() (ids []uuid.UUID) {
-	for id := range m.removedfields {
-		ids = append(ids, id)
+// AddLatitude adds f to the "latitude" field.
+func (m *ItemMutation) AddLatitude(f float64) {
+	if m.addlatitude != nil {
+		*m.addlatitude += f
+	} else {
+		m.addlatitude = &f
 	}
-	return
 }
 
-// FieldsIDs returns the "fields" edge IDs in the mutation.
-func (m *ItemMutation) FieldsIDs() (ids []uuid.UUID) {
-	for id := range m.fields {
-		ids = append(ids, id)
+// AddedLatitude returns the value that was added to the "latitude" field in this mutation.
+func (m *ItemMutation) AddedLatitude() (r float64, exists bool) {
+	v := m.addlatitude
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// ResetFields resets all changes to the "fields" edge.
-func (m *ItemMutation) ResetFields() {
-	m.fields = nil
-	m.clearedfields = false
-	m.removedfields = nil
+// ClearLatitude clears the value of the "latitude" field.
+func (m *ItemMutation) ClearLatitude() {
+	m.latitude = nil
+	m.addlatitude = nil
+	m.clearedFields[item.FieldLatitude] = struct{}{}
 }
 
-// AddMaintenanceEntryIDs adds the "maintenance_entries" edge to the MaintenanceEntry entity by ids.
-func (m *ItemMutation) AddMaintenanceEntryIDs(ids ...uuid.UUID) {
-	if m.maintenance_entries == nil {
-		m.maintenance_entries = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.maintenance_entries[ids[i]] = struct{}{}
-	}
+// LatitudeCleared returns if the "latitude" field was cleared in this mutation.
+func (m *ItemMutation) LatitudeCleared() bool {
+	_, ok := m.clearedFields[item.FieldLatitude]
+	return ok
 }
 
-// ClearMaintenanceEntries clears the "maintenance_entries" edge to the MaintenanceEntry entity.
-func (m *ItemMutation) ClearMaintenanceEntries() {
-	m.clearedmaintenance_entries = true
+// ResetLatitude resets all changes to the "latitude" field.
+func (m *ItemMutation) ResetLatitude() {
+	m.latitude = nil
+	m.addlatitude = nil
+	delete(m.clearedFields, item.FieldLatitude)
 }
 
-// MaintenanceEntriesCleared reports if the "maintenance_entries" edge to the MaintenanceEntry entity was cleared.
-func (m *ItemMutation) MaintenanceEntriesCleared() bool {
-	return m.clearedmaintenance_entries
+// SetLongitude sets the "longitude" field.
+func (m *ItemMutation) SetLongitude(f float64) {
+	m.longitude = &f
+	m.addlongitude = nil
 }
 
-// RemoveMaintenanceEntryIDs removes the "maintenance_entries" edge to the MaintenanceEntry entity by IDs.
-func (m *ItemMutation) RemoveMaintenanceEntryIDs(ids ...uuid.UUID) {
-	if m.removedmaintenance_entries == nil {
-		m.removedmaintenance_entries = make(map[uuid.UUID]struct{})
+// Longitude returns the value of the "longitude" field in the mutation.
+func (m *ItemMutation) Longitude() (r float64, exists bool) {
+	v := m.longitude
+	if v == nil {
+		return
 	}
-	for i := range ids {
-		delete(m.maintenance_entries, ids[i])
-		m.removedmaintenance_entries[ids[i]] = struct{}{}
+	return *v, true
+}
+
+// OldLongitude returns the old "longitude" field's value of the Item entity.
+// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemMutation) OldLongitude(ctx context.Context) (v *float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLongitude is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLongitude requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLongitude: %w", err)
 	}
+	return oldValue.Longitude, nil
 }
 
-// RemovedMaintenanceEntries returns the removed IDs of the "maintenance_entries" edge to the MaintenanceEntry entity.
-func (m *ItemMutation) RemovedMaintenanceEntriesIDs() (ids []uuid.UUID) {
-	for id := range m.removedmaintenance_entries {
-		ids = append(ids, id)
+// AddLongitude adds f to the "longitude" field.
+func (m *ItemMutation) AddLongitude(f float64) {
+	if m.addlongitude != nil {
+		*m.addlongitude += f
+	} else {
+		m.addlongitude = &f
 	}
-	return
 }
 
-// MaintenanceEntriesIDs returns the "maintenance_entries" edge IDs in the mutation.
-func (m *ItemMutation) MaintenanceEntriesIDs() (ids []uuid.UUID) {
-	for id := range m.maintenance_entries {
-		ids = append(ids, id)
+// AddedLongitude returns the value that was added to the "longitude" field in this mutation.
+func (m *ItemMutation) AddedLongitude() (r float64, exists bool) {
+	v := m.addlongitude
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// ResetMaintenanceEntries resets all changes to the "maintenance_entries" edge.
-func (m *ItemMutation) ResetMaintenanceEntries() {
-	m.maintenance_entries = nil
-	m.clearedmaintenance_entries = false
-	m.removedmaintenance_entries = nil
+// ClearLongitude clears the value of the "longitude" field.
+func (m *ItemMutation) ClearLongitude() {
+	m.longitude = nil
+	m.addlongitude = nil
+	m.clearedFields[item.FieldLongitude] = struct{}{}
 }
 
-// AddAttachmentIDs adds the "attachments" edge to the Attachment entity by ids.
-func (m *ItemMutation) AddAttachmentIDs(ids ...uuid.UUID) {
-	if m.attachments == nil {
-		m.attachments = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.attachments[ids[i]] = struct{}{}
-	}
+// LongitudeCleared returns if the "longitude" field was cleared in this mutation.
+func (m *ItemMutation) LongitudeCleared() bool {
+	_, ok := m.clearedFields[item.FieldLongitude]
+	return ok
 }
 
-// ClearAttachments clears the "attachments" edge to the Attachment entity.
-func (m *ItemMutation) ClearAttachments() {
-	m.clearedattachments = true
+// ResetLongitude resets all changes to the "longitude" field.
+func (m *ItemMutation) ResetLongitude() {
+	m.longitude = nil
+	m.addlongitude = nil
+	delete(m.clearedFields, item.FieldLongitude)
 }
 
-// AttachmentsCleared reports if the "attachments" edge to the Attachment entity was cleared.
-func (m *ItemMutation) AttachmentsCleared() bool {
-	return m.clearedattachments
+// SetSerialNumber sets the "serial_number" field.
+func (m *ItemMutation) SetSerialNumber(s string) {
+	m.serial_number = &s
 }
 
-// RemoveAttachmentIDs removes the "attachments" edge to the Attachment entity by IDs.
-func (m *ItemMutation) RemoveAttachmentIDs(ids ...uuid.UUID) {
-	if m.removedattachments == nil {
-		m.removedattachments = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.attachments, ids[i])
-		m.removedattachments[ids[i]] = struct{}{}
+// SerialNumber returns the value of the "serial_number" field in the mutation.
+func (m *ItemMutation) SerialNumber() (r string, exists bool) {
+	v := m.serial_number
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedAttachments returns the removed IDs of the "attachments" edge to the Attachment entity.
-func (m *ItemMutation) RemovedAttachmentsIDs() (ids []uuid.UUID) {
-	for id := range m.removedattachments {
-		ids = append(ids, id)
+// OldSerialNumber returns the old "serial_number" field's value of the Item entity.
+// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemMutation) OldSerialNumber(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSerialNumber is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSerialNumber requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSerialNumber: %w", err)
+	}
+	return oldValue.SerialNumber, nil
 }
 
-// AttachmentsIDs returns the "attachments" edge IDs in the mutation.
-func (m *ItemMutation) AttachmentsIDs() (ids []uuid.UUID) {
-	for id := range m.attachments {
-		ids = append(ids, id)
-	}
-	return
+// ClearSerialNumber clears the value of the "serial_number" field.
+func (m *ItemMutation) ClearSerialNumber() {
+	m.serial_number = nil
+	m.clearedFields[item.FieldSerialNumber] = struct{}{}
 }
 
-// ResetAttachments resets all changes to the "attachments" edge.
-func (m *ItemMutation) ResetAttachments() {
-	m.attachments = nil
-	m.clearedattachments = false
-	m.removedattachments = nil
+// SerialNumberCleared returns if the "serial_number" field was cleared in this mutation.
+func (m *ItemMutation) SerialNumberCleared() bool {
+	_, ok := m.clearedFields[item.FieldSerialNumber]
+	return ok
 }
 
-// Where appends a list predicates to the ItemMutation builder.
-func (m *ItemMutation) Where(ps ...predicate.Item) {
-	m.predicates = append(m.predicates, ps...)
+// ResetSerialNumber resets all changes to the "serial_number" field.
+func (m *ItemMutation) ResetSerialNumber() {
+	m.serial_number = nil
+	delete(m.clearedFields, item.FieldSerialNumber)
 }
 
-// WhereP appends storage-level predicates to the ItemMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *ItemMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Item, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// SetBarcode sets the "barcode" field.
+func (m *ItemMutation) SetBarcode(s string) {
+	m.barcode = &s
+}
+
+// Barcode returns the value of the "barcode" field in the mutation.
+func (m *ItemMutation) Barcode() (r string, exists bool) {
+	v := m.barcode
+	if v == nil {
+		return
 	}
-	m.Where(p...)
+	return *v, true
 }
 
-// Op returns the operation name.
-func (m *ItemMutation) Op() Op {
-	return m.op
+// OldBarcode returns the old "barcode" field's value of the Item entity.
+// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemMutation) OldBarcode(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBarcode is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBarcode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBarcode: %w", err)
+	}
+	return oldValue.Barcode, nil
 }
 
-// SetOp allows setting the mutation operation.
-func (m *ItemMutation) SetOp(op Op) {
-	m.op = op
+// ClearBarcode clears the value of the "barcode" field.
+func (m *ItemMutation) ClearBarcode() {
+	m.barcode = nil
+	m.clearedFields[item.FieldBarcode] = struct{}{}
 }
 
-// Type returns the node type of this mutation (Item).
-func (m *ItemMutation) Type() string {
-	return m.typ
+// BarcodeCleared returns if the "barcode" field was cleared in this mutation.
+func (m *ItemMutation) BarcodeCleared() bool {
+	_, ok := m.clearedFields[item.FieldBarcode]
+	return ok
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *ItemMutation) Fields() []string {
-	fields := make([]string, 0, 23)
-	if m.created_at != nil {
-		fields = append(fields, item.FieldCreatedAt)
-	}
-	if m.updated_at != nil {
-		fields = append(fields, item.FieldUpdatedAt)
-	}
-	if m.name != nil {
-		fields = append(fields, item.FieldName)
-	}
-	if m.description != nil {
-		fields = append(fields, item.FieldDescription)
-	}
-	if m.import_ref != nil {
-		fields = append(fields, item.FieldImportRef)
+// ResetBarcode resets all changes to the "barcode" field.
+func (m *ItemMutation) ResetBarcode() {
+	m.barcode = nil
+	delete(m.clearedFields, item.FieldBarcode)
+}
+
+// SetModelNumber sets the "model_number" field.
+func (m *ItemMutation) SetModelNumber(s string) {
+	m.model_number = &s
+}
+
+// ModelNumber returns the value of the "model_number" field in the mutation.
+func (m *ItemMutation) ModelNumber() (r string, exists bool) {
+	v := m.model_number
+	if v == nil {
+		return
 	}
-	if m.notes != nil {
-		fields = append(fields, item.FieldNotes)
+	return *v, true
+}
+
+// OldModelNumber returns the old "model_number" field's value of the Item entity.
+// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemMutation) OldModelNumber(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldModelNumber is only allowed on UpdateOne operations")
 	}
-	if m.quantity != nil {
-		fields = append(fields, item.FieldQuantity)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldModelNumber requires an ID field in the mutation")
 	}
-	if m.insured != nil {
-		fields = append(fields, item.FieldInsured)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldModelNumber: %w", err)
 	}
-	if m.archived != nil {
-		fields = append(fields, item.FieldArchived)
+	return oldValue.ModelNumber, nil
+}
+
+// ClearModelNumber clears the value of the "model_number" field.
+func (m *ItemMutation) ClearModelNumber() {
+	m.model_number = nil
+	m.clearedFields[item.FieldModelNumber] = struct{}{}
+}
+
+// ModelNumberCleared returns if the "model_number" field was cleared in this mutation.
+func (m *ItemMutation) ModelNumberCleared() bool {
+	_, ok := m.clearedFields[item.FieldModelNumber]
+	return ok
+}
+
+// ResetModelNumber resets all changes to the "model_number" field.
+func (m *ItemMutation) ResetModelNumber() {
+	m.model_number = nil
+	delete(m.clearedFields, item.FieldModelNumber)
+}
+
+// SetManufacturer sets the "manufacturer" field.
+func (m *ItemMutation) SetManufacturer(s string) {
+	m.manufacturer = &s
+}
+
+// Manufacturer returns the value of the "manufacturer" field in the mutation.
+func (m *ItemMutation) Manufacturer() (r string, exists bool) {
+	v := m.manufacturer
+	if v == nil {
+		return
 	}
-	if m.asset_id != nil {
-		fields = append(fields, item.FieldAssetID)
+	return *v, true
+}
+
+// OldManufacturer returns the old "manufacturer" field's value of the Item entity.
+// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemMutation) OldManufacturer(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldManufacturer is only allowed on UpdateOne operations")
 	}
-	if m.serial_number != nil {
-		fields = append(fields, item.FieldSerialNumber)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldManufacturer requires an ID field in the mutation")
 	}
-	if m.model_number != nil {
-		fields = append(fields, item.FieldModelNumber)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldManufacturer: %w", err)
 	}
-	if m.manufacturer != nil {
-		fields = append(fields, item.FieldManufacturer)
+	return oldValue.Manufacturer, nil
+}
+
+// ClearManufacturer clears the value of the "manufacturer" field.
+func (m *ItemMutation) ClearManufacturer() {
+	m.manufacturer = nil
+	m.clearedFields[item.FieldManufacturer] = struct{}{}
+}
+
+// ManufacturerCleared returns if the "manufacturer" field was cleared in this mutation.
+func (m *ItemMutation) ManufacturerCleared() bool {
+	_, ok := m.clearedFields[item.FieldManufacturer]
+	return ok
+}
+
+// ResetManufacturer resets all changes to the "manufacturer" field.
+func (m *ItemMutation) ResetManufacturer() {
+	m.manufacturer = nil
+	delete(m.clearedFields, item.FieldManufacturer)
+}
+
+// SetLifetimeWarranty sets the "lifetime_warranty" field.
+func (m *ItemMutation) SetLifetimeWarranty(b bool) {
+	m.lifetime_warranty = &b
+}
+
+// LifetimeWarranty returns the value of the "lifetime_warranty" field in the mutation.
+func (m *ItemMutation) LifetimeWarranty() (r bool, exists bool) {
+	v := m.lifetime_warranty
+	if v == nil {
+		return
 	}
-	if m.lifetime_warranty != nil {
-		fields = append(fields, item.FieldLifetimeWarranty)
+	return *v, true
+}
+
+// OldLifetimeWarranty returns the old "lifetime_warranty" field's value of the Item entity.
+// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemMutation) OldLifetimeWarranty(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLifetimeWarranty is only allowed on UpdateOne operations")
 	}
-	if m.warranty_expires != nil {
-		fields = append(fields, item.FieldWarrantyExpires)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLifetimeWarranty requires an ID field in the mutation")
 	}
-	if m.warranty_details != nil {
-		fields = append(fields, item.FieldWarrantyDetails)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLifetimeWarranty: %w", err)
 	}
-	if m.purchase_time != nil {
-		fields = append(fields, item.FieldPurchaseTime)
+	return oldValue.LifetimeWarranty, nil
+}
+
+// ResetLifetimeWarranty resets all changes to the "lifetime_warranty" field.
+func (m *ItemMutation) ResetLifetimeWarranty() {
+	m.lifetime_warranty = nil
+}
+
+// SetWarrantyExpires sets the "warranty_expires" field.
+func (m *ItemMutation) SetWarrantyExpires(t time.Time) {
+	m.warranty_expires = &t
+}
+
+// WarrantyExpires returns the value of the "warranty_expires" field in the mutation.
+func (m *ItemMutation) WarrantyExpires() (r time.Time, exists bool) {
+	v := m.warranty_expires
+	if v == nil {
+		return
 	}
-	if m.purchase_from != nil {
-		fields = append(fields, item.FieldPurchaseFrom)
+	return *v, true
+}
+
+// OldWarrantyExpires returns the old "warranty_expires" field's value of the Item entity.
+// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemMutation) OldWarrantyExpires(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWarrantyExpires is only allowed on UpdateOne operations")
 	}
-	if m.purchase_price != nil {
-		fields = append(fields, item.FieldPurchasePrice)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWarrantyExpires requires an ID field in the mutation")
 	}
-	if m.sold_time != nil {
-		fields = append(fields, item.FieldSoldTime)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWarrantyExpires: %w", err)
 	}
-	if m.sold_to != nil {
-		fields = append(fields, item.FieldSoldTo)
+	return oldValue.WarrantyExpires, nil
+}
+
+// ClearWarrantyExpires clears the value of the "warranty_expires" field.
+func (m *ItemMutation) ClearWarrantyExpires() {
+	m.warranty_expires = nil
+	m.clearedFields[item.FieldWarrantyExpires] = struct{}{}
+}
+
+// WarrantyExpiresCleared returns if the "warranty_expires" field was cleared in this mutation.
+func (m *ItemMutation) WarrantyExpiresCleared() bool {
+	_, ok := m.clearedFields[item.FieldWarrantyExpires]
+	return ok
+}
+
+// ResetWarrantyExpires resets all changes to the "warranty_expires" field.
+func (m *ItemMutation) ResetWarrantyExpires() {
+	m.warranty_expires = nil
+	delete(m.clearedFields, item.FieldWarrantyExpires)
+}
+
+// SetWarrantyDetails sets the "warranty_details" field.
+func (m *ItemMutation) SetWarrantyDetails(s string) {
+	m.warranty_details = &s
+}
+
+// WarrantyDetails returns the value of the "warranty_details" field in the mutation.
+func (m *ItemMutation) WarrantyDetails() (r string, exists bool) {
+	v := m.warranty_details
+	if v == nil {
+		return
 	}
-	if m.sold_price != nil {
-		fields = append(fields, item.FieldSoldPrice)
+	return *v, true
+}
+
+// OldWarrantyDetails returns the old "warranty_details" field's value of the Item entity.
+// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemMutation) OldWarrantyDetails(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWarrantyDetails is only allowed on UpdateOne operations")
 	}
-	if m.sold_notes != nil {
-		fields = append(fields, item.FieldSoldNotes)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWarrantyDetails requires an ID field in the mutation")
 	}
-	return fields
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWarrantyDetails: %w", err)
+	}
+	return oldValue.WarrantyDetails, nil
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *ItemMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case item.FieldCreatedAt:
-		return m.CreatedAt()
-	case item.FieldUpdatedAt:
-		return m.UpdatedAt()
-	case item.FieldName:
-		return m.Name()
-	case item.FieldDescription:
-		return m.Description()
-	case item.FieldImportRef:
-		return m.ImportRef()
-	case item.FieldNotes:
-		return m.Notes()
-	case item.FieldQuantity:
-		return m.Quantity()
-	case item.FieldInsured:
-		return m.Insured()
-	case item.FieldArchived:
-		return m.Archived()
-	case item.FieldAssetID:
-		return m.AssetID()
-	case item.FieldSerialNumber:
-		return m.SerialNumber()
-	case item.FieldModelNumber:
-		return m.ModelNumber()
-	case item.FieldManufacturer:
-		return m.Manufacturer()
-	case item.FieldLifetimeWarranty:
-		return m.LifetimeWarranty()
-	case item.FieldWarrantyExpires:
-		return m.WarrantyExpires()
-	case item.FieldWarrantyDetails:
-		return m.WarrantyDetails()
-	case item.FieldPurchaseTime:
-		return m.PurchaseTime()
-	case item.FieldPurchaseFrom:
-		return m.PurchaseFrom()
-	case item.FieldPurchasePrice:
-		return m.PurchasePrice()
-	case item.FieldSoldTime:
-		return m.SoldTime()
-	case item.FieldSoldTo:
-		return m.SoldTo()
-	case item.FieldSoldPrice:
-		return m.SoldPrice()
-	case item.FieldSoldNotes:
-		return m.SoldNotes()
+// ClearWarrantyDetails clears the value of the "warranty_details" field.
+func (m *ItemMutation) ClearWarrantyDetails() {
+	m.warranty_details = nil
+	m.clearedFields[item.FieldWarrantyDetails] = struct{}{}
+}
+
+// WarrantyDetailsCleared returns if the "warranty_details" field was cleared in this mutation.
+func (m *ItemMutation) WarrantyDetailsCleared() bool {
+	_, ok := m.clearedFields[item.FieldWarrantyDetails]
+	return ok
+}
+
+// ResetWarrantyDetails resets all changes to the "warranty_details" field.
+func (m *ItemMutation) ResetWarrantyDetails() {
+	m.warranty_details = nil
+	delete(m.clearedFields, item.FieldWarrantyDetails)
+}
+
+// SetPurchaseTime sets the "purchase_time" field.
+func (m *ItemMutation) SetPurchaseTime(t time.Time) {
+	m.purchase_time = &t
+}
+
+// PurchaseTime returns the value of the "purchase_time" field in the mutation.
+func (m *ItemMutation) PurchaseTime() (r time.Time, exists bool) {
+	v := m.purchase_time
+	if v == nil {
+		return
 	}
-	return nil, false
+	return *v, true
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *ItemMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case item.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case item.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
-	case item.FieldName:
-		return m.OldName(ctx)
-	case item.FieldDescription:
-		return m.OldDescription(ctx)
-	case item.FieldImportRef:
-		return m.OldImportRef(ctx)
-	case item.FieldNotes:
-		return m.OldNotes(ctx)
-	case item.FieldQuantity:
-		return m.OldQuantity(ctx)
-	case item.FieldInsured:
-		return m.OldInsured(ctx)
-	case item.FieldArchived:
-		return m.OldArchived(ctx)
-	case item.FieldAssetID:
-		return m.OldAssetID(ctx)
-	case item.FieldSerialNumber:
-		return m.OldSerialNumber(ctx)
-	case item.FieldModelNumber:
-		return m.OldModelNumber(ctx)
-	case item.FieldManufacturer:
-		return m.OldManufacturer(ctx)
-	case item.FieldLifetimeWarranty:
-		return m.OldLifetimeWarranty(ctx)
-	case item.FieldWarrantyExpires:
-		return m.OldWarrantyExpires(ctx)
-	case item.FieldWarrantyDetails:
-		return m.OldWarrantyDetails(ctx)
-	case item.FieldPurchaseTime:
-		return m.OldPurchaseTime(ctx)
-	case item.FieldPurchaseFrom:
-		return m.OldPurchaseFrom(ctx)
-	case item.FieldPurchasePrice:
-		return m.OldPurchasePrice(ctx)
-	case item.FieldSoldTime:
-		return m.OldSoldTime(ctx)
-	case item.FieldSoldTo:
-		return m.OldSoldTo(ctx)
-	case item.FieldSoldPrice:
-		return m.OldSoldPrice(ctx)
-	case item.FieldSoldNotes:
-		return m.OldSoldNotes(ctx)
+// OldPurchaseTime returns the old "purchase_time" field's value of the Item entity.
+// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemMutation) OldPurchaseTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPurchaseTime is only allowed on UpdateOne operations")
 	}
-	return nil, fmt.Errorf("unknown Item field %s", name)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPurchaseTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPurchaseTime: %w", err)
+	}
+	return oldValue.PurchaseTime, nil
 }
 
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *ItemMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case item.FieldCreatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreatedAt(v)
-		return nil
-	case item.FieldUpdatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
+// ClearPurchaseTime clears the value of the "purchase_time" field.
+func (m *ItemMutation) ClearPurchaseTime() {
+	m.purchase_time = nil
+	m.clearedFields[item.FieldPurchaseTime] = struct{}{}
+}
+
+// PurchaseTimeCleared returns if the "purchase_time" field was cleared in this mutation.
+func (m *ItemMutation) PurchaseTimeCleared() bool {
+	_, ok := m.clearedFields[item.FieldPurchaseTime]
+	return ok
+}
+
+// ResetPurchaseTime resets all changes to the "purchase_time" field.
+func (m *ItemMutation) ResetPurchaseTime() {
+	m.purchase_time = nil
+	delete(m.clearedFields, item.FieldPurchaseTime)
+}
+
+// SetPurchaseFrom sets the "purchase_from" field.
+func (m *ItemMutation) SetPurchaseFrom(s string) {
+	m.purchase_from = &s
+}
+
+// PurchaseFrom returns the value of the "purchase_from" field in the mutation.
+func (m *ItemMutation) PurchaseFrom() (r string, exists bool) {
+	v := m.purchase_from
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPurchaseFrom returns the old "purchase_from" field's value of the Item entity.
+// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemMutation) OldPurchaseFrom(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPurchaseFrom is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPurchaseFrom requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPurchaseFrom: %w", err)
+	}
+	return oldValue.PurchaseFrom, nil
+}
+
+// ClearPurchaseFrom clears the value of the "purchase_from" field.
+func (m *ItemMutation) ClearPurchaseFrom() {
+	m.purchase_from = nil
+	m.clearedFields[item.FieldPurchaseFrom] = struct{}{}
+}
+
+// PurchaseFromCleared returns if the "purchase_from" field was cleared in this mutation.
+func (m *ItemMutation) PurchaseFromCleared() bool {
+	_, ok := m.clearedFields[item.FieldPurchaseFrom]
+	return ok
+}
+
+// ResetPurchaseFrom resets all changes to the "purchase_from" field.
+func (m *ItemMutation) ResetPurchaseFrom() {
+	m.purchase_from = nil
+	delete(m.clearedFields, item.FieldPurchaseFrom)
+}
+
+// SetPurchaseOrderNumber sets the "purchase_order_number" field.
+func (m *ItemMutation) SetPurchaseOrderNumber(s string) {
+	m.purchase_order_number = &s
+}
+
+// PurchaseOrderNumber returns the value of the "purchase_order_number" field in the mutation.
+func (m *ItemMutation) PurchaseOrderNumber() (r string, exists bool) {
+	v := m.purchase_order_number
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPurchaseOrderNumber returns the old "purchase_order_number" field's value of the Item entity.
+// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemMutation) OldPurchaseOrderNumber(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPurchaseOrderNumber is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPurchaseOrderNumber requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPurchaseOrderNumber: %w", err)
+	}
+	return oldValue.PurchaseOrderNumber, nil
+}
+
+// ClearPurchaseOrderNumber clears the value of the "purchase_order_number" field.
+func (m *ItemMutation) ClearPurchaseOrderNumber() {
+	m.purchase_order_number = nil
+	m.clearedFields[item.FieldPurchaseOrderNumber] = struct{}{}
+}
+
+// PurchaseOrderNumberCleared returns if the "purchase_order_number" field was cleared in this mutation.
+func (m *ItemMutation) PurchaseOrderNumberCleared() bool {
+	_, ok := m.clearedFields[item.FieldPurchaseOrderNumber]
+	return ok
+}
+
+// ResetPurchaseOrderNumber resets all changes to the "purchase_order_number" field.
+func (m *ItemMutation) ResetPurchaseOrderNumber() {
+	m.purchase_order_number = nil
+	delete(m.clearedFields, item.FieldPurchaseOrderNumber)
+}
+
+// SetPurchasePrice sets the "purchase_price" field.
+func (m *ItemMutation) SetPurchasePrice(f float64) {
+	m.purchase_price = &f
+	m.addpurchase_price = nil
+}
+
+// PurchasePrice returns the value of the "purchase_price" field in the mutation.
+func (m *ItemMutation) PurchasePrice() (r float64, exists bool) {
+	v := m.purchase_price
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPurchasePrice returns the old "purchase_price" field's value of the Item entity.
+// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemMutation) OldPurchasePrice(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPurchasePrice is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPurchasePrice requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPurchasePrice: %w", err)
+	}
+	return oldValue.PurchasePrice, nil
+}
+
+// AddPurchasePrice adds f to the "purchase_price" field.
+func (m *ItemMutation) AddPurchasePrice(f float64) {
+	if m.addpurchase_price != nil {
+		*m.addpurchase_price += f
+	} else {
+		m.addpurchase_price = &f
+	}
+}
+
+// AddedPurchasePrice returns the value that was added to the "purchase_price" field in this mutation.
+func (m *ItemMutation) AddedPurchasePrice() (r float64, exists bool) {
+	v := m.addpurchase_price
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPurchasePrice resets all changes to the "purchase_price" field.
+func (m *ItemMutation) ResetPurchasePrice() {
+	m.purchase_price = nil
+	m.addpurchase_price = nil
+}
+
+// SetCurrency sets the "currency" field.
+func (m *ItemMutation) SetCurrency(s string) {
+	m.currency = &s
+}
+
+// Currency returns the value of the "currency" field in the mutation.
+func (m *ItemMutation) Currency() (r string, exists bool) {
+	v := m.currency
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCurrency returns the old "currency" field's value of the Item entity.
+// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemMutation) OldCurrency(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCurrency is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCurrency requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCurrency: %w", err)
+	}
+	return oldValue.Currency, nil
+}
+
+// ClearCurrency clears the value of the "currency" field.
+func (m *ItemMutation) ClearCurrency() {
+	m.currency = nil
+	m.clearedFields[item.FieldCurrency] = struct{}{}
+}
+
+// CurrencyCleared returns if the "currency" field was cleared in this mutation.
+func (m *ItemMutation) CurrencyCleared() bool {
+	_, ok := m.clearedFields[item.FieldCurrency]
+	return ok
+}
+
+// ResetCurrency resets all changes to the "currency" field.
+func (m *ItemMutation) ResetCurrency() {
+	m.currency = nil
+	delete(m.clearedFields, item.FieldCurrency)
+}
+
+// SetReplacementCost sets the "replacement_cost" field.
+func (m *ItemMutation) SetReplacementCost(f float64) {
+	m.replacement_cost = &f
+	m.addreplacement_cost = nil
+}
+
+// ReplacementCost returns the value of the "replacement_cost" field in the mutation.
+func (m *ItemMutation) ReplacementCost() (r float64, exists bool) {
+	v := m.replacement_cost
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReplacementCost returns the old "replacement_cost" field's value of the Item entity.
+// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemMutation) OldReplacementCost(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReplacementCost is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReplacementCost requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReplacementCost: %w", err)
+	}
+	return oldValue.ReplacementCost, nil
+}
+
+// AddReplacementCost adds f to the "replacement_cost" field.
+func (m *ItemMutation) AddReplacementCost(f float64) {
+	if m.addreplacement_cost != nil {
+		*m.addreplacement_cost += f
+	} else {
+		m.addreplacement_cost = &f
+	}
+}
+
+// AddedReplacementCost returns the value that was added to the "replacement_cost" field in this mutation.
+func (m *ItemMutation) AddedReplacementCost() (r float64, exists bool) {
+	v := m.addreplacement_cost
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetReplacementCost resets all changes to the "replacement_cost" field.
+func (m *ItemMutation) ResetReplacementCost() {
+	m.replacement_cost = nil
+	m.addreplacement_cost = nil
+}
+
+// SetLoanedTo sets the "loaned_to" field.
+func (m *ItemMutation) SetLoanedTo(s string) {
+	m.loaned_to = &s
+}
+
+// LoanedTo returns the value of the "loaned_to" field in the mutation.
+func (m *ItemMutation) LoanedTo() (r string, exists bool) {
+	v := m.loaned_to
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLoanedTo returns the old "loaned_to" field's value of the Item entity.
+// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemMutation) OldLoanedTo(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLoanedTo is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLoanedTo requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLoanedTo: %w", err)
+	}
+	return oldValue.LoanedTo, nil
+}
+
+// ClearLoanedTo clears the value of the "loaned_to" field.
+func (m *ItemMutation) ClearLoanedTo() {
+	m.loaned_to = nil
+	m.clearedFields[item.FieldLoanedTo] = struct{}{}
+}
+
+// LoanedToCleared returns if the "loaned_to" field was cleared in this mutation.
+func (m *ItemMutation) LoanedToCleared() bool {
+	_, ok := m.clearedFields[item.FieldLoanedTo]
+	return ok
+}
+
+// ResetLoanedTo resets all changes to the "loaned_to" field.
+func (m *ItemMutation) ResetLoanedTo() {
+	m.loaned_to = nil
+	delete(m.clearedFields, item.FieldLoanedTo)
+}
+
+// SetLoanedAt sets the "loaned_at" field.
+func (m *ItemMutation) SetLoanedAt(t time.Time) {
+	m.loaned_at = &t
+}
+
+// LoanedAt returns the value of the "loaned_at" field in the mutation.
+func (m *ItemMutation) LoanedAt() (r time.Time, exists bool) {
+	v := m.loaned_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLoanedAt returns the old "loaned_at" field's value of the Item entity.
+// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemMutation) OldLoanedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLoanedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLoanedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLoanedAt: %w", err)
+	}
+	return oldValue.LoanedAt, nil
+}
+
+// ClearLoanedAt clears the value of the "loaned_at" field.
+func (m *ItemMutation) ClearLoanedAt() {
+	m.loaned_at = nil
+	m.clearedFields[item.FieldLoanedAt] = struct{}{}
+}
+
+// LoanedAtCleared returns if the "loaned_at" field was cleared in this mutation.
+func (m *ItemMutation) LoanedAtCleared() bool {
+	_, ok := m.clearedFields[item.FieldLoanedAt]
+	return ok
+}
+
+// ResetLoanedAt resets all changes to the "loaned_at" field.
+func (m *ItemMutation) ResetLoanedAt() {
+	m.loaned_at = nil
+	delete(m.clearedFields, item.FieldLoanedAt)
+}
+
+// SetLoanDue sets the "loan_due" field.
+func (m *ItemMutation) SetLoanDue(t time.Time) {
+	m.loan_due = &t
+}
+
+// LoanDue returns the value of the "loan_due" field in the mutation.
+func (m *ItemMutation) LoanDue() (r time.Time, exists bool) {
+	v := m.loan_due
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLoanDue returns the old "loan_due" field's value of the Item entity.
+// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemMutation) OldLoanDue(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLoanDue is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLoanDue requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLoanDue: %w", err)
+	}
+	return oldValue.LoanDue, nil
+}
+
+// ClearLoanDue clears the value of the "loan_due" field.
+func (m *ItemMutation) ClearLoanDue() {
+	m.loan_due = nil
+	m.clearedFields[item.FieldLoanDue] = struct{}{}
+}
+
+// LoanDueCleared returns if the "loan_due" field was cleared in this mutation.
+func (m *ItemMutation) LoanDueCleared() bool {
+	_, ok := m.clearedFields[item.FieldLoanDue]
+	return ok
+}
+
+// ResetLoanDue resets all changes to the "loan_due" field.
+func (m *ItemMutation) ResetLoanDue() {
+	m.loan_due = nil
+	delete(m.clearedFields, item.FieldLoanDue)
+}
+
+// SetSoldTime sets the "sold_time" field.
+func (m *ItemMutation) SetSoldTime(t time.Time) {
+	m.sold_time = &t
+}
+
+// SoldTime returns the value of the "sold_time" field in the mutation.
+func (m *ItemMutation) SoldTime() (r time.Time, exists bool) {
+	v := m.sold_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSoldTime returns the old "sold_time" field's value of the Item entity.
+// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemMutation) OldSoldTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSoldTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSoldTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSoldTime: %w", err)
+	}
+	return oldValue.SoldTime, nil
+}
+
+// ClearSoldTime clears the value of the "sold_time" field.
+func (m *ItemMutation) ClearSoldTime() {
+	m.sold_time = nil
+	m.clearedFields[item.FieldSoldTime] = struct{}{}
+}
+
+// SoldTimeCleared returns if the "sold_time" field was cleared in this mutation.
+func (m *ItemMutation) SoldTimeCleared() bool {
+	_, ok := m.clearedFields[item.FieldSoldTime]
+	return ok
+}
+
+// ResetSoldTime resets all changes to the "sold_time" field.
+func (m *ItemMutation) ResetSoldTime() {
+	m.sold_time = nil
+	delete(m.clearedFields, item.FieldSoldTime)
+}
+
+// SetSoldTo sets the "sold_to" field.
+func (m *ItemMutation) SetSoldTo(s string) {
+	m.sold_to = &s
+}
+
+// SoldTo returns the value of the "sold_to" field in the mutation.
+func (m *ItemMutation) SoldTo() (r string, exists bool) {
+	v := m.sold_to
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSoldTo returns the old "sold_to" field's value of the Item entity.
+// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemMutation) OldSoldTo(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSoldTo is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSoldTo requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSoldTo: %w", err)
+	}
+	return oldValue.SoldTo, nil
+}
+
+// ClearSoldTo clears the value of the "sold_to" field.
+func (m *ItemMutation) ClearSoldTo() {
+	m.sold_to = nil
+	m.clearedFields[item.FieldSoldTo] = struct{}{}
+}
+
+// SoldToCleared returns if the "sold_to" field was cleared in this mutation.
+func (m *ItemMutation) SoldToCleared() bool {
+	_, ok := m.clearedFields[item.FieldSoldTo]
+	return ok
+}
+
+// ResetSoldTo resets all changes to the "sold_to" field.
+func (m *ItemMutation) ResetSoldTo() {
+	m.sold_to = nil
+	delete(m.clearedFields, item.FieldSoldTo)
+}
+
+// SetSoldPrice sets the "sold_price" field.
+func (m *ItemMutation) SetSoldPrice(f float64) {
+	m.sold_price = &f
+	m.addsold_price = nil
+}
+
+// SoldPrice returns the value of the "sold_price" field in the mutation.
+func (m *ItemMutation) SoldPrice() (r float64, exists bool) {
+	v := m.sold_price
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSoldPrice returns the old "sold_price" field's value of the Item entity.
+// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemMutation) OldSoldPrice(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSoldPrice is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSoldPrice requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSoldPrice: %w", err)
+	}
+	return oldValue.SoldPrice, nil
+}
+
+// AddSoldPrice adds f to the "sold_price" field.
+func (m *ItemMutation) AddSoldPrice(f float64) {
+	if m.addsold_price != nil {
+		*m.addsold_price += f
+	} else {
+		m.addsold_price = &f
+	}
+}
+
+// AddedSoldPrice returns the value that was added to the "sold_price" field in this mutation.
+func (m *ItemMutation) AddedSoldPrice() (r float64, exists bool) {
+	v := m.addsold_price
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSoldPrice resets all changes to the "sold_price" field.
+func (m *ItemMutation) ResetSoldPrice() {
+	m.sold_price = nil
+	m.addsold_price = nil
+}
+
+// SetSoldNotes sets the "sold_notes" field.
+func (m *ItemMutation) SetSoldNotes(s string) {
+	m.sold_notes = &s
+}
+
+// SoldNotes returns the value of the "sold_notes" field in the mutation.
+func (m *ItemMutation) SoldNotes() (r string, exists bool) {
+	v := m.sold_notes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSoldNotes returns the old "sold_notes" field's value of the Item entity.
+// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemMutation) OldSoldNotes(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSoldNotes is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSoldNotes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSoldNotes: %w", err)
+	}
+	return oldValue.SoldNotes, nil
+}
+
+// ClearSoldNotes clears the value of the "sold_notes" field.
+func (m *ItemMutation) ClearSoldNotes() {
+	m.sold_notes = nil
+	m.clearedFields[item.FieldSoldNotes] = struct{}{}
+}
+
+// SoldNotesCleared returns if the "sold_notes" field was cleared in this mutation.
+func (m *ItemMutation) SoldNotesCleared() bool {
+	_, ok := m.clearedFields[item.FieldSoldNotes]
+	return ok
+}
+
+// ResetSoldNotes resets all changes to the "sold_notes" field.
+func (m *ItemMutation) ResetSoldNotes() {
+	m.sold_notes = nil
+	delete(m.clearedFields, item.FieldSoldNotes)
+}
+
+// SetGroupID sets the "group" edge to the Group entity by id.
+func (m *ItemMutation) SetGroupID(id uuid.UUID) {
+	m.group = &id
+}
+
+// ClearGroup clears the "group" edge to the Group entity.
+func (m *ItemMutation) ClearGroup() {
+	m.clearedgroup = true
+}
+
+// GroupCleared reports if the "group" edge to the Group entity was cleared.
+func (m *ItemMutation) GroupCleared() bool {
+	return m.clearedgroup
+}
+
+// GroupID returns the "group" edge ID in the mutation.
+func (m *ItemMutation) GroupID() (id uuid.UUID, exists bool) {
+	if m.group != nil {
+		return *m.group, true
+	}
+	return
+}
+
+// GroupIDs returns the "group" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// GroupID instead. It exists only for internal usage by the builders.
+func (m *ItemMutation) GroupIDs() (ids []uuid.UUID) {
+	if id := m.group; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetGroup resets all changes to the "group" edge.
+func (m *ItemMutation) ResetGroup() {
+	m.group = nil
+	m.clearedgroup = false
+}
+
+// SetParentID sets the "parent" edge to the Item entity by id.
+func (m *ItemMutation) SetParentID(id uuid.UUID) {
+	m.parent = &id
+}
+
+// ClearParent clears the "parent" edge to the Item entity.
+func (m *ItemMutation) ClearParent() {
+	m.clearedparent = true
+}
+
+// ParentCleared reports if the "parent" edge to the Item entity was cleared.
+func (m *ItemMutation) ParentCleared() bool {
+	return m.clearedparent
+}
+
+// ParentID returns the "parent" edge ID in the mutation.
+func (m *ItemMutation) ParentID() (id uuid.UUID, exists bool) {
+	if m.parent != nil {
+		return *m.parent, true
+	}
+	return
+}
+
+// ParentIDs returns the "parent" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ParentID instead. It exists only for internal usage by the builders.
+func (m *ItemMutation) ParentIDs() (ids []uuid.UUID) {
+	if id := m.parent; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetParent resets all changes to the "parent" edge.
+func (m *ItemMutation) ResetParent() {
+	m.parent = nil
+	m.clearedparent = false
+}
+
+// AddChildIDs adds the "children" edge to the Item entity by ids.
+func (m *ItemMutation) AddChildIDs(ids ...uuid.UUID) {
+	if m.children == nil {
+		m.children = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.children[ids[i]] = struct{}{}
+	}
+}
+
+// ClearChildren clears the "children" edge to the Item entity.
+func (m *ItemMutation) ClearChildren() {
+	m.clearedchildren = true
+}
+
+// ChildrenCleared reports if the "children" edge to the Item entity was cleared.
+func (m *ItemMutation) ChildrenCleared() bool {
+	return m.clearedchildren
+}
+
+// RemoveChildIDs removes the "children" edge to the Item entity by IDs.
+func (m *ItemMutation) RemoveChildIDs(ids ...uuid.UUID) {
+	if m.removedchildren == nil {
+		m.removedchildren = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.children, ids[i])
+		m.removedchildren[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedChildren returns the removed IDs of the "children" edge to the Item entity.
+func (m *ItemMutation) RemovedChildrenIDs() (ids []uuid.UUID) {
+	for id := range m.removedchildren {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ChildrenIDs returns the "children" edge IDs in the mutation.
+func (m *ItemMutation) ChildrenIDs() (ids []uuid.UUID) {
+	for id := range m.children {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetChildren resets all changes to the "children" edge.
+func (m *ItemMutation) ResetChildren() {
+	m.children = nil
+	m.clearedchildren = false
+	m.removedchildren = nil
+}
+
+// AddRelatedIDs adds the "related" edge to the Item entity by ids.
+func (m *ItemMutation) AddRelatedIDs(ids ...uuid.UUID) {
+	if m.related == nil {
+		m.related = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.related[ids[i]] = struct{}{}
+	}
+}
+
+// ClearRelated clears the "related" edge to the Item entity.
+func (m *ItemMutation) ClearRelated() {
+	m.clearedrelated = true
+}
+
+// RelatedCleared reports if the "related" edge to the Item entity was cleared.
+func (m *ItemMutation) RelatedCleared() bool {
+	return m.clearedrelated
+}
+
+// RemoveRelatedIDs removes the "related" edge to the Item entity by IDs.
+func (m *ItemMutation) RemoveRelatedIDs(ids ...uuid.UUID) {
+	if m.removedrelated == nil {
+		m.removedrelated = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.related, ids[i])
+		m.removedrelated[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedRelated returns the removed IDs of the "related" edge to the Item entity.
+func (m *ItemMutation) RemovedRelatedIDs() (ids []uuid.UUID) {
+	for id := range m.removedrelated {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// RelatedIDs returns the "related" edge IDs in the mutation.
+func (m *ItemMutation) RelatedIDs() (ids []uuid.UUID) {
+	for id := range m.related {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetRelated resets all changes to the "related" edge.
+func (m *ItemMutation) ResetRelated() {
+	m.related = nil
+	m.clearedrelated = false
+	m.removedrelated = nil
+}
+
+// AddLabelIDs adds the "label" edge to the Label entity by ids.
+func (m *ItemMutation) AddLabelIDs(ids ...uuid.UUID) {
+	if m.label == nil {
+		m.label = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.label[ids[i]] = struct{}{}
+	}
+}
+
+// ClearLabel clears the "label" edge to the Label entity.
+func (m *ItemMutation) ClearLabel() {
+	m.clearedlabel = true
+}
+
+// LabelCleared reports if the "label" edge to the Label entity was cleared.
+func (m *ItemMutation) LabelCleared() bool {
+	return m.clearedlabel
+}
+
+// RemoveLabelIDs removes the "label" edge to the Label entity by IDs.
+func (m *ItemMutation) RemoveLabelIDs(ids ...uuid.UUID) {
+	if m.removedlabel == nil {
+		m.removedlabel = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.label, ids[i])
+		m.removedlabel[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedLabel returns the removed IDs of the "label" edge to the Label entity.
+func (m *ItemMutation) RemovedLabelIDs() (ids []uuid.UUID) {
+	for id := range m.removedlabel {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// LabelIDs returns the "label" edge IDs in the mutation.
+func (m *ItemMutation) LabelIDs() (ids []uuid.UUID) {
+	for id := range m.label {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetLabel resets all changes to the "label" edge.
+func (m *ItemMutation) ResetLabel() {
+	m.label = nil
+	m.clearedlabel = false
+	m.removedlabel = nil
+}
+
+// SetLocationID sets the "location" edge to the Location entity by id.
+func (m *ItemMutation) SetLocationID(id uuid.UUID) {
+	m.location = &id
+}
+
+// ClearLocation clears the "location" edge to the Location entity.
+func (m *ItemMutation) ClearLocation() {
+	m.clearedlocation = true
+}
+
+// LocationCleared reports if the "location" edge to the Location entity was cleared.
+func (m *ItemMutation) LocationCleared() bool {
+	return m.clearedlocation
+}
+
+// LocationID returns the "location" edge ID in the mutation.
+func (m *ItemMutation) LocationID() (id uuid.UUID, exists bool) {
+	if m.location != nil {
+		return *m.location, true
+	}
+	return
+}
+
+// LocationIDs returns the "location" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// LocationID instead. It exists only for internal usage by the builders.
+func (m *ItemMutation) LocationIDs() (ids []uuid.UUID) {
+	if id := m.location; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetLocation resets all changes to the "location" edge.
+func (m *ItemMutation) ResetLocation() {
+	m.location = nil
+	m.clearedlocation = false
+}
+
+// AddFieldIDs adds the "fields" edge to the ItemField entity by ids.
+func (m *ItemMutation) AddFieldIDs(ids ...uuid.UUID) {
+	if m.fields == nil {
+		m.fields = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.fields[ids[i]] = struct{}{}
+	}
+}
+
+// ClearFields clears the "fields" edge to the ItemField entity.
+func (m *ItemMutation) ClearFields() {
+	m.clearedfields = true
+}
+
+// FieldsCleared reports if the "fields" edge to the ItemField entity was cleared.
+func (m *ItemMutation) FieldsCleared() bool {
+	return m.clearedfields
+}
+
+// RemoveFieldIDs removes the "fields" edge to the ItemField entity by IDs.
+func (m *ItemMutation) RemoveFieldIDs(ids ...uuid.UUID) {
+	if m.removedfields == nil {
+		m.removedfields = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.fields, ids[i])
+		m.removedfields[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedFields returns the removed IDs of the "fields" edge to the ItemField entity.
+func (m *ItemMutation) RemovedFieldsIDs() (ids []uuid.UUID) {
+	for id := range m.removedfields {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// FieldsIDs returns the "fields" edge IDs in the mutation.
+func (m *ItemMutation) FieldsIDs() (ids []uuid.UUID) {
+	for id := range m.fields {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetFields resets all changes to the "fields" edge.
+func (m *ItemMutation) ResetFields() {
+	m.fields = nil
+	m.clearedfields = false
+	m.removedfields = nil
+}
+
+// AddMaintenanceEntryIDs adds the "maintenance_entries" edge to the MaintenanceEntry entity by ids.
+func (m *ItemMutation) AddMaintenanceEntryIDs(ids ...uuid.UUID) {
+	if m.maintenance_entries == nil {
+		m.maintenance_entries = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.maintenance_entries[ids[i]] = struct{}{}
+	}
+}
+
+// ClearMaintenanceEntries clears the "maintenance_entries" edge to the MaintenanceEntry entity.
+func (m *ItemMutation) ClearMaintenanceEntries() {
+	m.clearedmaintenance_entries = true
+}
+
+// MaintenanceEntriesCleared reports if the "maintenance_entries" edge to the MaintenanceEntry entity was cleared.
+func (m *ItemMutation) MaintenanceEntriesCleared() bool {
+	return m.clearedmaintenance_entries
+}
+
+// RemoveMaintenanceEntryIDs removes the "maintenance_entries" edge to the MaintenanceEntry entity by IDs.
+func (m *ItemMutation) RemoveMaintenanceEntryIDs(ids ...uuid.UUID) {
+	if m.removedmaintenance_entries == nil {
+		m.removedmaintenance_entries = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.maintenance_entries, ids[i])
+		m.removedmaintenance_entries[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedMaintenanceEntries returns the removed IDs of the "maintenance_entries" edge to the MaintenanceEntry entity.
+func (m *ItemMutation) RemovedMaintenanceEntriesIDs() (ids []uuid.UUID) {
+	for id := range m.removedmaintenance_entries {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// MaintenanceEntriesIDs returns the "maintenance_entries" edge IDs in the mutation.
+func (m *ItemMutation) MaintenanceEntriesIDs() (ids []uuid.UUID) {
+	for id := range m.maintenance_entries {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetMaintenanceEntries resets all changes to the "maintenance_entries" edge.
+func (m *ItemMutation) ResetMaintenanceEntries() {
+	m.maintenance_entries = nil
+	m.clearedmaintenance_entries = false
+	m.removedmaintenance_entries = nil
+}
+
+// AddAttachmentIDs adds the "attachments" edge to the Attachment entity by ids.
+func (m *ItemMutation) AddAttachmentIDs(ids ...uuid.UUID) {
+	if m.attachments == nil {
+		m.attachments = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.attachments[ids[i]] = struct{}{}
+	}
+}
+
+// ClearAttachments clears the "attachments" edge to the Attachment entity.
+func (m *ItemMutation) ClearAttachments() {
+	m.clearedattachments = true
+}
+
+// AttachmentsCleared reports if the "attachments" edge to the Attachment entity was cleared.
+func (m *ItemMutation) AttachmentsCleared() bool {
+	return m.clearedattachments
+}
+
+// RemoveAttachmentIDs removes the "attachments" edge to the Attachment entity by IDs.
+func (m *ItemMutation) RemoveAttachmentIDs(ids ...uuid.UUID) {
+	if m.removedattachments == nil {
+		m.removedattachments = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.attachments, ids[i])
+		m.removedattachments[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedAttachments returns the removed IDs of the "attachments" edge to the Attachment entity.
+func (m *ItemMutation) RemovedAttachmentsIDs() (ids []uuid.UUID) {
+	for id := range m.removedattachments {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// AttachmentsIDs returns the "attachments" edge IDs in the mutation.
+func (m *ItemMutation) AttachmentsIDs() (ids []uuid.UUID) {
+	for id := range m.attachments {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetAttachments resets all changes to the "attachments" edge.
+func (m *ItemMutation) ResetAttachments() {
+	m.attachments = nil
+	m.clearedattachments = false
+	m.removedattachments = nil
+}
+
+// AddCommentIDs adds the "comments" edge to the ItemComment entity by ids.
+func (m *ItemMutation) AddCommentIDs(ids ...uuid.UUID) {
+	if m.comments == nil {
+		m.comments = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.comments[ids[i]] = struct{}{}
+	}
+}
+
+// ClearComments clears the "comments" edge to the ItemComment entity.
+func (m *ItemMutation) ClearComments() {
+	m.clearedcomments = true
+}
+
+// CommentsCleared reports if the "comments" edge to the ItemComment entity was cleared.
+func (m *ItemMutation) CommentsCleared() bool {
+	return m.clearedcomments
+}
+
+// RemoveCommentIDs removes the "comments" edge to the ItemComment entity by IDs.
+func (m *ItemMutation) RemoveCommentIDs(ids ...uuid.UUID) {
+	if m.removedcomments == nil {
+		m.removedcomments = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.comments, ids[i])
+		m.removedcomments[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedComments returns the removed IDs of the "comments" edge to the ItemComment entity.
+func (m *ItemMutation) RemovedCommentsIDs() (ids []uuid.UUID) {
+	for id := range m.removedcomments {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// CommentsIDs returns the "comments" edge IDs in the mutation.
+func (m *ItemMutation) CommentsIDs() (ids []uuid.UUID) {
+	for id := range m.comments {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetComments resets all changes to the "comments" edge.
+func (m *ItemMutation) ResetComments() {
+	m.comments = nil
+	m.clearedcomments = false
+	m.removedcomments = nil
+}
+
+// Where appends a list predicates to the ItemMutation builder.
+func (m *ItemMutation) Where(ps ...predicate.Item) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ItemMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ItemMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Item, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ItemMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ItemMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Item).
+func (m *ItemMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ItemMutation) Fields() []string {
+	fields := make([]string, 0, 40)
+	if m.created_at != nil {
+		fields = append(fields, item.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, item.FieldUpdatedAt)
+	}
+	if m.name != nil {
+		fields = append(fields, item.FieldName)
+	}
+	if m.description != nil {
+		fields = append(fields, item.FieldDescription)
+	}
+	if m.import_ref != nil {
+		fields = append(fields, item.FieldImportRef)
+	}
+	if m.notes != nil {
+		fields = append(fields, item.FieldNotes)
+	}
+	if m.notes_format != nil {
+		fields = append(fields, item.FieldNotesFormat)
+	}
+	if m.quantity != nil {
+		fields = append(fields, item.FieldQuantity)
+	}
+	if m.insured != nil {
+		fields = append(fields, item.FieldInsured)
+	}
+	if m.archived != nil {
+		fields = append(fields, item.FieldArchived)
+	}
+	if m.favorite != nil {
+		fields = append(fields, item.FieldFavorite)
+	}
+	if m.version != nil {
+		fields = append(fields, item.FieldVersion)
+	}
+	if m.archived_at != nil {
+		fields = append(fields, item.FieldArchivedAt)
+	}
+	if m.asset_id != nil {
+		fields = append(fields, item.FieldAssetID)
+	}
+	if m.reorder_threshold != nil {
+		fields = append(fields, item.FieldReorderThreshold)
+	}
+	if m.tags != nil {
+		fields = append(fields, item.FieldTags)
+	}
+	if m.source != nil {
+		fields = append(fields, item.FieldSource)
+	}
+	if m.acquisition_type != nil {
+		fields = append(fields, item.FieldAcquisitionType)
+	}
+	if m.latitude != nil {
+		fields = append(fields, item.FieldLatitude)
+	}
+	if m.longitude != nil {
+		fields = append(fields, item.FieldLongitude)
+	}
+	if m.serial_number != nil {
+		fields = append(fields, item.FieldSerialNumber)
+	}
+	if m.barcode != nil {
+		fields = append(fields, item.FieldBarcode)
+	}
+	if m.model_number != nil {
+		fields = append(fields, item.FieldModelNumber)
+	}
+	if m.manufacturer != nil {
+		fields = append(fields, item.FieldManufacturer)
+	}
+	if m.lifetime_warranty != nil {
+		fields = append(fields, item.FieldLifetimeWarranty)
+	}
+	if m.warranty_expires != nil {
+		fields = append(fields, item.FieldWarrantyExpires)
+	}
+	if m.warranty_details != nil {
+		fields = append(fields, item.FieldWarrantyDetails)
+	}
+	if m.purchase_time != nil {
+		fields = append(fields, item.FieldPurchaseTime)
+	}
+	if m.purchase_from != nil {
+		fields = append(fields, item.FieldPurchaseFrom)
+	}
+	if m.purchase_order_number != nil {
+		fields = append(fields, item.FieldPurchaseOrderNumber)
+	}
+	if m.purchase_price != nil {
+		fields = append(fields, item.FieldPurchasePrice)
+	}
+	if m.currency != nil {
+		fields = append(fields, item.FieldCurrency)
+	}
+	if m.replacement_cost != nil {
+		fields = append(fields, item.FieldReplacementCost)
+	}
+	if m.loaned_to != nil {
+		fields = append(fields, item.FieldLoanedTo)
+	}
+	if m.loaned_at != nil {
+		fields = append(fields, item.FieldLoanedAt)
+	}
+	if m.loan_due != nil {
+		fields = append(fields, item.FieldLoanDue)
+	}
+	if m.sold_time != nil {
+		fields = append(fields, item.FieldSoldTime)
+	}
+	if m.sold_to != nil {
+		fields = append(fields, item.FieldSoldTo)
+	}
+	if m.sold_price != nil {
+		fields = append(fields, item.FieldSoldPrice)
+	}
+	if m.sold_notes != nil {
+		fields = append(fields, item.FieldSoldNotes)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ItemMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case item.FieldCreatedAt:
+		return m.CreatedAt()
+	case item.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case item.FieldName:
+		return m.Name()
+	case item.FieldDescription:
+		return m.Description()
+	case item.FieldImportRef:
+		return m.ImportRef()
+	case item.FieldNotes:
+		return m.Notes()
+	case item.FieldNotesFormat:
+		return m.NotesFormat()
+	case item.FieldQuantity:
+		return m.Quantity()
+	case item.FieldInsured:
+		return m.Insured()
+	case item.FieldArchived:
+		return m.Archived()
+	case item.FieldFavorite:
+		return m.Favorite()
+	case item.FieldVersion:
+		return m.Version()
+	case item.FieldArchivedAt:
+		return m.ArchivedAt()
+	case item.FieldAssetID:
+		return m.AssetID()
+	case item.FieldReorderThreshold:
+		return m.ReorderThreshold()
+	case item.FieldTags:
+		return m.Tags()
+	case item.FieldSource:
+		return m.Source()
+	case item.FieldAcquisitionType:
+		return m.AcquisitionType()
+	case item.FieldLatitude:
+		return m.Latitude()
+	case item.FieldLongitude:
+		return m.Longitude()
+	case item.FieldSerialNumber:
+		return m.SerialNumber()
+	case item.FieldBarcode:
+		return m.Barcode()
+	case item.FieldModelNumber:
+		return m.ModelNumber()
+	case item.FieldManufacturer:
+		return m.Manufacturer()
+	case item.FieldLifetimeWarranty:
+		return m.LifetimeWarranty()
+	case item.FieldWarrantyExpires:
+		return m.WarrantyExpires()
+	case item.FieldWarrantyDetails:
+		return m.WarrantyDetails()
+	case item.FieldPurchaseTime:
+		return m.PurchaseTime()
+	case item.FieldPurchaseFrom:
+		return m.PurchaseFrom()
+	case item.FieldPurchaseOrderNumber:
+		return m.PurchaseOrderNumber()
+	case item.FieldPurchasePrice:
+		return m.PurchasePrice()
+	case item.FieldCurrency:
+		return m.Currency()
+	case item.FieldReplacementCost:
+		return m.ReplacementCost()
+	case item.FieldLoanedTo:
+		return m.LoanedTo()
+	case item.FieldLoanedAt:
+		return m.LoanedAt()
+	case item.FieldLoanDue:
+		return m.LoanDue()
+	case item.FieldSoldTime:
+		return m.SoldTime()
+	case item.FieldSoldTo:
+		return m.SoldTo()
+	case item.FieldSoldPrice:
+		return m.SoldPrice()
+	case item.FieldSoldNotes:
+		return m.SoldNotes()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ItemMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case item.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case item.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case item.FieldName:
+		return m.OldName(ctx)
+	case item.FieldDescription:
+		return m.OldDescription(ctx)
+	case item.FieldImportRef:
+		return m.OldImportRef(ctx)
+	case item.FieldNotes:
+		return m.OldNotes(ctx)
+	case item.FieldNotesFormat:
+		return m.OldNotesFormat(ctx)
+	case item.FieldQuantity:
+		return m.OldQuantity(ctx)
+	case item.FieldInsured:
+		return m.OldInsured(ctx)
+	case item.FieldArchived:
+		return m.OldArchived(ctx)
+	case item.FieldFavorite:
+		return m.OldFavorite(ctx)
+	case item.FieldVersion:
+		return m.OldVersion(ctx)
+	case item.FieldArchivedAt:
+		return m.OldArchivedAt(ctx)
+	case item.FieldAssetID:
+		return m.OldAssetID(ctx)
+	case item.FieldReorderThreshold:
+		return m.OldReorderThreshold(ctx)
+	case item.FieldTags:
+		return m.OldTags(ctx)
+	case item.FieldSource:
+		return m.OldSource(ctx)
+	case item.FieldAcquisitionType:
+		return m.OldAcquisitionType(ctx)
+	case item.FieldLatitude:
+		return m.OldLatitude(ctx)
+	case item.FieldLongitude:
+		return m.OldLongitude(ctx)
+	case item.FieldSerialNumber:
+		return m.OldSerialNumber(ctx)
+	case item.FieldBarcode:
+		return m.OldBarcode(ctx)
+	case item.FieldModelNumber:
+		return m.OldModelNumber(ctx)
+	case item.FieldManufacturer:
+		return m.OldManufacturer(ctx)
+	case item.FieldLifetimeWarranty:
+		return m.OldLifetimeWarranty(ctx)
+	case item.FieldWarrantyExpires:
+		return m.OldWarrantyExpires(ctx)
+	case item.FieldWarrantyDetails:
+		return m.OldWarrantyDetails(ctx)
+	case item.FieldPurchaseTime:
+		return m.OldPurchaseTime(ctx)
+	case item.FieldPurchaseFrom:
+		return m.OldPurchaseFrom(ctx)
+	case item.FieldPurchaseOrderNumber:
+		return m.OldPurchaseOrderNumber(ctx)
+	case item.FieldPurchasePrice:
+		return m.OldPurchasePrice(ctx)
+	case item.FieldCurrency:
+		return m.OldCurrency(ctx)
+	case item.FieldReplacementCost:
+		return m.OldReplacementCost(ctx)
+	case item.FieldLoanedTo:
+		return m.OldLoanedTo(ctx)
+	case item.FieldLoanedAt:
+		return m.OldLoanedAt(ctx)
+	case item.FieldLoanDue:
+		return m.OldLoanDue(ctx)
+	case item.FieldSoldTime:
+		return m.OldSoldTime(ctx)
+	case item.FieldSoldTo:
+		return m.OldSoldTo(ctx)
+	case item.FieldSoldPrice:
+		return m.OldSoldPrice(ctx)
+	case item.FieldSoldNotes:
+		return m.OldSoldNotes(ctx)
+	}
+	return nil, fmt.Errorf("unknown Item field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ItemMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case item.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case item.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case item.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case item.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case item.FieldImportRef:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetImportRef(v)
+		return nil
+	case item.FieldNotes:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNotes(v)
+		return nil
+	case item.FieldNotesFormat:
+		v, ok := value.(item.NotesFormat)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNotesFormat(v)
+		return nil
+	case item.FieldQuantity:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetQuantity(v)
+		return nil
+	case item.FieldInsured:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetInsured(v)
+		return nil
+	case item.FieldArchived:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetArchived(v)
+		return nil
+	case item.FieldFavorite:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFavorite(v)
+		return nil
+	case item.FieldVersion:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVersion(v)
+		return nil
+	case item.FieldArchivedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetArchivedAt(v)
+		return nil
+	case item.FieldAssetID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAssetID(v)
+		return nil
+	case item.FieldReorderThreshold:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReorderThreshold(v)
+		return nil
+	case item.FieldTags:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTags(v)
+		return nil
+	case item.FieldSource:
+		v, ok := value.(item.Source)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSource(v)
+		return nil
+	case item.FieldAcquisitionType:
+		v, ok := value.(item.AcquisitionType)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAcquisitionType(v)
+		return nil
+	case item.FieldLatitude:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLatitude(v)
+		return nil
+	case item.FieldLongitude:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLongitude(v)
+		return nil
+	case item.FieldSerialNumber:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSerialNumber(v)
+		return nil
+	case item.FieldBarcode:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBarcode(v)
+		return nil
+	case item.FieldModelNumber:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetModelNumber(v)
+		return nil
+	case item.FieldManufacturer:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetManufacturer(v)
+		return nil
+	case item.FieldLifetimeWarranty:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLifetimeWarranty(v)
+		return nil
+	case item.FieldWarrantyExpires:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWarrantyExpires(v)
+		return nil
+	case item.FieldWarrantyDetails:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWarrantyDetails(v)
+		return nil
+	case item.FieldPurchaseTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPurchaseTime(v)
+		return nil
+	case item.FieldPurchaseFrom:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPurchaseFrom(v)
+		return nil
+	case item.FieldPurchaseOrderNumber:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPurchaseOrderNumber(v)
+		return nil
+	case item.FieldPurchasePrice:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPurchasePrice(v)
+		return nil
+	case item.FieldCurrency:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCurrency(v)
+		return nil
+	case item.FieldReplacementCost:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReplacementCost(v)
+		return nil
+	case item.FieldLoanedTo:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLoanedTo(v)
+		return nil
+	case item.FieldLoanedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLoanedAt(v)
+		return nil
+	case item.FieldLoanDue:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLoanDue(v)
+		return nil
+	case item.FieldSoldTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSoldTime(v)
+		return nil
+	case item.FieldSoldTo:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSoldTo(v)
+		return nil
+	case item.FieldSoldPrice:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSoldPrice(v)
+		return nil
+	case item.FieldSoldNotes:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSoldNotes(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Item field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ItemMutation) AddedFields() []string {
+	var fields []string
+	if m.addquantity != nil {
+		fields = append(fields, item.FieldQuantity)
+	}
+	if m.addversion != nil {
+		fields = append(fields, item.FieldVersion)
+	}
+	if m.addasset_id != nil {
+		fields = append(fields, item.FieldAssetID)
+	}
+	if m.addreorder_threshold != nil {
+		fields = append(fields, item.FieldReorderThreshold)
+	}
+	if m.addlatitude != nil {
+		fields = append(fields, item.FieldLatitude)
+	}
+	if m.addlongitude != nil {
+		fields = append(fields, item.FieldLongitude)
+	}
+	if m.addpurchase_price != nil {
+		fields = append(fields, item.FieldPurchasePrice)
+	}
+	if m.addreplacement_cost != nil {
+		fields = append(fields, item.FieldReplacementCost)
+	}
+	if m.addsold_price != nil {
+		fields = append(fields, item.FieldSoldPrice)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ItemMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case item.FieldQuantity:
+		return m.AddedQuantity()
+	case item.FieldVersion:
+		return m.AddedVersion()
+	case item.FieldAssetID:
+		return m.AddedAssetID()
+	case item.FieldReorderThreshold:
+		return m.AddedReorderThreshold()
+	case item.FieldLatitude:
+		return m.AddedLatitude()
+	case item.FieldLongitude:
+		return m.AddedLongitude()
+	case item.FieldPurchasePrice:
+		return m.AddedPurchasePrice()
+	case item.FieldReplacementCost:
+		return m.AddedReplacementCost()
+	case item.FieldSoldPrice:
+		return m.AddedSoldPrice()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ItemMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case item.FieldQuantity:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddQuantity(v)
+		return nil
+	case item.FieldVersion:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddVersion(v)
+		return nil
+	case item.FieldAssetID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAssetID(v)
+		return nil
+	case item.FieldReorderThreshold:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddReorderThreshold(v)
+		return nil
+	case item.FieldLatitude:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLatitude(v)
+		return nil
+	case item.FieldLongitude:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLongitude(v)
+		return nil
+	case item.FieldPurchasePrice:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPurchasePrice(v)
+		return nil
+	case item.FieldReplacementCost:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddReplacementCost(v)
+		return nil
+	case item.FieldSoldPrice:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSoldPrice(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Item numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ItemMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(item.FieldDescription) {
+		fields = append(fields, item.FieldDescription)
+	}
+	if m.FieldCleared(item.FieldImportRef) {
+		fields = append(fields, item.FieldImportRef)
+	}
+	if m.FieldCleared(item.FieldNotes) {
+		fields = append(fields, item.FieldNotes)
+	}
+	if m.FieldCleared(item.FieldArchivedAt) {
+		fields = append(fields, item.FieldArchivedAt)
+	}
+	if m.FieldCleared(item.FieldTags) {
+		fields = append(fields, item.FieldTags)
+	}
+	if m.FieldCleared(item.FieldLatitude) {
+		fields = append(fields, item.FieldLatitude)
+	}
+	if m.FieldCleared(item.FieldLongitude) {
+		fields = append(fields, item.FieldLongitude)
+	}
+	if m.FieldCleared(item.FieldSerialNumber) {
+		fields = append(fields, item.FieldSerialNumber)
+	}
+	if m.FieldCleared(item.FieldBarcode) {
+		fields = append(fields, item.FieldBarcode)
+	}
+	if m.FieldCleared(item.FieldModelNumber) {
+		fields = append(fields, item.FieldModelNumber)
+	}
+	if m.FieldCleared(item.FieldManufacturer) {
+		fields = append(fields, item.FieldManufacturer)
+	}
+	if m.FieldCleared(item.FieldWarrantyExpires) {
+		fields = append(fields, item.FieldWarrantyExpires)
+	}
+	if m.FieldCleared(item.FieldWarrantyDetails) {
+		fields = append(fields, item.FieldWarrantyDetails)
+	}
+	if m.FieldCleared(item.FieldPurchaseTime) {
+		fields = append(fields, item.FieldPurchaseTime)
+	}
+	if m.FieldCleared(item.FieldPurchaseFrom) {
+		fields = append(fields, item.FieldPurchaseFrom)
+	}
+	if m.FieldCleared(item.FieldPurchaseOrderNumber) {
+		fields = append(fields, item.FieldPurchaseOrderNumber)
+	}
+	if m.FieldCleared(item.FieldCurrency) {
+		fields = append(fields, item.FieldCurrency)
+	}
+	if m.FieldCleared(item.FieldLoanedTo) {
+		fields = append(fields, item.FieldLoanedTo)
+	}
+	if m.FieldCleared(item.FieldLoanedAt) {
+		fields = append(fields, item.FieldLoanedAt)
+	}
+	if m.FieldCleared(item.FieldLoanDue) {
+		fields = append(fields, item.FieldLoanDue)
+	}
+	if m.FieldCleared(item.FieldSoldTime) {
+		fields = append(fields, item.FieldSoldTime)
+	}
+	if m.FieldCleared(item.FieldSoldTo) {
+		fields = append(fields, item.FieldSoldTo)
+	}
+	if m.FieldCleared(item.FieldSoldNotes) {
+		fields = append(fields, item.FieldSoldNotes)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ItemMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ItemMutation) ClearField(name string) error {
+	switch name {
+	case item.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case item.FieldImportRef:
+		m.ClearImportRef()
+		return nil
+	case item.FieldNotes:
+		m.ClearNotes()
+		return nil
+	case item.FieldArchivedAt:
+		m.ClearArchivedAt()
+		return nil
+	case item.FieldTags:
+		m.ClearTags()
+		return nil
+	case item.FieldLatitude:
+		m.ClearLatitude()
+		return nil
+	case item.FieldLongitude:
+		m.ClearLongitude()
+		return nil
+	case item.FieldSerialNumber:
+		m.ClearSerialNumber()
+		return nil
+	case item.FieldBarcode:
+		m.ClearBarcode()
+		return nil
+	case item.FieldModelNumber:
+		m.ClearModelNumber()
+		return nil
+	case item.FieldManufacturer:
+		m.ClearManufacturer()
+		return nil
+	case item.FieldWarrantyExpires:
+		m.ClearWarrantyExpires()
+		return nil
+	case item.FieldWarrantyDetails:
+		m.ClearWarrantyDetails()
+		return nil
+	case item.FieldPurchaseTime:
+		m.ClearPurchaseTime()
+		return nil
+	case item.FieldPurchaseFrom:
+		m.ClearPurchaseFrom()
+		return nil
+	case item.FieldPurchaseOrderNumber:
+		m.ClearPurchaseOrderNumber()
+		return nil
+	case item.FieldCurrency:
+		m.ClearCurrency()
+		return nil
+	case item.FieldLoanedTo:
+		m.ClearLoanedTo()
+		return nil
+	case item.FieldLoanedAt:
+		m.ClearLoanedAt()
+		return nil
+	case item.FieldLoanDue:
+		m.ClearLoanDue()
+		return nil
+	case item.FieldSoldTime:
+		m.ClearSoldTime()
+		return nil
+	case item.FieldSoldTo:
+		m.ClearSoldTo()
+		return nil
+	case item.FieldSoldNotes:
+		m.ClearSoldNotes()
+		return nil
+	}
+	return fmt.Errorf("unknown Item nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ItemMutation) ResetField(name string) error {
+	switch name {
+	case item.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case item.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case item.FieldName:
+		m.ResetName()
+		return nil
+	case item.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case item.FieldImportRef:
+		m.ResetImportRef()
+		return nil
+	case item.FieldNotes:
+		m.ResetNotes()
+		return nil
+	case item.FieldNotesFormat:
+		m.ResetNotesFormat()
+		return nil
+	case item.FieldQuantity:
+		m.ResetQuantity()
+		return nil
+	case item.FieldInsured:
+		m.ResetInsured()
+		return nil
+	case item.FieldArchived:
+		m.ResetArchived()
+		return nil
+	case item.FieldFavorite:
+		m.ResetFavorite()
+		return nil
+	case item.FieldVersion:
+		m.ResetVersion()
+		return nil
+	case item.FieldArchivedAt:
+		m.ResetArchivedAt()
+		return nil
+	case item.FieldAssetID:
+		m.ResetAssetID()
+		return nil
+	case item.FieldReorderThreshold:
+		m.ResetReorderThreshold()
+		return nil
+	case item.FieldTags:
+		m.ResetTags()
+		return nil
+	case item.FieldSource:
+		m.ResetSource()
+		return nil
+	case item.FieldAcquisitionType:
+		m.ResetAcquisitionType()
+		return nil
+	case item.FieldLatitude:
+		m.ResetLatitude()
+		return nil
+	case item.FieldLongitude:
+		m.ResetLongitude()
+		return nil
+	case item.FieldSerialNumber:
+		m.ResetSerialNumber()
+		return nil
+	case item.FieldBarcode:
+		m.ResetBarcode()
+		return nil
+	case item.FieldModelNumber:
+		m.ResetModelNumber()
+		return nil
+	case item.FieldManufacturer:
+		m.ResetManufacturer()
+		return nil
+	case item.FieldLifetimeWarranty:
+		m.ResetLifetimeWarranty()
+		return nil
+	case item.FieldWarrantyExpires:
+		m.ResetWarrantyExpires()
+		return nil
+	case item.FieldWarrantyDetails:
+		m.ResetWarrantyDetails()
+		return nil
+	case item.FieldPurchaseTime:
+		m.ResetPurchaseTime()
+		return nil
+	case item.FieldPurchaseFrom:
+		m.ResetPurchaseFrom()
+		return nil
+	case item.FieldPurchaseOrderNumber:
+		m.ResetPurchaseOrderNumber()
+		return nil
+	case item.FieldPurchasePrice:
+		m.ResetPurchasePrice()
+		return nil
+	case item.FieldCurrency:
+		m.ResetCurrency()
+		return nil
+	case item.FieldReplacementCost:
+		m.ResetReplacementCost()
+		return nil
+	case item.FieldLoanedTo:
+		m.ResetLoanedTo()
+		return nil
+	case item.FieldLoanedAt:
+		m.ResetLoanedAt()
+		return nil
+	case item.FieldLoanDue:
+		m.ResetLoanDue()
+		return nil
+	case item.FieldSoldTime:
+		m.ResetSoldTime()
+		return nil
+	case item.FieldSoldTo:
+		m.ResetSoldTo()
+		return nil
+	case item.FieldSoldPrice:
+		m.ResetSoldPrice()
+		return nil
+	case item.FieldSoldNotes:
+		m.ResetSoldNotes()
+		return nil
+	}
+	return fmt.Errorf("unknown Item field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ItemMutation) AddedEdges() []string {
+	edges := make([]string, 0, 10)
+	if m.group != nil {
+		edges = append(edges, item.EdgeGroup)
+	}
+	if m.parent != nil {
+		edges = append(edges, item.EdgeParent)
+	}
+	if m.children != nil {
+		edges = append(edges, item.EdgeChildren)
+	}
+	if m.related != nil {
+		edges = append(edges, item.EdgeRelated)
+	}
+	if m.label != nil {
+		edges = append(edges, item.EdgeLabel)
+	}
+	if m.location != nil {
+		edges = append(edges, item.EdgeLocation)
+	}
+	if m.fields != nil {
+		edges = append(edges, item.EdgeFields)
+	}
+	if m.maintenance_entries != nil {
+		edges = append(edges, item.EdgeMaintenanceEntries)
+	}
+	if m.attachments != nil {
+		edges = append(edges, item.EdgeAttachments)
+	}
+	if m.comments != nil {
+		edges = append(edges, item.EdgeComments)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ItemMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case item.EdgeGroup:
+		if id := m.group; id != nil {
+			return []ent.Value{*id}
+		}
+	case item.EdgeParent:
+		if id := m.parent; id != nil {
+			return []ent.Value{*id}
+		}
+	case item.EdgeChildren:
+		ids := make([]ent.Value, 0, len(m.children))
+		for id := range m.children {
+			ids = append(ids, id)
+		}
+		return ids
+	case item.EdgeRelated:
+		ids := make([]ent.Value, 0, len(m.related))
+		for id := range m.related {
+			ids = append(ids, id)
+		}
+		return ids
+	case item.EdgeLabel:
+		ids := make([]ent.Value, 0, len(m.label))
+		for id := range m.label {
+			ids = append(ids, id)
+		}
+		return ids
+	case item.EdgeLocation:
+		if id := m.location; id != nil {
+			return []ent.Value{*id}
+		}
+	case item.EdgeFields:
+		ids := make([]ent.Value, 0, len(m.fields))
+		for id := range m.fields {
+			ids = append(ids, id)
+		}
+		return ids
+	case item.EdgeMaintenanceEntries:
+		ids := make([]ent.Value, 0, len(m.maintenance_entries))
+		for id := range m.maintenance_entries {
+			ids = append(ids, id)
+		}
+		return ids
+	case item.EdgeAttachments:
+		ids := make([]ent.Value, 0, len(m.attachments))
+		for id := range m.attachments {
+			ids = append(ids, id)
+		}
+		return ids
+	case item.EdgeComments:
+		ids := make([]ent.Value, 0, len(m.comments))
+		for id := range m.comments {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ItemMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 10)
+	if m.removedchildren != nil {
+		edges = append(edges, item.EdgeChildren)
+	}
+	if m.removedrelated != nil {
+		edges = append(edges, item.EdgeRelated)
+	}
+	if m.removedlabel != nil {
+		edges = append(edges, item.EdgeLabel)
+	}
+	if m.removedfields != nil {
+		edges = append(edges, item.EdgeFields)
+	}
+	if m.removedmaintenance_entries != nil {
+		edges = append(edges, item.EdgeMaintenanceEntries)
+	}
+	if m.removedattachments != nil {
+		edges = append(edges, item.EdgeAttachments)
+	}
+	if m.removedcomments != nil {
+		edges = append(edges, item.EdgeComments)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ItemMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case item.EdgeChildren:
+		ids := make([]ent.Value, 0, len(m.removedchildren))
+		for id := range m.removedchildren {
+			ids = append(ids, id)
+		}
+		return ids
+	case item.EdgeRelated:
+		ids := make([]ent.Value, 0, len(m.removedrelated))
+		for id := range m.removedrelated {
+			ids = append(ids, id)
+		}
+		return ids
+	case item.EdgeLabel:
+		ids := make([]ent.Value, 0, len(m.removedlabel))
+		for id := range m.removedlabel {
+			ids = append(ids, id)
+		}
+		return ids
+	case item.EdgeFields:
+		ids := make([]ent.Value, 0, len(m.removedfields))
+		for id := range m.removedfields {
+			ids = append(ids, id)
+		}
+		return ids
+	case item.EdgeMaintenanceEntries:
+		ids := make([]ent.Value, 0, len(m.removedmaintenance_entries))
+		for id := range m.removedmaintenance_entries {
+			ids = append(ids, id)
+		}
+		return ids
+	case item.EdgeAttachments:
+		ids := make([]ent.Value, 0, len(m.removedattachments))
+		for id := range m.removedattachments {
+			ids = append(ids, id)
+		}
+		return ids
+	case item.EdgeComments:
+		ids := make([]ent.Value, 0, len(m.removedcomments))
+		for id := range m.removedcomments {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ItemMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 10)
+	if m.clearedgroup {
+		edges = append(edges, item.EdgeGroup)
+	}
+	if m.clearedparent {
+		edges = append(edges, item.EdgeParent)
+	}
+	if m.clearedchildren {
+		edges = append(edges, item.EdgeChildren)
+	}
+	if m.clearedrelated {
+		edges = append(edges, item.EdgeRelated)
+	}
+	if m.clearedlabel {
+		edges = append(edges, item.EdgeLabel)
+	}
+	if m.clearedlocation {
+		edges = append(edges, item.EdgeLocation)
+	}
+	if m.clearedfields {
+		edges = append(edges, item.EdgeFields)
+	}
+	if m.clearedmaintenance_entries {
+		edges = append(edges, item.EdgeMaintenanceEntries)
+	}
+	if m.clearedattachments {
+		edges = append(edges, item.EdgeAttachments)
+	}
+	if m.clearedcomments {
+		edges = append(edges, item.EdgeComments)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ItemMutation) EdgeCleared(name string) bool {
+	switch name {
+	case item.EdgeGroup:
+		return m.clearedgroup
+	case item.EdgeParent:
+		return m.clearedparent
+	case item.EdgeChildren:
+		return m.clearedchildren
+	case item.EdgeRelated:
+		return m.clearedrelated
+	case item.EdgeLabel:
+		return m.clearedlabel
+	case item.EdgeLocation:
+		return m.clearedlocation
+	case item.EdgeFields:
+		return m.clearedfields
+	case item.EdgeMaintenanceEntries:
+		return m.clearedmaintenance_entries
+	case item.EdgeAttachments:
+		return m.clearedattachments
+	case item.EdgeComments:
+		return m.clearedcomments
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ItemMutation) ClearEdge(name string) error {
+	switch name {
+	case item.EdgeGroup:
+		m.ClearGroup()
+		return nil
+	case item.EdgeParent:
+		m.ClearParent()
+		return nil
+	case item.EdgeLocation:
+		m.ClearLocation()
+		return nil
+	}
+	return fmt.Errorf("unknown Item unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ItemMutation) ResetEdge(name string) error {
+	switch name {
+	case item.EdgeGroup:
+		m.ResetGroup()
+		return nil
+	case item.EdgeParent:
+		m.ResetParent()
+		return nil
+	case item.EdgeChildren:
+		m.ResetChildren()
+		return nil
+	case item.EdgeRelated:
+		m.ResetRelated()
+		return nil
+	case item.EdgeLabel:
+		m.ResetLabel()
+		return nil
+	case item.EdgeLocation:
+		m.ResetLocation()
+		return nil
+	case item.EdgeFields:
+		m.ResetFields()
+		return nil
+	case item.EdgeMaintenanceEntries:
+		m.ResetMaintenanceEntries()
+		return nil
+	case item.EdgeAttachments:
+		m.ResetAttachments()
+		return nil
+	case item.EdgeComments:
+		m.ResetComments()
+		return nil
+	}
+	return fmt.Errorf("unknown Item edge %s", name)
+}
+
+// ItemCommentMutation represents an operation that mutates the ItemComment nodes in the graph.
+type ItemCommentMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	created_at    *time.Time
+	updated_at    *time.Time
+	content       *string
+	clearedFields map[string]struct{}
+	item          *uuid.UUID
+	cleareditem   bool
+	author        *uuid.UUID
+	clearedauthor bool
+	done          bool
+	oldValue      func(context.Context) (*ItemComment, error)
+	predicates    []predicate.ItemComment
+}
+
+var _ ent.Mutation = (*ItemCommentMutation)(nil)
+
+// itemcommentOption allows management of the mutation configuration using functional options.
+type itemcommentOption func(*ItemCommentMutation)
+
+// newItemCommentMutation creates new mutation for the ItemComment entity.
+func newItemCommentMutation(c config, op Op, opts ...itemcommentOption) *ItemCommentMutation {
+	m := &ItemCommentMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeItemComment,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withItemCommentID sets the ID field of the mutation.
+func withItemCommentID(id uuid.UUID) itemcommentOption {
+	return func(m *ItemCommentMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ItemComment
+		)
+		m.oldValue = func(ctx context.Context) (*ItemComment, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ItemComment.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withItemComment sets the old ItemComment of the mutation.
+func withItemComment(node *ItemComment) itemcommentOption {
+	return func(m *ItemCommentMutation) {
+		m.oldValue = func(context.Context) (*ItemComment, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ItemCommentMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ItemCommentMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of ItemComment entities.
+func (m *ItemCommentMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ItemCommentMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ItemCommentMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ItemComment.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ItemCommentMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ItemCommentMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the ItemComment entity.
+// If the ItemComment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemCommentMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ItemCommentMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *ItemCommentMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *ItemCommentMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the ItemComment entity.
+// If the ItemComment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemCommentMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *ItemCommentMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetItemID sets the "item_id" field.
+func (m *ItemCommentMutation) SetItemID(u uuid.UUID) {
+	m.item = &u
+}
+
+// ItemID returns the value of the "item_id" field in the mutation.
+func (m *ItemCommentMutation) ItemID() (r uuid.UUID, exists bool) {
+	v := m.item
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldItemID returns the old "item_id" field's value of the ItemComment entity.
+// If the ItemComment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemCommentMutation) OldItemID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldItemID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldItemID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldItemID: %w", err)
+	}
+	return oldValue.ItemID, nil
+}
+
+// ResetItemID resets all changes to the "item_id" field.
+func (m *ItemCommentMutation) ResetItemID() {
+	m.item = nil
+}
+
+// SetContent sets the "content" field.
+func (m *ItemCommentMutation) SetContent(s string) {
+	m.content = &s
+}
+
+// Content returns the value of the "content" field in the mutation.
+func (m *ItemCommentMutation) Content() (r string, exists bool) {
+	v := m.content
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldContent returns the old "content" field's value of the ItemComment entity.
+// If the ItemComment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemCommentMutation) OldContent(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContent: %w", err)
+	}
+	return oldValue.Content, nil
+}
+
+// ResetContent resets all changes to the "content" field.
+func (m *ItemCommentMutation) ResetContent() {
+	m.content = nil
+}
+
+// ClearItem clears the "item" edge to the Item entity.
+func (m *ItemCommentMutation) ClearItem() {
+	m.cleareditem = true
+	m.clearedFields[itemcomment.FieldItemID] = struct{}{}
+}
+
+// ItemCleared reports if the "item" edge to the Item entity was cleared.
+func (m *ItemCommentMutation) ItemCleared() bool {
+	return m.cleareditem
+}
+
+// ItemIDs returns the "item" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ItemID instead. It exists only for internal usage by the builders.
+func (m *ItemCommentMutation) ItemIDs() (ids []uuid.UUID) {
+	if id := m.item; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetItem resets all changes to the "item" edge.
+func (m *ItemCommentMutation) ResetItem() {
+	m.item = nil
+	m.cleareditem = false
+}
+
+// SetAuthorID sets the "author" edge to the User entity by id.
+func (m *ItemCommentMutation) SetAuthorID(id uuid.UUID) {
+	m.author = &id
+}
+
+// ClearAuthor clears the "author" edge to the User entity.
+func (m *ItemCommentMutation) ClearAuthor() {
+	m.clearedauthor = true
+}
+
+// AuthorCleared reports if the "author" edge to the User entity was cleared.
+func (m *ItemCommentMutation) AuthorCleared() bool {
+	return m.clearedauthor
+}
+
+// AuthorID returns the "author" edge ID in the mutation.
+func (m *ItemCommentMutation) AuthorID() (id uuid.UUID, exists bool) {
+	if m.author != nil {
+		return *m.author, true
+	}
+	return
+}
+
+// AuthorIDs returns the "author" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// AuthorID instead. It exists only for internal usage by the builders.
+func (m *ItemCommentMutation) AuthorIDs() (ids []uuid.UUID) {
+	if id := m.author; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetAuthor resets all changes to the "author" edge.
+func (m *ItemCommentMutation) ResetAuthor() {
+	m.author = nil
+	m.clearedauthor = false
+}
+
+// Where appends a list predicates to the ItemCommentMutation builder.
+func (m *ItemCommentMutation) Where(ps ...predicate.ItemComment) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ItemCommentMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ItemCommentMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ItemComment, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ItemCommentMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ItemCommentMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ItemComment).
+func (m *ItemCommentMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ItemCommentMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.created_at != nil {
+		fields = append(fields, itemcomment.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, itemcomment.FieldUpdatedAt)
+	}
+	if m.item != nil {
+		fields = append(fields, itemcomment.FieldItemID)
+	}
+	if m.content != nil {
+		fields = append(fields, itemcomment.FieldContent)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ItemCommentMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case itemcomment.FieldCreatedAt:
+		return m.CreatedAt()
+	case itemcomment.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case itemcomment.FieldItemID:
+		return m.ItemID()
+	case itemcomment.FieldContent:
+		return m.Content()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ItemCommentMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case itemcomment.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case itemcomment.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case itemcomment.FieldItemID:
+		return m.OldItemID(ctx)
+	case itemcomment.FieldContent:
+		return m.OldContent(ctx)
+	}
+	return nil, fmt.Errorf("unknown ItemComment field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ItemCommentMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case itemcomment.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case itemcomment.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case itemcomment.FieldItemID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetItemID(v)
+		return nil
+	case itemcomment.FieldContent:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContent(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ItemComment field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ItemCommentMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ItemCommentMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ItemCommentMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown ItemComment numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ItemCommentMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ItemCommentMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ItemCommentMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown ItemComment nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ItemCommentMutation) ResetField(name string) error {
+	switch name {
+	case itemcomment.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case itemcomment.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case itemcomment.FieldItemID:
+		m.ResetItemID()
+		return nil
+	case itemcomment.FieldContent:
+		m.ResetContent()
+		return nil
+	}
+	return fmt.Errorf("unknown ItemComment field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ItemCommentMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.item != nil {
+		edges = append(edges, itemcomment.EdgeItem)
+	}
+	if m.author != nil {
+		edges = append(edges, itemcomment.EdgeAuthor)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ItemCommentMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case itemcomment.EdgeItem:
+		if id := m.item; id != nil {
+			return []ent.Value{*id}
+		}
+	case itemcomment.EdgeAuthor:
+		if id := m.author; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ItemCommentMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ItemCommentMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ItemCommentMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.cleareditem {
+		edges = append(edges, itemcomment.EdgeItem)
+	}
+	if m.clearedauthor {
+		edges = append(edges, itemcomment.EdgeAuthor)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ItemCommentMutation) EdgeCleared(name string) bool {
+	switch name {
+	case itemcomment.EdgeItem:
+		return m.cleareditem
+	case itemcomment.EdgeAuthor:
+		return m.clearedauthor
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ItemCommentMutation) ClearEdge(name string) error {
+	switch name {
+	case itemcomment.EdgeItem:
+		m.ClearItem()
+		return nil
+	case itemcomment.EdgeAuthor:
+		m.ClearAuthor()
+		return nil
+	}
+	return fmt.Errorf("unknown ItemComment unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ItemCommentMutation) ResetEdge(name string) error {
+	switch name {
+	case itemcomment.EdgeItem:
+		m.ResetItem()
+		return nil
+	case itemcomment.EdgeAuthor:
+		m.ResetAuthor()
+		return nil
+	}
+	return fmt.Errorf("unknown ItemComment edge %s", name)
+}
+
+// ItemEventMutation represents an operation that mutates the ItemEvent nodes in the graph.
+type ItemEventMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	created_at    *time.Time
+	updated_at    *time.Time
+	item_id       *uuid.UUID
+	_type         *itemevent.Type
+	changes       *map[string]types.FieldChange
+	clearedFields map[string]struct{}
+	group         *uuid.UUID
+	clearedgroup  bool
+	user          *uuid.UUID
+	cleareduser   bool
+	done          bool
+	oldValue      func(context.Context) (*ItemEvent, error)
+	predicates    []predicate.ItemEvent
+}
+
+var _ ent.Mutation = (*ItemEventMutation)(nil)
+
+// itemeventOption allows management of the mutation configuration using functional options.
+type itemeventOption func(*ItemEventMutation)
+
+// newItemEventMutation creates new mutation for the ItemEvent entity.
+func newItemEventMutation(c config, op Op, opts ...itemeventOption) *ItemEventMutation {
+	m := &ItemEventMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeItemEvent,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withItemEventID sets the ID field of the mutation.
+func withItemEventID(id uuid.UUID) itemeventOption {
+	return func(m *ItemEventMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ItemEvent
+		)
+		m.oldValue = func(ctx context.Context) (*ItemEvent, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ItemEvent.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withItemEvent sets the old ItemEvent of the mutation.
+func withItemEvent(node *ItemEvent) itemeventOption {
+	return func(m *ItemEventMutation) {
+		m.oldValue = func(context.Context) (*ItemEvent, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ItemEventMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ItemEventMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of ItemEvent entities.
+func (m *ItemEventMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ItemEventMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ItemEventMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ItemEvent.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ItemEventMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ItemEventMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the ItemEvent entity.
+// If the ItemEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemEventMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ItemEventMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *ItemEventMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *ItemEventMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the ItemEvent entity.
+// If the ItemEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemEventMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *ItemEventMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetItemID sets the "item_id" field.
+func (m *ItemEventMutation) SetItemID(u uuid.UUID) {
+	m.item_id = &u
+}
+
+// ItemID returns the value of the "item_id" field in the mutation.
+func (m *ItemEventMutation) ItemID() (r uuid.UUID, exists bool) {
+	v := m.item_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldItemID returns the old "item_id" field's value of the ItemEvent entity.
+// If the ItemEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemEventMutation) OldItemID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldItemID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldItemID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldItemID: %w", err)
+	}
+	return oldValue.ItemID, nil
+}
+
+// ResetItemID resets all changes to the "item_id" field.
+func (m *ItemEventMutation) ResetItemID() {
+	m.item_id = nil
+}
+
+// SetType sets the "type" field.
+func (m *ItemEventMutation) SetType(i itemevent.Type) {
+	m._type = &i
+}
+
+// GetType returns the value of the "type" field in the mutation.
+func (m *ItemEventMutation) GetType() (r itemevent.Type, exists bool) {
+	v := m._type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldType returns the old "type" field's value of the ItemEvent entity.
+// If the ItemEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemEventMutation) OldType(ctx context.Context) (v itemevent.Type, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
+	}
+	return oldValue.Type, nil
+}
+
+// ResetType resets all changes to the "type" field.
+func (m *ItemEventMutation) ResetType() {
+	m._type = nil
+}
+
+// SetChanges sets the "changes" field.
+func (m *ItemEventMutation) SetChanges(mc map[string]types.FieldChange) {
+	m.changes = &mc
+}
+
+// Changes returns the value of the "changes" field in the mutation.
+func (m *ItemEventMutation) Changes() (r map[string]types.FieldChange, exists bool) {
+	v := m.changes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChanges returns the old "changes" field's value of the ItemEvent entity.
+// If the ItemEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemEventMutation) OldChanges(ctx context.Context) (v map[string]types.FieldChange, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChanges is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChanges requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChanges: %w", err)
+	}
+	return oldValue.Changes, nil
+}
+
+// ClearChanges clears the value of the "changes" field.
+func (m *ItemEventMutation) ClearChanges() {
+	m.changes = nil
+	m.clearedFields[itemevent.FieldChanges] = struct{}{}
+}
+
+// ChangesCleared returns if the "changes" field was cleared in this mutation.
+func (m *ItemEventMutation) ChangesCleared() bool {
+	_, ok := m.clearedFields[itemevent.FieldChanges]
+	return ok
+}
+
+// ResetChanges resets all changes to the "changes" field.
+func (m *ItemEventMutation) ResetChanges() {
+	m.changes = nil
+	delete(m.clearedFields, itemevent.FieldChanges)
+}
+
+// SetGroupID sets the "group" edge to the Group entity by id.
+func (m *ItemEventMutation) SetGroupID(id uuid.UUID) {
+	m.group = &id
+}
+
+// ClearGroup clears the "group" edge to the Group entity.
+func (m *ItemEventMutation) ClearGroup() {
+	m.clearedgroup = true
+}
+
+// GroupCleared reports if the "group" edge to the Group entity was cleared.
+func (m *ItemEventMutation) GroupCleared() bool {
+	return m.clearedgroup
+}
+
+// GroupID returns the "group" edge ID in the mutation.
+func (m *ItemEventMutation) GroupID() (id uuid.UUID, exists bool) {
+	if m.group != nil {
+		return *m.group, true
+	}
+	return
+}
+
+// GroupIDs returns the "group" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// GroupID instead. It exists only for internal usage by the builders.
+func (m *ItemEventMutation) GroupIDs() (ids []uuid.UUID) {
+	if id := m.group; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetGroup resets all changes to the "group" edge.
+func (m *ItemEventMutation) ResetGroup() {
+	m.group = nil
+	m.clearedgroup = false
+}
+
+// SetUserID sets the "user" edge to the User entity by id.
+func (m *ItemEventMutation) SetUserID(id uuid.UUID) {
+	m.user = &id
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *ItemEventMutation) ClearUser() {
+	m.cleareduser = true
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *ItemEventMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserID returns the "user" edge ID in the mutation.
+func (m *ItemEventMutation) UserID() (id uuid.UUID, exists bool) {
+	if m.user != nil {
+		return *m.user, true
+	}
+	return
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *ItemEventMutation) UserIDs() (ids []uuid.UUID) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *ItemEventMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// Where appends a list predicates to the ItemEventMutation builder.
+func (m *ItemEventMutation) Where(ps ...predicate.ItemEvent) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ItemEventMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ItemEventMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ItemEvent, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ItemEventMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ItemEventMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ItemEvent).
+func (m *ItemEventMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ItemEventMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.created_at != nil {
+		fields = append(fields, itemevent.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, itemevent.FieldUpdatedAt)
+	}
+	if m.item_id != nil {
+		fields = append(fields, itemevent.FieldItemID)
+	}
+	if m._type != nil {
+		fields = append(fields, itemevent.FieldType)
+	}
+	if m.changes != nil {
+		fields = append(fields, itemevent.FieldChanges)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ItemEventMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case itemevent.FieldCreatedAt:
+		return m.CreatedAt()
+	case itemevent.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case itemevent.FieldItemID:
+		return m.ItemID()
+	case itemevent.FieldType:
+		return m.GetType()
+	case itemevent.FieldChanges:
+		return m.Changes()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ItemEventMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case itemevent.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case itemevent.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case itemevent.FieldItemID:
+		return m.OldItemID(ctx)
+	case itemevent.FieldType:
+		return m.OldType(ctx)
+	case itemevent.FieldChanges:
+		return m.OldChanges(ctx)
+	}
+	return nil, fmt.Errorf("unknown ItemEvent field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ItemEventMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case itemevent.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case itemevent.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case itemevent.FieldItemID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetItemID(v)
+		return nil
+	case itemevent.FieldType:
+		v, ok := value.(itemevent.Type)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetType(v)
+		return nil
+	case itemevent.FieldChanges:
+		v, ok := value.(map[string]types.FieldChange)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChanges(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ItemEvent field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ItemEventMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ItemEventMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ItemEventMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown ItemEvent numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ItemEventMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(itemevent.FieldChanges) {
+		fields = append(fields, itemevent.FieldChanges)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ItemEventMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ItemEventMutation) ClearField(name string) error {
+	switch name {
+	case itemevent.FieldChanges:
+		m.ClearChanges()
+		return nil
+	}
+	return fmt.Errorf("unknown ItemEvent nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ItemEventMutation) ResetField(name string) error {
+	switch name {
+	case itemevent.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case itemevent.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case itemevent.FieldItemID:
+		m.ResetItemID()
+		return nil
+	case itemevent.FieldType:
+		m.ResetType()
+		return nil
+	case itemevent.FieldChanges:
+		m.ResetChanges()
+		return nil
+	}
+	return fmt.Errorf("unknown ItemEvent field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ItemEventMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.group != nil {
+		edges = append(edges, itemevent.EdgeGroup)
+	}
+	if m.user != nil {
+		edges = append(edges, itemevent.EdgeUser)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ItemEventMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case itemevent.EdgeGroup:
+		if id := m.group; id != nil {
+			return []ent.Value{*id}
+		}
+	case itemevent.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ItemEventMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ItemEventMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ItemEventMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedgroup {
+		edges = append(edges, itemevent.EdgeGroup)
+	}
+	if m.cleareduser {
+		edges = append(edges, itemevent.EdgeUser)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ItemEventMutation) EdgeCleared(name string) bool {
+	switch name {
+	case itemevent.EdgeGroup:
+		return m.clearedgroup
+	case itemevent.EdgeUser:
+		return m.cleareduser
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ItemEventMutation) ClearEdge(name string) error {
+	switch name {
+	case itemevent.EdgeGroup:
+		m.ClearGroup()
+		return nil
+	case itemevent.EdgeUser:
+		m.ClearUser()
+		return nil
+	}
+	return fmt.Errorf("unknown ItemEvent unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ItemEventMutation) ResetEdge(name string) error {
+	switch name {
+	case itemevent.EdgeGroup:
+		m.ResetGroup()
+		return nil
+	case itemevent.EdgeUser:
+		m.ResetUser()
+		return nil
+	}
+	return fmt.Errorf("unknown ItemEvent edge %s", name)
+}
+
+// ItemFieldMutation represents an operation that mutates the ItemField nodes in the graph.
+type ItemFieldMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *uuid.UUID
+	created_at      *time.Time
+	updated_at      *time.Time
+	name            *string
+	description     *string
+	_type           *itemfield.Type
+	text_value      *string
+	number_value    *int
+	addnumber_value *int
+	boolean_value   *bool
+	time_value      *time.Time
+	clearedFields   map[string]struct{}
+	item            *uuid.UUID
+	cleareditem     bool
+	done            bool
+	oldValue        func(context.Context) (*ItemField, error)
+	predicates      []predicate.ItemField
+}
+
+var _ ent.Mutation = (*ItemFieldMutation)(nil)
+
+// itemfieldOption allows management of the mutation configuration using functional options.
+type itemfieldOption func(*ItemFieldMutation)
+
+// newItemFieldMutation creates new mutation for the ItemField entity.
+func newItemFieldMutation(c config, op Op, opts ...itemfieldOption) *ItemFieldMutation {
+	m := &ItemFieldMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeItemField,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withItemFieldID sets the ID field of the mutation.
+func withItemFieldID(id uuid.UUID) itemfieldOption {
+	return func(m *ItemFieldMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ItemField
+		)
+		m.oldValue = func(ctx context.Context) (*ItemField, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ItemField.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withItemField sets the old ItemField of the mutation.
+func withItemField(node *ItemField) itemfieldOption {
+	return func(m *ItemFieldMutation) {
+		m.oldValue = func(context.Context) (*ItemField, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ItemFieldMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ItemFieldMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of ItemField entities.
+func (m *ItemFieldMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ItemFieldMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ItemFieldMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ItemField.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ItemFieldMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ItemFieldMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the ItemField entity.
+// If the ItemField object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemFieldMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ItemFieldMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *ItemFieldMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *ItemFieldMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the ItemField entity.
+// If the ItemField object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemFieldMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *ItemFieldMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetName sets the "name" field.
+func (m *ItemFieldMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *ItemFieldMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the ItemField entity.
+// If the ItemField object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemFieldMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *ItemFieldMutation) ResetName() {
+	m.name = nil
+}
+
+// SetDescription sets the "description" field.
+func (m *ItemFieldMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *ItemFieldMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the ItemField entity.
+// If the ItemField object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemFieldMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *ItemFieldMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[itemfield.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *ItemFieldMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[itemfield.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *ItemFieldMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, itemfield.FieldDescription)
+}
+
+// SetType sets the "type" field.
+func (m *ItemFieldMutation) SetType(i itemfield.Type) {
+	m._type = &i
+}
+
+// GetType returns the value of the "type" field in the mutation.
+func (m *ItemFieldMutation) GetType() (r itemfield.Type, exists bool) {
+	v := m._type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldType returns the old "type" field's value of the ItemField entity.
+// If the ItemField object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemFieldMutation) OldType(ctx context.Context) (v itemfield.Type, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
+	}
+	return oldValue.Type, nil
+}
+
+// ResetType resets all changes to the "type" field.
+func (m *ItemFieldMutation) ResetType() {
+	m._type = nil
+}
+
+// SetTextValue sets the "text_value" field.
+func (m *ItemFieldMutation) SetTextValue(s string) {
+	m.text_value = &s
+}
+
+// TextValue returns the value of the "text_value" field in the mutation.
+func (m *ItemFieldMutation) TextValue() (r string, exists bool) {
+	v := m.text_value
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTextValue returns the old "text_value" field's value of the ItemField entity.
+// If the ItemField object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemFieldMutation) OldTextValue(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTextValue is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTextValue requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTextValue: %w", err)
+	}
+	return oldValue.TextValue, nil
+}
+
+// ClearTextValue clears the value of the "text_value" field.
+func (m *ItemFieldMutation) ClearTextValue() {
+	m.text_value = nil
+	m.clearedFields[itemfield.FieldTextValue] = struct{}{}
+}
+
+// TextValueCleared returns if the "text_value" field was cleared in this mutation.
+func (m *ItemFieldMutation) TextValueCleared() bool {
+	_, ok := m.clearedFields[itemfield.FieldTextValue]
+	return ok
+}
+
+// ResetTextValue resets all changes to the "text_value" field.
+func (m *ItemFieldMutation) ResetTextValue() {
+	m.text_value = nil
+	delete(m.clearedFields, itemfield.FieldTextValue)
+}
+
+// SetNumberValue sets the "number_value" field.
+func (m *ItemFieldMutation) SetNumberValue(i int) {
+	m.number_value = &i
+	m.addnumber_value = nil
+}
+
+// NumberValue returns the value of the "number_value" field in the mutation.
+func (m *ItemFieldMutation) NumberValue() (r int, exists bool) {
+	v := m.number_value
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNumberValue returns the old "number_value" field's value of the ItemField entity.
+// If the ItemField object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemFieldMutation) OldNumberValue(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNumberValue is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNumberValue requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNumberValue: %w", err)
+	}
+	return oldValue.NumberValue, nil
+}
+
+// AddNumberValue adds i to the "number_value" field.
+func (m *ItemFieldMutation) AddNumberValue(i int) {
+	if m.addnumber_value != nil {
+		*m.addnumber_value += i
+	} else {
+		m.addnumber_value = &i
+	}
+}
+
+// AddedNumberValue returns the value that was added to the "number_value" field in this mutation.
+func (m *ItemFieldMutation) AddedNumberValue() (r int, exists bool) {
+	v := m.addnumber_value
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearNumberValue clears the value of the "number_value" field.
+func (m *ItemFieldMutation) ClearNumberValue() {
+	m.number_value = nil
+	m.addnumber_value = nil
+	m.clearedFields[itemfield.FieldNumberValue] = struct{}{}
+}
+
+// NumberValueCleared returns if the "number_value" field was cleared in this mutation.
+func (m *ItemFieldMutation) NumberValueCleared() bool {
+	_, ok := m.clearedFields[itemfield.FieldNumberValue]
+	return ok
+}
+
+// ResetNumberValue resets all changes to the "number_value" field.
+func (m *ItemFieldMutation) ResetNumberValue() {
+	m.number_value = nil
+	m.addnumber_value = nil
+	delete(m.clearedFields, itemfield.FieldNumberValue)
+}
+
+// SetBooleanValue sets the "boolean_value" field.
+func (m *ItemFieldMutation) SetBooleanValue(b bool) {
+	m.boolean_value = &b
+}
+
+// BooleanValue returns the value of the "boolean_value" field in the mutation.
+func (m *ItemFieldMutation) BooleanValue() (r bool, exists bool) {
+	v := m.boolean_value
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBooleanValue returns the old "boolean_value" field's value of the ItemField entity.
+// If the ItemField object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemFieldMutation) OldBooleanValue(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBooleanValue is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBooleanValue requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBooleanValue: %w", err)
+	}
+	return oldValue.BooleanValue, nil
+}
+
+// ResetBooleanValue resets all changes to the "boolean_value" field.
+func (m *ItemFieldMutation) ResetBooleanValue() {
+	m.boolean_value = nil
+}
+
+// SetTimeValue sets the "time_value" field.
+func (m *ItemFieldMutation) SetTimeValue(t time.Time) {
+	m.time_value = &t
+}
+
+// TimeValue returns the value of the "time_value" field in the mutation.
+func (m *ItemFieldMutation) TimeValue() (r time.Time, exists bool) {
+	v := m.time_value
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTimeValue returns the old "time_value" field's value of the ItemField entity.
+// If the ItemField object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemFieldMutation) OldTimeValue(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTimeValue is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTimeValue requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTimeValue: %w", err)
+	}
+	return oldValue.TimeValue, nil
+}
+
+// ResetTimeValue resets all changes to the "time_value" field.
+func (m *ItemFieldMutation) ResetTimeValue() {
+	m.time_value = nil
+}
+
+// SetItemID sets the "item" edge to the Item entity by id.
+func (m *ItemFieldMutation) SetItemID(id uuid.UUID) {
+	m.item = &id
+}
+
+// ClearItem clears the "item" edge to the Item entity.
+func (m *ItemFieldMutation) ClearItem() {
+	m.cleareditem = true
+}
+
+// ItemCleared reports if the "item" edge to the Item entity was cleared.
+func (m *ItemFieldMutation) ItemCleared() bool {
+	return m.cleareditem
+}
+
+// ItemID returns the "item" edge ID in the mutation.
+func (m *ItemFieldMutation) ItemID() (id uuid.UUID, exists bool) {
+	if m.item != nil {
+		return *m.item, true
+	}
+	return
+}
+
+// ItemIDs returns the "item" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ItemID instead. It exists only for internal usage by the builders.
+func (m *ItemFieldMutation) ItemIDs() (ids []uuid.UUID) {
+	if id := m.item; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetItem resets all changes to the "item" edge.
+func (m *ItemFieldMutation) ResetItem() {
+	m.item = nil
+	m.cleareditem = false
+}
+
+// Where appends a list predicates to the ItemFieldMutation builder.
+func (m *ItemFieldMutation) Where(ps ...predicate.ItemField) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ItemFieldMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ItemFieldMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ItemField, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ItemFieldMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ItemFieldMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ItemField).
+func (m *ItemFieldMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ItemFieldMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.created_at != nil {
+		fields = append(fields, itemfield.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, itemfield.FieldUpdatedAt)
+	}
+	if m.name != nil {
+		fields = append(fields, itemfield.FieldName)
+	}
+	if m.description != nil {
+		fields = append(fields, itemfield.FieldDescription)
+	}
+	if m._type != nil {
+		fields = append(fields, itemfield.FieldType)
+	}
+	if m.text_value != nil {
+		fields = append(fields, itemfield.FieldTextValue)
+	}
+	if m.number_value != nil {
+		fields = append(fields, itemfield.FieldNumberValue)
+	}
+	if m.boolean_value != nil {
+		fields = append(fields, itemfield.FieldBooleanValue)
+	}
+	if m.time_value != nil {
+		fields = append(fields, itemfield.FieldTimeValue)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ItemFieldMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case itemfield.FieldCreatedAt:
+		return m.CreatedAt()
+	case itemfield.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case itemfield.FieldName:
+		return m.Name()
+	case itemfield.FieldDescription:
+		return m.Description()
+	case itemfield.FieldType:
+		return m.GetType()
+	case itemfield.FieldTextValue:
+		return m.TextValue()
+	case itemfield.FieldNumberValue:
+		return m.NumberValue()
+	case itemfield.FieldBooleanValue:
+		return m.BooleanValue()
+	case itemfield.FieldTimeValue:
+		return m.TimeValue()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ItemFieldMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case itemfield.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case itemfield.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case itemfield.FieldName:
+		return m.OldName(ctx)
+	case itemfield.FieldDescription:
+		return m.OldDescription(ctx)
+	case itemfield.FieldType:
+		return m.OldType(ctx)
+	case itemfield.FieldTextValue:
+		return m.OldTextValue(ctx)
+	case itemfield.FieldNumberValue:
+		return m.OldNumberValue(ctx)
+	case itemfield.FieldBooleanValue:
+		return m.OldBooleanValue(ctx)
+	case itemfield.FieldTimeValue:
+		return m.OldTimeValue(ctx)
+	}
+	return nil, fmt.Errorf("unknown ItemField field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ItemFieldMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case itemfield.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case itemfield.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdatedAt(v)
 		return nil
-	case item.FieldName:
+	case itemfield.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetName(v)
 		return nil
-	case item.FieldDescription:
+	case itemfield.FieldDescription:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetDescription(v)
 		return nil
-	case item.FieldImportRef:
-		v, ok := value.(string)
+	case itemfield.FieldType:
+		v, ok := value.(itemfield.Type)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetImportRef(v)
+		m.SetType(v)
 		return nil
-	case item.FieldNotes:
+	case itemfield.FieldTextValue:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetNotes(v)
+		m.SetTextValue(v)
 		return nil
-	case item.FieldQuantity:
+	case itemfield.FieldNumberValue:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetQuantity(v)
+		m.SetNumberValue(v)
 		return nil
-	case item.FieldInsured:
+	case itemfield.FieldBooleanValue:
 		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetInsured(v)
+		m.SetBooleanValue(v)
 		return nil
-	case item.FieldArchived:
-		v, ok := value.(bool)
+	case itemfield.FieldTimeValue:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetArchived(v)
+		m.SetTimeValue(v)
 		return nil
-	case item.FieldAssetID:
+	}
+	return fmt.Errorf("unknown ItemField field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ItemFieldMutation) AddedFields() []string {
+	var fields []string
+	if m.addnumber_value != nil {
+		fields = append(fields, itemfield.FieldNumberValue)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ItemFieldMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case itemfield.FieldNumberValue:
+		return m.AddedNumberValue()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ItemFieldMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case itemfield.FieldNumberValue:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAssetID(v)
+		m.AddNumberValue(v)
 		return nil
-	case item.FieldSerialNumber:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSerialNumber(v)
+	}
+	return fmt.Errorf("unknown ItemField numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ItemFieldMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(itemfield.FieldDescription) {
+		fields = append(fields, itemfield.FieldDescription)
+	}
+	if m.FieldCleared(itemfield.FieldTextValue) {
+		fields = append(fields, itemfield.FieldTextValue)
+	}
+	if m.FieldCleared(itemfield.FieldNumberValue) {
+		fields = append(fields, itemfield.FieldNumberValue)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ItemFieldMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ItemFieldMutation) ClearField(name string) error {
+	switch name {
+	case itemfield.FieldDescription:
+		m.ClearDescription()
 		return nil
-	case item.FieldModelNumber:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetModelNumber(v)
+	case itemfield.FieldTextValue:
+		m.ClearTextValue()
 		return nil
-	case item.FieldManufacturer:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetManufacturer(v)
+	case itemfield.FieldNumberValue:
+		m.ClearNumberValue()
 		return nil
-	case item.FieldLifetimeWarranty:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetLifetimeWarranty(v)
+	}
+	return fmt.Errorf("unknown ItemField nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ItemFieldMutation) ResetField(name string) error {
+	switch name {
+	case itemfield.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
-	case item.FieldWarrantyExpires:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetWarrantyExpires(v)
+	case itemfield.FieldUpdatedAt:
+		m.ResetUpdatedAt()
 		return nil
-	case item.FieldWarrantyDetails:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetWarrantyDetails(v)
+	case itemfield.FieldName:
+		m.ResetName()
 		return nil
-	case item.FieldPurchaseTime:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetPurchaseTime(v)
+	case itemfield.FieldDescription:
+		m.ResetDescription()
 		return nil
-	case item.FieldPurchaseFrom:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetPurchaseFrom(v)
+	case itemfield.FieldType:
+		m.ResetType()
 		return nil
-	case item.FieldPurchasePrice:
-		v, ok := value.(float64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
+	case itemfield.FieldTextValue:
+		m.ResetTextValue()
+		return nil
+	case itemfield.FieldNumberValue:
+		m.ResetNumberValue()
+		return nil
+	case itemfield.FieldBooleanValue:
+		m.ResetBooleanValue()
+		return nil
+	case itemfield.FieldTimeValue:
+		m.ResetTimeValue()
+		return nil
+	}
+	return fmt.Errorf("unknown ItemField field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ItemFieldMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.item != nil {
+		edges = append(edges, itemfield.EdgeItem)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ItemFieldMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case itemfield.EdgeItem:
+		if id := m.item; id != nil {
+			return []ent.Value{*id}
 		}
-		m.SetPurchasePrice(v)
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ItemFieldMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ItemFieldMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ItemFieldMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareditem {
+		edges = append(edges, itemfield.EdgeItem)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ItemFieldMutation) EdgeCleared(name string) bool {
+	switch name {
+	case itemfield.EdgeItem:
+		return m.cleareditem
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ItemFieldMutation) ClearEdge(name string) error {
+	switch name {
+	case itemfield.EdgeItem:
+		m.ClearItem()
 		return nil
-	case item.FieldSoldTime:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSoldTime(v)
+	}
+	return fmt.Errorf("unknown ItemField unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ItemFieldMutation) ResetEdge(name string) error {
+	switch name {
+	case itemfield.EdgeItem:
+		m.ResetItem()
 		return nil
-	case item.FieldSoldTo:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
+	}
+	return fmt.Errorf("unknown ItemField edge %s", name)
+}
+
+// ItemTemplateMutation represents an operation that mutates the ItemTemplate nodes in the graph.
+type ItemTemplateMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	created_at    *time.Time
+	updated_at    *time.Time
+	name          *string
+	defaults      *types.ItemTemplateDefaults
+	clearedFields map[string]struct{}
+	group         *uuid.UUID
+	clearedgroup  bool
+	done          bool
+	oldValue      func(context.Context) (*ItemTemplate, error)
+	predicates    []predicate.ItemTemplate
+}
+
+var _ ent.Mutation = (*ItemTemplateMutation)(nil)
+
+// itemtemplateOption allows management of the mutation configuration using functional options.
+type itemtemplateOption func(*ItemTemplateMutation)
+
+// newItemTemplateMutation creates new mutation for the ItemTemplate entity.
+func newItemTemplateMutation(c config, op Op, opts ...itemtemplateOption) *ItemTemplateMutation {
+	m := &ItemTemplateMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeItemTemplate,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withItemTemplateID sets the ID field of the mutation.
+func withItemTemplateID(id uuid.UUID) itemtemplateOption {
+	return func(m *ItemTemplateMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ItemTemplate
+		)
+		m.oldValue = func(ctx context.Context) (*ItemTemplate, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ItemTemplate.Get(ctx, id)
+				}
+			})
+			return value, err
 		}
-		m.SetSoldTo(v)
-		return nil
-	case item.FieldSoldPrice:
-		v, ok := value.(float64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		m.id = &id
+	}
+}
+
+// withItemTemplate sets the old ItemTemplate of the mutation.
+func withItemTemplate(node *ItemTemplate) itemtemplateOption {
+	return func(m *ItemTemplateMutation) {
+		m.oldValue = func(context.Context) (*ItemTemplate, error) {
+			return node, nil
 		}
-		m.SetSoldPrice(v)
-		return nil
-	case item.FieldSoldNotes:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ItemTemplateMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ItemTemplateMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of ItemTemplate entities.
+func (m *ItemTemplateMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ItemTemplateMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ItemTemplateMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
 		}
-		m.SetSoldNotes(v)
-		return nil
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ItemTemplate.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ItemTemplateMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ItemTemplateMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the ItemTemplate entity.
+// If the ItemTemplate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemTemplateMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ItemTemplateMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *ItemTemplateMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *ItemTemplateMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the ItemTemplate entity.
+// If the ItemTemplate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemTemplateMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *ItemTemplateMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetName sets the "name" field.
+func (m *ItemTemplateMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *ItemTemplateMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the ItemTemplate entity.
+// If the ItemTemplate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemTemplateMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
-	return fmt.Errorf("unknown Item field %s", name)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
 }
 
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *ItemMutation) AddedFields() []string {
-	var fields []string
-	if m.addquantity != nil {
-		fields = append(fields, item.FieldQuantity)
+// ResetName resets all changes to the "name" field.
+func (m *ItemTemplateMutation) ResetName() {
+	m.name = nil
+}
+
+// SetDefaults sets the "defaults" field.
+func (m *ItemTemplateMutation) SetDefaults(ttd types.ItemTemplateDefaults) {
+	m.defaults = &ttd
+}
+
+// Defaults returns the value of the "defaults" field in the mutation.
+func (m *ItemTemplateMutation) Defaults() (r types.ItemTemplateDefaults, exists bool) {
+	v := m.defaults
+	if v == nil {
+		return
 	}
-	if m.addasset_id != nil {
-		fields = append(fields, item.FieldAssetID)
+	return *v, true
+}
+
+// OldDefaults returns the old "defaults" field's value of the ItemTemplate entity.
+// If the ItemTemplate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemTemplateMutation) OldDefaults(ctx context.Context) (v types.ItemTemplateDefaults, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDefaults is only allowed on UpdateOne operations")
 	}
-	if m.addpurchase_price != nil {
-		fields = append(fields, item.FieldPurchasePrice)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDefaults requires an ID field in the mutation")
 	}
-	if m.addsold_price != nil {
-		fields = append(fields, item.FieldSoldPrice)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDefaults: %w", err)
+	}
+	return oldValue.Defaults, nil
+}
+
+// ResetDefaults resets all changes to the "defaults" field.
+func (m *ItemTemplateMutation) ResetDefaults() {
+	m.defaults = nil
+}
+
+// SetGroupID sets the "group" edge to the Group entity by id.
+func (m *ItemTemplateMutation) SetGroupID(id uuid.UUID) {
+	m.group = &id
+}
+
+// ClearGroup clears the "group" edge to the Group entity.
+func (m *ItemTemplateMutation) ClearGroup() {
+	m.clearedgroup = true
+}
+
+// GroupCleared reports if the "group" edge to the Group entity was cleared.
+func (m *ItemTemplateMutation) GroupCleared() bool {
+	return m.clearedgroup
+}
+
+// GroupID returns the "group" edge ID in the mutation.
+func (m *ItemTemplateMutation) GroupID() (id uuid.UUID, exists bool) {
+	if m.group != nil {
+		return *m.group, true
+	}
+	return
+}
+
+// GroupIDs returns the "group" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// GroupID instead. It exists only for internal usage by the builders.
+func (m *ItemTemplateMutation) GroupIDs() (ids []uuid.UUID) {
+	if id := m.group; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetGroup resets all changes to the "group" edge.
+func (m *ItemTemplateMutation) ResetGroup() {
+	m.group = nil
+	m.clearedgroup = false
+}
+
+// Where appends a list predicates to the ItemTemplateMutation builder.
+func (m *ItemTemplateMutation) Where(ps ...predicate.ItemTemplate) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ItemTemplateMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ItemTemplateMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ItemTemplate, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ItemTemplateMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ItemTemplateMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ItemTemplate).
+func (m *ItemTemplateMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ItemTemplateMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.created_at != nil {
+		fields = append(fields, itemtemplate.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, itemtemplate.FieldUpdatedAt)
+	}
+	if m.name != nil {
+		fields = append(fields, itemtemplate.FieldName)
+	}
+	if m.defaults != nil {
+		fields = append(fields, itemtemplate.FieldDefaults)
 	}
 	return fields
 }
 
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *ItemMutation) AddedField(name string) (ent.Value, bool) {
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ItemTemplateMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case item.FieldQuantity:
-		return m.AddedQuantity()
-	case item.FieldAssetID:
-		return m.AddedAssetID()
-	case item.FieldPurchasePrice:
-		return m.AddedPurchasePrice()
-	case item.FieldSoldPrice:
-		return m.AddedSoldPrice()
+	case itemtemplate.FieldCreatedAt:
+		return m.CreatedAt()
+	case itemtemplate.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case itemtemplate.FieldName:
+		return m.Name()
+	case itemtemplate.FieldDefaults:
+		return m.Defaults()
 	}
 	return nil, false
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ItemTemplateMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case itemtemplate.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case itemtemplate.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case itemtemplate.FieldName:
+		return m.OldName(ctx)
+	case itemtemplate.FieldDefaults:
+		return m.OldDefaults(ctx)
+	}
+	return nil, fmt.Errorf("unknown ItemTemplate field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ItemMutation) AddField(name string, value ent.Value) error {
+func (m *ItemTemplateMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case item.FieldQuantity:
-		v, ok := value.(int)
+	case itemtemplate.FieldCreatedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddQuantity(v)
+		m.SetCreatedAt(v)
 		return nil
-	case item.FieldAssetID:
-		v, ok := value.(int)
+	case itemtemplate.FieldUpdatedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddAssetID(v)
+		m.SetUpdatedAt(v)
 		return nil
-	case item.FieldPurchasePrice:
-		v, ok := value.(float64)
+	case itemtemplate.FieldName:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddPurchasePrice(v)
+		m.SetName(v)
 		return nil
-	case item.FieldSoldPrice:
-		v, ok := value.(float64)
+	case itemtemplate.FieldDefaults:
+		v, ok := value.(types.ItemTemplateDefaults)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddSoldPrice(v)
+		m.SetDefaults(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Item numeric field %s", name)
-}
-
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *ItemMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(item.FieldDescription) {
-		fields = append(fields, item.FieldDescription)
-	}
-	if m.FieldCleared(item.FieldImportRef) {
-		fields = append(fields, item.FieldImportRef)
-	}
-	if m.FieldCleared(item.FieldNotes) {
-		fields = append(fields, item.FieldNotes)
-	}
-	if m.FieldCleared(item.FieldSerialNumber) {
-		fields = append(fields, item.FieldSerialNumber)
-	}
-	if m.FieldCleared(item.FieldModelNumber) {
-		fields = append(fields, item.FieldModelNumber)
-	}
-	if m.FieldCleared(item.FieldManufacturer) {
-		fields = append(fields, item.FieldManufacturer)
-	}
-	if m.FieldCleared(item.FieldWarrantyExpires) {
-		fields = append(fields, item.FieldWarrantyExpires)
-	}
-	if m.FieldCleared(item.FieldWarrantyDetails) {
-		fields = append(fields, item.FieldWarrantyDetails)
-	}
-	if m.FieldCleared(item.FieldPurchaseTime) {
-		fields = append(fields, item.FieldPurchaseTime)
-	}
-	if m.FieldCleared(item.FieldPurchaseFrom) {
-		fields = append(fields, item.FieldPurchaseFrom)
-	}
-	if m.FieldCleared(item.FieldSoldTime) {
-		fields = append(fields, item.FieldSoldTime)
-	}
-	if m.FieldCleared(item.FieldSoldTo) {
-		fields = append(fields, item.FieldSoldTo)
-	}
-	if m.FieldCleared(item.FieldSoldNotes) {
-		fields = append(fields, item.FieldSoldNotes)
-	}
-	return fields
-}
-
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *ItemMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
-	return ok
+	return fmt.Errorf("unknown ItemTemplate field %s", name)
 }
 
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *ItemMutation) ClearField(name string) error {
-	switch name {
-	case item.FieldDescription:
-		m.ClearDescription()
-		return nil
-	case item.FieldImportRef:
-		m.ClearImportRef()
-		return nil
-	case item.FieldNotes:
-		m.ClearNotes()
-		return nil
-	case item.FieldSerialNumber:
-		m.ClearSerialNumber()
-		return nil
-	case item.FieldModelNumber:
-		m.ClearModelNumber()
-		return nil
-	case item.FieldManufacturer:
-		m.ClearManufacturer()
-		return nil
-	case item.FieldWarrantyExpires:
-		m.ClearWarrantyExpires()
-		return nil
-	case item.FieldWarrantyDetails:
-		m.ClearWarrantyDetails()
-		return nil
-	case item.FieldPurchaseTime:
-		m.ClearPurchaseTime()
-		return nil
-	case item.FieldPurchaseFrom:
-		m.ClearPurchaseFrom()
-		return nil
-	case item.FieldSoldTime:
-		m.ClearSoldTime()
-		return nil
-	case item.FieldSoldTo:
-		m.ClearSoldTo()
-		return nil
-	case item.FieldSoldNotes:
-		m.ClearSoldNotes()
-		return nil
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ItemTemplateMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ItemTemplateMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ItemTemplateMutation) AddField(name string, value ent.Value) error {
+	switch name {
 	}
-	return fmt.Errorf("unknown Item nullable field %s", name)
+	return fmt.Errorf("unknown ItemTemplate numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ItemTemplateMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ItemTemplateMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ItemTemplateMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown ItemTemplate nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *ItemMutation) ResetField(name string) error {
+func (m *ItemTemplateMutation) ResetField(name string) error {
 	switch name {
-	case item.FieldCreatedAt:
+	case itemtemplate.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case item.FieldUpdatedAt:
+	case itemtemplate.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
-	case item.FieldName:
+	case itemtemplate.FieldName:
 		m.ResetName()
 		return nil
-	case item.FieldDescription:
-		m.ResetDescription()
-		return nil
-	case item.FieldImportRef:
-		m.ResetImportRef()
-		return nil
-	case item.FieldNotes:
-		m.ResetNotes()
-		return nil
-	case item.FieldQuantity:
-		m.ResetQuantity()
-		return nil
-	case item.FieldInsured:
-		m.ResetInsured()
-		return nil
-	case item.FieldArchived:
-		m.ResetArchived()
-		return nil
-	case item.FieldAssetID:
-		m.ResetAssetID()
-		return nil
-	case item.FieldSerialNumber:
-		m.ResetSerialNumber()
-		return nil
-	case item.FieldModelNumber:
-		m.ResetModelNumber()
-		return nil
-	case item.FieldManufacturer:
-		m.ResetManufacturer()
-		return nil
-	case item.FieldLifetimeWarranty:
-		m.ResetLifetimeWarranty()
-		return nil
-	case item.FieldWarrantyExpires:
-		m.ResetWarrantyExpires()
-		return nil
-	case item.FieldWarrantyDetails:
-		m.ResetWarrantyDetails()
-		return nil
-	case item.FieldPurchaseTime:
-		m.ResetPurchaseTime()
-		return nil
-	case item.FieldPurchaseFrom:
-		m.ResetPurchaseFrom()
-		return nil
-	case item.FieldPurchasePrice:
-		m.ResetPurchasePrice()
-		return nil
-	case item.FieldSoldTime:
-		m.ResetSoldTime()
-		return nil
-	case item.FieldSoldTo:
-		m.ResetSoldTo()
-		return nil
-	case item.FieldSoldPrice:
-		m.ResetSoldPrice()
-		return nil
-	case item.FieldSoldNotes:
-		m.ResetSoldNotes()
+	case itemtemplate.FieldDefaults:
+		m.ResetDefaults()
 		return nil
 	}
-	return fmt.Errorf("unknown Item field %s", name)
+	return fmt.Errorf("unknown ItemTemplate field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ItemMutation) AddedEdges() []string {
-	edges := make([]string, 0, 8)
+func (m *ItemTemplateMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
 	if m.group != nil {
-		edges = append(edges, item.EdgeGroup)
-	}
-	if m.parent != nil {
-		edges = append(edges, item.EdgeParent)
-	}
-	if m.children != nil {
-		edges = append(edges, item.EdgeChildren)
-	}
-	if m.label != nil {
-		edges = append(edges, item.EdgeLabel)
-	}
-	if m.location != nil {
-		edges = append(edges, item.EdgeLocation)
-	}
-	if m.fields != nil {
-		edges = append(edges, item.EdgeFields)
-	}
-	if m.maintenance_entries != nil {
-		edges = append(edges, item.EdgeMaintenanceEntries)
-	}
-	if m.attachments != nil {
-		edges = append(edges, item.EdgeAttachments)
+		edges = append(edges, itemtemplate.EdgeGroup)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *ItemMutation) AddedIDs(name string) []ent.Value {
+func (m *ItemTemplateMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case item.EdgeGroup:
+	case itemtemplate.EdgeGroup:
 		if id := m.group; id != nil {
 			return []ent.Value{*id}
 		}
-	case item.EdgeParent:
-		if id := m.parent; id != nil {
-			return []ent.Value{*id}
-		}
-	case item.EdgeChildren:
-		ids := make([]ent.Value, 0, len(m.children))
-		for id := range m.children {
-			ids = append(ids, id)
-		}
-		return ids
-	case item.EdgeLabel:
-		ids := make([]ent.Value, 0, len(m.label))
-		for id := range m.label {
-			ids = append(ids, id)
-		}
-		return ids
-	case item.EdgeLocation:
-		if id := m.location; id != nil {
-			return []ent.Value{*id}
-		}
-	case item.EdgeFields:
-		ids := make([]ent.Value, 0, len(m.fields))
-		for id := range m.fields {
-			ids = append(ids, id)
-		}
-		return ids
-	case item.EdgeMaintenanceEntries:
-		ids := make([]ent.Value, 0, len(m.maintenance_entries))
-		for id := range m.maintenance_entries {
-			ids = append(ids, id)
-		}
-		return ids
-	case item.EdgeAttachments:
-		ids := make([]ent.Value, 0, len(m.attachments))
-		for id := range m.attachments {
-			ids = append(ids, id)
-		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ItemMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 8)
-	if m.removedchildren != nil {
-		edges = append(edges, item.EdgeChildren)
-	}
-	if m.removedlabel != nil {
-		edges = append(edges, item.EdgeLabel)
-	}
-	if m.removedfields != nil {
-		edges = append(edges, item.EdgeFields)
-	}
-	if m.removedmaintenance_entries != nil {
-		edges = append(edges, item.EdgeMaintenanceEntries)
-	}
-	if m.removedattachments != nil {
-		edges = append(edges, item.EdgeAttachments)
-	}
+func (m *ItemTemplateMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *ItemMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case item.EdgeChildren:
-		ids := make([]ent.Value, 0, len(m.removedchildren))
-		for id := range m.removedchildren {
-			ids = append(ids, id)
-		}
-		return ids
-	case item.EdgeLabel:
-		ids := make([]ent.Value, 0, len(m.removedlabel))
-		for id := range m.removedlabel {
-			ids = append(ids, id)
-		}
-		return ids
-	case item.EdgeFields:
-		ids := make([]ent.Value, 0, len(m.removedfields))
-		for id := range m.removedfields {
-			ids = append(ids, id)
-		}
-		return ids
-	case item.EdgeMaintenanceEntries:
-		ids := make([]ent.Value, 0, len(m.removedmaintenance_entries))
-		for id := range m.removedmaintenance_entries {
-			ids = append(ids, id)
-		}
-		return ids
-	case item.EdgeAttachments:
-		ids := make([]ent.Value, 0, len(m.removedattachments))
-		for id := range m.removedattachments {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *ItemTemplateMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ItemMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 8)
-	if m.clearedgroup {
-		edges = append(edges, item.EdgeGroup)
-	}
-	if m.clearedparent {
-		edges = append(edges, item.EdgeParent)
-	}
-	if m.clearedchildren {
-		edges = append(edges, item.EdgeChildren)
-	}
-	if m.clearedlabel {
-		edges = append(edges, item.EdgeLabel)
-	}
-	if m.clearedlocation {
-		edges = append(edges, item.EdgeLocation)
-	}
-	if m.clearedfields {
-		edges = append(edges, item.EdgeFields)
-	}
-	if m.clearedmaintenance_entries {
-		edges = append(edges, item.EdgeMaintenanceEntries)
-	}
-	if m.clearedattachments {
-		edges = append(edges, item.EdgeAttachments)
-	}
-	return edges
-}
-
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *ItemMutation) EdgeCleared(name string) bool {
-	switch name {
-	case item.EdgeGroup:
-		return m.clearedgroup
-	case item.EdgeParent:
-		return m.clearedparent
-	case item.EdgeChildren:
-		return m.clearedchildren
-	case item.EdgeLabel:
-		return m.clearedlabel
-	case item.EdgeLocation:
-		return m.clearedlocation
-	case item.EdgeFields:
-		return m.clearedfields
-	case item.EdgeMaintenanceEntries:
-		return m.clearedmaintenance_entries
-	case item.EdgeAttachments:
-		return m.clearedattachments
+func (m *ItemTemplateMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedgroup {
+		edges = append(edges, itemtemplate.EdgeGroup)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ItemTemplateMutation) EdgeCleared(name string) bool {
+	switch name {
+	case itemtemplate.EdgeGroup:
+		return m.clearedgroup
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *ItemMutation) ClearEdge(name string) error {
+func (m *ItemTemplateMutation) ClearEdge(name string) error {
 	switch name {
-	case item.EdgeGroup:
+	case itemtemplate.EdgeGroup:
 		m.ClearGroup()
 		return nil
-	case item.EdgeParent:
-		m.ClearParent()
-		return nil
-	case item.EdgeLocation:
-		m.ClearLocation()
-		return nil
 	}
-	return fmt.Errorf("unknown Item unique edge %s", name)
+	return fmt.Errorf("unknown ItemTemplate unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *ItemMutation) ResetEdge(name string) error {
+func (m *ItemTemplateMutation) ResetEdge(name string) error {
 	switch name {
-	case item.EdgeGroup:
+	case itemtemplate.EdgeGroup:
 		m.ResetGroup()
 		return nil
-	case item.EdgeParent:
-		m.ResetParent()
-		return nil
-	case item.EdgeChildren:
-		m.ResetChildren()
-		return nil
-	case item.EdgeLabel:
-		m.ResetLabel()
-		return nil
-	case item.EdgeLocation:
-		m.ResetLocation()
-		return nil
-	case item.EdgeFields:
-		m.ResetFields()
-		return nil
-	case item.EdgeMaintenanceEntries:
-		m.ResetMaintenanceEntries()
-		return nil
-	case item.EdgeAttachments:
-		m.ResetAttachments()
-		return nil
 	}
-	return fmt.Errorf("unknown Item edge %s", name)
+	return fmt.Errorf("unknown ItemTemplate edge %s", name)
 }
 
-// ItemFieldMutation represents an operation that mutates the ItemField nodes in the graph.
-type ItemFieldMutation struct {
+// LabelMutation represents an operation that mutates the Label nodes in the graph.
+type LabelMutation struct {
 	config
-	op              Op
-	typ             string
-	id              *uuid.UUID
-	created_at      *time.Time
-	updated_at      *time.Time
-	name            *string
-	description     *string
-	_type           *itemfield.Type
-	text_value      *string
-	number_value    *int
-	addnumber_value *int
-	boolean_value   *bool
-	time_value      *time.Time
-	clearedFields   map[string]struct{}
-	item            *uuid.UUID
-	cleareditem     bool
-	done            bool
-	oldValue        func(context.Context) (*ItemField, error)
-	predicates      []predicate.ItemField
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	created_at    *time.Time
+	updated_at    *time.Time
+	name          *string
+	description   *string
+	color         *string
+	clearedFields map[string]struct{}
+	group         *uuid.UUID
+	clearedgroup  bool
+	items         map[uuid.UUID]struct{}
+	removeditems  map[uuid.UUID]struct{}
+	cleareditems  bool
+	done          bool
+	oldValue      func(context.Context) (*Label, error)
+	predicates    []predicate.Label
 }
 
-var _ ent.Mutation = (*ItemFieldMutation)(nil)
+var _ ent.Mutation = (*LabelMutation)(nil)
 
-// itemfieldOption allows management of the mutation configuration using functional options.
-type itemfieldOption func(*ItemFieldMutation)
+// labelOption allows management of the mutation configuration using functional options.
+type labelOption func(*LabelMutation)
 
-// newItemFieldMutation creates new mutation for the ItemField entity.
-func newItemFieldMutation(c config, op Op, opts ...itemfieldOption) *ItemFieldMutation {
-	m := &ItemFieldMutation{
+// newLabelMutation creates new mutation for the Label entity.
+func newLabelMutation(c config, op Op, opts ...labelOption) *LabelMutation {
+	m := &LabelMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeItemField,
+		typ:           TypeLabel,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -6617,20 +12271,20 @@ func newItemFieldMutation(c config, op Op, opts ...itemfieldOption) *ItemFieldMu
 	return m
 }
 
-// withItemFieldID sets the ID field of the mutation.
-func withItemFieldID(id uuid.UUID) itemfieldOption {
-	return func(m *ItemFieldMutation) {
+// withLabelID sets the ID field of the mutation.
+func withLabelID(id uuid.UUID) labelOption {
+	return func(m *LabelMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *ItemField
+			value *Label
 		)
-		m.oldValue = func(ctx context.Context) (*ItemField, error) {
+		m.oldValue = func(ctx context.Context) (*Label, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().ItemField.Get(ctx, id)
+					value, err = m.Client().Label.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -6639,10 +12293,10 @@ func withItemFieldID(id uuid.UUID) itemfieldOption {
 	}
 }
 
-// withItemField sets the old ItemField of the mutation.
-func withItemField(node *ItemField) itemfieldOption {
-	return func(m *ItemFieldMutation) {
-		m.oldValue = func(context.Context) (*ItemField, error) {
+// withLabel sets the old Label of the mutation.
+func withLabel(node *Label) labelOption {
+	return func(m *LabelMutation) {
+		m.oldValue = func(context.Context) (*Label, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -6651,7 +12305,7 @@ func withItemField(node *ItemField) itemfieldOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ItemFieldMutation) Client() *Client {
+func (m LabelMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -6659,7 +12313,7 @@ func (m ItemFieldMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m ItemFieldMutation) Tx() (*Tx, error) {
+func (m LabelMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -6669,14 +12323,14 @@ func (m ItemFieldMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of ItemField entities.
-func (m *ItemFieldMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of Label entities.
+func (m *LabelMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *ItemFieldMutation) ID() (id uuid.UUID, exists bool) {
+func (m *LabelMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -6687,7 +12341,7 @@ func (m *ItemFieldMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *ItemFieldMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *LabelMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -6696,19 +12350,19 @@ func (m *ItemFieldMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().ItemField.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Label.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *ItemFieldMutation) SetCreatedAt(t time.Time) {
+func (m *LabelMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *ItemFieldMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *LabelMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -6716,10 +12370,10 @@ func (m *ItemFieldMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the ItemField entity.
-// If the ItemField object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Label entity.
+// If the Label object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ItemFieldMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *LabelMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -6734,17 +12388,17 @@ func (m *ItemFieldMutation) OldCreatedAt(ctx context.Context) (v time.Time, err
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *ItemFieldMutation) ResetCreatedAt() {
+func (m *LabelMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *ItemFieldMutation) SetUpdatedAt(t time.Time) {
+func (m *LabelMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *ItemFieldMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *LabelMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -6752,10 +12406,10 @@ func (m *ItemFieldMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the ItemField entity.
-// If the ItemField object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the Label entity.
+// If the Label object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ItemFieldMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *LabelMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -6770,17 +12424,17 @@ func (m *ItemFieldMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *ItemFieldMutation) ResetUpdatedAt() {
+func (m *LabelMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
 // SetName sets the "name" field.
-func (m *ItemFieldMutation) SetName(s string) {
+func (m *LabelMutation) SetName(s string) {
 	m.name = &s
 }
 
 // Name returns the value of the "name" field in the mutation.
-func (m *ItemFieldMutation) Name() (r string, exists bool) {
+func (m *LabelMutation) Name() (r string, exists bool) {
 	v := m.name
 	if v == nil {
 		return
@@ -6788,10 +12442,10 @@ func (m *ItemFieldMutation) Name() (r string, exists bool) {
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the ItemField entity.
-// If the ItemField object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the Label entity.
+// If the Label object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ItemFieldMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *LabelMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
@@ -6806,334 +12460,210 @@ func (m *ItemFieldMutation) OldName(ctx context.Context) (v string, err error) {
 }
 
 // ResetName resets all changes to the "name" field.
-func (m *ItemFieldMutation) ResetName() {
+func (m *LabelMutation) ResetName() {
 	m.name = nil
 }
 
 // SetDescription sets the "description" field.
-func (m *ItemFieldMutation) SetDescription(s string) {
+func (m *LabelMutation) SetDescription(s string) {
 	m.description = &s
 }
 
 // Description returns the value of the "description" field in the mutation.
-func (m *ItemFieldMutation) Description() (r string, exists bool) {
-	v := m.description
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldDescription returns the old "description" field's value of the ItemField entity.
-// If the ItemField object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ItemFieldMutation) OldDescription(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDescription requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
-	}
-	return oldValue.Description, nil
-}
-
-// ClearDescription clears the value of the "description" field.
-func (m *ItemFieldMutation) ClearDescription() {
-	m.description = nil
-	m.clearedFields[itemfield.FieldDescription] = struct{}{}
-}
-
-// DescriptionCleared returns if the "description" field was cleared in this mutation.
-func (m *ItemFieldMutation) DescriptionCleared() bool {
-	_, ok := m.clearedFields[itemfield.FieldDescription]
-	return ok
-}
-
-// ResetDescription resets all changes to the "description" field.
-func (m *ItemFieldMutation) ResetDescription() {
-	m.description = nil
-	delete(m.clearedFields, itemfield.FieldDescription)
-}
-
-// SetType sets the "type" field.
-func (m *ItemFieldMutation) SetType(i itemfield.Type) {
-	m._type = &i
-}
-
-// GetType returns the value of the "type" field in the mutation.
-func (m *ItemFieldMutation) GetType() (r itemfield.Type, exists bool) {
-	v := m._type
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldType returns the old "type" field's value of the ItemField entity.
-// If the ItemField object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ItemFieldMutation) OldType(ctx context.Context) (v itemfield.Type, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldType is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldType requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldType: %w", err)
-	}
-	return oldValue.Type, nil
-}
-
-// ResetType resets all changes to the "type" field.
-func (m *ItemFieldMutation) ResetType() {
-	m._type = nil
-}
-
-// SetTextValue sets the "text_value" field.
-func (m *ItemFieldMutation) SetTextValue(s string) {
-	m.text_value = &s
-}
-
-// TextValue returns the value of the "text_value" field in the mutation.
-func (m *ItemFieldMutation) TextValue() (r string, exists bool) {
-	v := m.text_value
+func (m *LabelMutation) Description() (r string, exists bool) {
+	v := m.description
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTextValue returns the old "text_value" field's value of the ItemField entity.
-// If the ItemField object wasn't provided to the builder, the object is fetched from the database.
+// OldDescription returns the old "description" field's value of the Label entity.
+// If the Label object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ItemFieldMutation) OldTextValue(ctx context.Context) (v string, err error) {
+func (m *LabelMutation) OldDescription(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTextValue is only allowed on UpdateOne operations")
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTextValue requires an ID field in the mutation")
+		return v, errors.New("OldDescription requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTextValue: %w", err)
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
 	}
-	return oldValue.TextValue, nil
+	return oldValue.Description, nil
 }
 
-// ClearTextValue clears the value of the "text_value" field.
-func (m *ItemFieldMutation) ClearTextValue() {
-	m.text_value = nil
-	m.clearedFields[itemfield.FieldTextValue] = struct{}{}
+// ClearDescription clears the value of the "description" field.
+func (m *LabelMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[label.FieldDescription] = struct{}{}
 }
 
-// TextValueCleared returns if the "text_value" field was cleared in this mutation.
-func (m *ItemFieldMutation) TextValueCleared() bool {
-	_, ok := m.clearedFields[itemfield.FieldTextValue]
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *LabelMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[label.FieldDescription]
 	return ok
 }
 
-// ResetTextValue resets all changes to the "text_value" field.
-func (m *ItemFieldMutation) ResetTextValue() {
-	m.text_value = nil
-	delete(m.clearedFields, itemfield.FieldTextValue)
+// ResetDescription resets all changes to the "description" field.
+func (m *LabelMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, label.FieldDescription)
 }
 
-// SetNumberValue sets the "number_value" field.
-func (m *ItemFieldMutation) SetNumberValue(i int) {
-	m.number_value = &i
-	m.addnumber_value = nil
+// SetColor sets the "color" field.
+func (m *LabelMutation) SetColor(s string) {
+	m.color = &s
 }
 
-// NumberValue returns the value of the "number_value" field in the mutation.
-func (m *ItemFieldMutation) NumberValue() (r int, exists bool) {
-	v := m.number_value
+// Color returns the value of the "color" field in the mutation.
+func (m *LabelMutation) Color() (r string, exists bool) {
+	v := m.color
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldNumberValue returns the old "number_value" field's value of the ItemField entity.
-// If the ItemField object wasn't provided to the builder, the object is fetched from the database.
+// OldColor returns the old "color" field's value of the Label entity.
+// If the Label object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ItemFieldMutation) OldNumberValue(ctx context.Context) (v int, err error) {
+func (m *LabelMutation) OldColor(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldNumberValue is only allowed on UpdateOne operations")
+		return v, errors.New("OldColor is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldNumberValue requires an ID field in the mutation")
+		return v, errors.New("OldColor requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldNumberValue: %w", err)
+		return v, fmt.Errorf("querying old value for OldColor: %w", err)
 	}
-	return oldValue.NumberValue, nil
+	return oldValue.Color, nil
 }
 
-// AddNumberValue adds i to the "number_value" field.
-func (m *ItemFieldMutation) AddNumberValue(i int) {
-	if m.addnumber_value != nil {
-		*m.addnumber_value += i
-	} else {
-		m.addnumber_value = &i
-	}
+// ClearColor clears the value of the "color" field.
+func (m *LabelMutation) ClearColor() {
+	m.color = nil
+	m.clearedFields[label.FieldColor] = struct{}{}
 }
 
-// AddedNumberValue returns the value that was added to the "number_value" field in this mutation.
-func (m *ItemFieldMutation) AddedNumberValue() (r int, exists bool) {
-	v := m.addnumber_value
-	if v == nil {
-		return
-	}
-	return *v, true
+// ColorCleared returns if the "color" field was cleared in this mutation.
+func (m *LabelMutation) ColorCleared() bool {
+	_, ok := m.clearedFields[label.FieldColor]
+	return ok
 }
 
-// ClearNumberValue clears the value of the "number_value" field.
-func (m *ItemFieldMutation) ClearNumberValue() {
-	m.number_value = nil
-	m.addnumber_value = nil
-	m.clearedFields[itemfield.FieldNumberValue] = struct{}{}
+// ResetColor resets all changes to the "color" field.
+func (m *LabelMutation) ResetColor() {
+	m.color = nil
+	delete(m.clearedFields, label.FieldColor)
 }
 
-// NumberValueCleared returns if the "number_value" field was cleared in this mutation.
-func (m *ItemFieldMutation) NumberValueCleared() bool {
-	_, ok := m.clearedFields[itemfield.FieldNumberValue]
-	return ok
+// SetGroupID sets the "group" edge to the Group entity by id.
+func (m *LabelMutation) SetGroupID(id uuid.UUID) {
+	m.group = &id
 }
 
-// ResetNumberValue resets all changes to the "number_value" field.
-func (m *ItemFieldMutation) ResetNumberValue() {
-	m.number_value = nil
-	m.addnumber_value = nil
-	delete(m.clearedFields, itemfield.FieldNumberValue)
+// ClearGroup clears the "group" edge to the Group entity.
+func (m *LabelMutation) ClearGroup() {
+	m.clearedgroup = true
 }
 
-// SetBooleanValue sets the "boolean_value" field.
-func (m *ItemFieldMutation) SetBooleanValue(b bool) {
-	m.boolean_value = &b
+// GroupCleared reports if the "group" edge to the Group entity was cleared.
+func (m *LabelMutation) GroupCleared() bool {
+	return m.clearedgroup
 }
 
-// BooleanValue returns the value of the "boolean_value" field in the mutation.
-func (m *ItemFieldMutation) BooleanValue() (r bool, exists bool) {
-	v := m.boolean_value
-	if v == nil {
-		return
+// GroupID returns the "group" edge ID in the mutation.
+func (m *LabelMutation) GroupID() (id uuid.UUID, exists bool) {
+	if m.group != nil {
+		return *m.group, true
 	}
-	return *v, true
+	return
 }
 
-// OldBooleanValue returns the old "boolean_value" field's value of the ItemField entity.
-// If the ItemField object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ItemFieldMutation) OldBooleanValue(ctx context.Context) (v bool, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldBooleanValue is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldBooleanValue requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldBooleanValue: %w", err)
+// GroupIDs returns the "group" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// GroupID instead. It exists only for internal usage by the builders.
+func (m *LabelMutation) GroupIDs() (ids []uuid.UUID) {
+	if id := m.group; id != nil {
+		ids = append(ids, *id)
 	}
-	return oldValue.BooleanValue, nil
-}
-
-// ResetBooleanValue resets all changes to the "boolean_value" field.
-func (m *ItemFieldMutation) ResetBooleanValue() {
-	m.boolean_value = nil
-}
-
-// SetTimeValue sets the "time_value" field.
-func (m *ItemFieldMutation) SetTimeValue(t time.Time) {
-	m.time_value = &t
+	return
 }
 
-// TimeValue returns the value of the "time_value" field in the mutation.
-func (m *ItemFieldMutation) TimeValue() (r time.Time, exists bool) {
-	v := m.time_value
-	if v == nil {
-		return
-	}
-	return *v, true
+// ResetGroup resets all changes to the "group" edge.
+func (m *LabelMutation) ResetGroup() {
+	m.group = nil
+	m.clearedgroup = false
 }
 
-// OldTimeValue returns the old "time_value" field's value of the ItemField entity.
-// If the ItemField object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ItemFieldMutation) OldTimeValue(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTimeValue is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTimeValue requires an ID field in the mutation")
+// AddItemIDs adds the "items" edge to the Item entity by ids.
+func (m *LabelMutation) AddItemIDs(ids ...uuid.UUID) {
+	if m.items == nil {
+		m.items = make(map[uuid.UUID]struct{})
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTimeValue: %w", err)
+	for i := range ids {
+		m.items[ids[i]] = struct{}{}
 	}
-	return oldValue.TimeValue, nil
-}
-
-// ResetTimeValue resets all changes to the "time_value" field.
-func (m *ItemFieldMutation) ResetTimeValue() {
-	m.time_value = nil
 }
 
-// SetItemID sets the "item" edge to the Item entity by id.
-func (m *ItemFieldMutation) SetItemID(id uuid.UUID) {
-	m.item = &id
+// ClearItems clears the "items" edge to the Item entity.
+func (m *LabelMutation) ClearItems() {
+	m.cleareditems = true
 }
 
-// ClearItem clears the "item" edge to the Item entity.
-func (m *ItemFieldMutation) ClearItem() {
-	m.cleareditem = true
+// ItemsCleared reports if the "items" edge to the Item entity was cleared.
+func (m *LabelMutation) ItemsCleared() bool {
+	return m.cleareditems
 }
 
-// ItemCleared reports if the "item" edge to the Item entity was cleared.
-func (m *ItemFieldMutation) ItemCleared() bool {
-	return m.cleareditem
+// RemoveItemIDs removes the "items" edge to the Item entity by IDs.
+func (m *LabelMutation) RemoveItemIDs(ids ...uuid.UUID) {
+	if m.removeditems == nil {
+		m.removeditems = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.items, ids[i])
+		m.removeditems[ids[i]] = struct{}{}
+	}
 }
 
-// ItemID returns the "item" edge ID in the mutation.
-func (m *ItemFieldMutation) ItemID() (id uuid.UUID, exists bool) {
-	if m.item != nil {
-		return *m.item, true
+// RemovedItems returns the removed IDs of the "items" edge to the Item entity.
+func (m *LabelMutation) RemovedItemsIDs() (ids []uuid.UUID) {
+	for id := range m.removeditems {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// ItemIDs returns the "item" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// ItemID instead. It exists only for internal usage by the builders.
-func (m *ItemFieldMutation) ItemIDs() (ids []uuid.UUID) {
-	if id := m.item; id != nil {
-		ids = append(ids, *id)
+// ItemsIDs returns the "items" edge IDs in the mutation.
+func (m *LabelMutation) ItemsIDs() (ids []uuid.UUID) {
+	for id := range m.items {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetItem resets all changes to the "item" edge.
-func (m *ItemFieldMutation) ResetItem() {
-	m.item = nil
-	m.cleareditem = false
+// ResetItems resets all changes to the "items" edge.
+func (m *LabelMutation) ResetItems() {
+	m.items = nil
+	m.cleareditems = false
+	m.removeditems = nil
 }
 
-// Where appends a list predicates to the ItemFieldMutation builder.
-func (m *ItemFieldMutation) Where(ps ...predicate.ItemField) {
+// Where appends a list predicates to the LabelMutation builder.
+func (m *LabelMutation) Where(ps ...predicate.Label) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the ItemFieldMutation builder. Using this method,
+// WhereP appends storage-level predicates to the LabelMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *ItemFieldMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.ItemField, len(ps))
+func (m *LabelMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Label, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -7141,51 +12671,39 @@ func (m *ItemFieldMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *ItemFieldMutation) Op() Op {
+func (m *LabelMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *ItemFieldMutation) SetOp(op Op) {
+func (m *LabelMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (ItemField).
-func (m *ItemFieldMutation) Type() string {
+// Type returns the node type of this mutation (Label).
+func (m *LabelMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *ItemFieldMutation) Fields() []string {
-	fields := make([]string, 0, 9)
+func (m *LabelMutation) Fields() []string {
+	fields := make([]string, 0, 5)
 	if m.created_at != nil {
-		fields = append(fields, itemfield.FieldCreatedAt)
+		fields = append(fields, label.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, itemfield.FieldUpdatedAt)
+		fields = append(fields, label.FieldUpdatedAt)
 	}
 	if m.name != nil {
-		fields = append(fields, itemfield.FieldName)
+		fields = append(fields, label.FieldName)
 	}
 	if m.description != nil {
-		fields = append(fields, itemfield.FieldDescription)
-	}
-	if m._type != nil {
-		fields = append(fields, itemfield.FieldType)
-	}
-	if m.text_value != nil {
-		fields = append(fields, itemfield.FieldTextValue)
-	}
-	if m.number_value != nil {
-		fields = append(fields, itemfield.FieldNumberValue)
-	}
-	if m.boolean_value != nil {
-		fields = append(fields, itemfield.FieldBooleanValue)
+		fields = append(fields, label.FieldDescription)
 	}
-	if m.time_value != nil {
-		fields = append(fields, itemfield.FieldTimeValue)
+	if m.color != nil {
+		fields = append(fields, label.FieldColor)
 	}
 	return fields
 }
@@ -7193,26 +12711,18 @@ func (m *ItemFieldMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *ItemFieldMutation) Field(name string) (ent.Value, bool) {
+func (m *LabelMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case itemfield.FieldCreatedAt:
+	case label.FieldCreatedAt:
 		return m.CreatedAt()
-	case itemfield.FieldUpdatedAt:
+	case label.FieldUpdatedAt:
 		return m.UpdatedAt()
-	case itemfield.FieldName:
+	case label.FieldName:
 		return m.Name()
-	case itemfield.FieldDescription:
+	case label.FieldDescription:
 		return m.Description()
-	case itemfield.FieldType:
-		return m.GetType()
-	case itemfield.FieldTextValue:
-		return m.TextValue()
-	case itemfield.FieldNumberValue:
-		return m.NumberValue()
-	case itemfield.FieldBooleanValue:
-		return m.BooleanValue()
-	case itemfield.FieldTimeValue:
-		return m.TimeValue()
+	case label.FieldColor:
+		return m.Color()
 	}
 	return nil, false
 }
@@ -7220,321 +12730,286 @@ func (m *ItemFieldMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *ItemFieldMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *LabelMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case itemfield.FieldCreatedAt:
+	case label.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case itemfield.FieldUpdatedAt:
+	case label.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
-	case itemfield.FieldName:
+	case label.FieldName:
 		return m.OldName(ctx)
-	case itemfield.FieldDescription:
+	case label.FieldDescription:
 		return m.OldDescription(ctx)
-	case itemfield.FieldType:
-		return m.OldType(ctx)
-	case itemfield.FieldTextValue:
-		return m.OldTextValue(ctx)
-	case itemfield.FieldNumberValue:
-		return m.OldNumberValue(ctx)
-	case itemfield.FieldBooleanValue:
-		return m.OldBooleanValue(ctx)
-	case itemfield.FieldTimeValue:
-		return m.OldTimeValue(ctx)
+	case label.FieldColor:
+		return m.OldColor(ctx)
 	}
-	return nil, fmt.Errorf("unknown ItemField field %s", name)
+	return nil, fmt.Errorf("unknown Label field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ItemFieldMutation) SetField(name string, value ent.Value) error {
+func (m *LabelMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case itemfield.FieldCreatedAt:
+	case label.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case itemfield.FieldUpdatedAt:
+	case label.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdatedAt(v)
 		return nil
-	case itemfield.FieldName:
+	case label.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetName(v)
 		return nil
-	case itemfield.FieldDescription:
+	case label.FieldDescription:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetDescription(v)
 		return nil
-	case itemfield.FieldType:
-		v, ok := value.(itemfield.Type)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetType(v)
-		return nil
-	case itemfield.FieldTextValue:
+	case label.FieldColor:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTextValue(v)
-		return nil
-	case itemfield.FieldNumberValue:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetNumberValue(v)
-		return nil
-	case itemfield.FieldBooleanValue:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetBooleanValue(v)
-		return nil
-	case itemfield.FieldTimeValue:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetTimeValue(v)
+		m.SetColor(v)
 		return nil
 	}
-	return fmt.Errorf("unknown ItemField field %s", name)
+	return fmt.Errorf("unknown Label field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *ItemFieldMutation) AddedFields() []string {
-	var fields []string
-	if m.addnumber_value != nil {
-		fields = append(fields, itemfield.FieldNumberValue)
-	}
-	return fields
+func (m *LabelMutation) AddedFields() []string {
+	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *ItemFieldMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case itemfield.FieldNumberValue:
-		return m.AddedNumberValue()
-	}
+func (m *LabelMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ItemFieldMutation) AddField(name string, value ent.Value) error {
+func (m *LabelMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case itemfield.FieldNumberValue:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddNumberValue(v)
-		return nil
 	}
-	return fmt.Errorf("unknown ItemField numeric field %s", name)
+	return fmt.Errorf("unknown Label numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *ItemFieldMutation) ClearedFields() []string {
+func (m *LabelMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(itemfield.FieldDescription) {
-		fields = append(fields, itemfield.FieldDescription)
-	}
-	if m.FieldCleared(itemfield.FieldTextValue) {
-		fields = append(fields, itemfield.FieldTextValue)
+	if m.FieldCleared(label.FieldDescription) {
+		fields = append(fields, label.FieldDescription)
 	}
-	if m.FieldCleared(itemfield.FieldNumberValue) {
-		fields = append(fields, itemfield.FieldNumberValue)
+	if m.FieldCleared(label.FieldColor) {
+		fields = append(fields, label.FieldColor)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *ItemFieldMutation) FieldCleared(name string) bool {
+func (m *LabelMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *ItemFieldMutation) ClearField(name string) error {
+func (m *LabelMutation) ClearField(name string) error {
 	switch name {
-	case itemfield.FieldDescription:
+	case label.FieldDescription:
 		m.ClearDescription()
 		return nil
-	case itemfield.FieldTextValue:
-		m.ClearTextValue()
-		return nil
-	case itemfield.FieldNumberValue:
-		m.ClearNumberValue()
+	case label.FieldColor:
+		m.ClearColor()
 		return nil
 	}
-	return fmt.Errorf("unknown ItemField nullable field %s", name)
+	return fmt.Errorf("unknown Label nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *ItemFieldMutation) ResetField(name string) error {
+func (m *LabelMutation) ResetField(name string) error {
 	switch name {
-	case itemfield.FieldCreatedAt:
+	case label.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case itemfield.FieldUpdatedAt:
+	case label.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
-	case itemfield.FieldName:
+	case label.FieldName:
 		m.ResetName()
 		return nil
-	case itemfield.FieldDescription:
+	case label.FieldDescription:
 		m.ResetDescription()
 		return nil
-	case itemfield.FieldType:
-		m.ResetType()
-		return nil
-	case itemfield.FieldTextValue:
-		m.ResetTextValue()
-		return nil
-	case itemfield.FieldNumberValue:
-		m.ResetNumberValue()
-		return nil
-	case itemfield.FieldBooleanValue:
-		m.ResetBooleanValue()
-		return nil
-	case itemfield.FieldTimeValue:
-		m.ResetTimeValue()
+	case label.FieldColor:
+		m.ResetColor()
 		return nil
 	}
-	return fmt.Errorf("unknown ItemField field %s", name)
+	return fmt.Errorf("unknown Label field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ItemFieldMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.item != nil {
-		edges = append(edges, itemfield.EdgeItem)
+func (m *LabelMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.group != nil {
+		edges = append(edges, label.EdgeGroup)
+	}
+	if m.items != nil {
+		edges = append(edges, label.EdgeItems)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *ItemFieldMutation) AddedIDs(name string) []ent.Value {
+func (m *LabelMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case itemfield.EdgeItem:
-		if id := m.item; id != nil {
+	case label.EdgeGroup:
+		if id := m.group; id != nil {
 			return []ent.Value{*id}
 		}
+	case label.EdgeItems:
+		ids := make([]ent.Value, 0, len(m.items))
+		for id := range m.items {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ItemFieldMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *LabelMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removeditems != nil {
+		edges = append(edges, label.EdgeItems)
+	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *ItemFieldMutation) RemovedIDs(name string) []ent.Value {
+func (m *LabelMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case label.EdgeItems:
+		ids := make([]ent.Value, 0, len(m.removeditems))
+		for id := range m.removeditems {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ItemFieldMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.cleareditem {
-		edges = append(edges, itemfield.EdgeItem)
+func (m *LabelMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedgroup {
+		edges = append(edges, label.EdgeGroup)
+	}
+	if m.cleareditems {
+		edges = append(edges, label.EdgeItems)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *ItemFieldMutation) EdgeCleared(name string) bool {
+func (m *LabelMutation) EdgeCleared(name string) bool {
 	switch name {
-	case itemfield.EdgeItem:
-		return m.cleareditem
+	case label.EdgeGroup:
+		return m.clearedgroup
+	case label.EdgeItems:
+		return m.cleareditems
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *ItemFieldMutation) ClearEdge(name string) error {
+func (m *LabelMutation) ClearEdge(name string) error {
 	switch name {
-	case itemfield.EdgeItem:
-		m.ClearItem()
+	case label.EdgeGroup:
+		m.ClearGroup()
 		return nil
 	}
-	return fmt.Errorf("unknown ItemField unique edge %s", name)
+	return fmt.Errorf("unknown Label unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *ItemFieldMutation) ResetEdge(name string) error {
+func (m *LabelMutation) ResetEdge(name string) error {
 	switch name {
-	case itemfield.EdgeItem:
-		m.ResetItem()
+	case label.EdgeGroup:
+		m.ResetGroup()
+		return nil
+	case label.EdgeItems:
+		m.ResetItems()
 		return nil
 	}
-	return fmt.Errorf("unknown ItemField edge %s", name)
+	return fmt.Errorf("unknown Label edge %s", name)
 }
 
-// LabelMutation represents an operation that mutates the Label nodes in the graph.
-type LabelMutation struct {
+// LocationMutation represents an operation that mutates the Location nodes in the graph.
+type LocationMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *uuid.UUID
-	created_at    *time.Time
-	updated_at    *time.Time
-	name          *string
-	description   *string
-	color         *string
-	clearedFields map[string]struct{}
-	group         *uuid.UUID
-	clearedgroup  bool
-	items         map[uuid.UUID]struct{}
-	removeditems  map[uuid.UUID]struct{}
-	cleareditems  bool
-	done          bool
-	oldValue      func(context.Context) (*Label, error)
-	predicates    []predicate.Label
+	op              Op
+	typ             string
+	id              *uuid.UUID
+	created_at      *time.Time
+	updated_at      *time.Time
+	name            *string
+	description     *string
+	capacity        *int
+	addcapacity     *int
+	clearedFields   map[string]struct{}
+	group           *uuid.UUID
+	clearedgroup    bool
+	parent          *uuid.UUID
+	clearedparent   bool
+	children        map[uuid.UUID]struct{}
+	removedchildren map[uuid.UUID]struct{}
+	clearedchildren bool
+	items           map[uuid.UUID]struct{}
+	removeditems    map[uuid.UUID]struct{}
+	cleareditems    bool
+	done            bool
+	oldValue        func(context.Context) (*Location, error)
+	predicates      []predicate.Location
 }
 
-var _ ent.Mutation = (*LabelMutation)(nil)
+var _ ent.Mutation = (*LocationMutation)(nil)
 
-// labelOption allows management of the mutation configuration using functional options.
-type labelOption func(*LabelMutation)
+// locationOption allows management of the mutation configuration using functional options.
+type locationOption func(*LocationMutation)
 
-// newLabelMutation creates new mutation for the Label entity.
-func newLabelMutation(c config, op Op, opts ...labelOption) *LabelMutation {
-	m := &LabelMutation{
+// newLocationMutation creates new mutation for the Location entity.
+func newLocationMutation(c config, op Op, opts ...locationOption) *LocationMutation {
+	m := &LocationMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeLabel,
+		typ:           TypeLocation,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -7543,20 +13018,20 @@ func newLabelMutation(c config, op Op, opts ...labelOption) *LabelMutation {
 	return m
 }
 
-// withLabelID sets the ID field of the mutation.
-func withLabelID(id uuid.UUID) labelOption {
-	return func(m *LabelMutation) {
+// withLocationID sets the ID field of the mutation.
+func withLocationID(id uuid.UUID) locationOption {
+	return func(m *LocationMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Label
+			value *Location
 		)
-		m.oldValue = func(ctx context.Context) (*Label, error) {
+		m.oldValue = func(ctx context.Context) (*Location, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Label.Get(ctx, id)
+					value, err = m.Client().Location.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -7565,10 +13040,10 @@ func withLabelID(id uuid.UUID) labelOption {
 	}
 }
 
-// withLabel sets the old Label of the mutation.
-func withLabel(node *Label) labelOption {
-	return func(m *LabelMutation) {
-		m.oldValue = func(context.Context) (*Label, error) {
+// withLocation sets the old Location of the mutation.
+func withLocation(node *Location) locationOption {
+	return func(m *LocationMutation) {
+		m.oldValue = func(context.Context) (*Location, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -7577,7 +13052,7 @@ func withLabel(node *Label) labelOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m LabelMutation) Client() *Client {
+func (m LocationMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -7585,7 +13060,7 @@ func (m LabelMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m LabelMutation) Tx() (*Tx, error) {
+func (m LocationMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -7595,14 +13070,14 @@ func (m LabelMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Label entities.
-func (m *LabelMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of Location entities.
+func (m *LocationMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *LabelMutation) ID() (id uuid.UUID, exists bool) {
+func (m *LocationMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -7613,7 +13088,7 @@ func (m *LabelMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *LabelMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *LocationMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -7622,19 +13097,19 @@ func (m *LabelMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Label.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Location.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *LabelMutation) SetCreatedAt(t time.Time) {
+func (m *LocationMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *LabelMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *LocationMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -7642,10 +13117,10 @@ func (m *LabelMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Label entity.
-// If the Label object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Location entity.
+// If the Location object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LabelMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *LocationMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -7660,17 +13135,17 @@ func (m *LabelMutation) OldCreatedAt(ctx context.Context) (v time.Time, err erro
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *LabelMutation) ResetCreatedAt() {
+func (m *LocationMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *LabelMutation) SetUpdatedAt(t time.Time) {
+func (m *LocationMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *LabelMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *LocationMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -7678,10 +13153,10 @@ func (m *LabelMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Label entity.
-// If the Label object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the Location entity.
+// If the Location object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LabelMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *LocationMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -7696,17 +13171,17 @@ func (m *LabelMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err erro
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *LabelMutation) ResetUpdatedAt() {
+func (m *LocationMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
 // SetName sets the "name" field.
-func (m *LabelMutation) SetName(s string) {
+func (m *LocationMutation) SetName(s string) {
 	m.name = &s
 }
 
 // Name returns the value of the "name" field in the mutation.
-func (m *LabelMutation) Name() (r string, exists bool) {
+func (m *LocationMutation) Name() (r string, exists bool) {
 	v := m.name
 	if v == nil {
 		return
@@ -7714,10 +13189,10 @@ func (m *LabelMutation) Name() (r string, exists bool) {
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Label entity.
-// If the Label object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the Location entity.
+// If the Location object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LabelMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *LocationMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
@@ -7732,17 +13207,17 @@ func (m *LabelMutation) OldName(ctx context.Context) (v string, err error) {
 }
 
 // ResetName resets all changes to the "name" field.
-func (m *LabelMutation) ResetName() {
+func (m *LocationMutation) ResetName() {
 	m.name = nil
 }
 
 // SetDescription sets the "description" field.
-func (m *LabelMutation) SetDescription(s string) {
+func (m *LocationMutation) SetDescription(s string) {
 	m.description = &s
 }
 
 // Description returns the value of the "description" field in the mutation.
-func (m *LabelMutation) Description() (r string, exists bool) {
+func (m *LocationMutation) Description() (r string, exists bool) {
 	v := m.description
 	if v == nil {
 		return
@@ -7750,10 +13225,10 @@ func (m *LabelMutation) Description() (r string, exists bool) {
 	return *v, true
 }
 
-// OldDescription returns the old "description" field's value of the Label entity.
-// If the Label object wasn't provided to the builder, the object is fetched from the database.
+// OldDescription returns the old "description" field's value of the Location entity.
+// If the Location object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LabelMutation) OldDescription(ctx context.Context) (v string, err error) {
+func (m *LocationMutation) OldDescription(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
 	}
@@ -7768,113 +13243,213 @@ func (m *LabelMutation) OldDescription(ctx context.Context) (v string, err error
 }
 
 // ClearDescription clears the value of the "description" field.
-func (m *LabelMutation) ClearDescription() {
+func (m *LocationMutation) ClearDescription() {
 	m.description = nil
-	m.clearedFields[label.FieldDescription] = struct{}{}
+	m.clearedFields[location.FieldDescription] = struct{}{}
 }
 
 // DescriptionCleared returns if the "description" field was cleared in this mutation.
-func (m *LabelMutation) DescriptionCleared() bool {
-	_, ok := m.clearedFields[label.FieldDescription]
+func (m *LocationMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[location.FieldDescription]
 	return ok
 }
 
 // ResetDescription resets all changes to the "description" field.
-func (m *LabelMutation) ResetDescription() {
+func (m *LocationMutation) ResetDescription() {
 	m.description = nil
-	delete(m.clearedFields, label.FieldDescription)
+	delete(m.clearedFields, location.FieldDescription)
 }
 
-// SetColor sets the "color" field.
-func (m *LabelMutation) SetColor(s string) {
-	m.color = &s
+// SetCapacity sets the "capacity" field.
+func (m *LocationMutation) SetCapacity(i int) {
+	m.capacity = &i
+	m.addcapacity = nil
 }
 
-// Color returns the value of the "color" field in the mutation.
-func (m *LabelMutation) Color() (r string, exists bool) {
-	v := m.color
+// Capacity returns the value of the "capacity" field in the mutation.
+func (m *LocationMutation) Capacity() (r int, exists bool) {
+	v := m.capacity
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldColor returns the old "color" field's value of the Label entity.
-// If the Label object wasn't provided to the builder, the object is fetched from the database.
+// OldCapacity returns the old "capacity" field's value of the Location entity.
+// If the Location object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LabelMutation) OldColor(ctx context.Context) (v string, err error) {
+func (m *LocationMutation) OldCapacity(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldColor is only allowed on UpdateOne operations")
+		return v, errors.New("OldCapacity is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldColor requires an ID field in the mutation")
+		return v, errors.New("OldCapacity requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldColor: %w", err)
+		return v, fmt.Errorf("querying old value for OldCapacity: %w", err)
+	}
+	return oldValue.Capacity, nil
+}
+
+// AddCapacity adds i to the "capacity" field.
+func (m *LocationMutation) AddCapacity(i int) {
+	if m.addcapacity != nil {
+		*m.addcapacity += i
+	} else {
+		m.addcapacity = &i
+	}
+}
+
+// AddedCapacity returns the value that was added to the "capacity" field in this mutation.
+func (m *LocationMutation) AddedCapacity() (r int, exists bool) {
+	v := m.addcapacity
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCapacity resets all changes to the "capacity" field.
+func (m *LocationMutation) ResetCapacity() {
+	m.capacity = nil
+	m.addcapacity = nil
+}
+
+// SetGroupID sets the "group" edge to the Group entity by id.
+func (m *LocationMutation) SetGroupID(id uuid.UUID) {
+	m.group = &id
+}
+
+// ClearGroup clears the "group" edge to the Group entity.
+func (m *LocationMutation) ClearGroup() {
+	m.clearedgroup = true
+}
+
+// GroupCleared reports if the "group" edge to the Group entity was cleared.
+func (m *LocationMutation) GroupCleared() bool {
+	return m.clearedgroup
+}
+
+// GroupID returns the "group" edge ID in the mutation.
+func (m *LocationMutation) GroupID() (id uuid.UUID, exists bool) {
+	if m.group != nil {
+		return *m.group, true
+	}
+	return
+}
+
+// GroupIDs returns the "group" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// GroupID instead. It exists only for internal usage by the builders.
+func (m *LocationMutation) GroupIDs() (ids []uuid.UUID) {
+	if id := m.group; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetGroup resets all changes to the "group" edge.
+func (m *LocationMutation) ResetGroup() {
+	m.group = nil
+	m.clearedgroup = false
+}
+
+// SetParentID sets the "parent" edge to the Location entity by id.
+func (m *LocationMutation) SetParentID(id uuid.UUID) {
+	m.parent = &id
+}
+
+// ClearParent clears the "parent" edge to the Location entity.
+func (m *LocationMutation) ClearParent() {
+	m.clearedparent = true
+}
+
+// ParentCleared reports if the "parent" edge to the Location entity was cleared.
+func (m *LocationMutation) ParentCleared() bool {
+	return m.clearedparent
+}
+
+// ParentID returns the "parent" edge ID in the mutation.
+func (m *LocationMutation) ParentID() (id uuid.UUID, exists bool) {
+	if m.parent != nil {
+		return *m.parent, true
 	}
-	return oldValue.Color, nil
+	return
 }
 
-// ClearColor clears the value of the "color" field.
-func (m *LabelMutation) ClearColor() {
-	m.color = nil
-	m.clearedFields[label.FieldColor] = struct{}{}
+// ParentIDs returns the "parent" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ParentID instead. It exists only for internal usage by the builders.
+func (m *LocationMutation) ParentIDs() (ids []uuid.UUID) {
+	if id := m.parent; id != nil {
+		ids = append(ids, *id)
+	}
+	return
 }
 
-// ColorCleared returns if the "color" field was cleared in this mutation.
-func (m *LabelMutation) ColorCleared() bool {
-	_, ok := m.clearedFields[label.FieldColor]
-	return ok
+// ResetParent resets all changes to the "parent" edge.
+func (m *LocationMutation) ResetParent() {
+	m.parent = nil
+	m.clearedparent = false
 }
 
-// ResetColor resets all changes to the "color" field.
-func (m *LabelMutation) ResetColor() {
-	m.color = nil
-	delete(m.clearedFields, label.FieldColor)
+// AddChildIDs adds the "children" edge to the Location entity by ids.
+func (m *LocationMutation) AddChildIDs(ids ...uuid.UUID) {
+	if m.children == nil {
+		m.children = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.children[ids[i]] = struct{}{}
+	}
 }
 
-// SetGroupID sets the "group" edge to the Group entity by id.
-func (m *LabelMutation) SetGroupID(id uuid.UUID) {
-	m.group = &id
+// ClearChildren clears the "children" edge to the Location entity.
+func (m *LocationMutation) ClearChildren() {
+	m.clearedchildren = true
 }
 
-// ClearGroup clears the "group" edge to the Group entity.
-func (m *LabelMutation) ClearGroup() {
-	m.clearedgroup = true
+// ChildrenCleared reports if the "children" edge to the Location entity was cleared.
+func (m *LocationMutation) ChildrenCleared() bool {
+	return m.clearedchildren
 }
 
-// GroupCleared reports if the "group" edge to the Group entity was cleared.
-func (m *LabelMutation) GroupCleared() bool {
-	return m.clearedgroup
+// RemoveChildIDs removes the "children" edge to the Location entity by IDs.
+func (m *LocationMutation) RemoveChildIDs(ids ...uuid.UUID) {
+	if m.removedchildren == nil {
+		m.removedchildren = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.children, ids[i])
+		m.removedchildren[ids[i]] = struct{}{}
+	}
 }
 
-// GroupID returns the "group" edge ID in the mutation.
-func (m *LabelMutation) GroupID() (id uuid.UUID, exists bool) {
-	if m.group != nil {
-		return *m.group, true
+// RemovedChildren returns the removed IDs of the "children" edge to the Location entity.
+func (m *LocationMutation) RemovedChildrenIDs() (ids []uuid.UUID) {
+	for id := range m.removedchildren {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// GroupIDs returns the "group" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// GroupID instead. It exists only for internal usage by the builders.
-func (m *LabelMutation) GroupIDs() (ids []uuid.UUID) {
-	if id := m.group; id != nil {
-		ids = append(ids, *id)
+// ChildrenIDs returns the "children" edge IDs in the mutation.
+func (m *LocationMutation) ChildrenIDs() (ids []uuid.UUID) {
+	for id := range m.children {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetGroup resets all changes to the "group" edge.
-func (m *LabelMutation) ResetGroup() {
-	m.group = nil
-	m.clearedgroup = false
+// ResetChildren resets all changes to the "children" edge.
+func (m *LocationMutation) ResetChildren() {
+	m.children = nil
+	m.clearedchildren = false
+	m.removedchildren = nil
 }
 
 // AddItemIDs adds the "items" edge to the Item entity by ids.
-func (m *LabelMutation) AddItemIDs(ids ...uuid.UUID) {
+func (m *LocationMutation) AddItemIDs(ids ...uuid.UUID) {
 	if m.items == nil {
 		m.items = make(map[uuid.UUID]struct{})
 	}
@@ -7884,17 +13459,17 @@ func (m *LabelMutation) AddItemIDs(ids ...uuid.UUID) {
 }
 
 // ClearItems clears the "items" edge to the Item entity.
-func (m *LabelMutation) ClearItems() {
+func (m *LocationMutation) ClearItems() {
 	m.cleareditems = true
 }
 
 // ItemsCleared reports if the "items" edge to the Item entity was cleared.
-func (m *LabelMutation) ItemsCleared() bool {
+func (m *LocationMutation) ItemsCleared() bool {
 	return m.cleareditems
 }
 
 // RemoveItemIDs removes the "items" edge to the Item entity by IDs.
-func (m *LabelMutation) RemoveItemIDs(ids ...uuid.UUID) {
+func (m *LocationMutation) RemoveItemIDs(ids ...uuid.UUID) {
 	if m.removeditems == nil {
 		m.removeditems = make(map[uuid.UUID]struct{})
 	}
@@ -7905,7 +13480,7 @@ func (m *LabelMutation) RemoveItemIDs(ids ...uuid.UUID) {
 }
 
 // RemovedItems returns the removed IDs of the "items" edge to the Item entity.
-func (m *LabelMutation) RemovedItemsIDs() (ids []uuid.UUID) {
+func (m *LocationMutation) RemovedItemsIDs() (ids []uuid.UUID) {
 	for id := range m.removeditems {
 		ids = append(ids, id)
 	}
@@ -7913,7 +13488,7 @@ func (m *LabelMutation) RemovedItemsIDs() (ids []uuid.UUID) {
 }
 
 // ItemsIDs returns the "items" edge IDs in the mutation.
-func (m *LabelMutation) ItemsIDs() (ids []uuid.UUID) {
+func (m *LocationMutation) ItemsIDs() (ids []uuid.UUID) {
 	for id := range m.items {
 		ids = append(ids, id)
 	}
@@ -7921,21 +13496,21 @@ func (m *LabelMutation) ItemsIDs() (ids []uuid.UUID) {
 }
 
 // ResetItems resets all changes to the "items" edge.
-func (m *LabelMutation) ResetItems() {
+func (m *LocationMutation) ResetItems() {
 	m.items = nil
 	m.cleareditems = false
 	m.removeditems = nil
 }
 
-// Where appends a list predicates to the LabelMutation builder.
-func (m *LabelMutation) Where(ps ...predicate.Label) {
+// Where appends a list predicates to the LocationMutation builder.
+func (m *LocationMutation) Where(ps ...predicate.Location) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the LabelMutation builder. Using this method,
+// WhereP appends storage-level predicates to the LocationMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *LabelMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Label, len(ps))
+func (m *LocationMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Location, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -7943,39 +13518,39 @@ func (m *LabelMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *LabelMutation) Op() Op {
+func (m *LocationMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *LabelMutation) SetOp(op Op) {
+func (m *LocationMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Label).
-func (m *LabelMutation) Type() string {
+// Type returns the node type of this mutation (Location).
+func (m *LocationMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *LabelMutation) Fields() []string {
+func (m *LocationMutation) Fields() []string {
 	fields := make([]string, 0, 5)
 	if m.created_at != nil {
-		fields = append(fields, label.FieldCreatedAt)
+		fields = append(fields, location.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, label.FieldUpdatedAt)
+		fields = append(fields, location.FieldUpdatedAt)
 	}
 	if m.name != nil {
-		fields = append(fields, label.FieldName)
+		fields = append(fields, location.FieldName)
 	}
 	if m.description != nil {
-		fields = append(fields, label.FieldDescription)
+		fields = append(fields, location.FieldDescription)
 	}
-	if m.color != nil {
-		fields = append(fields, label.FieldColor)
+	if m.capacity != nil {
+		fields = append(fields, location.FieldCapacity)
 	}
 	return fields
 }
@@ -7983,18 +13558,18 @@ func (m *LabelMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *LabelMutation) Field(name string) (ent.Value, bool) {
+func (m *LocationMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case label.FieldCreatedAt:
+	case location.FieldCreatedAt:
 		return m.CreatedAt()
-	case label.FieldUpdatedAt:
+	case location.FieldUpdatedAt:
 		return m.UpdatedAt()
-	case label.FieldName:
+	case location.FieldName:
 		return m.Name()
-	case label.FieldDescription:
+	case location.FieldDescription:
 		return m.Description()
-	case label.FieldColor:
-		return m.Color()
+	case location.FieldCapacity:
+		return m.Capacity()
 	}
 	return nil, false
 }
@@ -8002,166 +13577,191 @@ func (m *LabelMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *LabelMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *LocationMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case label.FieldCreatedAt:
+	case location.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case label.FieldUpdatedAt:
+	case location.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
-	case label.FieldName:
+	case location.FieldName:
 		return m.OldName(ctx)
-	case label.FieldDescription:
+	case location.FieldDescription:
 		return m.OldDescription(ctx)
-	case label.FieldColor:
-		return m.OldColor(ctx)
+	case location.FieldCapacity:
+		return m.OldCapacity(ctx)
 	}
-	return nil, fmt.Errorf("unknown Label field %s", name)
+	return nil, fmt.Errorf("unknown Location field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *LabelMutation) SetField(name string, value ent.Value) error {
+func (m *LocationMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case label.FieldCreatedAt:
+	case location.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case label.FieldUpdatedAt:
+	case location.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdatedAt(v)
 		return nil
-	case label.FieldName:
+	case location.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetName(v)
 		return nil
-	case label.FieldDescription:
+	case location.FieldDescription:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetDescription(v)
 		return nil
-	case label.FieldColor:
-		v, ok := value.(string)
+	case location.FieldCapacity:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetColor(v)
+		m.SetCapacity(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Label field %s", name)
+	return fmt.Errorf("unknown Location field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *LabelMutation) AddedFields() []string {
-	return nil
+func (m *LocationMutation) AddedFields() []string {
+	var fields []string
+	if m.addcapacity != nil {
+		fields = append(fields, location.FieldCapacity)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *LabelMutation) AddedField(name string) (ent.Value, bool) {
+func (m *LocationMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case location.FieldCapacity:
+		return m.AddedCapacity()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *LabelMutation) AddField(name string, value ent.Value) error {
+func (m *LocationMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case location.FieldCapacity:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCapacity(v)
+		return nil
 	}
-	return fmt.Errorf("unknown Label numeric field %s", name)
+	return fmt.Errorf("unknown Location numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *LabelMutation) ClearedFields() []string {
+func (m *LocationMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(label.FieldDescription) {
-		fields = append(fields, label.FieldDescription)
-	}
-	if m.FieldCleared(label.FieldColor) {
-		fields = append(fields, label.FieldColor)
+	if m.FieldCleared(location.FieldDescription) {
+		fields = append(fields, location.FieldDescription)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *LabelMutation) FieldCleared(name string) bool {
+func (m *LocationMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *LabelMutation) ClearField(name string) error {
+func (m *LocationMutation) ClearField(name string) error {
 	switch name {
-	case label.FieldDescription:
+	case location.FieldDescription:
 		m.ClearDescription()
 		return nil
-	case label.FieldColor:
-		m.ClearColor()
-		return nil
 	}
-	return fmt.Errorf("unknown Label nullable field %s", name)
+	return fmt.Errorf("unknown Location nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *LabelMutation) ResetField(name string) error {
+func (m *LocationMutation) ResetField(name string) error {
 	switch name {
-	case label.FieldCreatedAt:
+	case location.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case label.FieldUpdatedAt:
+	case location.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
-	case label.FieldName:
+	case location.FieldName:
 		m.ResetName()
 		return nil
-	case label.FieldDescription:
+	case location.FieldDescription:
 		m.ResetDescription()
 		return nil
-	case label.FieldColor:
-		m.ResetColor()
+	case location.FieldCapacity:
+		m.ResetCapacity()
 		return nil
 	}
-	return fmt.Errorf("unknown Label field %s", name)
+	return fmt.Errorf("unknown Location field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *LabelMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
+func (m *LocationMutation) AddedEdges() []string {
+	edges := make([]string, 0, 4)
 	if m.group != nil {
-		edges = append(edges, label.EdgeGroup)
+		edges = append(edges, location.EdgeGroup)
+	}
+	if m.parent != nil {
+		edges = append(edges, location.EdgeParent)
+	}
+	if m.children != nil {
+		edges = append(edges, location.EdgeChildren)
 	}
 	if m.items != nil {
-		edges = append(edges, label.EdgeItems)
+		edges = append(edges, location.EdgeItems)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *LabelMutation) AddedIDs(name string) []ent.Value {
+func (m *LocationMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case label.EdgeGroup:
+	case location.EdgeGroup:
 		if id := m.group; id != nil {
 			return []ent.Value{*id}
 		}
-	case label.EdgeItems:
+	case location.EdgeParent:
+		if id := m.parent; id != nil {
+			return []ent.Value{*id}
+		}
+	case location.EdgeChildren:
+		ids := make([]ent.Value, 0, len(m.children))
+		for id := range m.children {
+			ids = append(ids, id)
+		}
+		return ids
+	case location.EdgeItems:
 		ids := make([]ent.Value, 0, len(m.items))
 		for id := range m.items {
 			ids = append(ids, id)
@@ -8172,19 +13772,28 @@ func (m *LabelMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *LabelMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
+func (m *LocationMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.removedchildren != nil {
+		edges = append(edges, location.EdgeChildren)
+	}
 	if m.removeditems != nil {
-		edges = append(edges, label.EdgeItems)
+		edges = append(edges, location.EdgeItems)
 	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *LabelMutation) RemovedIDs(name string) []ent.Value {
+func (m *LocationMutation) RemovedIDs(name string) []ent.Value {
 	switch name {
-	case label.EdgeItems:
+	case location.EdgeChildren:
+		ids := make([]ent.Value, 0, len(m.removedchildren))
+		for id := range m.removedchildren {
+			ids = append(ids, id)
+		}
+		return ids
+	case location.EdgeItems:
 		ids := make([]ent.Value, 0, len(m.removeditems))
 		for id := range m.removeditems {
 			ids = append(ids, id)
@@ -8195,24 +13804,34 @@ func (m *LabelMutation) RemovedIDs(name string) []ent.Value {
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *LabelMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
+func (m *LocationMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 4)
 	if m.clearedgroup {
-		edges = append(edges, label.EdgeGroup)
+		edges = append(edges, location.EdgeGroup)
+	}
+	if m.clearedparent {
+		edges = append(edges, location.EdgeParent)
+	}
+	if m.clearedchildren {
+		edges = append(edges, location.EdgeChildren)
 	}
 	if m.cleareditems {
-		edges = append(edges, label.EdgeItems)
+		edges = append(edges, location.EdgeItems)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *LabelMutation) EdgeCleared(name string) bool {
+func (m *LocationMutation) EdgeCleared(name string) bool {
 	switch name {
-	case label.EdgeGroup:
+	case location.EdgeGroup:
 		return m.clearedgroup
-	case label.EdgeItems:
+	case location.EdgeParent:
+		return m.clearedparent
+	case location.EdgeChildren:
+		return m.clearedchildren
+	case location.EdgeItems:
 		return m.cleareditems
 	}
 	return false
@@ -8220,66 +13839,71 @@ func (m *LabelMutation) EdgeCleared(name string) bool {
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *LabelMutation) ClearEdge(name string) error {
+func (m *LocationMutation) ClearEdge(name string) error {
 	switch name {
-	case label.EdgeGroup:
+	case location.EdgeGroup:
 		m.ClearGroup()
 		return nil
+	case location.EdgeParent:
+		m.ClearParent()
+		return nil
 	}
-	return fmt.Errorf("unknown Label unique edge %s", name)
+	return fmt.Errorf("unknown Location unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *LabelMutation) ResetEdge(name string) error {
+func (m *LocationMutation) ResetEdge(name string) error {
 	switch name {
-	case label.EdgeGroup:
+	case location.EdgeGroup:
 		m.ResetGroup()
 		return nil
-	case label.EdgeItems:
+	case location.EdgeParent:
+		m.ResetParent()
+		return nil
+	case location.EdgeChildren:
+		m.ResetChildren()
+		return nil
+	case location.EdgeItems:
 		m.ResetItems()
 		return nil
 	}
-	return fmt.Errorf("unknown Label edge %s", name)
+	return fmt.Errorf("unknown Location edge %s", name)
 }
 
-// LocationMutation represents an operation that mutates the Location nodes in the graph.
-type LocationMutation struct {
+// MaintenanceEntryMutation represents an operation that mutates the MaintenanceEntry nodes in the graph.
+type MaintenanceEntryMutation struct {
 	config
-	op              Op
-	typ             string
-	id              *uuid.UUID
-	created_at      *time.Time
-	updated_at      *time.Time
-	name            *string
-	description     *string
-	clearedFields   map[string]struct{}
-	group           *uuid.UUID
-	clearedgroup    bool
-	parent          *uuid.UUID
-	clearedparent   bool
-	children        map[uuid.UUID]struct{}
-	removedchildren map[uuid.UUID]struct{}
-	clearedchildren bool
-	items           map[uuid.UUID]struct{}
-	removeditems    map[uuid.UUID]struct{}
-	cleareditems    bool
-	done            bool
-	oldValue        func(context.Context) (*Location, error)
-	predicates      []predicate.Location
+	op             Op
+	typ            string
+	id             *uuid.UUID
+	created_at     *time.Time
+	updated_at     *time.Time
+	date           *time.Time
+	scheduled_date *time.Time
+	name           *string
+	description    *string
+	cost           *float64
+	addcost        *float64
+	clearedFields  map[string]struct{}
+	item           *uuid.UUID
+	cleareditem    bool
+	done           bool
+	oldValue       func(context.Context) (*MaintenanceEntry, error)
+	predicates     []predicate.MaintenanceEntry
 }
 
-var _ ent.Mutation = (*LocationMutation)(nil)
+var _ ent.Mutation = (*MaintenanceEntryMutation)(nil)
 
-// locationOption allows management of the mutation configuration using functional options.
-type locationOption func(*LocationMutation)
+// maintenanceentryOption allows management of the mutation configuration using functional options.
+type maintenanceentryOption func(*MaintenanceEntryMutation)
 
-// newLocationMutation creates new mutation for the Location entity.
-func newLocationMutation(c config, op Op, opts ...locationOption) *LocationMutation {
-	m := &LocationMutation{
+// newMaintenanceEntryMutation creates new mutation for the MaintenanceEntry entity.
+func newMaintenanceEntryMutation(c config, op Op, opts ...maintenanceentryOption) *MaintenanceEntryMutation {
+	m := &MaintenanceEntryMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeLocation,
+		typ:           TypeMaintenanceEntry,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -8288,20 +13912,20 @@ func newLocationMutation(c config, op Op, opts ...locationOption) *LocationMutat
 	return m
 }
 
-// withLocationID sets the ID field of the mutation.
-func withLocationID(id uuid.UUID) locationOption {
-	return func(m *LocationMutation) {
+// withMaintenanceEntryID sets the ID field of the mutation.
+func withMaintenanceEntryID(id uuid.UUID) maintenanceentryOption {
+	return func(m *MaintenanceEntryMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Location
+			value *MaintenanceEntry
 		)
-		m.oldValue = func(ctx context.Context) (*Location, error) {
+		m.oldValue = func(ctx context.Context) (*MaintenanceEntry, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Location.Get(ctx, id)
+					value, err = m.Client().MaintenanceEntry.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -8310,10 +13934,10 @@ func withLocationID(id uuid.UUID) locationOption {
 	}
 }
 
-// withLocation sets the old Location of the mutation.
-func withLocation(node *Location) locationOption {
-	return func(m *LocationMutation) {
-		m.oldValue = func(context.Context) (*Location, error) {
+// withMaintenanceEntry sets the old MaintenanceEntry of the mutation.
+func withMaintenanceEntry(node *MaintenanceEntry) maintenanceentryOption {
+	return func(m *MaintenanceEntryMutation) {
+		m.oldValue = func(context.Context) (*MaintenanceEntry, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -8322,7 +13946,7 @@ func withLocation(node *Location) locationOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m LocationMutation) Client() *Client {
+func (m MaintenanceEntryMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -8330,7 +13954,7 @@ func (m LocationMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m LocationMutation) Tx() (*Tx, error) {
+func (m MaintenanceEntryMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -8340,14 +13964,14 @@ func (m LocationMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Location entities.
-func (m *LocationMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of MaintenanceEntry entities.
+func (m *MaintenanceEntryMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *LocationMutation) ID() (id uuid.UUID, exists bool) {
+func (m *MaintenanceEntryMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -8358,7 +13982,7 @@ func (m *LocationMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *LocationMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *MaintenanceEntryMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -8367,91 +13991,225 @@ func (m *LocationMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Location.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().MaintenanceEntry.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *LocationMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetCreatedAt sets the "created_at" field.
+func (m *MaintenanceEntryMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *MaintenanceEntryMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the MaintenanceEntry entity.
+// If the MaintenanceEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MaintenanceEntryMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *MaintenanceEntryMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *MaintenanceEntryMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *MaintenanceEntryMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the MaintenanceEntry entity.
+// If the MaintenanceEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MaintenanceEntryMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *MaintenanceEntryMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetItemID sets the "item_id" field.
+func (m *MaintenanceEntryMutation) SetItemID(u uuid.UUID) {
+	m.item = &u
+}
+
+// ItemID returns the value of the "item_id" field in the mutation.
+func (m *MaintenanceEntryMutation) ItemID() (r uuid.UUID, exists bool) {
+	v := m.item
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldItemID returns the old "item_id" field's value of the MaintenanceEntry entity.
+// If the MaintenanceEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MaintenanceEntryMutation) OldItemID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldItemID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldItemID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldItemID: %w", err)
+	}
+	return oldValue.ItemID, nil
+}
+
+// ResetItemID resets all changes to the "item_id" field.
+func (m *MaintenanceEntryMutation) ResetItemID() {
+	m.item = nil
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *LocationMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// SetDate sets the "date" field.
+func (m *MaintenanceEntryMutation) SetDate(t time.Time) {
+	m.date = &t
+}
+
+// Date returns the value of the "date" field in the mutation.
+func (m *MaintenanceEntryMutation) Date() (r time.Time, exists bool) {
+	v := m.date
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Location entity.
-// If the Location object wasn't provided to the builder, the object is fetched from the database.
+// OldDate returns the old "date" field's value of the MaintenanceEntry entity.
+// If the MaintenanceEntry object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LocationMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *MaintenanceEntryMutation) OldDate(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldDate is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldDate requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldDate: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.Date, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *LocationMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ClearDate clears the value of the "date" field.
+func (m *MaintenanceEntryMutation) ClearDate() {
+	m.date = nil
+	m.clearedFields[maintenanceentry.FieldDate] = struct{}{}
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *LocationMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// DateCleared returns if the "date" field was cleared in this mutation.
+func (m *MaintenanceEntryMutation) DateCleared() bool {
+	_, ok := m.clearedFields[maintenanceentry.FieldDate]
+	return ok
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *LocationMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// ResetDate resets all changes to the "date" field.
+func (m *MaintenanceEntryMutation) ResetDate() {
+	m.date = nil
+	delete(m.clearedFields, maintenanceentry.FieldDate)
+}
+
+// SetScheduledDate sets the "scheduled_date" field.
+func (m *MaintenanceEntryMutation) SetScheduledDate(t time.Time) {
+	m.scheduled_date = &t
+}
+
+// ScheduledDate returns the value of the "scheduled_date" field in the mutation.
+func (m *MaintenanceEntryMutation) ScheduledDate() (r time.Time, exists bool) {
+	v := m.scheduled_date
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Location entity.
-// If the Location object wasn't provided to the builder, the object is fetched from the database.
+// OldScheduledDate returns the old "scheduled_date" field's value of the MaintenanceEntry entity.
+// If the MaintenanceEntry object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LocationMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *MaintenanceEntryMutation) OldScheduledDate(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldScheduledDate is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldScheduledDate requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldScheduledDate: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
+	return oldValue.ScheduledDate, nil
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *LocationMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// ClearScheduledDate clears the value of the "scheduled_date" field.
+func (m *MaintenanceEntryMutation) ClearScheduledDate() {
+	m.scheduled_date = nil
+	m.clearedFields[maintenanceentry.FieldScheduledDate] = struct{}{}
+}
+
+// ScheduledDateCleared returns if the "scheduled_date" field was cleared in this mutation.
+func (m *MaintenanceEntryMutation) ScheduledDateCleared() bool {
+	_, ok := m.clearedFields[maintenanceentry.FieldScheduledDate]
+	return ok
+}
+
+// ResetScheduledDate resets all changes to the "scheduled_date" field.
+func (m *MaintenanceEntryMutation) ResetScheduledDate() {
+	m.scheduled_date = nil
+	delete(m.clearedFields, maintenanceentry.FieldScheduledDate)
 }
 
 // SetName sets the "name" field.
-func (m *LocationMutation) SetName(s string) {
+func (m *MaintenanceEntryMutation) SetName(s string) {
 	m.name = &s
 }
 
 // Name returns the value of the "name" field in the mutation.
-func (m *LocationMutation) Name() (r string, exists bool) {
+func (m *MaintenanceEntryMutation) Name() (r string, exists bool) {
 	v := m.name
 	if v == nil {
 		return
@@ -8459,10 +14217,10 @@ func (m *LocationMutation) Name() (r string, exists bool) {
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Location entity.
-// If the Location object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the MaintenanceEntry entity.
+// If the MaintenanceEntry object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LocationMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *MaintenanceEntryMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
@@ -8477,17 +14235,17 @@ func (m *LocationMutation) OldName(ctx context.Context) (v string, err error) {
 }
 
 // ResetName resets all changes to the "name" field.
-func (m *LocationMutation) ResetName() {
+func (m *MaintenanceEntryMutation) ResetName() {
 	m.name = nil
 }
 
 // SetDescription sets the "description" field.
-func (m *LocationMutation) SetDescription(s string) {
+func (m *MaintenanceEntryMutation) SetDescription(s string) {
 	m.description = &s
 }
 
 // Description returns the value of the "description" field in the mutation.
-func (m *LocationMutation) Description() (r string, exists bool) {
+func (m *MaintenanceEntryMutation) Description() (r string, exists bool) {
 	v := m.description
 	if v == nil {
 		return
@@ -8495,10 +14253,10 @@ func (m *LocationMutation) Description() (r string, exists bool) {
 	return *v, true
 }
 
-// OldDescription returns the old "description" field's value of the Location entity.
-// If the Location object wasn't provided to the builder, the object is fetched from the database.
+// OldDescription returns the old "description" field's value of the MaintenanceEntry entity.
+// If the MaintenanceEntry object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LocationMutation) OldDescription(ctx context.Context) (v string, err error) {
+func (m *MaintenanceEntryMutation) OldDescription(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
 	}
@@ -8513,218 +14271,115 @@ func (m *LocationMutation) OldDescription(ctx context.Context) (v string, err er
 }
 
 // ClearDescription clears the value of the "description" field.
-func (m *LocationMutation) ClearDescription() {
+func (m *MaintenanceEntryMutation) ClearDescription() {
 	m.description = nil
-	m.clearedFields[location.FieldDescription] = struct{}{}
+	m.clearedFields[maintenanceentry.FieldDescription] = struct{}{}
 }
 
 // DescriptionCleared returns if the "description" field was cleared in this mutation.
-func (m *LocationMutation) DescriptionCleared() bool {
-	_, ok := m.clearedFields[location.FieldDescription]
+func (m *MaintenanceEntryMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[maintenanceentry.FieldDescription]
 	return ok
 }
 
 // ResetDescription resets all changes to the "description" field.
-func (m *LocationMutation) ResetDescription() {
+func (m *MaintenanceEntryMutation) ResetDescription() {
 	m.description = nil
-	delete(m.clearedFields, location.FieldDescription)
-}
-
-// SetGroupID sets the "group" edge to the Group entity by id.
-func (m *LocationMutation) SetGroupID(id uuid.UUID) {
-	m.group = &id
-}
-
-// ClearGroup clears the "group" edge to the Group entity.
-func (m *LocationMutation) ClearGroup() {
-	m.clearedgroup = true
-}
-
-// GroupCleared reports if the "group" edge to the Group entity was cleared.
-func (m *LocationMutation) GroupCleared() bool {
-	return m.clearedgroup
-}
-
-// GroupID returns the "group" edge ID in the mutation.
-func (m *LocationMutation) GroupID() (id uuid.UUID, exists bool) {
-	if m.group != nil {
-		return *m.group, true
-	}
-	return
-}
-
-// GroupIDs returns the "group" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// GroupID instead. It exists only for internal usage by the builders.
-func (m *LocationMutation) GroupIDs() (ids []uuid.UUID) {
-	if id := m.group; id != nil {
-		ids = append(ids, *id)
-	}
-	return
-}
-
-// ResetGroup resets all changes to the "group" edge.
-func (m *LocationMutation) ResetGroup() {
-	m.group = nil
-	m.clearedgroup = false
-}
-
-// SetParentID sets the "parent" edge to the Location entity by id.
-func (m *LocationMutation) SetParentID(id uuid.UUID) {
-	m.parent = &id
-}
-
-// ClearParent clears the "parent" edge to the Location entity.
-func (m *LocationMutation) ClearParent() {
-	m.clearedparent = true
-}
-
-// ParentCleared reports if the "parent" edge to the Location entity was cleared.
-func (m *LocationMutation) ParentCleared() bool {
-	return m.clearedparent
-}
-
-// ParentID returns the "parent" edge ID in the mutation.
-func (m *LocationMutation) ParentID() (id uuid.UUID, exists bool) {
-	if m.parent != nil {
-		return *m.parent, true
-	}
-	return
-}
-
-// ParentIDs returns the "parent" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// ParentID instead. It exists only for internal usage by the builders.
-func (m *LocationMutation) ParentIDs() (ids []uuid.UUID) {
-	if id := m.parent; id != nil {
-		ids = append(ids, *id)
-	}
-	return
-}
-
-// ResetParent resets all changes to the "parent" edge.
-func (m *LocationMutation) ResetParent() {
-	m.parent = nil
-	m.clearedparent = false
-}
-
-// AddChildIDs adds the "children" edge to the Location entity by ids.
-func (m *LocationMutation) AddChildIDs(ids ...uuid.UUID) {
-	if m.children == nil {
-		m.children = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.children[ids[i]] = struct{}{}
-	}
-}
-
-// ClearChildren clears the "children" edge to the Location entity.
-func (m *LocationMutation) ClearChildren() {
-	m.clearedchildren = true
-}
-
-// ChildrenCleared reports if the "children" edge to the Location entity was cleared.
-func (m *LocationMutation) ChildrenCleared() bool {
-	return m.clearedchildren
+	delete(m.clearedFields, maintenanceentry.FieldDescription)
 }
 
-// RemoveChildIDs removes the "children" edge to the Location entity by IDs.
-func (m *LocationMutation) RemoveChildIDs(ids ...uuid.UUID) {
-	if m.removedchildren == nil {
-		m.removedchildren = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.children, ids[i])
-		m.removedchildren[ids[i]] = struct{}{}
-	}
+// SetCost sets the "cost" field.
+func (m *MaintenanceEntryMutation) SetCost(f float64) {
+	m.cost = &f
+	m.addcost = nil
 }
 
-// RemovedChildren returns the removed IDs of the "children" edge to the Location entity.
-func (m *LocationMutation) RemovedChildrenIDs() (ids []uuid.UUID) {
-	for id := range m.removedchildren {
-		ids = append(ids, id)
+// Cost returns the value of the "cost" field in the mutation.
+func (m *MaintenanceEntryMutation) Cost() (r float64, exists bool) {
+	v := m.cost
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// ChildrenIDs returns the "children" edge IDs in the mutation.
-func (m *LocationMutation) ChildrenIDs() (ids []uuid.UUID) {
-	for id := range m.children {
-		ids = append(ids, id)
+// OldCost returns the old "cost" field's value of the MaintenanceEntry entity.
+// If the MaintenanceEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MaintenanceEntryMutation) OldCost(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCost is only allowed on UpdateOne operations")
 	}
-	return
-}
-
-// ResetChildren resets all changes to the "children" edge.
-func (m *LocationMutation) ResetChildren() {
-	m.children = nil
-	m.clearedchildren = false
-	m.removedchildren = nil
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCost requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCost: %w", err)
+	}
+	return oldValue.Cost, nil
 }
 
-// AddItemIDs adds the "items" edge to the Item entity by ids.
-func (m *LocationMutation) AddItemIDs(ids ...uuid.UUID) {
-	if m.items == nil {
-		m.items = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.items[ids[i]] = struct{}{}
+// AddCost adds f to the "cost" field.
+func (m *MaintenanceEntryMutation) AddCost(f float64) {
+	if m.addcost != nil {
+		*m.addcost += f
+	} else {
+		m.addcost = &f
 	}
 }
 
-// ClearItems clears the "items" edge to the Item entity.
-func (m *LocationMutation) ClearItems() {
-	m.cleareditems = true
+// AddedCost returns the value that was added to the "cost" field in this mutation.
+func (m *MaintenanceEntryMutation) AddedCost() (r float64, exists bool) {
+	v := m.addcost
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ItemsCleared reports if the "items" edge to the Item entity was cleared.
-func (m *LocationMutation) ItemsCleared() bool {
-	return m.cleareditems
+// ResetCost resets all changes to the "cost" field.
+func (m *MaintenanceEntryMutation) ResetCost() {
+	m.cost = nil
+	m.addcost = nil
 }
 
-// RemoveItemIDs removes the "items" edge to the Item entity by IDs.
-func (m *LocationMutation) RemoveItemIDs(ids ...uuid.UUID) {
-	if m.removeditems == nil {
-		m.removeditems = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.items, ids[i])
-		m.removeditems[ids[i]] = struct{}{}
-	}
+// ClearItem clears the "item" edge to the Item entity.
+func (m *MaintenanceEntryMutation) ClearItem() {
+	m.cleareditem = true
+	m.clearedFields[maintenanceentry.FieldItemID] = struct{}{}
 }
 
-// RemovedItems returns the removed IDs of the "items" edge to the Item entity.
-func (m *LocationMutation) RemovedItemsIDs() (ids []uuid.UUID) {
-	for id := range m.removeditems {
-		ids = append(ids, id)
-	}
-	return
+// ItemCleared reports if the "item" edge to the Item entity was cleared.
+func (m *MaintenanceEntryMutation) ItemCleared() bool {
+	return m.cleareditem
 }
 
-// ItemsIDs returns the "items" edge IDs in the mutation.
-func (m *LocationMutation) ItemsIDs() (ids []uuid.UUID) {
-	for id := range m.items {
-		ids = append(ids, id)
+// ItemIDs returns the "item" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ItemID instead. It exists only for internal usage by the builders.
+func (m *MaintenanceEntryMutation) ItemIDs() (ids []uuid.UUID) {
+	if id := m.item; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetItems resets all changes to the "items" edge.
-func (m *LocationMutation) ResetItems() {
-	m.items = nil
-	m.cleareditems = false
-	m.removeditems = nil
+// ResetItem resets all changes to the "item" edge.
+func (m *MaintenanceEntryMutation) ResetItem() {
+	m.item = nil
+	m.cleareditem = false
 }
 
-// Where appends a list predicates to the LocationMutation builder.
-func (m *LocationMutation) Where(ps ...predicate.Location) {
+// Where appends a list predicates to the MaintenanceEntryMutation builder.
+func (m *MaintenanceEntryMutation) Where(ps ...predicate.MaintenanceEntry) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the LocationMutation builder. Using this method,
+// WhereP appends storage-level predicates to the MaintenanceEntryMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *LocationMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Location, len(ps))
+func (m *MaintenanceEntryMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.MaintenanceEntry, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -8732,36 +14387,48 @@ func (m *LocationMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *LocationMutation) Op() Op {
+func (m *MaintenanceEntryMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *LocationMutation) SetOp(op Op) {
+func (m *MaintenanceEntryMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Location).
-func (m *LocationMutation) Type() string {
+// Type returns the node type of this mutation (MaintenanceEntry).
+func (m *MaintenanceEntryMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *LocationMutation) Fields() []string {
-	fields := make([]string, 0, 4)
+func (m *MaintenanceEntryMutation) Fields() []string {
+	fields := make([]string, 0, 8)
 	if m.created_at != nil {
-		fields = append(fields, location.FieldCreatedAt)
+		fields = append(fields, maintenanceentry.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, location.FieldUpdatedAt)
+		fields = append(fields, maintenanceentry.FieldUpdatedAt)
+	}
+	if m.item != nil {
+		fields = append(fields, maintenanceentry.FieldItemID)
+	}
+	if m.date != nil {
+		fields = append(fields, maintenanceentry.FieldDate)
+	}
+	if m.scheduled_date != nil {
+		fields = append(fields, maintenanceentry.FieldScheduledDate)
 	}
 	if m.name != nil {
-		fields = append(fields, location.FieldName)
+		fields = append(fields, maintenanceentry.FieldName)
 	}
 	if m.description != nil {
-		fields = append(fields, location.FieldDescription)
+		fields = append(fields, maintenanceentry.FieldDescription)
+	}
+	if m.cost != nil {
+		fields = append(fields, maintenanceentry.FieldCost)
 	}
 	return fields
 }
@@ -8769,16 +14436,24 @@ func (m *LocationMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *LocationMutation) Field(name string) (ent.Value, bool) {
+func (m *MaintenanceEntryMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case location.FieldCreatedAt:
+	case maintenanceentry.FieldCreatedAt:
 		return m.CreatedAt()
-	case location.FieldUpdatedAt:
+	case maintenanceentry.FieldUpdatedAt:
 		return m.UpdatedAt()
-	case location.FieldName:
+	case maintenanceentry.FieldItemID:
+		return m.ItemID()
+	case maintenanceentry.FieldDate:
+		return m.Date()
+	case maintenanceentry.FieldScheduledDate:
+		return m.ScheduledDate()
+	case maintenanceentry.FieldName:
 		return m.Name()
-	case location.FieldDescription:
+	case maintenanceentry.FieldDescription:
 		return m.Description()
+	case maintenanceentry.FieldCost:
+		return m.Cost()
 	}
 	return nil, false
 }
@@ -8786,306 +14461,308 @@ func (m *LocationMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *LocationMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *MaintenanceEntryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case location.FieldCreatedAt:
+	case maintenanceentry.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case location.FieldUpdatedAt:
+	case maintenanceentry.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
-	case location.FieldName:
+	case maintenanceentry.FieldItemID:
+		return m.OldItemID(ctx)
+	case maintenanceentry.FieldDate:
+		return m.OldDate(ctx)
+	case maintenanceentry.FieldScheduledDate:
+		return m.OldScheduledDate(ctx)
+	case maintenanceentry.FieldName:
 		return m.OldName(ctx)
-	case location.FieldDescription:
+	case maintenanceentry.FieldDescription:
 		return m.OldDescription(ctx)
+	case maintenanceentry.FieldCost:
+		return m.OldCost(ctx)
 	}
-	return nil, fmt.Errorf("unknown Location field %s", name)
+	return nil, fmt.Errorf("unknown MaintenanceEntry field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *LocationMutation) SetField(name string, value ent.Value) error {
+func (m *MaintenanceEntryMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case location.FieldCreatedAt:
+	case maintenanceentry.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case location.FieldUpdatedAt:
+	case maintenanceentry.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdatedAt(v)
 		return nil
-	case location.FieldName:
+	case maintenanceentry.FieldItemID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetItemID(v)
+		return nil
+	case maintenanceentry.FieldDate:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDate(v)
+		return nil
+	case maintenanceentry.FieldScheduledDate:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScheduledDate(v)
+		return nil
+	case maintenanceentry.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetName(v)
 		return nil
-	case location.FieldDescription:
+	case maintenanceentry.FieldDescription:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetDescription(v)
 		return nil
+	case maintenanceentry.FieldCost:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCost(v)
+		return nil
 	}
-	return fmt.Errorf("unknown Location field %s", name)
+	return fmt.Errorf("unknown MaintenanceEntry field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *LocationMutation) AddedFields() []string {
-	return nil
+func (m *MaintenanceEntryMutation) AddedFields() []string {
+	var fields []string
+	if m.addcost != nil {
+		fields = append(fields, maintenanceentry.FieldCost)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *LocationMutation) AddedField(name string) (ent.Value, bool) {
+func (m *MaintenanceEntryMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case maintenanceentry.FieldCost:
+		return m.AddedCost()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *LocationMutation) AddField(name string, value ent.Value) error {
+func (m *MaintenanceEntryMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case maintenanceentry.FieldCost:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCost(v)
+		return nil
 	}
-	return fmt.Errorf("unknown Location numeric field %s", name)
+	return fmt.Errorf("unknown MaintenanceEntry numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *LocationMutation) ClearedFields() []string {
+func (m *MaintenanceEntryMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(location.FieldDescription) {
-		fields = append(fields, location.FieldDescription)
+	if m.FieldCleared(maintenanceentry.FieldDate) {
+		fields = append(fields, maintenanceentry.FieldDate)
+	}
+	if m.FieldCleared(maintenanceentry.FieldScheduledDate) {
+		fields = append(fields, maintenanceentry.FieldScheduledDate)
+	}
+	if m.FieldCleared(maintenanceentry.FieldDescription) {
+		fields = append(fields, maintenanceentry.FieldDescription)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *LocationMutation) FieldCleared(name string) bool {
+func (m *MaintenanceEntryMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *LocationMutation) ClearField(name string) error {
+func (m *MaintenanceEntryMutation) ClearField(name string) error {
 	switch name {
-	case location.FieldDescription:
+	case maintenanceentry.FieldDate:
+		m.ClearDate()
+		return nil
+	case maintenanceentry.FieldScheduledDate:
+		m.ClearScheduledDate()
+		return nil
+	case maintenanceentry.FieldDescription:
 		m.ClearDescription()
 		return nil
 	}
-	return fmt.Errorf("unknown Location nullable field %s", name)
+	return fmt.Errorf("unknown MaintenanceEntry nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *LocationMutation) ResetField(name string) error {
+func (m *MaintenanceEntryMutation) ResetField(name string) error {
 	switch name {
-	case location.FieldCreatedAt:
+	case maintenanceentry.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case location.FieldUpdatedAt:
+	case maintenanceentry.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
-	case location.FieldName:
+	case maintenanceentry.FieldItemID:
+		m.ResetItemID()
+		return nil
+	case maintenanceentry.FieldDate:
+		m.ResetDate()
+		return nil
+	case maintenanceentry.FieldScheduledDate:
+		m.ResetScheduledDate()
+		return nil
+	case maintenanceentry.FieldName:
 		m.ResetName()
 		return nil
-	case location.FieldDescription:
+	case maintenanceentry.FieldDescription:
 		m.ResetDescription()
 		return nil
+	case maintenanceentry.FieldCost:
+		m.ResetCost()
+		return nil
 	}
-	return fmt.Errorf("unknown Location field %s", name)
+	return fmt.Errorf("unknown MaintenanceEntry field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *LocationMutation) AddedEdges() []string {
-	edges := make([]string, 0, 4)
-	if m.group != nil {
-		edges = append(edges, location.EdgeGroup)
-	}
-	if m.parent != nil {
-		edges = append(edges, location.EdgeParent)
-	}
-	if m.children != nil {
-		edges = append(edges, location.EdgeChildren)
-	}
-	if m.items != nil {
-		edges = append(edges, location.EdgeItems)
+func (m *MaintenanceEntryMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.item != nil {
+		edges = append(edges, maintenanceentry.EdgeItem)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *LocationMutation) AddedIDs(name string) []ent.Value {
+func (m *MaintenanceEntryMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case location.EdgeGroup:
-		if id := m.group; id != nil {
-			return []ent.Value{*id}
-		}
-	case location.EdgeParent:
-		if id := m.parent; id != nil {
+	case maintenanceentry.EdgeItem:
+		if id := m.item; id != nil {
 			return []ent.Value{*id}
 		}
-	case location.EdgeChildren:
-		ids := make([]ent.Value, 0, len(m.children))
-		for id := range m.children {
-			ids = append(ids, id)
-		}
-		return ids
-	case location.EdgeItems:
-		ids := make([]ent.Value, 0, len(m.items))
-		for id := range m.items {
-			ids = append(ids, id)
-		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *LocationMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 4)
-	if m.removedchildren != nil {
-		edges = append(edges, location.EdgeChildren)
-	}
-	if m.removeditems != nil {
-		edges = append(edges, location.EdgeItems)
-	}
+func (m *MaintenanceEntryMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *LocationMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case location.EdgeChildren:
-		ids := make([]ent.Value, 0, len(m.removedchildren))
-		for id := range m.removedchildren {
-			ids = append(ids, id)
-		}
-		return ids
-	case location.EdgeItems:
-		ids := make([]ent.Value, 0, len(m.removeditems))
-		for id := range m.removeditems {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *MaintenanceEntryMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *LocationMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 4)
-	if m.clearedgroup {
-		edges = append(edges, location.EdgeGroup)
-	}
-	if m.clearedparent {
-		edges = append(edges, location.EdgeParent)
-	}
-	if m.clearedchildren {
-		edges = append(edges, location.EdgeChildren)
-	}
-	if m.cleareditems {
-		edges = append(edges, location.EdgeItems)
+func (m *MaintenanceEntryMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareditem {
+		edges = append(edges, maintenanceentry.EdgeItem)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *LocationMutation) EdgeCleared(name string) bool {
+func (m *MaintenanceEntryMutation) EdgeCleared(name string) bool {
 	switch name {
-	case location.EdgeGroup:
-		return m.clearedgroup
-	case location.EdgeParent:
-		return m.clearedparent
-	case location.EdgeChildren:
-		return m.clearedchildren
-	case location.EdgeItems:
-		return m.cleareditems
+	case maintenanceentry.EdgeItem:
+		return m.cleareditem
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *LocationMutation) ClearEdge(name string) error {
+func (m *MaintenanceEntryMutation) ClearEdge(name string) error {
 	switch name {
-	case location.EdgeGroup:
-		m.ClearGroup()
-		return nil
-	case location.EdgeParent:
-		m.ClearParent()
+	case maintenanceentry.EdgeItem:
+		m.ClearItem()
 		return nil
 	}
-	return fmt.Errorf("unknown Location unique edge %s", name)
+	return fmt.Errorf("unknown MaintenanceEntry unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *LocationMutation) ResetEdge(name string) error {
+func (m *MaintenanceEntryMutation) ResetEdge(name string) error {
 	switch name {
-	case location.EdgeGroup:
-		m.ResetGroup()
-		return nil
-	case location.EdgeParent:
-		m.ResetParent()
-		return nil
-	case location.EdgeChildren:
-		m.ResetChildren()
-		return nil
-	case location.EdgeItems:
-		m.ResetItems()
+	case maintenanceentry.EdgeItem:
+		m.ResetItem()
 		return nil
 	}
-	return fmt.Errorf("unknown Location edge %s", name)
+	return fmt.Errorf("unknown MaintenanceEntry edge %s", name)
 }
 
-// MaintenanceEntryMutation represents an operation that mutates the MaintenanceEntry nodes in the graph.
-type MaintenanceEntryMutation struct {
+// NotifierMutation represents an operation that mutates the Notifier nodes in the graph.
+type NotifierMutation struct {
 	config
-	op             Op
-	typ            string
-	id             *uuid.UUID
-	created_at     *time.Time
-	updated_at     *time.Time
-	date           *time.Time
-	scheduled_date *time.Time
-	name           *string
-	description    *string
-	cost           *float64
-	addcost        *float64
-	clearedFields  map[string]struct{}
-	item           *uuid.UUID
-	cleareditem    bool
-	done           bool
-	oldValue       func(context.Context) (*MaintenanceEntry, error)
-	predicates     []predicate.MaintenanceEntry
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	created_at    *time.Time
+	updated_at    *time.Time
+	name          *string
+	url           *string
+	is_active     *bool
+	clearedFields map[string]struct{}
+	group         *uuid.UUID
+	clearedgroup  bool
+	user          *uuid.UUID
+	cleareduser   bool
+	done          bool
+	oldValue      func(context.Context) (*Notifier, error)
+	predicates    []predicate.Notifier
 }
 
-var _ ent.Mutation = (*MaintenanceEntryMutation)(nil)
+var _ ent.Mutation = (*NotifierMutation)(nil)
 
-// maintenanceentryOption allows management of the mutation configuration using functional options.
-type maintenanceentryOption func(*MaintenanceEntryMutation)
+// notifierOption allows management of the mutation configuration using functional options.
+type notifierOption func(*NotifierMutation)
 
-// newMaintenanceEntryMutation creates new mutation for the MaintenanceEntry entity.
-func newMaintenanceEntryMutation(c config, op Op, opts ...maintenanceentryOption) *MaintenanceEntryMutation {
-	m := &MaintenanceEntryMutation{
+// newNotifierMutation creates new mutation for the Notifier entity.
+func newNotifierMutation(c config, op Op, opts ...notifierOption) *NotifierMutation {
+	m := &NotifierMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeMaintenanceEntry,
+		typ:           TypeNotifier,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -9094,20 +14771,20 @@ func newMaintenanceEntryMutation(c config, op Op, opts ...maintenanceentryOption
 	return m
 }
 
-// withMaintenanceEntryID sets the ID field of the mutation.
-func withMaintenanceEntryID(id uuid.UUID) maintenanceentryOption {
-	return func(m *MaintenanceEntryMutation) {
+// withNotifierID sets the ID field of the mutation.
+func withNotifierID(id uuid.UUID) notifierOption {
+	return func(m *NotifierMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *MaintenanceEntry
+			value *Notifier
 		)
-		m.oldValue = func(ctx context.Context) (*MaintenanceEntry, error) {
+		m.oldValue = func(ctx context.Context) (*Notifier, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().MaintenanceEntry.Get(ctx, id)
+					value, err = m.Client().Notifier.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -9116,10 +14793,10 @@ func withMaintenanceEntryID(id uuid.UUID) maintenanceentryOption {
 	}
 }
 
-// withMaintenanceEntry sets the old MaintenanceEntry of the mutation.
-func withMaintenanceEntry(node *MaintenanceEntry) maintenanceentryOption {
-	return func(m *MaintenanceEntryMutation) {
-		m.oldValue = func(context.Context) (*MaintenanceEntry, error) {
+// withNotifier sets the old Notifier of the mutation.
+func withNotifier(node *Notifier) notifierOption {
+	return func(m *NotifierMutation) {
+		m.oldValue = func(context.Context) (*Notifier, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -9128,7 +14805,7 @@ func withMaintenanceEntry(node *MaintenanceEntry) maintenanceentryOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m MaintenanceEntryMutation) Client() *Client {
+func (m NotifierMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -9136,7 +14813,7 @@ func (m MaintenanceEntryMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m MaintenanceEntryMutation) Tx() (*Tx, error) {
+func (m NotifierMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -9146,14 +14823,14 @@ func (m MaintenanceEntryMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of MaintenanceEntry entities.
-func (m *MaintenanceEntryMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of Notifier entities.
+func (m *NotifierMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *MaintenanceEntryMutation) ID() (id uuid.UUID, exists bool) {
+func (m *NotifierMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -9164,7 +14841,7 @@ func (m *MaintenanceEntryMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *MaintenanceEntryMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *NotifierMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -9173,19 +14850,19 @@ func (m *MaintenanceEntryMutation) IDs(ctx context.Context) ([]uuid.UUID, error)
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().MaintenanceEntry.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Notifier.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *MaintenanceEntryMutation) SetCreatedAt(t time.Time) {
+func (m *NotifierMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *MaintenanceEntryMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *NotifierMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -9193,10 +14870,10 @@ func (m *MaintenanceEntryMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the MaintenanceEntry entity.
-// If the MaintenanceEntry object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Notifier entity.
+// If the Notifier object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MaintenanceEntryMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *NotifierMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -9211,17 +14888,17 @@ func (m *MaintenanceEntryMutation) OldCreatedAt(ctx context.Context) (v time.Tim
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *MaintenanceEntryMutation) ResetCreatedAt() {
+func (m *NotifierMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *MaintenanceEntryMutation) SetUpdatedAt(t time.Time) {
+func (m *NotifierMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *MaintenanceEntryMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *NotifierMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -9229,10 +14906,10 @@ func (m *MaintenanceEntryMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the MaintenanceEntry entity.
-// If the MaintenanceEntry object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the Notifier entity.
+// If the Notifier object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MaintenanceEntryMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *NotifierMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -9247,151 +14924,89 @@ func (m *MaintenanceEntryMutation) OldUpdatedAt(ctx context.Context) (v time.Tim
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *MaintenanceEntryMutation) ResetUpdatedAt() {
+func (m *NotifierMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
-// SetItemID sets the "item_id" field.
-func (m *MaintenanceEntryMutation) SetItemID(u uuid.UUID) {
-	m.item = &u
-}
-
-// ItemID returns the value of the "item_id" field in the mutation.
-func (m *MaintenanceEntryMutation) ItemID() (r uuid.UUID, exists bool) {
-	v := m.item
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldItemID returns the old "item_id" field's value of the MaintenanceEntry entity.
-// If the MaintenanceEntry object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MaintenanceEntryMutation) OldItemID(ctx context.Context) (v uuid.UUID, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldItemID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldItemID requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldItemID: %w", err)
-	}
-	return oldValue.ItemID, nil
-}
-
-// ResetItemID resets all changes to the "item_id" field.
-func (m *MaintenanceEntryMutation) ResetItemID() {
-	m.item = nil
-}
-
-// SetDate sets the "date" field.
-func (m *MaintenanceEntryMutation) SetDate(t time.Time) {
-	m.date = &t
+// SetGroupID sets the "group_id" field.
+func (m *NotifierMutation) SetGroupID(u uuid.UUID) {
+	m.group = &u
 }
 
-// Date returns the value of the "date" field in the mutation.
-func (m *MaintenanceEntryMutation) Date() (r time.Time, exists bool) {
-	v := m.date
+// GroupID returns the value of the "group_id" field in the mutation.
+func (m *NotifierMutation) GroupID() (r uuid.UUID, exists bool) {
+	v := m.group
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDate returns the old "date" field's value of the MaintenanceEntry entity.
-// If the MaintenanceEntry object wasn't provided to the builder, the object is fetched from the database.
+// OldGroupID returns the old "group_id" field's value of the Notifier entity.
+// If the Notifier object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MaintenanceEntryMutation) OldDate(ctx context.Context) (v time.Time, err error) {
+func (m *NotifierMutation) OldGroupID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDate is only allowed on UpdateOne operations")
+		return v, errors.New("OldGroupID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDate requires an ID field in the mutation")
+		return v, errors.New("OldGroupID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDate: %w", err)
+		return v, fmt.Errorf("querying old value for OldGroupID: %w", err)
 	}
-	return oldValue.Date, nil
-}
-
-// ClearDate clears the value of the "date" field.
-func (m *MaintenanceEntryMutation) ClearDate() {
-	m.date = nil
-	m.clearedFields[maintenanceentry.FieldDate] = struct{}{}
-}
-
-// DateCleared returns if the "date" field was cleared in this mutation.
-func (m *MaintenanceEntryMutation) DateCleared() bool {
-	_, ok := m.clearedFields[maintenanceentry.FieldDate]
-	return ok
+	return oldValue.GroupID, nil
 }
 
-// ResetDate resets all changes to the "date" field.
-func (m *MaintenanceEntryMutation) ResetDate() {
-	m.date = nil
-	delete(m.clearedFields, maintenanceentry.FieldDate)
+// ResetGroupID resets all changes to the "group_id" field.
+func (m *NotifierMutation) ResetGroupID() {
+	m.group = nil
 }
 
-// SetScheduledDate sets the "scheduled_date" field.
-func (m *MaintenanceEntryMutation) SetScheduledDate(t time.Time) {
-	m.scheduled_date = &t
+// SetUserID sets the "user_id" field.
+func (m *NotifierMutation) SetUserID(u uuid.UUID) {
+	m.user = &u
 }
 
-// ScheduledDate returns the value of the "scheduled_date" field in the mutation.
-func (m *MaintenanceEntryMutation) ScheduledDate() (r time.Time, exists bool) {
-	v := m.scheduled_date
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *NotifierMutation) UserID() (r uuid.UUID, exists bool) {
+	v := m.user
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldScheduledDate returns the old "scheduled_date" field's value of the MaintenanceEntry entity.
-// If the MaintenanceEntry object wasn't provided to the builder, the object is fetched from the database.
+// OldUserID returns the old "user_id" field's value of the Notifier entity.
+// If the Notifier object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MaintenanceEntryMutation) OldScheduledDate(ctx context.Context) (v time.Time, err error) {
+func (m *NotifierMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldScheduledDate is only allowed on UpdateOne operations")
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldScheduledDate requires an ID field in the mutation")
+		return v, errors.New("OldUserID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldScheduledDate: %w", err)
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
 	}
-	return oldValue.ScheduledDate, nil
-}
-
-// ClearScheduledDate clears the value of the "scheduled_date" field.
-func (m *MaintenanceEntryMutation) ClearScheduledDate() {
-	m.scheduled_date = nil
-	m.clearedFields[maintenanceentry.FieldScheduledDate] = struct{}{}
-}
-
-// ScheduledDateCleared returns if the "scheduled_date" field was cleared in this mutation.
-func (m *MaintenanceEntryMutation) ScheduledDateCleared() bool {
-	_, ok := m.clearedFields[maintenanceentry.FieldScheduledDate]
-	return ok
+	return oldValue.UserID, nil
 }
 
-// ResetScheduledDate resets all changes to the "scheduled_date" field.
-func (m *MaintenanceEntryMutation) ResetScheduledDate() {
-	m.scheduled_date = nil
-	delete(m.clearedFields, maintenanceentry.FieldScheduledDate)
+// ResetUserID resets all changes to the "user_id" field.
+func (m *NotifierMutation) ResetUserID() {
+	m.user = nil
 }
 
 // SetName sets the "name" field.
-func (m *MaintenanceEntryMutation) SetName(s string) {
+func (m *NotifierMutation) SetName(s string) {
 	m.name = &s
 }
 
 // Name returns the value of the "name" field in the mutation.
-func (m *MaintenanceEntryMutation) Name() (r string, exists bool) {
+func (m *NotifierMutation) Name() (r string, exists bool) {
 	v := m.name
 	if v == nil {
 		return
@@ -9399,10 +15014,10 @@ func (m *MaintenanceEntryMutation) Name() (r string, exists bool) {
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the MaintenanceEntry entity.
-// If the MaintenanceEntry object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the Notifier entity.
+// If the Notifier object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MaintenanceEntryMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *NotifierMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
@@ -9417,151 +15032,145 @@ func (m *MaintenanceEntryMutation) OldName(ctx context.Context) (v string, err e
 }
 
 // ResetName resets all changes to the "name" field.
-func (m *MaintenanceEntryMutation) ResetName() {
+func (m *NotifierMutation) ResetName() {
 	m.name = nil
 }
 
-// SetDescription sets the "description" field.
-func (m *MaintenanceEntryMutation) SetDescription(s string) {
-	m.description = &s
+// SetURL sets the "url" field.
+func (m *NotifierMutation) SetURL(s string) {
+	m.url = &s
 }
 
-// Description returns the value of the "description" field in the mutation.
-func (m *MaintenanceEntryMutation) Description() (r string, exists bool) {
-	v := m.description
+// URL returns the value of the "url" field in the mutation.
+func (m *NotifierMutation) URL() (r string, exists bool) {
+	v := m.url
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDescription returns the old "description" field's value of the MaintenanceEntry entity.
-// If the MaintenanceEntry object wasn't provided to the builder, the object is fetched from the database.
+// OldURL returns the old "url" field's value of the Notifier entity.
+// If the Notifier object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MaintenanceEntryMutation) OldDescription(ctx context.Context) (v string, err error) {
+func (m *NotifierMutation) OldURL(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+		return v, errors.New("OldURL is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDescription requires an ID field in the mutation")
+		return v, errors.New("OldURL requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+		return v, fmt.Errorf("querying old value for OldURL: %w", err)
 	}
-	return oldValue.Description, nil
-}
-
-// ClearDescription clears the value of the "description" field.
-func (m *MaintenanceEntryMutation) ClearDescription() {
-	m.description = nil
-	m.clearedFields[maintenanceentry.FieldDescription] = struct{}{}
-}
-
-// DescriptionCleared returns if the "description" field was cleared in this mutation.
-func (m *MaintenanceEntryMutation) DescriptionCleared() bool {
-	_, ok := m.clearedFields[maintenanceentry.FieldDescription]
-	return ok
+	return oldValue.URL, nil
 }
 
-// ResetDescription resets all changes to the "description" field.
-func (m *MaintenanceEntryMutation) ResetDescription() {
-	m.description = nil
-	delete(m.clearedFields, maintenanceentry.FieldDescription)
+// ResetURL resets all changes to the "url" field.
+func (m *NotifierMutation) ResetURL() {
+	m.url = nil
 }
 
-// SetCost sets the "cost" field.
-func (m *MaintenanceEntryMutation) SetCost(f float64) {
-	m.cost = &f
-	m.addcost = nil
+// SetIsActive sets the "is_active" field.
+func (m *NotifierMutation) SetIsActive(b bool) {
+	m.is_active = &b
 }
 
-// Cost returns the value of the "cost" field in the mutation.
-func (m *MaintenanceEntryMutation) Cost() (r float64, exists bool) {
-	v := m.cost
+// IsActive returns the value of the "is_active" field in the mutation.
+func (m *NotifierMutation) IsActive() (r bool, exists bool) {
+	v := m.is_active
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCost returns the old "cost" field's value of the MaintenanceEntry entity.
-// If the MaintenanceEntry object wasn't provided to the builder, the object is fetched from the database.
+// OldIsActive returns the old "is_active" field's value of the Notifier entity.
+// If the Notifier object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MaintenanceEntryMutation) OldCost(ctx context.Context) (v float64, err error) {
+func (m *NotifierMutation) OldIsActive(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCost is only allowed on UpdateOne operations")
+		return v, errors.New("OldIsActive is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCost requires an ID field in the mutation")
+		return v, errors.New("OldIsActive requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCost: %w", err)
+		return v, fmt.Errorf("querying old value for OldIsActive: %w", err)
 	}
-	return oldValue.Cost, nil
+	return oldValue.IsActive, nil
 }
 
-// AddCost adds f to the "cost" field.
-func (m *MaintenanceEntryMutation) AddCost(f float64) {
-	if m.addcost != nil {
-		*m.addcost += f
-	} else {
-		m.addcost = &f
-	}
+// ResetIsActive resets all changes to the "is_active" field.
+func (m *NotifierMutation) ResetIsActive() {
+	m.is_active = nil
 }
 
-// AddedCost returns the value that was added to the "cost" field in this mutation.
-func (m *MaintenanceEntryMutation) AddedCost() (r float64, exists bool) {
-	v := m.addcost
-	if v == nil {
-		return
+// ClearGroup clears the "group" edge to the Group entity.
+func (m *NotifierMutation) ClearGroup() {
+	m.clearedgroup = true
+	m.clearedFields[notifier.FieldGroupID] = struct{}{}
+}
+
+// GroupCleared reports if the "group" edge to the Group entity was cleared.
+func (m *NotifierMutation) GroupCleared() bool {
+	return m.clearedgroup
+}
+
+// GroupIDs returns the "group" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// GroupID instead. It exists only for internal usage by the builders.
+func (m *NotifierMutation) GroupIDs() (ids []uuid.UUID) {
+	if id := m.group; id != nil {
+		ids = append(ids, *id)
 	}
-	return *v, true
+	return
 }
 
-// ResetCost resets all changes to the "cost" field.
-func (m *MaintenanceEntryMutation) ResetCost() {
-	m.cost = nil
-	m.addcost = nil
+// ResetGroup resets all changes to the "group" edge.
+func (m *NotifierMutation) ResetGroup() {
+	m.group = nil
+	m.clearedgroup = false
 }
 
-// ClearItem clears the "item" edge to the Item entity.
-func (m *MaintenanceEntryMutation) ClearItem() {
-	m.cleareditem = true
-	m.clearedFields[maintenanceentry.FieldItemID] = struct{}{}
+// ClearUser clears the "user" edge to the User entity.
+func (m *NotifierMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[notifier.FieldUserID] = struct{}{}
 }
 
-// ItemCleared reports if the "item" edge to the Item entity was cleared.
-func (m *MaintenanceEntryMutation) ItemCleared() bool {
-	return m.cleareditem
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *NotifierMutation) UserCleared() bool {
+	return m.cleareduser
 }
 
-// ItemIDs returns the "item" edge IDs in the mutation.
+// UserIDs returns the "user" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// ItemID instead. It exists only for internal usage by the builders.
-func (m *MaintenanceEntryMutation) ItemIDs() (ids []uuid.UUID) {
-	if id := m.item; id != nil {
+// UserID instead. It exists only for internal usage by the builders.
+func (m *NotifierMutation) UserIDs() (ids []uuid.UUID) {
+	if id := m.user; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetItem resets all changes to the "item" edge.
-func (m *MaintenanceEntryMutation) ResetItem() {
-	m.item = nil
-	m.cleareditem = false
+// ResetUser resets all changes to the "user" edge.
+func (m *NotifierMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
 }
 
-// Where appends a list predicates to the MaintenanceEntryMutation builder.
-func (m *MaintenanceEntryMutation) Where(ps ...predicate.MaintenanceEntry) {
+// Where appends a list predicates to the NotifierMutation builder.
+func (m *NotifierMutation) Where(ps ...predicate.Notifier) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the MaintenanceEntryMutation builder. Using this method,
+// WhereP appends storage-level predicates to the NotifierMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *MaintenanceEntryMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.MaintenanceEntry, len(ps))
+func (m *NotifierMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Notifier, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -9569,48 +15178,45 @@ func (m *MaintenanceEntryMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *MaintenanceEntryMutation) Op() Op {
+func (m *NotifierMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *MaintenanceEntryMutation) SetOp(op Op) {
+func (m *NotifierMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (MaintenanceEntry).
-func (m *MaintenanceEntryMutation) Type() string {
+// Type returns the node type of this mutation (Notifier).
+func (m *NotifierMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *MaintenanceEntryMutation) Fields() []string {
-	fields := make([]string, 0, 8)
+func (m *NotifierMutation) Fields() []string {
+	fields := make([]string, 0, 7)
 	if m.created_at != nil {
-		fields = append(fields, maintenanceentry.FieldCreatedAt)
+		fields = append(fields, notifier.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, maintenanceentry.FieldUpdatedAt)
-	}
-	if m.item != nil {
-		fields = append(fields, maintenanceentry.FieldItemID)
+		fields = append(fields, notifier.FieldUpdatedAt)
 	}
-	if m.date != nil {
-		fields = append(fields, maintenanceentry.FieldDate)
+	if m.group != nil {
+		fields = append(fields, notifier.FieldGroupID)
 	}
-	if m.scheduled_date != nil {
-		fields = append(fields, maintenanceentry.FieldScheduledDate)
+	if m.user != nil {
+		fields = append(fields, notifier.FieldUserID)
 	}
 	if m.name != nil {
-		fields = append(fields, maintenanceentry.FieldName)
+		fields = append(fields, notifier.FieldName)
 	}
-	if m.description != nil {
-		fields = append(fields, maintenanceentry.FieldDescription)
+	if m.url != nil {
+		fields = append(fields, notifier.FieldURL)
 	}
-	if m.cost != nil {
-		fields = append(fields, maintenanceentry.FieldCost)
+	if m.is_active != nil {
+		fields = append(fields, notifier.FieldIsActive)
 	}
 	return fields
 }
@@ -9618,24 +15224,22 @@ func (m *MaintenanceEntryMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *MaintenanceEntryMutation) Field(name string) (ent.Value, bool) {
+func (m *NotifierMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case maintenanceentry.FieldCreatedAt:
+	case notifier.FieldCreatedAt:
 		return m.CreatedAt()
-	case maintenanceentry.FieldUpdatedAt:
+	case notifier.FieldUpdatedAt:
 		return m.UpdatedAt()
-	case maintenanceentry.FieldItemID:
-		return m.ItemID()
-	case maintenanceentry.FieldDate:
-		return m.Date()
-	case maintenanceentry.FieldScheduledDate:
-		return m.ScheduledDate()
-	case maintenanceentry.FieldName:
+	case notifier.FieldGroupID:
+		return m.GroupID()
+	case notifier.FieldUserID:
+		return m.UserID()
+	case notifier.FieldName:
 		return m.Name()
-	case maintenanceentry.FieldDescription:
-		return m.Description()
-	case maintenanceentry.FieldCost:
-		return m.Cost()
+	case notifier.FieldURL:
+		return m.URL()
+	case notifier.FieldIsActive:
+		return m.IsActive()
 	}
 	return nil, false
 }
@@ -9643,217 +15247,176 @@ func (m *MaintenanceEntryMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *MaintenanceEntryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *NotifierMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case maintenanceentry.FieldCreatedAt:
+	case notifier.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case maintenanceentry.FieldUpdatedAt:
+	case notifier.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
-	case maintenanceentry.FieldItemID:
-		return m.OldItemID(ctx)
-	case maintenanceentry.FieldDate:
-		return m.OldDate(ctx)
-	case maintenanceentry.FieldScheduledDate:
-		return m.OldScheduledDate(ctx)
-	case maintenanceentry.FieldName:
+	case notifier.FieldGroupID:
+		return m.OldGroupID(ctx)
+	case notifier.FieldUserID:
+		return m.OldUserID(ctx)
+	case notifier.FieldName:
 		return m.OldName(ctx)
-	case maintenanceentry.FieldDescription:
-		return m.OldDescription(ctx)
-	case maintenanceentry.FieldCost:
-		return m.OldCost(ctx)
+	case notifier.FieldURL:
+		return m.OldURL(ctx)
+	case notifier.FieldIsActive:
+		return m.OldIsActive(ctx)
 	}
-	return nil, fmt.Errorf("unknown MaintenanceEntry field %s", name)
+	return nil, fmt.Errorf("unknown Notifier field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *MaintenanceEntryMutation) SetField(name string, value ent.Value) error {
+func (m *NotifierMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case maintenanceentry.FieldCreatedAt:
+	case notifier.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case maintenanceentry.FieldUpdatedAt:
+	case notifier.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdatedAt(v)
 		return nil
-	case maintenanceentry.FieldItemID:
+	case notifier.FieldGroupID:
 		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetItemID(v)
-		return nil
-	case maintenanceentry.FieldDate:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDate(v)
+		m.SetGroupID(v)
 		return nil
-	case maintenanceentry.FieldScheduledDate:
-		v, ok := value.(time.Time)
+	case notifier.FieldUserID:
+		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetScheduledDate(v)
+		m.SetUserID(v)
 		return nil
-	case maintenanceentry.FieldName:
+	case notifier.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetName(v)
 		return nil
-	case maintenanceentry.FieldDescription:
+	case notifier.FieldURL:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDescription(v)
+		m.SetURL(v)
 		return nil
-	case maintenanceentry.FieldCost:
-		v, ok := value.(float64)
+	case notifier.FieldIsActive:
+		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCost(v)
+		m.SetIsActive(v)
 		return nil
 	}
-	return fmt.Errorf("unknown MaintenanceEntry field %s", name)
+	return fmt.Errorf("unknown Notifier field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *MaintenanceEntryMutation) AddedFields() []string {
-	var fields []string
-	if m.addcost != nil {
-		fields = append(fields, maintenanceentry.FieldCost)
-	}
-	return fields
+func (m *NotifierMutation) AddedFields() []string {
+	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *MaintenanceEntryMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case maintenanceentry.FieldCost:
-		return m.AddedCost()
-	}
+func (m *NotifierMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *MaintenanceEntryMutation) AddField(name string, value ent.Value) error {
+func (m *NotifierMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case maintenanceentry.FieldCost:
-		v, ok := value.(float64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddCost(v)
-		return nil
 	}
-	return fmt.Errorf("unknown MaintenanceEntry numeric field %s", name)
+	return fmt.Errorf("unknown Notifier numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *MaintenanceEntryMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(maintenanceentry.FieldDate) {
-		fields = append(fields, maintenanceentry.FieldDate)
-	}
-	if m.FieldCleared(maintenanceentry.FieldScheduledDate) {
-		fields = append(fields, maintenanceentry.FieldScheduledDate)
-	}
-	if m.FieldCleared(maintenanceentry.FieldDescription) {
-		fields = append(fields, maintenanceentry.FieldDescription)
-	}
-	return fields
+func (m *NotifierMutation) ClearedFields() []string {
+	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *MaintenanceEntryMutation) FieldCleared(name string) bool {
+func (m *NotifierMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *MaintenanceEntryMutation) ClearField(name string) error {
-	switch name {
-	case maintenanceentry.FieldDate:
-		m.ClearDate()
-		return nil
-	case maintenanceentry.FieldScheduledDate:
-		m.ClearScheduledDate()
-		return nil
-	case maintenanceentry.FieldDescription:
-		m.ClearDescription()
-		return nil
-	}
-	return fmt.Errorf("unknown MaintenanceEntry nullable field %s", name)
+func (m *NotifierMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Notifier nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *MaintenanceEntryMutation) ResetField(name string) error {
+func (m *NotifierMutation) ResetField(name string) error {
 	switch name {
-	case maintenanceentry.FieldCreatedAt:
+	case notifier.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case maintenanceentry.FieldUpdatedAt:
+	case notifier.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
-	case maintenanceentry.FieldItemID:
-		m.ResetItemID()
-		return nil
-	case maintenanceentry.FieldDate:
-		m.ResetDate()
+	case notifier.FieldGroupID:
+		m.ResetGroupID()
 		return nil
-	case maintenanceentry.FieldScheduledDate:
-		m.ResetScheduledDate()
+	case notifier.FieldUserID:
+		m.ResetUserID()
 		return nil
-	case maintenanceentry.FieldName:
+	case notifier.FieldName:
 		m.ResetName()
 		return nil
-	case maintenanceentry.FieldDescription:
-		m.ResetDescription()
+	case notifier.FieldURL:
+		m.ResetURL()
 		return nil
-	case maintenanceentry.FieldCost:
-		m.ResetCost()
+	case notifier.FieldIsActive:
+		m.ResetIsActive()
 		return nil
 	}
-	return fmt.Errorf("unknown MaintenanceEntry field %s", name)
+	return fmt.Errorf("unknown Notifier field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *MaintenanceEntryMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.item != nil {
-		edges = append(edges, maintenanceentry.EdgeItem)
+func (m *NotifierMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.group != nil {
+		edges = append(edges, notifier.EdgeGroup)
+	}
+	if m.user != nil {
+		edges = append(edges, notifier.EdgeUser)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *MaintenanceEntryMutation) AddedIDs(name string) []ent.Value {
+func (m *NotifierMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case maintenanceentry.EdgeItem:
-		if id := m.item; id != nil {
+	case notifier.EdgeGroup:
+		if id := m.group; id != nil {
+			return []ent.Value{*id}
+		}
+	case notifier.EdgeUser:
+		if id := m.user; id != nil {
 			return []ent.Value{*id}
 		}
 	}
@@ -9861,60 +15424,71 @@ func (m *MaintenanceEntryMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *MaintenanceEntryMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *NotifierMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *MaintenanceEntryMutation) RemovedIDs(name string) []ent.Value {
+func (m *NotifierMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *MaintenanceEntryMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.cleareditem {
-		edges = append(edges, maintenanceentry.EdgeItem)
+func (m *NotifierMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedgroup {
+		edges = append(edges, notifier.EdgeGroup)
+	}
+	if m.cleareduser {
+		edges = append(edges, notifier.EdgeUser)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *MaintenanceEntryMutation) EdgeCleared(name string) bool {
+func (m *NotifierMutation) EdgeCleared(name string) bool {
 	switch name {
-	case maintenanceentry.EdgeItem:
-		return m.cleareditem
+	case notifier.EdgeGroup:
+		return m.clearedgroup
+	case notifier.EdgeUser:
+		return m.cleareduser
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *MaintenanceEntryMutation) ClearEdge(name string) error {
+func (m *NotifierMutation) ClearEdge(name string) error {
 	switch name {
-	case maintenanceentry.EdgeItem:
-		m.ClearItem()
+	case notifier.EdgeGroup:
+		m.ClearGroup()
+		return nil
+	case notifier.EdgeUser:
+		m.ClearUser()
 		return nil
 	}
-	return fmt.Errorf("unknown MaintenanceEntry unique edge %s", name)
+	return fmt.Errorf("unknown Notifier unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *MaintenanceEntryMutation) ResetEdge(name string) error {
+func (m *NotifierMutation) ResetEdge(name string) error {
 	switch name {
-	case maintenanceentry.EdgeItem:
-		m.ResetItem()
+	case notifier.EdgeGroup:
+		m.ResetGroup()
+		return nil
+	case notifier.EdgeUser:
+		m.ResetUser()
 		return nil
 	}
-	return fmt.Errorf("unknown MaintenanceEntry edge %s", name)
+	return fmt.Errorf("unknown Notifier edge %s", name)
 }
 
-// NotifierMutation represents an operation that mutates the Notifier nodes in the graph.
-type NotifierMutation struct {
+// SavedSearchMutation represents an operation that mutates the SavedSearch nodes in the graph.
+type SavedSearchMutation struct {
 	config
 	op            Op
 	typ           string
@@ -9922,29 +15496,26 @@ type NotifierMutation struct {
 	created_at    *time.Time
 	updated_at    *time.Time
 	name          *string
-	url           *string
-	is_active     *bool
+	query         *types.SavedSearchFilter
 	clearedFields map[string]struct{}
 	group         *uuid.UUID
 	clearedgroup  bool
-	user          *uuid.UUID
-	cleareduser   bool
 	done          bool
-	oldValue      func(context.Context) (*Notifier, error)
-	predicates    []predicate.Notifier
+	oldValue      func(context.Context) (*SavedSearch, error)
+	predicates    []predicate.SavedSearch
 }
 
-var _ ent.Mutation = (*NotifierMutation)(nil)
+var _ ent.Mutation = (*SavedSearchMutation)(nil)
 
-// notifierOption allows management of the mutation configuration using functional options.
-type notifierOption func(*NotifierMutation)
+// savedsearchOption allows management of the mutation configuration using functional options.
+type savedsearchOption func(*SavedSearchMutation)
 
-// newNotifierMutation creates new mutation for the Notifier entity.
-func newNotifierMutation(c config, op Op, opts ...notifierOption) *NotifierMutation {
-	m := &NotifierMutation{
+// newSavedSearchMutation creates new mutation for the SavedSearch entity.
+func newSavedSearchMutation(c config, op Op, opts ...savedsearchOption) *SavedSearchMutation {
+	m := &SavedSearchMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeNotifier,
+		typ:           TypeSavedSearch,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -9953,20 +15524,20 @@ func newNotifierMutation(c config, op Op, opts ...notifierOption) *NotifierMutat
 	return m
 }
 
-// withNotifierID sets the ID field of the mutation.
-func withNotifierID(id uuid.UUID) notifierOption {
-	return func(m *NotifierMutation) {
+// withSavedSearchID sets the ID field of the mutation.
+func withSavedSearchID(id uuid.UUID) savedsearchOption {
+	return func(m *SavedSearchMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Notifier
+			value *SavedSearch
 		)
-		m.oldValue = func(ctx context.Context) (*Notifier, error) {
+		m.oldValue = func(ctx context.Context) (*SavedSearch, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Notifier.Get(ctx, id)
+					value, err = m.Client().SavedSearch.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -9975,10 +15546,10 @@ func withNotifierID(id uuid.UUID) notifierOption {
 	}
 }
 
-// withNotifier sets the old Notifier of the mutation.
-func withNotifier(node *Notifier) notifierOption {
-	return func(m *NotifierMutation) {
-		m.oldValue = func(context.Context) (*Notifier, error) {
+// withSavedSearch sets the old SavedSearch of the mutation.
+func withSavedSearch(node *SavedSearch) savedsearchOption {
+	return func(m *SavedSearchMutation) {
+		m.oldValue = func(context.Context) (*SavedSearch, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -9987,7 +15558,7 @@ func withNotifier(node *Notifier) notifierOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m NotifierMutation) Client() *Client {
+func (m SavedSearchMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -9995,7 +15566,7 @@ func (m NotifierMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m NotifierMutation) Tx() (*Tx, error) {
+func (m SavedSearchMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -10005,14 +15576,14 @@ func (m NotifierMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Notifier entities.
-func (m *NotifierMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of SavedSearch entities.
+func (m *SavedSearchMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *NotifierMutation) ID() (id uuid.UUID, exists bool) {
+func (m *SavedSearchMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -10023,7 +15594,7 @@ func (m *NotifierMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *NotifierMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *SavedSearchMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -10032,19 +15603,19 @@ func (m *NotifierMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Notifier.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().SavedSearch.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *NotifierMutation) SetCreatedAt(t time.Time) {
+func (m *SavedSearchMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *NotifierMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *SavedSearchMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -10052,10 +15623,10 @@ func (m *NotifierMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Notifier entity.
-// If the Notifier object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the SavedSearch entity.
+// If the SavedSearch object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NotifierMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *SavedSearchMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -10070,17 +15641,17 @@ func (m *NotifierMutation) OldCreatedAt(ctx context.Context) (v time.Time, err e
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *NotifierMutation) ResetCreatedAt() {
+func (m *SavedSearchMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *NotifierMutation) SetUpdatedAt(t time.Time) {
+func (m *SavedSearchMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *NotifierMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *SavedSearchMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -10088,10 +15659,10 @@ func (m *NotifierMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Notifier entity.
-// If the Notifier object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the SavedSearch entity.
+// If the SavedSearch object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NotifierMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *SavedSearchMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -10106,89 +15677,17 @@ func (m *NotifierMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err e
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *NotifierMutation) ResetUpdatedAt() {
+func (m *SavedSearchMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
-// SetGroupID sets the "group_id" field.
-func (m *NotifierMutation) SetGroupID(u uuid.UUID) {
-	m.group = &u
-}
-
-// GroupID returns the value of the "group_id" field in the mutation.
-func (m *NotifierMutation) GroupID() (r uuid.UUID, exists bool) {
-	v := m.group
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldGroupID returns the old "group_id" field's value of the Notifier entity.
-// If the Notifier object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NotifierMutation) OldGroupID(ctx context.Context) (v uuid.UUID, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldGroupID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldGroupID requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldGroupID: %w", err)
-	}
-	return oldValue.GroupID, nil
-}
-
-// ResetGroupID resets all changes to the "group_id" field.
-func (m *NotifierMutation) ResetGroupID() {
-	m.group = nil
-}
-
-// SetUserID sets the "user_id" field.
-func (m *NotifierMutation) SetUserID(u uuid.UUID) {
-	m.user = &u
-}
-
-// UserID returns the value of the "user_id" field in the mutation.
-func (m *NotifierMutation) UserID() (r uuid.UUID, exists bool) {
-	v := m.user
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldUserID returns the old "user_id" field's value of the Notifier entity.
-// If the Notifier object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NotifierMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUserID requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
-	}
-	return oldValue.UserID, nil
-}
-
-// ResetUserID resets all changes to the "user_id" field.
-func (m *NotifierMutation) ResetUserID() {
-	m.user = nil
-}
-
 // SetName sets the "name" field.
-func (m *NotifierMutation) SetName(s string) {
+func (m *SavedSearchMutation) SetName(s string) {
 	m.name = &s
 }
 
 // Name returns the value of the "name" field in the mutation.
-func (m *NotifierMutation) Name() (r string, exists bool) {
+func (m *SavedSearchMutation) Name() (r string, exists bool) {
 	v := m.name
 	if v == nil {
 		return
@@ -10196,10 +15695,10 @@ func (m *NotifierMutation) Name() (r string, exists bool) {
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Notifier entity.
-// If the Notifier object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the SavedSearch entity.
+// If the SavedSearch object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NotifierMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *SavedSearchMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
@@ -10214,97 +15713,73 @@ func (m *NotifierMutation) OldName(ctx context.Context) (v string, err error) {
 }
 
 // ResetName resets all changes to the "name" field.
-func (m *NotifierMutation) ResetName() {
+func (m *SavedSearchMutation) ResetName() {
 	m.name = nil
 }
 
-// SetURL sets the "url" field.
-func (m *NotifierMutation) SetURL(s string) {
-	m.url = &s
+// SetQuery sets the "query" field.
+func (m *SavedSearchMutation) SetQuery(tsf types.SavedSearchFilter) {
+	m.query = &tsf
 }
 
-// URL returns the value of the "url" field in the mutation.
-func (m *NotifierMutation) URL() (r string, exists bool) {
-	v := m.url
+// Query returns the value of the "query" field in the mutation.
+func (m *SavedSearchMutation) Query() (r types.SavedSearchFilter, exists bool) {
+	v := m.query
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldURL returns the old "url" field's value of the Notifier entity.
-// If the Notifier object wasn't provided to the builder, the object is fetched from the database.
+// OldQuery returns the old "query" field's value of the SavedSearch entity.
+// If the SavedSearch object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NotifierMutation) OldURL(ctx context.Context) (v string, err error) {
+func (m *SavedSearchMutation) OldQuery(ctx context.Context) (v types.SavedSearchFilter, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldURL is only allowed on UpdateOne operations")
+		return v, errors.New("OldQuery is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldURL requires an ID field in the mutation")
+		return v, errors.New("OldQuery requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldURL: %w", err)
-	}
-	return oldValue.URL, nil
-}
-
-// ResetURL resets all changes to the "url" field.
-func (m *NotifierMutation) ResetURL() {
-	m.url = nil
-}
-
-// SetIsActive sets the "is_active" field.
-func (m *NotifierMutation) SetIsActive(b bool) {
-	m.is_active = &b
-}
-
-// IsActive returns the value of the "is_active" field in the mutation.
-func (m *NotifierMutation) IsActive() (r bool, exists bool) {
-	v := m.is_active
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldQuery: %w", err)
 	}
-	return *v, true
+	return oldValue.Query, nil
 }
 
-// OldIsActive returns the old "is_active" field's value of the Notifier entity.
-// If the Notifier object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NotifierMutation) OldIsActive(ctx context.Context) (v bool, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsActive is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsActive requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsActive: %w", err)
-	}
-	return oldValue.IsActive, nil
+// ResetQuery resets all changes to the "query" field.
+func (m *SavedSearchMutation) ResetQuery() {
+	m.query = nil
 }
 
-// ResetIsActive resets all changes to the "is_active" field.
-func (m *NotifierMutation) ResetIsActive() {
-	m.is_active = nil
+// SetGroupID sets the "group" edge to the Group entity by id.
+func (m *SavedSearchMutation) SetGroupID(id uuid.UUID) {
+	m.group = &id
 }
 
 // ClearGroup clears the "group" edge to the Group entity.
-func (m *NotifierMutation) ClearGroup() {
+func (m *SavedSearchMutation) ClearGroup() {
 	m.clearedgroup = true
-	m.clearedFields[notifier.FieldGroupID] = struct{}{}
 }
 
 // GroupCleared reports if the "group" edge to the Group entity was cleared.
-func (m *NotifierMutation) GroupCleared() bool {
+func (m *SavedSearchMutation) GroupCleared() bool {
 	return m.clearedgroup
 }
 
+// GroupID returns the "group" edge ID in the mutation.
+func (m *SavedSearchMutation) GroupID() (id uuid.UUID, exists bool) {
+	if m.group != nil {
+		return *m.group, true
+	}
+	return
+}
+
 // GroupIDs returns the "group" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
 // GroupID instead. It exists only for internal usage by the builders.
-func (m *NotifierMutation) GroupIDs() (ids []uuid.UUID) {
+func (m *SavedSearchMutation) GroupIDs() (ids []uuid.UUID) {
 	if id := m.group; id != nil {
 		ids = append(ids, *id)
 	}
@@ -10312,47 +15787,20 @@ func (m *NotifierMutation) GroupIDs() (ids []uuid.UUID) {
 }
 
 // ResetGroup resets all changes to the "group" edge.
-func (m *NotifierMutation) ResetGroup() {
+func (m *SavedSearchMutation) ResetGroup() {
 	m.group = nil
 	m.clearedgroup = false
 }
 
-// ClearUser clears the "user" edge to the User entity.
-func (m *NotifierMutation) ClearUser() {
-	m.cleareduser = true
-	m.clearedFields[notifier.FieldUserID] = struct{}{}
-}
-
-// UserCleared reports if the "user" edge to the User entity was cleared.
-func (m *NotifierMutation) UserCleared() bool {
-	return m.cleareduser
-}
-
-// UserIDs returns the "user" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// UserID instead. It exists only for internal usage by the builders.
-func (m *NotifierMutation) UserIDs() (ids []uuid.UUID) {
-	if id := m.user; id != nil {
-		ids = append(ids, *id)
-	}
-	return
-}
-
-// ResetUser resets all changes to the "user" edge.
-func (m *NotifierMutation) ResetUser() {
-	m.user = nil
-	m.cleareduser = false
-}
-
-// Where appends a list predicates to the NotifierMutation builder.
-func (m *NotifierMutation) Where(ps ...predicate.Notifier) {
+// Where appends a list predicates to the SavedSearchMutation builder.
+func (m *SavedSearchMutation) Where(ps ...predicate.SavedSearch) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the NotifierMutation builder. Using this method,
+// WhereP appends storage-level predicates to the SavedSearchMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *NotifierMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Notifier, len(ps))
+func (m *SavedSearchMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.SavedSearch, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -10360,45 +15808,36 @@ func (m *NotifierMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *NotifierMutation) Op() Op {
+func (m *SavedSearchMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *NotifierMutation) SetOp(op Op) {
+func (m *SavedSearchMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Notifier).
-func (m *NotifierMutation) Type() string {
+// Type returns the node type of this mutation (SavedSearch).
+func (m *SavedSearchMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *NotifierMutation) Fields() []string {
-	fields := make([]string, 0, 7)
+func (m *SavedSearchMutation) Fields() []string {
+	fields := make([]string, 0, 4)
 	if m.created_at != nil {
-		fields = append(fields, notifier.FieldCreatedAt)
+		fields = append(fields, savedsearch.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, notifier.FieldUpdatedAt)
-	}
-	if m.group != nil {
-		fields = append(fields, notifier.FieldGroupID)
-	}
-	if m.user != nil {
-		fields = append(fields, notifier.FieldUserID)
+		fields = append(fields, savedsearch.FieldUpdatedAt)
 	}
 	if m.name != nil {
-		fields = append(fields, notifier.FieldName)
+		fields = append(fields, savedsearch.FieldName)
 	}
-	if m.url != nil {
-		fields = append(fields, notifier.FieldURL)
-	}
-	if m.is_active != nil {
-		fields = append(fields, notifier.FieldIsActive)
+	if m.query != nil {
+		fields = append(fields, savedsearch.FieldQuery)
 	}
 	return fields
 }
@@ -10406,22 +15845,16 @@ func (m *NotifierMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *NotifierMutation) Field(name string) (ent.Value, bool) {
+func (m *SavedSearchMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case notifier.FieldCreatedAt:
+	case savedsearch.FieldCreatedAt:
 		return m.CreatedAt()
-	case notifier.FieldUpdatedAt:
+	case savedsearch.FieldUpdatedAt:
 		return m.UpdatedAt()
-	case notifier.FieldGroupID:
-		return m.GroupID()
-	case notifier.FieldUserID:
-		return m.UserID()
-	case notifier.FieldName:
+	case savedsearch.FieldName:
 		return m.Name()
-	case notifier.FieldURL:
-		return m.URL()
-	case notifier.FieldIsActive:
-		return m.IsActive()
+	case savedsearch.FieldQuery:
+		return m.Query()
 	}
 	return nil, false
 }
@@ -10429,273 +15862,225 @@ func (m *NotifierMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *NotifierMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *SavedSearchMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case notifier.FieldCreatedAt:
+	case savedsearch.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case notifier.FieldUpdatedAt:
+	case savedsearch.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
-	case notifier.FieldGroupID:
-		return m.OldGroupID(ctx)
-	case notifier.FieldUserID:
-		return m.OldUserID(ctx)
-	case notifier.FieldName:
+	case savedsearch.FieldName:
 		return m.OldName(ctx)
-	case notifier.FieldURL:
-		return m.OldURL(ctx)
-	case notifier.FieldIsActive:
-		return m.OldIsActive(ctx)
+	case savedsearch.FieldQuery:
+		return m.OldQuery(ctx)
 	}
-	return nil, fmt.Errorf("unknown Notifier field %s", name)
+	return nil, fmt.Errorf("unknown SavedSearch field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *NotifierMutation) SetField(name string, value ent.Value) error {
+func (m *SavedSearchMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case notifier.FieldCreatedAt:
+	case savedsearch.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case notifier.FieldUpdatedAt:
+	case savedsearch.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdatedAt(v)
 		return nil
-	case notifier.FieldGroupID:
-		v, ok := value.(uuid.UUID)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetGroupID(v)
-		return nil
-	case notifier.FieldUserID:
-		v, ok := value.(uuid.UUID)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUserID(v)
-		return nil
-	case notifier.FieldName:
+	case savedsearch.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetName(v)
 		return nil
-	case notifier.FieldURL:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetURL(v)
-		return nil
-	case notifier.FieldIsActive:
-		v, ok := value.(bool)
+	case savedsearch.FieldQuery:
+		v, ok := value.(types.SavedSearchFilter)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetIsActive(v)
+		m.SetQuery(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Notifier field %s", name)
+	return fmt.Errorf("unknown SavedSearch field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *NotifierMutation) AddedFields() []string {
+func (m *SavedSearchMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *NotifierMutation) AddedField(name string) (ent.Value, bool) {
+func (m *SavedSearchMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *NotifierMutation) AddField(name string, value ent.Value) error {
+func (m *SavedSearchMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Notifier numeric field %s", name)
+	return fmt.Errorf("unknown SavedSearch numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *NotifierMutation) ClearedFields() []string {
+func (m *SavedSearchMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *NotifierMutation) FieldCleared(name string) bool {
+func (m *SavedSearchMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *NotifierMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Notifier nullable field %s", name)
+func (m *SavedSearchMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown SavedSearch nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *NotifierMutation) ResetField(name string) error {
+func (m *SavedSearchMutation) ResetField(name string) error {
 	switch name {
-	case notifier.FieldCreatedAt:
+	case savedsearch.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case notifier.FieldUpdatedAt:
+	case savedsearch.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
-	case notifier.FieldGroupID:
-		m.ResetGroupID()
-		return nil
-	case notifier.FieldUserID:
-		m.ResetUserID()
-		return nil
-	case notifier.FieldName:
+	case savedsearch.FieldName:
 		m.ResetName()
 		return nil
-	case notifier.FieldURL:
-		m.ResetURL()
-		return nil
-	case notifier.FieldIsActive:
-		m.ResetIsActive()
+	case savedsearch.FieldQuery:
+		m.ResetQuery()
 		return nil
 	}
-	return fmt.Errorf("unknown Notifier field %s", name)
+	return fmt.Errorf("unknown SavedSearch field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *NotifierMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
+func (m *SavedSearchMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
 	if m.group != nil {
-		edges = append(edges, notifier.EdgeGroup)
-	}
-	if m.user != nil {
-		edges = append(edges, notifier.EdgeUser)
+		edges = append(edges, savedsearch.EdgeGroup)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *NotifierMutation) AddedIDs(name string) []ent.Value {
+func (m *SavedSearchMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case notifier.EdgeGroup:
+	case savedsearch.EdgeGroup:
 		if id := m.group; id != nil {
 			return []ent.Value{*id}
 		}
-	case notifier.EdgeUser:
-		if id := m.user; id != nil {
-			return []ent.Value{*id}
-		}
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *NotifierMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
+func (m *SavedSearchMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *NotifierMutation) RemovedIDs(name string) []ent.Value {
+func (m *SavedSearchMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *NotifierMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
+func (m *SavedSearchMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
 	if m.clearedgroup {
-		edges = append(edges, notifier.EdgeGroup)
-	}
-	if m.cleareduser {
-		edges = append(edges, notifier.EdgeUser)
+		edges = append(edges, savedsearch.EdgeGroup)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *NotifierMutation) EdgeCleared(name string) bool {
+func (m *SavedSearchMutation) EdgeCleared(name string) bool {
 	switch name {
-	case notifier.EdgeGroup:
+	case savedsearch.EdgeGroup:
 		return m.clearedgroup
-	case notifier.EdgeUser:
-		return m.cleareduser
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *NotifierMutation) ClearEdge(name string) error {
+func (m *SavedSearchMutation) ClearEdge(name string) error {
 	switch name {
-	case notifier.EdgeGroup:
+	case savedsearch.EdgeGroup:
 		m.ClearGroup()
 		return nil
-	case notifier.EdgeUser:
-		m.ClearUser()
-		return nil
 	}
-	return fmt.Errorf("unknown Notifier unique edge %s", name)
+	return fmt.Errorf("unknown SavedSearch unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *NotifierMutation) ResetEdge(name string) error {
+func (m *SavedSearchMutation) ResetEdge(name string) error {
 	switch name {
-	case notifier.EdgeGroup:
+	case savedsearch.EdgeGroup:
 		m.ResetGroup()
 		return nil
-	case notifier.EdgeUser:
-		m.ResetUser()
-		return nil
 	}
-	return fmt.Errorf("unknown Notifier edge %s", name)
+	return fmt.Errorf("unknown SavedSearch edge %s", name)
 }
 
 // UserMutation represents an operation that mutates the User nodes in the graph.
 type UserMutation struct {
 	config
-	op                 Op
-	typ                string
-	id                 *uuid.UUID
-	created_at         *time.Time
-	updated_at         *time.Time
-	name               *string
-	email              *string
-	password           *string
-	is_superuser       *bool
-	superuser          *bool
-	role               *user.Role
-	activated_on       *time.Time
-	clearedFields      map[string]struct{}
-	group              *uuid.UUID
-	clearedgroup       bool
-	auth_tokens        map[uuid.UUID]struct{}
-	removedauth_tokens map[uuid.UUID]struct{}
-	clearedauth_tokens bool
-	notifiers          map[uuid.UUID]struct{}
-	removednotifiers   map[uuid.UUID]struct{}
-	clearednotifiers   bool
-	done               bool
-	oldValue           func(context.Context) (*User, error)
-	predicates         []predicate.User
+	op                   Op
+	typ                  string
+	id                   *uuid.UUID
+	created_at           *time.Time
+	updated_at           *time.Time
+	name                 *string
+	email                *string
+	password             *string
+	is_superuser         *bool
+	superuser            *bool
+	role                 *user.Role
+	activated_on         *time.Time
+	clearedFields        map[string]struct{}
+	group                *uuid.UUID
+	clearedgroup         bool
+	auth_tokens          map[uuid.UUID]struct{}
+	removedauth_tokens   map[uuid.UUID]struct{}
+	clearedauth_tokens   bool
+	notifiers            map[uuid.UUID]struct{}
+	removednotifiers     map[uuid.UUID]struct{}
+	clearednotifiers     bool
+	item_comments        map[uuid.UUID]struct{}
+	removeditem_comments map[uuid.UUID]struct{}
+	cleareditem_comments bool
+	item_events          map[uuid.UUID]struct{}
+	removeditem_events   map[uuid.UUID]struct{}
+	cleareditem_events   bool
+	done                 bool
+	oldValue             func(context.Context) (*User, error)
+	predicates           []predicate.User
 }
 
 var _ ent.Mutation = (*UserMutation)(nil)
@@ -11286,6 +16671,114 @@ func (m *UserMutation) ResetNotifiers() {
 	m.removednotifiers = nil
 }
 
+// AddItemCommentIDs adds the "item_comments" edge to the ItemComment entity by ids.
+func (m *UserMutation) AddItemCommentIDs(ids ...uuid.UUID) {
+	if m.item_comments == nil {
+		m.item_comments = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.item_comments[ids[i]] = struct{}{}
+	}
+}
+
+// ClearItemComments clears the "item_comments" edge to the ItemComment entity.
+func (m *UserMutation) ClearItemComments() {
+	m.cleareditem_comments = true
+}
+
+// ItemCommentsCleared reports if the "item_comments" edge to the ItemComment entity was cleared.
+func (m *UserMutation) ItemCommentsCleared() bool {
+	return m.cleareditem_comments
+}
+
+// RemoveItemCommentIDs removes the "item_comments" edge to the ItemComment entity by IDs.
+func (m *UserMutation) RemoveItemCommentIDs(ids ...uuid.UUID) {
+	if m.removeditem_comments == nil {
+		m.removeditem_comments = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.item_comments, ids[i])
+		m.removeditem_comments[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedItemComments returns the removed IDs of the "item_comments" edge to the ItemComment entity.
+func (m *UserMutation) RemovedItemCommentsIDs() (ids []uuid.UUID) {
+	for id := range m.removeditem_comments {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ItemCommentsIDs returns the "item_comments" edge IDs in the mutation.
+func (m *UserMutation) ItemCommentsIDs() (ids []uuid.UUID) {
+	for id := range m.item_comments {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetItemComments resets all changes to the "item_comments" edge.
+func (m *UserMutation) ResetItemComments() {
+	m.item_comments = nil
+	m.cleareditem_comments = false
+	m.removeditem_comments = nil
+}
+
+// AddItemEventIDs adds the "item_events" edge to the ItemEvent entity by ids.
+func (m *UserMutation) AddItemEventIDs(ids ...uuid.UUID) {
+	if m.item_events == nil {
+		m.item_events = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.item_events[ids[i]] = struct{}{}
+	}
+}
+
+// ClearItemEvents clears the "item_events" edge to the ItemEvent entity.
+func (m *UserMutation) ClearItemEvents() {
+	m.cleareditem_events = true
+}
+
+// ItemEventsCleared reports if the "item_events" edge to the ItemEvent entity was cleared.
+func (m *UserMutation) ItemEventsCleared() bool {
+	return m.cleareditem_events
+}
+
+// RemoveItemEventIDs removes the "item_events" edge to the ItemEvent entity by IDs.
+func (m *UserMutation) RemoveItemEventIDs(ids ...uuid.UUID) {
+	if m.removeditem_events == nil {
+		m.removeditem_events = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.item_events, ids[i])
+		m.removeditem_events[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedItemEvents returns the removed IDs of the "item_events" edge to the ItemEvent entity.
+func (m *UserMutation) RemovedItemEventsIDs() (ids []uuid.UUID) {
+	for id := range m.removeditem_events {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ItemEventsIDs returns the "item_events" edge IDs in the mutation.
+func (m *UserMutation) ItemEventsIDs() (ids []uuid.UUID) {
+	for id := range m.item_events {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetItemEvents resets all changes to the "item_events" edge.
+func (m *UserMutation) ResetItemEvents() {
+	m.item_events = nil
+	m.cleareditem_events = false
+	m.removeditem_events = nil
+}
+
 // Where appends a list predicates to the UserMutation builder.
 func (m *UserMutation) Where(ps ...predicate.User) {
 	m.predicates = append(m.predicates, ps...)
@@ -11564,7 +17057,7 @@ func (m *UserMutation) ResetField(name string) error {
 
 // AddedEdges returns all edge names that were set/added in this mutation.
 func (m *UserMutation) AddedEdges() []string {
-	edges := make([]string, 0, 3)
+	edges := make([]string, 0, 5)
 	if m.group != nil {
 		edges = append(edges, user.EdgeGroup)
 	}
@@ -11574,6 +17067,12 @@ func (m *UserMutation) AddedEdges() []string {
 	if m.notifiers != nil {
 		edges = append(edges, user.EdgeNotifiers)
 	}
+	if m.item_comments != nil {
+		edges = append(edges, user.EdgeItemComments)
+	}
+	if m.item_events != nil {
+		edges = append(edges, user.EdgeItemEvents)
+	}
 	return edges
 }
 
@@ -11597,19 +17096,37 @@ func (m *UserMutation) AddedIDs(name string) []ent.Value {
 			ids = append(ids, id)
 		}
 		return ids
+	case user.EdgeItemComments:
+		ids := make([]ent.Value, 0, len(m.item_comments))
+		for id := range m.item_comments {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeItemEvents:
+		ids := make([]ent.Value, 0, len(m.item_events))
+		for id := range m.item_events {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
 func (m *UserMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 3)
+	edges := make([]string, 0, 5)
 	if m.removedauth_tokens != nil {
 		edges = append(edges, user.EdgeAuthTokens)
 	}
 	if m.removednotifiers != nil {
 		edges = append(edges, user.EdgeNotifiers)
 	}
+	if m.removeditem_comments != nil {
+		edges = append(edges, user.EdgeItemComments)
+	}
+	if m.removeditem_events != nil {
+		edges = append(edges, user.EdgeItemEvents)
+	}
 	return edges
 }
 
@@ -11629,13 +17146,25 @@ func (m *UserMutation) RemovedIDs(name string) []ent.Value {
 			ids = append(ids, id)
 		}
 		return ids
+	case user.EdgeItemComments:
+		ids := make([]ent.Value, 0, len(m.removeditem_comments))
+		for id := range m.removeditem_comments {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeItemEvents:
+		ids := make([]ent.Value, 0, len(m.removeditem_events))
+		for id := range m.removeditem_events {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
 func (m *UserMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 3)
+	edges := make([]string, 0, 5)
 	if m.clearedgroup {
 		edges = append(edges, user.EdgeGroup)
 	}
@@ -11645,6 +17174,12 @@ func (m *UserMutation) ClearedEdges() []string {
 	if m.clearednotifiers {
 		edges = append(edges, user.EdgeNotifiers)
 	}
+	if m.cleareditem_comments {
+		edges = append(edges, user.EdgeItemComments)
+	}
+	if m.cleareditem_events {
+		edges = append(edges, user.EdgeItemEvents)
+	}
 	return edges
 }
 
@@ -11658,6 +17193,10 @@ func (m *UserMutation) EdgeCleared(name string) bool {
 		return m.clearedauth_tokens
 	case user.EdgeNotifiers:
 		return m.clearednotifiers
+	case user.EdgeItemComments:
+		return m.cleareditem_comments
+	case user.EdgeItemEvents:
+		return m.cleareditem_events
 	}
 	return false
 }
@@ -11686,6 +17225,12 @@ func (m *UserMutation) ResetEdge(name string) error {
 	case user.EdgeNotifiers:
 		m.ResetNotifiers()
 		return nil
+	case user.EdgeItemComments:
+		m.ResetItemComments()
+		return nil
+	case user.EdgeItemEvents:
+		m.ResetItemEvents()
+		return nil
 	}
 	return fmt.Errorf("unknown User edge %s", name)
 }
@@ -15,6 +15,9 @@ type AuthRoles func(*sql.Selector)
 // AuthTokens is the predicate function for authtokens builders.
 type AuthTokens func(*sql.Selector)
 
+// CurrencyConversion is the predicate function for currencyconversion builders.
+type CurrencyConversion func(*sql.Selector)
+
 // Document is the predicate function for document builders.
 type Document func(*sql.Selector)
 
@@ -27,9 +30,18 @@ type GroupInvitationToken func(*sql.Selector)
 // Item is the predicate function for item builders.
 type Item func(*sql.Selector)
 
+// ItemComment is the predicate function for itemcomment builders.
+type ItemComment func(*sql.Selector)
+
+// ItemEvent is the predicate function for itemevent builders.
+type ItemEvent func(*sql.Selector)
+
 // ItemField is the predicate function for itemfield builders.
 type ItemField func(*sql.Selector)
 
+// ItemTemplate is the predicate function for itemtemplate builders.
+type ItemTemplate func(*sql.Selector)
+
 // Label is the predicate function for label builders.
 type Label func(*sql.Selector)
 
@@ -42,5 +54,8 @@ type MaintenanceEntry func(*sql.Selector)
 // Notifier is the predicate function for notifier builders.
 type Notifier func(*sql.Selector)
 
+// SavedSearch is the predicate function for savedsearch builders.
+type SavedSearch func(*sql.Selector)
+
 // User is the predicate function for user builders.
 type User func(*sql.Selector)
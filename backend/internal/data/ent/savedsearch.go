@@ -0,0 +1,187 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/group"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/savedsearch"
+	"github.com/hay-kot/homebox/backend/internal/data/types"
+)
+
+// SavedSearch is the model entity for the SavedSearch schema.
+type SavedSearch struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Query holds the value of the "query" field.
+	Query types.SavedSearchFilter `json:"query,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the SavedSearchQuery when eager-loading is set.
+	Edges                SavedSearchEdges `json:"edges"`
+	group_saved_searches *uuid.UUID
+	selectValues         sql.SelectValues
+}
+
+// SavedSearchEdges holds the relations/edges for other nodes in the graph.
+type SavedSearchEdges struct {
+	// Group holds the value of the group edge.
+	Group *Group `json:"group,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// GroupOrErr returns the Group value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SavedSearchEdges) GroupOrErr() (*Group, error) {
+	if e.loadedTypes[0] {
+		if e.Group == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: group.Label}
+		}
+		return e.Group, nil
+	}
+	return nil, &NotLoadedError{edge: "group"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*SavedSearch) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case savedsearch.FieldQuery:
+			values[i] = new([]byte)
+		case savedsearch.FieldName:
+			values[i] = new(sql.NullString)
+		case savedsearch.FieldCreatedAt, savedsearch.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case savedsearch.FieldID:
+			values[i] = new(uuid.UUID)
+		case savedsearch.ForeignKeys[0]: // group_saved_searches
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the SavedSearch fields.
+func (ss *SavedSearch) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case savedsearch.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				ss.ID = *value
+			}
+		case savedsearch.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ss.CreatedAt = value.Time
+			}
+		case savedsearch.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				ss.UpdatedAt = value.Time
+			}
+		case savedsearch.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				ss.Name = value.String
+			}
+		case savedsearch.FieldQuery:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field query", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &ss.Query); err != nil {
+					return fmt.Errorf("unmarshal field query: %w", err)
+				}
+			}
+		case savedsearch.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field group_saved_searches", values[i])
+			} else if value.Valid {
+				ss.group_saved_searches = new(uuid.UUID)
+				*ss.group_saved_searches = *value.S.(*uuid.UUID)
+			}
+		default:
+			ss.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the SavedSearch.
+// This includes values selected through modifiers, order, etc.
+func (ss *SavedSearch) Value(name string) (ent.Value, error) {
+	return ss.selectValues.Get(name)
+}
+
+// QueryGroup queries the "group" edge of the SavedSearch entity.
+func (ss *SavedSearch) QueryGroup() *GroupQuery {
+	return NewSavedSearchClient(ss.config).QueryGroup(ss)
+}
+
+// Update returns a builder for updating this SavedSearch.
+// Note that you need to call SavedSearch.Unwrap() before calling this method if this SavedSearch
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ss *SavedSearch) Update() *SavedSearchUpdateOne {
+	return NewSavedSearchClient(ss.config).UpdateOne(ss)
+}
+
+// Unwrap unwraps the SavedSearch entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ss *SavedSearch) Unwrap() *SavedSearch {
+	_tx, ok := ss.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: SavedSearch is not a transactional entity")
+	}
+	ss.config.driver = _tx.drv
+	return ss
+}
+
+// String implements the fmt.Stringer.
+func (ss *SavedSearch) String() string {
+	var builder strings.Builder
+	builder.WriteString("SavedSearch(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ss.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(ss.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(ss.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(ss.Name)
+	builder.WriteString(", ")
+	builder.WriteString("query=")
+	builder.WriteString(fmt.Sprintf("%v", ss.Query))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SavedSearches is a parsable slice of SavedSearch.
+type SavedSearches []*SavedSearch
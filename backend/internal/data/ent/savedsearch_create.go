@@ -0,0 +1,315 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/group"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/savedsearch"
+	"github.com/hay-kot/homebox/backend/internal/data/types"
+)
+
+// SavedSearchCreate is the builder for creating a SavedSearch entity.
+type SavedSearchCreate struct {
+	config
+	mutation *SavedSearchMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (ssc *SavedSearchCreate) SetCreatedAt(t time.Time) *SavedSearchCreate {
+	ssc.mutation.SetCreatedAt(t)
+	return ssc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (ssc *SavedSearchCreate) SetNillableCreatedAt(t *time.Time) *SavedSearchCreate {
+	if t != nil {
+		ssc.SetCreatedAt(*t)
+	}
+	return ssc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ssc *SavedSearchCreate) SetUpdatedAt(t time.Time) *SavedSearchCreate {
+	ssc.mutation.SetUpdatedAt(t)
+	return ssc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (ssc *SavedSearchCreate) SetNillableUpdatedAt(t *time.Time) *SavedSearchCreate {
+	if t != nil {
+		ssc.SetUpdatedAt(*t)
+	}
+	return ssc
+}
+
+// SetName sets the "name" field.
+func (ssc *SavedSearchCreate) SetName(s string) *SavedSearchCreate {
+	ssc.mutation.SetName(s)
+	return ssc
+}
+
+// SetQuery sets the "query" field.
+func (ssc *SavedSearchCreate) SetQuery(tsf types.SavedSearchFilter) *SavedSearchCreate {
+	ssc.mutation.SetQuery(tsf)
+	return ssc
+}
+
+// SetID sets the "id" field.
+func (ssc *SavedSearchCreate) SetID(u uuid.UUID) *SavedSearchCreate {
+	ssc.mutation.SetID(u)
+	return ssc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (ssc *SavedSearchCreate) SetNillableID(u *uuid.UUID) *SavedSearchCreate {
+	if u != nil {
+		ssc.SetID(*u)
+	}
+	return ssc
+}
+
+// SetGroupID sets the "group" edge to the Group entity by ID.
+func (ssc *SavedSearchCreate) SetGroupID(id uuid.UUID) *SavedSearchCreate {
+	ssc.mutation.SetGroupID(id)
+	return ssc
+}
+
+// SetGroup sets the "group" edge to the Group entity.
+func (ssc *SavedSearchCreate) SetGroup(g *Group) *SavedSearchCreate {
+	return ssc.SetGroupID(g.ID)
+}
+
+// Mutation returns the SavedSearchMutation object of the builder.
+func (ssc *SavedSearchCreate) Mutation() *SavedSearchMutation {
+	return ssc.mutation
+}
+
+// Save creates the SavedSearch in the database.
+func (ssc *SavedSearchCreate) Save(ctx context.Context) (*SavedSearch, error) {
+	ssc.defaults()
+	return withHooks(ctx, ssc.sqlSave, ssc.mutation, ssc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ssc *SavedSearchCreate) SaveX(ctx context.Context) *SavedSearch {
+	v, err := ssc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ssc *SavedSearchCreate) Exec(ctx context.Context) error {
+	_, err := ssc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ssc *SavedSearchCreate) ExecX(ctx context.Context) {
+	if err := ssc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ssc *SavedSearchCreate) defaults() {
+	if _, ok := ssc.mutation.CreatedAt(); !ok {
+		v := savedsearch.DefaultCreatedAt()
+		ssc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := ssc.mutation.UpdatedAt(); !ok {
+		v := savedsearch.DefaultUpdatedAt()
+		ssc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := ssc.mutation.ID(); !ok {
+		v := savedsearch.DefaultID()
+		ssc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ssc *SavedSearchCreate) check() error {
+	if _, ok := ssc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "SavedSearch.created_at"`)}
+	}
+	if _, ok := ssc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "SavedSearch.updated_at"`)}
+	}
+	if _, ok := ssc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "SavedSearch.name"`)}
+	}
+	if v, ok := ssc.mutation.Name(); ok {
+		if err := savedsearch.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "SavedSearch.name": %w`, err)}
+		}
+	}
+	if _, ok := ssc.mutation.Query(); !ok {
+		return &ValidationError{Name: "query", err: errors.New(`ent: missing required field "SavedSearch.query"`)}
+	}
+	if _, ok := ssc.mutation.GroupID(); !ok {
+		return &ValidationError{Name: "group", err: errors.New(`ent: missing required edge "SavedSearch.group"`)}
+	}
+	return nil
+}
+
+func (ssc *SavedSearchCreate) sqlSave(ctx context.Context) (*SavedSearch, error) {
+	if err := ssc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := ssc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ssc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	ssc.mutation.id = &_node.ID
+	ssc.mutation.done = true
+	return _node, nil
+}
+
+func (ssc *SavedSearchCreate) createSpec() (*SavedSearch, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SavedSearch{config: ssc.config}
+		_spec = sqlgraph.NewCreateSpec(savedsearch.Table, sqlgraph.NewFieldSpec(savedsearch.FieldID, field.TypeUUID))
+	)
+	if id, ok := ssc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := ssc.mutation.CreatedAt(); ok {
+		_spec.SetField(savedsearch.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := ssc.mutation.UpdatedAt(); ok {
+		_spec.SetField(savedsearch.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := ssc.mutation.Name(); ok {
+		_spec.SetField(savedsearch.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := ssc.mutation.Query(); ok {
+		_spec.SetField(savedsearch.FieldQuery, field.TypeJSON, value)
+		_node.Query = value
+	}
+	if nodes := ssc.mutation.GroupIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   savedsearch.GroupTable,
+			Columns: []string{savedsearch.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.group_saved_searches = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// SavedSearchCreateBulk is the builder for creating many SavedSearch entities in bulk.
+type SavedSearchCreateBulk struct {
+	config
+	err      error
+	builders []*SavedSearchCreate
+}
+
+// Save creates the SavedSearch entities in the database.
+func (sscb *SavedSearchCreateBulk) Save(ctx context.Context) ([]*SavedSearch, error) {
+	if sscb.err != nil {
+		return nil, sscb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(sscb.builders))
+	nodes := make([]*SavedSearch, len(sscb.builders))
+	mutators := make([]Mutator, len(sscb.builders))
+	for i := range sscb.builders {
+		func(i int, root context.Context) {
+			builder := sscb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SavedSearchMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, sscb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, sscb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, sscb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sscb *SavedSearchCreateBulk) SaveX(ctx context.Context) []*SavedSearch {
+	v, err := sscb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sscb *SavedSearchCreateBulk) Exec(ctx context.Context) error {
+	_, err := sscb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sscb *SavedSearchCreateBulk) ExecX(ctx context.Context) {
+	if err := sscb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
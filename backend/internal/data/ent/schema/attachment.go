@@ -26,6 +26,10 @@ func (Attachment) Fields() []ent.Field {
 			Default("attachment"),
 		field.Bool("primary").
 			Default(false),
+		// date associated with the attached document, e.g. the expiration
+		// date of a warranty
+		field.Time("date").
+			Optional(),
 	}
 }
 
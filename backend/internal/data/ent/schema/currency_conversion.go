@@ -0,0 +1,33 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/schema/mixins"
+	"github.com/hay-kot/homebox/backend/internal/data/types"
+)
+
+// CurrencyConversion records a conversion of all the prices of a group to another
+// currency along with the original prices so the conversion can be audited or undone.
+type CurrencyConversion struct {
+	ent.Schema
+}
+
+func (CurrencyConversion) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixins.BaseMixin{},
+		GroupMixin{ref: "currency_conversions"},
+	}
+}
+
+// Fields of the CurrencyConversion.
+func (CurrencyConversion) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("from_currency").
+			MaxLen(3),
+		field.String("to_currency").
+			MaxLen(3),
+		field.Float("rate"),
+		field.JSON("original_prices", []types.PriceSnapshot{}),
+	}
+}
@@ -62,6 +62,15 @@ func (Group) Fields() []ent.Field {
 				"xau",
 				"zar",
 			),
+		field.UUID("default_location_id", uuid.UUID{}).
+			Optional().
+			Nillable(),
+		// depreciation used to estimate the current value of the group's items
+		field.Enum("depreciation_method").
+			Values("none", "straight_line", "declining_balance").
+			Default("none"),
+		field.Int("depreciation_years").
+			Default(0),
 	}
 }
 
@@ -82,6 +91,14 @@ func (Group) Edges() []ent.Edge {
 		owned("documents", Document.Type),
 		owned("invitation_tokens", GroupInvitationToken.Type),
 		owned("notifiers", Notifier.Type),
+		owned("currency_conversions", CurrencyConversion.Type),
+		owned("item_events", ItemEvent.Type),
+		owned("saved_searches", SavedSearch.Type),
+		owned("item_templates", ItemTemplate.Type),
+		// location new items are placed in when none is given
+		edge.To("default_location", Location.Type).
+			Field("default_location_id").
+			Unique(),
 		// $scaffold_edge
 	}
 }
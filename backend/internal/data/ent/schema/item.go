@@ -1,11 +1,15 @@
 package schema
 
 import (
+	"context"
+
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
+	gen "github.com/hay-kot/homebox/backend/internal/data/ent"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/hook"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/schema/mixins"
 )
 
@@ -29,6 +33,7 @@ func (Item) Indexes() []ent.Index {
 		index.Fields("manufacturer"),
 		index.Fields("model_number"),
 		index.Fields("serial_number"),
+		index.Fields("barcode"),
 		index.Fields("archived"),
 		index.Fields("asset_id"),
 	}
@@ -43,20 +48,53 @@ func (Item) Fields() []ent.Field {
 		field.String("notes").
 			MaxLen(1000).
 			Optional(),
+		// notes_format tells clients how to render the notes
+		field.Enum("notes_format").
+			Values("plain", "markdown").
+			Default("plain"),
 		field.Int("quantity").
 			Default(1),
 		field.Bool("insured").
 			Default(false),
 		field.Bool("archived").
 			Default(false),
+		field.Bool("favorite").
+			Default(false),
+		// version is incremented on every update and used for optimistic locking
+		field.Int("version").
+			Default(1),
+		// archived_at is set when the item is moved to the trash
+		field.Time("archived_at").
+			Optional().
+			Nillable(),
 		field.Int("asset_id").
 			Default(0),
+		field.Int("reorder_threshold").
+			Default(0),
+		// tags are free-text, stored trimmed and without duplicates
+		field.Strings("tags").
+			Optional(),
+		field.Enum("source").
+			Values("manual", "import", "api").
+			Default("manual"),
+		field.Enum("acquisition_type").
+			Values("bought", "gift", "inherited", "made", "found").
+			Default("bought"),
+		field.Float("latitude").
+			Optional().
+			Nillable(),
+		field.Float("longitude").
+			Optional().
+			Nillable(),
 
 		// ------------------------------------
 		// item identification
 		field.String("serial_number").
 			MaxLen(255).
 			Optional(),
+		field.String("barcode").
+			MaxLen(255).
+			Optional(),
 		field.String("model_number").
 			MaxLen(255).
 			Optional(),
@@ -80,8 +118,30 @@ func (Item) Fields() []ent.Field {
 			Optional(),
 		field.String("purchase_from").
 			Optional(),
+		field.String("purchase_order_number").
+			MaxLen(255).
+			Optional(),
 		field.Float("purchase_price").
 			Default(0),
+		// currency of the purchase and sold price, empty when it is the group's currency
+		field.String("currency").
+			MaxLen(3).
+			Optional(),
+		// current cost to replace the item, e.g. for insurance
+		field.Float("replacement_cost").
+			Default(0),
+
+		// ------------------------------------
+		// Loan, loaned_at is set while the item is checked out
+		field.String("loaned_to").
+			MaxLen(255).
+			Optional(),
+		field.Time("loaned_at").
+			Optional().
+			Nillable(),
+		field.Time("loan_due").
+			Optional().
+			Nillable(),
 
 		// ------------------------------------
 		// Sold Details
@@ -110,6 +170,7 @@ func (Item) Edges() []ent.Edge {
 		edge.To("children", Item.Type).
 			From("parent").
 			Unique(),
+		edge.To("related", Item.Type),
 		edge.From("label", Label.Type).
 			Ref("items"),
 		edge.From("location", Location.Type).
@@ -118,5 +179,27 @@ func (Item) Edges() []ent.Edge {
 		owned("fields", ItemField.Type),
 		owned("maintenance_entries", MaintenanceEntry.Type),
 		owned("attachments", Attachment.Type),
+		owned("comments", ItemComment.Type),
+	}
+}
+
+// Hooks of the Item.
+func (Item) Hooks() []ent.Hook {
+	return []ent.Hook{
+		// every update bumps the version used for optimistic locking, unless the mutation
+		// sets it explicitly
+		hook.On(
+			func(next ent.Mutator) ent.Mutator {
+				return hook.ItemFunc(func(ctx context.Context, m *gen.ItemMutation) (ent.Value, error) {
+					_, added := m.AddedVersion()
+					_, set := m.Version()
+					if !added && !set {
+						m.AddVersion(1)
+					}
+					return next.Mutate(ctx, m)
+				})
+			},
+			ent.OpUpdate|ent.OpUpdateOne,
+		),
 	}
 }
@@ -0,0 +1,53 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/schema/mixins"
+)
+
+// ItemComment holds the schema definition for the ItemComment entity. A
+// comment is a dated note appended to an item's running log.
+type ItemComment struct {
+	ent.Schema
+}
+
+func (ItemComment) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixins.BaseMixin{},
+	}
+}
+
+// Fields of the ItemComment.
+func (ItemComment) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("item_id", uuid.UUID{}),
+		field.String("content").
+			MaxLen(2500).
+			NotEmpty(),
+	}
+}
+
+// Edges of the ItemComment.
+func (ItemComment) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("item", Item.Type).
+			Field("item_id").
+			Ref("comments").
+			Required().
+			Unique(),
+		// author is optional so that comments outlive the user who wrote them
+		edge.From("author", User.Type).
+			Ref("item_comments").
+			Unique(),
+	}
+}
+
+func (ItemComment) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("item_id", "created_at"),
+	}
+}
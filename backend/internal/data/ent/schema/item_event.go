@@ -0,0 +1,52 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/schema/mixins"
+	"github.com/hay-kot/homebox/backend/internal/data/types"
+)
+
+// ItemEvent holds the schema definition for the ItemEvent entity. An event records
+// a change made to an item. Events reference the item by ID only so that the history
+// of an item outlives the item itself.
+type ItemEvent struct {
+	ent.Schema
+}
+
+func (ItemEvent) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixins.BaseMixin{},
+		GroupMixin{ref: "item_events"},
+	}
+}
+
+// Fields of the ItemEvent.
+func (ItemEvent) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("item_id", uuid.UUID{}),
+		field.Enum("type").
+			Values("adjustment", "create", "update", "delete"),
+		field.JSON("changes", map[string]types.FieldChange{}).
+			Optional(),
+	}
+}
+
+// Edges of the ItemEvent.
+func (ItemEvent) Edges() []ent.Edge {
+	return []ent.Edge{
+		// user is optional so that events outlive the user who made the change
+		edge.From("user", User.Type).
+			Ref("item_events").
+			Unique(),
+	}
+}
+
+func (ItemEvent) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("item_id", "created_at"),
+	}
+}
@@ -0,0 +1,31 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/schema/mixins"
+	"github.com/hay-kot/homebox/backend/internal/data/types"
+)
+
+// ItemTemplate holds the schema definition for the ItemTemplate entity. A template stores
+// the defaults of an item under a name so similar items can be created from it.
+type ItemTemplate struct {
+	ent.Schema
+}
+
+func (ItemTemplate) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixins.BaseMixin{},
+		GroupMixin{ref: "item_templates"},
+	}
+}
+
+// Fields of the ItemTemplate.
+func (ItemTemplate) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").
+			MaxLen(255).
+			NotEmpty(),
+		field.JSON("defaults", types.ItemTemplateDefaults{}),
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/schema/mixins"
 )
 
@@ -22,7 +23,12 @@ func (Location) Mixin() []ent.Mixin {
 
 // Fields of the Location.
 func (Location) Fields() []ent.Field {
-	return nil
+	return []ent.Field{
+		// number of items the location can hold, 0 when not defined
+		field.Int("capacity").
+			NonNegative().
+			Default(0),
+	}
 }
 
 // Edges of the Location.
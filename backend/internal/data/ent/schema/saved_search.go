@@ -0,0 +1,31 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/schema/mixins"
+	"github.com/hay-kot/homebox/backend/internal/data/types"
+)
+
+// SavedSearch holds the schema definition for the SavedSearch entity. A saved search
+// stores the filters of an item query under a name so it can be run again.
+type SavedSearch struct {
+	ent.Schema
+}
+
+func (SavedSearch) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixins.BaseMixin{},
+		GroupMixin{ref: "saved_searches"},
+	}
+}
+
+// Fields of the SavedSearch.
+func (SavedSearch) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").
+			MaxLen(255).
+			NotEmpty(),
+		field.JSON("query", types.SavedSearchFilter{}),
+	}
+}
@@ -59,6 +59,8 @@ func (User) Edges() []ent.Edge {
 			Annotations(entsql.Annotation{
 				OnDelete: entsql.Cascade,
 			}),
+		edge.To("item_comments", ItemComment.Type),
+		edge.To("item_events", ItemEvent.Type),
 	}
 }
 
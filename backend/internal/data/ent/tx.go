@@ -18,6 +18,8 @@ type Tx struct {
 	AuthRoles *AuthRolesClient
 	// AuthTokens is the client for interacting with the AuthTokens builders.
 	AuthTokens *AuthTokensClient
+	// CurrencyConversion is the client for interacting with the CurrencyConversion builders.
+	CurrencyConversion *CurrencyConversionClient
 	// Document is the client for interacting with the Document builders.
 	Document *DocumentClient
 	// Group is the client for interacting with the Group builders.
@@ -26,8 +28,14 @@ type Tx struct {
 	GroupInvitationToken *GroupInvitationTokenClient
 	// Item is the client for interacting with the Item builders.
 	Item *ItemClient
+	// ItemComment is the client for interacting with the ItemComment builders.
+	ItemComment *ItemCommentClient
+	// ItemEvent is the client for interacting with the ItemEvent builders.
+	ItemEvent *ItemEventClient
 	// ItemField is the client for interacting with the ItemField builders.
 	ItemField *ItemFieldClient
+	// ItemTemplate is the client for interacting with the ItemTemplate builders.
+	ItemTemplate *ItemTemplateClient
 	// Label is the client for interacting with the Label builders.
 	Label *LabelClient
 	// Location is the client for interacting with the Location builders.
@@ -36,6 +44,8 @@ type Tx struct {
 	MaintenanceEntry *MaintenanceEntryClient
 	// Notifier is the client for interacting with the Notifier builders.
 	Notifier *NotifierClient
+	// SavedSearch is the client for interacting with the SavedSearch builders.
+	SavedSearch *SavedSearchClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
 
@@ -172,15 +182,20 @@ func (tx *Tx) init() {
 	tx.Attachment = NewAttachmentClient(tx.config)
 	tx.AuthRoles = NewAuthRolesClient(tx.config)
 	tx.AuthTokens = NewAuthTokensClient(tx.config)
+	tx.CurrencyConversion = NewCurrencyConversionClient(tx.config)
 	tx.Document = NewDocumentClient(tx.config)
 	tx.Group = NewGroupClient(tx.config)
 	tx.GroupInvitationToken = NewGroupInvitationTokenClient(tx.config)
 	tx.Item = NewItemClient(tx.config)
+	tx.ItemComment = NewItemCommentClient(tx.config)
+	tx.ItemEvent = NewItemEventClient(tx.config)
 	tx.ItemField = NewItemFieldClient(tx.config)
+	tx.ItemTemplate = NewItemTemplateClient(tx.config)
 	tx.Label = NewLabelClient(tx.config)
 	tx.Location = NewLocationClient(tx.config)
 	tx.MaintenanceEntry = NewMaintenanceEntryClient(tx.config)
 	tx.Notifier = NewNotifierClient(tx.config)
+	tx.SavedSearch = NewSavedSearchClient(tx.config)
 	tx.User = NewUserClient(tx.config)
 }
 
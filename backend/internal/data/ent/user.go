@@ -52,9 +52,13 @@ type UserEdges struct {
 	AuthTokens []*AuthTokens `json:"auth_tokens,omitempty"`
 	// Notifiers holds the value of the notifiers edge.
 	Notifiers []*Notifier `json:"notifiers,omitempty"`
+	// ItemComments holds the value of the item_comments edge.
+	ItemComments []*ItemComment `json:"item_comments,omitempty"`
+	// ItemEvents holds the value of the item_events edge.
+	ItemEvents []*ItemEvent `json:"item_events,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [3]bool
+	loadedTypes [5]bool
 }
 
 // GroupOrErr returns the Group value or an error if the edge
@@ -88,6 +92,24 @@ func (e UserEdges) NotifiersOrErr() ([]*Notifier, error) {
 	return nil, &NotLoadedError{edge: "notifiers"}
 }
 
+// ItemCommentsOrErr returns the ItemComments value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) ItemCommentsOrErr() ([]*ItemComment, error) {
+	if e.loadedTypes[3] {
+		return e.ItemComments, nil
+	}
+	return nil, &NotLoadedError{edge: "item_comments"}
+}
+
+// ItemEventsOrErr returns the ItemEvents value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) ItemEventsOrErr() ([]*ItemEvent, error) {
+	if e.loadedTypes[4] {
+		return e.ItemEvents, nil
+	}
+	return nil, &NotLoadedError{edge: "item_events"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*User) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -213,6 +235,16 @@ func (u *User) QueryNotifiers() *NotifierQuery {
 	return NewUserClient(u.config).QueryNotifiers(u)
 }
 
+// QueryItemComments queries the "item_comments" edge of the User entity.
+func (u *User) QueryItemComments() *ItemCommentQuery {
+	return NewUserClient(u.config).QueryItemComments(u)
+}
+
+// QueryItemEvents queries the "item_events" edge of the User entity.
+func (u *User) QueryItemEvents() *ItemEventQuery {
+	return NewUserClient(u.config).QueryItemEvents(u)
+}
+
 // Update returns a builder for updating this User.
 // Note that you need to call User.Unwrap() before calling this method if this User
 // was returned from a transaction, and the transaction was committed or rolled back.
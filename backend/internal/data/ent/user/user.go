@@ -40,6 +40,10 @@ const (
 	EdgeAuthTokens = "auth_tokens"
 	// EdgeNotifiers holds the string denoting the notifiers edge name in mutations.
 	EdgeNotifiers = "notifiers"
+	// EdgeItemComments holds the string denoting the item_comments edge name in mutations.
+	EdgeItemComments = "item_comments"
+	// EdgeItemEvents holds the string denoting the item_events edge name in mutations.
+	EdgeItemEvents = "item_events"
 	// Table holds the table name of the user in the database.
 	Table = "users"
 	// GroupTable is the table that holds the group relation/edge.
@@ -63,6 +67,20 @@ const (
 	NotifiersInverseTable = "notifiers"
 	// NotifiersColumn is the table column denoting the notifiers relation/edge.
 	NotifiersColumn = "user_id"
+	// ItemCommentsTable is the table that holds the item_comments relation/edge.
+	ItemCommentsTable = "item_comments"
+	// ItemCommentsInverseTable is the table name for the ItemComment entity.
+	// It exists in this package in order to avoid circular dependency with the "itemcomment" package.
+	ItemCommentsInverseTable = "item_comments"
+	// ItemCommentsColumn is the table column denoting the item_comments relation/edge.
+	ItemCommentsColumn = "user_item_comments"
+	// ItemEventsTable is the table that holds the item_events relation/edge.
+	ItemEventsTable = "item_events"
+	// ItemEventsInverseTable is the table name for the ItemEvent entity.
+	// It exists in this package in order to avoid circular dependency with the "itemevent" package.
+	ItemEventsInverseTable = "item_events"
+	// ItemEventsColumn is the table column denoting the item_events relation/edge.
+	ItemEventsColumn = "user_item_events"
 )
 
 // Columns holds all SQL columns for user fields.
@@ -234,6 +252,34 @@ func ByNotifiers(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newNotifiersStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByItemCommentsCount orders the results by item_comments count.
+func ByItemCommentsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newItemCommentsStep(), opts...)
+	}
+}
+
+// ByItemComments orders the results by item_comments terms.
+func ByItemComments(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newItemCommentsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByItemEventsCount orders the results by item_events count.
+func ByItemEventsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newItemEventsStep(), opts...)
+	}
+}
+
+// ByItemEvents orders the results by item_events terms.
+func ByItemEvents(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newItemEventsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newGroupStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -255,3 +301,17 @@ func newNotifiersStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, NotifiersTable, NotifiersColumn),
 	)
 }
+func newItemCommentsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ItemCommentsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ItemCommentsTable, ItemCommentsColumn),
+	)
+}
+func newItemEventsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ItemEventsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ItemEventsTable, ItemEventsColumn),
+	)
+}
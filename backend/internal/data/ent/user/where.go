@@ -530,6 +530,52 @@ func HasNotifiersWith(preds ...predicate.Notifier) predicate.User {
 	})
 }
 
+// HasItemComments applies the HasEdge predicate on the "item_comments" edge.
+func HasItemComments() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ItemCommentsTable, ItemCommentsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasItemCommentsWith applies the HasEdge predicate on the "item_comments" edge with a given conditions (other predicates).
+func HasItemCommentsWith(preds ...predicate.ItemComment) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newItemCommentsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasItemEvents applies the HasEdge predicate on the "item_events" edge.
+func HasItemEvents() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ItemEventsTable, ItemEventsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasItemEventsWith applies the HasEdge predicate on the "item_events" edge with a given conditions (other predicates).
+func HasItemEventsWith(preds ...predicate.ItemEvent) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newItemEventsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.User) predicate.User {
 	return predicate.User(sql.AndPredicates(predicates...))
@@ -14,6 +14,8 @@ import (
 	"github.com/google/uuid"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/authtokens"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/group"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/itemcomment"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/itemevent"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/notifier"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/predicate"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/user"
@@ -22,14 +24,16 @@ import (
 // UserQuery is the builder for querying User entities.
 type UserQuery struct {
 	config
-	ctx            *QueryContext
-	order          []user.OrderOption
-	inters         []Interceptor
-	predicates     []predicate.User
-	withGroup      *GroupQuery
-	withAuthTokens *AuthTokensQuery
-	withNotifiers  *NotifierQuery
-	withFKs        bool
+	ctx              *QueryContext
+	order            []user.OrderOption
+	inters           []Interceptor
+	predicates       []predicate.User
+	withGroup        *GroupQuery
+	withAuthTokens   *AuthTokensQuery
+	withNotifiers    *NotifierQuery
+	withItemComments *ItemCommentQuery
+	withItemEvents   *ItemEventQuery
+	withFKs          bool
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -132,6 +136,50 @@ func (uq *UserQuery) QueryNotifiers() *NotifierQuery {
 	return query
 }
 
+// QueryItemComments chains the current query on the "item_comments" edge.
+func (uq *UserQuery) QueryItemComments() *ItemCommentQuery {
+	query := (&ItemCommentClient{config: uq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(itemcomment.Table, itemcomment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.ItemCommentsTable, user.ItemCommentsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryItemEvents chains the current query on the "item_events" edge.
+func (uq *UserQuery) QueryItemEvents() *ItemEventQuery {
+	query := (&ItemEventClient{config: uq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(itemevent.Table, itemevent.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.ItemEventsTable, user.ItemEventsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first User entity from the query.
 // Returns a *NotFoundError when no User was found.
 func (uq *UserQuery) First(ctx context.Context) (*User, error) {
@@ -319,14 +367,16 @@ func (uq *UserQuery) Clone() *UserQuery {
 		return nil
 	}
 	return &UserQuery{
-		config:         uq.config,
-		ctx:            uq.ctx.Clone(),
-		order:          append([]user.OrderOption{}, uq.order...),
-		inters:         append([]Interceptor{}, uq.inters...),
-		predicates:     append([]predicate.User{}, uq.predicates...),
-		withGroup:      uq.withGroup.Clone(),
-		withAuthTokens: uq.withAuthTokens.Clone(),
-		withNotifiers:  uq.withNotifiers.Clone(),
+		config:           uq.config,
+		ctx:              uq.ctx.Clone(),
+		order:            append([]user.OrderOption{}, uq.order...),
+		inters:           append([]Interceptor{}, uq.inters...),
+		predicates:       append([]predicate.User{}, uq.predicates...),
+		withGroup:        uq.withGroup.Clone(),
+		withAuthTokens:   uq.withAuthTokens.Clone(),
+		withNotifiers:    uq.withNotifiers.Clone(),
+		withItemComments: uq.withItemComments.Clone(),
+		withItemEvents:   uq.withItemEvents.Clone(),
 		// clone intermediate query.
 		sql:  uq.sql.Clone(),
 		path: uq.path,
@@ -366,6 +416,28 @@ func (uq *UserQuery) WithNotifiers(opts ...func(*NotifierQuery)) *UserQuery {
 	return uq
 }
 
+// WithItemComments tells the query-builder to eager-load the nodes that are connected to
+// the "item_comments" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithItemComments(opts ...func(*ItemCommentQuery)) *UserQuery {
+	query := (&ItemCommentClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withItemComments = query
+	return uq
+}
+
+// WithItemEvents tells the query-builder to eager-load the nodes that are connected to
+// the "item_events" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithItemEvents(opts ...func(*ItemEventQuery)) *UserQuery {
+	query := (&ItemEventClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withItemEvents = query
+	return uq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -445,10 +517,12 @@ func (uq *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, e
 		nodes       = []*User{}
 		withFKs     = uq.withFKs
 		_spec       = uq.querySpec()
-		loadedTypes = [3]bool{
+		loadedTypes = [5]bool{
 			uq.withGroup != nil,
 			uq.withAuthTokens != nil,
 			uq.withNotifiers != nil,
+			uq.withItemComments != nil,
+			uq.withItemEvents != nil,
 		}
 	)
 	if uq.withGroup != nil {
@@ -495,6 +569,20 @@ func (uq *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, e
 			return nil, err
 		}
 	}
+	if query := uq.withItemComments; query != nil {
+		if err := uq.loadItemComments(ctx, query, nodes,
+			func(n *User) { n.Edges.ItemComments = []*ItemComment{} },
+			func(n *User, e *ItemComment) { n.Edges.ItemComments = append(n.Edges.ItemComments, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withItemEvents; query != nil {
+		if err := uq.loadItemEvents(ctx, query, nodes,
+			func(n *User) { n.Edges.ItemEvents = []*ItemEvent{} },
+			func(n *User, e *ItemEvent) { n.Edges.ItemEvents = append(n.Edges.ItemEvents, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -591,6 +679,68 @@ func (uq *UserQuery) loadNotifiers(ctx context.Context, query *NotifierQuery, no
 	}
 	return nil
 }
+func (uq *UserQuery) loadItemComments(ctx context.Context, query *ItemCommentQuery, nodes []*User, init func(*User), assign func(*User, *ItemComment)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.ItemComment(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.ItemCommentsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.user_item_comments
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "user_item_comments" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_item_comments" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (uq *UserQuery) loadItemEvents(ctx context.Context, query *ItemEventQuery, nodes []*User, init func(*User), assign func(*User, *ItemEvent)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.ItemEvent(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.ItemEventsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.user_item_events
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "user_item_events" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_item_events" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (uq *UserQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := uq.querySpec()
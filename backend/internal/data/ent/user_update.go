@@ -14,6 +14,8 @@ import (
 	"github.com/google/uuid"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/authtokens"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/group"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/itemcomment"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/itemevent"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/notifier"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/predicate"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/user"
@@ -183,6 +185,36 @@ func (uu *UserUpdate) AddNotifiers(n ...*Notifier) *UserUpdate {
 	return uu.AddNotifierIDs(ids...)
 }
 
+// AddItemCommentIDs adds the "item_comments" edge to the ItemComment entity by IDs.
+func (uu *UserUpdate) AddItemCommentIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.AddItemCommentIDs(ids...)
+	return uu
+}
+
+// AddItemComments adds the "item_comments" edges to the ItemComment entity.
+func (uu *UserUpdate) AddItemComments(i ...*ItemComment) *UserUpdate {
+	ids := make([]uuid.UUID, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return uu.AddItemCommentIDs(ids...)
+}
+
+// AddItemEventIDs adds the "item_events" edge to the ItemEvent entity by IDs.
+func (uu *UserUpdate) AddItemEventIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.AddItemEventIDs(ids...)
+	return uu
+}
+
+// AddItemEvents adds the "item_events" edges to the ItemEvent entity.
+func (uu *UserUpdate) AddItemEvents(i ...*ItemEvent) *UserUpdate {
+	ids := make([]uuid.UUID, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return uu.AddItemEventIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (uu *UserUpdate) Mutation() *UserMutation {
 	return uu.mutation
@@ -236,6 +268,48 @@ func (uu *UserUpdate) RemoveNotifiers(n ...*Notifier) *UserUpdate {
 	return uu.RemoveNotifierIDs(ids...)
 }
 
+// ClearItemComments clears all "item_comments" edges to the ItemComment entity.
+func (uu *UserUpdate) ClearItemComments() *UserUpdate {
+	uu.mutation.ClearItemComments()
+	return uu
+}
+
+// RemoveItemCommentIDs removes the "item_comments" edge to ItemComment entities by IDs.
+func (uu *UserUpdate) RemoveItemCommentIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.RemoveItemCommentIDs(ids...)
+	return uu
+}
+
+// RemoveItemComments removes "item_comments" edges to ItemComment entities.
+func (uu *UserUpdate) RemoveItemComments(i ...*ItemComment) *UserUpdate {
+	ids := make([]uuid.UUID, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return uu.RemoveItemCommentIDs(ids...)
+}
+
+// ClearItemEvents clears all "item_events" edges to the ItemEvent entity.
+func (uu *UserUpdate) ClearItemEvents() *UserUpdate {
+	uu.mutation.ClearItemEvents()
+	return uu
+}
+
+// RemoveItemEventIDs removes the "item_events" edge to ItemEvent entities by IDs.
+func (uu *UserUpdate) RemoveItemEventIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.RemoveItemEventIDs(ids...)
+	return uu
+}
+
+// RemoveItemEvents removes "item_events" edges to ItemEvent entities.
+func (uu *UserUpdate) RemoveItemEvents(i ...*ItemEvent) *UserUpdate {
+	ids := make([]uuid.UUID, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return uu.RemoveItemEventIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (uu *UserUpdate) Save(ctx context.Context) (int, error) {
 	uu.defaults()
@@ -458,6 +532,96 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if uu.mutation.ItemCommentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ItemCommentsTable,
+			Columns: []string{user.ItemCommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemcomment.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedItemCommentsIDs(); len(nodes) > 0 && !uu.mutation.ItemCommentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ItemCommentsTable,
+			Columns: []string{user.ItemCommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemcomment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.ItemCommentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ItemCommentsTable,
+			Columns: []string{user.ItemCommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemcomment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.ItemEventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ItemEventsTable,
+			Columns: []string{user.ItemEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemevent.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedItemEventsIDs(); len(nodes) > 0 && !uu.mutation.ItemEventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ItemEventsTable,
+			Columns: []string{user.ItemEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemevent.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.ItemEventsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ItemEventsTable,
+			Columns: []string{user.ItemEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemevent.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, uu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{user.Label}
@@ -629,6 +793,36 @@ func (uuo *UserUpdateOne) AddNotifiers(n ...*Notifier) *UserUpdateOne {
 	return uuo.AddNotifierIDs(ids...)
 }
 
+// AddItemCommentIDs adds the "item_comments" edge to the ItemComment entity by IDs.
+func (uuo *UserUpdateOne) AddItemCommentIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.AddItemCommentIDs(ids...)
+	return uuo
+}
+
+// AddItemComments adds the "item_comments" edges to the ItemComment entity.
+func (uuo *UserUpdateOne) AddItemComments(i ...*ItemComment) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return uuo.AddItemCommentIDs(ids...)
+}
+
+// AddItemEventIDs adds the "item_events" edge to the ItemEvent entity by IDs.
+func (uuo *UserUpdateOne) AddItemEventIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.AddItemEventIDs(ids...)
+	return uuo
+}
+
+// AddItemEvents adds the "item_events" edges to the ItemEvent entity.
+func (uuo *UserUpdateOne) AddItemEvents(i ...*ItemEvent) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return uuo.AddItemEventIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (uuo *UserUpdateOne) Mutation() *UserMutation {
 	return uuo.mutation
@@ -682,6 +876,48 @@ func (uuo *UserUpdateOne) RemoveNotifiers(n ...*Notifier) *UserUpdateOne {
 	return uuo.RemoveNotifierIDs(ids...)
 }
 
+// ClearItemComments clears all "item_comments" edges to the ItemComment entity.
+func (uuo *UserUpdateOne) ClearItemComments() *UserUpdateOne {
+	uuo.mutation.ClearItemComments()
+	return uuo
+}
+
+// RemoveItemCommentIDs removes the "item_comments" edge to ItemComment entities by IDs.
+func (uuo *UserUpdateOne) RemoveItemCommentIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.RemoveItemCommentIDs(ids...)
+	return uuo
+}
+
+// RemoveItemComments removes "item_comments" edges to ItemComment entities.
+func (uuo *UserUpdateOne) RemoveItemComments(i ...*ItemComment) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return uuo.RemoveItemCommentIDs(ids...)
+}
+
+// ClearItemEvents clears all "item_events" edges to the ItemEvent entity.
+func (uuo *UserUpdateOne) ClearItemEvents() *UserUpdateOne {
+	uuo.mutation.ClearItemEvents()
+	return uuo
+}
+
+// RemoveItemEventIDs removes the "item_events" edge to ItemEvent entities by IDs.
+func (uuo *UserUpdateOne) RemoveItemEventIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.RemoveItemEventIDs(ids...)
+	return uuo
+}
+
+// RemoveItemEvents removes "item_events" edges to ItemEvent entities.
+func (uuo *UserUpdateOne) RemoveItemEvents(i ...*ItemEvent) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return uuo.RemoveItemEventIDs(ids...)
+}
+
 // Where appends a list predicates to the UserUpdate builder.
 func (uuo *UserUpdateOne) Where(ps ...predicate.User) *UserUpdateOne {
 	uuo.mutation.Where(ps...)
@@ -934,6 +1170,96 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if uuo.mutation.ItemCommentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ItemCommentsTable,
+			Columns: []string{user.ItemCommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemcomment.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedItemCommentsIDs(); len(nodes) > 0 && !uuo.mutation.ItemCommentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ItemCommentsTable,
+			Columns: []string{user.ItemCommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemcomment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.ItemCommentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ItemCommentsTable,
+			Columns: []string{user.ItemCommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemcomment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uuo.mutation.ItemEventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ItemEventsTable,
+			Columns: []string{user.ItemEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemevent.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedItemEventsIDs(); len(nodes) > 0 && !uuo.mutation.ItemEventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ItemEventsTable,
+			Columns: []string{user.ItemEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemevent.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.ItemEventsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ItemEventsTable,
+			Columns: []string{user.ItemEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemevent.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &User{config: uuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
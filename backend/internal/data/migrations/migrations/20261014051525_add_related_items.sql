@@ -0,0 +1,2 @@
+-- Create "item_related" table
+CREATE TABLE `item_related` (`item_id` uuid NOT NULL, `related_id` uuid NOT NULL, PRIMARY KEY (`item_id`, `related_id`), CONSTRAINT `item_related_item_id` FOREIGN KEY (`item_id`) REFERENCES `items` (`id`) ON DELETE CASCADE, CONSTRAINT `item_related_related_id` FOREIGN KEY (`related_id`) REFERENCES `items` (`id`) ON DELETE CASCADE);
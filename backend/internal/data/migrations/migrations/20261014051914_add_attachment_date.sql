@@ -0,0 +1,2 @@
+-- Add column "date" to table: "attachments"
+ALTER TABLE `attachments` ADD COLUMN `date` datetime NULL;
@@ -0,0 +1,4 @@
+-- Create "item_comments" table
+CREATE TABLE `item_comments` (`id` uuid NOT NULL, `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL, `content` text NOT NULL, `item_id` uuid NOT NULL, `user_item_comments` uuid NULL, PRIMARY KEY (`id`), CONSTRAINT `item_comments_items_comments` FOREIGN KEY (`item_id`) REFERENCES `items` (`id`) ON DELETE CASCADE, CONSTRAINT `item_comments_users_item_comments` FOREIGN KEY (`user_item_comments`) REFERENCES `users` (`id`) ON DELETE SET NULL);
+-- Create index "itemcomment_item_id_created_at" to table: "item_comments"
+CREATE INDEX `itemcomment_item_id_created_at` ON `item_comments` (`item_id`, `created_at`);
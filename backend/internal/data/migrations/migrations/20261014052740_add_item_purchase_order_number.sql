@@ -0,0 +1,2 @@
+-- Add column "purchase_order_number" to table: "items"
+ALTER TABLE `items` ADD COLUMN `purchase_order_number` text NULL;
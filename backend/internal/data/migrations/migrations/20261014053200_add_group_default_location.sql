@@ -0,0 +1,12 @@
+-- Disable the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = off;
+-- Create "new_groups" table
+CREATE TABLE `new_groups` (`id` uuid NOT NULL, `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL, `name` text NOT NULL, `currency` text NOT NULL DEFAULT ('usd'), `default_location_id` uuid NULL, PRIMARY KEY (`id`), CONSTRAINT `groups_locations_default_location` FOREIGN KEY (`default_location_id`) REFERENCES `locations` (`id`) ON DELETE SET NULL);
+-- Copy rows from old table "groups" to new temporary table "new_groups"
+INSERT INTO `new_groups` (`id`, `created_at`, `updated_at`, `name`, `currency`) SELECT `id`, `created_at`, `updated_at`, `name`, `currency` FROM `groups`;
+-- Drop "groups" table after copying rows
+DROP TABLE `groups`;
+-- Rename temporary table "new_groups" to "groups"
+ALTER TABLE `new_groups` RENAME TO `groups`;
+-- Enable back the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = on;
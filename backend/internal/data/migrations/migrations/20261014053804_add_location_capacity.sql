@@ -0,0 +1,12 @@
+-- Disable the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = off;
+-- Create "new_locations" table
+CREATE TABLE `new_locations` (`id` uuid NOT NULL, `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL, `name` text NOT NULL, `description` text NULL, `capacity` integer NOT NULL DEFAULT (0), `group_locations` uuid NOT NULL, `location_children` uuid NULL, PRIMARY KEY (`id`), CONSTRAINT `locations_groups_locations` FOREIGN KEY (`group_locations`) REFERENCES `groups` (`id`) ON DELETE CASCADE, CONSTRAINT `locations_locations_children` FOREIGN KEY (`location_children`) REFERENCES `locations` (`id`) ON DELETE SET NULL);
+-- Copy rows from old table "locations" to new temporary table "new_locations"
+INSERT INTO `new_locations` (`id`, `created_at`, `updated_at`, `name`, `description`, `group_locations`, `location_children`) SELECT `id`, `created_at`, `updated_at`, `name`, `description`, `group_locations`, `location_children` FROM `locations`;
+-- Drop "locations" table after copying rows
+DROP TABLE `locations`;
+-- Rename temporary table "new_locations" to "locations"
+ALTER TABLE `new_locations` RENAME TO `locations`;
+-- Enable back the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = on;
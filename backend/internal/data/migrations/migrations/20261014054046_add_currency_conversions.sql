@@ -0,0 +1,2 @@
+-- Create "currency_conversions" table
+CREATE TABLE `currency_conversions` (`id` uuid NOT NULL, `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL, `from_currency` text NOT NULL, `to_currency` text NOT NULL, `rate` real NOT NULL, `original_prices` json NOT NULL, `group_currency_conversions` uuid NOT NULL, PRIMARY KEY (`id`), CONSTRAINT `currency_conversions_groups_currency_conversions` FOREIGN KEY (`group_currency_conversions`) REFERENCES `groups` (`id`) ON DELETE CASCADE);
@@ -0,0 +1,4 @@
+-- Create "item_events" table
+CREATE TABLE `item_events` (`id` uuid NOT NULL, `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL, `item_id` uuid NOT NULL, `type` text NOT NULL, `changes` json NULL, `group_item_events` uuid NOT NULL, `user_item_events` uuid NULL, PRIMARY KEY (`id`), CONSTRAINT `item_events_groups_item_events` FOREIGN KEY (`group_item_events`) REFERENCES `groups` (`id`) ON DELETE CASCADE, CONSTRAINT `item_events_users_item_events` FOREIGN KEY (`user_item_events`) REFERENCES `users` (`id`) ON DELETE SET NULL);
+-- Create index "itemevent_item_id_created_at" to table: "item_events"
+CREATE INDEX `itemevent_item_id_created_at` ON `item_events` (`item_id`, `created_at`);
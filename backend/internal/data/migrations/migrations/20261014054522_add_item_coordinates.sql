@@ -0,0 +1,4 @@
+-- Add column "latitude" to table: "items"
+ALTER TABLE `items` ADD COLUMN `latitude` real NULL;
+-- Add column "longitude" to table: "items"
+ALTER TABLE `items` ADD COLUMN `longitude` real NULL;
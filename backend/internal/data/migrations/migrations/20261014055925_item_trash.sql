@@ -0,0 +1,2 @@
+-- Add column "archived_at" to table: "items"
+ALTER TABLE `items` ADD COLUMN `archived_at` datetime NULL;
@@ -0,0 +1,6 @@
+-- Add column "loaned_to" to table: "items"
+ALTER TABLE `items` ADD COLUMN `loaned_to` text NULL;
+-- Add column "loaned_at" to table: "items"
+ALTER TABLE `items` ADD COLUMN `loaned_at` datetime NULL;
+-- Add column "loan_due" to table: "items"
+ALTER TABLE `items` ADD COLUMN `loan_due` datetime NULL;
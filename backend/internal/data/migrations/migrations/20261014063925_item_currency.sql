@@ -0,0 +1,2 @@
+-- Add column "currency" to table: "items"
+ALTER TABLE `items` ADD COLUMN `currency` text NULL;
@@ -0,0 +1,2 @@
+-- Add column "tags" to table: "items"
+ALTER TABLE `items` ADD COLUMN `tags` json NULL;
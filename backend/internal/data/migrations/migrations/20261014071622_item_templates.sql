@@ -0,0 +1,2 @@
+-- Create "item_templates" table
+CREATE TABLE `item_templates` (`id` uuid NOT NULL, `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL, `name` text NOT NULL, `defaults` json NOT NULL, `group_item_templates` uuid NOT NULL, PRIMARY KEY (`id`), CONSTRAINT `item_templates_groups_item_templates` FOREIGN KEY (`group_item_templates`) REFERENCES `groups` (`id`) ON DELETE CASCADE);
@@ -42,6 +42,16 @@ func (aid AssetID) String() string {
 	return aidStr
 }
 
+// Format renders the asset ID zero-padded to at least 6 digits behind the prefix, e.g.
+// "HBX-000123" for the prefix "HBX-". An unset asset ID is rendered as an empty string.
+func (aid AssetID) Format(prefix string) string {
+	if aid.Nil() {
+		return ""
+	}
+
+	return fmt.Sprintf("%s%06d", prefix, aid.Int())
+}
+
 func (aid AssetID) MarshalJSON() ([]byte, error) {
 	return []byte(`"` + aid.String() + `"`), nil
 }
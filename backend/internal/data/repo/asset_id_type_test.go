@@ -113,3 +113,24 @@ func TestAssetID_UnmarshalJSON(t *testing.T) {
 		})
 	}
 }
+
+func TestAssetID_Format(t *testing.T) {
+	tests := []struct {
+		name   string
+		aid    AssetID
+		prefix string
+		want   string
+	}{
+		{name: "basic", aid: 123, prefix: "HBX-", want: "HBX-000123"},
+		{name: "no prefix", aid: 7, prefix: "", want: "000007"},
+		{name: "large int", aid: 123456789, prefix: "LAB", want: "LAB123456789"},
+		{name: "zero", aid: 0, prefix: "HBX-", want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.aid.Format(tt.prefix); got != tt.want {
+				t.Errorf("AssetID.Format() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
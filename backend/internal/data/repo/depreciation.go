@@ -0,0 +1,82 @@
+package repo
+
+import (
+	"errors"
+	"math"
+	"time"
+
+	"github.com/hay-kot/homebox/backend/internal/data/ent"
+)
+
+// DepreciationMethod is the method used to estimate the current value of an item from its
+// purchase price.
+type DepreciationMethod string
+
+const (
+	DepreciationNone             DepreciationMethod = "none"
+	DepreciationStraightLine     DepreciationMethod = "straight_line"
+	DepreciationDecliningBalance DepreciationMethod = "declining_balance"
+)
+
+var ErrInvalidDepreciation = errors.New("invalid depreciation method or useful life")
+
+// Valid reports whether the method is one of the supported depreciation methods.
+func (m DepreciationMethod) Valid() bool {
+	switch m {
+	case DepreciationNone, DepreciationStraightLine, DepreciationDecliningBalance:
+		return true
+	default:
+		return false
+	}
+}
+
+// ItemCurrentValue estimates the current value of the item by depreciating its purchase
+// price from the purchase time until now over usefulLifeYears. Items without a purchase
+// time, or without a valid method and useful life, are valued at their purchase price.
+func ItemCurrentValue(item ItemOut, method DepreciationMethod, usefulLifeYears int) float64 {
+	return itemValueAt(item, method, usefulLifeYears, time.Now())
+}
+
+func itemValueAt(item ItemOut, method DepreciationMethod, usefulLifeYears int, now time.Time) float64 {
+	price := item.PurchasePrice
+	if price <= 0 {
+		return 0
+	}
+
+	purchased := item.PurchaseTime.Time()
+	if purchased.IsZero() || usefulLifeYears <= 0 || now.Before(purchased) {
+		return price
+	}
+
+	// fractional years elapsed, using the average length of a year
+	elapsed := now.Sub(purchased).Hours() / (24 * 365.25)
+	life := float64(usefulLifeYears)
+
+	switch method {
+	case DepreciationStraightLine:
+		return price * math.Max(0, 1-elapsed/life)
+	case DepreciationDecliningBalance:
+		// double declining balance, the rate is capped so the value never goes negative
+		rate := math.Min(2/life, 1)
+		return price * math.Pow(1-rate, elapsed)
+	default:
+		return price
+	}
+}
+
+// mapDepreciatedValue returns the depreciated value of the item according to its group's
+// depreciation setting, or nil when the group or the setting isn't available.
+func mapDepreciatedValue(itm *ent.Item, out ItemOut) *float64 {
+	g := itm.Edges.Group
+	if g == nil {
+		return nil
+	}
+
+	method := DepreciationMethod(g.DepreciationMethod.String())
+	if method == DepreciationNone || g.DepreciationYears <= 0 {
+		return nil
+	}
+
+	v := ItemCurrentValue(out, method, g.DepreciationYears)
+	return &v
+}
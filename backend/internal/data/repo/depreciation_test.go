@@ -0,0 +1,97 @@
+package repo
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hay-kot/homebox/backend/internal/data/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestItemValueAt(t *testing.T) {
+	purchased := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	year := time.Duration(24*365.25) * time.Hour
+
+	itm := ItemOut{
+		ItemSummary:  ItemSummary{PurchasePrice: 1000},
+		PurchaseTime: types.DateFromTime(purchased),
+	}
+
+	tests := []struct {
+		name    string
+		method  DepreciationMethod
+		elapsed time.Duration
+		want    float64
+	}{
+		{name: "straight line at purchase", method: DepreciationStraightLine, elapsed: 0, want: 1000},
+		{name: "straight line half way", method: DepreciationStraightLine, elapsed: 2*year + year/2, want: 500},
+		{name: "straight line end of life", method: DepreciationStraightLine, elapsed: 5 * year, want: 0},
+		{name: "straight line past end of life", method: DepreciationStraightLine, elapsed: 8 * year, want: 0},
+		{name: "declining balance at purchase", method: DepreciationDecliningBalance, elapsed: 0, want: 1000},
+		{name: "declining balance one year", method: DepreciationDecliningBalance, elapsed: year, want: 600},
+		{name: "declining balance two years", method: DepreciationDecliningBalance, elapsed: 2 * year, want: 360},
+		{name: "no method", method: DepreciationNone, elapsed: 2 * year, want: 1000},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := itemValueAt(itm, tt.method, 5, purchased.Add(tt.elapsed))
+			assert.InDelta(t, tt.want, got, 0.01)
+		})
+	}
+}
+
+func TestItemValueAt_MissingPurchase(t *testing.T) {
+	now := time.Now()
+
+	// no purchase time, the purchase price is returned as is
+	itm := ItemOut{ItemSummary: ItemSummary{PurchasePrice: 250}}
+	assert.InDelta(t, 250.0, itemValueAt(itm, DepreciationStraightLine, 5, now), 0.001)
+
+	// no purchase price
+	itm = ItemOut{PurchaseTime: types.DateFromTime(now.AddDate(-2, 0, 0))}
+	assert.Zero(t, itemValueAt(itm, DepreciationStraightLine, 5, now))
+
+	// declining balance with a useful life of a year can't go negative
+	itm = ItemOut{
+		ItemSummary:  ItemSummary{PurchasePrice: 100},
+		PurchaseTime: types.DateFromTime(now.AddDate(-2, 0, 0)),
+	}
+	assert.Zero(t, itemValueAt(itm, DepreciationDecliningBalance, 1, now))
+}
+
+func TestItemsRepository_DepreciatedValue(t *testing.T) {
+	ctx := context.Background()
+
+	g, items := useGroupItems(t, ItemUpdate{
+		PurchasePrice: 1000,
+		PurchaseTime:  types.DateFromTime(time.Now().AddDate(-1, 0, 0)),
+	})
+	itm := items[0]
+	assert.Nil(t, itm.DepreciatedValue)
+
+	_, err := tRepos.Groups.SetDepreciation(ctx, g.ID, DepreciationStraightLine, 0)
+	assert.ErrorIs(t, err, ErrInvalidDepreciation)
+
+	_, err = tRepos.Groups.SetDepreciation(ctx, g.ID, DepreciationMethod("sum_of_years"), 5)
+	assert.ErrorIs(t, err, ErrInvalidDepreciation)
+
+	grp, err := tRepos.Groups.SetDepreciation(ctx, g.ID, DepreciationStraightLine, 4)
+	require.NoError(t, err)
+	assert.Equal(t, "straight_line", grp.DepreciationMethod)
+	assert.Equal(t, 4, grp.DepreciationYears)
+
+	itm, err = tRepos.Items.GetOneByGroup(ctx, g.ID, itm.ID)
+	require.NoError(t, err)
+	require.NotNil(t, itm.DepreciatedValue)
+	assert.InDelta(t, 750.0, *itm.DepreciatedValue, 5)
+
+	_, err = tRepos.Groups.SetDepreciation(ctx, g.ID, DepreciationNone, 0)
+	require.NoError(t, err)
+
+	itm, err = tRepos.Items.GetOneByGroup(ctx, g.ID, itm.ID)
+	require.NoError(t, err)
+	assert.Nil(t, itm.DepreciatedValue)
+}
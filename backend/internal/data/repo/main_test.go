@@ -8,6 +8,7 @@ import (
 
 	"github.com/hay-kot/homebox/backend/internal/core/services/reporting/eventbus"
 	"github.com/hay-kot/homebox/backend/internal/data/ent"
+	_ "github.com/hay-kot/homebox/backend/internal/data/ent/runtime" // schema defaults, validators and hooks
 	"github.com/hay-kot/homebox/backend/pkgs/faker"
 	_ "github.com/mattn/go-sqlite3"
 )
@@ -53,7 +54,7 @@ func TestMain(m *testing.M) {
 	}
 
 	tClient = client
-	tRepos = New(tClient, tbus, os.TempDir())
+	tRepos = New(tClient, tbus, os.TempDir(), nil)
 	defer client.Close()
 
 	bootstrap()
@@ -49,3 +49,12 @@ func mapEach[T any, U any](items []T, fn func(T) U) []U {
 	}
 	return result
 }
+
+// orEmpty returns the given slice or an empty, non-nil slice when it is nil so
+// that it is serialized as an empty JSON array.
+func orEmpty[T any](v []T) []T {
+	if v == nil {
+		return []T{}
+	}
+	return v
+}
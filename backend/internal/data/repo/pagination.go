@@ -1,5 +1,19 @@
 package repo
 
+import (
+	"encoding/base64"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrInvalidCursor   = errors.New("invalid cursor")
+	ErrInvalidPageSize = errors.New("page size must be a positive number")
+)
+
 type PaginationResult[T any] struct {
 	Page     int `json:"page"`
 	PageSize int `json:"pageSize"`
@@ -7,6 +21,68 @@ type PaginationResult[T any] struct {
 	Items    []T `json:"items"`
 }
 
+// CursorResult is a page of a keyset paginated query. NextCursor is empty on the last page.
+type CursorResult[T any] struct {
+	Items      []T    `json:"items"`
+	NextCursor string `json:"nextCursor"`
+}
+
+// PageBounds are the page size limits of offset paginated queries. Default is used when no
+// page size is given and Max caps the page size a client can request.
+type PageBounds struct {
+	Default int
+	Max     int
+}
+
+// DefaultPageBounds are the page bounds used unless configured otherwise.
+var DefaultPageBounds = PageBounds{Default: 50, Max: 500}
+
+// normalize returns the page and page size to query, pages start at 1.
+func (b PageBounds) normalize(page, pageSize int) (int, int) {
+	if page < 1 {
+		page = 1
+	}
+
+	switch {
+	case pageSize <= 0:
+		pageSize = b.Default
+	case pageSize > b.Max:
+		pageSize = b.Max
+	}
+
+	return page, pageSize
+}
+
 func calculateOffset(page, pageSize int) int {
 	return (page - 1) * pageSize
 }
+
+// encodeCursor returns an opaque cursor pointing at the row with the given creation time and ID.
+func encodeCursor(createdAt time.Time, id uuid.UUID) string {
+	raw := createdAt.Format(time.RFC3339Nano) + "|" + id.String()
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+func decodeCursor(cursor string) (time.Time, uuid.UUID, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return time.Time{}, uuid.Nil, ErrInvalidCursor
+	}
+
+	ts, idStr, ok := strings.Cut(string(raw), "|")
+	if !ok {
+		return time.Time{}, uuid.Nil, ErrInvalidCursor
+	}
+
+	createdAt, err := time.Parse(time.RFC3339Nano, ts)
+	if err != nil {
+		return time.Time{}, uuid.Nil, ErrInvalidCursor
+	}
+
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		return time.Time{}, uuid.Nil, ErrInvalidCursor
+	}
+
+	return createdAt, id, nil
+}
@@ -1,11 +1,24 @@
 package repo
 
-import "time"
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hay-kot/homebox/backend/internal/data/ent"
+)
 
 func sqliteDateFormat(t time.Time) string {
 	return t.Format("2006-01-02 15:04:05")
 }
 
+// today returns the start of the current day in UTC. Dates such as warranty expirations are
+// stored as midnight UTC, so a warranty expiring today hasn't expired yet.
+func today() time.Time {
+	now := time.Now().UTC()
+	return time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+}
+
 // orDefault returns the value of the pointer if it is not nil, otherwise it returns the default value
 //
 // This is used for nullable or potentially nullable fields (or aggregates) in the database when running
@@ -16,3 +29,29 @@ func orDefault[T any](v *T, def T) T {
 	}
 	return *v
 }
+
+// withTx runs fn within a transaction. The transaction is committed when fn returns
+// without an error and rolled back otherwise.
+func withTx(ctx context.Context, db *ent.Client, fn func(tx *ent.Tx) error) error {
+	tx, err := db.Tx(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if v := recover(); v != nil {
+			_ = tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	err = fn(tx)
+	if err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			return fmt.Errorf("%w: rolling back transaction: %v", err, rerr)
+		}
+		return err
+	}
+
+	return tx.Commit()
+}
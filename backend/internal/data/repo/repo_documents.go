@@ -101,6 +101,22 @@ func (r *DocumentRepository) Rename(ctx context.Context, id uuid.UUID, title str
 		Save(ctx))
 }
 
+// DeleteUnattached deletes the document and its file unless it is still attached to an item.
+// It reports whether the document was deleted.
+func (r *DocumentRepository) DeleteUnattached(ctx context.Context, id uuid.UUID) (bool, error) {
+	attached, err := r.db.Document.Query().
+		Where(
+			document.ID(id),
+			document.HasAttachments(),
+		).
+		Exist(ctx)
+	if err != nil || attached {
+		return false, err
+	}
+
+	return true, r.Delete(ctx, id)
+}
+
 func (r *DocumentRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	doc, err := r.db.Document.Get(ctx, id)
 	if err != nil {
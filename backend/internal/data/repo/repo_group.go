@@ -2,6 +2,11 @@ package repo
 
 import (
 	"context"
+	dbsql "database/sql"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
 	"strings"
 	"time"
 
@@ -11,8 +16,23 @@ import (
 	"github.com/hay-kot/homebox/backend/internal/data/ent/group"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/groupinvitationtoken"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/item"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/itemevent"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/label"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/location"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/predicate"
+	"github.com/hay-kot/homebox/backend/internal/data/types"
+)
+
+// maxNetWorthPoints bounds the number of intervals NetWorthTrend will compute.
+const maxNetWorthPoints = 1000
+
+// warrantyForecastMonths is the number of months, including the current one, covered by
+// WarrantyExpiryForecast.
+const warrantyForecastMonths = 12
+
+var (
+	ErrInvalidTrendRange   = errors.New("invalid trend range")
+	ErrInvalidExchangeRate = errors.New("exchange rate must be a positive number")
 )
 
 type GroupRepository struct {
@@ -29,6 +49,11 @@ func NewGroupRepository(db *ent.Client) *GroupRepository {
 			CreatedAt: g.CreatedAt,
 			UpdatedAt: g.UpdatedAt,
 			Currency:  strings.ToUpper(g.Currency.String()),
+
+			DefaultLocationID: g.DefaultLocationID,
+
+			DepreciationMethod: g.DepreciationMethod.String(),
+			DepreciationYears:  g.DepreciationYears,
 		}
 	}
 
@@ -55,6 +80,11 @@ type (
 		CreatedAt time.Time `json:"createdAt,omitempty"`
 		UpdatedAt time.Time `json:"updatedAt,omitempty"`
 		Currency  string    `json:"currency,omitempty"`
+
+		DefaultLocationID *uuid.UUID `json:"defaultLocationId,omitempty" extensions:"x-nullable,x-omitempty"`
+
+		DepreciationMethod string `json:"depreciationMethod"`
+		DepreciationYears  int    `json:"depreciationYears"`
 	}
 
 	GroupUpdate struct {
@@ -84,6 +114,14 @@ type (
 		TotalWithWarranty int     `json:"totalWithWarranty"`
 	}
 
+	PurchaseValueStats struct {
+		TotalItems    int     `json:"totalItems"`
+		TotalQuantity int     `json:"totalQuantity"`
+		TotalValue    float64 `json:"totalValue"`
+		InsuredValue  float64 `json:"insuredValue"`
+		TotalUnpriced int     `json:"totalUnpriced"`
+	}
+
 	ValueOverTimeEntry struct {
 		Date  time.Time `json:"date"`
 		Value float64   `json:"value"`
@@ -103,6 +141,40 @@ type (
 		Name  string    `json:"name"`
 		Total float64   `json:"total"`
 	}
+
+	LabelAvgPrice struct {
+		ID      uuid.UUID `json:"id"`
+		Name    string    `json:"name"`
+		Average float64   `json:"average"`
+	}
+
+	LabelQuantity struct {
+		ID    uuid.UUID `json:"id"`
+		Name  string    `json:"name"`
+		Total int       `json:"total"`
+	}
+
+	NetWorthPoint struct {
+		Date  time.Time `json:"date"`
+		Value float64   `json:"value"`
+	}
+
+	MonthCount struct {
+		Month string `json:"month"`
+		Count int    `json:"count"`
+	}
+
+	LocationValue struct {
+		ID    uuid.UUID `json:"id"`
+		Name  string    `json:"name"`
+		Count int       `json:"count"`
+	}
+
+	ManufacturerStat struct {
+		Manufacturer string  `json:"manufacturer"`
+		Count        int     `json:"count"`
+		Value        float64 `json:"value"`
+	}
 )
 
 func (r *GroupRepository) GetAllGroups(ctx context.Context) ([]Group, error) {
@@ -157,6 +229,76 @@ func (r *GroupRepository) StatsLabelsByPurchasePrice(ctx context.Context, GID uu
 	return v, err
 }
 
+// AvgPriceByLabel returns the average purchase price of the non-archived items of each label.
+// Items without a purchase price or priced in another currency than the group's are ignored
+// and labels without any priced items are omitted.
+func (r *GroupRepository) AvgPriceByLabel(ctx context.Context, GID uuid.UUID) ([]LabelAvgPrice, error) {
+	var v []LabelAvgPrice
+
+	err := r.db.Label.Query().
+		Where(
+			label.HasGroupWith(group.ID(GID)),
+		).
+		GroupBy(label.FieldID, label.FieldName).
+		Aggregate(func(sq *sql.Selector) string {
+			itemTable := sql.Table(item.Table)
+
+			jt := sql.Table(label.ItemsTable)
+
+			sq.Join(jt).On(sq.C(label.FieldID), jt.C(label.ItemsPrimaryKey[0]))
+			sq.Join(itemTable).On(jt.C(label.ItemsPrimaryKey[1]), itemTable.C(item.FieldID))
+			sq.Where(sql.And(
+				sql.GT(itemTable.C(item.FieldPurchasePrice), 0),
+				sql.EQ(itemTable.C(item.FieldArchived), false),
+				sql.ExprP(groupCurrencyCond(itemTable.C(item.FieldCurrency), itemTable.C(item.GroupColumn))),
+			))
+
+			return sql.As(sql.Avg(itemTable.C(item.FieldPurchasePrice)), "average")
+		}).
+		Scan(ctx, &v)
+	if err != nil {
+		return nil, err
+	}
+
+	return v, err
+}
+
+// QuantityByLabel returns the total quantity of the non-archived items of each label, ordered
+// by total descending. Items with multiple labels count towards each of them.
+func (r *GroupRepository) QuantityByLabel(ctx context.Context, GID uuid.UUID) ([]LabelQuantity, error) {
+	var v []LabelQuantity
+
+	err := r.db.Label.Query().
+		Where(
+			label.HasGroupWith(group.ID(GID)),
+		).
+		GroupBy(label.FieldID, label.FieldName).
+		Aggregate(func(sq *sql.Selector) string {
+			itemTable := sql.Table(item.Table)
+
+			jt := sql.Table(label.ItemsTable)
+
+			sq.Join(jt).On(sq.C(label.FieldID), jt.C(label.ItemsPrimaryKey[0]))
+			sq.Join(itemTable).On(jt.C(label.ItemsPrimaryKey[1]), itemTable.C(item.FieldID))
+			sq.Where(sql.EQ(itemTable.C(item.FieldArchived), false))
+
+			return sql.As(sql.Sum(itemTable.C(item.FieldQuantity)), "total")
+		}).
+		Scan(ctx, &v)
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(v, func(i, j int) bool {
+		if v[i].Total != v[j].Total {
+			return v[i].Total > v[j].Total
+		}
+		return v[i].Name < v[j].Name
+	})
+
+	return v, nil
+}
+
 func (r *GroupRepository) StatsPurchasePrice(ctx context.Context, GID uuid.UUID, start, end time.Time) (*ValueOverTime, error) {
 	// Get the Totals for the Start and End of the Given Time Period
 	q := `
@@ -226,6 +368,435 @@ func (r *GroupRepository) StatsPurchasePrice(ctx context.Context, GID uuid.UUID,
 	return &stats, nil
 }
 
+// NetWorthTrend returns the value of the items owned by the group at each interval between
+// from and to, inclusive of from. An item is owned from its purchase time, or from its creation
+// when it has no purchase time, until it's sold or archived. Items priced in another currency
+// than the group's are not counted.
+func (r *GroupRepository) NetWorthTrend(ctx context.Context, GID uuid.UUID, from, to time.Time, interval time.Duration) ([]NetWorthPoint, error) {
+	if interval <= 0 || to.Before(from) {
+		return nil, ErrInvalidTrendRange
+	}
+
+	if to.Sub(from)/interval >= maxNetWorthPoints {
+		return nil, fmt.Errorf("%w: more than %d intervals", ErrInvalidTrendRange, maxNetWorthPoints)
+	}
+
+	items, err := r.db.Item.Query().
+		Where(
+			item.HasGroupWith(group.ID(GID)),
+			itemInGroupCurrency(),
+		).
+		Select(
+			item.FieldCreatedAt,
+			item.FieldUpdatedAt,
+			item.FieldPurchaseTime,
+			item.FieldPurchasePrice,
+			item.FieldSoldTime,
+			item.FieldQuantity,
+			item.FieldArchived,
+			item.FieldArchivedAt,
+		).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	archived, err := r.archivedTimes(ctx, GID, items)
+	if err != nil {
+		return nil, err
+	}
+
+	points := make([]NetWorthPoint, 0, to.Sub(from)/interval+1)
+	for t := from; !t.After(to); t = t.Add(interval) {
+		point := NetWorthPoint{Date: t}
+
+		for _, itm := range items {
+			acquired := itm.PurchaseTime
+			if acquired.IsZero() {
+				acquired = itm.CreatedAt
+			}
+
+			if acquired.After(t) {
+				continue
+			}
+
+			if !itm.SoldTime.IsZero() && !itm.SoldTime.After(t) {
+				continue
+			}
+
+			if at, ok := archived[itm.ID]; ok && !at.After(t) {
+				continue
+			}
+
+			point.Value += itm.PurchasePrice * float64(itm.Quantity)
+		}
+
+		points = append(points, point)
+	}
+
+	return points, nil
+}
+
+// archivedTimes returns when each of the archived items was archived. That's the update
+// event that last archived the item, or the time it was moved to the trash when it wasn't
+// archived before. Items archived before their history was recorded fall back to their last
+// update.
+func (r *GroupRepository) archivedTimes(ctx context.Context, GID uuid.UUID, items []*ent.Item) (map[uuid.UUID]time.Time, error) {
+	ids := make([]uuid.UUID, 0)
+	for _, itm := range items {
+		if itm.Archived {
+			ids = append(ids, itm.ID)
+		}
+	}
+
+	times := map[uuid.UUID]time.Time{}
+	if len(ids) == 0 {
+		return times, nil
+	}
+
+	events, err := r.db.ItemEvent.Query().
+		Where(
+			itemevent.HasGroupWith(group.ID(GID)),
+			itemevent.ItemIDIn(ids...),
+			itemevent.TypeEQ(itemevent.TypeUpdate),
+		).
+		Order(ent.Asc(itemevent.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, ev := range events {
+		change, ok := ev.Changes[item.FieldArchived]
+		if !ok {
+			continue
+		}
+
+		if change.New == true {
+			times[ev.ItemID] = ev.CreatedAt
+		} else {
+			delete(times, ev.ItemID)
+		}
+	}
+
+	for _, itm := range items {
+		if !itm.Archived {
+			continue
+		}
+
+		if _, ok := times[itm.ID]; ok {
+			continue
+		}
+
+		if itm.ArchivedAt != nil {
+			times[itm.ID] = *itm.ArchivedAt
+		} else {
+			times[itm.ID] = itm.UpdatedAt
+		}
+	}
+
+	return times, nil
+}
+
+// WarrantyExpiryForecast returns the number of warranties expiring in each month, formatted
+// as YYYY-MM, starting with the current month. Lifetime warranties and warranties that have
+// already expired are not counted. Every month is present, even if nothing expires in it.
+func (r *GroupRepository) WarrantyExpiryForecast(ctx context.Context, GID uuid.UUID) ([]MonthCount, error) {
+	today := today()
+	start := time.Date(today.Year(), today.Month(), 1, 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(0, warrantyForecastMonths, 0)
+
+	items, err := r.db.Item.Query().
+		Where(
+			item.HasGroupWith(group.ID(GID)),
+			item.Archived(false),
+			item.LifetimeWarranty(false),
+			item.WarrantyExpiresGTE(today),
+			item.WarrantyExpiresLT(end),
+		).
+		Select(item.FieldWarrantyExpires).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	months := make([]MonthCount, warrantyForecastMonths)
+	for i := range months {
+		months[i].Month = start.AddDate(0, i, 0).Format("2006-01")
+	}
+
+	for _, itm := range items {
+		expires := itm.WarrantyExpires.UTC()
+		i := (expires.Year()-start.Year())*12 + int(expires.Month()-start.Month())
+		months[i].Count++
+	}
+
+	return months, nil
+}
+
+// WarrantyCounts classifies the non-archived items of the group by their warranty. Every
+// item is counted in exactly one bucket, a lifetime warranty takes precedence over an
+// expiration date and items with neither are counted as none.
+func (r *GroupRepository) WarrantyCounts(ctx context.Context, GID uuid.UUID) (active, expired, lifetime, none int, err error) {
+	today := today()
+
+	count := func(where ...predicate.Item) (int, error) {
+		return r.db.Item.Query().
+			Where(
+				item.HasGroupWith(group.ID(GID)),
+				item.Archived(false),
+			).
+			Where(where...).
+			Count(ctx)
+	}
+
+	total, err := count()
+	if err != nil {
+		return 0, 0, 0, 0, err
+	}
+
+	lifetime, err = count(item.LifetimeWarranty(true))
+	if err != nil {
+		return 0, 0, 0, 0, err
+	}
+
+	active, err = count(
+		item.LifetimeWarranty(false),
+		item.WarrantyExpiresGTE(today),
+	)
+	if err != nil {
+		return 0, 0, 0, 0, err
+	}
+
+	// unset expiration dates are stored as the zero time
+	expired, err = count(
+		item.LifetimeWarranty(false),
+		item.WarrantyExpiresGT(time.Time{}),
+		item.WarrantyExpiresLT(today),
+	)
+	if err != nil {
+		return 0, 0, 0, 0, err
+	}
+
+	return active, expired, lifetime, total - active - expired - lifetime, nil
+}
+
+// StatsPurchaseValue returns the item counts and purchase value totals of the group's
+// non-archived items, accounting for their quantity. Only the items priced in the group's
+// currency are summed into the values. The totals are computed by the database so that it
+// is cheap enough to call on every dashboard load.
+func (r *GroupRepository) StatsPurchaseValue(ctx context.Context, GID uuid.UUID) (PurchaseValueStats, error) {
+	var v []struct {
+		Count    int      `json:"count"`
+		Quantity *int     `json:"quantity"`
+		Value    *float64 `json:"value"`
+		Insured  *float64 `json:"insured"`
+		Unpriced *int     `json:"unpriced"`
+	}
+
+	err := r.db.Item.Query().
+		Where(
+			item.HasGroupWith(group.ID(GID)),
+			item.Archived(false),
+		).
+		Aggregate(
+			ent.As(ent.Count(), "count"),
+			ent.As(ent.Sum(item.FieldQuantity), "quantity"),
+			func(s *sql.Selector) string {
+				cond := groupCurrencyCond(s.C(item.FieldCurrency), s.C(item.GroupColumn))
+				expr := fmt.Sprintf("SUM(CASE WHEN %s THEN %s * %s ELSE 0 END)", cond, s.C(item.FieldPurchasePrice), s.C(item.FieldQuantity))
+				return sql.As(expr, "value")
+			},
+			func(s *sql.Selector) string {
+				cond := groupCurrencyCond(s.C(item.FieldCurrency), s.C(item.GroupColumn))
+				expr := fmt.Sprintf("SUM(CASE WHEN %s AND %s THEN %s * %s ELSE 0 END)", s.C(item.FieldInsured), cond, s.C(item.FieldPurchasePrice), s.C(item.FieldQuantity))
+				return sql.As(expr, "insured")
+			},
+			func(s *sql.Selector) string {
+				expr := fmt.Sprintf("SUM(CASE WHEN %s = 0 THEN 1 ELSE 0 END)", s.C(item.FieldPurchasePrice))
+				return sql.As(expr, "unpriced")
+			},
+		).
+		Scan(ctx, &v)
+	if err != nil {
+		return PurchaseValueStats{}, err
+	}
+
+	if len(v) == 0 {
+		return PurchaseValueStats{}, nil
+	}
+
+	return PurchaseValueStats{
+		TotalItems:    v[0].Count,
+		TotalQuantity: orDefault(v[0].Quantity, 0),
+		TotalValue:    orDefault(v[0].Value, 0),
+		InsuredValue:  orDefault(v[0].Insured, 0),
+		TotalUnpriced: orDefault(v[0].Unpriced, 0),
+	}, nil
+}
+
+// TotalQuantity returns the number of physical things in the group, the sum of the quantity of
+// its non-archived items. Unlike the TotalItems of GroupStatistics an item with a quantity of
+// 5 is counted 5 times.
+func (r *GroupRepository) TotalQuantity(ctx context.Context, GID uuid.UUID) (int, error) {
+	var v []struct {
+		Quantity *int `json:"quantity"`
+	}
+
+	err := r.db.Item.Query().
+		Where(
+			item.HasGroupWith(group.ID(GID)),
+			item.Archived(false),
+		).
+		Aggregate(ent.As(ent.Sum(item.FieldQuantity), "quantity")).
+		Scan(ctx, &v)
+	if err != nil || len(v) == 0 {
+		return 0, err
+	}
+
+	return orDefault(v[0].Quantity, 0), nil
+}
+
+// StatsPurchaseValueByCurrency is StatsPurchaseValue with the totals segmented by the
+// upper case currency of the items, so that prices in different currencies aren't summed.
+// Items without a currency of their own are counted in the group's currency.
+func (r *GroupRepository) StatsPurchaseValueByCurrency(ctx context.Context, GID uuid.UUID) (map[string]PurchaseValueStats, error) {
+	g, err := r.db.Group.Get(ctx, GID)
+	if err != nil {
+		return nil, err
+	}
+
+	var v []struct {
+		Currency *string  `json:"currency"`
+		Count    int      `json:"count"`
+		Quantity *int     `json:"quantity"`
+		Value    *float64 `json:"value"`
+		Insured  *float64 `json:"insured"`
+		Unpriced *int     `json:"unpriced"`
+	}
+
+	err = r.db.Item.Query().
+		Where(
+			item.HasGroupWith(group.ID(GID)),
+			item.Archived(false),
+		).
+		GroupBy(item.FieldCurrency).
+		Aggregate(
+			ent.As(ent.Count(), "count"),
+			ent.As(ent.Sum(item.FieldQuantity), "quantity"),
+			func(s *sql.Selector) string {
+				expr := fmt.Sprintf("SUM(%s * %s)", s.C(item.FieldPurchasePrice), s.C(item.FieldQuantity))
+				return sql.As(expr, "value")
+			},
+			func(s *sql.Selector) string {
+				expr := fmt.Sprintf("SUM(CASE WHEN %s THEN %s * %s ELSE 0 END)", s.C(item.FieldInsured), s.C(item.FieldPurchasePrice), s.C(item.FieldQuantity))
+				return sql.As(expr, "insured")
+			},
+			func(s *sql.Selector) string {
+				expr := fmt.Sprintf("SUM(CASE WHEN %s = 0 THEN 1 ELSE 0 END)", s.C(item.FieldPurchasePrice))
+				return sql.As(expr, "unpriced")
+			},
+		).
+		Scan(ctx, &v)
+	if err != nil {
+		return nil, err
+	}
+
+	stats := make(map[string]PurchaseValueStats, len(v))
+
+	for _, row := range v {
+		currency := strings.ToUpper(orDefault(row.Currency, ""))
+		if currency == "" {
+			currency = strings.ToUpper(g.Currency.String())
+		}
+
+		// items without a currency and items set to the group's currency share a row
+		s := stats[currency]
+		s.TotalItems += row.Count
+		s.TotalQuantity += orDefault(row.Quantity, 0)
+		s.TotalValue += orDefault(row.Value, 0)
+		s.InsuredValue += orDefault(row.Insured, 0)
+		s.TotalUnpriced += orDefault(row.Unpriced, 0)
+		stats[currency] = s
+	}
+
+	return stats, nil
+}
+
+// unknownManufacturer is the name items without a manufacturer are grouped under.
+const unknownManufacturer = "(unknown)"
+
+// ManufacturerBreakdown returns the number of non-archived items and their purchase value
+// per manufacturer, most items first. Items without a manufacturer are grouped under
+// "(unknown)". The value only sums the items priced in the group's currency.
+func (r *GroupRepository) ManufacturerBreakdown(ctx context.Context, GID uuid.UUID) ([]ManufacturerStat, error) {
+	var v []struct {
+		Manufacturer *string  `json:"manufacturer"`
+		Count        int      `json:"count"`
+		Value        *float64 `json:"value"`
+	}
+
+	err := r.db.Item.Query().
+		Where(
+			item.HasGroupWith(group.ID(GID)),
+			item.Archived(false),
+		).
+		GroupBy(item.FieldManufacturer).
+		Aggregate(
+			ent.As(ent.Count(), "count"),
+			func(s *sql.Selector) string {
+				expr := fmt.Sprintf("SUM(CASE WHEN %s THEN %s * %s ELSE 0 END)",
+					groupCurrencyCond(s.C(item.FieldCurrency), s.C(item.GroupColumn)),
+					s.C(item.FieldPurchasePrice),
+					s.C(item.FieldQuantity),
+				)
+				return sql.As(expr, "value")
+			},
+		).
+		Scan(ctx, &v)
+	if err != nil {
+		return nil, err
+	}
+
+	stats := make([]ManufacturerStat, 0, len(v))
+	unknown := -1
+
+	for _, row := range v {
+		name := orDefault(row.Manufacturer, "")
+		if strings.TrimSpace(name) == "" {
+			name = unknownManufacturer
+		}
+
+		// items without a manufacturer and items with an empty one share a row
+		if name == unknownManufacturer && unknown >= 0 {
+			stats[unknown].Count += row.Count
+			stats[unknown].Value += orDefault(row.Value, 0)
+			continue
+		}
+
+		if name == unknownManufacturer {
+			unknown = len(stats)
+		}
+
+		stats = append(stats, ManufacturerStat{
+			Manufacturer: name,
+			Count:        row.Count,
+			Value:        orDefault(row.Value, 0),
+		})
+	}
+
+	sort.SliceStable(stats, func(i, j int) bool {
+		if stats[i].Count != stats[j].Count {
+			return stats[i].Count > stats[j].Count
+		}
+		return stats[i].Manufacturer < stats[j].Manufacturer
+	})
+
+	return stats, nil
+}
+
+// StatsGroup returns the totals of the group. The total item price only sums the items
+// priced in the group's currency.
 func (r *GroupRepository) StatsGroup(ctx context.Context, GID uuid.UUID) (GroupStatistics, error) {
 	q := `
 		SELECT
@@ -233,7 +804,7 @@ func (r *GroupRepository) StatsGroup(ctx context.Context, GID uuid.UUID) (GroupS
 			(SELECT COUNT(*) FROM items WHERE group_items = ? AND items.archived = false) AS total_items,
 			(SELECT COUNT(*) FROM locations WHERE group_locations = ?) AS total_locations,
 			(SELECT COUNT(*) FROM labels WHERE group_labels = ?) AS total_labels,
-			(SELECT SUM(purchase_price*quantity) FROM items WHERE group_items = ? AND items.archived = false) AS total_item_price,
+			(SELECT SUM(purchase_price*quantity) FROM items WHERE group_items = ? AND items.archived = false AND ` + groupCurrencyCond("items.currency", "items.group_items") + `) AS total_item_price,
 			(SELECT COUNT(*)
 				FROM items
 					WHERE group_items = ?
@@ -258,6 +829,37 @@ func (r *GroupRepository) StatsGroup(ctx context.Context, GID uuid.UUID) (GroupS
 	return stats, nil
 }
 
+// BusiestLocation returns the location holding the most non-archived items in the group,
+// ties are broken by name. If none of the group's items are in a location, a not found
+// error is returned.
+func (r *GroupRepository) BusiestLocation(ctx context.Context, GID uuid.UUID) (LocationValue, error) {
+	q := `
+		SELECT
+			locations.id,
+			locations.name,
+			COUNT(items.id) AS item_count
+		FROM locations
+			JOIN items ON items.location_items = locations.id
+		WHERE locations.group_locations = ?
+			AND items.archived = false
+		GROUP BY locations.id, locations.name
+		ORDER BY item_count DESC, locations.name ASC
+		LIMIT 1
+`
+	var v LocationValue
+	row := r.db.Sql().QueryRowContext(ctx, q, GID)
+
+	err := row.Scan(&v.ID, &v.Name, &v.Count)
+	if errors.Is(err, dbsql.ErrNoRows) {
+		return LocationValue{}, &ent.NotFoundError{}
+	}
+	if err != nil {
+		return LocationValue{}, err
+	}
+
+	return v, nil
+}
+
 func (r *GroupRepository) GroupCreate(ctx context.Context, name string) (Group, error) {
 	return r.groupMapper.MapErr(r.db.Group.Create().
 		SetName(name).
@@ -275,6 +877,127 @@ func (r *GroupRepository) GroupUpdate(ctx context.Context, ID uuid.UUID, data Gr
 	return r.groupMapper.MapErr(entity, err)
 }
 
+// SetDepreciation sets the depreciation used to estimate the current value of the group's
+// items. DepreciationNone disables the estimate.
+func (r *GroupRepository) SetDepreciation(ctx context.Context, GID uuid.UUID, method DepreciationMethod, usefulLifeYears int) (Group, error) {
+	if !method.Valid() || usefulLifeYears < 0 || (method != DepreciationNone && usefulLifeYears == 0) {
+		return Group{}, ErrInvalidDepreciation
+	}
+
+	entity, err := r.db.Group.UpdateOneID(GID).
+		SetDepreciationMethod(group.DepreciationMethod(method)).
+		SetDepreciationYears(usefulLifeYears).
+		Save(ctx)
+
+	return r.groupMapper.MapErr(entity, err)
+}
+
+// SetDefaultLocation sets the location new items are placed in when they're created without
+// one. The location must belong to the group, passing uuid.Nil clears the default.
+func (r *GroupRepository) SetDefaultLocation(ctx context.Context, GID, locationID uuid.UUID) (Group, error) {
+	q := r.db.Group.UpdateOneID(GID)
+
+	if locationID == uuid.Nil {
+		q.ClearDefaultLocationID()
+	} else {
+		_, err := r.db.Location.Query().
+			Where(
+				location.ID(locationID),
+				location.HasGroupWith(group.ID(GID)),
+			).
+			OnlyID(ctx)
+		if err != nil {
+			return Group{}, err
+		}
+
+		q.SetDefaultLocationID(locationID)
+	}
+
+	return r.groupMapper.MapErr(q.Save(ctx))
+}
+
+// ConvertAllPrices multiplies the prices of the items in the group's currency by the rate and
+// switches the group to the new currency. The original prices are recorded as a
+// CurrencyConversion first. It returns the number of items converted.
+func (r *GroupRepository) ConvertAllPrices(ctx context.Context, GID uuid.UUID, rate float64, newCurrency string) (int, error) {
+	if rate <= 0 || math.IsInf(rate, 0) || math.IsNaN(rate) {
+		return 0, ErrInvalidExchangeRate
+	}
+
+	currency := group.Currency(strings.ToLower(newCurrency))
+	if err := group.CurrencyValidator(currency); err != nil {
+		return 0, err
+	}
+
+	var converted int
+
+	err := withTx(ctx, r.db, func(tx *ent.Tx) error {
+		g, err := tx.Group.Get(ctx, GID)
+		if err != nil {
+			return err
+		}
+
+		items, err := tx.Item.Query().
+			Where(
+				item.HasGroupWith(group.ID(GID)),
+				item.Or(
+					item.CurrencyIsNil(),
+					item.CurrencyEQ(""),
+					item.CurrencyEQ(g.Currency.String()),
+				),
+			).
+			Select(item.FieldPurchasePrice, item.FieldSoldPrice, item.FieldReplacementCost).
+			All(ctx)
+		if err != nil {
+			return err
+		}
+
+		originals := make([]types.PriceSnapshot, len(items))
+		for i, itm := range items {
+			originals[i] = types.PriceSnapshot{
+				ItemID:          itm.ID,
+				PurchasePrice:   itm.PurchasePrice,
+				SoldPrice:       itm.SoldPrice,
+				ReplacementCost: itm.ReplacementCost,
+			}
+		}
+
+		err = tx.CurrencyConversion.Create().
+			SetGroupID(GID).
+			SetFromCurrency(g.Currency.String()).
+			SetToCurrency(currency.String()).
+			SetRate(rate).
+			SetOriginalPrices(originals).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+
+		for _, itm := range items {
+			err = tx.Item.UpdateOneID(itm.ID).
+				SetPurchasePrice(itm.PurchasePrice * rate).
+				SetSoldPrice(itm.SoldPrice * rate).
+				SetReplacementCost(itm.ReplacementCost * rate).
+				ClearCurrency().
+				Exec(ctx)
+			if err != nil {
+				return err
+			}
+		}
+
+		converted = len(items)
+
+		return tx.Group.UpdateOneID(GID).
+			SetCurrency(currency).
+			Exec(ctx)
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return converted, nil
+}
+
 func (r *GroupRepository) GroupByID(ctx context.Context, id uuid.UUID) (Group, error) {
 	return r.groupMapper.MapErr(r.db.Group.Get(ctx, id))
 }
@@ -3,8 +3,15 @@ package repo
 import (
 	"context"
 	"testing"
+	"time"
 
+	"github.com/google/uuid"
+	"github.com/hay-kot/homebox/backend/internal/data/ent"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/currencyconversion"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/group"
+	"github.com/hay-kot/homebox/backend/internal/data/types"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func Test_Group_Create(t *testing.T) {
@@ -44,3 +51,417 @@ func Test_Group_GroupStatistics(t *testing.T) {
 	assert.Equal(t, 1, stats.TotalUsers)
 	assert.Equal(t, 1, stats.TotalLocations)
 }
+
+func Test_Group_AvgPriceByLabel(t *testing.T) {
+	items := useItems(t, 5)
+	labels := useLabels(t, 2)
+
+	priced, unpriced := labels[0], labels[1]
+
+	for i, price := range []float64{10, 30, 0, 1000, 500} {
+		labelIDs := []uuid.UUID{priced.ID}
+		if price == 0 {
+			labelIDs = []uuid.UUID{priced.ID, unpriced.ID}
+		}
+
+		update := ItemUpdate{
+			ID:            items[i].ID,
+			Name:          items[i].Name,
+			LocationID:    items[i].Location.ID,
+			LabelIDs:      labelIDs,
+			PurchasePrice: price,
+			Archived:      i == 3, // archived items don't count
+		}
+		if i == 4 {
+			update.Currency = "eur" // prices in another currency don't count either
+		}
+
+		_, err := tRepos.Items.UpdateByGroup(context.Background(), tGroup.ID, update)
+		require.NoError(t, err)
+	}
+
+	stats, err := tRepos.Groups.AvgPriceByLabel(context.Background(), tGroup.ID)
+	require.NoError(t, err)
+
+	require.Len(t, stats, 1)
+	assert.Equal(t, priced.ID, stats[0].ID)
+	assert.InDelta(t, 20.0, stats[0].Average, 0.001)
+}
+
+func Test_Group_BusiestLocation(t *testing.T) {
+	g, err := tRepos.Groups.GroupCreate(context.Background(), "busiest")
+	require.NoError(t, err)
+
+	_, err = tRepos.Groups.BusiestLocation(context.Background(), g.ID)
+	require.Error(t, err)
+	assert.True(t, ent.IsNotFound(err))
+
+	useItems(t, 1)
+	busiest := useItems(t, 3)
+
+	loc, err := tRepos.Groups.BusiestLocation(context.Background(), tGroup.ID)
+	require.NoError(t, err)
+	assert.Equal(t, busiest[0].Location.ID, loc.ID)
+	assert.Equal(t, busiest[0].Location.Name, loc.Name)
+	assert.Equal(t, 3, loc.Count)
+}
+
+func Test_Group_NetWorthTrend(t *testing.T) {
+	g, err := tRepos.Groups.GroupCreate(context.Background(), "net-worth")
+	require.NoError(t, err)
+
+	loc, err := tRepos.Locations.Create(context.Background(), g.ID, locationFactory())
+	require.NoError(t, err)
+
+	day := 24 * time.Hour
+	start := time.Now().Add(-9 * day)
+
+	type entry struct {
+		price     float64
+		purchased time.Time
+		sold      time.Time
+		archived  bool
+	}
+
+	entries := []entry{
+		{price: 100, purchased: start.Add(-day)},                          // owned throughout
+		{price: 50, purchased: start.Add(4 * day)},                        // bought mid-range
+		{price: 25, purchased: start.Add(-day), sold: start.Add(2 * day)}, // sold mid-range
+		{price: 10}, // no purchase date, counts from creation
+		{price: 1000, purchased: start.Add(-day), archived: true}, // archived now, counts until then
+	}
+
+	for _, e := range entries {
+		data := itemFactory()
+		data.LocationID = loc.ID
+
+		itm, err := tRepos.Items.Create(context.Background(), g.ID, data)
+		require.NoError(t, err)
+
+		_, err = tRepos.Items.UpdateByGroup(context.Background(), g.ID, ItemUpdate{
+			ID:            itm.ID,
+			Name:          itm.Name,
+			LocationID:    loc.ID,
+			Quantity:      1,
+			PurchasePrice: e.price,
+			PurchaseTime:  types.DateFromTime(e.purchased),
+			SoldTime:      types.DateFromTime(e.sold),
+			Archived:      e.archived,
+		})
+		require.NoError(t, err)
+	}
+
+	points, err := tRepos.Groups.NetWorthTrend(context.Background(), g.ID, start, start.Add(10*day), 5*day)
+	require.NoError(t, err)
+	require.Len(t, points, 3)
+
+	assert.InDelta(t, 1125.0, points[0].Value, 0.001)
+	assert.InDelta(t, 1150.0, points[1].Value, 0.001)
+	assert.InDelta(t, 160.0, points[2].Value, 0.001)
+
+	_, err = tRepos.Groups.NetWorthTrend(context.Background(), g.ID, start, start.Add(-day), day)
+	require.ErrorIs(t, err, ErrInvalidTrendRange)
+}
+
+func Test_Group_SetDefaultLocation(t *testing.T) {
+	ctx := context.Background()
+
+	g, err := tRepos.Groups.GroupCreate(ctx, "default-location")
+	require.NoError(t, err)
+	assert.Nil(t, g.DefaultLocationID)
+
+	loc, err := tRepos.Locations.Create(ctx, g.ID, locationFactory())
+	require.NoError(t, err)
+
+	// locations from other groups are rejected
+	other := useLocations(t, 1)[0]
+	_, err = tRepos.Groups.SetDefaultLocation(ctx, g.ID, other.ID)
+	require.Error(t, err)
+
+	g, err = tRepos.Groups.SetDefaultLocation(ctx, g.ID, loc.ID)
+	require.NoError(t, err)
+	require.NotNil(t, g.DefaultLocationID)
+	assert.Equal(t, loc.ID, *g.DefaultLocationID)
+
+	itm, err := tRepos.Items.Create(ctx, g.ID, itemFactory())
+	require.NoError(t, err)
+	require.NotNil(t, itm.Location)
+	assert.Equal(t, loc.ID, itm.Location.ID)
+
+	g, err = tRepos.Groups.SetDefaultLocation(ctx, g.ID, uuid.Nil)
+	require.NoError(t, err)
+	assert.Nil(t, g.DefaultLocationID)
+
+	itm, err = tRepos.Items.Create(ctx, g.ID, itemFactory())
+	require.NoError(t, err)
+	assert.Nil(t, itm.Location)
+}
+
+func Test_Group_QuantityByLabel(t *testing.T) {
+	items := useItems(t, 3)
+	labels := useLabels(t, 2)
+
+	updates := []struct {
+		quantity int
+		labels   []uuid.UUID
+	}{
+		{quantity: 100, labels: []uuid.UUID{labels[0].ID}},
+		{quantity: 20, labels: []uuid.UUID{labels[0].ID, labels[1].ID}},
+		{quantity: 5, labels: []uuid.UUID{labels[1].ID}},
+	}
+
+	for i, u := range updates {
+		_, err := tRepos.Items.UpdateByGroup(context.Background(), tGroup.ID, ItemUpdate{
+			ID:         items[i].ID,
+			Name:       items[i].Name,
+			LocationID: items[i].Location.ID,
+			Quantity:   u.quantity,
+			LabelIDs:   u.labels,
+		})
+		require.NoError(t, err)
+	}
+
+	totals, err := tRepos.Groups.QuantityByLabel(context.Background(), tGroup.ID)
+	require.NoError(t, err)
+	require.Len(t, totals, 2)
+
+	assert.Equal(t, labels[0].ID, totals[0].ID)
+	assert.Equal(t, 120, totals[0].Total)
+	assert.Equal(t, labels[1].ID, totals[1].ID)
+	assert.Equal(t, 25, totals[1].Total)
+}
+
+func Test_Group_ConvertAllPrices(t *testing.T) {
+	ctx := context.Background()
+
+	g, items := useGroupItems(t, ItemUpdate{PurchasePrice: 100, SoldPrice: 50, ReplacementCost: 120})
+	itm := items[0]
+
+	_, err := tRepos.Groups.ConvertAllPrices(ctx, g.ID, 0, "eur")
+	require.ErrorIs(t, err, ErrInvalidExchangeRate)
+
+	_, err = tRepos.Groups.ConvertAllPrices(ctx, g.ID, 0.5, "xyz")
+	require.Error(t, err)
+
+	count, err := tRepos.Groups.ConvertAllPrices(ctx, g.ID, 0.5, "EUR")
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+
+	got, err := tRepos.Items.GetOneByGroup(ctx, g.ID, itm.ID)
+	require.NoError(t, err)
+	assert.InDelta(t, 50.0, got.PurchasePrice, 0.001)
+	assert.InDelta(t, 25.0, got.SoldPrice, 0.001)
+	assert.InDelta(t, 60.0, got.ReplacementCost, 0.001)
+
+	g, err = tRepos.Groups.GroupByID(ctx, g.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "EUR", g.Currency)
+
+	conversion, err := tClient.CurrencyConversion.Query().
+		Where(currencyconversion.HasGroupWith(group.ID(g.ID))).
+		Only(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "usd", conversion.FromCurrency)
+	assert.Equal(t, "eur", conversion.ToCurrency)
+	require.Len(t, conversion.OriginalPrices, 1)
+	assert.Equal(t, itm.ID, conversion.OriginalPrices[0].ItemID)
+	assert.InDelta(t, 100.0, conversion.OriginalPrices[0].PurchasePrice, 0.001)
+	assert.InDelta(t, 120.0, conversion.OriginalPrices[0].ReplacementCost, 0.001)
+}
+
+func Test_Group_WarrantyExpiryForecast(t *testing.T) {
+	ctx := context.Background()
+
+	now := time.Now().UTC()
+	month := time.Date(now.Year(), now.Month(), 15, 0, 0, 0, 0, time.UTC)
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+
+	expires := func(t time.Time) types.Date { return types.DateFromTime(t) }
+
+	g, _ := useGroupItems(t,
+		ItemUpdate{WarrantyExpires: expires(month.AddDate(0, 1, 0))},
+		ItemUpdate{WarrantyExpires: expires(month.AddDate(0, 1, 0))},
+		ItemUpdate{WarrantyExpires: expires(month.AddDate(0, 11, 0))},
+		ItemUpdate{WarrantyExpires: expires(today)},                                          // expires today
+		ItemUpdate{WarrantyExpires: expires(month.AddDate(0, 2, 0)), LifetimeWarranty: true}, // lifetime warranty
+		ItemUpdate{WarrantyExpires: expires(month.AddDate(0, -1, 0))},                        // already expired
+		ItemUpdate{WarrantyExpires: expires(month.AddDate(0, 12, 0))},                        // beyond the forecast
+		ItemUpdate{}, // no warranty
+	)
+
+	forecast, err := tRepos.Groups.WarrantyExpiryForecast(ctx, g.ID)
+	require.NoError(t, err)
+	require.Len(t, forecast, 12)
+
+	assert.Equal(t, now.Format("2006-01"), forecast[0].Month)
+	assert.Equal(t, 1, forecast[0].Count)
+	assert.Equal(t, month.AddDate(0, 1, 0).Format("2006-01"), forecast[1].Month)
+	assert.Equal(t, 2, forecast[1].Count)
+	assert.Equal(t, 0, forecast[2].Count)
+	assert.Equal(t, 1, forecast[11].Count)
+}
+
+func Test_Group_StatsPurchaseValue(t *testing.T) {
+	ctx := context.Background()
+
+	empty, _ := useGroupItems(t)
+
+	stats, err := tRepos.Groups.StatsPurchaseValue(ctx, empty.ID)
+	require.NoError(t, err)
+	assert.Equal(t, PurchaseValueStats{}, stats)
+
+	g, _ := useGroupItems(t,
+		ItemUpdate{PurchasePrice: 100, Quantity: 2, Insured: true},
+		ItemUpdate{PurchasePrice: 50, Quantity: 1},
+		ItemUpdate{PurchasePrice: 0, Quantity: 3},
+		ItemUpdate{PurchasePrice: 500, Quantity: 1, Insured: true, Archived: true},
+	)
+
+	stats, err = tRepos.Groups.StatsPurchaseValue(ctx, g.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 3, stats.TotalItems)
+	assert.Equal(t, 6, stats.TotalQuantity)
+	assert.InDelta(t, 250.0, stats.TotalValue, 0.001)
+	assert.InDelta(t, 200.0, stats.InsuredValue, 0.001)
+	assert.Equal(t, 1, stats.TotalUnpriced)
+}
+
+func Test_Group_StatsPurchaseValueByCurrency(t *testing.T) {
+	ctx := context.Background()
+
+	g, items := useGroupItems(t,
+		ItemUpdate{PurchasePrice: 100, Quantity: 1},
+		ItemUpdate{PurchasePrice: 50, Quantity: 2, Currency: "usd"},
+		ItemUpdate{PurchasePrice: 30, Quantity: 1, Currency: "EUR"},
+		ItemUpdate{PurchasePrice: 0, Quantity: 1, Currency: "eur"},
+	)
+	loc := items[0].Location
+
+	for i, want := range []string{"USD", "USD", "EUR", "EUR"} {
+		assert.Equal(t, want, items[i].Currency)
+	}
+
+	stats, err := tRepos.Groups.StatsPurchaseValueByCurrency(ctx, g.ID)
+	require.NoError(t, err)
+	require.Len(t, stats, 2)
+
+	assert.Equal(t, 2, stats["USD"].TotalItems)
+	assert.InDelta(t, 200.0, stats["USD"].TotalValue, 0.001)
+
+	assert.Equal(t, 2, stats["EUR"].TotalItems)
+	assert.InDelta(t, 30.0, stats["EUR"].TotalValue, 0.001)
+	assert.Equal(t, 1, stats["EUR"].TotalUnpriced)
+
+	// totals that aren't segmented only sum the items priced in the group's currency, the
+	// counts include every item
+	value, err := tRepos.Groups.StatsPurchaseValue(ctx, g.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 4, value.TotalItems)
+	assert.Equal(t, 5, value.TotalQuantity)
+	assert.Equal(t, 1, value.TotalUnpriced)
+	assert.InDelta(t, 200.0, value.TotalValue, 0.001)
+
+	groupStats, err := tRepos.Groups.StatsGroup(ctx, g.ID)
+	require.NoError(t, err)
+	assert.InDelta(t, 200.0, groupStats.TotalItemPrice, 0.001)
+
+	locValue, err := tRepos.Locations.LocationValue(ctx, g.ID, loc.ID, false)
+	require.NoError(t, err)
+	assert.InDelta(t, 150.0, locValue, 0.001)
+
+	cost, err := tRepos.Items.SumReplacementCost(ctx, g.ID, ItemQuery{})
+	require.NoError(t, err)
+	assert.InDelta(t, 200.0, cost, 0.001)
+
+	totals, err := tRepos.Items.QueryByGroupWithTotals(ctx, g.ID, ItemQuery{})
+	require.NoError(t, err)
+	assert.Equal(t, 4, totals.Total)
+	assert.InDelta(t, 150.0, totals.TotalPurchasePrice, 0.001)
+
+	trend, err := tRepos.Groups.NetWorthTrend(ctx, g.ID, time.Now(), time.Now(), time.Hour)
+	require.NoError(t, err)
+	require.Len(t, trend, 1)
+	assert.InDelta(t, 200.0, trend[0].Value, 0.001)
+
+	// converting the group's currency leaves the items priced in euros untouched
+	converted, err := tRepos.Groups.ConvertAllPrices(ctx, g.ID, 2, "gbp")
+	require.NoError(t, err)
+	assert.Equal(t, 2, converted)
+
+	stats, err = tRepos.Groups.StatsPurchaseValueByCurrency(ctx, g.ID)
+	require.NoError(t, err)
+	require.Len(t, stats, 2)
+	assert.InDelta(t, 400.0, stats["GBP"].TotalValue, 0.001)
+	assert.InDelta(t, 30.0, stats["EUR"].TotalValue, 0.001)
+}
+
+func Test_Group_WarrantyCounts(t *testing.T) {
+	ctx := context.Background()
+
+	now := time.Now().UTC()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+
+	g, _ := useGroupItems(t,
+		ItemUpdate{WarrantyExpires: types.DateFromTime(now.AddDate(1, 0, 0))},                          // active
+		ItemUpdate{WarrantyExpires: types.DateFromTime(today)},                                         // expires today, still active
+		ItemUpdate{WarrantyExpires: types.DateFromTime(now.AddDate(-1, 0, 0))},                         // expired
+		ItemUpdate{WarrantyExpires: types.DateFromTime(now.AddDate(-1, 0, 0)), LifetimeWarranty: true}, // lifetime wins over the date
+		ItemUpdate{}, // none
+	)
+
+	active, expired, lifetime, none, err := tRepos.Groups.WarrantyCounts(ctx, g.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 2, active)
+	assert.Equal(t, 1, expired)
+	assert.Equal(t, 1, lifetime)
+	assert.Equal(t, 1, none)
+}
+
+func Test_Group_TotalQuantity(t *testing.T) {
+	ctx := context.Background()
+
+	empty, _ := useGroupItems(t)
+
+	total, err := tRepos.Groups.TotalQuantity(ctx, empty.ID)
+	require.NoError(t, err)
+	assert.Zero(t, total)
+
+	g, _ := useGroupItems(t, ItemUpdate{Quantity: 1}, ItemUpdate{Quantity: 5}, ItemUpdate{Quantity: 10})
+
+	total, err = tRepos.Groups.TotalQuantity(ctx, g.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 16, total)
+}
+
+func Test_Group_ManufacturerBreakdown(t *testing.T) {
+	ctx := context.Background()
+
+	g, items := useGroupItems(t,
+		ItemUpdate{Manufacturer: "Acme", PurchasePrice: 10, Quantity: 1},
+		ItemUpdate{Manufacturer: "Acme", PurchasePrice: 20, Quantity: 2},
+		ItemUpdate{Manufacturer: "Acme", PurchasePrice: 5, Quantity: 1},
+		ItemUpdate{Manufacturer: "Acme", PurchasePrice: 1000, Quantity: 1, Archived: true}, // archived items aren't counted
+		ItemUpdate{Manufacturer: "Globex", PurchasePrice: 100, Quantity: 1},
+		ItemUpdate{Manufacturer: "Globex", PurchasePrice: 100, Quantity: 1},
+		ItemUpdate{Manufacturer: "Initech", PurchasePrice: 7, Quantity: 1},
+		ItemUpdate{Manufacturer: "Initech", PurchasePrice: 50, Quantity: 1, Currency: "eur"}, // counted, but not added to the value
+		ItemUpdate{PurchasePrice: 3, Quantity: 1},
+	)
+
+	// an item that never had its manufacturer set
+	data := itemFactory()
+	data.LocationID = items[0].Location.ID
+	_, err := tRepos.Items.Create(ctx, g.ID, data)
+	require.NoError(t, err)
+
+	stats, err := tRepos.Groups.ManufacturerBreakdown(ctx, g.ID)
+	require.NoError(t, err)
+
+	// most items first, ties by name
+	assert.Equal(t, []ManufacturerStat{
+		{Manufacturer: "Acme", Count: 3, Value: 55},
+		{Manufacturer: "(unknown)", Count: 2, Value: 3},
+		{Manufacturer: "Globex", Count: 2, Value: 200},
+		{Manufacturer: "Initech", Count: 2, Value: 7},
+	}, stats)
+}
@@ -2,12 +2,16 @@ package repo
 
 import (
 	"context"
+	"sort"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/hay-kot/homebox/backend/internal/data/ent"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/attachment"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/document"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/group"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/item"
+	"github.com/hay-kot/homebox/backend/internal/data/types"
 )
 
 // AttachmentRepo is a repository for Attachments table that links Items to Documents
@@ -26,16 +30,22 @@ type (
 		Type      string      `json:"type"`
 		Document  DocumentOut `json:"document"`
 		Primary   bool        `json:"primary"`
+		Date      types.Date  `json:"date"`
+		// Shared is true when the document is also attached to other items
+		Shared bool `json:"shared"`
 	}
 
 	ItemAttachmentUpdate struct {
-		ID      uuid.UUID `json:"-"`
-		Type    string    `json:"type"`
-		Title   string    `json:"title"`
-		Primary bool      `json:"primary"`
+		ID      uuid.UUID  `json:"-"`
+		Type    string     `json:"type"`
+		Title   string     `json:"title"`
+		Primary bool       `json:"primary"`
+		Date    types.Date `json:"date"`
 	}
 )
 
+// ToItemAttachment maps the attachment, its document must be loaded with withSharedDocument
+// for Shared to be set.
 func ToItemAttachment(attachment *ent.Attachment) ItemAttachment {
 	return ItemAttachment{
 		ID:        attachment.ID,
@@ -43,6 +53,8 @@ func ToItemAttachment(attachment *ent.Attachment) ItemAttachment {
 		UpdatedAt: attachment.UpdatedAt,
 		Type:      attachment.Type.String(),
 		Primary:   attachment.Primary,
+		Date:      types.DateFromTime(attachment.Date),
+		Shared:    len(attachment.Edges.Document.Edges.Attachments) > 1,
 		Document: DocumentOut{
 			ID:    attachment.Edges.Document.ID,
 			Title: attachment.Edges.Document.Title,
@@ -51,6 +63,59 @@ func ToItemAttachment(attachment *ent.Attachment) ItemAttachment {
 	}
 }
 
+// withSharedDocument loads the document of the attachments along with the IDs of all the
+// attachments of the document, which ToItemAttachment needs to tell whether it's shared.
+func withSharedDocument(aq *ent.AttachmentQuery) {
+	aq.WithDocument(func(dq *ent.DocumentQuery) {
+		dq.WithAttachments(func(q *ent.AttachmentQuery) {
+			q.Select(attachment.FieldID)
+		})
+	})
+}
+
+// AttachmentsByType returns the attachments of the item with the given type, e.g. "manual",
+// in the order they are listed on the item.
+func (i ItemOut) AttachmentsByType(t string) []ItemAttachment {
+	out := []ItemAttachment{}
+	for _, a := range i.Attachments {
+		if a.Type == t {
+			out = append(out, a)
+		}
+	}
+
+	return out
+}
+
+// sortAttachments orders the attachments with the primary one first, followed by the rest
+// from oldest to newest. When none is flagged as primary the oldest photo is treated as the
+// primary attachment.
+func sortAttachments(attachments []ItemAttachment) {
+	sort.SliceStable(attachments, func(i, j int) bool {
+		return attachments[i].CreatedAt.Before(attachments[j].CreatedAt)
+	})
+
+	primary := -1
+	for i, a := range attachments {
+		if a.Primary {
+			primary = i
+			break
+		}
+
+		if primary == -1 && a.Type == attachment.TypePhoto.String() {
+			primary = i
+		}
+	}
+
+	if primary == -1 {
+		return
+	}
+
+	p := attachments[primary]
+	p.Primary = true
+	copy(attachments[1:primary+1], attachments[:primary])
+	attachments[0] = p
+}
+
 func (r *AttachmentRepo) Create(ctx context.Context, itemId, docId uuid.UUID, typ attachment.Type) (*ent.Attachment, error) {
 	bldr := r.db.Attachment.Create().
 		SetType(typ).
@@ -78,6 +143,110 @@ func (r *AttachmentRepo) Create(ctx context.Context, itemId, docId uuid.UUID, ty
   return bldr.Save(ctx)
 }
 
+// LinkDocument attaches an existing document to another item so that a single stored file
+// can be shared between items, e.g. a receipt covering several purchases. The new attachment
+// takes the type of the document's existing attachments. Linking a document that is already
+// attached to the item returns the existing attachment.
+func (r *AttachmentRepo) LinkDocument(ctx context.Context, GID, itemID, documentID uuid.UUID) (*ent.Attachment, error) {
+	_, err := r.db.Item.Query().
+		Where(
+			item.ID(itemID),
+			item.HasGroupWith(group.ID(GID)),
+		).
+		OnlyID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	doc, err := r.db.Document.Query().
+		Where(
+			document.ID(documentID),
+			document.HasGroupWith(group.ID(GID)),
+		).
+		WithAttachments(func(aq *ent.AttachmentQuery) {
+			aq.WithItem()
+		}).
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	typ := attachment.TypeAttachment
+	for _, att := range doc.Edges.Attachments {
+		if att.Edges.Item != nil && att.Edges.Item.ID == itemID {
+			return r.Get(ctx, att.ID)
+		}
+
+		typ = att.Type
+	}
+
+	return r.Create(ctx, itemID, doc.ID, typ)
+}
+
+// SetPrimary makes the photo the primary attachment of the item, clearing the flag on the
+// item's other attachments.
+func (r *AttachmentRepo) SetPrimary(ctx context.Context, GID, itemID, attachmentID uuid.UUID) error {
+	return withTx(ctx, r.db, func(tx *ent.Tx) error {
+		att, err := tx.Attachment.Query().
+			Where(
+				attachment.ID(attachmentID),
+				attachment.HasItemWith(
+					item.ID(itemID),
+					item.HasGroupWith(group.ID(GID)),
+				),
+			).
+			Only(ctx)
+		if err != nil {
+			return err
+		}
+
+		if att.Type != attachment.TypePhoto {
+			return ErrAttachmentNotPhoto
+		}
+
+		err = tx.Attachment.Update().
+			Where(
+				attachment.HasItemWith(item.ID(itemID)),
+				attachment.IDNEQ(attachmentID),
+			).
+			SetPrimary(false).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+
+		return tx.Attachment.UpdateOneID(attachmentID).SetPrimary(true).Exec(ctx)
+	})
+}
+
+// GetPrimaryImage returns the primary photo of the item, falling back to the oldest photo
+// when none is flagged as primary. A not found error is returned when the item has no photos.
+func (r *AttachmentRepo) GetPrimaryImage(ctx context.Context, GID, itemID uuid.UUID) (ItemAttachment, error) {
+	q := r.db.Attachment.Query().
+		Where(
+			attachment.TypeEQ(attachment.TypePhoto),
+			attachment.HasItemWith(
+				item.ID(itemID),
+				item.HasGroupWith(group.ID(GID)),
+			),
+		)
+	withSharedDocument(q)
+
+	att, err := q.
+		Order(
+			ent.Desc(attachment.FieldPrimary),
+			ent.Asc(attachment.FieldCreatedAt),
+		).
+		First(ctx)
+	if err != nil {
+		return ItemAttachment{}, err
+	}
+
+	out := ToItemAttachment(att)
+	out.Primary = true
+	return out, nil
+}
+
 func (r *AttachmentRepo) Get(ctx context.Context, id uuid.UUID) (*ent.Attachment, error) {
 	return r.db.Attachment.
 		Query().
@@ -94,6 +263,11 @@ func (r *AttachmentRepo) Update(ctx context.Context, itemId uuid.UUID, data *Ite
 	bldr := r.db.Attachment.UpdateOneID(itemId).
 		SetType(typ)
 
+	// the date is left unchanged when none is given
+	if !data.Date.Time().IsZero() {
+		bldr = bldr.SetDate(data.Date.Time())
+	}
+
 	// Primary only applies to photos
 	if typ == attachment.TypePhoto {
 		bldr = bldr.SetPrimary(data.Primary)
@@ -8,6 +8,7 @@ import (
 	"github.com/hay-kot/homebox/backend/internal/data/ent"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/attachment"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestAttachmentRepo_Create(t *testing.T) {
@@ -132,3 +133,155 @@ func TestAttachmentRepo_Delete(t *testing.T) {
 	_, err = tRepos.Attachments.Get(context.Background(), entity.ID)
 	assert.Error(t, err)
 }
+
+func TestAttachmentRepo_LinkDocument(t *testing.T) {
+	doc := useDocs(t, 1)[0]
+	items := useItems(t, 2)
+
+	receipt, err := tRepos.Attachments.Create(context.Background(), items[0].ID, doc.ID, attachment.TypeReceipt)
+	require.NoError(t, err)
+
+	linked, err := tRepos.Attachments.LinkDocument(context.Background(), tGroup.ID, items[1].ID, doc.ID)
+	require.NoError(t, err)
+	assert.Equal(t, attachment.TypeReceipt, linked.Type)
+	assert.NotEqual(t, receipt.ID, linked.ID)
+
+	// linking again is a no-op
+	again, err := tRepos.Attachments.LinkDocument(context.Background(), tGroup.ID, items[1].ID, doc.ID)
+	require.NoError(t, err)
+	assert.Equal(t, linked.ID, again.ID)
+
+	_, err = tRepos.Attachments.LinkDocument(context.Background(), uuid.New(), items[1].ID, doc.ID)
+	assert.True(t, ent.IsNotFound(err))
+
+	out, err := tRepos.Items.GetOneByGroup(context.Background(), tGroup.ID, items[1].ID)
+	require.NoError(t, err)
+	require.Len(t, out.Attachments, 1)
+	assert.Equal(t, doc.ID, out.Attachments[0].Document.ID)
+	assert.True(t, out.Attachments[0].Shared)
+
+	// deleting one item keeps the document for the other
+	err = tRepos.Items.DeleteByGroup(context.Background(), tGroup.ID, items[0].ID)
+	require.NoError(t, err)
+
+	_, err = tRepos.Items.PurgeArchived(context.Background(), tGroup.ID, 0)
+	require.NoError(t, err)
+
+	deleted, err := tRepos.Docs.DeleteUnattached(context.Background(), doc.ID)
+	require.NoError(t, err)
+	assert.False(t, deleted)
+
+	out, err = tRepos.Items.GetOneByGroup(context.Background(), tGroup.ID, items[1].ID)
+	require.NoError(t, err)
+	require.Len(t, out.Attachments, 1)
+	assert.False(t, out.Attachments[0].Shared)
+
+	err = tRepos.Attachments.Delete(context.Background(), linked.ID)
+	require.NoError(t, err)
+
+	deleted, err = tRepos.Docs.DeleteUnattached(context.Background(), doc.ID)
+	require.NoError(t, err)
+	assert.True(t, deleted)
+}
+
+func TestAttachmentRepo_SetPrimary(t *testing.T) {
+	ctx := context.Background()
+	doc := useDocs(t, 1)[0]
+	itm := useItems(t, 1)[0]
+
+	var attachments []*ent.Attachment
+	for _, typ := range []attachment.Type{attachment.TypeManual, attachment.TypePhoto, attachment.TypePhoto} {
+		a, err := tRepos.Attachments.Create(ctx, itm.ID, doc.ID, typ)
+		require.NoError(t, err)
+		attachments = append(attachments, a)
+	}
+	manual, first, second := attachments[0], attachments[1], attachments[2]
+
+	ids := func() []uuid.UUID {
+		out, err := tRepos.Items.GetOneByGroup(ctx, tGroup.ID, itm.ID)
+		require.NoError(t, err)
+
+		ids := make([]uuid.UUID, len(out.Attachments))
+		for i, a := range out.Attachments {
+			ids[i] = a.ID
+		}
+
+		assert.True(t, out.Attachments[0].Primary)
+		for _, a := range out.Attachments[1:] {
+			assert.False(t, a.Primary)
+		}
+		return ids
+	}
+
+	// the first photo is flagged as primary when created
+	assert.Equal(t, []uuid.UUID{first.ID, manual.ID, second.ID}, ids())
+
+	// without a flagged attachment the oldest photo is used
+	err := tClient.Attachment.UpdateOneID(first.ID).SetPrimary(false).Exec(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{first.ID, manual.ID, second.ID}, ids())
+
+	err = tRepos.Attachments.SetPrimary(ctx, tGroup.ID, itm.ID, second.ID)
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{second.ID, manual.ID, first.ID}, ids())
+
+	err = tRepos.Attachments.SetPrimary(ctx, tGroup.ID, itm.ID, manual.ID)
+	assert.ErrorIs(t, err, ErrAttachmentNotPhoto)
+
+	err = tRepos.Attachments.SetPrimary(ctx, uuid.New(), itm.ID, first.ID)
+	assert.True(t, ent.IsNotFound(err))
+}
+
+func TestItemOut_AttachmentsByType(t *testing.T) {
+	ctx := context.Background()
+	doc := useDocs(t, 1)[0]
+	itm := useItems(t, 1)[0]
+
+	for _, typ := range []attachment.Type{attachment.TypeManual, attachment.TypePhoto, attachment.TypeManual} {
+		_, err := tRepos.Attachments.Create(ctx, itm.ID, doc.ID, typ)
+		require.NoError(t, err)
+	}
+
+	out, err := tRepos.Items.GetOneByGroup(ctx, tGroup.ID, itm.ID)
+	require.NoError(t, err)
+
+	manuals := out.AttachmentsByType(attachment.TypeManual.String())
+	require.Len(t, manuals, 2)
+	for _, m := range manuals {
+		assert.Equal(t, attachment.TypeManual.String(), m.Type)
+	}
+
+	assert.Empty(t, out.AttachmentsByType(attachment.TypeReceipt.String()))
+}
+
+func TestAttachmentRepo_GetPrimaryImage(t *testing.T) {
+	ctx := context.Background()
+	doc := useDocs(t, 1)[0]
+	itm := useItems(t, 1)[0]
+
+	manual, err := tRepos.Attachments.Create(ctx, itm.ID, doc.ID, attachment.TypeManual)
+	require.NoError(t, err)
+
+	_, err = tRepos.Attachments.GetPrimaryImage(ctx, tGroup.ID, itm.ID)
+	assert.True(t, ent.IsNotFound(err))
+
+	first, err := tRepos.Attachments.Create(ctx, itm.ID, doc.ID, attachment.TypePhoto)
+	require.NoError(t, err)
+	second, err := tRepos.Attachments.Create(ctx, itm.ID, doc.ID, attachment.TypePhoto)
+	require.NoError(t, err)
+
+	got, err := tRepos.Attachments.GetPrimaryImage(ctx, tGroup.ID, itm.ID)
+	require.NoError(t, err)
+	assert.Equal(t, first.ID, got.ID)
+
+	err = tRepos.Attachments.SetPrimary(ctx, tGroup.ID, itm.ID, second.ID)
+	require.NoError(t, err)
+
+	got, err = tRepos.Attachments.GetPrimaryImage(ctx, tGroup.ID, itm.ID)
+	require.NoError(t, err)
+	assert.Equal(t, second.ID, got.ID)
+	assert.NotEqual(t, manual.ID, got.ID)
+
+	_, err = tRepos.Attachments.GetPrimaryImage(ctx, uuid.New(), itm.ID)
+	assert.True(t, ent.IsNotFound(err))
+}
@@ -0,0 +1,114 @@
+package repo
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hay-kot/homebox/backend/internal/data/ent"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/group"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/item"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/itemcomment"
+)
+
+// latestCommentsLimit is the number of comments that are embedded in an ItemOut.
+// The full thread is available through ItemCommentRepository.GetAll.
+const latestCommentsLimit = 3
+
+// ItemCommentRepository is a repository for the dated comments that make up an
+// item's running log. Comments are deleted along with their item.
+type ItemCommentRepository struct {
+	db *ent.Client
+}
+
+type (
+	ItemCommentCreate struct {
+		Content string `json:"content" validate:"required,max=2500"`
+	}
+
+	ItemComment struct {
+		ID         uuid.UUID  `json:"id"`
+		CreatedAt  time.Time  `json:"createdAt"`
+		Content    string     `json:"content"`
+		AuthorID   *uuid.UUID `json:"authorId,omitempty" extensions:"x-nullable,x-omitempty"`
+		AuthorName string     `json:"authorName"`
+	}
+)
+
+var (
+	mapItemCommentErr  = mapTErrFunc(mapItemComment)
+	mapItemCommentsErr = mapTEachErrFunc(mapItemComment)
+)
+
+func mapItemComment(comment *ent.ItemComment) ItemComment {
+	out := ItemComment{
+		ID:        comment.ID,
+		CreatedAt: comment.CreatedAt,
+		Content:   comment.Content,
+	}
+
+	if comment.Edges.Author != nil {
+		out.AuthorID = &comment.Edges.Author.ID
+		out.AuthorName = comment.Edges.Author.Name
+	}
+
+	return out
+}
+
+// Create appends a comment to the item. The authorID may be uuid.Nil for comments
+// that aren't attributed to a user.
+func (r *ItemCommentRepository) Create(ctx context.Context, GID, itemID, authorID uuid.UUID, data ItemCommentCreate) (ItemComment, error) {
+	_, err := r.db.Item.Query().
+		Where(
+			item.ID(itemID),
+			item.HasGroupWith(group.ID(GID)),
+		).
+		OnlyID(ctx)
+	if err != nil {
+		return ItemComment{}, err
+	}
+
+	q := r.db.ItemComment.Create().
+		SetItemID(itemID).
+		SetContent(data.Content)
+
+	if authorID != uuid.Nil {
+		q.SetAuthorID(authorID)
+	}
+
+	comment, err := q.Save(ctx)
+	if err != nil {
+		return ItemComment{}, err
+	}
+
+	return mapItemCommentErr(r.db.ItemComment.Query().
+		Where(itemcomment.ID(comment.ID)).
+		WithAuthor().
+		Only(ctx),
+	)
+}
+
+// GetAll returns the item's comments, newest first.
+func (r *ItemCommentRepository) GetAll(ctx context.Context, GID, itemID uuid.UUID) ([]ItemComment, error) {
+	return mapItemCommentsErr(r.db.ItemComment.Query().
+		Where(
+			itemcomment.ItemID(itemID),
+			itemcomment.HasItemWith(item.HasGroupWith(group.ID(GID))),
+		).
+		WithAuthor().
+		Order(ent.Desc(itemcomment.FieldCreatedAt)).
+		All(ctx),
+	)
+}
+
+// Delete removes a single comment from the item.
+func (r *ItemCommentRepository) Delete(ctx context.Context, GID, itemID, commentID uuid.UUID) error {
+	_, err := r.db.ItemComment.Delete().
+		Where(
+			itemcomment.ID(commentID),
+			itemcomment.ItemID(itemID),
+			itemcomment.HasItemWith(item.HasGroupWith(group.ID(GID))),
+		).
+		Exec(ctx)
+	return err
+}
@@ -0,0 +1,65 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/itemcomment"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestItemCommentRepository_CreateAndGetAll(t *testing.T) {
+	ctx := context.Background()
+	itm := useItems(t, 1)[0]
+
+	for i := 0; i < 5; i++ {
+		authorID := tUser.ID
+		if i == 0 {
+			authorID = uuid.Nil
+		}
+
+		comment, err := tRepos.Comments.Create(ctx, tGroup.ID, itm.ID, authorID, ItemCommentCreate{
+			Content: fmt.Sprintf("comment %d", i),
+		})
+		require.NoError(t, err)
+
+		if authorID == uuid.Nil {
+			assert.Nil(t, comment.AuthorID)
+		} else {
+			require.NotNil(t, comment.AuthorID)
+			assert.Equal(t, tUser.ID, *comment.AuthorID)
+			assert.Equal(t, tUser.Name, comment.AuthorName)
+		}
+	}
+
+	all, err := tRepos.Comments.GetAll(ctx, tGroup.ID, itm.ID)
+	require.NoError(t, err)
+	require.Len(t, all, 5)
+	assert.Equal(t, "comment 4", all[0].Content)
+	assert.Equal(t, "comment 0", all[4].Content)
+
+	// ItemOut only embeds the latest few
+	out, err := tRepos.Items.GetOneByGroup(ctx, tGroup.ID, itm.ID)
+	require.NoError(t, err)
+	require.Len(t, out.Comments, latestCommentsLimit)
+	assert.Equal(t, all[0].ID, out.Comments[0].ID)
+
+	// other groups can neither read nor append
+	_, err = tRepos.Comments.Create(ctx, uuid.New(), itm.ID, tUser.ID, ItemCommentCreate{Content: "nope"})
+	assert.Error(t, err)
+
+	other, err := tRepos.Comments.GetAll(ctx, uuid.New(), itm.ID)
+	require.NoError(t, err)
+	assert.Empty(t, other)
+
+	// comments are removed with the item
+	err = tRepos.Items.Delete(ctx, itm.ID)
+	require.NoError(t, err)
+
+	count, err := tClient.ItemComment.Query().Where(itemcomment.ItemID(itm.ID)).Count(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, count)
+}
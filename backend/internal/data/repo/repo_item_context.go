@@ -0,0 +1,116 @@
+package repo
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/hay-kot/homebox/backend/internal/data/ent"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/group"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/item"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/location"
+)
+
+// maxContextDepth caps how many levels of parents and children GetFullContext loads.
+const maxContextDepth = 5
+
+type (
+	// ItemContext is an item together with the items surrounding it. Related items and the
+	// direct parent are available on the item itself.
+	ItemContext struct {
+		Item ItemOut `json:"item"`
+
+		// Ancestors holds the chain of parents, nearest first.
+		Ancestors []ItemSummary      `json:"ancestors"`
+		Children  []ItemContextChild `json:"children"`
+		Roommates []ItemSummary      `json:"roommates"`
+	}
+
+	ItemContextChild struct {
+		ItemSummary
+		Children []ItemContextChild `json:"children"`
+	}
+)
+
+func mapItemContextChild(itm *ent.Item) ItemContextChild {
+	return ItemContextChild{
+		ItemSummary: mapItemSummary(itm),
+		Children:    mapEach(itm.Edges.Children, mapItemContextChild),
+	}
+}
+
+// withContextParents eager loads the parent chain of the item up to depth levels.
+func withContextParents(q *ent.ItemQuery, depth int) {
+	q.WithParent(func(pq *ent.ItemQuery) {
+		pq.WithLabel().WithLocation()
+		if depth > 1 {
+			withContextParents(pq, depth-1)
+		}
+	})
+}
+
+// withContextChildren eager loads the descendants of the item up to depth levels.
+func withContextChildren(q *ent.ItemQuery, depth int) {
+	q.WithChildren(func(cq *ent.ItemQuery) {
+		cq.WithLabel().WithLocation().Order(ent.Asc(item.FieldName))
+		if depth > 1 {
+			withContextChildren(cq, depth-1)
+		}
+	})
+}
+
+// GetFullContext returns the item along with its parents, children and the other items
+// at its location. Parents and children are loaded up to depth levels, which is clamped
+// to between 1 and maxContextDepth.
+func (e *ItemsRepository) GetFullContext(ctx context.Context, gid, id uuid.UUID, depth int) (ItemContext, error) {
+	if depth < 1 {
+		depth = 1
+	}
+	if depth > maxContextDepth {
+		depth = maxContextDepth
+	}
+
+	out, err := e.GetOneByGroup(ctx, gid, id)
+	if err != nil {
+		return ItemContext{}, err
+	}
+
+	q := e.db.Item.Query().Where(item.ID(id))
+	withContextParents(q, depth)
+	withContextChildren(q, depth)
+
+	itm, err := q.Only(ctx)
+	if err != nil {
+		return ItemContext{}, err
+	}
+
+	var ancestors []ItemSummary
+	for p := itm.Edges.Parent; p != nil; p = p.Edges.Parent {
+		ancestors = append(ancestors, mapItemSummary(p))
+	}
+
+	var roommates []ItemSummary
+	if out.Location != nil {
+		roommates, err = mapItemsSummaryErr(e.db.Item.Query().
+			Where(
+				item.HasGroupWith(group.ID(gid)),
+				item.HasLocationWith(location.ID(out.Location.ID)),
+				item.IDNEQ(id),
+				item.Archived(false),
+			).
+			Order(ent.Asc(item.FieldName)).
+			WithLabel().
+			WithLocation().
+			All(ctx),
+		)
+		if err != nil {
+			return ItemContext{}, err
+		}
+	}
+
+	return ItemContext{
+		Item:      out,
+		Ancestors: ancestors,
+		Children:  mapEach(itm.Edges.Children, mapItemContextChild),
+		Roommates: roommates,
+	}, nil
+}
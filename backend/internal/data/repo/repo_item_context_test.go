@@ -0,0 +1,52 @@
+package repo
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestItemsRepository_GetFullContext(t *testing.T) {
+	items := useItems(t, 6)
+
+	// items[0] -> items[1] -> items[2] -> items[3] -> items[4], items[5] is a roommate
+	for i := 1; i < 5; i++ {
+		_, err := tRepos.Items.UpdateByGroup(context.Background(), tGroup.ID, ItemUpdate{
+			ID:         items[i].ID,
+			Name:       items[i].Name,
+			LocationID: items[i].Location.ID,
+			ParentID:   items[i-1].ID,
+		})
+		require.NoError(t, err)
+	}
+
+	err := tRepos.Items.LinkItems(context.Background(), tGroup.ID, items[2].ID, items[5].ID)
+	require.NoError(t, err)
+
+	got, err := tRepos.Items.GetFullContext(context.Background(), tGroup.ID, items[2].ID, 1)
+	require.NoError(t, err)
+	assert.Equal(t, items[2].ID, got.Item.ID)
+	require.Len(t, got.Item.Related, 1)
+	assert.Equal(t, items[5].ID, got.Item.Related[0].ID)
+
+	require.Len(t, got.Ancestors, 1)
+	assert.Equal(t, items[1].ID, got.Ancestors[0].ID)
+	require.Len(t, got.Children, 1)
+	assert.Equal(t, items[3].ID, got.Children[0].ID)
+	assert.Empty(t, got.Children[0].Children)
+	assert.Len(t, got.Roommates, 5)
+
+	got, err = tRepos.Items.GetFullContext(context.Background(), tGroup.ID, items[2].ID, 100)
+	require.NoError(t, err)
+	require.Len(t, got.Ancestors, 2)
+	assert.Equal(t, items[0].ID, got.Ancestors[1].ID)
+	require.Len(t, got.Children, 1)
+	require.Len(t, got.Children[0].Children, 1)
+	assert.Equal(t, items[4].ID, got.Children[0].Children[0].ID)
+
+	_, err = tRepos.Items.GetFullContext(context.Background(), uuid.New(), items[2].ID, 1)
+	assert.Error(t, err)
+}
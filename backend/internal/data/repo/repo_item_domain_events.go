@@ -0,0 +1,58 @@
+package repo
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hay-kot/homebox/backend/internal/core/services/reporting/eventbus"
+)
+
+// EventBus receives the domain events of item changes, e.g. to trigger webhooks or
+// automations. Publish is called after the change is committed and must not block.
+type EventBus interface {
+	Publish(event any)
+}
+
+// busEvents publishes the item domain events on the application event bus.
+type busEvents struct {
+	bus *eventbus.EventBus
+}
+
+// ItemEventsOnBus returns an EventBus that publishes the item domain events on the bus as
+// eventbus.EventItemChange events.
+func ItemEventsOnBus(bus *eventbus.EventBus) EventBus {
+	return busEvents{bus}
+}
+
+func (b busEvents) Publish(event any) {
+	b.bus.Publish(eventbus.EventItemChange, event)
+}
+
+// ItemChange holds the details shared by the item domain events.
+type ItemChange struct {
+	ItemID  uuid.UUID `json:"itemId"`
+	GroupID uuid.UUID `json:"groupId"`
+	At      time.Time `json:"at"`
+}
+
+type (
+	// ItemCreated is published when an item is created.
+	ItemCreated struct{ ItemChange }
+
+	// ItemUpdated is published when an item is updated with UpdateByGroup.
+	ItemUpdated struct{ ItemChange }
+
+	// ItemDeleted is published when an item is moved to the trash or deleted.
+	ItemDeleted struct{ ItemChange }
+)
+
+func newItemChange(GID, itemID uuid.UUID) ItemChange {
+	return ItemChange{ItemID: itemID, GroupID: GID, At: time.Now()}
+}
+
+// publish sends the event to the event bus, it is a no-op when no bus is configured.
+func (e *ItemsRepository) publish(event any) {
+	if e.events != nil {
+		e.events.Publish(event)
+	}
+}
@@ -0,0 +1,145 @@
+package repo
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hay-kot/homebox/backend/internal/core/services/reporting/eventbus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeEventBus struct {
+	mu     sync.Mutex
+	events []any
+}
+
+func (b *fakeEventBus) Publish(event any) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.events = append(b.events, event)
+}
+
+func (b *fakeEventBus) take() []any {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	events := b.events
+	b.events = nil
+	return events
+}
+
+func TestItemsRepository_DomainEvents(t *testing.T) {
+	ctx := context.Background()
+	bus := &fakeEventBus{}
+	items := NewItemsRepository(tClient, nil, bus)
+
+	loc := useLocations(t, 1)[0]
+
+	start := time.Now()
+
+	data := itemFactory()
+	data.LocationID = loc.ID
+
+	itm, err := items.Create(ctx, tGroup.ID, data)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = items.Delete(context.Background(), itm.ID)
+	})
+
+	change := func(events []any) ItemChange {
+		t.Helper()
+		require.Len(t, events, 1)
+
+		var c ItemChange
+		switch ev := events[0].(type) {
+		case ItemCreated:
+			c = ev.ItemChange
+		case ItemUpdated:
+			c = ev.ItemChange
+		case ItemDeleted:
+			c = ev.ItemChange
+		default:
+			t.Fatalf("unexpected event %T", ev)
+		}
+
+		assert.Equal(t, itm.ID, c.ItemID)
+		assert.Equal(t, tGroup.ID, c.GroupID)
+		assert.False(t, c.At.Before(start))
+		return c
+	}
+
+	events := bus.take()
+	require.Len(t, events, 1)
+	assert.IsType(t, ItemCreated{}, events[0])
+	change(events)
+
+	_, err = items.UpdateByGroup(ctx, tGroup.ID, ItemUpdate{
+		ID:         itm.ID,
+		Name:       "updated",
+		LocationID: loc.ID,
+	})
+	require.NoError(t, err)
+
+	events = bus.take()
+	require.Len(t, events, 1)
+	assert.IsType(t, ItemUpdated{}, events[0])
+	change(events)
+
+	err = items.DeleteByGroup(ctx, tGroup.ID, itm.ID)
+	require.NoError(t, err)
+
+	events = bus.take()
+	require.Len(t, events, 1)
+	assert.IsType(t, ItemDeleted{}, events[0])
+	change(events)
+
+	// deleting an item that is already in the trash publishes nothing
+	err = items.DeleteByGroup(ctx, tGroup.ID, itm.ID)
+	require.NoError(t, err)
+	assert.Empty(t, bus.take())
+
+	n, err := items.PurgeManyByGroup(ctx, tGroup.ID, []uuid.UUID{itm.ID})
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+
+	events = bus.take()
+	require.Len(t, events, 1)
+	assert.IsType(t, ItemDeleted{}, events[0])
+	change(events)
+}
+
+func TestItemsRepository_DomainEventsOnBus(t *testing.T) {
+	ctx := context.Background()
+
+	received := make(chan any, 1)
+	tbus.Subscribe(eventbus.EventItemChange, func(event any) {
+		select {
+		case received <- event:
+		default:
+		}
+	})
+
+	items := NewItemsRepository(tClient, nil, ItemEventsOnBus(tbus))
+	loc := useLocations(t, 1)[0]
+
+	data := itemFactory()
+	data.LocationID = loc.ID
+
+	itm, err := items.Create(ctx, tGroup.ID, data)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = items.Delete(context.Background(), itm.ID)
+	})
+
+	select {
+	case event := <-received:
+		created, ok := event.(ItemCreated)
+		require.True(t, ok)
+		assert.Equal(t, itm.ID, created.ItemID)
+	case <-time.After(time.Second):
+		t.Fatal("no event received from the bus")
+	}
+}
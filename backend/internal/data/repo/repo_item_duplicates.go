@@ -0,0 +1,148 @@
+package repo
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hay-kot/homebox/backend/internal/data/ent"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/group"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/item"
+)
+
+// duplicateNameThreshold is the minimum similarity, between 0 and 1, at which two item
+// names are considered a potential duplicate.
+const duplicateNameThreshold = 0.8
+
+// FindPotentialDuplicates returns the items of the group that may be the same as the item
+// about to be created. Items with the same serial number are returned when there are any,
+// otherwise the items with a name similar to the new one are returned, most similar first.
+func (e *ItemsRepository) FindPotentialDuplicates(ctx context.Context, gid uuid.UUID, data ItemCreate) ([]ItemSummary, error) {
+	base := e.db.Item.Query().
+		Where(
+			item.HasGroupWith(group.ID(gid)),
+			item.Archived(false),
+		)
+
+	serial := strings.TrimSpace(data.SerialNumber)
+	if serial != "" {
+		matches, err := mapItemsSummaryErr(base.Clone().
+			Where(item.SerialNumberEqualFold(serial)).
+			Order(ent.Asc(item.FieldName)).
+			WithLabel().
+			WithLocation().
+			All(ctx),
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(matches) > 0 {
+			return matches, nil
+		}
+	}
+
+	name := normalizeItemName(data.Name)
+	if name == "" {
+		return []ItemSummary{}, nil
+	}
+
+	candidates, err := base.Clone().
+		Select(item.FieldID, item.FieldName).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	scores := make(map[uuid.UUID]float64)
+	var ids []uuid.UUID
+
+	for _, c := range candidates {
+		score := nameSimilarity(name, normalizeItemName(c.Name))
+		if score >= duplicateNameThreshold {
+			scores[c.ID] = score
+			ids = append(ids, c.ID)
+		}
+	}
+
+	if len(ids) == 0 {
+		return []ItemSummary{}, nil
+	}
+
+	matches, err := mapItemsSummaryErr(base.Clone().
+		Where(item.IDIn(ids...)).
+		Order(ent.Asc(item.FieldName)).
+		WithLabel().
+		WithLocation().
+		All(ctx),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		return scores[matches[i].ID] > scores[matches[j].ID]
+	})
+
+	return matches, nil
+}
+
+// normalizeItemName lowercases the name and collapses its whitespace so that names only
+// differing in case or spacing are identical.
+func normalizeItemName(name string) string {
+	return strings.Join(strings.Fields(strings.ToLower(name)), " ")
+}
+
+// nameSimilarity returns the similarity of the two names between 0 and 1, based on their
+// Levenshtein distance relative to the length of the longer name.
+func nameSimilarity(a, b string) float64 {
+	ra, rb := []rune(a), []rune(b)
+
+	longest := len(ra)
+	if len(rb) > longest {
+		longest = len(rb)
+	}
+
+	if longest == 0 {
+		return 1
+	}
+
+	return 1 - float64(levenshtein(ra, rb))/float64(longest)
+}
+
+func levenshtein(a, b []rune) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+
+			curr[j] = minInt(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+
+		prev, curr = curr, prev
+	}
+
+	return prev[len(b)]
+}
+
+func minInt(first int, rest ...int) int {
+	m := first
+	for _, v := range rest {
+		if v < m {
+			m = v
+		}
+	}
+	return m
+}
@@ -0,0 +1,68 @@
+package repo
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestItemsRepository_FindPotentialDuplicates(t *testing.T) {
+	ctx := context.Background()
+
+	g, err := tRepos.Groups.GroupCreate(ctx, "duplicates")
+	require.NoError(t, err)
+
+	loc, err := tRepos.Locations.Create(ctx, g.ID, locationFactory())
+	require.NoError(t, err)
+
+	create := func(name, serial string) ItemOut {
+		itm, err := tRepos.Items.Create(ctx, g.ID, ItemCreate{
+			Name:         name,
+			SerialNumber: serial,
+			LocationID:   loc.ID,
+		})
+		require.NoError(t, err)
+		return itm
+	}
+
+	drill := create("Cordless Drill", "SN-12345")
+	drills := create("Cordless Drills", "")
+	create("Hammer", "")
+
+	// the serial number match wins over the name matches
+	matches, err := tRepos.Items.FindPotentialDuplicates(ctx, g.ID, ItemCreate{
+		Name:         "Cordless Drill",
+		SerialNumber: "sn-12345",
+	})
+	require.NoError(t, err)
+	require.Len(t, matches, 1)
+	assert.Equal(t, drill.ID, matches[0].ID)
+
+	// near-identical names, the closest match first
+	matches, err = tRepos.Items.FindPotentialDuplicates(ctx, g.ID, ItemCreate{
+		Name:         "cordless  drill",
+		SerialNumber: "SN-00000",
+	})
+	require.NoError(t, err)
+	require.Len(t, matches, 2)
+	assert.Equal(t, drill.ID, matches[0].ID)
+	assert.Equal(t, drills.ID, matches[1].ID)
+
+	matches, err = tRepos.Items.FindPotentialDuplicates(ctx, g.ID, ItemCreate{Name: "Screwdriver"})
+	require.NoError(t, err)
+	assert.Empty(t, matches)
+
+	// items of other groups are never matched
+	matches, err = tRepos.Items.FindPotentialDuplicates(ctx, tGroup.ID, ItemCreate{SerialNumber: "SN-12345"})
+	require.NoError(t, err)
+	assert.Empty(t, matches)
+}
+
+func TestNameSimilarity(t *testing.T) {
+	assert.InDelta(t, 1.0, nameSimilarity("drill", "drill"), 0.001)
+	assert.InDelta(t, 0.8, nameSimilarity("drill", "drall"), 0.001)
+	assert.InDelta(t, 0.0, nameSimilarity("abc", "xyz"), 0.001)
+	assert.InDelta(t, 1.0, nameSimilarity("", ""), 0.001)
+}
@@ -0,0 +1,206 @@
+package repo
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hay-kot/homebox/backend/internal/data/ent"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/group"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/item"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/itemevent"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/itemfield"
+	"github.com/hay-kot/homebox/backend/internal/data/types"
+)
+
+type ItemEvent struct {
+	ID        uuid.UUID                    `json:"id"`
+	CreatedAt time.Time                    `json:"createdAt"`
+	ItemID    uuid.UUID                    `json:"itemId"`
+	Type      string                       `json:"type"`
+	UserID    *uuid.UUID                   `json:"userId,omitempty" extensions:"x-nullable,x-omitempty"`
+	UserName  string                       `json:"userName"`
+	Changes   map[string]types.FieldChange `json:"changes"`
+}
+
+var mapItemEventsErr = mapTEachErrFunc(mapItemEvent)
+
+func mapItemEvent(event *ent.ItemEvent) ItemEvent {
+	out := ItemEvent{
+		ID:        event.ID,
+		CreatedAt: event.CreatedAt,
+		ItemID:    event.ItemID,
+		Type:      event.Type.String(),
+		Changes:   event.Changes,
+	}
+
+	if event.Edges.User != nil {
+		out.UserID = &event.Edges.User.ID
+		out.UserName = event.Edges.User.Name
+	}
+
+	return out
+}
+
+type actorCtxKey struct{}
+
+// WithActor returns a copy of the context that attributes the item changes made with it to
+// the user.
+func WithActor(ctx context.Context, userID uuid.UUID) context.Context {
+	return context.WithValue(ctx, actorCtxKey{}, userID)
+}
+
+// actorFromContext returns the user set by WithActor, or uuid.Nil when there is none.
+func actorFromContext(ctx context.Context) uuid.UUID {
+	if v, ok := ctx.Value(actorCtxKey{}).(uuid.UUID); ok {
+		return v
+	}
+	return uuid.Nil
+}
+
+// itemAuditFields are the fields compared by diffItems, keyed by their column name.
+var itemAuditFields = map[string]func(*ent.Item) any{
+	item.FieldName:                func(i *ent.Item) any { return i.Name },
+	item.FieldDescription:         func(i *ent.Item) any { return i.Description },
+	item.FieldNotes:               func(i *ent.Item) any { return i.Notes },
+	item.FieldNotesFormat:         func(i *ent.Item) any { return i.NotesFormat.String() },
+	item.FieldQuantity:            func(i *ent.Item) any { return i.Quantity },
+	item.FieldInsured:             func(i *ent.Item) any { return i.Insured },
+	item.FieldArchived:            func(i *ent.Item) any { return i.Archived },
+	item.FieldAssetID:             func(i *ent.Item) any { return i.AssetID },
+	item.FieldReorderThreshold:    func(i *ent.Item) any { return i.ReorderThreshold },
+	item.FieldTags:                func(i *ent.Item) any { return i.Tags },
+	item.FieldAcquisitionType:     func(i *ent.Item) any { return i.AcquisitionType.String() },
+	item.FieldLatitude:            func(i *ent.Item) any { return i.Latitude },
+	item.FieldLongitude:           func(i *ent.Item) any { return i.Longitude },
+	item.FieldSerialNumber:        func(i *ent.Item) any { return i.SerialNumber },
+	item.FieldBarcode:             func(i *ent.Item) any { return i.Barcode },
+	item.FieldModelNumber:         func(i *ent.Item) any { return i.ModelNumber },
+	item.FieldManufacturer:        func(i *ent.Item) any { return i.Manufacturer },
+	item.FieldLifetimeWarranty:    func(i *ent.Item) any { return i.LifetimeWarranty },
+	item.FieldWarrantyExpires:     func(i *ent.Item) any { return i.WarrantyExpires },
+	item.FieldWarrantyDetails:     func(i *ent.Item) any { return i.WarrantyDetails },
+	item.FieldPurchaseTime:        func(i *ent.Item) any { return i.PurchaseTime },
+	item.FieldPurchaseFrom:        func(i *ent.Item) any { return i.PurchaseFrom },
+	item.FieldPurchaseOrderNumber: func(i *ent.Item) any { return i.PurchaseOrderNumber },
+	item.FieldPurchasePrice:       func(i *ent.Item) any { return i.PurchasePrice },
+	item.FieldReplacementCost:     func(i *ent.Item) any { return i.ReplacementCost },
+	item.FieldCurrency:            func(i *ent.Item) any { return i.Currency },
+	item.FieldSoldTime:            func(i *ent.Item) any { return i.SoldTime },
+	item.FieldSoldTo:              func(i *ent.Item) any { return i.SoldTo },
+	item.FieldSoldPrice:           func(i *ent.Item) any { return i.SoldPrice },
+	item.FieldSoldNotes:           func(i *ent.Item) any { return i.SoldNotes },
+	item.EdgeLocation: func(i *ent.Item) any {
+		if i.Edges.Location == nil {
+			return nil
+		}
+		return i.Edges.Location.ID
+	},
+	item.EdgeParent: func(i *ent.Item) any {
+		if i.Edges.Parent == nil {
+			return nil
+		}
+		return i.Edges.Parent.ID
+	},
+	item.EdgeLabel: func(i *ent.Item) any {
+		ids := make([]string, 0, len(i.Edges.Label))
+		for _, l := range i.Edges.Label {
+			ids = append(ids, l.ID.String())
+		}
+		sort.Strings(ids)
+		return ids
+	},
+	item.EdgeFields: func(i *ent.Item) any {
+		fields := make(map[string]any, len(i.Edges.Fields))
+		for _, f := range i.Edges.Fields {
+			switch f.Type {
+			case itemfield.TypeNumber:
+				fields[f.Name] = f.NumberValue
+			case itemfield.TypeBoolean:
+				fields[f.Name] = f.BooleanValue
+			case itemfield.TypeTime:
+				fields[f.Name] = f.TimeValue
+			default:
+				fields[f.Name] = f.TextValue
+			}
+		}
+		return fields
+	},
+}
+
+// diffItems returns the fields that differ between the two snapshots of an item. Both
+// snapshots are expected to be loaded by itemSnapshot.
+func diffItems(old, new *ent.Item) map[string]types.FieldChange {
+	changes := map[string]types.FieldChange{}
+
+	for name, value := range itemAuditFields {
+		o, n := value(old), value(new)
+		if !reflect.DeepEqual(o, n) {
+			changes[name] = types.FieldChange{Old: o, New: n}
+		}
+	}
+
+	return changes
+}
+
+// itemSnapshot loads the item of the group with the edges compared by diffItems.
+func itemSnapshot(ctx context.Context, db *ent.Client, GID, ID uuid.UUID) (*ent.Item, error) {
+	return db.Item.Query().
+		Where(
+			item.ID(ID),
+			item.HasGroupWith(group.ID(GID)),
+		).
+		WithLocation().
+		WithParent().
+		WithLabel().
+		WithFields().
+		Only(ctx)
+}
+
+// recordItemUpdate records an update event with the fields of the item that changed since
+// the before snapshot was taken. Nothing is recorded when no field changed. Pass the client
+// of the transaction that made the update so the event is committed with it.
+func recordItemUpdate(ctx context.Context, db *ent.Client, GID uuid.UUID, before *ent.Item) error {
+	after, err := itemSnapshot(ctx, db, GID, before.ID)
+	if err != nil {
+		return err
+	}
+
+	changes := diffItems(before, after)
+	if len(changes) == 0 {
+		return nil
+	}
+
+	return createItemEvent(ctx, db, GID, before.ID, actorFromContext(ctx), itemevent.TypeUpdate, changes)
+}
+
+// createItemEvent records an event for the item. The userID may be uuid.Nil when the
+// change isn't attributed to a user.
+func createItemEvent(ctx context.Context, db *ent.Client, GID, itemID, userID uuid.UUID, typ itemevent.Type, changes map[string]types.FieldChange) error {
+	q := db.ItemEvent.Create().
+		SetGroupID(GID).
+		SetItemID(itemID).
+		SetType(typ).
+		SetChanges(changes)
+
+	if userID != uuid.Nil {
+		q.SetUserID(userID)
+	}
+
+	return q.Exec(ctx)
+}
+
+// GetItemHistory returns the events recorded for the item, newest first.
+func (e *ItemsRepository) GetItemHistory(ctx context.Context, GID, itemID uuid.UUID) ([]ItemEvent, error) {
+	return mapItemEventsErr(e.db.ItemEvent.Query().
+		Where(
+			itemevent.ItemID(itemID),
+			itemevent.HasGroupWith(group.ID(GID)),
+		).
+		WithUser().
+		Order(ent.Desc(itemevent.FieldCreatedAt)).
+		All(ctx),
+	)
+}
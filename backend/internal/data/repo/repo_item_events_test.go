@@ -0,0 +1,132 @@
+package repo
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestItemsRepository_SetCountedQuantity(t *testing.T) {
+	ctx := context.Background()
+	itm := useItems(t, 1)[0]
+
+	_, err := tRepos.Items.SetCountedQuantity(ctx, tGroup.ID, itm.ID, -1, tUser.ID)
+	require.ErrorIs(t, err, ErrNegativeQuantity)
+
+	got, err := tRepos.Items.SetCountedQuantity(ctx, tGroup.ID, itm.ID, 7, tUser.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 7, got.Quantity)
+
+	history, err := tRepos.Items.GetItemHistory(ctx, tGroup.ID, itm.ID)
+	require.NoError(t, err)
+	// the creation of the item is recorded as well
+	require.Len(t, history, 2)
+	assert.Equal(t, "create", history[1].Type)
+
+	event := history[0]
+	assert.Equal(t, "adjustment", event.Type)
+	require.NotNil(t, event.UserID)
+	assert.Equal(t, tUser.ID, *event.UserID)
+	require.Contains(t, event.Changes, "quantity")
+	assert.EqualValues(t, itm.Quantity, event.Changes["quantity"].Old)
+	assert.EqualValues(t, 7, event.Changes["quantity"].New)
+
+	// items from other groups can't be counted
+	_, err = tRepos.Items.SetCountedQuantity(ctx, uuid.New(), itm.ID, 3, tUser.ID)
+	assert.Error(t, err)
+
+	other, err := tRepos.Items.GetItemHistory(ctx, uuid.New(), itm.ID)
+	require.NoError(t, err)
+	assert.Empty(t, other)
+}
+
+func TestItemsRepository_GetItemHistory_Audit(t *testing.T) {
+	ctx := WithActor(context.Background(), tUser.ID)
+
+	loc := useLocations(t, 1)[0]
+
+	data := itemFactory()
+	data.LocationID = loc.ID
+
+	itm, err := tRepos.Items.Create(ctx, tGroup.ID, data)
+	require.NoError(t, err)
+
+	update := ItemUpdate{
+		ID:          itm.ID,
+		Name:        "renamed",
+		Description: itm.Description,
+		LocationID:  loc.ID,
+		Quantity:    itm.Quantity,
+	}
+
+	_, err = tRepos.Items.UpdateByGroup(ctx, tGroup.ID, update)
+	require.NoError(t, err)
+
+	// an update without changes isn't recorded
+	_, err = tRepos.Items.UpdateByGroup(ctx, tGroup.ID, update)
+	require.NoError(t, err)
+
+	err = tRepos.Items.DeleteByGroup(ctx, tGroup.ID, itm.ID)
+	require.NoError(t, err)
+
+	history, err := tRepos.Items.GetItemHistory(ctx, tGroup.ID, itm.ID)
+	require.NoError(t, err)
+	require.Len(t, history, 3)
+
+	assert.Equal(t, "delete", history[0].Type)
+	assert.Equal(t, "update", history[1].Type)
+	assert.Equal(t, "create", history[2].Type)
+
+	for _, event := range history {
+		require.NotNil(t, event.UserID)
+		assert.Equal(t, tUser.ID, *event.UserID)
+	}
+
+	changes := history[1].Changes
+	require.Len(t, changes, 1)
+	require.Contains(t, changes, "name")
+	assert.Equal(t, data.Name, changes["name"].Old)
+	assert.Equal(t, "renamed", changes["name"].New)
+
+	_, err = tRepos.Items.PurgeArchived(ctx, tGroup.ID, 0)
+	require.NoError(t, err)
+}
+
+func TestItemsRepository_GetItemHistory_AuditEdges(t *testing.T) {
+	ctx := context.Background()
+
+	items := useItems(t, 2)
+	itm, parent := items[0], items[1]
+	label := useLabels(t, 1)[0]
+
+	_, err := tRepos.Items.UpdateByGroup(ctx, tGroup.ID, ItemUpdate{
+		ID:          itm.ID,
+		Name:        itm.Name,
+		Description: itm.Description,
+		LocationID:  itm.Location.ID,
+		Quantity:    itm.Quantity,
+		LabelIDs:    []uuid.UUID{label.ID},
+		ParentID:    parent.ID,
+		Fields:      []ItemField{{Type: "text", Name: "color", TextValue: "red"}},
+	})
+	require.NoError(t, err)
+
+	history, err := tRepos.Items.GetItemHistory(ctx, tGroup.ID, itm.ID)
+	require.NoError(t, err)
+	require.Equal(t, "update", history[0].Type)
+
+	changes := history[0].Changes
+	require.Len(t, changes, 3)
+
+	assert.Nil(t, changes["parent"].Old)
+	assert.Equal(t, parent.ID.String(), changes["parent"].New)
+
+	assert.Empty(t, changes["label"].Old)
+	assert.Equal(t, []any{label.ID.String()}, changes["label"].New)
+
+	assert.Empty(t, changes["fields"].Old)
+	assert.Equal(t, map[string]any{"color": "red"}, changes["fields"].New)
+}
@@ -0,0 +1,151 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/hay-kot/homebox/backend/internal/data/ent"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/group"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/item"
+)
+
+var (
+	ErrItemCheckedOut    = errors.New("item is already checked out")
+	ErrItemNotCheckedOut = errors.New("item is not checked out")
+	ErrBorrowerRequired  = errors.New("borrower is required")
+)
+
+type (
+	// ItemLoanStatus describes who currently has the item.
+	ItemLoanStatus struct {
+		Borrower     string     `json:"borrower"`
+		CheckedOutAt time.Time  `json:"checkedOutAt"`
+		Due          *time.Time `json:"due,omitempty" extensions:"x-nullable,x-omitempty"`
+	}
+
+	ItemLoan struct {
+		Item ItemSummary `json:"item"`
+		ItemLoanStatus
+	}
+)
+
+// mapItemLoanStatus returns the loan status of the item, or nil when it isn't checked out.
+func mapItemLoanStatus(itm *ent.Item) *ItemLoanStatus {
+	if itm.LoanedAt == nil {
+		return nil
+	}
+
+	return &ItemLoanStatus{
+		Borrower:     itm.LoanedTo,
+		CheckedOutAt: *itm.LoanedAt,
+		Due:          itm.LoanDue,
+	}
+}
+
+func mapItemLoan(itm *ent.Item) ItemLoan {
+	return ItemLoan{
+		Item:           mapItemSummary(itm),
+		ItemLoanStatus: *mapItemLoanStatus(itm),
+	}
+}
+
+var mapItemLoansErr = mapTEachErrFunc(mapItemLoan)
+
+// CheckoutItem lends the item to the borrower until the optional due date. An item that is
+// already checked out has to be checked in first.
+func (e *ItemsRepository) CheckoutItem(ctx context.Context, GID, ID uuid.UUID, borrower string, due *time.Time) (ItemOut, error) {
+	borrower = strings.TrimSpace(borrower)
+	if borrower == "" {
+		return ItemOut{}, ErrBorrowerRequired
+	}
+
+	itm, err := e.db.Item.Query().
+		Where(
+			item.ID(ID),
+			item.HasGroupWith(group.ID(GID)),
+		).
+		Only(ctx)
+	if err != nil {
+		return ItemOut{}, err
+	}
+
+	if itm.LoanedAt != nil {
+		return ItemOut{}, ErrItemCheckedOut
+	}
+
+	// the loaned_at condition guards against a concurrent checkout of the same item
+	n, err := e.db.Item.Update().
+		Where(
+			item.ID(ID),
+			item.LoanedAtIsNil(),
+		).
+		SetLoanedTo(borrower).
+		SetLoanedAt(time.Now()).
+		SetNillableLoanDue(due).
+		Save(ctx)
+	if err != nil {
+		return ItemOut{}, err
+	}
+
+	if n == 0 {
+		return ItemOut{}, ErrItemCheckedOut
+	}
+
+	e.publishMutationEvent(GID)
+	return e.GetOne(ctx, ID)
+}
+
+// CheckinItem returns a checked out item and clears its loan.
+func (e *ItemsRepository) CheckinItem(ctx context.Context, GID, ID uuid.UUID) (ItemOut, error) {
+	_, err := e.db.Item.Query().
+		Where(
+			item.ID(ID),
+			item.HasGroupWith(group.ID(GID)),
+		).
+		OnlyID(ctx)
+	if err != nil {
+		return ItemOut{}, err
+	}
+
+	n, err := e.db.Item.Update().
+		Where(
+			item.ID(ID),
+			item.LoanedAtNotNil(),
+		).
+		ClearLoanedTo().
+		ClearLoanedAt().
+		ClearLoanDue().
+		Save(ctx)
+	if err != nil {
+		return ItemOut{}, err
+	}
+
+	if n == 0 {
+		return ItemOut{}, ErrItemNotCheckedOut
+	}
+
+	e.publishMutationEvent(GID)
+	return e.GetOne(ctx, ID)
+}
+
+// QueryCheckedOut returns the loans of the group's checked out items, the ones due soonest
+// first. Loans without a due date are listed last.
+func (e *ItemsRepository) QueryCheckedOut(ctx context.Context, GID uuid.UUID) ([]ItemLoan, error) {
+	return mapItemLoansErr(e.db.Item.Query().
+		Where(
+			item.HasGroupWith(group.ID(GID)),
+			item.LoanedAtNotNil(),
+		).
+		Order(
+			item.ByLoanDue(sql.OrderNullsLast()),
+			item.ByLoanedAt(),
+		).
+		WithLabel().
+		WithLocation().
+		All(ctx),
+	)
+}
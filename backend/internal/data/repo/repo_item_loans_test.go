@@ -0,0 +1,93 @@
+package repo
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestItemsRepository_CheckoutItem(t *testing.T) {
+	ctx := context.Background()
+	items := useItems(t, 1)
+	itm := items[0]
+
+	due := time.Now().Add(7 * 24 * time.Hour)
+
+	out, err := tRepos.Items.CheckoutItem(ctx, tGroup.ID, itm.ID, "  Jane  ", &due)
+	require.NoError(t, err)
+	require.NotNil(t, out.Loan)
+	assert.Equal(t, "Jane", out.Loan.Borrower)
+	require.NotNil(t, out.Loan.Due)
+	assert.WithinDuration(t, due, *out.Loan.Due, time.Second)
+
+	_, err = tRepos.Items.CheckoutItem(ctx, tGroup.ID, itm.ID, "John", nil)
+	assert.ErrorIs(t, err, ErrItemCheckedOut)
+
+	out, err = tRepos.Items.GetOneByGroup(ctx, tGroup.ID, itm.ID)
+	require.NoError(t, err)
+	require.NotNil(t, out.Loan)
+	assert.Equal(t, "Jane", out.Loan.Borrower)
+
+	out, err = tRepos.Items.CheckinItem(ctx, tGroup.ID, itm.ID)
+	require.NoError(t, err)
+	assert.Nil(t, out.Loan)
+
+	_, err = tRepos.Items.CheckinItem(ctx, tGroup.ID, itm.ID)
+	assert.ErrorIs(t, err, ErrItemNotCheckedOut)
+
+	// can be checked out again once returned
+	out, err = tRepos.Items.CheckoutItem(ctx, tGroup.ID, itm.ID, "John", nil)
+	require.NoError(t, err)
+	require.NotNil(t, out.Loan)
+	assert.Equal(t, "John", out.Loan.Borrower)
+	assert.Nil(t, out.Loan.Due)
+
+	_, err = tRepos.Items.CheckinItem(ctx, tGroup.ID, itm.ID)
+	require.NoError(t, err)
+}
+
+func TestItemsRepository_CheckoutItem_Validation(t *testing.T) {
+	ctx := context.Background()
+	items := useItems(t, 1)
+
+	_, err := tRepos.Items.CheckoutItem(ctx, tGroup.ID, items[0].ID, " ", nil)
+	assert.ErrorIs(t, err, ErrBorrowerRequired)
+
+	_, err = tRepos.Items.CheckoutItem(ctx, uuid.New(), items[0].ID, "Jane", nil)
+	assert.Error(t, err)
+}
+
+func TestItemsRepository_QueryCheckedOut(t *testing.T) {
+	ctx := context.Background()
+
+	g, items := useGroupItems(t, make([]ItemUpdate, 4)...)
+
+	ids := make([]uuid.UUID, len(items))
+	for i, itm := range items {
+		ids[i] = itm.ID
+	}
+
+	soon := time.Now().Add(24 * time.Hour)
+	later := time.Now().Add(48 * time.Hour)
+
+	_, err := tRepos.Items.CheckoutItem(ctx, g.ID, ids[0], "No Due Date", nil)
+	require.NoError(t, err)
+	_, err = tRepos.Items.CheckoutItem(ctx, g.ID, ids[1], "Later", &later)
+	require.NoError(t, err)
+	_, err = tRepos.Items.CheckoutItem(ctx, g.ID, ids[2], "Soon", &soon)
+	require.NoError(t, err)
+
+	loans, err := tRepos.Items.QueryCheckedOut(ctx, g.ID)
+	require.NoError(t, err)
+	require.Len(t, loans, 3)
+
+	assert.Equal(t, ids[2], loans[0].Item.ID)
+	assert.Equal(t, "Soon", loans[0].Borrower)
+	assert.Equal(t, ids[1], loans[1].Item.ID)
+	assert.Equal(t, ids[0], loans[2].Item.ID)
+	assert.Nil(t, loans[2].Due)
+}
@@ -0,0 +1,87 @@
+package repo
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hay-kot/homebox/backend/internal/data/ent"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/group"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/savedsearch"
+	"github.com/hay-kot/homebox/backend/internal/data/types"
+)
+
+// SavedSearch is an item query stored under a name. Only the filters of the query are
+// kept, see types.SavedSearchFilter; paging and sorting are chosen when it is run.
+type SavedSearch struct {
+	ID        uuid.UUID `json:"id"`
+	CreatedAt time.Time `json:"createdAt"`
+	Name      string    `json:"name"`
+	Query     ItemQuery `json:"query"`
+}
+
+var mapSavedSearchesErr = mapTEachErrFunc(mapSavedSearch)
+
+func mapSavedSearch(s *ent.SavedSearch) SavedSearch {
+	return SavedSearch{
+		ID:        s.ID,
+		CreatedAt: s.CreatedAt,
+		Name:      s.Name,
+		Query: ItemQuery{
+			Search:           s.Query.Search,
+			LabelIDs:         s.Query.LabelIDs,
+			LabelsMatchAll:   s.Query.LabelsMatchAll,
+			LocationIDs:      s.Query.LocationIDs,
+			MinPurchasePrice: s.Query.MinPurchasePrice,
+			MaxPurchasePrice: s.Query.MaxPurchasePrice,
+		},
+	}
+}
+
+// SaveSearch stores the filters of the query as a named search of the group.
+func (e *ItemsRepository) SaveSearch(ctx context.Context, GID uuid.UUID, name string, q ItemQuery) (SavedSearch, error) {
+	s, err := e.db.SavedSearch.Create().
+		SetGroupID(GID).
+		SetName(name).
+		SetQuery(types.SavedSearchFilter{
+			Search:           q.Search,
+			LabelIDs:         q.LabelIDs,
+			LabelsMatchAll:   q.LabelsMatchAll,
+			LocationIDs:      q.LocationIDs,
+			MinPurchasePrice: q.MinPurchasePrice,
+			MaxPurchasePrice: q.MaxPurchasePrice,
+		}).
+		Save(ctx)
+	if err != nil {
+		return SavedSearch{}, err
+	}
+
+	return mapSavedSearch(s), nil
+}
+
+// ListSavedSearches returns the saved searches of the group ordered by name.
+func (e *ItemsRepository) ListSavedSearches(ctx context.Context, GID uuid.UUID) ([]SavedSearch, error) {
+	return mapSavedSearchesErr(e.db.SavedSearch.Query().
+		Where(savedsearch.HasGroupWith(group.ID(GID))).
+		Order(ent.Asc(savedsearch.FieldName)).
+		All(ctx),
+	)
+}
+
+// RunSavedSearch runs the saved search with QueryByGroup and returns all matching items.
+func (e *ItemsRepository) RunSavedSearch(ctx context.Context, GID, ID uuid.UUID) (PaginationResult[ItemSummary], error) {
+	s, err := e.db.SavedSearch.Query().
+		Where(
+			savedsearch.ID(ID),
+			savedsearch.HasGroupWith(group.ID(GID)),
+		).
+		Only(ctx)
+	if err != nil {
+		return PaginationResult[ItemSummary]{}, err
+	}
+
+	q := mapSavedSearch(s).Query
+	q.Unpaginated = true
+
+	return e.QueryByGroup(ctx, GID, q)
+}
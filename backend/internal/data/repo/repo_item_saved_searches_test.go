@@ -0,0 +1,63 @@
+package repo
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestItemsRepository_SavedSearches(t *testing.T) {
+	ctx := context.Background()
+
+	g, items := useGroupItems(t,
+		ItemUpdate{PurchasePrice: 50},
+		ItemUpdate{PurchasePrice: 500}, // too expensive
+		ItemUpdate{PurchasePrice: 50},  // missing the label
+	)
+	match := items[0]
+
+	lbl, err := tRepos.Labels.Create(ctx, g.ID, LabelCreate{Name: "tools"})
+	require.NoError(t, err)
+
+	for _, itm := range items[:2] {
+		_, err := tRepos.Items.SetLabels(ctx, g.ID, itm.ID, []uuid.UUID{lbl.ID})
+		require.NoError(t, err)
+	}
+
+	minPrice, maxPrice := 10.0, 100.0
+
+	saved, err := tRepos.Items.SaveSearch(ctx, g.ID, "cheap tools", ItemQuery{
+		Page:             2,
+		LabelIDs:         []uuid.UUID{lbl.ID},
+		MinPurchasePrice: &minPrice,
+		MaxPurchasePrice: &maxPrice,
+	})
+	require.NoError(t, err)
+
+	list, err := tRepos.Items.ListSavedSearches(ctx, g.ID)
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+	assert.Equal(t, saved.ID, list[0].ID)
+	assert.Equal(t, "cheap tools", list[0].Name)
+	assert.Equal(t, []uuid.UUID{lbl.ID}, list[0].Query.LabelIDs)
+	require.NotNil(t, list[0].Query.MinPurchasePrice)
+	require.NotNil(t, list[0].Query.MaxPurchasePrice)
+	assert.Equal(t, minPrice, *list[0].Query.MinPurchasePrice)
+	assert.Equal(t, maxPrice, *list[0].Query.MaxPurchasePrice)
+	assert.Zero(t, list[0].Query.Page)
+
+	results, err := tRepos.Items.RunSavedSearch(ctx, g.ID, saved.ID)
+	require.NoError(t, err)
+	require.Len(t, results.Items, 1)
+	assert.Equal(t, match.ID, results.Items[0].ID)
+
+	_, err = tRepos.Items.RunSavedSearch(ctx, tGroup.ID, saved.ID)
+	require.Error(t, err)
+
+	others, err := tRepos.Items.ListSavedSearches(ctx, tGroup.ID)
+	require.NoError(t, err)
+	assert.Empty(t, others)
+}
@@ -0,0 +1,198 @@
+package repo
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hay-kot/homebox/backend/internal/data/ent"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/group"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/item"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/itemtemplate"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/label"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/location"
+	"github.com/hay-kot/homebox/backend/internal/data/types"
+)
+
+// ItemTemplate is a named set of item defaults that new items can be created from, see
+// types.ItemTemplateDefaults for the fields that are kept.
+type ItemTemplate struct {
+	ID        uuid.UUID                  `json:"id"`
+	CreatedAt time.Time                  `json:"createdAt"`
+	Name      string                     `json:"name"`
+	Defaults  types.ItemTemplateDefaults `json:"defaults"`
+}
+
+var mapItemTemplatesErr = mapTEachErrFunc(mapItemTemplate)
+
+func mapItemTemplate(t *ent.ItemTemplate) ItemTemplate {
+	return ItemTemplate{
+		ID:        t.ID,
+		CreatedAt: t.CreatedAt,
+		Name:      t.Name,
+		Defaults:  t.Defaults,
+	}
+}
+
+// SaveTemplate stores the item data as a named template of the group. The serial and
+// purchase order numbers are specific to a single item and aren't kept.
+func (e *ItemsRepository) SaveTemplate(ctx context.Context, GID uuid.UUID, name string, data ItemCreate) (ItemTemplate, error) {
+	err := checkLabelsInGroup(ctx, e.db, GID, data.LabelIDs)
+	if err != nil {
+		return ItemTemplate{}, err
+	}
+
+	return e.saveTemplate(ctx, GID, name, types.ItemTemplateDefaults{
+		Name:            data.Name,
+		Description:     data.Description,
+		AcquisitionType: data.AcquisitionType,
+		Barcode:         data.Barcode,
+		LocationID:      data.LocationID,
+		LabelIDs:        data.LabelIDs,
+		Tags:            normalizeTags(data.Tags),
+	})
+}
+
+// SaveItemAsTemplate stores the description, identification, warranty defaults, location
+// and labels of an existing item as a named template of the group.
+func (e *ItemsRepository) SaveItemAsTemplate(ctx context.Context, GID, itemID uuid.UUID, name string) (ItemTemplate, error) {
+	src, err := e.db.Item.Query().
+		Where(
+			item.ID(itemID),
+			item.HasGroupWith(group.ID(GID)),
+		).
+		WithLabel(func(lq *ent.LabelQuery) {
+			lq.Select(label.FieldID)
+		}).
+		WithLocation().
+		Only(ctx)
+	if err != nil {
+		return ItemTemplate{}, err
+	}
+
+	defaults := types.ItemTemplateDefaults{
+		Name:             src.Name,
+		Description:      src.Description,
+		Quantity:         src.Quantity,
+		Insured:          src.Insured,
+		AcquisitionType:  src.AcquisitionType.String(),
+		Manufacturer:     src.Manufacturer,
+		ModelNumber:      src.ModelNumber,
+		Barcode:          src.Barcode,
+		LifetimeWarranty: src.LifetimeWarranty,
+		WarrantyDetails:  src.WarrantyDetails,
+		Tags:             src.Tags,
+	}
+
+	if src.Edges.Location != nil {
+		defaults.LocationID = src.Edges.Location.ID
+	}
+
+	for _, l := range src.Edges.Label {
+		defaults.LabelIDs = append(defaults.LabelIDs, l.ID)
+	}
+
+	return e.saveTemplate(ctx, GID, name, defaults)
+}
+
+func (e *ItemsRepository) saveTemplate(ctx context.Context, GID uuid.UUID, name string, defaults types.ItemTemplateDefaults) (ItemTemplate, error) {
+	t, err := e.db.ItemTemplate.Create().
+		SetGroupID(GID).
+		SetName(name).
+		SetDefaults(defaults).
+		Save(ctx)
+	if err != nil {
+		return ItemTemplate{}, err
+	}
+
+	return mapItemTemplate(t), nil
+}
+
+// ListTemplates returns the item templates of the group ordered by name.
+func (e *ItemsRepository) ListTemplates(ctx context.Context, GID uuid.UUID) ([]ItemTemplate, error) {
+	return mapItemTemplatesErr(e.db.ItemTemplate.Query().
+		Where(itemtemplate.HasGroupWith(group.ID(GID))).
+		Order(ent.Asc(itemtemplate.FieldName)).
+		All(ctx),
+	)
+}
+
+// CreateFromTemplate creates an item from the template of the group. The fields set in the
+// overrides take precedence over the defaults of the template, the labels, tags and
+// location are replaced as a whole when set. Labels and the location of the template that
+// no longer exist in the group are skipped.
+func (e *ItemsRepository) CreateFromTemplate(ctx context.Context, GID, templateID uuid.UUID, overrides ItemCreate) (ItemOut, error) {
+	t, err := e.db.ItemTemplate.Query().
+		Where(
+			itemtemplate.ID(templateID),
+			itemtemplate.HasGroupWith(group.ID(GID)),
+		).
+		Only(ctx)
+	if err != nil {
+		return ItemOut{}, err
+	}
+
+	defaults := t.Defaults
+
+	data := overrides
+	if data.Name == "" {
+		data.Name = defaults.Name
+	}
+	if data.Description == "" {
+		data.Description = defaults.Description
+	}
+	if data.AcquisitionType == "" {
+		data.AcquisitionType = defaults.AcquisitionType
+	}
+	if data.Barcode == "" {
+		data.Barcode = defaults.Barcode
+	}
+	if data.Tags == nil {
+		data.Tags = defaults.Tags
+	}
+
+	if data.Quantity == 0 {
+		data.Quantity = defaults.Quantity
+	}
+	if data.Manufacturer == "" {
+		data.Manufacturer = defaults.Manufacturer
+	}
+	if data.ModelNumber == "" {
+		data.ModelNumber = defaults.ModelNumber
+	}
+	if data.WarrantyDetails == "" {
+		data.WarrantyDetails = defaults.WarrantyDetails
+	}
+	data.Insured = data.Insured || defaults.Insured
+	data.LifetimeWarranty = data.LifetimeWarranty || defaults.LifetimeWarranty
+
+	if data.LabelIDs == nil {
+		data.LabelIDs, err = e.db.Label.Query().
+			Where(
+				label.IDIn(defaults.LabelIDs...),
+				label.HasGroupWith(group.ID(GID)),
+			).
+			IDs(ctx)
+		if err != nil {
+			return ItemOut{}, err
+		}
+	}
+
+	if data.LocationID == uuid.Nil && defaults.LocationID != uuid.Nil {
+		ok, err := e.db.Location.Query().
+			Where(
+				location.ID(defaults.LocationID),
+				location.HasGroupWith(group.ID(GID)),
+			).
+			Exist(ctx)
+		if err != nil {
+			return ItemOut{}, err
+		}
+
+		if ok {
+			data.LocationID = defaults.LocationID
+		}
+	}
+
+	return e.Create(ctx, GID, data)
+}
@@ -0,0 +1,124 @@
+package repo
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hay-kot/homebox/backend/internal/data/ent"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestItemsRepository_Templates(t *testing.T) {
+	ctx := context.Background()
+
+	g, err := tRepos.Groups.GroupCreate(ctx, "item-templates")
+	require.NoError(t, err)
+
+	office, err := tRepos.Locations.Create(ctx, g.ID, LocationCreate{Name: "Office"})
+	require.NoError(t, err)
+
+	storage, err := tRepos.Locations.Create(ctx, g.ID, LocationCreate{Name: "Storage"})
+	require.NoError(t, err)
+
+	furniture, err := tRepos.Labels.Create(ctx, g.ID, LabelCreate{Name: "furniture"})
+	require.NoError(t, err)
+
+	spare, err := tRepos.Labels.Create(ctx, g.ID, LabelCreate{Name: "spare"})
+	require.NoError(t, err)
+
+	chair, err := tRepos.Items.Create(ctx, g.ID, ItemCreate{
+		Name:       "Office Chair",
+		LocationID: office.ID,
+		LabelIDs:   []uuid.UUID{furniture.ID},
+	})
+	require.NoError(t, err)
+
+	_, err = tRepos.Items.UpdateByGroup(ctx, g.ID, ItemUpdate{
+		ID:              chair.ID,
+		Name:            chair.Name,
+		Description:     "Ergonomic mesh chair",
+		LocationID:      office.ID,
+		LabelIDs:        []uuid.UUID{furniture.ID},
+		Quantity:        1,
+		Manufacturer:    "Acme",
+		ModelNumber:     "AC-200",
+		SerialNumber:    "SN-1",
+		WarrantyDetails: "5 years",
+		PurchasePrice:   250,
+		PurchaseFrom:    "Acme Store",
+	})
+	require.NoError(t, err)
+
+	tmpl, err := tRepos.Items.SaveItemAsTemplate(ctx, g.ID, chair.ID, "Chair")
+	require.NoError(t, err)
+	assert.Equal(t, "Chair", tmpl.Name)
+	assert.Equal(t, "Acme", tmpl.Defaults.Manufacturer)
+	assert.Equal(t, []uuid.UUID{furniture.ID}, tmpl.Defaults.LabelIDs)
+
+	desk, err := tRepos.Items.SaveTemplate(ctx, g.ID, "Desk", ItemCreate{
+		Name:         "Standing Desk",
+		SerialNumber: "SN-DESK",
+		LocationID:   storage.ID,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "Standing Desk", desk.Defaults.Name)
+
+	list, err := tRepos.Items.ListTemplates(ctx, g.ID)
+	require.NoError(t, err)
+	require.Len(t, list, 2)
+	assert.Equal(t, tmpl.ID, list[0].ID)
+	assert.Equal(t, desk.ID, list[1].ID)
+
+	t.Run("defaults", func(t *testing.T) {
+		got, err := tRepos.Items.CreateFromTemplate(ctx, g.ID, tmpl.ID, ItemCreate{
+			SerialNumber: "SN-5",
+		})
+		require.NoError(t, err)
+
+		assert.NotEqual(t, chair.ID, got.ID)
+		assert.Equal(t, "Office Chair", got.Name)
+		assert.Equal(t, "Ergonomic mesh chair", got.Description)
+		assert.Equal(t, "Acme", got.Manufacturer)
+		assert.Equal(t, "AC-200", got.ModelNumber)
+		assert.Equal(t, "5 years", got.WarrantyDetails)
+		assert.Equal(t, "SN-5", got.SerialNumber)
+		assert.Equal(t, office.ID, got.Location.ID)
+		require.Len(t, got.Labels, 1)
+		assert.Equal(t, furniture.ID, got.Labels[0].ID)
+
+		// purchase data isn't part of the template
+		assert.Zero(t, got.PurchasePrice)
+		assert.Empty(t, got.PurchaseFrom)
+	})
+
+	t.Run("overrides", func(t *testing.T) {
+		got, err := tRepos.Items.CreateFromTemplate(ctx, g.ID, tmpl.ID, ItemCreate{
+			Name:       "Office Chair #6",
+			LocationID: storage.ID,
+			LabelIDs:   []uuid.UUID{spare.ID},
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, "Office Chair #6", got.Name)
+		assert.Equal(t, "Acme", got.Manufacturer)
+		assert.Equal(t, storage.ID, got.Location.ID)
+		require.Len(t, got.Labels, 1)
+		assert.Equal(t, spare.ID, got.Labels[0].ID)
+	})
+
+	t.Run("serial isn't kept", func(t *testing.T) {
+		got, err := tRepos.Items.CreateFromTemplate(ctx, g.ID, desk.ID, ItemCreate{})
+		require.NoError(t, err)
+
+		assert.Equal(t, "Standing Desk", got.Name)
+		assert.Empty(t, got.SerialNumber)
+		assert.Equal(t, storage.ID, got.Location.ID)
+	})
+
+	t.Run("other group", func(t *testing.T) {
+		_, err := tRepos.Items.CreateFromTemplate(ctx, tGroup.ID, tmpl.ID, ItemCreate{})
+		assert.True(t, ent.IsNotFound(err))
+	})
+}
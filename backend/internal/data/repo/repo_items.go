@@ -2,25 +2,95 @@ package repo
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
 	"fmt"
+	"math"
+	"sort"
+	"strings"
 	"time"
+	"unicode/utf8"
 
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"github.com/google/uuid"
 	"github.com/hay-kot/homebox/backend/internal/core/services/reporting/eventbus"
 	"github.com/hay-kot/homebox/backend/internal/data/ent"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/attachment"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/group"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/item"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/itemcomment"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/itemevent"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/itemfield"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/label"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/location"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/maintenanceentry"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/predicate"
 	"github.com/hay-kot/homebox/backend/internal/data/types"
+	"github.com/hay-kot/homebox/backend/pkgs/set"
+)
+
+// maxBulkItems is the maximum number of items a single bulk operation is allowed to
+// modify. It guards against accidentally applying a change to an entire inventory.
+const maxBulkItems = 500
+
+var (
+	ErrBulkLimitExceeded = fmt.Errorf("bulk operations are limited to %d items", maxBulkItems)
+
+	ErrItemSelfLink    = errors.New("an item cannot be linked to itself")
+	ErrItemParentCycle = errors.New("an item cannot be its own ancestor")
+	ErrLabelNotInGroup = errors.New("label does not belong to the group")
+
+	ErrAttachmentNotWarranty = errors.New("attachment is not a warranty document")
+	ErrAttachmentNotPhoto    = errors.New("attachment is not a photo")
+	ErrNegativeQuantity      = errors.New("quantity cannot be negative")
+	ErrIncompleteCoordinates = errors.New("latitude and longitude must be set together")
+	ErrInvalidRadius         = errors.New("radius must be a positive number")
+	ErrAttachmentNoDate      = errors.New("attachment has no date set")
+	ErrInvalidCurrency       = errors.New("unsupported currency code")
+	ErrInvalidQuantityChange = errors.New("quantity change must be a positive number")
+	ErrVersionConflict       = errors.New("item was modified by someone else")
+	ErrInvalidNotesFormat    = errors.New("notes format must be plain or markdown")
+	ErrItemSelfMerge         = errors.New("an item cannot be merged into itself")
+	ErrEmptyBarcode          = errors.New("barcode cannot be empty")
+	ErrItemInTrash           = errors.New("item is in the trash, restore it instead")
 )
 
 type ItemsRepository struct {
-	db  *ent.Client
-	bus *eventbus.EventBus
+	db     *ent.Client
+	bus    *eventbus.EventBus
+	events EventBus
+	pages  PageBounds
+}
+
+// NewItemsRepository returns an items repository that publishes the domain events of item
+// changes to events, which may be nil.
+func NewItemsRepository(db *ent.Client, bus *eventbus.EventBus, events EventBus) *ItemsRepository {
+	return &ItemsRepository{
+		db:     db,
+		bus:    bus,
+		events: events,
+		pages:  DefaultPageBounds,
+	}
+}
+
+// SetPageBounds sets the page size limits of the paginated item queries. Limits that aren't
+// positive fall back to DefaultPageBounds.
+func (e *ItemsRepository) SetPageBounds(b PageBounds) {
+	if b.Max <= 0 {
+		b.Max = DefaultPageBounds.Max
+	}
+
+	if b.Default <= 0 {
+		b.Default = DefaultPageBounds.Default
+	}
+
+	if b.Default > b.Max {
+		b.Default = b.Max
+	}
+
+	e.pages = b
 }
 
 type (
@@ -30,17 +100,89 @@ type (
 	}
 
 	ItemQuery struct {
-		Page            int
-		PageSize        int
+		// Page and PageSize are bound by the repository's PageBounds, a page size of zero or
+		// less uses the default page size.
+		Page     int
+		PageSize int
+
+		// Unpaginated returns all matching items ignoring Page and PageSize. It can't be set
+		// by clients and is meant for callers within the server that need every item.
+		Unpaginated bool `json:"-"`
+
 		Search          string       `json:"search"`
 		AssetID         AssetID      `json:"assetId"`
 		LocationIDs     []uuid.UUID  `json:"locationIds"`
 		LabelIDs        []uuid.UUID  `json:"labelIds"`
+		LabelsMatchAll  bool         `json:"labelsMatchAll"`
+		ExcludeLabelIDs []uuid.UUID  `json:"excludeLabelIds"`
 		ParentItemIDs   []uuid.UUID  `json:"parentIds"`
 		SortBy          string       `json:"sortBy"`
 		IncludeArchived bool         `json:"includeArchived"`
+		IncludeTrashed  bool         `json:"includeTrashed"`
 		Fields          []FieldQuery `json:"fields"`
 		OrderBy         string       `json:"orderBy"`
+		Source          string       `json:"source"`
+		AcquisitionType string       `json:"acquisitionType"`
+		LabelColor      string       `json:"labelColor"`
+
+		// FavoritesOnly limits the query to favorite items, FavoritesFirst lists the favorite
+		// items before the others regardless of the sort order.
+		FavoritesOnly  bool `json:"favoritesOnly"`
+		FavoritesFirst bool `json:"favoritesFirst"`
+
+		// SearchFields restricts Search to the given fields, see itemSearchFields for the
+		// accepted keys. Unknown keys are ignored and all fields are searched when empty.
+		SearchFields []string `json:"searchFields"`
+
+		// MinPurchasePrice and MaxPurchasePrice limit the query to items with a purchase
+		// price within the inclusive bounds, either bound may be omitted.
+		MinPurchasePrice *float64 `json:"minPurchasePrice"`
+		MaxPurchasePrice *float64 `json:"maxPurchasePrice"`
+
+		// PurchaseOrderNumber limits the query to items bought under the given purchase
+		// order, the match is case-insensitive.
+		PurchaseOrderNumber string `json:"purchaseOrderNumber"`
+
+		// SnapshotAt limits the query to items created at or before the given time so that
+		// paging through a result set is repeatable. Items created after the snapshot never
+		// appear, however items updated after the snapshot are returned with their current
+		// data and deleted items are no longer returned, so page boundaries can still shift
+		// when items are removed or change in a way that affects the sort order.
+		SnapshotAt *time.Time `json:"snapshotAt"`
+
+		// CreatedAfter, CreatedBefore, UpdatedAfter and UpdatedBefore limit the query to items
+		// created or last updated within the inclusive bounds, any bound may be omitted.
+		CreatedAfter  *time.Time `json:"createdAfter"`
+		CreatedBefore *time.Time `json:"createdBefore"`
+		UpdatedAfter  *time.Time `json:"updatedAfter"`
+		UpdatedBefore *time.Time `json:"updatedBefore"`
+
+		// OlderThan limits the query to items purchased at least this long ago. Items
+		// without a purchase time are excluded.
+		OlderThan *time.Duration `json:"olderThan"`
+
+		// PurchasedOn limits the query to items purchased on the same calendar day. The day
+		// is taken in the location of the given time, so callers should pass a time in the
+		// user's timezone.
+		PurchasedOn *time.Time `json:"purchasedOn"`
+
+		// HasAttachments limits the query to items with at least one attachment when true
+		// and to items without any when false, nil doesn't filter.
+		HasAttachments *bool `json:"hasAttachments"`
+
+		// Tags limits the query to items with all of the given tags.
+		Tags []string `json:"tags"`
+
+		// Insured limits the query to insured or uninsured items, Sold to items with or
+		// without a sold time. Nil doesn't filter.
+		Insured *bool `json:"insured"`
+		Sold    *bool `json:"sold"`
+	}
+
+	ItemQueryResult struct {
+		PaginationResult[ItemSummary]
+		TotalPurchasePrice   float64 `json:"totalPurchasePrice,string"`
+		InsuredPurchasePrice float64 `json:"insuredPurchasePrice,string"`
 	}
 
 	ItemField struct {
@@ -59,21 +201,59 @@ type (
 		Name        string    `json:"name" validate:"required,min=1,max=255"`
 		Description string    `json:"description" validate:"max=1000"`
 		AssetID     AssetID   `json:"-"`
+		Source      string    `json:"-"` // defaults to "manual" when empty
+
+		// AcquisitionType defaults to "bought" when empty
+		AcquisitionType string `json:"acquisitionType" validate:"omitempty,oneof=bought gift inherited made found"`
+
+		PurchaseOrderNumber string `json:"purchaseOrderNumber" validate:"max=255"`
+		SerialNumber        string `json:"serialNumber" validate:"max=255"`
+		Barcode             string `json:"barcode" validate:"max=255"` // UPC or EAN
+
+		// Tags are free-text tags, they're trimmed and deduplicated when stored
+		Tags []string `json:"tags"`
+
+		// Set from the defaults of item templates, quantity defaults to 1 when 0
+		Quantity         int    `json:"-"`
+		Insured          bool   `json:"-"`
+		Manufacturer     string `json:"-"`
+		ModelNumber      string `json:"-"`
+		LifetimeWarranty bool   `json:"-"`
+		WarrantyDetails  string `json:"-"`
 
 		// Edges
 		LocationID uuid.UUID   `json:"locationId"`
 		LabelIDs   []uuid.UUID `json:"labelIds"`
+
+		// AttachmentURLs are downloaded and attached by ItemService.Create, the repository
+		// ignores them
+		AttachmentURLs []string `json:"attachmentUrls" validate:"dive,url"`
 	}
 
 	ItemUpdate struct {
-		ParentID    uuid.UUID `json:"parentId" extensions:"x-nullable,x-omitempty"`
-		ID          uuid.UUID `json:"id"`
-		AssetID     AssetID   `json:"assetId"`
-		Name        string    `json:"name"`
-		Description string    `json:"description"`
-		Quantity    int       `json:"quantity"`
-		Insured     bool      `json:"insured"`
-		Archived    bool      `json:"archived"`
+		ParentID uuid.UUID `json:"parentId" extensions:"x-nullable,x-omitempty"`
+		ID       uuid.UUID `json:"id"`
+		// Version is the version of the item the update is based on, the update fails with
+		// ErrVersionConflict when the item has changed since. A version of 0 skips the check.
+		Version     int     `json:"version"`
+		AssetID     AssetID `json:"assetId"`
+		Name        string  `json:"name"`
+		Description string  `json:"description"`
+		Quantity    int     `json:"quantity"`
+		Insured     bool    `json:"insured"`
+		Archived    bool    `json:"archived"`
+
+		ReorderThreshold int `json:"reorderThreshold"`
+
+		// AcquisitionType is left unchanged when empty
+		AcquisitionType string `json:"acquisitionType" validate:"omitempty,oneof=bought gift inherited made found"`
+
+		// Coordinates, both or neither must be set
+		Latitude  *float64 `json:"latitude" extensions:"x-nullable" validate:"omitempty,min=-90,max=90"`
+		Longitude *float64 `json:"longitude" extensions:"x-nullable" validate:"omitempty,min=-180,max=180"`
+
+		// Tags replace the tags of the item, they're trimmed and deduplicated when stored
+		Tags []string `json:"tags"`
 
 		// Edges
 		LocationID uuid.UUID   `json:"locationId"`
@@ -81,6 +261,7 @@ type (
 
 		// Identifications
 		SerialNumber string `json:"serialNumber"`
+		Barcode      string `json:"barcode" validate:"max=255"` // UPC or EAN
 		ModelNumber  string `json:"modelNumber"`
 		Manufacturer string `json:"manufacturer"`
 
@@ -90,9 +271,14 @@ type (
 		WarrantyDetails  string     `json:"warrantyDetails"`
 
 		// Purchase
-		PurchaseTime  types.Date `json:"purchaseTime"`
-		PurchaseFrom  string     `json:"purchaseFrom"`
-		PurchasePrice float64    `json:"purchasePrice,string"`
+		PurchaseTime        types.Date `json:"purchaseTime"`
+		PurchaseFrom        string     `json:"purchaseFrom"`
+		PurchasePrice       float64    `json:"purchasePrice,string"`
+		PurchaseOrderNumber string     `json:"purchaseOrderNumber" validate:"max=255"`
+		ReplacementCost     float64    `json:"replacementCost,string"`
+
+		// Currency of the purchase and sold price, empty for the group's currency
+		Currency string `json:"currency"`
 
 		// Sold
 		SoldTime  types.Date `json:"soldTime"`
@@ -103,12 +289,81 @@ type (
 		// Extras
 		Notes  string      `json:"notes"`
 		Fields []ItemField `json:"fields"`
+
+		// NotesFormat is either "plain" or "markdown", it is left unchanged when empty
+		NotesFormat string `json:"notesFormat" validate:"omitempty,oneof=plain markdown"`
 	}
 
+	// ItemPatch is a partial update of an item, only the fields that are set are changed.
 	ItemPatch struct {
-		ID        uuid.UUID `json:"id"`
-		Quantity  *int      `json:"quantity,omitempty" extensions:"x-nullable,x-omitempty"`
-		ImportRef *string   `json:"-,omitempty" extensions:"x-nullable,x-omitempty"`
+		ID uuid.UUID `json:"id"`
+		// Version is the version of the item the patch is based on, the patch fails with
+		// ErrVersionConflict when the item has changed since. A version of 0 skips the check.
+		Version   int      `json:"version"`
+		AssetID   *AssetID `json:"assetId,omitempty" extensions:"x-nullable,x-omitempty"`
+		Quantity  *int     `json:"quantity,omitempty" extensions:"x-nullable,x-omitempty"`
+		ImportRef *string  `json:"-,omitempty" extensions:"x-nullable,x-omitempty"`
+
+		Name        *string `json:"name,omitempty" validate:"omitempty,min=1,max=255" extensions:"x-nullable,x-omitempty"`
+		Description *string `json:"description,omitempty" validate:"omitempty,max=1000" extensions:"x-nullable,x-omitempty"`
+		Notes       *string `json:"notes,omitempty" extensions:"x-nullable,x-omitempty"`
+		NotesFormat *string `json:"notesFormat,omitempty" validate:"omitempty,oneof=plain markdown" extensions:"x-nullable,x-omitempty"`
+		Insured     *bool   `json:"insured,omitempty" extensions:"x-nullable,x-omitempty"`
+		Archived    *bool   `json:"archived,omitempty" extensions:"x-nullable,x-omitempty"`
+
+		ReorderThreshold *int    `json:"reorderThreshold,omitempty" extensions:"x-nullable,x-omitempty"`
+		AcquisitionType  *string `json:"acquisitionType,omitempty" validate:"omitempty,oneof=bought gift inherited made found" extensions:"x-nullable,x-omitempty"`
+
+		// Coordinates, both or neither must be set
+		Latitude  *float64 `json:"latitude,omitempty" validate:"omitempty,min=-90,max=90" extensions:"x-nullable,x-omitempty"`
+		Longitude *float64 `json:"longitude,omitempty" validate:"omitempty,min=-180,max=180" extensions:"x-nullable,x-omitempty"`
+
+		// Tags replace the tags of the item when not nil
+		Tags []string `json:"tags,omitempty" extensions:"x-nullable,x-omitempty"`
+
+		// Identification
+		SerialNumber *string `json:"serialNumber,omitempty" extensions:"x-nullable,x-omitempty"`
+		Barcode      *string `json:"barcode,omitempty" validate:"omitempty,max=255" extensions:"x-nullable,x-omitempty"`
+		ModelNumber  *string `json:"modelNumber,omitempty" extensions:"x-nullable,x-omitempty"`
+		Manufacturer *string `json:"manufacturer,omitempty" extensions:"x-nullable,x-omitempty"`
+
+		// Warranty
+		LifetimeWarranty *bool       `json:"lifetimeWarranty,omitempty" extensions:"x-nullable,x-omitempty"`
+		WarrantyExpires  *types.Date `json:"warrantyExpires,omitempty" extensions:"x-nullable,x-omitempty"`
+		WarrantyDetails  *string     `json:"warrantyDetails,omitempty" extensions:"x-nullable,x-omitempty"`
+
+		// Purchase
+		PurchaseTime        *types.Date `json:"purchaseTime,omitempty" extensions:"x-nullable,x-omitempty"`
+		PurchaseFrom        *string     `json:"purchaseFrom,omitempty" extensions:"x-nullable,x-omitempty"`
+		PurchasePrice       *float64    `json:"purchasePrice,omitempty,string" extensions:"x-nullable,x-omitempty"`
+		PurchaseOrderNumber *string     `json:"purchaseOrderNumber,omitempty" validate:"omitempty,max=255" extensions:"x-nullable,x-omitempty"`
+		ReplacementCost     *float64    `json:"replacementCost,omitempty,string" extensions:"x-nullable,x-omitempty"`
+
+		// Currency of the purchase and sold price, empty for the group's currency
+		Currency *string `json:"currency,omitempty" extensions:"x-nullable,x-omitempty"`
+
+		// Sold
+		SoldTime  *types.Date `json:"soldTime,omitempty" extensions:"x-nullable,x-omitempty"`
+		SoldTo    *string     `json:"soldTo,omitempty" extensions:"x-nullable,x-omitempty"`
+		SoldPrice *float64    `json:"soldPrice,omitempty,string" extensions:"x-nullable,x-omitempty"`
+		SoldNotes *string     `json:"soldNotes,omitempty" extensions:"x-nullable,x-omitempty"`
+
+		// Edges, nil LabelIDs leave the labels untouched while an empty slice removes them.
+		// A ParentID of uuid.Nil removes the item from its parent.
+		LocationID *uuid.UUID  `json:"locationId,omitempty" extensions:"x-nullable,x-omitempty"`
+		ParentID   *uuid.UUID  `json:"parentId,omitempty" extensions:"x-nullable,x-omitempty"`
+		LabelIDs   []uuid.UUID `json:"labelIds,omitempty" extensions:"x-nullable,x-omitempty"`
+	}
+
+	SaleInput struct {
+		SoldTime  types.Date `json:"soldTime"`
+		SoldTo    string     `json:"soldTo"`
+		SoldPrice float64    `json:"soldPrice,string"`
+		SoldNotes string     `json:"soldNotes"`
+
+		// Labels to apply to the item as part of the sale
+		AddLabelIDs    []uuid.UUID `json:"addLabelIds"`
+		RemoveLabelIDs []uuid.UUID `json:"removeLabelIds"`
 	}
 
 	ItemSummary struct {
@@ -119,6 +374,8 @@ type (
 		Quantity    int       `json:"quantity"`
 		Insured     bool      `json:"insured"`
 		Archived    bool      `json:"archived"`
+		Favorite    bool      `json:"favorite"`
+		Version     int       `json:"version"`
 		CreatedAt   time.Time `json:"createdAt"`
 		UpdatedAt   time.Time `json:"updatedAt"`
 
@@ -136,7 +393,28 @@ type (
 		ItemSummary
 		AssetID AssetID `json:"assetId,string"`
 
+		// LocationPath holds the location of the item and its ancestors, root first
+		LocationPath []LocationSummary `json:"locationPath"`
+
+		// ArchivedAt is set while the item is in the trash
+		ArchivedAt *time.Time `json:"archivedAt,omitempty" extensions:"x-nullable,x-omitempty"`
+
+		// Loan is set while the item is checked out
+		Loan *ItemLoanStatus `json:"loan,omitempty" extensions:"x-nullable,x-omitempty"`
+
+		ReorderThreshold int `json:"reorderThreshold"`
+		// LowStock is set when the quantity is at or below the reorder threshold
+		LowStock        bool   `json:"lowStock"`
+		Source          string `json:"source"`
+		AcquisitionType string `json:"acquisitionType"`
+
+		Latitude  *float64 `json:"latitude" extensions:"x-nullable"`
+		Longitude *float64 `json:"longitude" extensions:"x-nullable"`
+
+		Tags []string `json:"tags"`
+
 		SerialNumber string `json:"serialNumber"`
+		Barcode      string `json:"barcode"`
 		ModelNumber  string `json:"modelNumber"`
 		Manufacturer string `json:"manufacturer"`
 
@@ -146,8 +424,16 @@ type (
 		WarrantyDetails  string     `json:"warrantyDetails"`
 
 		// Purchase
-		PurchaseTime types.Date `json:"purchaseTime"`
-		PurchaseFrom string     `json:"purchaseFrom"`
+		PurchaseTime        types.Date `json:"purchaseTime"`
+		PurchaseFrom        string     `json:"purchaseFrom"`
+		PurchaseOrderNumber string     `json:"purchaseOrderNumber"`
+		ReplacementCost     float64    `json:"replacementCost,string"`
+
+		// Currency of the purchase and sold price, the group's currency unless set on the item
+		Currency string `json:"currency"`
+
+		// DepreciatedValue is set when the group has a depreciation setting configured
+		DepreciatedValue *float64 `json:"depreciatedValue,omitempty" extensions:"x-nullable,x-omitempty"`
 
 		// Sold
 		SoldTime  types.Date `json:"soldTime"`
@@ -156,10 +442,16 @@ type (
 		SoldNotes string     `json:"soldNotes"`
 
 		// Extras
-		Notes string `json:"notes"`
+		Notes       string `json:"notes"`
+		NotesFormat string `json:"notesFormat"`
 
 		Attachments []ItemAttachment `json:"attachments"`
 		Fields      []ItemField      `json:"fields"`
+		Related     []ItemSummary    `json:"related"`
+		Children    []ItemSummary    `json:"children"`
+
+		// Comments holds the most recent comments on the item, newest first.
+		Comments []ItemComment `json:"comments"`
 	}
 )
 
@@ -196,6 +488,8 @@ func mapItemSummary(item *ent.Item) ItemSummary {
 		CreatedAt:     item.CreatedAt,
 		UpdatedAt:     item.UpdatedAt,
 		Archived:      item.Archived,
+		Favorite:      item.Favorite,
+		Version:       item.Version,
 		PurchasePrice: item.PurchasePrice,
 
 		// Edges
@@ -233,6 +527,7 @@ func mapItemOut(item *ent.Item) ItemOut {
 	var attachments []ItemAttachment
 	if item.Edges.Attachments != nil {
 		attachments = mapEach(item.Edges.Attachments, ToItemAttachment)
+		sortAttachments(attachments)
 	}
 
 	var fields []ItemField
@@ -246,9 +541,33 @@ func mapItemOut(item *ent.Item) ItemOut {
 		parent = &v
 	}
 
-	return ItemOut{
+	var related []ItemSummary
+	if item.Edges.Related != nil {
+		related = mapEach(item.Edges.Related, mapItemSummary)
+	}
+
+	var children []ItemSummary
+	if item.Edges.Children != nil {
+		children = mapEach(item.Edges.Children, mapItemSummary)
+	}
+
+	var comments []ItemComment
+	if item.Edges.Comments != nil {
+		comments = mapEach(item.Edges.Comments, mapItemComment)
+	}
+
+	out := ItemOut{
 		Parent:           parent,
 		AssetID:          AssetID(item.AssetID),
+		ArchivedAt:       item.ArchivedAt,
+		Loan:             mapItemLoanStatus(item),
+		ReorderThreshold: item.ReorderThreshold,
+		LowStock:         item.ReorderThreshold > 0 && item.Quantity <= item.ReorderThreshold,
+		Source:           item.Source.String(),
+		AcquisitionType:  item.AcquisitionType.String(),
+		Latitude:         item.Latitude,
+		Longitude:        item.Longitude,
+		Tags:             item.Tags,
 		ItemSummary:      mapItemSummary(item),
 		LifetimeWarranty: item.LifetimeWarranty,
 		WarrantyExpires:  types.DateFromTime(item.WarrantyExpires),
@@ -256,12 +575,15 @@ func mapItemOut(item *ent.Item) ItemOut {
 
 		// Identification
 		SerialNumber: item.SerialNumber,
+		Barcode:      item.Barcode,
 		ModelNumber:  item.ModelNumber,
 		Manufacturer: item.Manufacturer,
 
 		// Purchase
-		PurchaseTime: types.DateFromTime(item.PurchaseTime),
-		PurchaseFrom: item.PurchaseFrom,
+		PurchaseTime:        types.DateFromTime(item.PurchaseTime),
+		PurchaseFrom:        item.PurchaseFrom,
+		PurchaseOrderNumber: item.PurchaseOrderNumber,
+		ReplacementCost:     item.ReplacementCost,
 
 		// Sold
 		SoldTime:  types.DateFromTime(item.SoldTime),
@@ -271,8 +593,85 @@ func mapItemOut(item *ent.Item) ItemOut {
 
 		// Extras
 		Notes:       item.Notes,
+		NotesFormat: item.NotesFormat.String(),
 		Attachments: attachments,
 		Fields:      fields,
+		Related:     related,
+		Children:    children,
+		Comments:    comments,
+	}
+
+	out.Currency = strings.ToUpper(item.Currency)
+	if out.Currency == "" && item.Edges.Group != nil {
+		out.Currency = strings.ToUpper(item.Edges.Group.Currency.String())
+	}
+
+	out.DepreciatedValue = mapDepreciatedValue(item, out)
+	return out
+}
+
+// Update returns an ItemUpdate that leaves the item unchanged when applied, callers change
+// the fields to update. The currency is kept as well, clear it to track the group's currency.
+func (i ItemOut) Update() ItemUpdate {
+	var parentID uuid.UUID
+	if i.Parent != nil {
+		parentID = i.Parent.ID
+	}
+
+	var locationID uuid.UUID
+	if i.Location != nil {
+		locationID = i.Location.ID
+	}
+
+	labelIDs := make([]uuid.UUID, len(i.Labels))
+	for j, l := range i.Labels {
+		labelIDs[j] = l.ID
+	}
+
+	return ItemUpdate{
+		ParentID:    parentID,
+		ID:          i.ID,
+		Version:     i.Version,
+		AssetID:     i.AssetID,
+		Name:        i.Name,
+		Description: i.Description,
+		Quantity:    i.Quantity,
+		Insured:     i.Insured,
+		Archived:    i.Archived,
+
+		ReorderThreshold: i.ReorderThreshold,
+		AcquisitionType:  i.AcquisitionType,
+		Latitude:         i.Latitude,
+		Longitude:        i.Longitude,
+		Tags:             i.Tags,
+
+		LocationID: locationID,
+		LabelIDs:   labelIDs,
+
+		SerialNumber: i.SerialNumber,
+		Barcode:      i.Barcode,
+		ModelNumber:  i.ModelNumber,
+		Manufacturer: i.Manufacturer,
+
+		LifetimeWarranty: i.LifetimeWarranty,
+		WarrantyExpires:  i.WarrantyExpires,
+		WarrantyDetails:  i.WarrantyDetails,
+
+		PurchaseTime:        i.PurchaseTime,
+		PurchaseFrom:        i.PurchaseFrom,
+		PurchasePrice:       i.PurchasePrice,
+		PurchaseOrderNumber: i.PurchaseOrderNumber,
+		ReplacementCost:     i.ReplacementCost,
+		Currency:            i.Currency,
+
+		SoldTime:  i.SoldTime,
+		SoldTo:    i.SoldTo,
+		SoldPrice: i.SoldPrice,
+		SoldNotes: i.SoldNotes,
+
+		Notes:       i.Notes,
+		NotesFormat: i.NotesFormat,
+		Fields:      i.Fields,
 	}
 }
 
@@ -285,17 +684,38 @@ func (r *ItemsRepository) publishMutationEvent(GID uuid.UUID) {
 func (e *ItemsRepository) getOne(ctx context.Context, where ...predicate.Item) (ItemOut, error) {
 	q := e.db.Item.Query().Where(where...)
 
-	return mapItemOutErr(q.
+	out, err := mapItemOutErr(q.
 		WithFields().
 		WithLabel().
 		WithLocation().
 		WithGroup().
 		WithParent().
-		WithAttachments(func(aq *ent.AttachmentQuery) {
-			aq.WithDocument()
+		WithRelated(func(iq *ent.ItemQuery) {
+			iq.Order(ent.Asc(item.FieldName))
+		}).
+		WithChildren(func(iq *ent.ItemQuery) {
+			iq.Order(ent.Asc(item.FieldName))
+		}).
+		WithAttachments(withSharedDocument).
+		WithComments(func(cq *ent.ItemCommentQuery) {
+			cq.WithAuthor().
+				Order(ent.Desc(itemcomment.FieldCreatedAt)).
+				Limit(latestCommentsLimit)
 		}).
 		Only(ctx),
 	)
+	if err != nil {
+		return ItemOut{}, err
+	}
+
+	if out.Location != nil {
+		out.LocationPath, err = locationAncestors(ctx, e.db, out.Location.ID)
+		if err != nil {
+			return ItemOut{}, err
+		}
+	}
+
+	return out, nil
 }
 
 // GetOne returns a single item by ID. If the item does not exist, an error is returned.
@@ -319,43 +739,134 @@ func (e *ItemsRepository) GetOneByGroup(ctx context.Context, gid, id uuid.UUID)
 	return e.getOne(ctx, item.ID(id), item.HasGroupWith(group.ID(gid)))
 }
 
-// QueryByGroup returns a list of items that belong to a specific group based on the provided query.
-func (e *ItemsRepository) QueryByGroup(ctx context.Context, gid uuid.UUID, q ItemQuery) (PaginationResult[ItemSummary], error) {
-	qb := e.db.Item.Query().Where(
+// itemQueryPredicates builds the predicates selecting the items of the group that match
+// the filters of the query. Pagination and ordering are not applied.
+func itemQueryPredicates(gid uuid.UUID, q ItemQuery) []predicate.Item {
+	where := []predicate.Item{
 		item.HasGroupWith(group.ID(gid)),
-	)
+	}
 
-	if q.IncludeArchived {
-		qb = qb.Where(
-			item.Or(
-				item.Archived(true),
-				item.Archived(false),
-			),
-		)
-	} else {
-		qb = qb.Where(item.Archived(false))
+	// deleted items are archived as well, the trash is told apart by ArchivedAt
+	switch {
+	case q.IncludeArchived && q.IncludeTrashed:
+	case q.IncludeArchived:
+		where = append(where, item.ArchivedAtIsNil())
+	case q.IncludeTrashed:
+		where = append(where, item.Or(item.Archived(false), item.ArchivedAtNotNil()))
+	default:
+		where = append(where, item.Archived(false))
 	}
 
-	if q.Search != "" {
-		qb.Where(
-			item.Or(
-				item.NameContainsFold(q.Search),
-				item.DescriptionContainsFold(q.Search),
-				item.NotesContainsFold(q.Search),
-				item.ManufacturerContainsFold(q.Search),
-			),
-		)
+	if strings.TrimSpace(q.Search) != "" {
+		where = append(where, itemSearchPredicate(q.Search, q.SearchFields))
 	}
 
 	if !q.AssetID.Nil() {
-		qb = qb.Where(item.AssetID(q.AssetID.Int()))
+		where = append(where, item.AssetID(q.AssetID.Int()))
+	}
+
+	if q.FavoritesOnly {
+		where = append(where, item.Favorite(true))
+	}
+
+	if q.Source != "" {
+		where = append(where, item.SourceEQ(item.Source(q.Source)))
+	}
+
+	if q.AcquisitionType != "" {
+		where = append(where, item.AcquisitionTypeEQ(item.AcquisitionType(q.AcquisitionType)))
+	}
+
+	if q.LabelColor != "" {
+		where = append(where, item.HasLabelWith(label.ColorEqualFold(q.LabelColor)))
+	}
+
+	if q.PurchaseOrderNumber != "" {
+		where = append(where, item.PurchaseOrderNumberEqualFold(q.PurchaseOrderNumber))
+	}
+
+	if q.MinPurchasePrice != nil {
+		where = append(where, item.PurchasePriceGTE(*q.MinPurchasePrice))
+	}
+
+	if q.MaxPurchasePrice != nil {
+		where = append(where, item.PurchasePriceLTE(*q.MaxPurchasePrice))
+	}
+
+	if q.SnapshotAt != nil {
+		where = append(where, item.CreatedAtLTE(*q.SnapshotAt))
+	}
+
+	if q.CreatedAfter != nil {
+		where = append(where, item.CreatedAtGTE(*q.CreatedAfter))
+	}
+
+	if q.CreatedBefore != nil {
+		where = append(where, item.CreatedAtLTE(*q.CreatedBefore))
+	}
+
+	if q.UpdatedAfter != nil {
+		where = append(where, item.UpdatedAtGTE(*q.UpdatedAfter))
+	}
+
+	if q.UpdatedBefore != nil {
+		where = append(where, item.UpdatedAtLTE(*q.UpdatedBefore))
+	}
+
+	if q.OlderThan != nil {
+		where = append(where,
+			item.PurchaseTimeGT(time.Time{}),
+			item.PurchaseTimeLTE(time.Now().Add(-*q.OlderThan)),
+		)
+	}
+
+	if q.HasAttachments != nil {
+		if *q.HasAttachments {
+			where = append(where, item.HasAttachments())
+		} else {
+			where = append(where, item.Not(item.HasAttachments()))
+		}
+	}
+
+	for _, tag := range normalizeTags(q.Tags) {
+		where = append(where, itemHasTag(tag))
+	}
+
+	if q.Insured != nil {
+		where = append(where, item.Insured(*q.Insured))
+	}
+
+	if q.Sold != nil {
+		// items that were never sold are stored with a zero sold time rather than none
+		if *q.Sold {
+			where = append(where, item.SoldTimeGT(time.Time{}))
+		} else {
+			where = append(where,
+				item.Or(
+					item.SoldTimeIsNil(),
+					item.SoldTimeLTE(time.Time{}),
+				),
+			)
+		}
+	}
+
+	if q.PurchasedOn != nil {
+		// purchase times are dates stored as midnight UTC, see types.Date, so the calendar
+		// day of the caller is matched against the UTC day
+		on := *q.PurchasedOn
+		start := time.Date(on.Year(), on.Month(), on.Day(), 0, 0, 0, 0, time.UTC)
+
+		where = append(where,
+			item.PurchaseTimeGTE(start),
+			item.PurchaseTimeLT(start.AddDate(0, 0, 1)),
+		)
 	}
 
 	// Filters within this block define a AND relationship where each subset
 	// of filters is OR'd together.
 	//
 	// The goal is to allow matches like where the item has
-	//  - one of the selected labels AND
+	//  - one of the selected labels (all of them with LabelsMatchAll) AND
 	//  - one of the selected locations AND
 	//  - one of the selected fields key/value matches
 	var andPredicates []predicate.Item
@@ -366,7 +877,11 @@ func (e *ItemsRepository) QueryByGroup(ctx context.Context, gid uuid.UUID, q Ite
 				labelPredicates = append(labelPredicates, item.HasLabelWith(label.ID(l)))
 			}
 
-			andPredicates = append(andPredicates, item.Or(labelPredicates...))
+			if q.LabelsMatchAll {
+				andPredicates = append(andPredicates, labelPredicates...)
+			} else {
+				andPredicates = append(andPredicates, item.Or(labelPredicates...))
+			}
 		}
 
 		if len(q.LocationIDs) > 0 {
@@ -398,19 +913,130 @@ func (e *ItemsRepository) QueryByGroup(ctx context.Context, gid uuid.UUID, q Ite
 	}
 
 	if len(andPredicates) > 0 {
-		qb = qb.Where(item.And(andPredicates...))
+		where = append(where, item.And(andPredicates...))
+	}
+
+	// items with any of the excluded labels are removed, even when they match LabelIDs
+	for _, l := range q.ExcludeLabelIDs {
+		where = append(where, item.Not(item.HasLabelWith(label.ID(l))))
+	}
+
+	return where
+}
+
+// itemSearchFields lists the fields matched by ItemQuery.Search, keyed by the names
+// accepted in ItemQuery.SearchFields.
+var itemSearchFields = []struct {
+	key   string
+	match func(string) predicate.Item
+}{
+	{"name", item.NameContainsFold},
+	{"description", item.DescriptionContainsFold},
+	{"notes", item.NotesContainsFold},
+	{"serialNumber", item.SerialNumberContainsFold},
+	{"modelNumber", item.ModelNumberContainsFold},
+	{"manufacturer", item.ManufacturerContainsFold},
+	{"purchaseOrderNumber", item.PurchaseOrderNumberContainsFold},
+}
+
+// itemSearchPredicate matches items containing every whitespace separated word of the
+// search, each in any of the given fields or in all search fields when none of the given
+// fields are known. The words don't have to be adjacent, "dewalt drill" matches an item
+// named "DeWalt Cordless Drill".
+func itemSearchPredicate(search string, fields []string) predicate.Item {
+	only := set.New(fields...)
+
+	matchers := make([]func(string) predicate.Item, 0, len(itemSearchFields))
+	for _, f := range itemSearchFields {
+		if only.Contains(f.key) {
+			matchers = append(matchers, f.match)
+		}
+	}
+
+	if len(matchers) == 0 {
+		for _, f := range itemSearchFields {
+			matchers = append(matchers, f.match)
+		}
+	}
+
+	words := strings.Fields(search)
+
+	tokens := make([]predicate.Item, 0, len(words))
+	for _, w := range words {
+		preds := make([]predicate.Item, len(matchers))
+		for i, match := range matchers {
+			preds[i] = match(w)
+		}
+
+		tokens = append(tokens, item.Or(preds...))
+	}
+
+	return item.And(tokens...)
+}
+
+// itemSortFields maps the keys accepted by ItemQuery.SortBy to their columns. Only these
+// columns can be sorted on.
+var itemSortFields = map[string]string{
+	"name":          item.FieldName,
+	"createdAt":     item.FieldCreatedAt,
+	"updatedAt":     item.FieldUpdatedAt,
+	"purchasePrice": item.FieldPurchasePrice,
+	"quantity":      item.FieldQuantity,
+}
+
+// itemSortOrder returns the order for a sort key, a leading "-" sorts in descending order.
+// Unknown keys sort by name ascending.
+func itemSortOrder(sortBy string) item.OrderOption {
+	key, desc := strings.CutPrefix(sortBy, "-")
+
+	field, ok := itemSortFields[key]
+	if !ok {
+		return ent.Asc(item.FieldName)
+	}
+
+	if desc {
+		return ent.Desc(field)
+	}
+	return ent.Asc(field)
+}
+
+// QueryByGroup returns a list of items that belong to a specific group based on the provided query.
+func (e *ItemsRepository) QueryByGroup(ctx context.Context, gid uuid.UUID, q ItemQuery) (PaginationResult[ItemSummary], error) {
+	return e.queryByGroup(ctx, q, itemQueryPredicates(gid, q))
+}
+
+// AdvancedSearch is like QueryByGroup but matches items against a boolean search expression
+// in place of q.Search, e.g. `drill AND dewalt NOT cordless`. See parseSearch for the syntax.
+// A *SearchParseError is returned when the expression is malformed.
+func (e *ItemsRepository) AdvancedSearch(ctx context.Context, gid uuid.UUID, expr string, q ItemQuery) (PaginationResult[ItemSummary], error) {
+	node, err := parseSearch(expr)
+	if err != nil {
+		return PaginationResult[ItemSummary]{}, err
 	}
 
+	q.Search = ""
+	return e.queryByGroup(ctx, q, append(itemQueryPredicates(gid, q), node.predicate()))
+}
+
+func (e *ItemsRepository) queryByGroup(ctx context.Context, q ItemQuery, where []predicate.Item) (PaginationResult[ItemSummary], error) {
+	qb := e.db.Item.Query().Where(where...)
+
 	count, err := qb.Count(ctx)
 	if err != nil {
 		return PaginationResult[ItemSummary]{}, err
 	}
 
 	// Order
-	switch q.OrderBy {
-	case "createdAt":
+	if q.FavoritesFirst {
+		qb = qb.Order(ent.Desc(item.FieldFavorite))
+	}
+
+	switch {
+	case q.SortBy != "":
+		qb = qb.Order(itemSortOrder(q.SortBy))
+	case q.OrderBy == "createdAt":
 		qb = qb.Order(ent.Desc(item.FieldCreatedAt))
-	case "updatedAt":
+	case q.OrderBy == "updatedAt":
 		qb = qb.Order(ent.Desc(item.FieldUpdatedAt))
 	default: // "name"
 		qb = qb.Order(ent.Asc(item.FieldName))
@@ -426,7 +1052,10 @@ func (e *ItemsRepository) QueryByGroup(ctx context.Context, gid uuid.UUID, q Ite
 				WithDocument()
 		})
 
-	if q.Page != -1 || q.PageSize != -1 {
+	if q.Unpaginated {
+		q.Page, q.PageSize = -1, -1
+	} else {
+		q.Page, q.PageSize = e.pages.normalize(q.Page, q.PageSize)
 		qb = qb.
 			Offset(calculateOffset(q.Page, q.PageSize)).
 			Limit(q.PageSize)
@@ -445,249 +1074,2395 @@ func (e *ItemsRepository) QueryByGroup(ctx context.Context, gid uuid.UUID, q Ite
 	}, nil
 }
 
-// QueryByAssetID returns items by asset ID. If the item does not exist, an error is returned.
-func (e *ItemsRepository) QueryByAssetID(ctx context.Context, gid uuid.UUID, assetID AssetID, page int, pageSize int) (PaginationResult[ItemSummary], error) {
-	qb := e.db.Item.Query().Where(
-		item.HasGroupWith(group.ID(gid)),
-		item.AssetID(int(assetID)),
-	)
+// QueryByGroupCursor is like QueryByGroup but pages through the items newest first using
+// an opaque cursor instead of an offset, which stays fast on deep pages. An empty cursor
+// starts at the first page. q.Page, q.PageSize and the query's sort options are ignored,
+// pageSize is bound by the repository's PageBounds like the page size of QueryByGroup.
+func (e *ItemsRepository) QueryByGroupCursor(ctx context.Context, gid uuid.UUID, q ItemQuery, cursor string, pageSize int) (CursorResult[ItemSummary], error) {
+	_, pageSize = e.pages.normalize(1, pageSize)
 
-	if page != -1 || pageSize != -1 {
-		qb.Offset(calculateOffset(page, pageSize)).
-			Limit(pageSize)
-	} else {
-		page = -1
-		pageSize = -1
+	where := itemQueryPredicates(gid, q)
+
+	if cursor != "" {
+		createdAt, id, err := decodeCursor(cursor)
+		if err != nil {
+			return CursorResult[ItemSummary]{}, err
+		}
+
+		where = append(where, item.Or(
+			item.CreatedAtLT(createdAt),
+			item.And(item.CreatedAt(createdAt), item.IDLT(id)),
+		))
 	}
 
-	items, err := mapItemsSummaryErr(
-		qb.Order(ent.Asc(item.FieldName)).
-			WithLabel().
-			WithLocation().
-			All(ctx),
-	)
+	// one extra item is loaded to find out whether there is another page
+	items, err := e.db.Item.Query().
+		Where(where...).
+		Order(ent.Desc(item.FieldCreatedAt), ent.Desc(item.FieldID)).
+		Limit(pageSize + 1).
+		WithLabel().
+		WithLocation().
+		WithAttachments(func(aq *ent.AttachmentQuery) {
+			aq.Where(
+				attachment.Primary(true),
+			).
+				WithDocument()
+		}).
+		All(ctx)
 	if err != nil {
-		return PaginationResult[ItemSummary]{}, err
+		return CursorResult[ItemSummary]{}, err
 	}
 
-	return PaginationResult[ItemSummary]{
-		Page:     page,
-		PageSize: pageSize,
-		Total:    len(items),
-		Items:    items,
-	}, nil
-}
+	var next string
+	if len(items) > pageSize {
+		items = items[:pageSize]
+		last := items[pageSize-1]
+		next = encodeCursor(last.CreatedAt, last.ID)
+	}
 
-// GetAll returns all the items in the database with the Labels and Locations eager loaded.
-func (e *ItemsRepository) GetAll(ctx context.Context, gid uuid.UUID) ([]ItemOut, error) {
-	return mapItemsOutErr(e.db.Item.Query().
-		Where(item.HasGroupWith(group.ID(gid))).
-		WithLabel().
-		WithLocation().
-		WithFields().
-		All(ctx))
+	return CursorResult[ItemSummary]{
+		Items:      mapEach(items, mapItemSummary),
+		NextCursor: next,
+	}, nil
 }
 
-func (e *ItemsRepository) GetAllZeroAssetID(ctx context.Context, GID uuid.UUID) ([]ItemSummary, error) {
-	q := e.db.Item.Query().Where(
-		item.HasGroupWith(group.ID(GID)),
-		item.AssetID(0),
-	).Order(
-		ent.Asc(item.FieldCreatedAt),
-	)
+// QueryByGroupWithTotals is like QueryByGroup but also sums the purchase prices of all the
+// items matching the query, regardless of the requested page. Only the items priced in the
+// group's currency are summed.
+func (e *ItemsRepository) QueryByGroupWithTotals(ctx context.Context, gid uuid.UUID, q ItemQuery) (ItemQueryResult, error) {
+	where := itemQueryPredicates(gid, q)
 
-	return mapItemsSummaryErr(q.All(ctx))
-}
+	result, err := e.queryByGroup(ctx, q, where)
+	if err != nil {
+		return ItemQueryResult{}, err
+	}
 
-func (e *ItemsRepository) GetHighestAssetID(ctx context.Context, GID uuid.UUID) (AssetID, error) {
-	q := e.db.Item.Query().Where(
-		item.HasGroupWith(group.ID(GID)),
-	).Order(
-		ent.Desc(item.FieldAssetID),
-	).Limit(1)
+	var v []struct {
+		Total   *float64 `json:"total"`
+		Insured *float64 `json:"insured"`
+	}
 
-	result, err := q.First(ctx)
+	err = e.db.Item.Query().
+		Where(append(where, itemInGroupCurrency())...).
+		Aggregate(
+			ent.As(ent.Sum(item.FieldPurchasePrice), "total"),
+			func(s *sql.Selector) string {
+				expr := fmt.Sprintf("SUM(CASE WHEN %s THEN %s ELSE 0 END)", s.C(item.FieldInsured), s.C(item.FieldPurchasePrice))
+				return sql.As(expr, "insured")
+			},
+		).
+		Scan(ctx, &v)
 	if err != nil {
-		if ent.IsNotFound(err) {
-			return 0, nil
-		}
-		return 0, err
+		return ItemQueryResult{}, err
 	}
 
-	return AssetID(result.AssetID), nil
+	out := ItemQueryResult{PaginationResult: result}
+	if len(v) > 0 {
+		out.TotalPurchasePrice = orDefault(v[0].Total, 0)
+		out.InsuredPurchasePrice = orDefault(v[0].Insured, 0)
+	}
+
+	return out, nil
 }
 
-func (e *ItemsRepository) SetAssetID(ctx context.Context, GID uuid.UUID, ID uuid.UUID, assetID AssetID) error {
-	q := e.db.Item.Update().Where(
-		item.HasGroupWith(group.ID(GID)),
-		item.ID(ID),
+// SumReplacementCost returns the total replacement cost of the items matching the query,
+// accounting for their quantity. Items without a replacement cost contribute their
+// purchase price instead. Only the items priced in the group's currency are summed.
+func (e *ItemsRepository) SumReplacementCost(ctx context.Context, gid uuid.UUID, q ItemQuery) (float64, error) {
+	var v []struct {
+		Total *float64 `json:"total"`
+	}
+
+	err := e.db.Item.Query().
+		Where(append(itemQueryPredicates(gid, q), itemInGroupCurrency())...).
+		Aggregate(func(s *sql.Selector) string {
+			cost, price := s.C(item.FieldReplacementCost), s.C(item.FieldPurchasePrice)
+			expr := fmt.Sprintf("SUM(CASE WHEN %s > 0 THEN %s ELSE %s END * %s)", cost, cost, price, s.C(item.FieldQuantity))
+			return sql.As(expr, "total")
+		}).
+		Scan(ctx, &v)
+	if err != nil {
+		return 0, err
+	}
+
+	if len(v) == 0 {
+		return 0, nil
+	}
+
+	return orDefault(v[0].Total, 0), nil
+}
+
+// ArchiveByQuery archives all the items of the group matching the query and returns the
+// number of archived items. ErrBulkLimitExceeded is returned when more than maxBulkItems
+// items match, in which case nothing is archived.
+func (e *ItemsRepository) ArchiveByQuery(ctx context.Context, gid uuid.UUID, q ItemQuery) (int, error) {
+	where := append(itemQueryPredicates(gid, q), item.Archived(false))
+
+	var archived int
+
+	err := withTx(ctx, e.db, func(tx *ent.Tx) error {
+		count, err := tx.Item.Query().Where(where...).Count(ctx)
+		if err != nil {
+			return err
+		}
+
+		if count > maxBulkItems {
+			return ErrBulkLimitExceeded
+		}
+
+		archived, err = tx.Item.Update().
+			Where(where...).
+			SetArchived(true).
+			Save(ctx)
+		return err
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	e.publishMutationEvent(gid)
+	return archived, nil
+}
+
+// AddLabelsByQuery adds the labels to all the items of the group matching the query and
+// returns the number of items that were modified. Labels already present on an item are
+// skipped. ErrBulkLimitExceeded is returned when more than maxBulkItems items match, in
+// which case nothing is changed.
+func (e *ItemsRepository) AddLabelsByQuery(ctx context.Context, gid uuid.UUID, q ItemQuery, labelIDs []uuid.UUID) (int, error) {
+	labels := set.New(labelIDs...)
+
+	var modified int
+
+	err := withTx(ctx, e.db, func(tx *ent.Tx) error {
+		err := checkLabelsInGroup(ctx, tx.Client(), gid, labels.Slice())
+		if err != nil {
+			return err
+		}
+
+		items, err := tx.Item.Query().
+			Where(itemQueryPredicates(gid, q)...).
+			WithLabel(func(lq *ent.LabelQuery) {
+				lq.Select(label.FieldID)
+			}).
+			Limit(maxBulkItems + 1).
+			All(ctx)
+		if err != nil {
+			return err
+		}
+
+		if len(items) > maxBulkItems {
+			return ErrBulkLimitExceeded
+		}
+
+		for _, itm := range items {
+			has := newIDSet(itm.Edges.Label)
+
+			var missing []uuid.UUID
+			for _, l := range labels.Slice() {
+				if !has.Contains(l) {
+					missing = append(missing, l)
+				}
+			}
+
+			if len(missing) == 0 {
+				continue
+			}
+
+			err = tx.Item.UpdateOneID(itm.ID).AddLabelIDs(missing...).Exec(ctx)
+			if err != nil {
+				return err
+			}
+
+			modified++
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	if modified > 0 {
+		e.publishMutationEvent(gid)
+	}
+	return modified, nil
+}
+
+// AddLabelToItems adds the label to the given items of the group in a single update and
+// returns the number of items that were modified. Items that already have the label are
+// skipped. ErrBulkLimitExceeded is returned when more than maxBulkItems ids are given.
+func (e *ItemsRepository) AddLabelToItems(ctx context.Context, gid, labelID uuid.UUID, itemIDs []uuid.UUID) (int, error) {
+	if len(itemIDs) > maxBulkItems {
+		return 0, ErrBulkLimitExceeded
+	}
+
+	err := checkLabelsInGroup(ctx, e.db, gid, []uuid.UUID{labelID})
+	if err != nil {
+		return 0, err
+	}
+
+	modified, err := e.db.Item.Update().
+		Where(
+			item.HasGroupWith(group.ID(gid)),
+			item.IDIn(itemIDs...),
+			item.Not(item.HasLabelWith(label.ID(labelID))),
+		).
+		AddLabelIDs(labelID).
+		Save(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	if modified > 0 {
+		e.publishMutationEvent(gid)
+	}
+	return modified, nil
+}
+
+// RemoveLabelFromItems removes the label from the given items of the group in a single
+// update and returns the number of items that were modified. ErrBulkLimitExceeded is
+// returned when more than maxBulkItems ids are given.
+func (e *ItemsRepository) RemoveLabelFromItems(ctx context.Context, gid, labelID uuid.UUID, itemIDs []uuid.UUID) (int, error) {
+	if len(itemIDs) > maxBulkItems {
+		return 0, ErrBulkLimitExceeded
+	}
+
+	modified, err := e.db.Item.Update().
+		Where(
+			item.HasGroupWith(group.ID(gid)),
+			item.IDIn(itemIDs...),
+			item.HasLabelWith(label.ID(labelID)),
+		).
+		RemoveLabelIDs(labelID).
+		Save(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	if modified > 0 {
+		e.publishMutationEvent(gid)
+	}
+	return modified, nil
+}
+
+// MoveItems moves the given items of the group to the location and returns the number of
+// items that were moved. Items outside the group, or already at the location, are skipped.
+// ErrBulkLimitExceeded is returned when more than maxBulkItems ids are given.
+func (e *ItemsRepository) MoveItems(ctx context.Context, gid uuid.UUID, itemIDs []uuid.UUID, locationID uuid.UUID) (int, error) {
+	if len(itemIDs) > maxBulkItems {
+		return 0, ErrBulkLimitExceeded
+	}
+
+	// ensure the location exists within the group
+	_, err := e.db.Location.Query().
+		Where(
+			location.ID(locationID),
+			location.HasGroupWith(group.ID(gid)),
+		).
+		OnlyID(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	moved, err := e.db.Item.Update().
+		Where(
+			item.HasGroupWith(group.ID(gid)),
+			item.IDIn(itemIDs...),
+			item.Not(item.HasLocationWith(location.ID(locationID))),
+		).
+		SetLocationID(locationID).
+		Save(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	if moved > 0 {
+		e.publishMutationEvent(gid)
+	}
+	return moved, nil
+}
+
+// SetNotesByGroup sets the notes of the given items of the group and returns the number of
+// items that were modified. Unless overwrite is set, only items without notes are changed.
+// ErrBulkLimitExceeded is returned when more than maxBulkItems ids are given.
+func (e *ItemsRepository) SetNotesByGroup(ctx context.Context, gid uuid.UUID, ids []uuid.UUID, notes string, overwrite bool) (int, error) {
+	if len(ids) > maxBulkItems {
+		return 0, ErrBulkLimitExceeded
+	}
+
+	where := []predicate.Item{
+		item.HasGroupWith(group.ID(gid)),
+		item.IDIn(ids...),
+	}
+
+	if !overwrite {
+		where = append(where, item.Or(item.NotesIsNil(), item.NotesEQ("")))
+	}
+
+	modified, err := e.db.Item.Update().
+		Where(where...).
+		SetNotes(notes).
+		Save(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	if modified > 0 {
+		e.publishMutationEvent(gid)
+	}
+	return modified, nil
+}
+
+// QueryByAssetID returns items by asset ID. If the item does not exist, an error is returned.
+func (e *ItemsRepository) QueryByAssetID(ctx context.Context, gid uuid.UUID, assetID AssetID, page int, pageSize int) (PaginationResult[ItemSummary], error) {
+	qb := e.db.Item.Query().Where(
+		item.HasGroupWith(group.ID(gid)),
+		item.AssetID(int(assetID)),
+	)
+
+	if page != -1 || pageSize != -1 {
+		qb.Offset(calculateOffset(page, pageSize)).
+			Limit(pageSize)
+	} else {
+		page = -1
+		pageSize = -1
+	}
+
+	items, err := mapItemsSummaryErr(
+		qb.Order(ent.Asc(item.FieldName)).
+			WithLabel().
+			WithLocation().
+			All(ctx),
+	)
+	if err != nil {
+		return PaginationResult[ItemSummary]{}, err
+	}
+
+	return PaginationResult[ItemSummary]{
+		Page:     page,
+		PageSize: pageSize,
+		Total:    len(items),
+		Items:    items,
+	}, nil
+}
+
+// GetByAssetID returns the item of the group with the given asset ID, e.g. to resolve a
+// scanned barcode. Asset IDs are not guaranteed to be unique, the oldest item wins.
+func (e *ItemsRepository) GetByAssetID(ctx context.Context, gid uuid.UUID, assetID AssetID) (ItemOut, error) {
+	if assetID.Nil() {
+		return ItemOut{}, &ent.NotFoundError{}
+	}
+
+	id, err := e.db.Item.Query().
+		Where(
+			item.HasGroupWith(group.ID(gid)),
+			item.AssetID(assetID.Int()),
+		).
+		Order(ent.Asc(item.FieldCreatedAt)).
+		FirstID(ctx)
+	if err != nil {
+		return ItemOut{}, err
+	}
+
+	return e.GetOne(ctx, id)
+}
+
+// Resolve returns the item of the group identified by a scanned or typed identifier. The
+// identifier is tried as an item ID, then as an asset ID and last as an exact serial number
+// match, the first match wins. A not found error is returned when nothing matches.
+func (e *ItemsRepository) Resolve(ctx context.Context, gid uuid.UUID, identifier string) (ItemOut, error) {
+	identifier = strings.TrimSpace(identifier)
+	if identifier == "" {
+		return ItemOut{}, &ent.NotFoundError{}
+	}
+
+	if id, err := uuid.Parse(identifier); err == nil {
+		out, err := e.GetOneByGroup(ctx, gid, id)
+		if !ent.IsNotFound(err) {
+			return out, err
+		}
+	}
+
+	if aid, ok := ParseAssetID(identifier); ok {
+		out, err := e.GetByAssetID(ctx, gid, aid)
+		if !ent.IsNotFound(err) {
+			return out, err
+		}
+	}
+
+	id, err := e.db.Item.Query().
+		Where(
+			item.HasGroupWith(group.ID(gid)),
+			item.SerialNumber(identifier),
+		).
+		Order(ent.Asc(item.FieldCreatedAt)).
+		FirstID(ctx)
+	if err != nil {
+		return ItemOut{}, err
+	}
+
+	return e.GetOne(ctx, id)
+}
+
+// GetByBarcode returns the items of the group with the given UPC or EAN barcode, oldest
+// first. Several items can share a barcode, e.g. multiple units of the same product.
+// ErrEmptyBarcode is returned for an empty code.
+func (e *ItemsRepository) GetByBarcode(ctx context.Context, gid uuid.UUID, code string) ([]ItemSummary, error) {
+	code = strings.TrimSpace(code)
+	if code == "" {
+		return nil, ErrEmptyBarcode
+	}
+
+	return mapItemsSummaryErr(
+		e.db.Item.Query().
+			Where(
+				item.HasGroupWith(group.ID(gid)),
+				item.Barcode(code),
+			).
+			WithLabel().
+			WithLocation().
+			Order(ent.Asc(item.FieldCreatedAt)).
+			All(ctx),
+	)
+}
+
+// GetByAssetIDs returns the items of the group with one of the given asset IDs keyed by
+// their asset ID. Asset IDs without a matching item are not present in the result.
+func (e *ItemsRepository) GetByAssetIDs(ctx context.Context, gid uuid.UUID, assetIDs []AssetID) (map[AssetID]ItemSummary, error) {
+	ids := make([]int, 0, len(assetIDs))
+	for _, aid := range assetIDs {
+		if !aid.Nil() {
+			ids = append(ids, aid.Int())
+		}
+	}
+
+	result := make(map[AssetID]ItemSummary, len(ids))
+	if len(ids) == 0 {
+		return result, nil
+	}
+
+	items, err := e.db.Item.Query().
+		Where(
+			item.HasGroupWith(group.ID(gid)),
+			item.AssetIDIn(ids...),
+		).
+		Order(ent.Asc(item.FieldCreatedAt)).
+		WithLabel().
+		WithLocation().
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, itm := range items {
+		aid := AssetID(itm.AssetID)
+
+		// asset IDs are not guaranteed to be unique, the oldest item wins
+		if _, ok := result[aid]; !ok {
+			result[aid] = mapItemSummary(itm)
+		}
+	}
+
+	return result, nil
+}
+
+// QueryMisplaced returns the items with the given label that are not stored in one of the
+// allowed locations. Items without a location are considered misplaced. The results are
+// ordered by location name.
+func (e *ItemsRepository) QueryMisplaced(ctx context.Context, gid, labelID uuid.UUID, allowed []uuid.UUID) ([]ItemSummary, error) {
+	q := e.db.Item.Query().Where(
+		item.HasGroupWith(group.ID(gid)),
+		item.Archived(false),
+		item.HasLabelWith(label.ID(labelID)),
+		item.Not(item.HasLocationWith(location.IDIn(allowed...))),
+	)
+
+	return mapItemsSummaryErr(q.
+		Order(
+			item.ByLocationField(location.FieldName),
+			ent.Asc(item.FieldName),
+		).
+		WithLabel().
+		WithLocation().
+		All(ctx),
+	)
+}
+
+// QueryBelowReorder returns the items whose quantity is at or below their reorder threshold.
+// Items without a threshold set are not included.
+func (e *ItemsRepository) QueryBelowReorder(ctx context.Context, gid uuid.UUID) ([]ItemSummary, error) {
+	q := e.db.Item.Query().Where(
+		item.HasGroupWith(group.ID(gid)),
+		item.Archived(false),
+		item.ReorderThresholdGT(0),
+		func(s *sql.Selector) {
+			s.Where(sql.ColumnsLTE(s.C(item.FieldQuantity), s.C(item.FieldReorderThreshold)))
+		},
+	)
+
+	return mapItemsSummaryErr(q.
+		Order(ent.Asc(item.FieldName)).
+		WithLabel().
+		WithLocation().
+		All(ctx),
+	)
+}
+
+// IncompleteCriteria selects which missing details QueryIncomplete looks for.
+type IncompleteCriteria struct {
+	NoAttachments   bool `json:"noAttachments"`
+	NoPurchasePrice bool `json:"noPurchasePrice"`
+	NoSerial        bool `json:"noSerial"`
+	NoLocation      bool `json:"noLocation"`
+}
+
+// QueryIncomplete returns the non-archived items of the group that are missing all of the
+// details selected by the criteria, ordered by name. All items are returned when no
+// criteria is set.
+func (e *ItemsRepository) QueryIncomplete(ctx context.Context, gid uuid.UUID, criteria IncompleteCriteria) ([]ItemSummary, error) {
+	where := []predicate.Item{
+		item.HasGroupWith(group.ID(gid)),
+		item.Archived(false),
+	}
+
+	if criteria.NoAttachments {
+		where = append(where, item.Not(item.HasAttachments()))
+	}
+
+	if criteria.NoPurchasePrice {
+		where = append(where, item.PurchasePrice(0))
+	}
+
+	if criteria.NoSerial {
+		where = append(where, item.Or(item.SerialNumberIsNil(), item.SerialNumberEQ("")))
+	}
+
+	if criteria.NoLocation {
+		where = append(where, item.Not(item.HasLocation()))
+	}
+
+	return mapItemsSummaryErr(e.db.Item.Query().
+		Where(where...).
+		Order(ent.Asc(item.FieldName)).
+		WithLabel().
+		WithLocation().
+		All(ctx),
+	)
+}
+
+const (
+	recentlyUpdatedDefault = 10
+	recentlyUpdatedMax     = 100
+)
+
+// RecentlyUpdated returns the most recently updated non-archived items of the group, newest
+// first. A limit of zero or less returns the default of 10 items and the limit is capped at 100.
+func (e *ItemsRepository) RecentlyUpdated(ctx context.Context, gid uuid.UUID, limit int) ([]ItemSummary, error) {
+	switch {
+	case limit <= 0:
+		limit = recentlyUpdatedDefault
+	case limit > recentlyUpdatedMax:
+		limit = recentlyUpdatedMax
+	}
+
+	return mapItemsSummaryErr(e.db.Item.Query().
+		Where(
+			item.HasGroupWith(group.ID(gid)),
+			item.Archived(false),
+		).
+		Order(ent.Desc(item.FieldUpdatedAt), ent.Asc(item.FieldID)).
+		Limit(limit).
+		WithLabel().
+		WithLocation().
+		All(ctx),
 	)
+}
+
+// QueryExpiringWarranties returns the non-archived items whose warranty expires between today
+// and before, soonest first. Warranties expiring today are included, like in WarrantyCounts. Items with a lifetime warranty or without an expiry date are not
+// included.
+func (e *ItemsRepository) QueryExpiringWarranties(ctx context.Context, gid uuid.UUID, before time.Time) ([]ItemSummary, error) {
+	q := e.db.Item.Query().Where(
+		item.HasGroupWith(group.ID(gid)),
+		item.Archived(false),
+		item.LifetimeWarranty(false),
+		item.WarrantyExpiresNotNil(),
+		item.WarrantyExpiresGTE(today()),
+		item.WarrantyExpiresLT(before),
+	)
+
+	return mapItemsSummaryErr(q.
+		Order(ent.Asc(item.FieldWarrantyExpires)).
+		WithLabel().
+		WithLocation().
+		All(ctx),
+	)
+}
+
+// QueryExpiredWarranties returns the non-archived items whose warranty expired before today,
+// most recently expired first. Items with a lifetime warranty or without an expiry date are
+// not included.
+func (e *ItemsRepository) QueryExpiredWarranties(ctx context.Context, gid uuid.UUID) ([]ItemSummary, error) {
+	q := e.db.Item.Query().Where(
+		item.HasGroupWith(group.ID(gid)),
+		item.Archived(false),
+		item.LifetimeWarranty(false),
+		item.WarrantyExpiresNotNil(),
+		item.WarrantyExpiresGT(time.Time{}),
+		item.WarrantyExpiresLT(today()),
+	)
+
+	return mapItemsSummaryErr(q.
+		Order(ent.Desc(item.FieldWarrantyExpires)).
+		WithLabel().
+		WithLocation().
+		All(ctx),
+	)
+}
+
+// QueryIncompleteWarranties returns the non-archived items with a half-filled warranty record,
+// an expiry date without details or details without an expiry date, most recently updated
+// first. Items with a lifetime warranty are not included.
+func (e *ItemsRepository) QueryIncompleteWarranties(ctx context.Context, gid uuid.UUID) ([]ItemSummary, error) {
+	hasExpiry := item.And(
+		item.WarrantyExpiresNotNil(),
+		item.WarrantyExpiresGT(time.Time{}),
+	)
+
+	hasDetails := item.And(
+		item.WarrantyDetailsNotNil(),
+		item.WarrantyDetailsNEQ(""),
+	)
+
+	q := e.db.Item.Query().Where(
+		item.HasGroupWith(group.ID(gid)),
+		item.Archived(false),
+		item.LifetimeWarranty(false),
+		item.Or(
+			item.And(hasExpiry, item.Not(hasDetails)),
+			item.And(hasDetails, item.Not(hasExpiry)),
+		),
+	)
+
+	return mapItemsSummaryErr(q.
+		Order(ent.Desc(item.FieldUpdatedAt)).
+		WithLabel().
+		WithLocation().
+		All(ctx),
+	)
+}
+
+// QueryMissingManufacturer returns the non-archived items that have a model or serial number
+// but no manufacturer, most recently updated first.
+func (e *ItemsRepository) QueryMissingManufacturer(ctx context.Context, gid uuid.UUID) ([]ItemSummary, error) {
+	q := e.db.Item.Query().Where(
+		item.HasGroupWith(group.ID(gid)),
+		item.Archived(false),
+		item.Or(
+			item.ManufacturerIsNil(),
+			item.ManufacturerEQ(""),
+		),
+		item.Or(
+			item.And(item.ModelNumberNotNil(), item.ModelNumberNEQ("")),
+			item.And(item.SerialNumberNotNil(), item.SerialNumberNEQ("")),
+		),
+	)
+
+	return mapItemsSummaryErr(q.
+		Order(ent.Desc(item.FieldUpdatedAt)).
+		WithLabel().
+		WithLocation().
+		All(ctx),
+	)
+}
+
+// QueryByProfitability returns the sold items that were sold for more than their purchase
+// price when profitable is true, or for less when it is false, ordered by the size of the
+// gain or loss. Only items with both a purchase and sold price are considered. Archived
+// items are included as sold items are commonly archived, items in the trash are not.
+func (e *ItemsRepository) QueryByProfitability(ctx context.Context, gid uuid.UUID, profitable bool) ([]ItemSummary, error) {
+	compare := sql.ColumnsLT
+	if profitable {
+		compare = sql.ColumnsGT
+	}
+
+	q := e.db.Item.Query().Where(
+		item.HasGroupWith(group.ID(gid)),
+		item.ArchivedAtIsNil(),
+		item.SoldPriceGT(0),
+		item.PurchasePriceGT(0),
+		func(s *sql.Selector) {
+			s.Where(compare(s.C(item.FieldSoldPrice), s.C(item.FieldPurchasePrice)))
+		},
+	)
+
+	return mapItemsSummaryErr(q.
+		Order(func(s *sql.Selector) {
+			s.OrderExpr(sql.Expr(fmt.Sprintf("ABS(%s - %s) DESC", s.C(item.FieldSoldPrice), s.C(item.FieldPurchasePrice))))
+		}).
+		WithLabel().
+		WithLocation().
+		All(ctx),
+	)
+}
+
+// earthRadiusKm is the mean radius of the earth used for distance calculations.
+const earthRadiusKm = 6371.0
+
+// haversineKm returns the great-circle distance in kilometers between two coordinates.
+func haversineKm(lat1, lng1, lat2, lng2 float64) float64 {
+	toRad := func(deg float64) float64 { return deg * math.Pi / 180 }
+
+	dLat := toRad(lat2 - lat1)
+	dLng := toRad(lng2 - lng1)
+
+	a := math.Sin(dLat/2)*math.Sin(dLat/2) +
+		math.Cos(toRad(lat1))*math.Cos(toRad(lat2))*math.Sin(dLng/2)*math.Sin(dLng/2)
+
+	return 2 * earthRadiusKm * math.Asin(math.Min(1, math.Sqrt(a)))
+}
+
+// QueryNearby returns the non-archived items with coordinates within radiusKm of the given
+// point, nearest first. Items are pre-filtered on latitude in the database before the
+// exact distance is checked.
+func (e *ItemsRepository) QueryNearby(ctx context.Context, gid uuid.UUID, lat, lng, radiusKm float64) ([]ItemSummary, error) {
+	if radiusKm <= 0 || math.IsNaN(radiusKm) || math.IsInf(radiusKm, 0) {
+		return nil, ErrInvalidRadius
+	}
+
+	// a degree of latitude is roughly 111km everywhere
+	latDelta := radiusKm / 111.0
+
+	items, err := e.db.Item.Query().
+		Where(
+			item.HasGroupWith(group.ID(gid)),
+			item.Archived(false),
+			item.LatitudeNotNil(),
+			item.LongitudeNotNil(),
+			item.LatitudeGTE(lat-latDelta),
+			item.LatitudeLTE(lat+latDelta),
+		).
+		WithLabel().
+		WithLocation().
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	distances := make(map[uuid.UUID]float64, len(items))
+	nearby := make([]*ent.Item, 0, len(items))
+	for _, itm := range items {
+		d := haversineKm(lat, lng, *itm.Latitude, *itm.Longitude)
+		if d <= radiusKm {
+			distances[itm.ID] = d
+			nearby = append(nearby, itm)
+		}
+	}
+
+	sort.SliceStable(nearby, func(i, j int) bool {
+		return distances[nearby[i].ID] < distances[nearby[j].ID]
+	})
+
+	return mapEach(nearby, mapItemSummary), nil
+}
+
+// QueryFuturePurchaseDates returns the non-archived items with a purchase time in the future,
+// which are almost always data entry errors, furthest in the future first.
+func (e *ItemsRepository) QueryFuturePurchaseDates(ctx context.Context, gid uuid.UUID) ([]ItemSummary, error) {
+	q := e.db.Item.Query().Where(
+		item.HasGroupWith(group.ID(gid)),
+		item.Archived(false),
+		item.PurchaseTimeGT(time.Now()),
+	)
+
+	return mapItemsSummaryErr(q.
+		Order(ent.Desc(item.FieldPurchaseTime)).
+		WithLabel().
+		WithLocation().
+		All(ctx),
+	)
+}
+
+// GetAll returns all the items of the group that are not in the trash with the Labels and
+// Locations eager loaded.
+func (e *ItemsRepository) GetAll(ctx context.Context, gid uuid.UUID) ([]ItemOut, error) {
+	return mapItemsOutErr(e.db.Item.Query().
+		Where(
+			item.HasGroupWith(group.ID(gid)),
+			item.ArchivedAtIsNil(),
+		).
+		WithLabel().
+		WithLocation().
+		WithFields().
+		All(ctx))
+}
+
+// StreamAll returns a function that yields the items returned by GetAll in batches of up to
+// batchSize items, so that large groups don't have to be held in memory at once. Once all
+// items have been yielded the function returns an empty slice.
+func (e *ItemsRepository) StreamAll(ctx context.Context, gid uuid.UUID, batchSize int) (func() ([]ItemSummary, error), error) {
+	if batchSize <= 0 {
+		return nil, ErrInvalidPageSize
+	}
+
+	var (
+		last uuid.UUID
+		done bool
+	)
+
+	next := func() ([]ItemSummary, error) {
+		if done {
+			return []ItemSummary{}, nil
+		}
+
+		// keyset pagination on the ID so that the batches stay cheap deep into the set
+		where := []predicate.Item{
+			item.HasGroupWith(group.ID(gid)),
+			item.ArchivedAtIsNil(),
+		}
+
+		if last != uuid.Nil {
+			where = append(where, item.IDGT(last))
+		}
+
+		items, err := mapItemsSummaryErr(e.db.Item.Query().
+			Where(where...).
+			Order(ent.Asc(item.FieldID)).
+			Limit(batchSize).
+			WithLabel().
+			WithLocation().
+			All(ctx),
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(items) < batchSize {
+			done = true
+		}
+
+		if len(items) > 0 {
+			last = items[len(items)-1].ID
+		}
+
+		return items, nil
+	}
+
+	return next, nil
+}
+
+// GetAllByLabel returns the non-archived items of the group that have the label. The label
+// has to belong to the group.
+func (e *ItemsRepository) GetAllByLabel(ctx context.Context, gid, labelID uuid.UUID) ([]ItemSummary, error) {
+	_, err := e.db.Label.Query().
+		Where(
+			label.ID(labelID),
+			label.HasGroupWith(group.ID(gid)),
+		).
+		OnlyID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapItemsSummaryErr(e.db.Item.Query().
+		Where(
+			item.HasGroupWith(group.ID(gid)),
+			item.HasLabelWith(label.ID(labelID)),
+			item.Archived(false),
+		).
+		Order(ent.Asc(item.FieldName)).
+		WithLabel().
+		WithLocation().
+		All(ctx),
+	)
+}
+
+// GetAllByLocation returns the non-archived items of the group stored directly in the
+// location. The location has to belong to the group.
+func (e *ItemsRepository) GetAllByLocation(ctx context.Context, gid, locationID uuid.UUID) ([]ItemSummary, error) {
+	_, err := e.db.Location.Query().
+		Where(
+			location.ID(locationID),
+			location.HasGroupWith(group.ID(gid)),
+		).
+		OnlyID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapItemsSummaryErr(e.db.Item.Query().
+		Where(
+			item.HasGroupWith(group.ID(gid)),
+			item.HasLocationWith(location.ID(locationID)),
+			item.Archived(false),
+		).
+		Order(ent.Asc(item.FieldName)).
+		WithLabel().
+		WithLocation().
+		All(ctx),
+	)
+}
+
+// QueryByLabel is QueryByGroup limited to the items that have the label, the filters,
+// sorting and pagination of the query apply as usual. The label has to belong to the group.
+func (e *ItemsRepository) QueryByLabel(ctx context.Context, gid, labelID uuid.UUID, q ItemQuery) (PaginationResult[ItemSummary], error) {
+	_, err := e.db.Label.Query().
+		Where(
+			label.ID(labelID),
+			label.HasGroupWith(group.ID(gid)),
+		).
+		OnlyID(ctx)
+	if err != nil {
+		return PaginationResult[ItemSummary]{}, err
+	}
+
+	return e.queryByGroup(ctx, q, append(itemQueryPredicates(gid, q), item.HasLabelWith(label.ID(labelID))))
+}
+
+// QueryByLocation is QueryByGroup limited to the items stored directly in the location, the
+// filters, sorting and pagination of the query apply as usual. The location has to belong
+// to the group.
+func (e *ItemsRepository) QueryByLocation(ctx context.Context, gid, locationID uuid.UUID, q ItemQuery) (PaginationResult[ItemSummary], error) {
+	_, err := e.db.Location.Query().
+		Where(
+			location.ID(locationID),
+			location.HasGroupWith(group.ID(gid)),
+		).
+		OnlyID(ctx)
+	if err != nil {
+		return PaginationResult[ItemSummary]{}, err
+	}
+
+	return e.queryByGroup(ctx, q, append(itemQueryPredicates(gid, q), item.HasLocationWith(location.ID(locationID))))
+}
+
+// GroupChecksum returns a hash derived from the item count and the most recent update time
+// of the group's items. The checksum changes whenever an item is created, updated or deleted,
+// which allows clients to cheaply detect whether a full sync is required.
+func (e *ItemsRepository) GroupChecksum(ctx context.Context, GID uuid.UUID) (string, error) {
+	q := e.db.Item.Query().Where(item.HasGroupWith(group.ID(GID)))
+
+	count, err := q.Clone().Count(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	var updatedAt time.Time
+
+	latest, err := q.Clone().
+		Order(ent.Desc(item.FieldUpdatedAt)).
+		Select(item.FieldUpdatedAt).
+		First(ctx)
+	switch {
+	case err == nil:
+		updatedAt = latest.UpdatedAt
+	case !ent.IsNotFound(err):
+		return "", err
+	}
+
+	sum := sha256.Sum256([]byte(fmt.Sprintf("%s:%d:%d", GID, count, updatedAt.UnixNano())))
+	return hex.EncodeToString(sum[:]), nil
+}
+
+func (e *ItemsRepository) GetAllZeroAssetID(ctx context.Context, GID uuid.UUID) ([]ItemSummary, error) {
+	q := e.db.Item.Query().Where(
+		item.HasGroupWith(group.ID(GID)),
+		item.AssetID(0),
+	).Order(
+		ent.Asc(item.FieldCreatedAt),
+	)
+
+	return mapItemsSummaryErr(q.All(ctx))
+}
+
+func (e *ItemsRepository) GetHighestAssetID(ctx context.Context, GID uuid.UUID) (AssetID, error) {
+	q := e.db.Item.Query().Where(
+		item.HasGroupWith(group.ID(GID)),
+	).Order(
+		ent.Desc(item.FieldAssetID),
+	).Limit(1)
+
+	result, err := q.First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return 0, nil
+		}
+		return 0, err
+	}
+
+	return AssetID(result.AssetID), nil
+}
+
+func (e *ItemsRepository) SetAssetID(ctx context.Context, GID uuid.UUID, ID uuid.UUID, assetID AssetID) error {
+	q := e.db.Item.Update().Where(
+		item.HasGroupWith(group.ID(GID)),
+		item.ID(ID),
+	)
+
+	_, err := q.SetAssetID(int(assetID)).Save(ctx)
+	return err
+}
+
+// AssignNextAssetID sets the asset ID of the item to one above the highest asset ID of the
+// group. The ID is computed and stored by a single statement so that concurrently created
+// items never receive the same number.
+func (e *ItemsRepository) AssignNextAssetID(ctx context.Context, GID, ID uuid.UUID) (AssetID, error) {
+	query := `--sql
+		UPDATE
+			items
+		SET
+			asset_id = (
+				SELECT
+					COALESCE(MAX(i.asset_id), 0) + 1
+				FROM
+					items i
+				WHERE
+					i.group_items = ?
+			)
+		WHERE
+			id = ?
+			AND group_items = ?
+`
+
+	_, err := e.db.Sql().ExecContext(ctx, query, GID, ID, GID)
+	if err != nil {
+		return 0, err
+	}
+
+	itm, err := e.db.Item.Query().
+		Where(
+			item.ID(ID),
+			item.HasGroupWith(group.ID(GID)),
+		).
+		Select(item.FieldAssetID).
+		Only(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return AssetID(itm.AssetID), nil
+}
+
+func (e *ItemsRepository) Create(ctx context.Context, gid uuid.UUID, data ItemCreate) (ItemOut, error) {
+	var result *ent.Item
+
+	err := withTx(ctx, e.db, func(tx *ent.Tx) error {
+		err := validateItemCreate(ctx, tx.Client(), gid, data)
+		if err != nil {
+			return err
+		}
+
+		// Fall back to the group's default location when none is given
+		locationID := data.LocationID
+		if locationID == uuid.Nil {
+			locationID, err = defaultLocationID(ctx, tx.Client(), gid)
+			if err != nil {
+				return err
+			}
+		}
+
+		result, err = newItemCreate(tx.Client(), gid, data, locationID).Save(ctx)
+		if err != nil {
+			return err
+		}
+
+		return createItemEvent(ctx, tx.Client(), gid, result.ID, actorFromContext(ctx), itemevent.TypeCreate, nil)
+	})
+	if err != nil {
+		return ItemOut{}, err
+	}
+
+	e.publishMutationEvent(gid)
+	e.publish(ItemCreated{newItemChange(gid, result.ID)})
+	return e.GetOne(ctx, result.ID)
+}
+
+// CreateMany creates all the items in a single transaction, either every item is created or
+// none are. Items without a location use the group's default location. Errors caused by a
+// specific item are prefixed with its index. ErrBulkLimitExceeded is returned when more
+// than maxBulkItems items are given. The items get no asset ID, see ItemService.CreateMany.
+func (e *ItemsRepository) CreateMany(ctx context.Context, gid uuid.UUID, data []ItemCreate) ([]ItemOut, error) {
+	if len(data) > maxBulkItems {
+		return nil, ErrBulkLimitExceeded
+	}
+
+	if len(data) == 0 {
+		return []ItemOut{}, nil
+	}
+
+	var created []*ent.Item
+
+	err := withTx(ctx, e.db, func(tx *ent.Tx) error {
+		defaultLocation, err := defaultLocationID(ctx, tx.Client(), gid)
+		if err != nil {
+			return err
+		}
+
+		builders := make([]*ent.ItemCreate, len(data))
+		for i, d := range data {
+			err := validateItemCreate(ctx, tx.Client(), gid, d)
+			if err != nil {
+				return fmt.Errorf("item %d: %w", i, err)
+			}
+
+			locationID := d.LocationID
+			if locationID == uuid.Nil {
+				locationID = defaultLocation
+			}
+
+			builders[i] = newItemCreate(tx.Client(), gid, d, locationID)
+		}
+
+		created, err = tx.Item.CreateBulk(builders...).Save(ctx)
+		if err != nil {
+			return err
+		}
+
+		for _, itm := range created {
+			err := createItemEvent(ctx, tx.Client(), gid, itm.ID, actorFromContext(ctx), itemevent.TypeCreate, nil)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]uuid.UUID, len(created))
+	for i, itm := range created {
+		ids[i] = itm.ID
+		e.publish(ItemCreated{newItemChange(gid, itm.ID)})
+	}
+
+	items, err := mapItemsOutErr(e.db.Item.Query().
+		Where(item.IDIn(ids...)).
+		WithLabel().
+		WithLocation().
+		WithFields().
+		All(ctx),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// return the items in the order they were given
+	order := make(map[uuid.UUID]int, len(ids))
+	for i, id := range ids {
+		order[id] = i
+	}
+	sort.Slice(items, func(i, j int) bool {
+		return order[items[i].ID] < order[items[j].ID]
+	})
+
+	e.publishMutationEvent(gid)
+	return items, nil
+}
+
+// defaultLocationID returns the default location of the group, or uuid.Nil when it has none.
+func defaultLocationID(ctx context.Context, db *ent.Client, gid uuid.UUID) (uuid.UUID, error) {
+	g, err := db.Group.Get(ctx, gid)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	if g.DefaultLocationID == nil {
+		return uuid.Nil, nil
+	}
+	return *g.DefaultLocationID, nil
+}
+
+// validateItemCreate checks the parts of an item that are otherwise only rejected once it is
+// saved, so that CreateMany can report which item is invalid. The labels must belong to the
+// group.
+func validateItemCreate(ctx context.Context, db *ent.Client, gid uuid.UUID, data ItemCreate) error {
+	err := item.NameValidator(data.Name)
+	if err != nil {
+		return fmt.Errorf("invalid name: %w", err)
+	}
+
+	err = item.DescriptionValidator(data.Description)
+	if err != nil {
+		return fmt.Errorf("invalid description: %w", err)
+	}
+
+	if data.Source != "" {
+		err = item.SourceValidator(item.Source(data.Source))
+		if err != nil {
+			return err
+		}
+	}
+
+	if data.AcquisitionType != "" {
+		err = item.AcquisitionTypeValidator(item.AcquisitionType(data.AcquisitionType))
+		if err != nil {
+			return err
+		}
+	}
+
+	return checkLabelsInGroup(ctx, db, gid, data.LabelIDs)
+}
+
+// newItemCreate returns the builder for a new item of the group at the given location,
+// uuid.Nil leaves the item without a location.
+func newItemCreate(db *ent.Client, gid uuid.UUID, data ItemCreate, locationID uuid.UUID) *ent.ItemCreate {
+	q := db.Item.Create().
+		SetImportRef(data.ImportRef).
+		SetName(data.Name).
+		SetDescription(data.Description).
+		SetGroupID(gid).
+		SetPurchaseOrderNumber(data.PurchaseOrderNumber).
+		SetSerialNumber(data.SerialNumber).
+		SetBarcode(data.Barcode).
+		SetTags(normalizeTags(data.Tags)).
+		SetAssetID(int(data.AssetID)).
+		SetInsured(data.Insured).
+		SetManufacturer(data.Manufacturer).
+		SetModelNumber(data.ModelNumber).
+		SetLifetimeWarranty(data.LifetimeWarranty).
+		SetWarrantyDetails(data.WarrantyDetails)
+
+	if locationID != uuid.Nil {
+		q.SetLocationID(locationID)
+	}
+
+	if data.Quantity > 0 {
+		q.SetQuantity(data.Quantity)
+	}
+
+	if data.Source != "" {
+		q.SetSource(item.Source(data.Source))
+	}
+
+	if data.AcquisitionType != "" {
+		q.SetAcquisitionType(item.AcquisitionType(data.AcquisitionType))
+	}
+
+	if data.LabelIDs != nil && len(data.LabelIDs) > 0 {
+		q.AddLabelIDs(data.LabelIDs...)
+	}
+
+	return q
+}
+
+func (e *ItemsRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	err := e.db.Item.DeleteOneID(id).Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	e.publishMutationEvent(id)
+	return nil
+}
+
+// DeleteByGroup moves the item to the trash by archiving it and recording when it was
+// deleted. Trashed items can be brought back with RestoreByGroup until they are purged.
+func (e *ItemsRepository) DeleteByGroup(ctx context.Context, gid, id uuid.UUID) error {
+	deleted := false
+
+	err := withTx(ctx, e.db, func(tx *ent.Tx) error {
+		n, err := tx.Item.
+			Update().
+			Where(
+				item.ID(id),
+				item.HasGroupWith(group.ID(gid)),
+				item.ArchivedAtIsNil(),
+			).
+			SetArchived(true).
+			SetArchivedAt(time.Now()).
+			Save(ctx)
+		if err != nil || n == 0 {
+			return err
+		}
+
+		deleted = true
+		return createItemEvent(ctx, tx.Client(), gid, id, actorFromContext(ctx), itemevent.TypeDelete, nil)
+	})
+	if err != nil {
+		return err
+	}
+
+	e.publishMutationEvent(gid)
+	if deleted {
+		e.publish(ItemDeleted{newItemChange(gid, id)})
+	}
+	return nil
+}
+
+// PurgeManyByGroup permanently deletes the given items of the group in a single statement
+// and returns the number of deleted items. Unlike DeleteByGroup the items are not moved to
+// the trash, their attachments, fields and other owned records are deleted with them. A
+// delete event is recorded for every purged item. Ids outside the group are ignored.
+// ErrBulkLimitExceeded is returned when more than maxBulkItems ids are given.
+func (e *ItemsRepository) PurgeManyByGroup(ctx context.Context, gid uuid.UUID, ids []uuid.UUID) (int, error) {
+	if len(ids) > maxBulkItems {
+		return 0, ErrBulkLimitExceeded
+	}
+
+	var purged []uuid.UUID
+
+	err := withTx(ctx, e.db, func(tx *ent.Tx) error {
+		var err error
+		purged, err = tx.Item.Query().
+			Where(
+				item.HasGroupWith(group.ID(gid)),
+				item.IDIn(ids...),
+			).
+			IDs(ctx)
+		if err != nil || len(purged) == 0 {
+			return err
+		}
+
+		_, err = tx.Item.Delete().
+			Where(item.IDIn(purged...)).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+
+		for _, id := range purged {
+			err = createItemEvent(ctx, tx.Client(), gid, id, actorFromContext(ctx), itemevent.TypeDelete, nil)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	if len(purged) > 0 {
+		e.publishMutationEvent(gid)
+		for _, id := range purged {
+			e.publish(ItemDeleted{newItemChange(gid, id)})
+		}
+	}
+	return len(purged), nil
+}
+
+// RestoreByGroup takes the item back out of the trash.
+func (e *ItemsRepository) RestoreByGroup(ctx context.Context, gid, id uuid.UUID) error {
+	err := e.db.Item.
+		UpdateOneID(id).
+		Where(
+			item.HasGroupWith(group.ID(gid)),
+			item.ArchivedAtNotNil(),
+		).
+		SetArchived(false).
+		ClearArchivedAt().
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	e.publishMutationEvent(gid)
+	return nil
+}
+
+// PurgeArchived permanently deletes the items of the group that have been in the trash for
+// longer than olderThan and returns the number of deleted items. Items that were archived
+// without being deleted are kept.
+func (e *ItemsRepository) PurgeArchived(ctx context.Context, gid uuid.UUID, olderThan time.Duration) (int, error) {
+	purged, err := e.db.Item.
+		Delete().
+		Where(
+			item.HasGroupWith(group.ID(gid)),
+			item.ArchivedAtNotNil(),
+			item.ArchivedAtLTE(time.Now().Add(-olderThan)),
+		).
+		Exec(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	if purged > 0 {
+		e.publishMutationEvent(gid)
+	}
+	return purged, nil
+}
+
+func (e *ItemsRepository) UpdateByGroup(ctx context.Context, GID uuid.UUID, data ItemUpdate) (ItemOut, error) {
+	err := withTx(ctx, e.db, func(tx *ent.Tx) error {
+		return updateItem(ctx, tx.Client(), GID, data)
+	})
+	if err != nil {
+		return ItemOut{}, err
+	}
+
+	e.publishMutationEvent(GID)
+	e.publish(ItemUpdated{newItemChange(GID, data.ID)})
+	return e.GetOne(ctx, data.ID)
+}
+
+// updateItem applies the update to the item of the group and records the changes, db is
+// the client of the transaction of UpdateByGroup.
+func updateItem(ctx context.Context, db *ent.Client, GID uuid.UUID, data ItemUpdate) error {
+	// snapshot of the item before the update, used to record the changed fields
+	before, err := itemSnapshot(ctx, db, GID, data.ID)
+	if err != nil {
+		return err
+	}
+
+	// items only leave the trash through RestoreByGroup
+	if before.ArchivedAt != nil && !data.Archived {
+		return ErrItemInTrash
+	}
+
+	q := db.Item.Update().Where(item.ID(data.ID), item.HasGroupWith(group.ID(GID))).
+		SetName(data.Name).
+		SetDescription(data.Description).
+		SetLocationID(data.LocationID).
+		SetSerialNumber(data.SerialNumber).
+		SetBarcode(data.Barcode).
+		SetTags(normalizeTags(data.Tags)).
+		SetModelNumber(data.ModelNumber).
+		SetManufacturer(data.Manufacturer).
+		SetArchived(data.Archived).
+		SetPurchaseTime(data.PurchaseTime.Time()).
+		SetPurchaseFrom(data.PurchaseFrom).
+		SetPurchasePrice(data.PurchasePrice).
+		SetPurchaseOrderNumber(data.PurchaseOrderNumber).
+		SetReplacementCost(data.ReplacementCost).
+		SetSoldTime(data.SoldTime.Time()).
+		SetSoldTo(data.SoldTo).
+		SetSoldPrice(data.SoldPrice).
+		SetSoldNotes(data.SoldNotes).
+		SetNotes(data.Notes).
+		SetLifetimeWarranty(data.LifetimeWarranty).
+		SetInsured(data.Insured).
+		SetWarrantyExpires(data.WarrantyExpires.Time()).
+		SetWarrantyDetails(data.WarrantyDetails).
+		SetQuantity(data.Quantity).
+		SetReorderThreshold(data.ReorderThreshold).
+		SetAssetID(int(data.AssetID))
+
+	if data.Version != 0 {
+		q.Where(item.Version(data.Version))
+	}
+
+	if data.NotesFormat != "" {
+		if item.NotesFormatValidator(item.NotesFormat(data.NotesFormat)) != nil {
+			return ErrInvalidNotesFormat
+		}
+		q.SetNotesFormat(item.NotesFormat(data.NotesFormat))
+	}
+
+	if data.AcquisitionType != "" {
+		q.SetAcquisitionType(item.AcquisitionType(data.AcquisitionType))
+	}
+
+	currency, err := normalizeCurrency(data.Currency)
+	if err != nil {
+		return err
+	}
+	q.SetCurrency(currency)
+
+	if (data.Latitude == nil) != (data.Longitude == nil) {
+		return ErrIncompleteCoordinates
+	}
+
+	if data.Latitude != nil {
+		q.SetLatitude(*data.Latitude).SetLongitude(*data.Longitude)
+	} else {
+		q.ClearLatitude().ClearLongitude()
+	}
+
+	add, remove := diffLabels(before.Edges.Label, data.LabelIDs)
+	q.AddLabelIDs(add...).RemoveLabelIDs(remove...)
+
+	if data.ParentID != uuid.Nil {
+		err := checkParent(ctx, db, data.ID, data.ParentID)
+		if err != nil {
+			return err
+		}
+
+		q.SetParentID(data.ParentID)
+	} else {
+		q.ClearParent()
+	}
+
+	updated, err := q.Save(ctx)
+	if err != nil {
+		return err
+	}
+
+	// the item exists since it was found by the snapshot, so it was changed in the meantime
+	if updated == 0 {
+		return ErrVersionConflict
+	}
+
+	fields, err := db.ItemField.Query().Where(itemfield.HasItemWith(item.ID(data.ID))).All(ctx)
+	if err != nil {
+		return err
+	}
+
+	fieldIds := newIDSet(fields)
+
+	// Update Existing Fields
+	for _, f := range data.Fields {
+		if f.ID == uuid.Nil {
+			// Create New Field
+			_, err = db.ItemField.Create().
+				SetItemID(data.ID).
+				SetType(itemfield.Type(f.Type)).
+				SetName(f.Name).
+				SetTextValue(f.TextValue).
+				SetNumberValue(f.NumberValue).
+				SetBooleanValue(f.BooleanValue).
+				// SetTimeValue(f.TimeValue).
+				Save(ctx)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		opt := db.ItemField.Update().
+			Where(
+				itemfield.ID(f.ID),
+				itemfield.HasItemWith(item.ID(data.ID)),
+			).
+			SetType(itemfield.Type(f.Type)).
+			SetName(f.Name).
+			SetTextValue(f.TextValue).
+			SetNumberValue(f.NumberValue).
+			SetBooleanValue(f.BooleanValue)
+			// SetTimeValue(f.TimeValue)
+
+		_, err = opt.Save(ctx)
+		if err != nil {
+			return err
+		}
+
+		fieldIds.Remove(f.ID)
+		continue
+	}
+
+	// Delete Fields that are no longer present
+	if fieldIds.Len() > 0 {
+		_, err = db.ItemField.Delete().
+			Where(
+				itemfield.IDIn(fieldIds.Slice()...),
+				itemfield.HasItemWith(item.ID(data.ID)),
+			).Exec(ctx)
+		if err != nil {
+			return err
+		}
+	}
+
+	return recordItemUpdate(ctx, db, GID, before)
+}
+
+// SetFavorite marks or unmarks the item of the group as a favorite.
+func (e *ItemsRepository) SetFavorite(ctx context.Context, GID, ID uuid.UUID, favorite bool) error {
+	err := e.db.Item.UpdateOneID(ID).
+		Where(item.HasGroupWith(group.ID(GID))).
+		SetFavorite(favorite).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	e.publishMutationEvent(GID)
+	return nil
+}
+
+// LinkItems marks two items of the group as related to each other. Links are symmetric,
+// linking A to B also links B to A. Linking already related items is a no-op.
+func (e *ItemsRepository) LinkItems(ctx context.Context, GID, idA, idB uuid.UUID) error {
+	err := e.checkLinkable(ctx, GID, idA, idB)
+	if err != nil {
+		return err
+	}
+
+	err = e.db.Item.UpdateOneID(idA).
+		AddRelatedIDs(idB).
+		Exec(ctx)
+	if err != nil {
+		// the link already exists
+		if ent.IsConstraintError(err) {
+			return nil
+		}
+		return err
+	}
+
+	e.publishMutationEvent(GID)
+	return nil
+}
+
+// UnlinkItems removes the related link between two items of the group.
+func (e *ItemsRepository) UnlinkItems(ctx context.Context, GID, idA, idB uuid.UUID) error {
+	err := e.checkLinkable(ctx, GID, idA, idB)
+	if err != nil {
+		return err
+	}
+
+	err = e.db.Item.UpdateOneID(idA).
+		RemoveRelatedIDs(idB).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	e.publishMutationEvent(GID)
+	return nil
+}
+
+// checkLinkable ensures that the two items are distinct and both belong to the group.
+func (e *ItemsRepository) checkLinkable(ctx context.Context, GID, idA, idB uuid.UUID) error {
+	if idA == idB {
+		return ErrItemSelfLink
+	}
+
+	for _, id := range []uuid.UUID{idA, idB} {
+		_, err := e.db.Item.Query().
+			Where(
+				item.ID(id),
+				item.HasGroupWith(group.ID(GID)),
+			).
+			OnlyID(ctx)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// SetParent makes the parent item the parent of the child, both items must belong to the
+// group. ErrItemParentCycle is returned when the parent is the child itself or one of its
+// descendants.
+func (e *ItemsRepository) SetParent(ctx context.Context, GID, childID, parentID uuid.UUID) error {
+	for _, id := range []uuid.UUID{childID, parentID} {
+		_, err := e.db.Item.Query().
+			Where(
+				item.ID(id),
+				item.HasGroupWith(group.ID(GID)),
+			).
+			OnlyID(ctx)
+		if err != nil {
+			return err
+		}
+	}
+
+	err := checkParent(ctx, e.db, childID, parentID)
+	if err != nil {
+		return err
+	}
+
+	err = e.db.Item.UpdateOneID(childID).
+		SetParentID(parentID).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	e.publishMutationEvent(GID)
+	return nil
+}
+
+// ClearParent removes the item from its parent.
+func (e *ItemsRepository) ClearParent(ctx context.Context, GID, ID uuid.UUID) error {
+	err := e.db.Item.UpdateOneID(ID).
+		Where(item.HasGroupWith(group.ID(GID))).
+		ClearParent().
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	e.publishMutationEvent(GID)
+	return nil
+}
+
+// checkParent ensures that making parentID the parent of childID does not create a cycle by
+// walking up the ancestors of the parent.
+func checkParent(ctx context.Context, db *ent.Client, childID, parentID uuid.UUID) error {
+	seen := set.New[uuid.UUID]()
+
+	for id := parentID; ; {
+		if id == childID {
+			return ErrItemParentCycle
+		}
+
+		// guard against cycles that already exist
+		if seen.Contains(id) {
+			return nil
+		}
+		seen.Insert(id)
+
+		next, err := db.Item.Query().
+			Where(item.ID(id)).
+			QueryParent().
+			OnlyID(ctx)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return nil
+			}
+			return err
+		}
+
+		id = next
+	}
+}
+
+// DuplicateOptions controls which instance specific details DuplicateByGroup copies.
+type DuplicateOptions struct {
+	CopyPurchase    bool // purchase time, place, price and order number
+	CopySold        bool // sale time, buyer, price and notes
+	CopyAttachments bool // attachments share the documents of the original item
+}
+
+// duplicateSuffix is appended to the name of duplicated items.
+const duplicateSuffix = " (copy)"
+
+// truncateBytes shortens s to at most n bytes without splitting a multi-byte character.
+func truncateBytes(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+
+	for n > 0 && !utf8.RuneStart(s[n]) {
+		n--
+	}
+	return s[:n]
+}
+
+// DuplicateByGroup creates a copy of the item in the same group and location. The name,
+// description, notes, identification, warranty, replacement cost, coordinates, custom fields
+// and labels are always copied, purchase and sale details and attachments only when enabled
+// in the options. The copy gets no asset ID, see ItemService.Duplicate.
+func (e *ItemsRepository) DuplicateByGroup(ctx context.Context, gid, id uuid.UUID, opts ...DuplicateOptions) (ItemOut, error) {
+	var opt DuplicateOptions
+	if len(opts) > 0 {
+		opt = opts[0]
+	}
+
+	var created uuid.UUID
+
+	err := withTx(ctx, e.db, func(tx *ent.Tx) error {
+		src, err := tx.Item.Query().
+			Where(
+				item.ID(id),
+				item.HasGroupWith(group.ID(gid)),
+			).
+			WithLabel(func(lq *ent.LabelQuery) {
+				lq.Select(label.FieldID)
+			}).
+			WithLocation().
+			WithFields().
+			WithAttachments(func(aq *ent.AttachmentQuery) {
+				aq.WithDocument()
+			}).
+			Only(ctx)
+		if err != nil {
+			return err
+		}
+
+		// make room for the suffix without exceeding the maximum name length, which ent
+		// checks in bytes
+		name := truncateBytes(src.Name, 255-len(duplicateSuffix))
+
+		q := tx.Item.Create().
+			SetGroupID(gid).
+			SetName(name + duplicateSuffix).
+			SetDescription(src.Description).
+			SetNotes(src.Notes).
+			SetNotesFormat(src.NotesFormat).
+			SetQuantity(src.Quantity).
+			SetReorderThreshold(src.ReorderThreshold).
+			SetAcquisitionType(src.AcquisitionType).
+			SetInsured(src.Insured).
+			SetSerialNumber(src.SerialNumber).
+			SetBarcode(src.Barcode).
+			SetTags(src.Tags).
+			SetModelNumber(src.ModelNumber).
+			SetManufacturer(src.Manufacturer).
+			SetLifetimeWarranty(src.LifetimeWarranty).
+			SetWarrantyExpires(src.WarrantyExpires).
+			SetWarrantyDetails(src.WarrantyDetails).
+			SetReplacementCost(src.ReplacementCost).
+			SetCurrency(src.Currency).
+			SetNillableLatitude(src.Latitude).
+			SetNillableLongitude(src.Longitude).
+			AddLabel(src.Edges.Label...)
+
+		if src.Edges.Location != nil {
+			q.SetLocationID(src.Edges.Location.ID)
+		}
+
+		if opt.CopyPurchase {
+			q.SetPurchaseTime(src.PurchaseTime).
+				SetPurchaseFrom(src.PurchaseFrom).
+				SetPurchasePrice(src.PurchasePrice).
+				SetPurchaseOrderNumber(src.PurchaseOrderNumber)
+		}
+
+		if opt.CopySold {
+			q.SetSoldTime(src.SoldTime).
+				SetSoldTo(src.SoldTo).
+				SetSoldPrice(src.SoldPrice).
+				SetSoldNotes(src.SoldNotes)
+		}
+
+		dup, err := q.Save(ctx)
+		if err != nil {
+			return err
+		}
+		created = dup.ID
+
+		for _, f := range src.Edges.Fields {
+			_, err = tx.ItemField.Create().
+				SetItemID(dup.ID).
+				SetType(f.Type).
+				SetName(f.Name).
+				SetDescription(f.Description).
+				SetTextValue(f.TextValue).
+				SetNumberValue(f.NumberValue).
+				SetBooleanValue(f.BooleanValue).
+				SetTimeValue(f.TimeValue).
+				Save(ctx)
+			if err != nil {
+				return err
+			}
+		}
+
+		if opt.CopyAttachments {
+			for _, att := range src.Edges.Attachments {
+				_, err = tx.Attachment.Create().
+					SetItemID(dup.ID).
+					SetDocument(att.Edges.Document).
+					SetType(att.Type).
+					SetPrimary(att.Primary).
+					SetDate(att.Date).
+					Save(ctx)
+				if err != nil {
+					return err
+				}
+			}
+		}
+
+		return createItemEvent(ctx, tx.Client(), gid, dup.ID, actorFromContext(ctx), itemevent.TypeCreate, nil)
+	})
+	if err != nil {
+		return ItemOut{}, err
+	}
+
+	e.publishMutationEvent(gid)
+	e.publish(ItemCreated{newItemChange(gid, created)})
+	return e.GetOne(ctx, created)
+}
+
+// MergeItems merges the item mergeID into the item keepID and deletes it. The attachments,
+// labels, custom fields, maintenance entries, comments, child items and related item links
+// of the merged item are moved to the kept item and the quantities are summed.
+// The fields of the kept item take precedence, empty ones are filled from the merged item.
+// Both items must belong to the group.
+func (e *ItemsRepository) MergeItems(ctx context.Context, gid, keepID, mergeID uuid.UUID) (ItemOut, error) {
+	if keepID == mergeID {
+		return ItemOut{}, ErrItemSelfMerge
+	}
+
+	err := withTx(ctx, e.db, func(tx *ent.Tx) error {
+		load := func(id uuid.UUID) (*ent.Item, error) {
+			return tx.Item.Query().
+				Where(
+					item.ID(id),
+					item.HasGroupWith(group.ID(gid)),
+				).
+				WithLabel().
+				WithLocation().
+				WithParent().
+				WithFields().
+				WithAttachments().
+				WithRelated().
+				Only(ctx)
+		}
+
+		keep, err := load(keepID)
+		if err != nil {
+			return err
+		}
+
+		merge, err := load(mergeID)
+		if err != nil {
+			return err
+		}
+
+		// keep the primary photo of the kept item when both items have one
+		keepHasPrimary := false
+		for _, att := range keep.Edges.Attachments {
+			if att.Primary {
+				keepHasPrimary = true
+				break
+			}
+		}
+
+		moved := tx.Attachment.Update().
+			Where(attachment.HasItemWith(item.ID(mergeID))).
+			SetItemID(keepID)
+		if keepHasPrimary {
+			moved.SetPrimary(false)
+		}
+
+		if _, err := moved.Save(ctx); err != nil {
+			return err
+		}
+
+		// move the other owned records before the merged item is deleted, they would be
+		// deleted with it otherwise
+		_, err = tx.ItemField.Update().
+			Where(itemfield.HasItemWith(item.ID(mergeID))).
+			SetItemID(keepID).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.MaintenanceEntry.Update().
+			Where(maintenanceentry.ItemID(mergeID)).
+			SetItemID(keepID).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.ItemComment.Update().
+			Where(itemcomment.ItemID(mergeID)).
+			SetItemID(keepID).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.Item.Update().
+			Where(
+				item.HasParentWith(item.ID(mergeID)),
+				item.IDNEQ(keepID),
+			).
+			SetParentID(keepID).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+
+		has := newIDSet(keep.Edges.Label)
+
+		q := tx.Item.UpdateOneID(keepID).
+			SetQuantity(keep.Quantity + merge.Quantity)
+
+		for _, l := range merge.Edges.Label {
+			if !has.Contains(l.ID) {
+				q.AddLabelIDs(l.ID)
+			}
+		}
+
+		// the links of the merged item are removed with it, link its related items to the
+		// kept item instead
+		related := newIDSet(keep.Edges.Related)
+		for _, r := range merge.Edges.Related {
+			if r.ID != keepID && !related.Contains(r.ID) {
+				q.AddRelatedIDs(r.ID)
+			}
+		}
+
+		if keep.Edges.Location == nil && merge.Edges.Location != nil {
+			q.SetLocationID(merge.Edges.Location.ID)
+		}
+
+		fillEmpty(keep.Description, merge.Description, q.SetDescription)
+		fillEmpty(keep.Notes, merge.Notes, q.SetNotes)
+		fillEmpty(keep.SerialNumber, merge.SerialNumber, q.SetSerialNumber)
+		fillEmpty(keep.Barcode, merge.Barcode, q.SetBarcode)
+		q.SetTags(normalizeTags(append(keep.Tags, merge.Tags...)))
+		fillEmpty(keep.ModelNumber, merge.ModelNumber, q.SetModelNumber)
+		fillEmpty(keep.Manufacturer, merge.Manufacturer, q.SetManufacturer)
+		fillEmpty(keep.WarrantyDetails, merge.WarrantyDetails, q.SetWarrantyDetails)
+		fillEmpty(keep.PurchaseFrom, merge.PurchaseFrom, q.SetPurchaseFrom)
+		fillEmpty(keep.PurchaseOrderNumber, merge.PurchaseOrderNumber, q.SetPurchaseOrderNumber)
+
+		// prices are only filled in the currency of the kept item, the currency of the merged
+		// item is taken along with its prices when the kept item has none
+		keepPriced := keep.PurchasePrice != 0 || keep.ReplacementCost != 0 || keep.SoldPrice != 0
+		mergePriced := merge.PurchasePrice != 0 || merge.ReplacementCost != 0
+
+		sameCurrency := keep.Currency == merge.Currency
+		if !sameCurrency && !keepPriced && mergePriced {
+			q.SetCurrency(merge.Currency)
+			sameCurrency = true
+		}
+
+		if sameCurrency {
+			fillEmpty(keep.PurchasePrice, merge.PurchasePrice, q.SetPurchasePrice)
+			fillEmpty(keep.ReplacementCost, merge.ReplacementCost, q.SetReplacementCost)
+		}
+
+		if keep.PurchaseTime.IsZero() && !merge.PurchaseTime.IsZero() {
+			q.SetPurchaseTime(merge.PurchaseTime)
+		}
+
+		if keep.WarrantyExpires.IsZero() && !merge.WarrantyExpires.IsZero() {
+			q.SetWarrantyExpires(merge.WarrantyExpires)
+		}
+
+		if err := q.Exec(ctx); err != nil {
+			return err
+		}
+
+		if err := tx.Item.DeleteOneID(mergeID).Exec(ctx); err != nil {
+			return err
+		}
+
+		after, err := itemSnapshot(ctx, tx.Client(), gid, keepID)
+		if err != nil {
+			return err
+		}
+
+		err = createItemEvent(ctx, tx.Client(), gid, keepID, actorFromContext(ctx), itemevent.TypeUpdate, diffItems(keep, after))
+		if err != nil {
+			return err
+		}
+
+		return createItemEvent(ctx, tx.Client(), gid, mergeID, actorFromContext(ctx), itemevent.TypeDelete, nil)
+	})
+	if err != nil {
+		return ItemOut{}, err
+	}
+
+	e.publishMutationEvent(gid)
+	e.publish(ItemUpdated{newItemChange(gid, keepID)})
+	e.publish(ItemDeleted{newItemChange(gid, mergeID)})
+	return e.GetOne(ctx, keepID)
+}
+
+// fillEmpty sets the field to the fallback value when the current value is empty.
+func fillEmpty[T comparable](current, fallback T, set func(T) *ent.ItemUpdateOne) {
+	var zero T
+	if current == zero && fallback != zero {
+		set(fallback)
+	}
+}
+
+// SellItem records the sale of an item and applies the label changes of the sale in a
+// single transaction.
+func (e *ItemsRepository) SellItem(ctx context.Context, GID, ID uuid.UUID, sale SaleInput) (ItemOut, error) {
+	err := withTx(ctx, e.db, func(tx *ent.Tx) error {
+		err := checkLabelsInGroup(ctx, tx.Client(), GID, sale.AddLabelIDs)
+		if err != nil {
+			return err
+		}
 
-	_, err := q.SetAssetID(int(assetID)).Save(ctx)
-	return err
+		current, err := tx.Item.Query().
+			Where(item.ID(ID)).
+			QueryLabel().
+			IDs(ctx)
+		if err != nil {
+			return err
+		}
+
+		has := set.New(current...)
+
+		q := tx.Item.UpdateOneID(ID).
+			Where(item.HasGroupWith(group.ID(GID))).
+			SetSoldTime(sale.SoldTime.Time()).
+			SetSoldTo(sale.SoldTo).
+			SetSoldPrice(sale.SoldPrice).
+			SetSoldNotes(sale.SoldNotes)
+
+		for _, l := range sale.AddLabelIDs {
+			if !has.Contains(l) {
+				q.AddLabelIDs(l)
+				has.Insert(l)
+			}
+		}
+
+		for _, l := range sale.RemoveLabelIDs {
+			if has.Contains(l) {
+				q.RemoveLabelIDs(l)
+				has.Remove(l)
+			}
+		}
+
+		return q.Exec(ctx)
+	})
+	if err != nil {
+		return ItemOut{}, err
+	}
+
+	e.publishMutationEvent(GID)
+	return e.GetOne(ctx, ID)
 }
 
-func (e *ItemsRepository) Create(ctx context.Context, gid uuid.UUID, data ItemCreate) (ItemOut, error) {
-	q := e.db.Item.Create().
-		SetImportRef(data.ImportRef).
-		SetName(data.Name).
-		SetDescription(data.Description).
-		SetGroupID(gid).
-		SetLocationID(data.LocationID).
-		SetAssetID(int(data.AssetID))
+// diffLabels returns the labels to add to and remove from an item with the current labels
+// for it to end up with exactly the wanted labels.
+func diffLabels(current []*ent.Label, want []uuid.UUID) (add, remove []uuid.UUID) {
+	has := newIDSet(current)
 
-	if data.LabelIDs != nil && len(data.LabelIDs) > 0 {
-		q.AddLabelIDs(data.LabelIDs...)
+	for _, l := range set.New(want...).Slice() {
+		if has.Contains(l) {
+			has.Remove(l)
+			continue
+		}
+		add = append(add, l)
 	}
 
-	result, err := q.Save(ctx)
+	return add, has.Slice()
+}
+
+// SetLabels replaces the labels of the item with exactly the provided set, only the labels
+// that differ from the current ones are added or removed and no other field is changed. An
+// empty slice removes all labels from the item.
+func (e *ItemsRepository) SetLabels(ctx context.Context, GID, ID uuid.UUID, labelIDs []uuid.UUID) (ItemOut, error) {
+	err := withTx(ctx, e.db, func(tx *ent.Tx) error {
+		err := checkLabelsInGroup(ctx, tx.Client(), GID, labelIDs)
+		if err != nil {
+			return err
+		}
+
+		current, err := tx.Item.Query().
+			Where(
+				item.ID(ID),
+				item.HasGroupWith(group.ID(GID)),
+			).
+			QueryLabel().
+			All(ctx)
+		if err != nil {
+			return err
+		}
+
+		add, remove := diffLabels(current, labelIDs)
+
+		return tx.Item.UpdateOneID(ID).
+			Where(item.HasGroupWith(group.ID(GID))).
+			AddLabelIDs(add...).
+			RemoveLabelIDs(remove...).
+			Exec(ctx)
+	})
 	if err != nil {
 		return ItemOut{}, err
 	}
 
-	e.publishMutationEvent(gid)
-	return e.GetOne(ctx, result.ID)
+	e.publishMutationEvent(GID)
+	return e.GetOne(ctx, ID)
 }
 
-func (e *ItemsRepository) Delete(ctx context.Context, id uuid.UUID) error {
-	err := e.db.Item.DeleteOneID(id).Exec(ctx)
+// SetCountedQuantity sets the quantity of the item to the counted value, e.g. during a
+// physical audit, and records the correction as an adjustment event attributed to the user.
+func (e *ItemsRepository) SetCountedQuantity(ctx context.Context, GID, ID uuid.UUID, counted int, userID uuid.UUID) (ItemOut, error) {
+	if counted < 0 {
+		return ItemOut{}, ErrNegativeQuantity
+	}
+
+	err := withTx(ctx, e.db, func(tx *ent.Tx) error {
+		current, err := tx.Item.Query().
+			Where(
+				item.ID(ID),
+				item.HasGroupWith(group.ID(GID)),
+			).
+			Select(item.FieldQuantity).
+			Only(ctx)
+		if err != nil {
+			return err
+		}
+
+		err = tx.Item.UpdateOneID(ID).
+			SetQuantity(counted).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+
+		return createItemEvent(ctx, tx.Client(), GID, ID, userID, itemevent.TypeAdjustment, map[string]types.FieldChange{
+			item.FieldQuantity: {Old: current.Quantity, New: counted},
+		})
+	})
 	if err != nil {
-		return err
+		return ItemOut{}, err
 	}
 
-	e.publishMutationEvent(id)
-	return nil
+	e.publishMutationEvent(GID)
+	return e.GetOne(ctx, ID)
 }
 
-func (e *ItemsRepository) DeleteByGroup(ctx context.Context, gid, id uuid.UUID) error {
-	_, err := e.db.Item.
-		Delete().
-		Where(
-			item.ID(id),
-			item.HasGroupWith(group.ID(gid)),
-		).Exec(ctx)
-	if err != nil {
-		return err
+// DecrementQuantity lowers the quantity of the item by the given amount, e.g. when a
+// consumable is used. The quantity is clamped at zero.
+func (e *ItemsRepository) DecrementQuantity(ctx context.Context, GID, ID uuid.UUID, by int) (ItemOut, error) {
+	if by <= 0 {
+		return ItemOut{}, ErrInvalidQuantityChange
 	}
 
-	e.publishMutationEvent(gid)
-	return err
+	return e.adjustQuantity(ctx, GID, ID, -by)
 }
 
-func (e *ItemsRepository) UpdateByGroup(ctx context.Context, GID uuid.UUID, data ItemUpdate) (ItemOut, error) {
-	q := e.db.Item.Update().Where(item.ID(data.ID), item.HasGroupWith(group.ID(GID))).
-		SetName(data.Name).
-		SetDescription(data.Description).
-		SetLocationID(data.LocationID).
-		SetSerialNumber(data.SerialNumber).
-		SetModelNumber(data.ModelNumber).
-		SetManufacturer(data.Manufacturer).
-		SetArchived(data.Archived).
-		SetPurchaseTime(data.PurchaseTime.Time()).
-		SetPurchaseFrom(data.PurchaseFrom).
-		SetPurchasePrice(data.PurchasePrice).
-		SetSoldTime(data.SoldTime.Time()).
-		SetSoldTo(data.SoldTo).
-		SetSoldPrice(data.SoldPrice).
-		SetSoldNotes(data.SoldNotes).
-		SetNotes(data.Notes).
-		SetLifetimeWarranty(data.LifetimeWarranty).
-		SetInsured(data.Insured).
-		SetWarrantyExpires(data.WarrantyExpires.Time()).
-		SetWarrantyDetails(data.WarrantyDetails).
-		SetQuantity(data.Quantity).
-		SetAssetID(int(data.AssetID))
+// IncrementQuantity raises the quantity of the item by the given amount.
+func (e *ItemsRepository) IncrementQuantity(ctx context.Context, GID, ID uuid.UUID, by int) (ItemOut, error) {
+	if by <= 0 {
+		return ItemOut{}, ErrInvalidQuantityChange
+	}
+
+	return e.adjustQuantity(ctx, GID, ID, by)
+}
+
+func (e *ItemsRepository) adjustQuantity(ctx context.Context, GID, ID uuid.UUID, delta int) (ItemOut, error) {
+	err := withTx(ctx, e.db, func(tx *ent.Tx) error {
+		current, err := tx.Item.Query().
+			Where(
+				item.ID(ID),
+				item.HasGroupWith(group.ID(GID)),
+			).
+			Select(item.FieldQuantity).
+			Only(ctx)
+		if err != nil {
+			return err
+		}
+
+		quantity := current.Quantity + delta
+		if quantity < 0 {
+			quantity = 0
+		}
+
+		if quantity == current.Quantity {
+			return nil
+		}
 
-	currentLabels, err := e.db.Item.Query().Where(item.ID(data.ID)).QueryLabel().All(ctx)
+		err = tx.Item.UpdateOneID(ID).
+			SetQuantity(quantity).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+
+		return createItemEvent(ctx, tx.Client(), GID, ID, actorFromContext(ctx), itemevent.TypeUpdate, map[string]types.FieldChange{
+			item.FieldQuantity: {Old: current.Quantity, New: quantity},
+		})
+	})
 	if err != nil {
 		return ItemOut{}, err
 	}
 
-	set := newIDSet(currentLabels)
+	e.publishMutationEvent(GID)
+	return e.GetOne(ctx, ID)
+}
 
-	for _, l := range data.LabelIDs {
-		if set.Contains(l) {
-			set.Remove(l)
+// normalizeTags trims the tags and drops empty and duplicate ones, keeping the order in
+// which they were first given. Nil is returned when no tag is left.
+func normalizeTags(tags []string) []string {
+	var out []string
+	seen := set.New[string]()
+
+	for _, t := range tags {
+		t = strings.TrimSpace(t)
+		if t == "" || seen.Contains(t) {
 			continue
 		}
-		q.AddLabelIDs(l)
+
+		seen.Insert(t)
+		out = append(out, t)
 	}
 
-	if set.Len() > 0 {
-		q.RemoveLabelIDs(set.Slice()...)
+	return out
+}
+
+// itemHasTag matches the items with the tag in their tags.
+func itemHasTag(tag string) predicate.Item {
+	return func(s *sql.Selector) {
+		s.Where(sqljson.ValueContains(s.C(item.FieldTags), tag))
 	}
+}
 
-	if data.ParentID != uuid.Nil {
-		q.SetParentID(data.ParentID)
-	} else {
-		q.ClearParent()
+// normalizeCurrency returns the lower case currency code, ErrInvalidCurrency is returned
+// when the code isn't a supported currency. An empty code is returned as is.
+func normalizeCurrency(code string) (string, error) {
+	code = strings.ToLower(strings.TrimSpace(code))
+	if code == "" {
+		return "", nil
 	}
 
-	err = q.Exec(ctx)
-	if err != nil {
-		return ItemOut{}, err
+	if err := group.CurrencyValidator(group.Currency(code)); err != nil {
+		return "", ErrInvalidCurrency
+	}
+
+	return code, nil
+}
+
+// itemInGroupCurrency selects the items priced in the currency of their group. Price totals
+// only sum these items as prices in other currencies can't be added to them, see
+// StatsPurchaseValueByCurrency for totals segmented by currency.
+func itemInGroupCurrency() predicate.Item {
+	return func(s *sql.Selector) {
+		s.Where(sql.ExprP(groupCurrencyCond(s.C(item.FieldCurrency), s.C(item.GroupColumn))))
+	}
+}
+
+// groupCurrencyCond returns the SQL condition of itemInGroupCurrency for the currency and
+// group columns of the items, for use in raw queries.
+func groupCurrencyCond(currency, groupID string) string {
+	return fmt.Sprintf("(%[1]s IS NULL OR %[1]s = '' OR %[1]s = (SELECT groups.currency FROM groups WHERE groups.id = %[2]s))", currency, groupID)
+}
+
+// checkLabelsInGroup ensures that all the provided labels belong to the group.
+func checkLabelsInGroup(ctx context.Context, db *ent.Client, GID uuid.UUID, labelIDs []uuid.UUID) error {
+	if len(labelIDs) == 0 {
+		return nil
 	}
 
-	fields, err := e.db.ItemField.Query().Where(itemfield.HasItemWith(item.ID(data.ID))).All(ctx)
+	ids := set.New(labelIDs...)
+
+	count, err := db.Label.Query().
+		Where(
+			label.IDIn(ids.Slice()...),
+			label.HasGroupWith(group.ID(GID)),
+		).
+		Count(ctx)
 	if err != nil {
-		return ItemOut{}, err
+		return err
 	}
 
-	fieldIds := newIDSet(fields)
+	if count != ids.Len() {
+		return ErrLabelNotInGroup
+	}
 
-	// Update Existing Fields
-	for _, f := range data.Fields {
-		if f.ID == uuid.Nil {
-			// Create New Field
-			_, err = e.db.ItemField.Create().
-				SetItemID(data.ID).
-				SetType(itemfield.Type(f.Type)).
-				SetName(f.Name).
-				SetTextValue(f.TextValue).
-				SetNumberValue(f.NumberValue).
-				SetBooleanValue(f.BooleanValue).
-				// SetTimeValue(f.TimeValue).
-				Save(ctx)
-			if err != nil {
-				return ItemOut{}, err
-			}
-		}
+	return nil
+}
 
-		opt := e.db.ItemField.Update().
-			Where(
-				itemfield.ID(f.ID),
-				itemfield.HasItemWith(item.ID(data.ID)),
-			).
-			SetType(itemfield.Type(f.Type)).
-			SetName(f.Name).
-			SetTextValue(f.TextValue).
-			SetNumberValue(f.NumberValue).
-			SetBooleanValue(f.BooleanValue)
-			// SetTimeValue(f.TimeValue)
+// SetWarrantyFromAttachment sets the warranty expiration date of an item to the date stored
+// on one of its warranty attachments so that the warranty fields stay in sync with the
+// uploaded document.
+func (e *ItemsRepository) SetWarrantyFromAttachment(ctx context.Context, GID, itemID, attachmentID uuid.UUID) (ItemOut, error) {
+	a, err := e.db.Attachment.Query().
+		Where(
+			attachment.ID(attachmentID),
+			attachment.HasItemWith(
+				item.ID(itemID),
+				item.HasGroupWith(group.ID(GID)),
+			),
+		).
+		Only(ctx)
+	if err != nil {
+		return ItemOut{}, err
+	}
 
-		_, err = opt.Save(ctx)
-		if err != nil {
-			return ItemOut{}, err
-		}
+	if a.Type != attachment.TypeWarranty {
+		return ItemOut{}, ErrAttachmentNotWarranty
+	}
 
-		fieldIds.Remove(f.ID)
-		continue
+	if a.Date.IsZero() {
+		return ItemOut{}, ErrAttachmentNoDate
 	}
 
-	// Delete Fields that are no longer present
-	if fieldIds.Len() > 0 {
-		_, err = e.db.ItemField.Delete().
-			Where(
-				itemfield.IDIn(fieldIds.Slice()...),
-				itemfield.HasItemWith(item.ID(data.ID)),
-			).Exec(ctx)
-		if err != nil {
-			return ItemOut{}, err
-		}
+	err = e.db.Item.UpdateOneID(itemID).
+		SetWarrantyExpires(a.Date).
+		Exec(ctx)
+	if err != nil {
+		return ItemOut{}, err
 	}
 
 	e.publishMutationEvent(GID)
-	return e.GetOne(ctx, data.ID)
+	return e.GetOne(ctx, itemID)
 }
 
 func (e *ItemsRepository) GetAllZeroImportRef(ctx context.Context, GID uuid.UUID) ([]uuid.UUID, error) {
@@ -710,23 +3485,165 @@ func (e *ItemsRepository) GetAllZeroImportRef(ctx context.Context, GID uuid.UUID
 	return ids, nil
 }
 
+// Patch applies the fields of the patch that are set to the item of the group, the other
+// fields are left unchanged.
 func (e *ItemsRepository) Patch(ctx context.Context, GID, ID uuid.UUID, data ItemPatch) error {
-	q := e.db.Item.Update().
-		Where(
-			item.ID(ID),
-			item.HasGroupWith(group.ID(GID)),
-		)
+	err := withTx(ctx, e.db, func(tx *ent.Tx) error {
+		return patchItem(ctx, tx.Client(), GID, ID, data)
+	})
+	if err != nil {
+		return err
+	}
+
+	e.publishMutationEvent(GID)
+	e.publish(ItemUpdated{newItemChange(GID, ID)})
+	return nil
+}
 
-	if data.ImportRef != nil {
-		q.SetImportRef(*data.ImportRef)
+// patchItem applies the patch to the item of the group and records the changes, db is the
+// client of the transaction of Patch.
+func patchItem(ctx context.Context, db *ent.Client, GID, ID uuid.UUID, data ItemPatch) error {
+	before, err := itemSnapshot(ctx, db, GID, ID)
+	if err != nil {
+		return err
 	}
 
-	if data.Quantity != nil {
-		q.SetQuantity(*data.Quantity)
+	// items only leave the trash through RestoreByGroup
+	if before.ArchivedAt != nil && data.Archived != nil && !*data.Archived {
+		return ErrItemInTrash
 	}
 
-	e.publishMutationEvent(GID)
-	return q.Exec(ctx)
+	q := db.Item.Update().Where(item.ID(ID), item.HasGroupWith(group.ID(GID)))
+
+	if data.Version != 0 {
+		q.Where(item.Version(data.Version))
+	}
+
+	setIfPresent(data.ImportRef, q.SetImportRef)
+	setIfPresent(data.Quantity, q.SetQuantity)
+	setIfPresent(data.Name, q.SetName)
+	setIfPresent(data.Description, q.SetDescription)
+	setIfPresent(data.Notes, q.SetNotes)
+	setIfPresent(data.Insured, q.SetInsured)
+	setIfPresent(data.ReorderThreshold, q.SetReorderThreshold)
+	setIfPresent(data.SerialNumber, q.SetSerialNumber)
+	setIfPresent(data.Barcode, q.SetBarcode)
+	setIfPresent(data.ModelNumber, q.SetModelNumber)
+	setIfPresent(data.Manufacturer, q.SetManufacturer)
+	setIfPresent(data.LifetimeWarranty, q.SetLifetimeWarranty)
+	setIfPresent(data.WarrantyDetails, q.SetWarrantyDetails)
+	setIfPresent(data.PurchaseFrom, q.SetPurchaseFrom)
+	setIfPresent(data.PurchasePrice, q.SetPurchasePrice)
+	setIfPresent(data.PurchaseOrderNumber, q.SetPurchaseOrderNumber)
+	setIfPresent(data.ReplacementCost, q.SetReplacementCost)
+	setIfPresent(data.SoldTo, q.SetSoldTo)
+	setIfPresent(data.SoldPrice, q.SetSoldPrice)
+	setIfPresent(data.SoldNotes, q.SetSoldNotes)
+
+	setIfPresent(data.Archived, q.SetArchived)
+
+	if data.AssetID != nil {
+		q.SetAssetID(int(*data.AssetID))
+	}
+
+	if data.NotesFormat != nil {
+		if item.NotesFormatValidator(item.NotesFormat(*data.NotesFormat)) != nil {
+			return ErrInvalidNotesFormat
+		}
+		q.SetNotesFormat(item.NotesFormat(*data.NotesFormat))
+	}
+
+	if data.AcquisitionType != nil {
+		q.SetAcquisitionType(item.AcquisitionType(*data.AcquisitionType))
+	}
+
+	if data.Currency != nil {
+		currency, err := normalizeCurrency(*data.Currency)
+		if err != nil {
+			return err
+		}
+		q.SetCurrency(currency)
+	}
+
+	if (data.Latitude == nil) != (data.Longitude == nil) {
+		return ErrIncompleteCoordinates
+	}
+
+	if data.Latitude != nil {
+		q.SetLatitude(*data.Latitude).SetLongitude(*data.Longitude)
+	}
+
+	if data.Tags != nil {
+		q.SetTags(normalizeTags(data.Tags))
+	}
+
+	if data.WarrantyExpires != nil {
+		q.SetWarrantyExpires(data.WarrantyExpires.Time())
+	}
+
+	if data.PurchaseTime != nil {
+		q.SetPurchaseTime(data.PurchaseTime.Time())
+	}
+
+	if data.SoldTime != nil {
+		q.SetSoldTime(data.SoldTime.Time())
+	}
+
+	if data.LocationID != nil {
+		_, err := db.Location.Query().
+			Where(
+				location.ID(*data.LocationID),
+				location.HasGroupWith(group.ID(GID)),
+			).
+			OnlyID(ctx)
+		if err != nil {
+			return err
+		}
+
+		q.SetLocationID(*data.LocationID)
+	}
+
+	if data.ParentID != nil {
+		if *data.ParentID == uuid.Nil {
+			q.ClearParent()
+		} else {
+			err := checkParent(ctx, db, ID, *data.ParentID)
+			if err != nil {
+				return err
+			}
+
+			q.SetParentID(*data.ParentID)
+		}
+	}
+
+	if data.LabelIDs != nil {
+		err := checkLabelsInGroup(ctx, db, GID, data.LabelIDs)
+		if err != nil {
+			return err
+		}
+
+		add, remove := diffLabels(before.Edges.Label, data.LabelIDs)
+		q.AddLabelIDs(add...).RemoveLabelIDs(remove...)
+	}
+
+	updated, err := q.Save(ctx)
+	if err != nil {
+		return err
+	}
+
+	// the item exists since it was found by the snapshot, so it was changed in the meantime
+	if updated == 0 {
+		return ErrVersionConflict
+	}
+
+	return recordItemUpdate(ctx, db, GID, before)
+}
+
+// setIfPresent calls set with the value when it is not nil.
+func setIfPresent[T any, R any](v *T, set func(T) R) {
+	if v != nil {
+		set(*v)
+	}
 }
 
 func (e *ItemsRepository) GetAllCustomFieldValues(ctx context.Context, GID uuid.UUID, name string) ([]string, error) {
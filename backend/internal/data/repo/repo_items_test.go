@@ -2,10 +2,15 @@ package repo
 
 import (
 	"context"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/hay-kot/homebox/backend/internal/data/ent"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/attachment"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/itemevent"
 	"github.com/hay-kot/homebox/backend/internal/data/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -45,6 +50,52 @@ func useItems(t *testing.T, len int) []ItemOut {
 	return items
 }
 
+// useGroupItems creates a new group with a location and an item in it for each of the
+// updates. Each update is applied to its item after it's created, the ID is set and an
+// empty name or location defaults to the created one.
+func useGroupItems(t *testing.T, updates ...ItemUpdate) (Group, []ItemOut) {
+	t.Helper()
+
+	ctx := context.Background()
+
+	g, err := tRepos.Groups.GroupCreate(ctx, fk.Str(10))
+	require.NoError(t, err)
+
+	loc, err := tRepos.Locations.Create(ctx, g.ID, locationFactory())
+	require.NoError(t, err)
+
+	items := make([]ItemOut, len(updates))
+	for i, update := range updates {
+		data := itemFactory()
+		data.LocationID = loc.ID
+
+		itm, err := tRepos.Items.Create(ctx, g.ID, data)
+		require.NoError(t, err)
+
+		update.ID = itm.ID
+		if update.Name == "" {
+			update.Name = itm.Name
+		}
+		if update.LocationID == uuid.Nil {
+			update.LocationID = loc.ID
+		}
+
+		items[i], err = tRepos.Items.UpdateByGroup(ctx, g.ID, update)
+		require.NoError(t, err)
+	}
+
+	return g, items
+}
+
+// itemIDs returns the IDs of the items in order.
+func itemIDs(items []ItemSummary) []uuid.UUID {
+	ids := make([]uuid.UUID, len(items))
+	for i, itm := range items {
+		ids[i] = itm.ID
+	}
+	return ids
+}
+
 func TestItemsRepository_RecursiveRelationships(t *testing.T) {
 	parent := useItems(t, 1)[0]
 
@@ -111,6 +162,96 @@ func TestItemsRepository_GetAll(t *testing.T) {
 	}
 }
 
+func TestItemsRepository_GetAllByLabelAndLocation(t *testing.T) {
+	ctx := context.Background()
+	items := useItems(t, 3)
+	lbl := useLabels(t, 1)[0]
+
+	for _, itm := range items[:2] {
+		_, err := tRepos.Items.SetLabels(ctx, tGroup.ID, itm.ID, []uuid.UUID{lbl.ID})
+		require.NoError(t, err)
+	}
+
+	// an item of another group linked to the label directly in the database
+	g, others := useGroupItems(t, ItemUpdate{})
+	other, otherLoc := others[0], others[0].Location
+
+	err := tClient.Item.UpdateOneID(other.ID).AddLabelIDs(lbl.ID).Exec(ctx)
+	require.NoError(t, err)
+
+	byLabel, err := tRepos.Items.GetAllByLabel(ctx, tGroup.ID, lbl.ID)
+	require.NoError(t, err)
+
+	ids := make([]uuid.UUID, len(byLabel))
+	for i, itm := range byLabel {
+		ids[i] = itm.ID
+		assert.NotEmpty(t, itm.Labels)
+		assert.NotNil(t, itm.Location)
+	}
+	assert.ElementsMatch(t, []uuid.UUID{items[0].ID, items[1].ID}, ids)
+
+	// the label doesn't belong to the other group
+	_, err = tRepos.Items.GetAllByLabel(ctx, g.ID, lbl.ID)
+	assert.True(t, ent.IsNotFound(err))
+
+	byLocation, err := tRepos.Items.GetAllByLocation(ctx, tGroup.ID, items[0].Location.ID)
+	require.NoError(t, err)
+	assert.Len(t, byLocation, 3)
+
+	byLocation, err = tRepos.Items.GetAllByLocation(ctx, g.ID, otherLoc.ID)
+	require.NoError(t, err)
+	require.Len(t, byLocation, 1)
+	assert.Equal(t, other.ID, byLocation[0].ID)
+
+	_, err = tRepos.Items.GetAllByLocation(ctx, tGroup.ID, otherLoc.ID)
+	assert.True(t, ent.IsNotFound(err))
+}
+
+func TestItemsRepository_QueryByLabelAndLocation(t *testing.T) {
+	ctx := context.Background()
+	items := useItems(t, 6)
+	lbl := useLabels(t, 1)[0]
+
+	labeled := make([]uuid.UUID, 0, 5)
+	for _, itm := range items[:5] {
+		_, err := tRepos.Items.SetLabels(ctx, tGroup.ID, itm.ID, []uuid.UUID{lbl.ID})
+		require.NoError(t, err)
+		labeled = append(labeled, itm.ID)
+	}
+
+	var seen []uuid.UUID
+	for page := 1; page <= 3; page++ {
+		result, err := tRepos.Items.QueryByLabel(ctx, tGroup.ID, lbl.ID, ItemQuery{Page: page, PageSize: 2})
+		require.NoError(t, err)
+		assert.Equal(t, 5, result.Total)
+
+		for _, itm := range result.Items {
+			seen = append(seen, itm.ID)
+		}
+	}
+	assert.ElementsMatch(t, labeled, seen)
+
+	// the filters of the query still apply
+	result, err := tRepos.Items.QueryByLabel(ctx, tGroup.ID, lbl.ID, ItemQuery{Search: items[0].Name})
+	require.NoError(t, err)
+	require.Len(t, result.Items, 1)
+	assert.Equal(t, items[0].ID, result.Items[0].ID)
+
+	result, err = tRepos.Items.QueryByLocation(ctx, tGroup.ID, items[0].Location.ID, ItemQuery{PageSize: 4})
+	require.NoError(t, err)
+	assert.Equal(t, 6, result.Total)
+	assert.Len(t, result.Items, 4)
+
+	g, err := tRepos.Groups.GroupCreate(ctx, "query-by-label")
+	require.NoError(t, err)
+
+	_, err = tRepos.Items.QueryByLabel(ctx, g.ID, lbl.ID, ItemQuery{})
+	assert.True(t, ent.IsNotFound(err))
+
+	_, err = tRepos.Items.QueryByLocation(ctx, g.ID, items[0].Location.ID, ItemQuery{})
+	assert.True(t, ent.IsNotFound(err))
+}
+
 func TestItemsRepository_Create(t *testing.T) {
 	location, err := tRepos.Locations.Create(context.Background(), tGroup.ID, locationFactory())
 	assert.NoError(t, err)
@@ -151,6 +292,23 @@ func TestItemsRepository_Create_Location(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestItemsRepository_Create_LabelsInGroup(t *testing.T) {
+	ctx := context.Background()
+
+	other, err := tRepos.Groups.GroupCreate(ctx, "create-labels")
+	require.NoError(t, err)
+
+	foreign, err := tRepos.Labels.Create(ctx, other.ID, LabelCreate{Name: fk.Str(10)})
+	require.NoError(t, err)
+
+	data := itemFactory()
+	data.LocationID = useLocations(t, 1)[0].ID
+	data.LabelIDs = []uuid.UUID{foreign.ID}
+
+	_, err = tRepos.Items.Create(ctx, tGroup.ID, data)
+	require.ErrorIs(t, err, ErrLabelNotInGroup)
+}
+
 func TestItemsRepository_Delete(t *testing.T) {
 	entities := useItems(t, 3)
 
@@ -229,24 +387,25 @@ func TestItemsRepository_Update(t *testing.T) {
 	entity := entities[0]
 
 	updateData := ItemUpdate{
-		ID:               entity.ID,
-		Name:             entity.Name,
-		LocationID:       entity.Location.ID,
-		SerialNumber:     fk.Str(10),
-		LabelIDs:         nil,
-		ModelNumber:      fk.Str(10),
-		Manufacturer:     fk.Str(10),
-		PurchaseTime:     types.DateFromTime(time.Now()),
-		PurchaseFrom:     fk.Str(10),
-		PurchasePrice:    300.99,
-		SoldTime:         types.DateFromTime(time.Now()),
-		SoldTo:           fk.Str(10),
-		SoldPrice:        300.99,
-		SoldNotes:        fk.Str(10),
-		Notes:            fk.Str(10),
-		WarrantyExpires:  types.DateFromTime(time.Now()),
-		WarrantyDetails:  fk.Str(10),
-		LifetimeWarranty: true,
+		ID:                  entity.ID,
+		Name:                entity.Name,
+		LocationID:          entity.Location.ID,
+		SerialNumber:        fk.Str(10),
+		LabelIDs:            nil,
+		ModelNumber:         fk.Str(10),
+		Manufacturer:        fk.Str(10),
+		PurchaseTime:        types.DateFromTime(time.Now()),
+		PurchaseFrom:        fk.Str(10),
+		PurchasePrice:       300.99,
+		PurchaseOrderNumber: fk.Str(10),
+		SoldTime:            types.DateFromTime(time.Now()),
+		SoldTo:              fk.Str(10),
+		SoldPrice:           300.99,
+		SoldNotes:           fk.Str(10),
+		Notes:               fk.Str(10),
+		WarrantyExpires:     types.DateFromTime(time.Now()),
+		WarrantyDetails:     fk.Str(10),
+		LifetimeWarranty:    true,
 	}
 
 	updatedEntity, err := tRepos.Items.UpdateByGroup(context.Background(), tGroup.ID, updateData)
@@ -263,6 +422,7 @@ func TestItemsRepository_Update(t *testing.T) {
 	assert.Equal(t, updateData.Manufacturer, got.Manufacturer)
 	// assert.Equal(t, updateData.PurchaseTime, got.PurchaseTime)
 	assert.Equal(t, updateData.PurchaseFrom, got.PurchaseFrom)
+	assert.Equal(t, updateData.PurchaseOrderNumber, got.PurchaseOrderNumber)
 	assert.Equal(t, updateData.PurchasePrice, got.PurchasePrice)
 	// assert.Equal(t, updateData.SoldTime, got.SoldTime)
 	assert.Equal(t, updateData.SoldTo, got.SoldTo)
@@ -318,3 +478,2669 @@ func TestItemRepository_GetAllCustomFields(t *testing.T) {
 		assert.ElementsMatch(t, values[:1], results)
 	}
 }
+
+func TestItemsRepository_UpdateByGroup_CustomFields(t *testing.T) {
+	entity := useItems(t, 1)[0]
+
+	update := ItemUpdate{
+		ID:         entity.ID,
+		Name:       entity.Name,
+		LocationID: entity.Location.ID,
+		Fields: []ItemField{
+			{Name: "color", Type: "text", TextValue: "red"},
+			{Name: "firmware version", Type: "text", TextValue: "1.0.0"},
+		},
+	}
+
+	got, err := tRepos.Items.UpdateByGroup(context.Background(), tGroup.ID, update)
+	require.NoError(t, err)
+	require.Len(t, got.Fields, 2)
+
+	byName := func(fields []ItemField) map[string]ItemField {
+		m := make(map[string]ItemField, len(fields))
+		for _, f := range fields {
+			m[f.Name] = f
+		}
+		return m
+	}
+
+	fields := byName(got.Fields)
+
+	// update one field and drop the other
+	color := fields["color"]
+	color.TextValue = "blue"
+	update.Fields = []ItemField{color}
+
+	got, err = tRepos.Items.UpdateByGroup(context.Background(), tGroup.ID, update)
+	require.NoError(t, err)
+	require.Len(t, got.Fields, 1)
+	assert.Equal(t, color.ID, got.Fields[0].ID)
+	assert.Equal(t, "blue", got.Fields[0].TextValue)
+
+	update.Fields = nil
+	got, err = tRepos.Items.UpdateByGroup(context.Background(), tGroup.ID, update)
+	require.NoError(t, err)
+	assert.Empty(t, got.Fields)
+}
+
+func TestItemsRepository_QueryMisplaced(t *testing.T) {
+	items := useItems(t, 3)
+	other := useLocations(t, 1)[0]
+	lbl := useLabels(t, 1)[0]
+
+	home := items[0].Location.ID
+
+	for i, locationID := range []uuid.UUID{home, other.ID} {
+		_, err := tRepos.Items.UpdateByGroup(context.Background(), tGroup.ID, ItemUpdate{
+			ID:         items[i].ID,
+			Name:       items[i].Name,
+			LocationID: locationID,
+			LabelIDs:   []uuid.UUID{lbl.ID},
+		})
+		require.NoError(t, err)
+	}
+
+	results, err := tRepos.Items.QueryMisplaced(context.Background(), tGroup.ID, lbl.ID, []uuid.UUID{other.ID})
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	assert.Equal(t, items[0].ID, results[0].ID)
+
+	results, err = tRepos.Items.QueryMisplaced(context.Background(), tGroup.ID, lbl.ID, []uuid.UUID{home})
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	assert.Equal(t, items[1].ID, results[0].ID)
+}
+
+func TestItemsRepository_QueryBelowReorder(t *testing.T) {
+	items := useItems(t, 4)
+
+	cases := []struct {
+		quantity  int
+		threshold int
+	}{
+		{quantity: 2, threshold: 5}, // below
+		{quantity: 5, threshold: 5}, // at
+		{quantity: 6, threshold: 5}, // above
+		{quantity: 0, threshold: 0}, // no threshold
+	}
+
+	for i, c := range cases {
+		_, err := tRepos.Items.UpdateByGroup(context.Background(), tGroup.ID, ItemUpdate{
+			ID:               items[i].ID,
+			Name:             items[i].Name,
+			LocationID:       items[i].Location.ID,
+			Quantity:         c.quantity,
+			ReorderThreshold: c.threshold,
+		})
+		require.NoError(t, err)
+	}
+
+	results, err := tRepos.Items.QueryBelowReorder(context.Background(), tGroup.ID)
+	require.NoError(t, err)
+
+	assert.ElementsMatch(t, []uuid.UUID{items[0].ID, items[1].ID}, itemIDs(results))
+}
+
+func TestItemsRepository_DecrementQuantity(t *testing.T) {
+	ctx := context.Background()
+	items := useItems(t, 1)
+
+	_, err := tRepos.Items.UpdateByGroup(ctx, tGroup.ID, ItemUpdate{
+		ID:         items[0].ID,
+		Name:       items[0].Name,
+		LocationID: items[0].Location.ID,
+		Quantity:   2,
+	})
+	require.NoError(t, err)
+
+	_, err = tRepos.Items.DecrementQuantity(ctx, tGroup.ID, items[0].ID, 0)
+	require.ErrorIs(t, err, ErrInvalidQuantityChange)
+
+	_, err = tRepos.Items.IncrementQuantity(ctx, tGroup.ID, items[0].ID, -1)
+	require.ErrorIs(t, err, ErrInvalidQuantityChange)
+
+	got, err := tRepos.Items.DecrementQuantity(ctx, tGroup.ID, items[0].ID, 5)
+	require.NoError(t, err)
+	assert.Equal(t, 0, got.Quantity)
+
+	got, err = tRepos.Items.IncrementQuantity(ctx, tGroup.ID, items[0].ID, 3)
+	require.NoError(t, err)
+	assert.Equal(t, 3, got.Quantity)
+
+	_, err = tRepos.Items.DecrementQuantity(ctx, uuid.New(), items[0].ID, 1)
+	require.Error(t, err)
+}
+
+func TestItemsRepository_QuantityLowStock(t *testing.T) {
+	ctx := context.Background()
+	items := useItems(t, 1)
+
+	_, err := tRepos.Items.UpdateByGroup(ctx, tGroup.ID, ItemUpdate{
+		ID:               items[0].ID,
+		Name:             items[0].Name,
+		LocationID:       items[0].Location.ID,
+		Quantity:         4,
+		ReorderThreshold: 2,
+	})
+	require.NoError(t, err)
+
+	got, err := tRepos.Items.DecrementQuantity(ctx, tGroup.ID, items[0].ID, 1)
+	require.NoError(t, err)
+	assert.False(t, got.LowStock)
+
+	got, err = tRepos.Items.DecrementQuantity(ctx, tGroup.ID, items[0].ID, 1)
+	require.NoError(t, err)
+	assert.True(t, got.LowStock)
+
+	got, err = tRepos.Items.IncrementQuantity(ctx, tGroup.ID, items[0].ID, 1)
+	require.NoError(t, err)
+	assert.False(t, got.LowStock)
+}
+
+func TestItemsRepository_QueryIncomplete(t *testing.T) {
+	ctx := context.Background()
+	doc := useDocs(t, 1)[0]
+
+	g, items := useGroupItems(t,
+		ItemUpdate{PurchasePrice: 10, SerialNumber: fk.Str(8)}, // complete
+		ItemUpdate{PurchasePrice: 10, SerialNumber: fk.Str(8)}, // no attachment
+		ItemUpdate{SerialNumber: fk.Str(8)},                    // no price
+		ItemUpdate{PurchasePrice: 10},                          // no serial
+		ItemUpdate{PurchasePrice: 10, SerialNumber: fk.Str(8)}, // no location
+		ItemUpdate{}, // nothing
+	)
+
+	for _, i := range []int{0, 2, 3, 4} {
+		_, err := tRepos.Attachments.Create(ctx, items[i].ID, doc.ID, attachment.TypeManual)
+		require.NoError(t, err)
+	}
+
+	for _, itm := range items[4:] {
+		err := tClient.Item.UpdateOneID(itm.ID).ClearLocation().Exec(ctx)
+		require.NoError(t, err)
+	}
+
+	complete, noAttachment, noPrice := items[0].ID, items[1].ID, items[2].ID
+	noSerial, noLocation, nothing := items[3].ID, items[4].ID, items[5].ID
+
+	cases := []struct {
+		name     string
+		criteria IncompleteCriteria
+		want     []uuid.UUID
+	}{
+		{"none", IncompleteCriteria{}, []uuid.UUID{complete, noAttachment, noPrice, noSerial, noLocation, nothing}},
+		{"attachments", IncompleteCriteria{NoAttachments: true}, []uuid.UUID{noAttachment, nothing}},
+		{"purchase price", IncompleteCriteria{NoPurchasePrice: true}, []uuid.UUID{noPrice, nothing}},
+		{"serial", IncompleteCriteria{NoSerial: true}, []uuid.UUID{noSerial, nothing}},
+		{"location", IncompleteCriteria{NoLocation: true}, []uuid.UUID{noLocation, nothing}},
+		{"combined", IncompleteCriteria{NoAttachments: true, NoPurchasePrice: true}, []uuid.UUID{nothing}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			results, err := tRepos.Items.QueryIncomplete(ctx, g.ID, tc.criteria)
+			require.NoError(t, err)
+
+			assert.ElementsMatch(t, tc.want, itemIDs(results))
+		})
+	}
+}
+
+func TestItemsRepository_QueryByGroup_HasAttachments(t *testing.T) {
+	ctx := context.Background()
+	doc := useDocs(t, 1)[0]
+
+	g, items := useGroupItems(t, ItemUpdate{}, ItemUpdate{}, ItemUpdate{}, ItemUpdate{})
+	photo, manual, bare1, bare2 := items[0].ID, items[1].ID, items[2].ID, items[3].ID
+
+	_, err := tRepos.Attachments.Create(ctx, photo, doc.ID, attachment.TypePhoto)
+	require.NoError(t, err)
+
+	_, err = tRepos.Attachments.Create(ctx, manual, doc.ID, attachment.TypeManual)
+	require.NoError(t, err)
+
+	yes, no := true, false
+
+	cases := []struct {
+		name string
+		has  *bool
+		want []uuid.UUID
+	}{
+		{"no filter", nil, []uuid.UUID{photo, manual, bare1, bare2}},
+		{"with attachments", &yes, []uuid.UUID{photo, manual}},
+		{"without attachments", &no, []uuid.UUID{bare1, bare2}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			results, err := tRepos.Items.QueryByGroup(ctx, g.ID, ItemQuery{HasAttachments: tc.has})
+			require.NoError(t, err)
+
+			assert.ElementsMatch(t, tc.want, itemIDs(results.Items))
+		})
+	}
+}
+
+func TestItemsRepository_QueryByGroup_InsuredSold(t *testing.T) {
+	ctx := context.Background()
+
+	sold := types.DateFromTime(time.Now().AddDate(0, -1, 0))
+
+	g, items := useGroupItems(t,
+		ItemUpdate{Insured: true},
+		ItemUpdate{Insured: true, SoldTime: sold},
+		ItemUpdate{},
+		ItemUpdate{SoldTime: sold},
+	)
+	insured, insuredSold, uninsured, uninsuredSold := items[0].ID, items[1].ID, items[2].ID, items[3].ID
+	loc := items[0].Location
+
+	yes, no := true, false
+
+	cases := []struct {
+		name  string
+		query ItemQuery
+		want  []uuid.UUID
+	}{
+		{"no filter", ItemQuery{}, []uuid.UUID{insured, insuredSold, uninsured, uninsuredSold}},
+		{"insured", ItemQuery{Insured: &yes}, []uuid.UUID{insured, insuredSold}},
+		{"uninsured", ItemQuery{Insured: &no}, []uuid.UUID{uninsured, uninsuredSold}},
+		{"sold", ItemQuery{Sold: &yes}, []uuid.UUID{insuredSold, uninsuredSold}},
+		{"not sold", ItemQuery{Sold: &no}, []uuid.UUID{insured, uninsured}},
+		{"insured and not sold", ItemQuery{Insured: &yes, Sold: &no}, []uuid.UUID{insured}},
+		{"with location", ItemQuery{Insured: &yes, LocationIDs: []uuid.UUID{loc.ID}}, []uuid.UUID{insured, insuredSold}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			results, err := tRepos.Items.QueryByGroup(ctx, g.ID, tc.query)
+			require.NoError(t, err)
+
+			assert.ElementsMatch(t, tc.want, itemIDs(results.Items))
+		})
+	}
+}
+
+func TestItemsRepository_SetWarrantyFromAttachment(t *testing.T) {
+	doc := useDocs(t, 1)[0]
+	itm := useItems(t, 1)[0]
+
+	warranty, err := tRepos.Attachments.Create(context.Background(), itm.ID, doc.ID, attachment.TypeWarranty)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = tRepos.Attachments.Delete(context.Background(), warranty.ID)
+	})
+
+	_, err = tRepos.Items.SetWarrantyFromAttachment(context.Background(), tGroup.ID, itm.ID, warranty.ID)
+	assert.ErrorIs(t, err, ErrAttachmentNoDate)
+
+	expires := types.DateFromTime(time.Now().AddDate(1, 0, 0))
+
+	_, err = tRepos.Attachments.Update(context.Background(), warranty.ID, &ItemAttachmentUpdate{
+		Type: string(attachment.TypeWarranty),
+		Date: expires,
+	})
+	require.NoError(t, err)
+
+	// updating the attachment without a date keeps the date
+	_, err = tRepos.Attachments.Update(context.Background(), warranty.ID, &ItemAttachmentUpdate{
+		Type: string(attachment.TypeWarranty),
+	})
+	require.NoError(t, err)
+
+	got, err := tRepos.Items.SetWarrantyFromAttachment(context.Background(), tGroup.ID, itm.ID, warranty.ID)
+	require.NoError(t, err)
+	assert.Equal(t, expires.Time().Format(time.DateOnly), got.WarrantyExpires.Time().Format(time.DateOnly))
+}
+
+func TestItemsRepository_MergeItems(t *testing.T) {
+	ctx := context.Background()
+	items := useItems(t, 3)
+	labels := useLabels(t, 3)
+	doc := useDocs(t, 1)[0]
+
+	keep, merge, child := items[0], items[1], items[2]
+
+	_, err := tRepos.Items.UpdateByGroup(ctx, tGroup.ID, ItemUpdate{
+		ID:           keep.ID,
+		Name:         keep.Name,
+		LocationID:   keep.Location.ID,
+		Quantity:     2,
+		SerialNumber: "KEEP-1",
+		LabelIDs:     []uuid.UUID{labels[0].ID, labels[1].ID},
+	})
+	require.NoError(t, err)
+
+	_, err = tRepos.Items.UpdateByGroup(ctx, tGroup.ID, ItemUpdate{
+		ID:           merge.ID,
+		Name:         merge.Name,
+		LocationID:   merge.Location.ID,
+		Quantity:     3,
+		SerialNumber: "MERGE-1",
+		ModelNumber:  "M-100",
+		LabelIDs:     []uuid.UUID{labels[1].ID, labels[2].ID},
+		Fields: []ItemField{
+			{Name: "color", Type: "text", TextValue: "red"},
+		},
+	})
+	require.NoError(t, err)
+
+	_, err = tRepos.Items.UpdateByGroup(ctx, tGroup.ID, ItemUpdate{
+		ID:         child.ID,
+		Name:       child.Name,
+		LocationID: child.Location.ID,
+		ParentID:   merge.ID,
+	})
+	require.NoError(t, err)
+
+	entry, err := tRepos.MaintEntry.Create(ctx, tGroup.ID, merge.ID, MaintenanceEntryCreate{
+		CompletedDate: types.DateFromTime(time.Now()),
+		Name:          "Service",
+	})
+	require.NoError(t, err)
+
+	before, err := tRepos.Items.GetOne(ctx, keep.ID)
+	require.NoError(t, err)
+
+	photo, err := tRepos.Attachments.Create(ctx, merge.ID, doc.ID, attachment.TypePhoto)
+	require.NoError(t, err)
+	manual, err := tRepos.Attachments.Create(ctx, merge.ID, doc.ID, attachment.TypeManual)
+	require.NoError(t, err)
+
+	got, err := tRepos.Items.MergeItems(ctx, tGroup.ID, keep.ID, merge.ID)
+	require.NoError(t, err)
+
+	assert.Equal(t, 5, got.Quantity)
+	assert.Equal(t, "KEEP-1", got.SerialNumber, "kept value takes precedence")
+	assert.Equal(t, "M-100", got.ModelNumber, "empty value is filled from the merged item")
+
+	labelIDs := make([]uuid.UUID, len(got.Labels))
+	for i, l := range got.Labels {
+		labelIDs[i] = l.ID
+	}
+	assert.ElementsMatch(t, []uuid.UUID{labels[0].ID, labels[1].ID, labels[2].ID}, labelIDs)
+
+	attachmentIDs := make([]uuid.UUID, len(got.Attachments))
+	for i, a := range got.Attachments {
+		attachmentIDs[i] = a.ID
+	}
+	assert.ElementsMatch(t, []uuid.UUID{photo.ID, manual.ID}, attachmentIDs)
+
+	require.Len(t, got.Fields, 1)
+	assert.Equal(t, "red", got.Fields[0].TextValue)
+	require.Len(t, got.Children, 1)
+	assert.Equal(t, child.ID, got.Children[0].ID)
+	assert.Greater(t, got.Version, before.Version)
+
+	log, err := tRepos.MaintEntry.GetLog(ctx, tGroup.ID, keep.ID, MaintenanceLogQuery{})
+	require.NoError(t, err)
+	require.Len(t, log.Entries, 1)
+	assert.Equal(t, entry.ID, log.Entries[0].ID)
+
+	_, err = tRepos.Items.GetOne(ctx, merge.ID)
+	require.Error(t, err)
+	assert.True(t, ent.IsNotFound(err))
+
+	history, err := tRepos.Items.GetItemHistory(ctx, tGroup.ID, merge.ID)
+	require.NoError(t, err)
+	require.NotEmpty(t, history)
+	assert.Equal(t, itemevent.TypeDelete.String(), history[0].Type)
+
+	_, err = tRepos.Items.MergeItems(ctx, tGroup.ID, keep.ID, keep.ID)
+	require.ErrorIs(t, err, ErrItemSelfMerge)
+
+	// both items must belong to the group
+	_, others := useGroupItems(t, ItemUpdate{})
+	foreign := others[0]
+
+	_, err = tRepos.Items.MergeItems(ctx, tGroup.ID, keep.ID, foreign.ID)
+	require.Error(t, err)
+	assert.True(t, ent.IsNotFound(err))
+
+	_, err = tRepos.Items.GetOne(ctx, foreign.ID)
+	require.NoError(t, err)
+}
+
+func TestItemsRepository_MergeItems_CurrencyAndLinks(t *testing.T) {
+	ctx := context.Background()
+
+	g, items := useGroupItems(t,
+		ItemUpdate{},
+		ItemUpdate{PurchasePrice: 100, ReplacementCost: 150, Currency: "eur"},
+		ItemUpdate{PurchasePrice: 20},
+		ItemUpdate{ReplacementCost: 30, Currency: "eur"},
+		ItemUpdate{},
+	)
+	unpriced, eur, priced, eurCost, linked := items[0], items[1], items[2], items[3], items[4]
+
+	// the kept item without prices takes the prices along with their currency
+	require.NoError(t, tRepos.Items.LinkItems(ctx, g.ID, eur.ID, linked.ID))
+
+	got, err := tRepos.Items.MergeItems(ctx, g.ID, unpriced.ID, eur.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "EUR", got.Currency)
+	assert.Equal(t, 100.0, got.PurchasePrice)
+	assert.Equal(t, 150.0, got.ReplacementCost)
+
+	require.Len(t, got.Related, 1, "the links of the merged item are moved")
+	assert.Equal(t, linked.ID, got.Related[0].ID)
+
+	// prices in another currency are not filled into a priced item
+	got, err = tRepos.Items.MergeItems(ctx, g.ID, priced.ID, eurCost.ID)
+	require.NoError(t, err)
+	assert.Equal(t, priced.Currency, got.Currency)
+	assert.Equal(t, 20.0, got.PurchasePrice)
+	assert.Zero(t, got.ReplacementCost)
+}
+
+func TestItemsRepository_GetByBarcode(t *testing.T) {
+	ctx := context.Background()
+	items := useItems(t, 3)
+
+	single, shared := fk.Str(12), fk.Str(13)
+
+	for i, code := range []string{single, shared, shared} {
+		_, err := tRepos.Items.UpdateByGroup(ctx, tGroup.ID, ItemUpdate{
+			ID:         items[i].ID,
+			Name:       items[i].Name,
+			LocationID: items[i].Location.ID,
+			Barcode:    code,
+		})
+		require.NoError(t, err)
+	}
+
+	got, err := tRepos.Items.GetByBarcode(ctx, tGroup.ID, single)
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+	assert.Equal(t, items[0].ID, got[0].ID)
+
+	got, err = tRepos.Items.GetByBarcode(ctx, tGroup.ID, shared)
+	require.NoError(t, err)
+	require.Len(t, got, 2)
+	assert.ElementsMatch(t, []uuid.UUID{items[1].ID, items[2].ID}, []uuid.UUID{got[0].ID, got[1].ID})
+
+	out, err := tRepos.Items.GetOne(ctx, items[1].ID)
+	require.NoError(t, err)
+	assert.Equal(t, shared, out.Barcode)
+
+	got, err = tRepos.Items.GetByBarcode(ctx, tGroup.ID, fk.Str(14))
+	require.NoError(t, err)
+	assert.Empty(t, got)
+
+	_, err = tRepos.Items.GetByBarcode(ctx, tGroup.ID, "  ")
+	require.ErrorIs(t, err, ErrEmptyBarcode)
+}
+
+func TestItemsRepository_Create_Barcode(t *testing.T) {
+	data := itemFactory()
+	data.LocationID = useLocations(t, 1)[0].ID
+	data.Barcode = "0012345678905"
+
+	itm, err := tRepos.Items.Create(context.Background(), tGroup.ID, data)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = tRepos.Items.Delete(context.Background(), itm.ID)
+	})
+
+	assert.Equal(t, data.Barcode, itm.Barcode)
+}
+
+func TestItemsRepository_Tags(t *testing.T) {
+	ctx := context.Background()
+
+	g, items := useGroupItems(t,
+		ItemUpdate{Tags: []string{"garage", "fragile"}},
+		ItemUpdate{Tags: []string{"garage ", "garage", " spare"}},
+	)
+	garage, spare := items[0], items[1]
+
+	// tags are trimmed and deduplicated on write
+	assert.Equal(t, []string{"garage", "spare"}, spare.Tags)
+
+	data := itemFactory()
+	data.LocationID = garage.Location.ID
+	data.Tags = []string{" kitchen ", "fragile", "kitchen", "", "fragile"}
+
+	kitchen, err := tRepos.Items.Create(ctx, g.ID, data)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"kitchen", "fragile"}, kitchen.Tags)
+
+	cases := []struct {
+		name string
+		tags []string
+		want []uuid.UUID
+	}{
+		{"no tags", nil, []uuid.UUID{kitchen.ID, garage.ID, spare.ID}},
+		{"one tag", []string{"fragile"}, []uuid.UUID{kitchen.ID, garage.ID}},
+		{"all of multiple tags", []string{"garage", "fragile"}, []uuid.UUID{garage.ID}},
+		{"untrimmed tag", []string{" spare "}, []uuid.UUID{spare.ID}},
+		{"unknown tag", []string{"fragile", "attic"}, []uuid.UUID{}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			results, err := tRepos.Items.QueryByGroup(ctx, g.ID, ItemQuery{Tags: tc.tags})
+			require.NoError(t, err)
+
+			assert.ElementsMatch(t, tc.want, itemIDs(results.Items))
+		})
+	}
+}
+
+func TestItemsRepository_RecentlyUpdated(t *testing.T) {
+	ctx := context.Background()
+
+	g, err := tRepos.Groups.GroupCreate(ctx, "recently-updated")
+	require.NoError(t, err)
+
+	loc, err := tRepos.Locations.Create(ctx, g.ID, locationFactory())
+	require.NoError(t, err)
+
+	base := time.Now().Add(-24 * time.Hour)
+
+	bulk := make([]*ent.ItemCreate, recentlyUpdatedMax+5)
+	for i := range bulk {
+		bulk[i] = tClient.Item.Create().
+			SetName(fk.Str(10)).
+			SetGroupID(g.ID).
+			SetLocationID(loc.ID).
+			SetUpdatedAt(base.Add(time.Duration(i) * time.Minute))
+	}
+
+	created, err := tClient.Item.CreateBulk(bulk...).Save(ctx)
+	require.NoError(t, err)
+
+	newest := created[len(created)-1]
+
+	results, err := tRepos.Items.RecentlyUpdated(ctx, g.ID, 3)
+	require.NoError(t, err)
+	require.Len(t, results, 3)
+	assert.Equal(t, newest.ID, results[0].ID)
+	for i := 1; i < len(results); i++ {
+		assert.True(t, results[i-1].UpdatedAt.After(results[i].UpdatedAt))
+	}
+	assert.Equal(t, loc.ID, results[0].Location.ID)
+
+	results, err = tRepos.Items.RecentlyUpdated(ctx, g.ID, 0)
+	require.NoError(t, err)
+	assert.Len(t, results, recentlyUpdatedDefault)
+
+	results, err = tRepos.Items.RecentlyUpdated(ctx, g.ID, 1000)
+	require.NoError(t, err)
+	assert.Len(t, results, recentlyUpdatedMax)
+}
+
+func TestItemsRepository_LinkItems(t *testing.T) {
+	items := useItems(t, 3)
+
+	err := tRepos.Items.LinkItems(context.Background(), tGroup.ID, items[0].ID, items[1].ID)
+	require.NoError(t, err)
+
+	// Linking twice is a no-op
+	err = tRepos.Items.LinkItems(context.Background(), tGroup.ID, items[1].ID, items[0].ID)
+	require.NoError(t, err)
+
+	// Links are symmetric
+	for _, pair := range [][2]ItemOut{{items[0], items[1]}, {items[1], items[0]}} {
+		got, err := tRepos.Items.GetOne(context.Background(), pair[0].ID)
+		require.NoError(t, err)
+		require.Len(t, got.Related, 1)
+		assert.Equal(t, pair[1].ID, got.Related[0].ID)
+	}
+
+	err = tRepos.Items.LinkItems(context.Background(), tGroup.ID, items[2].ID, items[2].ID)
+	assert.ErrorIs(t, err, ErrItemSelfLink)
+
+	err = tRepos.Items.UnlinkItems(context.Background(), tGroup.ID, items[1].ID, items[0].ID)
+	require.NoError(t, err)
+
+	got, err := tRepos.Items.GetOne(context.Background(), items[0].ID)
+	require.NoError(t, err)
+	assert.Empty(t, got.Related)
+}
+
+func TestItemsRepository_QueryBySource(t *testing.T) {
+	manual := useItems(t, 1)[0]
+	assert.Equal(t, "manual", manual.Source)
+
+	data := itemFactory()
+	data.LocationID = manual.Location.ID
+	data.Source = "import"
+
+	imported, err := tRepos.Items.Create(context.Background(), tGroup.ID, data)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = tRepos.Items.Delete(context.Background(), imported.ID)
+	})
+
+	assert.Equal(t, "import", imported.Source)
+
+	results, err := tRepos.Items.QueryByGroup(context.Background(), tGroup.ID, ItemQuery{Source: "import"})
+	require.NoError(t, err)
+	require.Len(t, results.Items, 1)
+	assert.Equal(t, imported.ID, results.Items[0].ID)
+}
+
+func TestItemsRepository_GroupChecksum(t *testing.T) {
+	items := useItems(t, 2)
+
+	initial, err := tRepos.Items.GroupChecksum(context.Background(), tGroup.ID)
+	require.NoError(t, err)
+	assert.NotEmpty(t, initial)
+
+	again, err := tRepos.Items.GroupChecksum(context.Background(), tGroup.ID)
+	require.NoError(t, err)
+	assert.Equal(t, initial, again, "checksum should be stable without changes")
+
+	_, err = tRepos.Items.UpdateByGroup(context.Background(), tGroup.ID, ItemUpdate{
+		ID:         items[0].ID,
+		Name:       fk.Str(10),
+		LocationID: items[0].Location.ID,
+	})
+	require.NoError(t, err)
+
+	updated, err := tRepos.Items.GroupChecksum(context.Background(), tGroup.ID)
+	require.NoError(t, err)
+	assert.NotEqual(t, initial, updated)
+
+	err = tRepos.Items.DeleteByGroup(context.Background(), tGroup.ID, items[1].ID)
+	require.NoError(t, err)
+
+	deleted, err := tRepos.Items.GroupChecksum(context.Background(), tGroup.ID)
+	require.NoError(t, err)
+	assert.NotEqual(t, updated, deleted)
+}
+
+func TestItemsRepository_SellItem(t *testing.T) {
+	entity := useItems(t, 1)[0]
+	labels := useLabels(t, 2)
+
+	forSale, sold := labels[0], labels[1]
+
+	_, err := tRepos.Items.UpdateByGroup(context.Background(), tGroup.ID, ItemUpdate{
+		ID:         entity.ID,
+		Name:       entity.Name,
+		LocationID: entity.Location.ID,
+		LabelIDs:   []uuid.UUID{forSale.ID},
+	})
+	require.NoError(t, err)
+
+	got, err := tRepos.Items.SellItem(context.Background(), tGroup.ID, entity.ID, SaleInput{
+		SoldTime:       types.DateFromTime(time.Now()),
+		SoldTo:         "buyer",
+		SoldPrice:      42.5,
+		AddLabelIDs:    []uuid.UUID{sold.ID},
+		RemoveLabelIDs: []uuid.UUID{forSale.ID},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "buyer", got.SoldTo)
+	assert.Equal(t, 42.5, got.SoldPrice)
+	require.Len(t, got.Labels, 1)
+	assert.Equal(t, sold.ID, got.Labels[0].ID)
+
+	// Labels from another group are rejected and nothing is applied
+	_, err = tRepos.Items.SellItem(context.Background(), tGroup.ID, entity.ID, SaleInput{
+		SoldTo:      "someone else",
+		AddLabelIDs: []uuid.UUID{uuid.New()},
+	})
+	assert.ErrorIs(t, err, ErrLabelNotInGroup)
+
+	got, err = tRepos.Items.GetOne(context.Background(), entity.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "buyer", got.SoldTo)
+}
+
+func TestItemsRepository_Resolve(t *testing.T) {
+	ctx := context.Background()
+
+	g, items := useGroupItems(t,
+		ItemUpdate{AssetID: 4242},
+		ItemUpdate{SerialNumber: "SN-1234"},
+		ItemUpdate{SerialNumber: "98765"},
+	)
+	tagged, serial, numeric := items[0], items[1], items[2]
+
+	cases := []struct {
+		name       string
+		identifier string
+		want       uuid.UUID
+	}{
+		{"item id", tagged.ID.String(), tagged.ID},
+		{"asset id", "004-242", tagged.ID},
+		{"serial number", "SN-1234", serial.ID},
+		{"numeric serial number", "98765", numeric.ID},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := tRepos.Items.Resolve(ctx, g.ID, tc.identifier)
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got.ID)
+		})
+	}
+
+	for _, identifier := range []string{"", "unknown", "sn-1234", uuid.NewString()} {
+		_, err := tRepos.Items.Resolve(ctx, g.ID, identifier)
+		assert.True(t, ent.IsNotFound(err), identifier)
+	}
+
+	// items of other groups aren't resolved
+	_, err := tRepos.Items.Resolve(ctx, tGroup.ID, tagged.ID.String())
+	assert.True(t, ent.IsNotFound(err))
+}
+
+func TestItemsRepository_GetByAssetIDs(t *testing.T) {
+	items := useItems(t, 2)
+
+	for i, itm := range items {
+		err := tRepos.Items.SetAssetID(context.Background(), tGroup.ID, itm.ID, AssetID(9000+i))
+		require.NoError(t, err)
+	}
+
+	results, err := tRepos.Items.GetByAssetIDs(context.Background(), tGroup.ID, []AssetID{9000, 9001, 9999})
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+
+	assert.Equal(t, items[0].ID, results[9000].ID)
+	assert.Equal(t, items[1].ID, results[9001].ID)
+
+	_, ok := results[9999]
+	assert.False(t, ok)
+}
+
+func TestItemsRepository_QueryByGroup_Snapshot(t *testing.T) {
+	before := useItems(t, 2)
+
+	snapshot := time.Now()
+
+	after := useItems(t, 1)
+
+	results, err := tRepos.Items.QueryByGroup(context.Background(), tGroup.ID, ItemQuery{
+		Page:       -1,
+		PageSize:   -1,
+		SnapshotAt: &snapshot,
+	})
+	require.NoError(t, err)
+
+	ids := itemIDs(results.Items)
+
+	assert.Equal(t, 2, results.Total)
+	assert.ElementsMatch(t, []uuid.UUID{before[0].ID, before[1].ID}, ids)
+	assert.NotContains(t, ids, after[0].ID)
+}
+
+func TestItemsRepository_ArchiveByQuery(t *testing.T) {
+	items := useItems(t, 3)
+	lbl := useLabels(t, 1)[0]
+
+	for _, itm := range items[:2] {
+		_, err := tRepos.Items.UpdateByGroup(context.Background(), tGroup.ID, ItemUpdate{
+			ID:         itm.ID,
+			Name:       itm.Name,
+			LocationID: itm.Location.ID,
+			LabelIDs:   []uuid.UUID{lbl.ID},
+		})
+		require.NoError(t, err)
+	}
+
+	count, err := tRepos.Items.ArchiveByQuery(context.Background(), tGroup.ID, ItemQuery{
+		LabelIDs: []uuid.UUID{lbl.ID},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+
+	results, err := tRepos.Items.QueryByGroup(context.Background(), tGroup.ID, ItemQuery{Page: -1, PageSize: -1})
+	require.NoError(t, err)
+	require.Len(t, results.Items, 1)
+	assert.Equal(t, items[2].ID, results.Items[0].ID)
+}
+
+func TestItemsRepository_QueryByGroup_PageBounds(t *testing.T) {
+	ctx := context.Background()
+
+	g, _ := useGroupItems(t, make([]ItemUpdate, 5)...)
+
+	items := &ItemsRepository{db: tClient}
+	items.SetPageBounds(PageBounds{Default: 2, Max: 3})
+
+	// a page size of zero uses the default
+	results, err := items.QueryByGroup(ctx, g.ID, ItemQuery{})
+	require.NoError(t, err)
+	assert.Len(t, results.Items, 2)
+	assert.Equal(t, 1, results.Page)
+	assert.Equal(t, 2, results.PageSize)
+	assert.Equal(t, 5, results.Total)
+
+	// page sizes above the maximum are capped
+	results, err = items.QueryByGroup(ctx, g.ID, ItemQuery{Page: 2, PageSize: 1000})
+	require.NoError(t, err)
+	assert.Len(t, results.Items, 2)
+	assert.Equal(t, 3, results.PageSize)
+
+	// -1 doesn't disable pagination without the flag
+	results, err = items.QueryByGroup(ctx, g.ID, ItemQuery{Page: -1, PageSize: -1})
+	require.NoError(t, err)
+	assert.Len(t, results.Items, 2)
+
+	results, err = items.QueryByGroup(ctx, g.ID, ItemQuery{Unpaginated: true})
+	require.NoError(t, err)
+	assert.Len(t, results.Items, 5)
+}
+
+func TestItemsRepository_QueryByGroup_OlderThan(t *testing.T) {
+	items := useItems(t, 3)
+
+	now := time.Now()
+	purchased := []time.Time{
+		now.AddDate(-3, 0, 0),
+		now.AddDate(0, -1, 0),
+		{},
+	}
+
+	for i, pt := range purchased {
+		_, err := tRepos.Items.UpdateByGroup(context.Background(), tGroup.ID, ItemUpdate{
+			ID:           items[i].ID,
+			Name:         items[i].Name,
+			LocationID:   items[i].Location.ID,
+			PurchaseTime: types.DateFromTime(pt),
+		})
+		require.NoError(t, err)
+	}
+
+	olderThan := 2 * 365 * 24 * time.Hour
+
+	results, err := tRepos.Items.QueryByGroup(context.Background(), tGroup.ID, ItemQuery{
+		OlderThan:   &olderThan,
+		LocationIDs: []uuid.UUID{items[0].Location.ID},
+	})
+	require.NoError(t, err)
+	require.Len(t, results.Items, 1)
+	assert.Equal(t, items[0].ID, results.Items[0].ID)
+}
+
+func TestItemsRepository_QueryByPurchaseOrderNumber(t *testing.T) {
+	location := useLocations(t, 1)[0]
+
+	created := make([]ItemOut, 3)
+	for i, po := range []string{"PO-1001", "PO-1001", ""} {
+		data := itemFactory()
+		data.LocationID = location.ID
+		data.PurchaseOrderNumber = po
+
+		itm, err := tRepos.Items.Create(context.Background(), tGroup.ID, data)
+		require.NoError(t, err)
+		assert.Equal(t, po, itm.PurchaseOrderNumber)
+
+		created[i] = itm
+	}
+
+	t.Cleanup(func() {
+		for _, itm := range created {
+			_ = tRepos.Items.Delete(context.Background(), itm.ID)
+		}
+	})
+
+	results, err := tRepos.Items.QueryByGroup(context.Background(), tGroup.ID, ItemQuery{PurchaseOrderNumber: "po-1001"})
+	require.NoError(t, err)
+	assert.Len(t, results.Items, 2)
+
+	results, err = tRepos.Items.QueryByGroup(context.Background(), tGroup.ID, ItemQuery{Search: "PO-10"})
+	require.NoError(t, err)
+	assert.Len(t, results.Items, 2)
+}
+
+func TestItemsRepository_QueryExpiredWarranties(t *testing.T) {
+	items := useItems(t, 6)
+
+	now := time.Now()
+	updates := []struct {
+		lifetime bool
+		expires  time.Time
+	}{
+		{expires: now.AddDate(0, -1, 0)},                 // expired recently
+		{expires: now.AddDate(-1, 0, 0)},                 // expired long ago
+		{expires: now.AddDate(0, 1, 0)},                  // still covered
+		{lifetime: true, expires: now.AddDate(-1, 0, 0)}, // lifetime
+		{},                 // no warranty
+		{expires: today()}, // expires today, not expired yet
+	}
+
+	for i, u := range updates {
+		_, err := tRepos.Items.UpdateByGroup(context.Background(), tGroup.ID, ItemUpdate{
+			ID:               items[i].ID,
+			Name:             items[i].Name,
+			LocationID:       items[i].Location.ID,
+			LifetimeWarranty: u.lifetime,
+			WarrantyExpires:  types.DateFromTime(u.expires),
+		})
+		require.NoError(t, err)
+	}
+
+	expired, err := tRepos.Items.QueryExpiredWarranties(context.Background(), tGroup.ID)
+	require.NoError(t, err)
+	require.Len(t, expired, 2)
+	assert.Equal(t, items[0].ID, expired[0].ID)
+	assert.Equal(t, items[1].ID, expired[1].ID)
+}
+
+func TestItemsRepository_QueryExpiringWarranties(t *testing.T) {
+	items := useItems(t, 6)
+
+	now := time.Now()
+	updates := []struct {
+		lifetime bool
+		expires  time.Time
+	}{
+		{expires: now.AddDate(0, 0, -1)},                // expired yesterday
+		{expires: now.AddDate(0, 0, 7)},                 // expires next week
+		{expires: now.AddDate(0, 2, 0)},                 // outside the window
+		{lifetime: true, expires: now.AddDate(0, 0, 7)}, // lifetime
+		{},                 // no warranty
+		{expires: today()}, // expires today
+	}
+
+	for i, u := range updates {
+		_, err := tRepos.Items.UpdateByGroup(context.Background(), tGroup.ID, ItemUpdate{
+			ID:               items[i].ID,
+			Name:             items[i].Name,
+			LocationID:       items[i].Location.ID,
+			LifetimeWarranty: u.lifetime,
+			WarrantyExpires:  types.DateFromTime(u.expires),
+		})
+		require.NoError(t, err)
+	}
+
+	expiring, err := tRepos.Items.QueryExpiringWarranties(context.Background(), tGroup.ID, now.AddDate(0, 0, 30))
+	require.NoError(t, err)
+	require.Len(t, expiring, 2)
+	assert.Equal(t, items[5].ID, expiring[0].ID)
+	assert.Equal(t, items[1].ID, expiring[1].ID)
+}
+
+func TestItemsRepository_QueryIncompleteWarranties(t *testing.T) {
+	items := useItems(t, 5)
+
+	expires := time.Now().AddDate(1, 0, 0)
+	updates := []struct {
+		lifetime bool
+		expires  time.Time
+		details  string
+	}{
+		{expires: expires},                          // missing details
+		{details: "2 years parts"},                  // missing expiry
+		{expires: expires, details: "full cover"},   // complete
+		{lifetime: true, details: "lifetime cover"}, // lifetime
+		{}, // no warranty
+	}
+
+	for i, u := range updates {
+		_, err := tRepos.Items.UpdateByGroup(context.Background(), tGroup.ID, ItemUpdate{
+			ID:               items[i].ID,
+			Name:             items[i].Name,
+			LocationID:       items[i].Location.ID,
+			LifetimeWarranty: u.lifetime,
+			WarrantyExpires:  types.DateFromTime(u.expires),
+			WarrantyDetails:  u.details,
+		})
+		require.NoError(t, err)
+	}
+
+	incomplete, err := tRepos.Items.QueryIncompleteWarranties(context.Background(), tGroup.ID)
+	require.NoError(t, err)
+	require.Len(t, incomplete, 2)
+
+	// most recently updated first
+	assert.Equal(t, items[1].ID, incomplete[0].ID)
+	assert.Equal(t, items[0].ID, incomplete[1].ID)
+}
+
+func TestItemsRepository_MutationsBumpVersion(t *testing.T) {
+	ctx := context.Background()
+	itm := useItems(t, 1)[0]
+	lbl := useLabels(t, 1)[0]
+
+	stale := ItemUpdate{
+		ID:         itm.ID,
+		Version:    itm.Version,
+		Name:       "stale",
+		LocationID: itm.Location.ID,
+		Quantity:   itm.Quantity,
+	}
+
+	got, err := tRepos.Items.IncrementQuantity(ctx, tGroup.ID, itm.ID, 3)
+	require.NoError(t, err)
+	got, err = tRepos.Items.DecrementQuantity(ctx, tGroup.ID, itm.ID, 1)
+	require.NoError(t, err)
+	assert.Equal(t, itm.Version+2, got.Version)
+
+	// an update based on the version before the quantity change must not overwrite it
+	_, err = tRepos.Items.UpdateByGroup(ctx, tGroup.ID, stale)
+	require.ErrorIs(t, err, ErrVersionConflict)
+
+	got, err = tRepos.Items.SetLabels(ctx, tGroup.ID, itm.ID, []uuid.UUID{lbl.ID})
+	require.NoError(t, err)
+	assert.Equal(t, itm.Version+3, got.Version)
+
+	require.NoError(t, tRepos.Items.SetFavorite(ctx, tGroup.ID, itm.ID, true))
+
+	got, err = tRepos.Items.GetOne(ctx, itm.ID)
+	require.NoError(t, err)
+	assert.Equal(t, itm.Version+4, got.Version)
+	assert.Equal(t, itm.Quantity+2, got.Quantity)
+
+	stale.Version = got.Version
+	got, err = tRepos.Items.UpdateByGroup(ctx, tGroup.ID, stale)
+	require.NoError(t, err)
+	assert.Equal(t, itm.Version+5, got.Version)
+}
+
+func TestItemsRepository_UpdateByGroup_Version(t *testing.T) {
+	ctx := context.Background()
+	itm := useItems(t, 1)[0]
+	assert.Equal(t, 1, itm.Version)
+
+	update := ItemUpdate{
+		ID:         itm.ID,
+		Version:    itm.Version,
+		Name:       "first",
+		LocationID: itm.Location.ID,
+	}
+
+	got, err := tRepos.Items.UpdateByGroup(ctx, tGroup.ID, update)
+	require.NoError(t, err)
+	assert.Equal(t, 2, got.Version)
+
+	// a second update based on the same version is stale
+	update.Name = "second"
+	_, err = tRepos.Items.UpdateByGroup(ctx, tGroup.ID, update)
+	require.ErrorIs(t, err, ErrVersionConflict)
+
+	got, err = tRepos.Items.GetOne(ctx, itm.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "first", got.Name)
+	assert.Equal(t, 2, got.Version)
+
+	// patches bump the version as well
+	name := "patched"
+	err = tRepos.Items.Patch(ctx, tGroup.ID, itm.ID, ItemPatch{ID: itm.ID, Name: &name})
+	require.NoError(t, err)
+
+	got, err = tRepos.Items.GetOne(ctx, itm.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 3, got.Version)
+
+	// without a version the update isn't checked
+	update.Version = 0
+	got, err = tRepos.Items.UpdateByGroup(ctx, tGroup.ID, update)
+	require.NoError(t, err)
+	assert.Equal(t, "second", got.Name)
+	assert.Equal(t, 4, got.Version)
+}
+
+func TestItemsRepository_UpdateByGroup_NotesFormat(t *testing.T) {
+	ctx := context.Background()
+	itm := useItems(t, 1)[0]
+	assert.Equal(t, "plain", itm.NotesFormat)
+
+	update := ItemUpdate{
+		ID:          itm.ID,
+		Name:        itm.Name,
+		LocationID:  itm.Location.ID,
+		Notes:       "# Heading",
+		NotesFormat: "markdown",
+	}
+
+	got, err := tRepos.Items.UpdateByGroup(ctx, tGroup.ID, update)
+	require.NoError(t, err)
+	assert.Equal(t, "markdown", got.NotesFormat)
+
+	// an empty format leaves it unchanged
+	update.NotesFormat = ""
+	got, err = tRepos.Items.UpdateByGroup(ctx, tGroup.ID, update)
+	require.NoError(t, err)
+	assert.Equal(t, "markdown", got.NotesFormat)
+
+	update.NotesFormat = "html"
+	_, err = tRepos.Items.UpdateByGroup(ctx, tGroup.ID, update)
+	require.ErrorIs(t, err, ErrInvalidNotesFormat)
+
+	got, err = tRepos.Items.GetOne(ctx, itm.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "markdown", got.NotesFormat)
+}
+
+func TestItemsRepository_SetLabels(t *testing.T) {
+	itm := useItems(t, 1)[0]
+	labels := useLabels(t, 3)
+
+	got, err := tRepos.Items.SetLabels(context.Background(), tGroup.ID, itm.ID, []uuid.UUID{labels[0].ID, labels[1].ID, labels[1].ID})
+	require.NoError(t, err)
+	assert.Len(t, got.Labels, 2)
+
+	got, err = tRepos.Items.SetLabels(context.Background(), tGroup.ID, itm.ID, []uuid.UUID{labels[2].ID})
+	require.NoError(t, err)
+	require.Len(t, got.Labels, 1)
+	assert.Equal(t, labels[2].ID, got.Labels[0].ID)
+
+	// labels outside the group are rejected and leave the item untouched
+	_, err = tRepos.Items.SetLabels(context.Background(), tGroup.ID, itm.ID, []uuid.UUID{labels[0].ID, uuid.New()})
+	require.ErrorIs(t, err, ErrLabelNotInGroup)
+
+	got, err = tRepos.Items.GetOne(context.Background(), itm.ID)
+	require.NoError(t, err)
+	assert.Len(t, got.Labels, 1)
+
+	got, err = tRepos.Items.SetLabels(context.Background(), tGroup.ID, itm.ID, []uuid.UUID{})
+	require.NoError(t, err)
+	assert.Empty(t, got.Labels)
+
+	_, err = tRepos.Items.SetLabels(context.Background(), uuid.New(), itm.ID, nil)
+	assert.True(t, ent.IsNotFound(err))
+}
+
+func TestItemsRepository_SetLabels_KeepsOtherFields(t *testing.T) {
+	ctx := context.Background()
+	itm := useItems(t, 1)[0]
+	labels := useLabels(t, 2)
+
+	before, err := tRepos.Items.UpdateByGroup(ctx, tGroup.ID, ItemUpdate{
+		ID:          itm.ID,
+		Name:        itm.Name,
+		Description: "keep me",
+		LocationID:  itm.Location.ID,
+		Quantity:    7,
+		Notes:       "notes",
+	})
+	require.NoError(t, err)
+
+	assertUnchanged := func(got ItemOut) {
+		assert.Equal(t, before.Name, got.Name)
+		assert.Equal(t, before.Description, got.Description)
+		assert.Equal(t, before.Quantity, got.Quantity)
+		assert.Equal(t, before.Notes, got.Notes)
+		assert.Equal(t, before.Location.ID, got.Location.ID)
+	}
+
+	labelIDs := func(got ItemOut) []uuid.UUID {
+		ids := make([]uuid.UUID, len(got.Labels))
+		for i, l := range got.Labels {
+			ids[i] = l.ID
+		}
+		return ids
+	}
+
+	// adding
+	got, err := tRepos.Items.SetLabels(ctx, tGroup.ID, itm.ID, []uuid.UUID{labels[0].ID, labels[1].ID})
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []uuid.UUID{labels[0].ID, labels[1].ID}, labelIDs(got))
+	assertUnchanged(got)
+
+	// removing
+	got, err = tRepos.Items.SetLabels(ctx, tGroup.ID, itm.ID, []uuid.UUID{labels[1].ID})
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{labels[1].ID}, labelIDs(got))
+	assertUnchanged(got)
+
+	// clearing
+	got, err = tRepos.Items.SetLabels(ctx, tGroup.ID, itm.ID, []uuid.UUID{})
+	require.NoError(t, err)
+	assert.Empty(t, got.Labels)
+	assertUnchanged(got)
+}
+
+func TestItemsRepository_AdvancedSearch(t *testing.T) {
+	location := useLocations(t, 1)[0]
+
+	names := []string{"dewalt drill cordless", "dewalt drill corded", "makita drill"}
+	created := make([]ItemOut, len(names))
+	for i, name := range names {
+		data := itemFactory()
+		data.Name = name
+		data.LocationID = location.ID
+
+		itm, err := tRepos.Items.Create(context.Background(), tGroup.ID, data)
+		require.NoError(t, err)
+		created[i] = itm
+	}
+
+	t.Cleanup(func() {
+		for _, itm := range created {
+			_ = tRepos.Items.Delete(context.Background(), itm.ID)
+		}
+	})
+
+	results, err := tRepos.Items.AdvancedSearch(context.Background(), tGroup.ID, "drill AND dewalt NOT cordless", ItemQuery{})
+	require.NoError(t, err)
+	require.Len(t, results.Items, 1)
+	assert.Equal(t, created[1].ID, results.Items[0].ID)
+
+	results, err = tRepos.Items.AdvancedSearch(context.Background(), tGroup.ID, "makita OR cordless", ItemQuery{})
+	require.NoError(t, err)
+	assert.Len(t, results.Items, 2)
+
+	_, err = tRepos.Items.AdvancedSearch(context.Background(), tGroup.ID, "drill AND", ItemQuery{})
+	var perr *SearchParseError
+	assert.ErrorAs(t, err, &perr)
+}
+
+func TestItemsRepository_AcquisitionType(t *testing.T) {
+	bought := useItems(t, 1)[0]
+	assert.Equal(t, "bought", bought.AcquisitionType)
+
+	data := itemFactory()
+	data.LocationID = bought.Location.ID
+	data.AcquisitionType = "gift"
+
+	gift, err := tRepos.Items.Create(context.Background(), tGroup.ID, data)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = tRepos.Items.Delete(context.Background(), gift.ID)
+	})
+	assert.Equal(t, "gift", gift.AcquisitionType)
+
+	// an empty type leaves the current value in place
+	updated, err := tRepos.Items.UpdateByGroup(context.Background(), tGroup.ID, ItemUpdate{
+		ID:         gift.ID,
+		Name:       gift.Name,
+		LocationID: gift.Location.ID,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "gift", updated.AcquisitionType)
+
+	updated, err = tRepos.Items.UpdateByGroup(context.Background(), tGroup.ID, ItemUpdate{
+		ID:              bought.ID,
+		Name:            bought.Name,
+		LocationID:      bought.Location.ID,
+		AcquisitionType: "inherited",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "inherited", updated.AcquisitionType)
+
+	results, err := tRepos.Items.QueryByGroup(context.Background(), tGroup.ID, ItemQuery{AcquisitionType: "gift"})
+	require.NoError(t, err)
+	require.Len(t, results.Items, 1)
+	assert.Equal(t, gift.ID, results.Items[0].ID)
+}
+
+func TestItemsRepository_AddLabelsByQuery(t *testing.T) {
+	items := useItems(t, 3)
+	labels := useLabels(t, 2)
+
+	// the first item already has one of the labels
+	_, err := tRepos.Items.SetLabels(context.Background(), tGroup.ID, items[0].ID, []uuid.UUID{labels[0].ID})
+	require.NoError(t, err)
+
+	q := ItemQuery{LocationIDs: []uuid.UUID{items[0].Location.ID}}
+
+	count, err := tRepos.Items.AddLabelsByQuery(context.Background(), tGroup.ID, q, []uuid.UUID{labels[0].ID, labels[1].ID})
+	require.NoError(t, err)
+	assert.Equal(t, 3, count)
+
+	for _, itm := range items {
+		got, err := tRepos.Items.GetOne(context.Background(), itm.ID)
+		require.NoError(t, err)
+		assert.Len(t, got.Labels, 2)
+	}
+
+	// nothing left to add
+	count, err = tRepos.Items.AddLabelsByQuery(context.Background(), tGroup.ID, q, []uuid.UUID{labels[1].ID})
+	require.NoError(t, err)
+	assert.Equal(t, 0, count)
+
+	_, err = tRepos.Items.AddLabelsByQuery(context.Background(), tGroup.ID, q, []uuid.UUID{uuid.New()})
+	assert.ErrorIs(t, err, ErrLabelNotInGroup)
+}
+
+func TestItemsRepository_AddLabelToItems(t *testing.T) {
+	ctx := context.Background()
+	items := useItems(t, 4)
+	labels := useLabels(t, 1)
+	lbl := labels[0].ID
+
+	// the first two items already have the label
+	for _, itm := range items[:2] {
+		_, err := tRepos.Items.SetLabels(ctx, tGroup.ID, itm.ID, []uuid.UUID{lbl})
+		require.NoError(t, err)
+	}
+
+	// an item of another group is never touched
+	g, others := useGroupItems(t, ItemUpdate{})
+	other := others[0]
+
+	ids := []uuid.UUID{items[0].ID, items[1].ID, items[2].ID, other.ID}
+
+	count, err := tRepos.Items.AddLabelToItems(ctx, tGroup.ID, lbl, ids)
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+
+	for _, itm := range items[:3] {
+		got, err := tRepos.Items.GetOne(ctx, itm.ID)
+		require.NoError(t, err)
+		require.Len(t, got.Labels, 1)
+		assert.Equal(t, lbl, got.Labels[0].ID)
+	}
+
+	got, err := tRepos.Items.GetOne(ctx, items[3].ID)
+	require.NoError(t, err)
+	assert.Empty(t, got.Labels)
+
+	got, err = tRepos.Items.GetOne(ctx, other.ID)
+	require.NoError(t, err)
+	assert.Empty(t, got.Labels)
+
+	// assigning again is a no-op
+	count, err = tRepos.Items.AddLabelToItems(ctx, tGroup.ID, lbl, ids)
+	require.NoError(t, err)
+	assert.Equal(t, 0, count)
+
+	_, err = tRepos.Items.AddLabelToItems(ctx, g.ID, lbl, []uuid.UUID{other.ID})
+	assert.ErrorIs(t, err, ErrLabelNotInGroup)
+
+	count, err = tRepos.Items.RemoveLabelFromItems(ctx, tGroup.ID, lbl, []uuid.UUID{items[0].ID, items[3].ID})
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+
+	got, err = tRepos.Items.GetOne(ctx, items[0].ID)
+	require.NoError(t, err)
+	assert.Empty(t, got.Labels)
+
+	got, err = tRepos.Items.GetOne(ctx, items[1].ID)
+	require.NoError(t, err)
+	assert.Len(t, got.Labels, 1)
+}
+
+func TestItemsRepository_PurgeManyByGroup(t *testing.T) {
+	ctx := context.Background()
+	items := useItems(t, 3)
+	doc := useDocs(t, 1)[0]
+
+	att, err := tRepos.Attachments.Create(ctx, items[0].ID, doc.ID, attachment.TypeManual)
+	require.NoError(t, err)
+
+	_, others := useGroupItems(t, ItemUpdate{})
+	other := others[0]
+
+	// items of other groups and unknown ids are ignored
+	count, err := tRepos.Items.PurgeManyByGroup(ctx, tGroup.ID, []uuid.UUID{items[0].ID, items[1].ID, other.ID, uuid.New()})
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+
+	for _, itm := range items[:2] {
+		_, err := tRepos.Items.GetOne(ctx, itm.ID)
+		assert.True(t, ent.IsNotFound(err))
+	}
+
+	_, err = tRepos.Attachments.Get(ctx, att.ID)
+	assert.True(t, ent.IsNotFound(err))
+
+	history, err := tRepos.Items.GetItemHistory(ctx, tGroup.ID, items[0].ID)
+	require.NoError(t, err)
+	require.NotEmpty(t, history)
+	assert.Equal(t, itemevent.TypeDelete.String(), history[0].Type)
+
+	_, err = tRepos.Items.GetOne(ctx, items[2].ID)
+	require.NoError(t, err)
+
+	_, err = tRepos.Items.GetOne(ctx, other.ID)
+	require.NoError(t, err)
+
+	_, err = tRepos.Items.PurgeManyByGroup(ctx, tGroup.ID, make([]uuid.UUID, maxBulkItems+1))
+	assert.ErrorIs(t, err, ErrBulkLimitExceeded)
+}
+
+func TestItemsRepository_MoveItems(t *testing.T) {
+	ctx := context.Background()
+	items := useItems(t, 3)
+
+	shelf := useLocations(t, 1)[0]
+
+	_, others := useGroupItems(t, ItemUpdate{})
+	other, otherLoc := others[0], others[0].Location
+
+	// items of other groups are skipped
+	count, err := tRepos.Items.MoveItems(ctx, tGroup.ID, []uuid.UUID{items[0].ID, items[1].ID, other.ID}, shelf.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+
+	for _, itm := range items[:2] {
+		got, err := tRepos.Items.GetOne(ctx, itm.ID)
+		require.NoError(t, err)
+		assert.Equal(t, shelf.ID, got.Location.ID)
+		assert.True(t, got.UpdatedAt.After(itm.UpdatedAt))
+	}
+
+	got, err := tRepos.Items.GetOne(ctx, items[2].ID)
+	require.NoError(t, err)
+	assert.Equal(t, items[2].Location.ID, got.Location.ID)
+
+	got, err = tRepos.Items.GetOne(ctx, other.ID)
+	require.NoError(t, err)
+	assert.Equal(t, otherLoc.ID, got.Location.ID)
+
+	// items already at the location aren't counted
+	count, err = tRepos.Items.MoveItems(ctx, tGroup.ID, []uuid.UUID{items[0].ID}, shelf.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 0, count)
+
+	// the location has to belong to the group
+	_, err = tRepos.Items.MoveItems(ctx, tGroup.ID, []uuid.UUID{items[2].ID}, otherLoc.ID)
+	assert.True(t, ent.IsNotFound(err))
+}
+
+func TestItemsRepository_QueryByGroup_PurchasedOn(t *testing.T) {
+	items := useItems(t, 3)
+
+	purchased := []time.Time{
+		time.Date(2023, 5, 2, 0, 0, 0, 0, time.UTC),
+		time.Date(2023, 5, 3, 0, 0, 0, 0, time.UTC),
+		time.Date(2023, 5, 4, 0, 0, 0, 0, time.UTC),
+	}
+
+	for i, pt := range purchased {
+		_, err := tRepos.Items.UpdateByGroup(context.Background(), tGroup.ID, ItemUpdate{
+			ID:           items[i].ID,
+			Name:         items[i].Name,
+			LocationID:   items[i].Location.ID,
+			PurchaseTime: types.DateFromTime(pt),
+		})
+		require.NoError(t, err)
+	}
+
+	query := func(on time.Time) []uuid.UUID {
+		results, err := tRepos.Items.QueryByGroup(context.Background(), tGroup.ID, ItemQuery{PurchasedOn: &on})
+		require.NoError(t, err)
+
+		return itemIDs(results.Items)
+	}
+
+	assert.ElementsMatch(t, []uuid.UUID{items[1].ID}, query(time.Date(2023, 5, 3, 12, 0, 0, 0, time.UTC)))
+
+	// late on the 3rd in UTC-5 is already the 4th in UTC, the caller's day is matched
+	minusFive := time.FixedZone("UTC-5", -5*60*60)
+	assert.ElementsMatch(t, []uuid.UUID{items[1].ID}, query(time.Date(2023, 5, 3, 22, 0, 0, 0, minusFive)))
+	assert.ElementsMatch(t, []uuid.UUID{items[1].ID}, query(time.Date(2023, 5, 3, 0, 30, 0, 0, minusFive)))
+
+	// early on the 4th in UTC+2 is still the 3rd in UTC
+	plusTwo := time.FixedZone("UTC+2", 2*60*60)
+	assert.ElementsMatch(t, []uuid.UUID{items[2].ID}, query(time.Date(2023, 5, 4, 1, 0, 0, 0, plusTwo)))
+}
+
+func TestItemsRepository_QueryMissingManufacturer(t *testing.T) {
+	items := useItems(t, 4)
+
+	updates := []struct {
+		manufacturer string
+		model        string
+		serial       string
+	}{
+		{model: "DCD771"},                         // branded, missing manufacturer
+		{serial: "SN-1234"},                       // branded, missing manufacturer
+		{manufacturer: "DeWalt", model: "DCD771"}, // complete
+		{}, // generic item
+	}
+
+	for i, u := range updates {
+		_, err := tRepos.Items.UpdateByGroup(context.Background(), tGroup.ID, ItemUpdate{
+			ID:           items[i].ID,
+			Name:         items[i].Name,
+			LocationID:   items[i].Location.ID,
+			Manufacturer: u.manufacturer,
+			ModelNumber:  u.model,
+			SerialNumber: u.serial,
+		})
+		require.NoError(t, err)
+	}
+
+	missing, err := tRepos.Items.QueryMissingManufacturer(context.Background(), tGroup.ID)
+	require.NoError(t, err)
+	require.Len(t, missing, 2)
+	assert.Equal(t, items[1].ID, missing[0].ID)
+	assert.Equal(t, items[0].ID, missing[1].ID)
+}
+
+func TestItemsRepository_QueryByProfitability(t *testing.T) {
+	items := useItems(t, 5)
+
+	prices := []struct {
+		purchase float64
+		sold     float64
+	}{
+		{purchase: 100, sold: 150}, // +50
+		{purchase: 100, sold: 110}, // +10
+		{purchase: 100, sold: 20},  // -80
+		{purchase: 100, sold: 0},   // not sold
+		{purchase: 0, sold: 40},    // no purchase price
+	}
+
+	for i, p := range prices {
+		_, err := tRepos.Items.UpdateByGroup(context.Background(), tGroup.ID, ItemUpdate{
+			ID:            items[i].ID,
+			Name:          items[i].Name,
+			LocationID:    items[i].Location.ID,
+			PurchasePrice: p.purchase,
+			SoldPrice:     p.sold,
+		})
+		require.NoError(t, err)
+	}
+
+	wins, err := tRepos.Items.QueryByProfitability(context.Background(), tGroup.ID, true)
+	require.NoError(t, err)
+	require.Len(t, wins, 2)
+	assert.Equal(t, items[0].ID, wins[0].ID)
+	assert.Equal(t, items[1].ID, wins[1].ID)
+
+	losses, err := tRepos.Items.QueryByProfitability(context.Background(), tGroup.ID, false)
+	require.NoError(t, err)
+	require.Len(t, losses, 1)
+	assert.Equal(t, items[2].ID, losses[0].ID)
+}
+
+func TestItemsRepository_QueryNearby(t *testing.T) {
+	items := useItems(t, 4)
+
+	ptr := func(v float64) *float64 { return &v }
+
+	coords := []struct {
+		lat, lng *float64
+	}{
+		{ptr(51.5007), ptr(-0.1246)}, // Westminster
+		{ptr(51.5014), ptr(-0.1419)}, // Buckingham Palace, ~1.2km away
+		{ptr(48.8584), ptr(2.2945)},  // Paris, ~340km away
+		{},                           // no coordinates
+	}
+
+	for i, c := range coords {
+		got, err := tRepos.Items.UpdateByGroup(context.Background(), tGroup.ID, ItemUpdate{
+			ID:         items[i].ID,
+			Name:       items[i].Name,
+			LocationID: items[i].Location.ID,
+			Latitude:   c.lat,
+			Longitude:  c.lng,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, c.lat, got.Latitude)
+	}
+
+	_, err := tRepos.Items.UpdateByGroup(context.Background(), tGroup.ID, ItemUpdate{
+		ID:         items[3].ID,
+		Name:       items[3].Name,
+		LocationID: items[3].Location.ID,
+		Latitude:   ptr(10),
+	})
+	require.ErrorIs(t, err, ErrIncompleteCoordinates)
+
+	nearby, err := tRepos.Items.QueryNearby(context.Background(), tGroup.ID, 51.5010, -0.1300, 5)
+	require.NoError(t, err)
+	require.Len(t, nearby, 2)
+	assert.Equal(t, items[0].ID, nearby[0].ID)
+	assert.Equal(t, items[1].ID, nearby[1].ID)
+
+	nearby, err = tRepos.Items.QueryNearby(context.Background(), tGroup.ID, 51.5010, -0.1300, 500)
+	require.NoError(t, err)
+	assert.Len(t, nearby, 3)
+
+	_, err = tRepos.Items.QueryNearby(context.Background(), tGroup.ID, 0, 0, 0)
+	assert.ErrorIs(t, err, ErrInvalidRadius)
+}
+
+func TestItemsRepository_SumReplacementCost(t *testing.T) {
+	items := useItems(t, 3)
+
+	costs := []struct {
+		quantity    int
+		purchase    float64
+		replacement float64
+	}{
+		{quantity: 1, purchase: 100, replacement: 150},
+		{quantity: 2, purchase: 40},
+		{quantity: 1},
+	}
+
+	for i, c := range costs {
+		got, err := tRepos.Items.UpdateByGroup(context.Background(), tGroup.ID, ItemUpdate{
+			ID:              items[i].ID,
+			Name:            items[i].Name,
+			LocationID:      items[i].Location.ID,
+			Quantity:        c.quantity,
+			PurchasePrice:   c.purchase,
+			ReplacementCost: c.replacement,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, c.replacement, got.ReplacementCost)
+	}
+
+	total, err := tRepos.Items.SumReplacementCost(context.Background(), tGroup.ID, ItemQuery{
+		LocationIDs: []uuid.UUID{items[0].Location.ID},
+	})
+	require.NoError(t, err)
+	assert.InDelta(t, 230.0, total, 0.001)
+
+	total, err = tRepos.Items.SumReplacementCost(context.Background(), uuid.New(), ItemQuery{})
+	require.NoError(t, err)
+	assert.Zero(t, total)
+}
+
+func TestItemsRepository_QueryByLabelColor(t *testing.T) {
+	items := useItems(t, 3)
+	labels := useLabels(t, 2)
+
+	for i, color := range []string{"#FF0000", "#00FF00"} {
+		_, err := tRepos.Labels.UpdateByGroup(context.Background(), tGroup.ID, LabelUpdate{
+			ID:    labels[i].ID,
+			Name:  labels[i].Name,
+			Color: color,
+		})
+		require.NoError(t, err)
+	}
+
+	_, err := tRepos.Items.SetLabels(context.Background(), tGroup.ID, items[0].ID, []uuid.UUID{labels[0].ID, labels[1].ID})
+	require.NoError(t, err)
+
+	_, err = tRepos.Items.SetLabels(context.Background(), tGroup.ID, items[1].ID, []uuid.UUID{labels[1].ID})
+	require.NoError(t, err)
+
+	results, err := tRepos.Items.QueryByGroup(context.Background(), tGroup.ID, ItemQuery{LabelColor: "#ff0000"})
+	require.NoError(t, err)
+	require.Len(t, results.Items, 1)
+	assert.Equal(t, items[0].ID, results.Items[0].ID)
+
+	results, err = tRepos.Items.QueryByGroup(context.Background(), tGroup.ID, ItemQuery{LabelColor: "#00ff00"})
+	require.NoError(t, err)
+	assert.Len(t, results.Items, 2)
+}
+
+func TestItemsRepository_QueryFuturePurchaseDates(t *testing.T) {
+	items := useItems(t, 4)
+
+	now := time.Now()
+	purchased := []time.Time{
+		now.AddDate(0, 1, 0),
+		now.AddDate(10, 0, 0),
+		now.AddDate(0, -1, 0),
+		{},
+	}
+
+	for i, pt := range purchased {
+		_, err := tRepos.Items.UpdateByGroup(context.Background(), tGroup.ID, ItemUpdate{
+			ID:           items[i].ID,
+			Name:         items[i].Name,
+			LocationID:   items[i].Location.ID,
+			PurchaseTime: types.DateFromTime(pt),
+		})
+		require.NoError(t, err)
+	}
+
+	future, err := tRepos.Items.QueryFuturePurchaseDates(context.Background(), tGroup.ID)
+	require.NoError(t, err)
+	require.Len(t, future, 2)
+	assert.Equal(t, items[1].ID, future[0].ID)
+	assert.Equal(t, items[0].ID, future[1].ID)
+}
+
+func TestItemsRepository_SetNotesByGroup(t *testing.T) {
+	items := useItems(t, 3)
+
+	_, err := tRepos.Items.UpdateByGroup(context.Background(), tGroup.ID, ItemUpdate{
+		ID:         items[0].ID,
+		Name:       items[0].Name,
+		LocationID: items[0].Location.ID,
+		Notes:      "existing notes",
+	})
+	require.NoError(t, err)
+
+	ids := []uuid.UUID{items[0].ID, items[1].ID, items[2].ID}
+
+	count, err := tRepos.Items.SetNotesByGroup(context.Background(), tGroup.ID, ids, "template", false)
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+
+	got, err := tRepos.Items.GetOne(context.Background(), items[0].ID)
+	require.NoError(t, err)
+	assert.Equal(t, "existing notes", got.Notes)
+
+	got, err = tRepos.Items.GetOne(context.Background(), items[1].ID)
+	require.NoError(t, err)
+	assert.Equal(t, "template", got.Notes)
+
+	count, err = tRepos.Items.SetNotesByGroup(context.Background(), tGroup.ID, ids, "overwritten", true)
+	require.NoError(t, err)
+	assert.Equal(t, 3, count)
+
+	got, err = tRepos.Items.GetOne(context.Background(), items[0].ID)
+	require.NoError(t, err)
+	assert.Equal(t, "overwritten", got.Notes)
+
+	// items of other groups are never touched
+	count, err = tRepos.Items.SetNotesByGroup(context.Background(), uuid.New(), ids, "other", true)
+	require.NoError(t, err)
+	assert.Equal(t, 0, count)
+}
+
+func TestItemsRepository_SetFavorite(t *testing.T) {
+	ctx := context.Background()
+	items := useItems(t, 3)
+
+	q := ItemQuery{
+		Page:          -1,
+		PageSize:      -1,
+		LocationIDs:   []uuid.UUID{items[0].Location.ID},
+		FavoritesOnly: true,
+	}
+
+	results, err := tRepos.Items.QueryByGroup(ctx, tGroup.ID, q)
+	require.NoError(t, err)
+	assert.Empty(t, results.Items)
+
+	require.NoError(t, tRepos.Items.SetFavorite(ctx, tGroup.ID, items[1].ID, true))
+	require.NoError(t, tRepos.Items.SetFavorite(ctx, tGroup.ID, items[2].ID, true))
+
+	got, err := tRepos.Items.GetOne(ctx, items[1].ID)
+	require.NoError(t, err)
+	assert.True(t, got.Favorite)
+
+	// toggling back off
+	require.NoError(t, tRepos.Items.SetFavorite(ctx, tGroup.ID, items[2].ID, false))
+
+	results, err = tRepos.Items.QueryByGroup(ctx, tGroup.ID, q)
+	require.NoError(t, err)
+	require.Len(t, results.Items, 1)
+	assert.Equal(t, items[1].ID, results.Items[0].ID)
+	assert.True(t, results.Items[0].Favorite)
+
+	// favorites float to the top of the regular sort order
+	q.FavoritesOnly = false
+	q.FavoritesFirst = true
+	q.SortBy = "createdAt"
+
+	results, err = tRepos.Items.QueryByGroup(ctx, tGroup.ID, q)
+	require.NoError(t, err)
+	require.Len(t, results.Items, 3)
+	assert.Equal(t, items[1].ID, results.Items[0].ID)
+	assert.Equal(t, items[0].ID, results.Items[1].ID)
+	assert.Equal(t, items[2].ID, results.Items[2].ID)
+
+	err = tRepos.Items.SetFavorite(ctx, uuid.New(), items[0].ID, true)
+	assert.True(t, ent.IsNotFound(err))
+}
+
+func TestItemsRepository_QueryByGroup_SortBy(t *testing.T) {
+	// items are created in order, so the last one is the newest
+	items := useItems(t, 3)
+
+	q := ItemQuery{
+		Page:        -1,
+		PageSize:    -1,
+		LocationIDs: []uuid.UUID{items[0].Location.ID},
+	}
+
+	q.SortBy = "-createdAt"
+	results, err := tRepos.Items.QueryByGroup(context.Background(), tGroup.ID, q)
+	require.NoError(t, err)
+	require.Len(t, results.Items, 3)
+	assert.Equal(t, items[2].ID, results.Items[0].ID)
+	assert.Equal(t, items[1].ID, results.Items[1].ID)
+	assert.Equal(t, items[0].ID, results.Items[2].ID)
+
+	for _, sortBy := range []string{"", "bogus; DROP TABLE items"} {
+		q.SortBy = sortBy
+		results, err = tRepos.Items.QueryByGroup(context.Background(), tGroup.ID, q)
+		require.NoError(t, err)
+		require.Len(t, results.Items, 3)
+		assert.IsIncreasing(t, []string{results.Items[0].Name, results.Items[1].Name, results.Items[2].Name})
+	}
+}
+
+func TestItemsRepository_QueryByGroup_SearchFields(t *testing.T) {
+	itm := useItems(t, 1)[0]
+
+	serial := "SN-" + fk.Str(12)
+	_, err := tRepos.Items.UpdateByGroup(context.Background(), tGroup.ID, ItemUpdate{
+		ID:           itm.ID,
+		Name:         itm.Name,
+		LocationID:   itm.Location.ID,
+		SerialNumber: serial,
+	})
+	require.NoError(t, err)
+
+	results, err := tRepos.Items.QueryByGroup(context.Background(), tGroup.ID, ItemQuery{Search: serial})
+	require.NoError(t, err)
+	require.Len(t, results.Items, 1)
+	assert.Equal(t, itm.ID, results.Items[0].ID)
+
+	results, err = tRepos.Items.QueryByGroup(context.Background(), tGroup.ID, ItemQuery{
+		Search:       serial,
+		SearchFields: []string{"serialNumber"},
+	})
+	require.NoError(t, err)
+	assert.Len(t, results.Items, 1)
+
+	results, err = tRepos.Items.QueryByGroup(context.Background(), tGroup.ID, ItemQuery{
+		Search:       serial,
+		SearchFields: []string{"name", "notes"},
+	})
+	require.NoError(t, err)
+	assert.Empty(t, results.Items)
+}
+
+func TestItemsRepository_QueryByGroup_SearchWords(t *testing.T) {
+	ctx := context.Background()
+
+	g, _ := useGroupItems(t,
+		ItemUpdate{Name: "DeWalt Cordless Drill", ModelNumber: "DCD771C2-20V"},
+		ItemUpdate{Name: "DeWalt Hammer"},
+	)
+
+	cases := []struct {
+		name   string
+		search string
+		fields []string
+		want   int
+	}{
+		{"non-adjacent words", "dewalt drill", nil, 1},
+		{"any order", "DRILL  dewalt", nil, 1},
+		{"single word", "dewalt", nil, 2},
+		{"every word must match", "dewalt saw", nil, 0},
+		{"words across fields", "dewalt 20v", nil, 1},
+		{"restricted fields", "dewalt 20v", []string{"name"}, 0},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			results, err := tRepos.Items.QueryByGroup(ctx, g.ID, ItemQuery{Search: tc.search, SearchFields: tc.fields})
+			require.NoError(t, err)
+			assert.Len(t, results.Items, tc.want)
+		})
+	}
+}
+
+func TestItemsRepository_QueryByGroup_LabelsMatchAll(t *testing.T) {
+	items := useItems(t, 2)
+	labels := useLabels(t, 2)
+
+	both := []uuid.UUID{labels[0].ID, labels[1].ID}
+
+	_, err := tRepos.Items.SetLabels(context.Background(), tGroup.ID, items[0].ID, both)
+	require.NoError(t, err)
+	_, err = tRepos.Items.SetLabels(context.Background(), tGroup.ID, items[1].ID, both[:1])
+	require.NoError(t, err)
+
+	results, err := tRepos.Items.QueryByGroup(context.Background(), tGroup.ID, ItemQuery{LabelIDs: both})
+	require.NoError(t, err)
+	assert.Len(t, results.Items, 2)
+
+	results, err = tRepos.Items.QueryByGroup(context.Background(), tGroup.ID, ItemQuery{
+		LabelIDs:       both,
+		LabelsMatchAll: true,
+	})
+	require.NoError(t, err)
+	require.Len(t, results.Items, 1)
+	assert.Equal(t, items[0].ID, results.Items[0].ID)
+}
+
+func TestItemsRepository_QueryByGroup_ExcludeLabels(t *testing.T) {
+	items := useItems(t, 3)
+	labels := useLabels(t, 3)
+
+	tools, sold, archived := labels[0].ID, labels[1].ID, labels[2].ID
+
+	for i, ids := range [][]uuid.UUID{
+		{tools},
+		{tools, sold},
+		{archived},
+	} {
+		_, err := tRepos.Items.SetLabels(context.Background(), tGroup.ID, items[i].ID, ids)
+		require.NoError(t, err)
+	}
+
+	query := func(q ItemQuery) []uuid.UUID {
+		q.LocationIDs = []uuid.UUID{items[0].Location.ID}
+
+		results, err := tRepos.Items.QueryByGroup(context.Background(), tGroup.ID, q)
+		require.NoError(t, err)
+
+		return itemIDs(results.Items)
+	}
+
+	excluded := []uuid.UUID{sold, archived}
+
+	assert.ElementsMatch(t, []uuid.UUID{items[0].ID}, query(ItemQuery{ExcludeLabelIDs: excluded}))
+
+	// exclusion takes precedence over the positive filter
+	assert.ElementsMatch(t, []uuid.UUID{items[0].ID}, query(ItemQuery{
+		LabelIDs:        []uuid.UUID{tools},
+		ExcludeLabelIDs: excluded,
+	}))
+
+	assert.Empty(t, query(ItemQuery{
+		LabelIDs:        []uuid.UUID{sold},
+		ExcludeLabelIDs: excluded,
+	}))
+}
+
+func TestItemsRepository_QueryByGroupWithTotals(t *testing.T) {
+	items := useItems(t, 3)
+
+	updates := []struct {
+		price   float64
+		insured bool
+	}{
+		{price: 100, insured: true},
+		{price: 50, insured: true},
+		{price: 25},
+	}
+
+	for i, u := range updates {
+		_, err := tRepos.Items.UpdateByGroup(context.Background(), tGroup.ID, ItemUpdate{
+			ID:            items[i].ID,
+			Name:          items[i].Name,
+			LocationID:    items[i].Location.ID,
+			PurchasePrice: u.price,
+			Insured:       u.insured,
+		})
+		require.NoError(t, err)
+	}
+
+	// the totals cover every match, not just the requested page
+	result, err := tRepos.Items.QueryByGroupWithTotals(context.Background(), tGroup.ID, ItemQuery{
+		Page:        1,
+		PageSize:    1,
+		LocationIDs: []uuid.UUID{items[0].Location.ID},
+	})
+	require.NoError(t, err)
+	assert.Len(t, result.Items, 1)
+	assert.Equal(t, 3, result.Total)
+	assert.InDelta(t, 175.0, result.TotalPurchasePrice, 0.001)
+	assert.InDelta(t, 150.0, result.InsuredPurchasePrice, 0.001)
+}
+
+func TestItemsRepository_QueryByGroupCursor(t *testing.T) {
+	items := useItems(t, 7)
+
+	q := ItemQuery{LocationIDs: []uuid.UUID{items[0].Location.ID}}
+
+	var (
+		seen   []uuid.UUID
+		cursor string
+		pages  int
+	)
+
+	for {
+		page, err := tRepos.Items.QueryByGroupCursor(context.Background(), tGroup.ID, q, cursor, 3)
+		require.NoError(t, err)
+
+		for _, itm := range page.Items {
+			seen = append(seen, itm.ID)
+		}
+
+		pages++
+		if page.NextCursor == "" {
+			break
+		}
+		cursor = page.NextCursor
+	}
+
+	assert.Equal(t, 3, pages)
+	require.Len(t, seen, 7)
+
+	// newest first, without duplicates or gaps
+	for i, id := range seen {
+		assert.Equal(t, items[len(items)-1-i].ID, id)
+	}
+
+	_, err := tRepos.Items.QueryByGroupCursor(context.Background(), tGroup.ID, q, "not-a-cursor", 3)
+	assert.ErrorIs(t, err, ErrInvalidCursor)
+
+}
+
+func TestItemsRepository_QueryByGroupCursor_PageBounds(t *testing.T) {
+	ctx := context.Background()
+
+	g, _ := useGroupItems(t, make([]ItemUpdate, 5)...)
+
+	items := &ItemsRepository{db: tClient}
+	items.SetPageBounds(PageBounds{Default: 2, Max: 3})
+
+	// a page size of zero uses the default
+	page, err := items.QueryByGroupCursor(ctx, g.ID, ItemQuery{}, "", 0)
+	require.NoError(t, err)
+	assert.Len(t, page.Items, 2)
+	assert.NotEmpty(t, page.NextCursor)
+
+	// page sizes above the maximum are capped
+	page, err = items.QueryByGroupCursor(ctx, g.ID, ItemQuery{}, "", 1000)
+	require.NoError(t, err)
+	assert.Len(t, page.Items, 3)
+	assert.NotEmpty(t, page.NextCursor)
+}
+
+func TestItemsRepository_CreateMany(t *testing.T) {
+	ctx := context.Background()
+	location := useLocations(t, 1)[0]
+	labels := useLabels(t, 2)
+
+	data := make([]ItemCreate, 50)
+	for i := range data {
+		data[i] = itemFactory()
+		data[i].LocationID = location.ID
+		data[i].LabelIDs = []uuid.UUID{labels[i%2].ID}
+	}
+
+	created, err := tRepos.Items.CreateMany(ctx, tGroup.ID, data)
+	require.NoError(t, err)
+	require.Len(t, created, 50)
+
+	t.Cleanup(func() {
+		for _, itm := range created {
+			_ = tRepos.Items.Delete(ctx, itm.ID)
+		}
+	})
+
+	for i, itm := range created {
+		assert.Equal(t, data[i].Name, itm.Name)
+		require.Len(t, itm.Labels, 1)
+		assert.Equal(t, labels[i%2].ID, itm.Labels[0].ID)
+
+		got, err := tRepos.Items.GetOneByGroup(ctx, tGroup.ID, itm.ID)
+		require.NoError(t, err)
+		assert.Equal(t, location.ID, got.Location.ID)
+
+		history, err := tRepos.Items.GetItemHistory(ctx, tGroup.ID, itm.ID)
+		require.NoError(t, err)
+		require.Len(t, history, 1)
+		assert.Equal(t, "create", history[0].Type)
+	}
+}
+
+func TestItemsRepository_CreateMany_RollsBack(t *testing.T) {
+	ctx := context.Background()
+	location := useLocations(t, 1)[0]
+
+	data := []ItemCreate{itemFactory(), itemFactory(), itemFactory()}
+	for i := range data {
+		data[i].LocationID = location.ID
+	}
+	data[2].LabelIDs = []uuid.UUID{uuid.New()}
+
+	_, err := tRepos.Items.CreateMany(ctx, tGroup.ID, data)
+	require.ErrorIs(t, err, ErrLabelNotInGroup)
+	assert.Contains(t, err.Error(), "item 2")
+
+	results, err := tRepos.Items.QueryByGroup(ctx, tGroup.ID, ItemQuery{LocationIDs: []uuid.UUID{location.ID}})
+	require.NoError(t, err)
+	assert.Equal(t, 0, results.Total)
+}
+
+func TestItemsRepository_Trash(t *testing.T) {
+	ctx := context.Background()
+	items := useItems(t, 2)
+	trashed := items[0]
+
+	q := ItemQuery{LocationIDs: []uuid.UUID{trashed.Location.ID}}
+
+	err := tRepos.Items.DeleteByGroup(ctx, tGroup.ID, trashed.ID)
+	require.NoError(t, err)
+
+	results, err := tRepos.Items.QueryByGroup(ctx, tGroup.ID, q)
+	require.NoError(t, err)
+	require.Len(t, results.Items, 1)
+	assert.Equal(t, items[1].ID, results.Items[0].ID)
+
+	all, err := tRepos.Items.GetAll(ctx, tGroup.ID)
+	require.NoError(t, err)
+	for _, itm := range all {
+		assert.NotEqual(t, trashed.ID, itm.ID)
+	}
+
+	// the trash isn't part of the archive
+	q.IncludeArchived = true
+	results, err = tRepos.Items.QueryByGroup(ctx, tGroup.ID, q)
+	require.NoError(t, err)
+	assert.Len(t, results.Items, 1)
+
+	q.IncludeArchived = false
+	q.IncludeTrashed = true
+	results, err = tRepos.Items.QueryByGroup(ctx, tGroup.ID, q)
+	require.NoError(t, err)
+	assert.Len(t, results.Items, 2)
+
+	got, err := tRepos.Items.GetOneByGroup(ctx, tGroup.ID, trashed.ID)
+	require.NoError(t, err)
+	assert.True(t, got.Archived)
+	assert.NotNil(t, got.ArchivedAt)
+
+	// unarchiving doesn't take an item out of the trash
+	_, err = tRepos.Items.UpdateByGroup(ctx, tGroup.ID, ItemUpdate{
+		ID:         trashed.ID,
+		Name:       trashed.Name,
+		LocationID: trashed.Location.ID,
+	})
+	require.ErrorIs(t, err, ErrItemInTrash)
+
+	unarchive := false
+	err = tRepos.Items.Patch(ctx, tGroup.ID, trashed.ID, ItemPatch{ID: trashed.ID, Archived: &unarchive})
+	require.ErrorIs(t, err, ErrItemInTrash)
+
+	// items are only purged once they have been in the trash for long enough
+	purged, err := tRepos.Items.PurgeArchived(ctx, tGroup.ID, time.Hour)
+	require.NoError(t, err)
+	assert.Equal(t, 0, purged)
+
+	err = tRepos.Items.RestoreByGroup(ctx, tGroup.ID, trashed.ID)
+	require.NoError(t, err)
+
+	got, err = tRepos.Items.GetOneByGroup(ctx, tGroup.ID, trashed.ID)
+	require.NoError(t, err)
+	assert.False(t, got.Archived)
+	assert.Nil(t, got.ArchivedAt)
+
+	err = tRepos.Items.RestoreByGroup(ctx, tGroup.ID, trashed.ID)
+	assert.True(t, ent.IsNotFound(err))
+
+	err = tRepos.Items.DeleteByGroup(ctx, tGroup.ID, trashed.ID)
+	require.NoError(t, err)
+
+	purged, err = tRepos.Items.PurgeArchived(ctx, tGroup.ID, 0)
+	require.NoError(t, err)
+	assert.Equal(t, 1, purged)
+
+	_, err = tRepos.Items.GetOneByGroup(ctx, tGroup.ID, trashed.ID)
+	assert.True(t, ent.IsNotFound(err))
+}
+
+func TestItemsRepository_DuplicateByGroup(t *testing.T) {
+	ctx := context.Background()
+	original := useItems(t, 1)[0]
+	labels := useLabels(t, 2)
+	doc := useDocs(t, 1)[0]
+
+	lat, lng := 52.52, 13.405
+
+	_, err := tRepos.Items.UpdateByGroup(ctx, tGroup.ID, ItemUpdate{
+		ID:               original.ID,
+		Name:             original.Name,
+		LocationID:       original.Location.ID,
+		LabelIDs:         []uuid.UUID{labels[0].ID, labels[1].ID},
+		SerialNumber:     "SN-1",
+		Manufacturer:     "Acme",
+		WarrantyExpires:  types.DateFromTime(time.Now().AddDate(1, 0, 0)),
+		PurchasePrice:    99,
+		PurchaseTime:     types.DateFromTime(time.Now().AddDate(0, -1, 0)),
+		ReplacementCost:  120,
+		Currency:         "eur",
+		Latitude:         &lat,
+		Longitude:        &lng,
+		NotesFormat:      "markdown",
+		AcquisitionType:  "gift",
+		ReorderThreshold: 3,
+		Fields: []ItemField{
+			{Name: "color", Type: "text", TextValue: "red"},
+		},
+	})
+	require.NoError(t, err)
+
+	_, err = tRepos.Attachments.Create(ctx, original.ID, doc.ID, attachment.TypeManual)
+	require.NoError(t, err)
+
+	dup, err := tRepos.Items.DuplicateByGroup(ctx, tGroup.ID, original.ID)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = tRepos.Items.Delete(ctx, dup.ID)
+	})
+
+	assert.NotEqual(t, original.ID, dup.ID)
+	assert.Equal(t, original.Name+" (copy)", dup.Name)
+	assert.Equal(t, original.Location.ID, dup.Location.ID)
+	assert.Equal(t, "SN-1", dup.SerialNumber)
+	assert.Equal(t, "Acme", dup.Manufacturer)
+	assert.False(t, dup.WarrantyExpires.Time().IsZero())
+	assert.Len(t, dup.Labels, 2)
+	assert.Zero(t, dup.PurchasePrice)
+	assert.True(t, dup.PurchaseTime.Time().IsZero())
+	assert.Empty(t, dup.Attachments)
+	assert.InDelta(t, 120.0, dup.ReplacementCost, 0.001)
+	assert.Equal(t, "EUR", dup.Currency)
+	require.NotNil(t, dup.Latitude)
+	require.NotNil(t, dup.Longitude)
+	assert.InDelta(t, lat, *dup.Latitude, 0.0001)
+	assert.InDelta(t, lng, *dup.Longitude, 0.0001)
+	require.Len(t, dup.Fields, 1)
+	assert.Equal(t, "color", dup.Fields[0].Name)
+	assert.Equal(t, "red", dup.Fields[0].TextValue)
+	assert.Equal(t, "markdown", dup.NotesFormat)
+	assert.Equal(t, "gift", dup.AcquisitionType)
+	assert.Equal(t, 3, dup.ReorderThreshold)
+
+	// the copy is recorded as a created item
+	history, err := tRepos.Items.GetItemHistory(ctx, tGroup.ID, dup.ID)
+	require.NoError(t, err)
+	require.Len(t, history, 1)
+	assert.Equal(t, "create", history[0].Type)
+
+	withDetails, err := tRepos.Items.DuplicateByGroup(ctx, tGroup.ID, original.ID, DuplicateOptions{
+		CopyPurchase:    true,
+		CopyAttachments: true,
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = tRepos.Items.Delete(ctx, withDetails.ID)
+	})
+
+	assert.InDelta(t, 99.0, withDetails.PurchasePrice, 0.001)
+	require.Len(t, withDetails.Attachments, 1)
+	assert.Equal(t, doc.ID, withDetails.Attachments[0].Document.ID)
+
+	// the name is shortened to make room for the suffix without splitting a character
+	_, err = tRepos.Items.UpdateByGroup(ctx, tGroup.ID, ItemUpdate{
+		ID:         original.ID,
+		Name:       strings.Repeat("é", 127),
+		LocationID: original.Location.ID,
+	})
+	require.NoError(t, err)
+
+	long, err := tRepos.Items.DuplicateByGroup(ctx, tGroup.ID, original.ID)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = tRepos.Items.Delete(ctx, long.ID)
+	})
+
+	assert.Equal(t, strings.Repeat("é", 124)+" (copy)", long.Name)
+
+	_, err = tRepos.Items.DuplicateByGroup(ctx, uuid.New(), original.ID)
+	assert.True(t, ent.IsNotFound(err))
+}
+
+func TestItemsRepository_QueryByGroup_PurchasePriceRange(t *testing.T) {
+	items := useItems(t, 3)
+
+	for i, price := range []float64{10, 100, 1000} {
+		_, err := tRepos.Items.UpdateByGroup(context.Background(), tGroup.ID, ItemUpdate{
+			ID:            items[i].ID,
+			Name:          items[i].Name,
+			LocationID:    items[i].Location.ID,
+			PurchasePrice: price,
+		})
+		require.NoError(t, err)
+	}
+
+	price := func(v float64) *float64 { return &v }
+
+	tests := []struct {
+		name     string
+		min, max *float64
+		want     []uuid.UUID
+	}{
+		{name: "lower bound", min: price(100), want: []uuid.UUID{items[1].ID, items[2].ID}},
+		{name: "upper bound", max: price(100), want: []uuid.UUID{items[0].ID, items[1].ID}},
+		{name: "both bounds", min: price(50), max: price(500), want: []uuid.UUID{items[1].ID}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			results, err := tRepos.Items.QueryByGroup(context.Background(), tGroup.ID, ItemQuery{
+				LocationIDs:      []uuid.UUID{items[0].Location.ID},
+				MinPurchasePrice: tt.min,
+				MaxPurchasePrice: tt.max,
+			})
+			require.NoError(t, err)
+
+			assert.ElementsMatch(t, tt.want, itemIDs(results.Items))
+		})
+	}
+}
+
+func TestItemsRepository_SetParent(t *testing.T) {
+	ctx := context.Background()
+	items := useItems(t, 3)
+	camera, lens, lensCap := items[0], items[1], items[2]
+
+	err := tRepos.Items.SetParent(ctx, tGroup.ID, lens.ID, camera.ID)
+	require.NoError(t, err)
+	err = tRepos.Items.SetParent(ctx, tGroup.ID, lensCap.ID, lens.ID)
+	require.NoError(t, err)
+
+	got, err := tRepos.Items.GetOneByGroup(ctx, tGroup.ID, lens.ID)
+	require.NoError(t, err)
+	require.NotNil(t, got.Parent)
+	assert.Equal(t, camera.ID, got.Parent.ID)
+	require.Len(t, got.Children, 1)
+	assert.Equal(t, lensCap.ID, got.Children[0].ID)
+
+	// descendants and the item itself cannot become the parent
+	err = tRepos.Items.SetParent(ctx, tGroup.ID, camera.ID, lensCap.ID)
+	assert.ErrorIs(t, err, ErrItemParentCycle)
+	err = tRepos.Items.SetParent(ctx, tGroup.ID, camera.ID, camera.ID)
+	assert.ErrorIs(t, err, ErrItemParentCycle)
+
+	err = tRepos.Items.ClearParent(ctx, tGroup.ID, lensCap.ID)
+	require.NoError(t, err)
+
+	got, err = tRepos.Items.GetOneByGroup(ctx, tGroup.ID, lensCap.ID)
+	require.NoError(t, err)
+	assert.Nil(t, got.Parent)
+
+	// deleting the parent orphans the children
+	err = tRepos.Items.Delete(ctx, camera.ID)
+	require.NoError(t, err)
+
+	got, err = tRepos.Items.GetOneByGroup(ctx, tGroup.ID, lens.ID)
+	require.NoError(t, err)
+	assert.Nil(t, got.Parent)
+}
+
+func TestItemsRepository_AssignNextAssetID(t *testing.T) {
+	ctx := context.Background()
+
+	const count = 10
+
+	g, items := useGroupItems(t, make([]ItemUpdate, count)...)
+
+	ids := make([]uuid.UUID, count)
+	for i, itm := range items {
+		ids[i] = itm.ID
+	}
+
+	var wg sync.WaitGroup
+	assigned := make([]AssetID, count)
+	errs := make([]error, count)
+
+	for i := range ids {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			assigned[i], errs[i] = tRepos.Items.AssignNextAssetID(ctx, g.ID, ids[i])
+		}(i)
+	}
+	wg.Wait()
+
+	seen := make(map[AssetID]bool, count)
+	for i := range assigned {
+		require.NoError(t, errs[i])
+		assert.False(t, seen[assigned[i]], "asset id %d assigned twice", assigned[i])
+		seen[assigned[i]] = true
+	}
+
+	for aid := AssetID(1); aid <= count; aid++ {
+		assert.True(t, seen[aid], "asset id %d not assigned", aid)
+	}
+
+	for i, aid := range assigned {
+		out, err := tRepos.Items.GetByAssetID(ctx, g.ID, aid)
+		require.NoError(t, err)
+		assert.Equal(t, ids[i], out.ID)
+	}
+
+	// asset ids are scoped to the group
+	_, err := tRepos.Items.GetByAssetID(ctx, tGroup.ID, AssetID(count+1000))
+	assert.True(t, ent.IsNotFound(err))
+
+	_, err = tRepos.Items.GetByAssetID(ctx, g.ID, 0)
+	assert.True(t, ent.IsNotFound(err))
+}
+
+func TestItemsRepository_UpdateByGroup_Currency(t *testing.T) {
+	itm := useItems(t, 1)[0]
+
+	update := ItemUpdate{
+		ID:         itm.ID,
+		Name:       itm.Name,
+		LocationID: itm.Location.ID,
+		Currency:   "xyz",
+	}
+
+	_, err := tRepos.Items.UpdateByGroup(context.Background(), tGroup.ID, update)
+	assert.ErrorIs(t, err, ErrInvalidCurrency)
+
+	update.Currency = "eur"
+	got, err := tRepos.Items.UpdateByGroup(context.Background(), tGroup.ID, update)
+	require.NoError(t, err)
+	assert.Equal(t, "EUR", got.Currency)
+}
+
+func TestItemsRepository_GetOne_LocationPath(t *testing.T) {
+	ctx := context.Background()
+
+	g, err := tRepos.Groups.GroupCreate(ctx, "location-path")
+	require.NoError(t, err)
+
+	home, err := tRepos.Locations.Create(ctx, g.ID, LocationCreate{Name: "Home"})
+	require.NoError(t, err)
+
+	garage, err := tRepos.Locations.Create(ctx, g.ID, LocationCreate{Name: "Garage", ParentID: home.ID})
+	require.NoError(t, err)
+
+	shelf, err := tRepos.Locations.Create(ctx, g.ID, LocationCreate{Name: "Shelf B", ParentID: garage.ID})
+	require.NoError(t, err)
+
+	data := itemFactory()
+	data.LocationID = shelf.ID
+
+	itm, err := tRepos.Items.Create(ctx, g.ID, data)
+	require.NoError(t, err)
+
+	require.Len(t, itm.LocationPath, 3)
+	assert.Equal(t, home.ID, itm.LocationPath[0].ID)
+	assert.Equal(t, "Home", itm.LocationPath[0].Name)
+	assert.Equal(t, garage.ID, itm.LocationPath[1].ID)
+	assert.Equal(t, "Garage", itm.LocationPath[1].Name)
+	assert.Equal(t, shelf.ID, itm.LocationPath[2].ID)
+	assert.Equal(t, "Shelf B", itm.LocationPath[2].Name)
+
+	// a top-level location is its own path
+	data = itemFactory()
+	data.LocationID = home.ID
+
+	itm, err = tRepos.Items.Create(ctx, g.ID, data)
+	require.NoError(t, err)
+
+	require.Len(t, itm.LocationPath, 1)
+	assert.Equal(t, home.ID, itm.LocationPath[0].ID)
+}
+
+func TestItemsRepository_Patch(t *testing.T) {
+	ctx := context.Background()
+	itm := useItems(t, 1)[0]
+	labels := useLabels(t, 2)
+
+	full, err := tRepos.Items.UpdateByGroup(ctx, tGroup.ID, ItemUpdate{
+		ID:              itm.ID,
+		Name:            itm.Name,
+		Description:     itm.Description,
+		LocationID:      itm.Location.ID,
+		LabelIDs:        []uuid.UUID{labels[0].ID, labels[1].ID},
+		Quantity:        1,
+		SerialNumber:    "SN-1",
+		WarrantyDetails: "two years, parts only",
+		PurchasePrice:   99.5,
+		Notes:           "keep the box",
+	})
+	require.NoError(t, err)
+
+	quantity := 4
+	err = tRepos.Items.Patch(ctx, tGroup.ID, itm.ID, ItemPatch{Quantity: &quantity})
+	require.NoError(t, err)
+
+	got, err := tRepos.Items.GetOne(ctx, itm.ID)
+	require.NoError(t, err)
+
+	assert.Equal(t, 4, got.Quantity)
+	assert.Equal(t, full.Name, got.Name)
+	assert.Equal(t, full.Description, got.Description)
+	assert.Equal(t, full.Location.ID, got.Location.ID)
+	assert.Len(t, got.Labels, 2)
+	assert.Equal(t, "SN-1", got.SerialNumber)
+	assert.Equal(t, "two years, parts only", got.WarrantyDetails)
+	assert.InDelta(t, 99.5, got.PurchasePrice, 0.001)
+	assert.Equal(t, "keep the box", got.Notes)
+
+	// an empty label slice removes the labels
+	err = tRepos.Items.Patch(ctx, tGroup.ID, itm.ID, ItemPatch{LabelIDs: []uuid.UUID{}})
+	require.NoError(t, err)
+
+	got, err = tRepos.Items.GetOne(ctx, itm.ID)
+	require.NoError(t, err)
+	assert.Empty(t, got.Labels)
+	assert.Equal(t, 4, got.Quantity)
+
+	err = tRepos.Items.Patch(ctx, tGroup.ID, itm.ID, ItemPatch{LabelIDs: []uuid.UUID{uuid.New()}})
+	assert.ErrorIs(t, err, ErrLabelNotInGroup)
+
+	err = tRepos.Items.Patch(ctx, uuid.New(), itm.ID, ItemPatch{Quantity: &quantity})
+	assert.True(t, ent.IsNotFound(err))
+
+	// the fields that aren't part of the base patch are applied as well
+	parent := useItems(t, 1)[0]
+	aid, threshold, barcode, currency := AssetID(77), 2, "4006381333931", "eur"
+	lat, lng, cost, markdown := 52.5, 13.4, 150.0, "markdown"
+
+	err = tRepos.Items.Patch(ctx, tGroup.ID, itm.ID, ItemPatch{
+		AssetID:          &aid,
+		ReorderThreshold: &threshold,
+		Barcode:          &barcode,
+		Currency:         &currency,
+		Latitude:         &lat,
+		Longitude:        &lng,
+		ReplacementCost:  &cost,
+		NotesFormat:      &markdown,
+		Tags:             []string{" garage", "garage"},
+		ParentID:         &parent.ID,
+	})
+	require.NoError(t, err)
+
+	got, err = tRepos.Items.GetOne(ctx, itm.ID)
+	require.NoError(t, err)
+	assert.Equal(t, aid, got.AssetID)
+	assert.Equal(t, threshold, got.ReorderThreshold)
+	assert.Equal(t, barcode, got.Barcode)
+	assert.Equal(t, "EUR", got.Currency)
+	require.NotNil(t, got.Latitude)
+	assert.InDelta(t, lat, *got.Latitude, 0.0001)
+	assert.InDelta(t, cost, got.ReplacementCost, 0.001)
+	assert.Equal(t, markdown, got.NotesFormat)
+	assert.Equal(t, []string{"garage"}, got.Tags)
+	require.NotNil(t, got.Parent)
+	assert.Equal(t, parent.ID, got.Parent.ID)
+	assert.Equal(t, "keep the box", got.Notes)
+
+	err = tRepos.Items.Patch(ctx, tGroup.ID, itm.ID, ItemPatch{Latitude: &lat})
+	assert.ErrorIs(t, err, ErrIncompleteCoordinates)
+
+	err = tRepos.Items.Patch(ctx, tGroup.ID, itm.ID, ItemPatch{Version: got.Version - 1, Quantity: &quantity})
+	assert.ErrorIs(t, err, ErrVersionConflict)
+
+	err = tRepos.Items.Patch(ctx, tGroup.ID, itm.ID, ItemPatch{Version: got.Version, ParentID: &uuid.Nil})
+	require.NoError(t, err)
+
+	got, err = tRepos.Items.GetOne(ctx, itm.ID)
+	require.NoError(t, err)
+	assert.Nil(t, got.Parent)
+}
+
+func TestItemsRepository_StreamAll(t *testing.T) {
+	ctx := context.Background()
+
+	g, err := tRepos.Groups.GroupCreate(ctx, "stream-all")
+	require.NoError(t, err)
+
+	loc, err := tRepos.Locations.Create(ctx, g.ID, locationFactory())
+	require.NoError(t, err)
+
+	data := make([]ItemCreate, 250)
+	for i := range data {
+		data[i] = itemFactory()
+		data[i].LocationID = loc.ID
+	}
+
+	_, err = tRepos.Items.CreateMany(ctx, g.ID, data)
+	require.NoError(t, err)
+
+	all, err := tRepos.Items.GetAll(ctx, g.ID)
+	require.NoError(t, err)
+	require.Len(t, all, 250)
+
+	next, err := tRepos.Items.StreamAll(ctx, g.ID, 100)
+	require.NoError(t, err)
+
+	var (
+		streamed []uuid.UUID
+		batches  []int
+	)
+
+	for {
+		batch, err := next()
+		require.NoError(t, err)
+
+		if len(batch) == 0 {
+			break
+		}
+
+		batches = append(batches, len(batch))
+		for _, itm := range batch {
+			streamed = append(streamed, itm.ID)
+		}
+	}
+
+	assert.Equal(t, []int{100, 100, 50}, batches)
+
+	expected := make([]uuid.UUID, len(all))
+	for i, itm := range all {
+		expected[i] = itm.ID
+	}
+	assert.ElementsMatch(t, expected, streamed)
+
+	// the stream stays exhausted
+	batch, err := next()
+	require.NoError(t, err)
+	assert.Empty(t, batch)
+
+	_, err = tRepos.Items.StreamAll(ctx, g.ID, 0)
+	assert.ErrorIs(t, err, ErrInvalidPageSize)
+}
+
+func TestItemsRepository_QueryByGroup_DateRanges(t *testing.T) {
+	ctx := context.Background()
+
+	g, err := tRepos.Groups.GroupCreate(ctx, "date-ranges")
+	require.NoError(t, err)
+
+	loc, err := tRepos.Locations.Create(ctx, g.ID, locationFactory())
+	require.NoError(t, err)
+
+	day := func(d int) time.Time {
+		return time.Date(2024, 3, d, 12, 0, 0, 0, time.UTC)
+	}
+
+	ids := make([]uuid.UUID, 3)
+	for i, d := range []int{1, 10, 20} {
+		itm, err := tClient.Item.Create().
+			SetName(fk.Str(10)).
+			SetGroupID(g.ID).
+			SetLocationID(loc.ID).
+			SetCreatedAt(day(d)).
+			SetUpdatedAt(day(d + 5)).
+			Save(ctx)
+		require.NoError(t, err)
+		ids[i] = itm.ID
+	}
+
+	query := func(q ItemQuery) []uuid.UUID {
+		q.Page, q.PageSize = -1, -1
+
+		results, err := tRepos.Items.QueryByGroup(ctx, g.ID, q)
+		require.NoError(t, err)
+
+		return itemIDs(results.Items)
+	}
+
+	ptr := func(t time.Time) *time.Time { return &t }
+
+	assert.Len(t, query(ItemQuery{}), 3)
+	assert.ElementsMatch(t, ids[1:], query(ItemQuery{CreatedAfter: ptr(day(10))}))
+	assert.ElementsMatch(t, ids[:2], query(ItemQuery{CreatedBefore: ptr(day(10))}))
+	assert.ElementsMatch(t, ids[1:2], query(ItemQuery{CreatedAfter: ptr(day(5)), CreatedBefore: ptr(day(15))}))
+	assert.ElementsMatch(t, ids[2:], query(ItemQuery{UpdatedAfter: ptr(day(16))}))
+	assert.ElementsMatch(t, ids[:1], query(ItemQuery{UpdatedBefore: ptr(day(6))}))
+
+	// composes with the other filters
+	assert.Empty(t, query(ItemQuery{CreatedAfter: ptr(day(5)), Search: "no such item"}))
+}
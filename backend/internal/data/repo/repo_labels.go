@@ -12,6 +12,9 @@ import (
 	"github.com/hay-kot/homebox/backend/internal/data/ent/predicate"
 )
 
+// defaultLabelColor is the color of labels that don't have one set.
+const defaultLabelColor = "#6b7280"
+
 type LabelRepository struct {
 	db  *ent.Client
 	bus *eventbus.EventBus
@@ -35,6 +38,7 @@ type (
 		ID          uuid.UUID `json:"id"`
 		Name        string    `json:"name"`
 		Description string    `json:"description"`
+		Color       string    `json:"color"`
 		CreatedAt   time.Time `json:"createdAt"`
 		UpdatedAt   time.Time `json:"updatedAt"`
 	}
@@ -45,10 +49,16 @@ type (
 )
 
 func mapLabelSummary(label *ent.Label) LabelSummary {
+	color := label.Color
+	if color == "" {
+		color = defaultLabelColor
+	}
+
 	return LabelSummary{
 		ID:          label.ID,
 		Name:        label.Name,
 		Description: label.Description,
+		Color:       color,
 		CreatedAt:   label.CreatedAt,
 		UpdatedAt:   label.UpdatedAt,
 	}
@@ -4,7 +4,9 @@ import (
 	"context"
 	"testing"
 
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func labelFactory() LabelCreate {
@@ -100,3 +102,44 @@ func TestLabelRepository_Delete(t *testing.T) {
 	_, err = tRepos.Labels.GetOne(context.Background(), loc.ID)
 	assert.Error(t, err)
 }
+
+func TestLabelRepository_ItemLabelColors(t *testing.T) {
+	ctx := context.Background()
+	itm := useItems(t, 1)[0]
+
+	red, err := tRepos.Labels.Create(ctx, tGroup.ID, LabelCreate{Name: fk.Str(10), Color: "#ff0000"})
+	require.NoError(t, err)
+	plain, err := tRepos.Labels.Create(ctx, tGroup.ID, LabelCreate{Name: fk.Str(10)})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = tRepos.Labels.delete(context.Background(), red.ID)
+		_ = tRepos.Labels.delete(context.Background(), plain.ID)
+	})
+
+	_, err = tRepos.Items.SetLabels(ctx, tGroup.ID, itm.ID, []uuid.UUID{red.ID, plain.ID})
+	require.NoError(t, err)
+
+	colors := func(labels []LabelSummary) map[uuid.UUID]string {
+		out := make(map[uuid.UUID]string, len(labels))
+		for _, l := range labels {
+			out[l.ID] = l.Color
+		}
+		return out
+	}
+
+	want := map[uuid.UUID]string{
+		red.ID:   "#ff0000",
+		plain.ID: defaultLabelColor,
+	}
+
+	// full item output
+	out, err := tRepos.Items.GetOneByGroup(ctx, tGroup.ID, itm.ID)
+	require.NoError(t, err)
+	assert.Equal(t, want, colors(out.Labels))
+
+	// item summaries
+	results, err := tRepos.Items.QueryByGroup(ctx, tGroup.ID, ItemQuery{LabelIDs: []uuid.UUID{red.ID}})
+	require.NoError(t, err)
+	require.Len(t, results.Items, 1)
+	assert.Equal(t, want, colors(results.Items[0].Labels))
+}
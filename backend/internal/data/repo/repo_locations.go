@@ -9,6 +9,7 @@ import (
 	"github.com/hay-kot/homebox/backend/internal/core/services/reporting/eventbus"
 	"github.com/hay-kot/homebox/backend/internal/data/ent"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/group"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/item"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/location"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/predicate"
 )
@@ -23,6 +24,7 @@ type (
 		Name        string    `json:"name"`
 		ParentID    uuid.UUID `json:"parentId" extensions:"x-nullable"`
 		Description string    `json:"description"`
+		Capacity    int       `json:"capacity" validate:"min=0"`
 	}
 
 	LocationUpdate struct {
@@ -30,6 +32,7 @@ type (
 		ID          uuid.UUID `json:"id"`
 		Name        string    `json:"name"`
 		Description string    `json:"description"`
+		Capacity    int       `json:"capacity" validate:"min=0"`
 	}
 
 	LocationSummary struct {
@@ -48,8 +51,27 @@ type (
 	LocationOut struct {
 		Parent *LocationSummary `json:"parent,omitempty"`
 		LocationSummary
+		Capacity int               `json:"capacity"`
 		Children []LocationSummary `json:"children"`
 	}
+
+	LocationUtilization struct {
+		ID       uuid.UUID `json:"id"`
+		Name     string    `json:"name"`
+		Used     int       `json:"used"`
+		Capacity int       `json:"capacity"`
+		// Percent is nil for locations without a capacity
+		Percent *float64 `json:"percent" extensions:"x-nullable"`
+	}
+
+	// UtilizationReport holds the utilization of each location in the group along with a
+	// group total. The totals only account for locations with a capacity.
+	UtilizationReport struct {
+		Locations []LocationUtilization `json:"locations"`
+		Used      int                   `json:"used"`
+		Capacity  int                   `json:"capacity"`
+		Percent   float64               `json:"percent"`
+	}
 )
 
 func mapLocationSummary(location *ent.Location) LocationSummary {
@@ -78,6 +100,7 @@ func mapLocationOut(location *ent.Location) LocationOut {
 
 	return LocationOut{
 		Parent:   parent,
+		Capacity: location.Capacity,
 		Children: children,
 		LocationSummary: LocationSummary{
 			ID:          location.ID,
@@ -157,6 +180,120 @@ func (r *LocationRepository) GetAll(ctx context.Context, GID uuid.UUID, filter L
 	return list, err
 }
 
+// LocationsWithCounts returns all locations of the group, ordered by name, with the number
+// of non-archived items stored directly in each of them. The counts are computed with a
+// single grouped query over the items of the group.
+func (r *LocationRepository) LocationsWithCounts(ctx context.Context, GID uuid.UUID) ([]LocationOutCount, error) {
+	locations, err := r.db.Location.Query().
+		Where(location.HasGroupWith(group.ID(GID))).
+		Order(ent.Asc(location.FieldName)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var counts []struct {
+		LocationID uuid.UUID `json:"location_items"`
+		Count      int       `json:"count"`
+	}
+
+	err = r.db.Item.Query().
+		Where(
+			item.HasGroupWith(group.ID(GID)),
+			item.HasLocation(),
+			item.Archived(false),
+		).
+		GroupBy(item.LocationColumn).
+		Aggregate(ent.Count()).
+		Scan(ctx, &counts)
+	if err != nil {
+		return nil, err
+	}
+
+	byLocation := make(map[uuid.UUID]int, len(counts))
+	for _, c := range counts {
+		byLocation[c.LocationID] = c.Count
+	}
+
+	out := make([]LocationOutCount, len(locations))
+	for i, l := range locations {
+		out[i] = LocationOutCount{
+			LocationSummary: mapLocationSummary(l),
+			ItemCount:       byLocation[l.ID],
+		}
+	}
+
+	return out, nil
+}
+
+// StorageUtilization reports how full each location of the group is, based on the total
+// quantity of the non-archived items stored directly in it.
+func (r *LocationRepository) StorageUtilization(ctx context.Context, GID uuid.UUID) (UtilizationReport, error) {
+	query := `--sql
+		SELECT
+			id,
+			name,
+			capacity,
+			(
+				SELECT
+					SUM(items.quantity)
+				FROM
+					items
+				WHERE
+					items.location_items = locations.id
+					AND items.archived = false
+			) as used
+		FROM
+			locations
+		WHERE
+			locations.group_locations = ?
+		ORDER BY
+			locations.name ASC
+`
+
+	rows, err := r.db.Sql().QueryContext(ctx, query, GID)
+	if err != nil {
+		return UtilizationReport{}, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	report := UtilizationReport{
+		Locations: []LocationUtilization{},
+	}
+
+	for rows.Next() {
+		var lu LocationUtilization
+		var maybeUsed *int
+
+		err := rows.Scan(&lu.ID, &lu.Name, &lu.Capacity, &maybeUsed)
+		if err != nil {
+			return UtilizationReport{}, err
+		}
+
+		lu.Used = orDefault(maybeUsed, 0)
+
+		if lu.Capacity > 0 {
+			pct := float64(lu.Used) / float64(lu.Capacity) * 100
+			lu.Percent = &pct
+
+			report.Used += lu.Used
+			report.Capacity += lu.Capacity
+		}
+
+		report.Locations = append(report.Locations, lu)
+	}
+
+	if err := rows.Err(); err != nil {
+		return UtilizationReport{}, err
+	}
+
+	if report.Capacity > 0 {
+		report.Percent = float64(report.Used) / float64(report.Capacity) * 100
+	}
+
+	return report, nil
+}
+
 func (r *LocationRepository) getOne(ctx context.Context, where ...predicate.Location) (LocationOut, error) {
 	return mapLocationOutErr(r.db.Location.Query().
 		Where(where...).
@@ -178,6 +315,7 @@ func (r *LocationRepository) Create(ctx context.Context, GID uuid.UUID, data Loc
 	q := r.db.Location.Create().
 		SetName(data.Name).
 		SetDescription(data.Description).
+		SetCapacity(data.Capacity).
 		SetGroupID(GID)
 
 	if data.ParentID != uuid.Nil {
@@ -198,7 +336,8 @@ func (r *LocationRepository) update(ctx context.Context, data LocationUpdate, wh
 	q := r.db.Location.Update().
 		Where(where...).
 		SetName(data.Name).
-		SetDescription(data.Description)
+		SetDescription(data.Description).
+		SetCapacity(data.Capacity)
 
 	if data.ParentID != uuid.Nil {
 		q.SetParentID(data.ParentID)
@@ -259,6 +398,127 @@ type TreeQuery struct {
 	WithItems bool `json:"withItems" schema:"withItems"`
 }
 
+// LocationValue returns the total purchase price of the non-archived items stored in the
+// location that are priced in the group's currency. When recursive is true the items of
+// all descendant locations are included.
+func (r *LocationRepository) LocationValue(ctx context.Context, GID, ID uuid.UUID, recursive bool) (float64, error) {
+	// ensure the location exists within the group
+	_, err := r.db.Location.Query().
+		Where(
+			location.ID(ID),
+			location.HasGroupWith(group.ID(GID)),
+		).
+		OnlyID(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	query := `--sql
+		SELECT
+			SUM(items.purchase_price)
+		FROM
+			items
+		WHERE
+			items.location_items = ?
+			AND items.archived = false
+			AND ` + groupCurrencyCond("items.currency", "items.group_items") + `
+`
+
+	if recursive {
+		query = `--sql
+		WITH RECURSIVE location_tree AS (
+			SELECT id
+			FROM locations
+			WHERE id = ?
+
+			UNION ALL
+
+			SELECT loc.id
+			FROM locations loc
+			JOIN location_tree lt ON loc.location_children = lt.id
+		)
+
+		SELECT
+			SUM(items.purchase_price)
+		FROM
+			items
+		WHERE
+			items.location_items IN (SELECT id FROM location_tree)
+			AND items.archived = false
+			AND ` + groupCurrencyCond("items.currency", "items.group_items") + `
+`
+	}
+
+	var total *float64
+	if err := r.db.Sql().QueryRowContext(ctx, query, ID).Scan(&total); err != nil {
+		return 0, err
+	}
+
+	return orDefault(total, 0), nil
+}
+
+// locationAncestors returns the location and all of its ancestors, root first. The tree is
+// walked by a single recursive query regardless of its depth.
+func locationAncestors(ctx context.Context, db *ent.Client, ID uuid.UUID) ([]LocationSummary, error) {
+	query := `--sql
+		WITH RECURSIVE location_path AS (
+			SELECT id, location_children, 0 AS depth
+			FROM locations
+			WHERE id = ?
+
+			UNION ALL
+
+			SELECT loc.id, loc.location_children, lp.depth + 1
+			FROM locations loc
+			JOIN location_path lp ON loc.id = lp.location_children
+		)
+
+		SELECT id
+		FROM location_path
+		ORDER BY depth DESC
+`
+
+	rows, err := db.Sql().QueryContext(ctx, query, ID)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	var ids []uuid.UUID
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	locations, err := db.Location.Query().
+		Where(location.IDIn(ids...)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	byID := make(map[uuid.UUID]*ent.Location, len(locations))
+	for _, loc := range locations {
+		byID[loc.ID] = loc
+	}
+
+	path := make([]LocationSummary, 0, len(ids))
+	for _, id := range ids {
+		if loc, ok := byID[id]; ok {
+			path = append(path, mapLocationSummary(loc))
+		}
+	}
+
+	return path, nil
+}
+
 type LocationPath struct {
 	ID   uuid.UUID `json:"id"`
 	Name string    `json:"name"`
@@ -444,3 +704,117 @@ func ConvertLocationsToTree(locations []FlatTreeItem) []TreeItem {
 
 	return roots
 }
+
+// LocationNode is a location in the location hierarchy with the summaries of
+// the items stored directly in it. The root node returned by LocationTreeWithItems
+// has no location attached and holds the items that have no location.
+type LocationNode struct {
+	*LocationSummary
+	Items    []ItemSummary  `json:"items"`
+	Children []LocationNode `json:"children"`
+}
+
+// LocationTreeWithItems returns the location hierarchy of the group with the
+// summaries of the items in each location attached to their node. Archived items
+// are not included.
+func (lr *LocationRepository) LocationTreeWithItems(ctx context.Context, GID uuid.UUID) (LocationNode, error) {
+	locations, err := lr.db.Location.Query().
+		Where(location.HasGroupWith(group.ID(GID))).
+		WithParent(func(lq *ent.LocationQuery) {
+			lq.Select(location.FieldID)
+		}).
+		Order(ent.Asc(location.FieldName)).
+		All(ctx)
+	if err != nil {
+		return LocationNode{}, err
+	}
+
+	items, err := lr.db.Item.Query().
+		Where(
+			item.HasGroupWith(group.ID(GID)),
+			item.Archived(false),
+		).
+		WithLocation(func(lq *ent.LocationQuery) {
+			lq.Select(location.FieldID)
+		}).
+		Order(ent.Asc(item.FieldName)).
+		All(ctx)
+	if err != nil {
+		return LocationNode{}, err
+	}
+
+	itemsByLocation := make(map[uuid.UUID][]ItemSummary, len(locations))
+	for _, itm := range items {
+		locID := uuid.Nil
+		if itm.Edges.Location != nil {
+			locID = itm.Edges.Location.ID
+		}
+
+		summary := mapItemSummary(itm)
+		summary.Location = nil // implied by the node the item is attached to
+		itemsByLocation[locID] = append(itemsByLocation[locID], summary)
+	}
+
+	byID := make(map[uuid.UUID]*ent.Location, len(locations))
+	children := make(map[uuid.UUID][]*ent.Location, len(locations))
+	for _, loc := range locations {
+		byID[loc.ID] = loc
+	}
+
+	var roots []*ent.Location
+	for _, loc := range locations {
+		if loc.Edges.Parent != nil {
+			if _, ok := byID[loc.Edges.Parent.ID]; ok {
+				children[loc.Edges.Parent.ID] = append(children[loc.Edges.Parent.ID], loc)
+				continue
+			}
+		}
+
+		roots = append(roots, loc)
+	}
+
+	// visited guards against cycles in the parent/child edges, each location is
+	// only ever placed once in the tree.
+	visited := make(map[uuid.UUID]bool, len(locations))
+
+	var build func(loc *ent.Location) LocationNode
+	build = func(loc *ent.Location) LocationNode {
+		visited[loc.ID] = true
+
+		summary := mapLocationSummary(loc)
+		node := LocationNode{
+			LocationSummary: &summary,
+			Items:           orEmpty(itemsByLocation[loc.ID]),
+			Children:        []LocationNode{},
+		}
+
+		for _, child := range children[loc.ID] {
+			if visited[child.ID] {
+				continue
+			}
+
+			node.Children = append(node.Children, build(child))
+		}
+
+		return node
+	}
+
+	root := LocationNode{
+		Items:    orEmpty(itemsByLocation[uuid.Nil]),
+		Children: make([]LocationNode, 0, len(roots)),
+	}
+
+	for _, loc := range roots {
+		root.Children = append(root.Children, build(loc))
+	}
+
+	// Locations that are part of a cycle are never reached from a root, so they
+	// are attached to the root node instead of being dropped.
+	for _, loc := range locations {
+		if !visited[loc.ID] {
+			root.Children = append(root.Children, build(loc))
+		}
+	}
+
+	return root, nil
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/hay-kot/homebox/backend/internal/data/ent"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func locationFactory() LocationCreate {
@@ -146,6 +147,45 @@ func TestItemRepository_TreeQuery(t *testing.T) {
 	}
 }
 
+func TestLocationRepository_LocationTreeWithItems(t *testing.T) {
+	locs := useLocations(t, 2)
+
+	// Nest locs[0] under locs[1]
+	_, err := tRepos.Locations.UpdateByGroup(context.Background(), tGroup.ID, locs[0].ID, LocationUpdate{
+		ID:       locs[0].ID,
+		ParentID: locs[1].ID,
+		Name:     locs[0].Name,
+	})
+	assert.NoError(t, err)
+
+	itm, err := tRepos.Items.Create(context.Background(), tGroup.ID, ItemCreate{
+		Name:       fk.Str(10),
+		LocationID: locs[0].ID,
+	})
+	assert.NoError(t, err)
+
+	root, err := tRepos.Locations.LocationTreeWithItems(context.Background(), tGroup.ID)
+	assert.NoError(t, err)
+	assert.Nil(t, root.LocationSummary)
+
+	var parent *LocationNode
+	for i := range root.Children {
+		if root.Children[i].ID == locs[1].ID {
+			parent = &root.Children[i]
+		}
+	}
+
+	if assert.NotNil(t, parent) {
+		assert.Empty(t, parent.Items)
+		assert.Len(t, parent.Children, 1)
+
+		child := parent.Children[0]
+		assert.Equal(t, locs[0].ID, child.ID)
+		assert.Len(t, child.Items, 1)
+		assert.Equal(t, itm.ID, child.Items[0].ID)
+	}
+}
+
 func TestLocationRepository_PathForLoc(t *testing.T) {
 	locs := useLocations(t, 3)
 
@@ -290,3 +330,192 @@ func TestConvertLocationsToTree(t *testing.T) {
 		})
 	}
 }
+
+func TestLocationRepository_StorageUtilization(t *testing.T) {
+	ctx := context.Background()
+
+	g, err := tRepos.Groups.GroupCreate(ctx, "utilization")
+	require.NoError(t, err)
+
+	shelf, err := tRepos.Locations.Create(ctx, g.ID, LocationCreate{Name: "Shelf", Capacity: 10})
+	require.NoError(t, err)
+	assert.Equal(t, 10, shelf.Capacity)
+
+	drawer, err := tRepos.Locations.Create(ctx, g.ID, LocationCreate{Name: "Drawer", Capacity: 40})
+	require.NoError(t, err)
+
+	floor, err := tRepos.Locations.Create(ctx, g.ID, LocationCreate{Name: "Floor"})
+	require.NoError(t, err)
+
+	for _, u := range []struct {
+		location uuid.UUID
+		quantity int
+	}{
+		{shelf.ID, 5},
+		{drawer.ID, 15},
+		{floor.ID, 7},
+	} {
+		data := itemFactory()
+		data.LocationID = u.location
+
+		itm, err := tRepos.Items.Create(ctx, g.ID, data)
+		require.NoError(t, err)
+
+		_, err = tRepos.Items.UpdateByGroup(ctx, g.ID, ItemUpdate{
+			ID:         itm.ID,
+			Name:       itm.Name,
+			LocationID: u.location,
+			Quantity:   u.quantity,
+		})
+		require.NoError(t, err)
+	}
+
+	report, err := tRepos.Locations.StorageUtilization(ctx, g.ID)
+	require.NoError(t, err)
+	require.Len(t, report.Locations, 3)
+
+	// ordered by name
+	assert.Equal(t, drawer.ID, report.Locations[0].ID)
+	require.NotNil(t, report.Locations[0].Percent)
+	assert.InDelta(t, 37.5, *report.Locations[0].Percent, 0.001)
+
+	assert.Equal(t, floor.ID, report.Locations[1].ID)
+	assert.Equal(t, 7, report.Locations[1].Used)
+	assert.Nil(t, report.Locations[1].Percent)
+
+	assert.Equal(t, shelf.ID, report.Locations[2].ID)
+	assert.InDelta(t, 50.0, *report.Locations[2].Percent, 0.001)
+
+	assert.Equal(t, 20, report.Used)
+	assert.Equal(t, 50, report.Capacity)
+	assert.InDelta(t, 40.0, report.Percent, 0.001)
+}
+
+func TestLocationRepository_LocationsWithCounts(t *testing.T) {
+	ctx := context.Background()
+
+	g, err := tRepos.Groups.GroupCreate(ctx, "location-counts")
+	require.NoError(t, err)
+
+	attic, err := tRepos.Locations.Create(ctx, g.ID, LocationCreate{Name: "Attic"})
+	require.NoError(t, err)
+
+	basement, err := tRepos.Locations.Create(ctx, g.ID, LocationCreate{Name: "Basement"})
+	require.NoError(t, err)
+
+	closet, err := tRepos.Locations.Create(ctx, g.ID, LocationCreate{Name: "Closet", ParentID: basement.ID})
+	require.NoError(t, err)
+
+	garage, err := tRepos.Locations.Create(ctx, g.ID, LocationCreate{Name: "Garage"})
+	require.NoError(t, err)
+
+	seed := func(locationID uuid.UUID, n int) []ItemOut {
+		items := make([]ItemOut, n)
+		for i := range items {
+			data := itemFactory()
+			data.LocationID = locationID
+
+			items[i], err = tRepos.Items.Create(ctx, g.ID, data)
+			require.NoError(t, err)
+		}
+		return items
+	}
+
+	seed(attic.ID, 3)
+	seed(basement.ID, 1)
+	seed(closet.ID, 2)
+
+	// archived items aren't counted
+	archived := seed(garage.ID, 1)[0]
+	_, err = tRepos.Items.UpdateByGroup(ctx, g.ID, ItemUpdate{
+		ID:         archived.ID,
+		Name:       archived.Name,
+		LocationID: garage.ID,
+		Archived:   true,
+	})
+	require.NoError(t, err)
+
+	got, err := tRepos.Locations.LocationsWithCounts(ctx, g.ID)
+	require.NoError(t, err)
+	require.Len(t, got, 4)
+
+	// ordered by name, child items aren't counted for the parent
+	want := []struct {
+		id    uuid.UUID
+		count int
+	}{
+		{attic.ID, 3},
+		{basement.ID, 1},
+		{closet.ID, 2},
+		{garage.ID, 0},
+	}
+
+	for i, w := range want {
+		assert.Equal(t, w.id, got[i].ID)
+		assert.Equal(t, w.count, got[i].ItemCount, got[i].Name)
+	}
+}
+
+func TestLocationRepository_LocationValue(t *testing.T) {
+	ctx := context.Background()
+
+	g, err := tRepos.Groups.GroupCreate(ctx, "location-value")
+	require.NoError(t, err)
+
+	house, err := tRepos.Locations.Create(ctx, g.ID, LocationCreate{Name: "House"})
+	require.NoError(t, err)
+
+	room, err := tRepos.Locations.Create(ctx, g.ID, LocationCreate{Name: "Room", ParentID: house.ID})
+	require.NoError(t, err)
+
+	closet, err := tRepos.Locations.Create(ctx, g.ID, LocationCreate{Name: "Closet", ParentID: room.ID})
+	require.NoError(t, err)
+
+	for _, v := range []struct {
+		location uuid.UUID
+		price    float64
+		archived bool
+	}{
+		{house.ID, 10, false},
+		{room.ID, 20, false},
+		{closet.ID, 30, false},
+		{closet.ID, 1000, true},
+	} {
+		data := itemFactory()
+		data.LocationID = v.location
+
+		itm, err := tRepos.Items.Create(ctx, g.ID, data)
+		require.NoError(t, err)
+
+		_, err = tRepos.Items.UpdateByGroup(ctx, g.ID, ItemUpdate{
+			ID:            itm.ID,
+			Name:          itm.Name,
+			LocationID:    v.location,
+			PurchasePrice: v.price,
+			Archived:      v.archived,
+		})
+		require.NoError(t, err)
+	}
+
+	total, err := tRepos.Locations.LocationValue(ctx, g.ID, house.ID, false)
+	require.NoError(t, err)
+	assert.InDelta(t, 10.0, total, 0.001)
+
+	total, err = tRepos.Locations.LocationValue(ctx, g.ID, house.ID, true)
+	require.NoError(t, err)
+	assert.InDelta(t, 60.0, total, 0.001)
+
+	total, err = tRepos.Locations.LocationValue(ctx, g.ID, room.ID, true)
+	require.NoError(t, err)
+	assert.InDelta(t, 50.0, total, 0.001)
+
+	empty, err := tRepos.Locations.Create(ctx, g.ID, LocationCreate{Name: "Empty"})
+	require.NoError(t, err)
+
+	total, err = tRepos.Locations.LocationValue(ctx, g.ID, empty.ID, true)
+	require.NoError(t, err)
+	assert.Zero(t, total)
+
+	_, err = tRepos.Locations.LocationValue(ctx, tGroup.ID, house.ID, false)
+	assert.True(t, ent.IsNotFound(err))
+}
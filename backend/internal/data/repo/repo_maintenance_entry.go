@@ -3,13 +3,16 @@ package repo
 import (
 	"context"
 	"errors"
+	"sort"
 	"time"
 
+	"entgo.io/ent/dialect/sql"
 	"github.com/google/uuid"
 	"github.com/hay-kot/homebox/backend/internal/data/ent"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/group"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/item"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/maintenanceentry"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/predicate"
 	"github.com/hay-kot/homebox/backend/internal/data/types"
 )
 
@@ -60,6 +63,11 @@ type (
 		Cost          float64    `json:"cost,string"`
 	}
 
+	MaintenanceCostTotal struct {
+		ItemID uuid.UUID `json:"itemId"`
+		Total  float64   `json:"total"`
+	}
+
 	MaintenanceLog struct {
 		ItemID      uuid.UUID          `json:"itemId"`
 		CostAverage float64            `json:"costAverage"`
@@ -105,8 +113,25 @@ func (r *MaintenanceEntryRepository) GetScheduled(ctx context.Context, GID uuid.
 	return mapEachMaintenanceEntry(entries), nil
 }
 
-func (r *MaintenanceEntryRepository) Create(ctx context.Context, itemID uuid.UUID, input MaintenanceEntryCreate) (MaintenanceEntry, error) {
-	item, err := r.db.MaintenanceEntry.Create().
+// maintenanceInGroup matches the maintenance entries of the items of the group.
+func maintenanceInGroup(GID uuid.UUID) predicate.MaintenanceEntry {
+	return maintenanceentry.HasItemWith(item.HasGroupWith(group.ID(GID)))
+}
+
+// Create adds an entry to the item, a not found error is returned when the item doesn't
+// belong to the group.
+func (r *MaintenanceEntryRepository) Create(ctx context.Context, GID, itemID uuid.UUID, input MaintenanceEntryCreate) (MaintenanceEntry, error) {
+	_, err := r.db.Item.Query().
+		Where(
+			item.ID(itemID),
+			item.HasGroupWith(group.ID(GID)),
+		).
+		OnlyID(ctx)
+	if err != nil {
+		return MaintenanceEntry{}, err
+	}
+
+	entry, err := r.db.MaintenanceEntry.Create().
 		SetItemID(itemID).
 		SetDate(input.CompletedDate.Time()).
 		SetScheduledDate(input.ScheduledDate.Time()).
@@ -115,11 +140,14 @@ func (r *MaintenanceEntryRepository) Create(ctx context.Context, itemID uuid.UUI
 		SetCost(input.Cost).
 		Save(ctx)
 
-	return mapMaintenanceEntryErr(item, err)
+	return mapMaintenanceEntryErr(entry, err)
 }
 
-func (r *MaintenanceEntryRepository) Update(ctx context.Context, ID uuid.UUID, input MaintenanceEntryUpdate) (MaintenanceEntry, error) {
-	item, err := r.db.MaintenanceEntry.UpdateOneID(ID).
+// Update updates the entry, a not found error is returned when the entry doesn't belong to
+// an item of the group.
+func (r *MaintenanceEntryRepository) Update(ctx context.Context, GID, ID uuid.UUID, input MaintenanceEntryUpdate) (MaintenanceEntry, error) {
+	entry, err := r.db.MaintenanceEntry.UpdateOneID(ID).
+		Where(maintenanceInGroup(GID)).
 		SetDate(input.CompletedDate.Time()).
 		SetScheduledDate(input.ScheduledDate.Time()).
 		SetName(input.Name).
@@ -127,7 +155,7 @@ func (r *MaintenanceEntryRepository) Update(ctx context.Context, ID uuid.UUID, i
 		SetCost(input.Cost).
 		Save(ctx)
 
-	return mapMaintenanceEntryErr(item, err)
+	return mapMaintenanceEntryErr(entry, err)
 }
 
 type MaintenanceLogQuery struct {
@@ -203,6 +231,45 @@ FROM
 	return log, nil
 }
 
-func (r *MaintenanceEntryRepository) Delete(ctx context.Context, ID uuid.UUID) error {
-	return r.db.MaintenanceEntry.DeleteOneID(ID).Exec(ctx)
+// GetCostTotals returns the total maintenance cost of every item of the group that has
+// maintenance entries, most expensive first.
+func (r *MaintenanceEntryRepository) GetCostTotals(ctx context.Context, GID uuid.UUID) ([]MaintenanceCostTotal, error) {
+	var v []struct {
+		ItemID uuid.UUID `json:"item_id"`
+		Total  float64   `json:"total"`
+	}
+
+	err := r.db.MaintenanceEntry.Query().
+		Where(
+			maintenanceentry.HasItemWith(
+				item.HasGroupWith(group.ID(GID)),
+			),
+		).
+		GroupBy(maintenanceentry.FieldItemID).
+		Aggregate(func(sq *sql.Selector) string {
+			return sql.As(sql.Sum(sq.C(maintenanceentry.FieldCost)), "total")
+		}).
+		Scan(ctx, &v)
+	if err != nil {
+		return nil, err
+	}
+
+	totals := make([]MaintenanceCostTotal, len(v))
+	for i, t := range v {
+		totals[i] = MaintenanceCostTotal{ItemID: t.ItemID, Total: t.Total}
+	}
+
+	sort.Slice(totals, func(i, j int) bool {
+		return totals[i].Total > totals[j].Total
+	})
+
+	return totals, nil
+}
+
+// Delete deletes the entry, a not found error is returned when the entry doesn't belong to
+// an item of the group.
+func (r *MaintenanceEntryRepository) Delete(ctx context.Context, GID, ID uuid.UUID) error {
+	return r.db.MaintenanceEntry.DeleteOneID(ID).
+		Where(maintenanceInGroup(GID)).
+		Exec(ctx)
 }
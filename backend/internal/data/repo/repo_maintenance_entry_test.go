@@ -5,8 +5,10 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hay-kot/homebox/backend/internal/data/ent"
 	"github.com/hay-kot/homebox/backend/internal/data/types"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 // get the previous month from the current month, accounts for errors when run
@@ -52,7 +54,7 @@ func TestMaintenanceEntryRepository_GetLog(t *testing.T) {
 	}
 
 	for _, entry := range created {
-		_, err := tRepos.MaintEntry.Create(context.Background(), item.ID, entry)
+		_, err := tRepos.MaintEntry.Create(context.Background(), tGroup.ID, item.ID, entry)
 		if err != nil {
 			t.Fatalf("failed to create maintenance entry: %v", err)
 		}
@@ -80,7 +82,96 @@ func TestMaintenanceEntryRepository_GetLog(t *testing.T) {
 	assert.Equal(t, total/2, log.CostAverage, "average cost should be the average of the two months")
 
 	for _, entry := range log.Entries {
-		err := tRepos.MaintEntry.Delete(context.Background(), entry.ID)
+		err := tRepos.MaintEntry.Delete(context.Background(), tGroup.ID, entry.ID)
 		assert.NoError(t, err)
 	}
 }
+
+func TestMaintenanceEntryRepository_GetCostTotals(t *testing.T) {
+	g, err := tRepos.Groups.GroupCreate(context.Background(), "maintenance-costs")
+	require.NoError(t, err)
+
+	loc, err := tRepos.Locations.Create(context.Background(), g.ID, locationFactory())
+	require.NoError(t, err)
+
+	costs := [][]float64{
+		{10, 15},
+		{5, 50, 20},
+		{},
+	}
+
+	items := make([]ItemOut, len(costs))
+	for i, entries := range costs {
+		data := itemFactory()
+		data.LocationID = loc.ID
+
+		items[i], err = tRepos.Items.Create(context.Background(), g.ID, data)
+		require.NoError(t, err)
+
+		for _, cost := range entries {
+			_, err = tRepos.MaintEntry.Create(context.Background(), g.ID, items[i].ID, MaintenanceEntryCreate{
+				CompletedDate: types.DateFromTime(time.Now()),
+				Name:          "Service",
+				Cost:          cost,
+			})
+			require.NoError(t, err)
+		}
+	}
+
+	totals, err := tRepos.MaintEntry.GetCostTotals(context.Background(), g.ID)
+	require.NoError(t, err)
+	require.Len(t, totals, 2)
+
+	assert.Equal(t, items[1].ID, totals[0].ItemID)
+	assert.InDelta(t, 75.0, totals[0].Total, 0.001)
+	assert.Equal(t, items[0].ID, totals[1].ItemID)
+	assert.InDelta(t, 25.0, totals[1].Total, 0.001)
+}
+
+func TestMaintenanceEntryRepository_GroupScoped(t *testing.T) {
+	ctx := context.Background()
+	itm := useItems(t, 1)[0]
+
+	other, err := tRepos.Groups.GroupCreate(ctx, "maintenance-other")
+	require.NoError(t, err)
+
+	create := MaintenanceEntryCreate{
+		CompletedDate: types.DateFromTime(time.Now()),
+		Name:          "Oil change",
+		Cost:          40,
+	}
+
+	// the item isn't part of the other group
+	_, err = tRepos.MaintEntry.Create(ctx, other.ID, itm.ID, create)
+	require.Error(t, err)
+	assert.True(t, ent.IsNotFound(err))
+
+	entry, err := tRepos.MaintEntry.Create(ctx, tGroup.ID, itm.ID, create)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = tRepos.MaintEntry.Delete(context.Background(), tGroup.ID, entry.ID)
+	})
+
+	_, err = tRepos.MaintEntry.Update(ctx, other.ID, entry.ID, MaintenanceEntryUpdate{
+		CompletedDate: create.CompletedDate,
+		Name:          "Hijacked",
+	})
+	require.Error(t, err)
+	assert.True(t, ent.IsNotFound(err))
+
+	err = tRepos.MaintEntry.Delete(ctx, other.ID, entry.ID)
+	require.Error(t, err)
+	assert.True(t, ent.IsNotFound(err))
+
+	log, err := tRepos.MaintEntry.GetLog(ctx, tGroup.ID, itm.ID, MaintenanceLogQuery{})
+	require.NoError(t, err)
+	require.Len(t, log.Entries, 1)
+	assert.Equal(t, "Oil change", log.Entries[0].Name)
+
+	updated, err := tRepos.MaintEntry.Update(ctx, tGroup.ID, entry.ID, MaintenanceEntryUpdate{
+		CompletedDate: create.CompletedDate,
+		Name:          "Oil and filter change",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "Oil and filter change", updated.Name)
+}
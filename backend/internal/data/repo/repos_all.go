@@ -16,20 +16,24 @@ type AllRepos struct {
 	Docs        *DocumentRepository
 	Attachments *AttachmentRepo
 	MaintEntry  *MaintenanceEntryRepository
+	Comments    *ItemCommentRepository
 	Notifiers   *NotifierRepository
 }
 
-func New(db *ent.Client, bus *eventbus.EventBus, root string) *AllRepos {
+// New returns the repositories, the domain events of item changes are published to events,
+// which may be nil.
+func New(db *ent.Client, bus *eventbus.EventBus, root string, events EventBus) *AllRepos {
 	return &AllRepos{
 		Users:       &UserRepository{db},
 		AuthTokens:  &TokenRepository{db},
 		Groups:      NewGroupRepository(db),
 		Locations:   &LocationRepository{db, bus},
 		Labels:      &LabelRepository{db, bus},
-		Items:       &ItemsRepository{db, bus},
+		Items:       NewItemsRepository(db, bus, events),
 		Docs:        &DocumentRepository{db, root},
 		Attachments: &AttachmentRepo{db},
 		MaintEntry:  &MaintenanceEntryRepository{db},
+		Comments:    &ItemCommentRepository{db},
 		Notifiers:   NewNotifierRepository(db),
 	}
 }
@@ -0,0 +1,287 @@
+package repo
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/hay-kot/homebox/backend/internal/data/ent/item"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/predicate"
+)
+
+// maxSearchDepth limits how deeply search expressions may nest.
+const maxSearchDepth = 16
+
+// SearchParseError is returned when a boolean search expression is malformed.
+// Pos is the byte offset in the expression where the error was detected.
+type SearchParseError struct {
+	Pos int
+	Msg string
+}
+
+func (e *SearchParseError) Error() string {
+	return fmt.Sprintf("invalid search expression at position %d: %s", e.Pos, e.Msg)
+}
+
+type searchTokenKind int
+
+const (
+	searchTokenTerm searchTokenKind = iota
+	searchTokenAnd
+	searchTokenOr
+	searchTokenNot
+	searchTokenOpen
+	searchTokenClose
+	searchTokenEOF
+)
+
+type searchToken struct {
+	kind  searchTokenKind
+	value string
+	pos   int
+}
+
+// searchNode is a node of a parsed search expression.
+type searchNode interface {
+	predicate() predicate.Item
+	String() string
+}
+
+type (
+	searchTerm string
+	searchAnd  []searchNode
+	searchOr   []searchNode
+	searchNot  struct{ node searchNode }
+)
+
+func (t searchTerm) String() string { return fmt.Sprintf("%q", string(t)) }
+func (n searchNot) String() string  { return "NOT " + n.node.String() }
+func (a searchAnd) String() string  { return joinSearchNodes(a, " AND ") }
+func (o searchOr) String() string   { return joinSearchNodes(o, " OR ") }
+
+func joinSearchNodes(nodes []searchNode, sep string) string {
+	parts := make([]string, len(nodes))
+	for i, n := range nodes {
+		parts[i] = n.String()
+	}
+	return "(" + strings.Join(parts, sep) + ")"
+}
+
+// predicate matches the term against the same fields as the simple search. Optional
+// fields are guarded against NULL so that negated terms still match items without them.
+func (t searchTerm) predicate() predicate.Item {
+	v := string(t)
+
+	return item.Or(
+		item.NameContainsFold(v),
+		item.And(item.DescriptionNotNil(), item.DescriptionContainsFold(v)),
+		item.And(item.NotesNotNil(), item.NotesContainsFold(v)),
+		item.And(item.SerialNumberNotNil(), item.SerialNumberContainsFold(v)),
+		item.And(item.ModelNumberNotNil(), item.ModelNumberContainsFold(v)),
+		item.And(item.ManufacturerNotNil(), item.ManufacturerContainsFold(v)),
+		item.And(item.PurchaseOrderNumberNotNil(), item.PurchaseOrderNumberContainsFold(v)),
+	)
+}
+
+func (n searchNot) predicate() predicate.Item { return item.Not(n.node.predicate()) }
+
+func (a searchAnd) predicate() predicate.Item { return item.And(searchPredicates(a)...) }
+
+func (o searchOr) predicate() predicate.Item { return item.Or(searchPredicates(o)...) }
+
+func searchPredicates(nodes []searchNode) []predicate.Item {
+	preds := make([]predicate.Item, len(nodes))
+	for i, n := range nodes {
+		preds[i] = n.predicate()
+	}
+	return preds
+}
+
+func tokenizeSearch(expr string) ([]searchToken, error) {
+	var tokens []searchToken
+
+	i := 0
+	for i < len(expr) {
+		c, width := utf8.DecodeRuneInString(expr[i:])
+
+		switch {
+		case unicode.IsSpace(c):
+			i += width
+		case c == '(':
+			tokens = append(tokens, searchToken{kind: searchTokenOpen, pos: i})
+			i++
+		case c == ')':
+			tokens = append(tokens, searchToken{kind: searchTokenClose, pos: i})
+			i++
+		case c == '"':
+			end := strings.IndexByte(expr[i+1:], '"')
+			if end == -1 {
+				return nil, &SearchParseError{Pos: i, Msg: "unterminated quote"}
+			}
+
+			phrase := strings.TrimSpace(expr[i+1 : i+1+end])
+			if phrase == "" {
+				return nil, &SearchParseError{Pos: i, Msg: "empty phrase"}
+			}
+
+			tokens = append(tokens, searchToken{kind: searchTokenTerm, value: phrase, pos: i})
+			i += end + 2
+		default:
+			start := i
+			for i < len(expr) {
+				r, w := utf8.DecodeRuneInString(expr[i:])
+				if unicode.IsSpace(r) || strings.ContainsRune(`()"`, r) {
+					break
+				}
+				i += w
+			}
+
+			word := expr[start:i]
+			tok := searchToken{kind: searchTokenTerm, value: word, pos: start}
+
+			// operators are only recognized in upper case so that lower case words can
+			// still be searched for
+			switch word {
+			case "AND":
+				tok.kind = searchTokenAnd
+			case "OR":
+				tok.kind = searchTokenOr
+			case "NOT":
+				tok.kind = searchTokenNot
+			}
+
+			tokens = append(tokens, tok)
+		}
+	}
+
+	return append(tokens, searchToken{kind: searchTokenEOF, pos: len(expr)}), nil
+}
+
+type searchParser struct {
+	tokens []searchToken
+	pos    int
+	depth  int
+}
+
+// parseSearch parses a boolean search expression. Terms are separated by the upper case
+// operators AND, OR and NOT, adjacent terms are implicitly AND'd, and parentheses and double
+// quoted phrases are supported. NOT binds tightest, followed by AND and then OR.
+func parseSearch(expr string) (searchNode, error) {
+	tokens, err := tokenizeSearch(expr)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &searchParser{tokens: tokens}
+
+	node, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+
+	if tok := p.peek(); tok.kind != searchTokenEOF {
+		return nil, &SearchParseError{Pos: tok.pos, Msg: "unexpected token"}
+	}
+
+	return node, nil
+}
+
+func (p *searchParser) peek() searchToken {
+	return p.tokens[p.pos]
+}
+
+func (p *searchParser) next() searchToken {
+	tok := p.tokens[p.pos]
+	if tok.kind != searchTokenEOF {
+		p.pos++
+	}
+	return tok
+}
+
+func (p *searchParser) parseOr() (searchNode, error) {
+	first, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+
+	nodes := searchOr{first}
+	for p.peek().kind == searchTokenOr {
+		p.next()
+
+		n, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, n)
+	}
+
+	if len(nodes) == 1 {
+		return first, nil
+	}
+	return nodes, nil
+}
+
+func (p *searchParser) parseAnd() (searchNode, error) {
+	first, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+
+	nodes := searchAnd{first}
+	for {
+		switch p.peek().kind {
+		case searchTokenAnd:
+			p.next()
+		case searchTokenTerm, searchTokenNot, searchTokenOpen:
+			// implicit AND
+		default:
+			if len(nodes) == 1 {
+				return first, nil
+			}
+			return nodes, nil
+		}
+
+		n, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, n)
+	}
+}
+
+func (p *searchParser) parseUnary() (searchNode, error) {
+	tok := p.next()
+
+	switch tok.kind {
+	case searchTokenTerm:
+		return searchTerm(tok.value), nil
+	case searchTokenNot:
+		n, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return searchNot{n}, nil
+	case searchTokenOpen:
+		p.depth++
+		if p.depth > maxSearchDepth {
+			return nil, &SearchParseError{Pos: tok.pos, Msg: "expression is nested too deeply"}
+		}
+
+		n, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+
+		if closing := p.next(); closing.kind != searchTokenClose {
+			return nil, &SearchParseError{Pos: closing.pos, Msg: "expected closing parenthesis"}
+		}
+
+		p.depth--
+		return n, nil
+	case searchTokenEOF:
+		return nil, &SearchParseError{Pos: tok.pos, Msg: "unexpected end of expression"}
+	default:
+		return nil, &SearchParseError{Pos: tok.pos, Msg: "expected a search term"}
+	}
+}
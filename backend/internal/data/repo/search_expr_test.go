@@ -0,0 +1,102 @@
+package repo
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestParseSearch(t *testing.T) {
+	tests := []struct {
+		name string
+		expr string
+		want string
+	}{
+		{
+			name: "single term",
+			expr: "drill",
+			want: `"drill"`,
+		},
+		{
+			name: "implicit and with not",
+			expr: "drill AND dewalt NOT cordless",
+			want: `("drill" AND "dewalt" AND NOT "cordless")`,
+		},
+		{
+			name: "and binds tighter than or",
+			expr: "drill dewalt OR saw",
+			want: `(("drill" AND "dewalt") OR "saw")`,
+		},
+		{
+			name: "parentheses",
+			expr: "drill AND (dewalt OR makita)",
+			want: `("drill" AND ("dewalt" OR "makita"))`,
+		},
+		{
+			name: "quoted phrase",
+			expr: `"power drill" NOT (cordless)`,
+			want: `("power drill" AND NOT "cordless")`,
+		},
+		{
+			name: "lower case operators are terms",
+			expr: "salt and pepper",
+			want: `("salt" AND "and" AND "pepper")`,
+		},
+		{
+			name: "non-ascii term",
+			expr: "Škoda",
+			want: `"Škoda"`,
+		},
+		{
+			name: "non-ascii words",
+			expr: "à la carte OR café",
+			want: `(("à" AND "la" AND "carte") OR "café")`,
+		},
+		{
+			name: "unicode space separates terms",
+			expr: "tür\u00a0schloss",
+			want: `("tür" AND "schloss")`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseSearch(tt.expr)
+			if err != nil {
+				t.Fatalf("parseSearch() error = %v", err)
+			}
+			if got.String() != tt.want {
+				t.Errorf("parseSearch() = %v, want %v", got.String(), tt.want)
+			}
+		})
+	}
+}
+
+func TestParseSearch_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		expr    string
+		wantPos int
+	}{
+		{name: "empty", expr: "", wantPos: 0},
+		{name: "dangling operator", expr: "drill AND", wantPos: 9},
+		{name: "leading operator", expr: "OR drill", wantPos: 0},
+		{name: "unbalanced open", expr: "(drill", wantPos: 6},
+		{name: "unbalanced close", expr: "drill)", wantPos: 5},
+		{name: "empty parentheses", expr: "drill ()", wantPos: 7},
+		{name: "unterminated quote", expr: `drill "dewalt`, wantPos: 6},
+		{name: "too deep", expr: strings.Repeat("(", maxSearchDepth+1) + "drill" + strings.Repeat(")", maxSearchDepth+1), wantPos: maxSearchDepth},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := parseSearch(tt.expr)
+
+			var perr *SearchParseError
+			if !errors.As(err, &perr) {
+				t.Fatalf("parseSearch() error = %v, want *SearchParseError", err)
+			}
+			if perr.Pos != tt.wantPos {
+				t.Errorf("parseSearch() error position = %d, want %d", perr.Pos, tt.wantPos)
+			}
+		})
+	}
+}
@@ -0,0 +1,7 @@
+package types
+
+// FieldChange holds the previous and new value of a changed field.
+type FieldChange struct {
+	Old any `json:"old"`
+	New any `json:"new"`
+}
@@ -0,0 +1,27 @@
+package types
+
+import "github.com/google/uuid"
+
+// ItemTemplateDefaults holds the fields an item template pre-fills on the items created
+// from it. Purchase and sale details and serial numbers are specific to each item and are
+// not kept.
+type ItemTemplateDefaults struct {
+	Name            string `json:"name"`
+	Description     string `json:"description,omitempty"`
+	Quantity        int    `json:"quantity,omitempty"`
+	Insured         bool   `json:"insured,omitempty"`
+	AcquisitionType string `json:"acquisitionType,omitempty"`
+
+	// Identification
+	Manufacturer string `json:"manufacturer,omitempty"`
+	ModelNumber  string `json:"modelNumber,omitempty"`
+	Barcode      string `json:"barcode,omitempty"`
+
+	// Warranty
+	LifetimeWarranty bool   `json:"lifetimeWarranty,omitempty"`
+	WarrantyDetails  string `json:"warrantyDetails,omitempty"`
+
+	LocationID uuid.UUID   `json:"locationId,omitempty"`
+	LabelIDs   []uuid.UUID `json:"labelIds,omitempty"`
+	Tags       []string    `json:"tags,omitempty"`
+}
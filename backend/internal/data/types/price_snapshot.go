@@ -0,0 +1,12 @@
+package types
+
+import "github.com/google/uuid"
+
+// PriceSnapshot holds the prices of an item at a point in time, e.g. before the
+// prices of a group are converted to another currency.
+type PriceSnapshot struct {
+	ItemID          uuid.UUID `json:"itemId"`
+	PurchasePrice   float64   `json:"purchasePrice"`
+	SoldPrice       float64   `json:"soldPrice"`
+	ReplacementCost float64   `json:"replacementCost"`
+}
@@ -0,0 +1,14 @@
+package types
+
+import "github.com/google/uuid"
+
+// SavedSearchFilter holds the filters of an item query that are kept when the query is
+// saved as a named search.
+type SavedSearchFilter struct {
+	Search           string      `json:"search,omitempty"`
+	LabelIDs         []uuid.UUID `json:"labelIds,omitempty"`
+	LabelsMatchAll   bool        `json:"labelsMatchAll,omitempty"`
+	LocationIDs      []uuid.UUID `json:"locationIds,omitempty"`
+	MinPurchasePrice *float64    `json:"minPurchasePrice,omitempty"`
+	MaxPurchasePrice *float64    `json:"maxPurchasePrice,omitempty"`
+}
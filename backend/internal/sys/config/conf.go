@@ -29,6 +29,8 @@ type Config struct {
 type Options struct {
 	AllowRegistration    bool `yaml:"disable_registration" conf:"default:true"`
 	AutoIncrementAssetID bool `yaml:"auto_increment_asset_id" conf:"default:true"`
+	DefaultPageSize      int  `yaml:"default_page_size" conf:"default:50"`
+	MaxPageSize          int  `yaml:"max_page_size" conf:"default:500"`
 }
 
 type DebugConf struct {